@@ -362,6 +362,7 @@ func New(
 		keys[authoritytypes.StoreKey],
 		keys[authoritytypes.MemStoreKey],
 		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.MsgServiceRouter(),
 	)
 
 	app.ObserverKeeper = observerkeeper.NewKeeper(
@@ -397,6 +398,7 @@ func New(
 		app.StakingKeeper,
 		app.ObserverKeeper,
 		app.AccountKeeper,
+		app.AuthorityKeeper,
 	)
 
 	// Create Ethermint keepers
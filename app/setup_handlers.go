@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -18,7 +20,20 @@ func SetupHandlers(app *App) {
 		for m, mb := range app.mm.Modules {
 			vm[m] = mb.ConsensusVersion()
 		}
+		// observer runs the policies migration (v6 to v7), the liveness params migration (v7 to v8),
+		// the bitcoin header chains migration (v8 to v9), the block header verification flags migration (v9 to v10)
+		// and the blame retention migration (v10 to v11)
 		VersionMigrator{v: vm}.TriggerMigration(observertypes.ModuleName)
+		VersionMigrator{v: vm}.TriggerMigration(observertypes.ModuleName)
+		VersionMigrator{v: vm}.TriggerMigration(observertypes.ModuleName)
+		VersionMigrator{v: vm}.TriggerMigration(observertypes.ModuleName)
+		VersionMigrator{v: vm}.TriggerMigration(observertypes.ModuleName)
+		VersionMigrator{v: vm}.TriggerMigration(crosschaintypes.ModuleName)
+		// authority runs the authorization list migration (v1 to v2) and the timelock config migration (v2 to v3)
+		VersionMigrator{v: vm}.TriggerMigration(authoritytypes.ModuleName)
+		VersionMigrator{v: vm}.TriggerMigration(authoritytypes.ModuleName)
+		// emissions runs the emission schedule params migration (v2 to v3)
+		VersionMigrator{v: vm}.TriggerMigration(emissionstypes.ModuleName)
 
 		return app.mm.RunMigrations(ctx, app.configurator, vm)
 	})
@@ -115,6 +115,10 @@ func CreateChainClientMap(
 		if !evmChainParams.IsSupported {
 			continue
 		}
+		if !appContext.ZetaCoreContext().IsChainObserved(evmConfig.Chain.ChainId) {
+			loggers.Std.Info().Msgf("observer not assigned to chain %s, skipping chain client", evmConfig.Chain.String())
+			continue
+		}
 		co, err := evm.NewEVMChainClient(appContext, bridge, tss, dbpath, loggers, evmConfig, ts)
 		if err != nil {
 			loggers.Std.Error().Err(err).Msgf("NewEVMChainClient error for chain %s", evmConfig.Chain.String())
@@ -124,7 +128,9 @@ func CreateChainClientMap(
 	}
 	// BTC client
 	btcChain, _, enabled := appContext.GetBTCChainAndConfig()
-	if enabled {
+	if enabled && !appContext.ZetaCoreContext().IsChainObserved(btcChain.ChainId) {
+		loggers.Std.Info().Msgf("observer not assigned to chain %s, skipping chain client", btcChain.String())
+	} else if enabled {
 		co, err := bitcoin.NewBitcoinClient(appContext, btcChain, bridge, tss, dbpath, loggers, ts)
 		if err != nil {
 			loggers.Std.Error().Err(err).Msgf("NewBitcoinClient error for chain %s", btcChain.String())
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/spf13/cobra"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	flagMinHeight = "min-height"
+	flagMaxHeight = "max-height"
+	flagPageLimit = "page-limit"
+
+	// CctxExportFile is the name of the file the cctxs are exported to
+	CctxExportFile = "cctx.jsonl"
+
+	// BallotExportFile is the name of the file the ballots are exported to
+	BallotExportFile = "ballot.jsonl"
+)
+
+// ExportPrunableRecordsCmd exports the cctxs queued for pruning and their ballots to JSONL files
+// the command should be run against an archive node, with --height, to export the records already pruned
+func ExportPrunableRecordsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-prunable-records [output-dir]",
+		Short: "Export the cctxs queued for pruning and their ballots to JSONL files",
+		Long: fmt.Sprintf(`Export the cctxs in a terminal state queued for pruning to %s and their inbound and outbound ballots to %s.
+Each line of the files is the JSON encoding of a record. The --min-height and --max-height flags filter the cctxs by the
+zeta height at which they became prunable. Use --height against an archive node to export the records already pruned.`,
+			CctxExportFile,
+			BallotExportFile,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			minHeight, err := cmd.Flags().GetUint64(flagMinHeight)
+			if err != nil {
+				return err
+			}
+			maxHeight, err := cmd.Flags().GetUint64(flagMaxHeight)
+			if err != nil {
+				return err
+			}
+			pageLimit, err := cmd.Flags().GetUint64(flagPageLimit)
+			if err != nil {
+				return err
+			}
+
+			outputDir := args[0]
+			if err := os.MkdirAll(outputDir, 0o750); err != nil {
+				return err
+			}
+			cctxFile, err := os.Create(filepath.Clean(filepath.Join(outputDir, CctxExportFile)))
+			if err != nil {
+				return err
+			}
+			defer cctxFile.Close()
+			ballotFile, err := os.Create(filepath.Clean(filepath.Join(outputDir, BallotExportFile)))
+			if err != nil {
+				return err
+			}
+			defer ballotFile.Close()
+
+			cctxWriter := bufio.NewWriter(cctxFile)
+			ballotWriter := bufio.NewWriter(ballotFile)
+			cctxCount, ballotCount, err := exportPrunableRecords(
+				cmd,
+				clientCtx,
+				minHeight,
+				maxHeight,
+				pageLimit,
+				cctxWriter,
+				ballotWriter,
+			)
+			if err != nil {
+				return err
+			}
+			if err := cctxWriter.Flush(); err != nil {
+				return err
+			}
+			if err := ballotWriter.Flush(); err != nil {
+				return err
+			}
+
+			cmd.Printf("exported %d cctxs and %d ballots to %s\n", cctxCount, ballotCount, outputDir)
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(flagMinHeight, 0, "min zeta height at which the cctxs became prunable, 0 for no bound")
+	cmd.Flags().Uint64(flagMaxHeight, 0, "max zeta height at which the cctxs became prunable, 0 for no bound")
+	cmd.Flags().Uint64(flagPageLimit, 100, "number of cctxs queried per request")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// exportPrunableRecords pages through the prunable cctxs and writes the cctxs and their ballots as JSON lines
+// it returns the number of cctxs and ballots exported
+func exportPrunableRecords(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	minHeight, maxHeight, pageLimit uint64,
+	cctxWriter, ballotWriter *bufio.Writer,
+) (int, int, error) {
+	crosschainClient := crosschaintypes.NewQueryClient(clientCtx)
+	observerClient := observertypes.NewQueryClient(clientCtx)
+
+	cctxCount, ballotCount := 0, 0
+	var nextKey []byte
+	for {
+		res, err := crosschainClient.CctxListPrunable(cmd.Context(), &crosschaintypes.QueryListCctxPrunableRequest{
+			MinHeight:  minHeight,
+			MaxHeight:  maxHeight,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: pageLimit},
+		})
+		if err != nil {
+			return cctxCount, ballotCount, err
+		}
+
+		for _, cctx := range res.CrossChainTx {
+			if err := writeJSONLine(clientCtx.Codec, cctxWriter, cctx); err != nil {
+				return cctxCount, ballotCount, err
+			}
+			cctxCount++
+
+			for _, ballotIndex := range cctxBallotIndexes(cctx) {
+				ballot, err := observerClient.BallotByIdentifier(cmd.Context(), &observertypes.QueryBallotByIdentifierRequest{
+					BallotIdentifier: ballotIndex,
+				})
+				if status.Code(err) == codes.NotFound {
+					continue
+				}
+				if err != nil {
+					return cctxCount, ballotCount, err
+				}
+				if err := writeJSONLine(clientCtx.Codec, ballotWriter, ballot); err != nil {
+					return cctxCount, ballotCount, err
+				}
+				ballotCount++
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return cctxCount, ballotCount, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+// cctxBallotIndexes returns the indexes of the inbound and outbound ballots of a cctx
+func cctxBallotIndexes(cctx *crosschaintypes.CrossChainTx) []string {
+	var indexes []string
+	if cctx.InboundTxParams != nil && cctx.InboundTxParams.InboundTxBallotIndex != "" {
+		indexes = append(indexes, cctx.InboundTxParams.InboundTxBallotIndex)
+	}
+	for _, outTxParams := range cctx.OutboundTxParams {
+		if outTxParams.OutboundTxBallotIndex != "" {
+			indexes = append(indexes, outTxParams.OutboundTxBallotIndex)
+		}
+	}
+	return indexes
+}
+
+// writeJSONLine writes the JSON encoding of a record followed by a new line
+func writeJSONLine(cdc codec.JSONCodec, w *bufio.Writer, record codec.ProtoMarshaler) error {
+	bz, err := cdc.MarshalJSON(record)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(bz); err != nil {
+		return err
+	}
+	return w.WriteByte('\n')
+}
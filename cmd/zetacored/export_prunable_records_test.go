@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/app"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestCctxBallotIndexes(t *testing.T) {
+	cctx := sample.CrossChainTx(t, "foo")
+	cctx.InboundTxParams.InboundTxBallotIndex = "inbound"
+	cctx.OutboundTxParams[0].OutboundTxBallotIndex = "outbound"
+	cctx.OutboundTxParams[1].OutboundTxBallotIndex = ""
+	require.Equal(t, []string{"inbound", "outbound"}, cctxBallotIndexes(cctx))
+
+	cctx.InboundTxParams = nil
+	cctx.OutboundTxParams = nil
+	require.Empty(t, cctxBallotIndexes(cctx))
+}
+
+func TestWriteJSONLine(t *testing.T) {
+	cdc := app.MakeEncodingConfig().Codec
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+
+	cctxs := []*crosschaintypes.CrossChainTx{sample.CrossChainTx(t, "foo"), sample.CrossChainTx(t, "bar")}
+	for _, cctx := range cctxs {
+		require.NoError(t, writeJSONLine(cdc, w, cctx))
+	}
+	require.NoError(t, w.Flush())
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, len(cctxs))
+	for i, line := range lines {
+		var cctx crosschaintypes.CrossChainTx
+		require.NoError(t, cdc.UnmarshalJSON([]byte(line), &cctx))
+		require.Equal(t, cctxs[i].Index, cctx.Index)
+	}
+}
@@ -0,0 +1,130 @@
+package bitcoin
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// ErrAncestorNotFound is returned when an ancestor header needed to compute the required difficulty is not available
+var ErrAncestorNotFound = errors.New("ancestor header not found")
+
+// AncestorFunc returns the header at the given height on the branch of the header being validated
+type AncestorFunc func(height int64) (*wire.BlockHeader, bool)
+
+// BlocksPerRetarget returns the number of blocks between two difficulty retargets
+func BlocksPerRetarget(params *chaincfg.Params) int64 {
+	return int64(params.TargetTimespan / params.TargetTimePerBlock)
+}
+
+// CalcWork returns the work represented by the difficulty bits of a header
+func CalcWork(bits uint32) *big.Int {
+	return blockchain.CalcWork(bits)
+}
+
+// noRetargeting returns true for the chains whose difficulty never changes
+// NOTE: the pow no retargeting rule of regtest is not part of the btcd chain params, regtest is identified by its network
+func noRetargeting(params *chaincfg.Params) bool {
+	return params.Net == wire.TestNet
+}
+
+// CalcNextRequiredDifficulty returns the difficulty bits required for the header following the parent header
+// it applies the retarget rules of the chain params, ancestors of the parent are looked up through the ancestor function
+// ErrAncestorNotFound is returned if an ancestor required to compute the difficulty is not available
+func CalcNextRequiredDifficulty(
+	params *chaincfg.Params,
+	parent *wire.BlockHeader,
+	parentHeight int64,
+	newBlockTime time.Time,
+	ancestor AncestorFunc,
+) (uint32, error) {
+	// the difficulty never changes on chains without retargeting (e.g. regtest)
+	if noRetargeting(params) {
+		return parent.Bits, nil
+	}
+
+	blocksPerRetarget := BlocksPerRetarget(params)
+	powLimitBits := params.PowLimitBits
+
+	// the difficulty only changes at retarget intervals
+	if (parentHeight+1)%blocksPerRetarget != 0 {
+		if !params.ReduceMinDifficulty {
+			return parent.Bits, nil
+		}
+
+		// testnet rules: a block with the minimum difficulty is allowed if no block was mined for a while
+		reductionTime := int64(params.MinDiffReductionTime / time.Second)
+		allowMinTime := parent.Timestamp.Unix() + reductionTime
+		if newBlockTime.Unix() > allowMinTime {
+			return powLimitBits, nil
+		}
+
+		// otherwise the difficulty is the one of the last block that was not mined with the minimum difficulty
+		height, header := parentHeight, parent
+		for height%blocksPerRetarget != 0 && header.Bits == powLimitBits {
+			height--
+			var found bool
+			header, found = ancestor(height)
+			if !found {
+				return 0, fmt.Errorf("%w at height %d", ErrAncestorNotFound, height)
+			}
+		}
+		return header.Bits, nil
+	}
+
+	// retarget the difficulty from the time spent to mine the blocks of the previous interval
+	first, found := ancestor(parentHeight - (blocksPerRetarget - 1))
+	if !found {
+		return 0, fmt.Errorf("%w at height %d", ErrAncestorNotFound, parentHeight-(blocksPerRetarget-1))
+	}
+	targetTimespan := int64(params.TargetTimespan / time.Second)
+	adjustmentFactor := params.RetargetAdjustmentFactor
+	actualTimespan := parent.Timestamp.Unix() - first.Timestamp.Unix()
+	if actualTimespan < targetTimespan/adjustmentFactor {
+		actualTimespan = targetTimespan / adjustmentFactor
+	} else if actualTimespan > targetTimespan*adjustmentFactor {
+		actualTimespan = targetTimespan * adjustmentFactor
+	}
+
+	newTarget := blockchain.CompactToBig(parent.Bits)
+	newTarget.Mul(newTarget, big.NewInt(actualTimespan))
+	newTarget.Div(newTarget, big.NewInt(targetTimespan))
+	if newTarget.Cmp(params.PowLimit) > 0 {
+		newTarget.Set(params.PowLimit)
+	}
+	return blockchain.BigToCompact(newTarget), nil
+}
+
+// CheckDifficultyTransition checks the difficulty bits of a header are reachable from the difficulty bits of its parent
+// it is used when the ancestors needed to compute the exact required difficulty are not available
+// the target can't change by more than the retarget adjustment factor and can't exceed the proof-of-work limit
+func CheckDifficultyTransition(params *chaincfg.Params, parentBits, bits uint32) error {
+	target := blockchain.CompactToBig(bits)
+	if target.Sign() <= 0 || target.Cmp(params.PowLimit) > 0 {
+		return fmt.Errorf("target difficulty %064x is out of range", target)
+	}
+	if noRetargeting(params) {
+		if bits != parentBits {
+			return fmt.Errorf("difficulty bits %08x differ from parent difficulty bits %08x", bits, parentBits)
+		}
+		return nil
+	}
+	// testnet blocks can be mined with the minimum difficulty, and go back to the regular difficulty after
+	if params.ReduceMinDifficulty && (bits == params.PowLimitBits || parentBits == params.PowLimitBits) {
+		return nil
+	}
+
+	adjustmentFactor := big.NewInt(params.RetargetAdjustmentFactor)
+	parentTarget := blockchain.CompactToBig(parentBits)
+	minTarget := new(big.Int).Div(parentTarget, adjustmentFactor)
+	maxTarget := new(big.Int).Mul(parentTarget, adjustmentFactor)
+	if target.Cmp(minTarget) < 0 || target.Cmp(maxTarget) > 0 {
+		return fmt.Errorf("difficulty bits %08x are not reachable from parent difficulty bits %08x", bits, parentBits)
+	}
+	return nil
+}
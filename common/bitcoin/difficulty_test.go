@@ -0,0 +1,169 @@
+package bitcoin
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// ancestors returns an ancestor function looking up the headers from a map
+func ancestors(headers map[int64]*wire.BlockHeader) AncestorFunc {
+	return func(height int64) (*wire.BlockHeader, bool) {
+		header, found := headers[height]
+		return header, found
+	}
+}
+
+func TestCalcNextRequiredDifficulty(t *testing.T) {
+	// a mainnet difficulty lower than the proof-of-work limit
+	const bits = uint32(0x1a05db8b)
+	parentTime := time.Unix(1700000000, 0)
+	twoWeeks := 14 * 24 * time.Hour
+
+	t.Run("should return parent bits for regtest", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: 0x207fffff, Timestamp: parentTime}
+		requiredBits, err := CalcNextRequiredDifficulty(&chaincfg.RegressionNetParams, parent, 2015, parentTime, ancestors(nil))
+		require.NoError(t, err)
+		require.Equal(t, uint32(0x207fffff), requiredBits)
+	})
+
+	t.Run("should return parent bits between two retargets", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: bits, Timestamp: parentTime}
+		requiredBits, err := CalcNextRequiredDifficulty(&chaincfg.MainNetParams, parent, 2016, parentTime, ancestors(nil))
+		require.NoError(t, err)
+		require.Equal(t, bits, requiredBits)
+	})
+
+	t.Run("should keep the difficulty if the blocks were mined at the target pace", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: bits, Timestamp: parentTime}
+		headers := map[int64]*wire.BlockHeader{
+			0: {Bits: bits, Timestamp: parentTime.Add(-twoWeeks)},
+		}
+		requiredBits, err := CalcNextRequiredDifficulty(&chaincfg.MainNetParams, parent, 2015, parentTime, ancestors(headers))
+		require.NoError(t, err)
+		require.Equal(t, bits, requiredBits)
+	})
+
+	t.Run("should double the difficulty if the blocks were mined twice faster", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: bits, Timestamp: parentTime}
+		headers := map[int64]*wire.BlockHeader{
+			2016: {Bits: bits, Timestamp: parentTime.Add(-twoWeeks / 2)},
+		}
+		requiredBits, err := CalcNextRequiredDifficulty(&chaincfg.MainNetParams, parent, 4031, parentTime, ancestors(headers))
+		require.NoError(t, err)
+
+		expectedTarget := new(big.Int).Div(blockchain.CompactToBig(bits), big.NewInt(2))
+		require.Equal(t, blockchain.BigToCompact(expectedTarget), requiredBits)
+	})
+
+	t.Run("should limit the difficulty adjustment to the adjustment factor", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: bits, Timestamp: parentTime}
+		headers := map[int64]*wire.BlockHeader{
+			0: {Bits: bits, Timestamp: parentTime.Add(-twoWeeks * 10)},
+		}
+		requiredBits, err := CalcNextRequiredDifficulty(&chaincfg.MainNetParams, parent, 2015, parentTime, ancestors(headers))
+		require.NoError(t, err)
+
+		expectedTarget := new(big.Int).Mul(blockchain.CompactToBig(bits), big.NewInt(4))
+		require.Equal(t, blockchain.BigToCompact(expectedTarget), requiredBits)
+	})
+
+	t.Run("should not exceed the proof-of-work limit", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: chaincfg.MainNetParams.PowLimitBits, Timestamp: parentTime}
+		headers := map[int64]*wire.BlockHeader{
+			0: {Bits: chaincfg.MainNetParams.PowLimitBits, Timestamp: parentTime.Add(-twoWeeks * 2)},
+		}
+		requiredBits, err := CalcNextRequiredDifficulty(&chaincfg.MainNetParams, parent, 2015, parentTime, ancestors(headers))
+		require.NoError(t, err)
+		require.Equal(t, chaincfg.MainNetParams.PowLimitBits, requiredBits)
+	})
+
+	t.Run("should fail if the first block of the retarget interval is not available", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: bits, Timestamp: parentTime}
+		_, err := CalcNextRequiredDifficulty(&chaincfg.MainNetParams, parent, 2015, parentTime, ancestors(nil))
+		require.ErrorIs(t, err, ErrAncestorNotFound)
+	})
+
+	t.Run("should allow the minimum difficulty on testnet if no block was mined for a while", func(t *testing.T) {
+		parent := &wire.BlockHeader{Bits: bits, Timestamp: parentTime}
+		requiredBits, err := CalcNextRequiredDifficulty(
+			&chaincfg.TestNet3Params,
+			parent,
+			2016,
+			parentTime.Add(21*time.Minute),
+			ancestors(nil),
+		)
+		require.NoError(t, err)
+		require.Equal(t, chaincfg.TestNet3Params.PowLimitBits, requiredBits)
+	})
+
+	t.Run("should return the last regular difficulty on testnet after minimum difficulty blocks", func(t *testing.T) {
+		powLimitBits := chaincfg.TestNet3Params.PowLimitBits
+		parent := &wire.BlockHeader{Bits: powLimitBits, Timestamp: parentTime}
+		headers := map[int64]*wire.BlockHeader{
+			2018: {Bits: powLimitBits, Timestamp: parentTime.Add(-time.Minute)},
+			2017: {Bits: bits, Timestamp: parentTime.Add(-2 * time.Minute)},
+		}
+		requiredBits, err := CalcNextRequiredDifficulty(
+			&chaincfg.TestNet3Params,
+			parent,
+			2019,
+			parentTime.Add(time.Minute),
+			ancestors(headers),
+		)
+		require.NoError(t, err)
+		require.Equal(t, bits, requiredBits)
+
+		// the walk back stops at the retarget height
+		delete(headers, 2017)
+		_, err = CalcNextRequiredDifficulty(
+			&chaincfg.TestNet3Params,
+			parent,
+			2019,
+			parentTime.Add(time.Minute),
+			ancestors(headers),
+		)
+		require.ErrorIs(t, err, ErrAncestorNotFound)
+	})
+}
+
+func TestCheckDifficultyTransition(t *testing.T) {
+	const bits = uint32(0x1a05db8b)
+	target := blockchain.CompactToBig(bits)
+	harderBits := blockchain.BigToCompact(new(big.Int).Div(target, big.NewInt(2)))
+	tooHardBits := blockchain.BigToCompact(new(big.Int).Div(target, big.NewInt(5)))
+	tooEasyBits := blockchain.BigToCompact(new(big.Int).Mul(target, big.NewInt(5)))
+
+	t.Run("should accept a difficulty within the adjustment factor", func(t *testing.T) {
+		require.NoError(t, CheckDifficultyTransition(&chaincfg.MainNetParams, bits, bits))
+		require.NoError(t, CheckDifficultyTransition(&chaincfg.MainNetParams, bits, harderBits))
+		require.NoError(t, CheckDifficultyTransition(&chaincfg.MainNetParams, harderBits, bits))
+	})
+
+	t.Run("should reject a difficulty beyond the adjustment factor", func(t *testing.T) {
+		require.Error(t, CheckDifficultyTransition(&chaincfg.MainNetParams, bits, tooHardBits))
+		require.Error(t, CheckDifficultyTransition(&chaincfg.MainNetParams, bits, tooEasyBits))
+	})
+
+	t.Run("should reject a target above the proof-of-work limit", func(t *testing.T) {
+		err := CheckDifficultyTransition(&chaincfg.MainNetParams, chaincfg.MainNetParams.PowLimitBits, 0x1d01ffff)
+		require.Error(t, err)
+	})
+
+	t.Run("should only accept the parent difficulty on regtest", func(t *testing.T) {
+		require.NoError(t, CheckDifficultyTransition(&chaincfg.RegressionNetParams, 0x207fffff, 0x207fffff))
+		require.Error(t, CheckDifficultyTransition(&chaincfg.RegressionNetParams, 0x207fffff, 0x1d00ffff))
+	})
+
+	t.Run("should accept the minimum difficulty on testnet", func(t *testing.T) {
+		powLimitBits := chaincfg.TestNet3Params.PowLimitBits
+		require.NoError(t, CheckDifficultyTransition(&chaincfg.TestNet3Params, bits, powLimitBits))
+		require.NoError(t, CheckDifficultyTransition(&chaincfg.TestNet3Params, powLimitBits, bits))
+		require.Error(t, CheckDifficultyTransition(&chaincfg.MainNetParams, bits, powLimitBits))
+	})
+}
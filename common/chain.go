@@ -116,7 +116,9 @@ func IsHeaderSupportedEvmChain(chainID int64) bool {
 		chainID == 97 || // BSC testnet
 		chainID == 1337 || // eth privnet
 		chainID == 1 || // eth mainnet
-		chainID == 56 // bsc mainnet
+		chainID == 56 || // bsc mainnet
+		chainID == 80001 || // Polygon mumbai
+		chainID == 137 // polygon mainnet
 }
 
 // SupportMerkleProof returns true if the chain supports block header-based verification
@@ -133,6 +135,22 @@ func IsBitcoinChain(chainID int64) bool {
 		chainID == 8332 // mainnet
 }
 
+// IsBscChain returns true if the chain is a BSC chain
+// TODO: put this information directly in chain object
+// https://github.com/zeta-chain/node-private/issues/63
+func IsBscChain(chainID int64) bool {
+	return chainID == 56 || // bsc mainnet
+		chainID == 97 // bsc testnet
+}
+
+// IsPolygonChain returns true if the chain is a Polygon chain
+// TODO: put this information directly in chain object
+// https://github.com/zeta-chain/node-private/issues/63
+func IsPolygonChain(chainID int64) bool {
+	return chainID == 137 || // polygon mainnet
+		chainID == 80001 // polygon mumbai
+}
+
 // IsEthereumChain returns true if the chain is an Ethereum chain
 // TODO: put this information directly in chain object
 // https://github.com/zeta-chain/node-private/issues/63
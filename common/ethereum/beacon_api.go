@@ -0,0 +1,183 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// the types below decode the light client objects returned by the light client endpoints of the beacon API
+// numbers are encoded as decimal strings and bytes as hexadecimal strings
+
+type apiBeaconBlockHeader struct {
+	Slot          uint64        `json:"slot,string"`
+	ProposerIndex uint64        `json:"proposer_index,string"`
+	ParentRoot    hexutil.Bytes `json:"parent_root"`
+	StateRoot     hexutil.Bytes `json:"state_root"`
+	BodyRoot      hexutil.Bytes `json:"body_root"`
+}
+
+type apiExecutionPayloadHeader struct {
+	ParentHash       hexutil.Bytes `json:"parent_hash"`
+	FeeRecipient     hexutil.Bytes `json:"fee_recipient"`
+	StateRoot        hexutil.Bytes `json:"state_root"`
+	ReceiptsRoot     hexutil.Bytes `json:"receipts_root"`
+	LogsBloom        hexutil.Bytes `json:"logs_bloom"`
+	PrevRandao       hexutil.Bytes `json:"prev_randao"`
+	BlockNumber      uint64        `json:"block_number,string"`
+	GasLimit         uint64        `json:"gas_limit,string"`
+	GasUsed          uint64        `json:"gas_used,string"`
+	Timestamp        uint64        `json:"timestamp,string"`
+	ExtraData        hexutil.Bytes `json:"extra_data"`
+	BaseFeePerGas    string        `json:"base_fee_per_gas"`
+	BlockHash        hexutil.Bytes `json:"block_hash"`
+	TransactionsRoot hexutil.Bytes `json:"transactions_root"`
+	WithdrawalsRoot  hexutil.Bytes `json:"withdrawals_root"`
+	BlobGasUsed      uint64        `json:"blob_gas_used,string,omitempty"`
+	ExcessBlobGas    uint64        `json:"excess_blob_gas,string,omitempty"`
+}
+
+type apiLightClientHeader struct {
+	Beacon          apiBeaconBlockHeader      `json:"beacon"`
+	Execution       apiExecutionPayloadHeader `json:"execution"`
+	ExecutionBranch []hexutil.Bytes           `json:"execution_branch"`
+}
+
+type apiSyncCommittee struct {
+	Pubkeys         []hexutil.Bytes `json:"pubkeys"`
+	AggregatePubkey hexutil.Bytes   `json:"aggregate_pubkey"`
+}
+
+type apiSyncAggregate struct {
+	SyncCommitteeBits      hexutil.Bytes `json:"sync_committee_bits"`
+	SyncCommitteeSignature hexutil.Bytes `json:"sync_committee_signature"`
+}
+
+type apiLightClientBootstrap struct {
+	Header                     apiLightClientHeader `json:"header"`
+	CurrentSyncCommittee       apiSyncCommittee     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []hexutil.Bytes      `json:"current_sync_committee_branch"`
+}
+
+type apiLightClientUpdate struct {
+	AttestedHeader          apiLightClientHeader `json:"attested_header"`
+	NextSyncCommittee       *apiSyncCommittee    `json:"next_sync_committee,omitempty"`
+	NextSyncCommitteeBranch []hexutil.Bytes      `json:"next_sync_committee_branch,omitempty"`
+	FinalizedHeader         apiLightClientHeader `json:"finalized_header"`
+	FinalityBranch          []hexutil.Bytes      `json:"finality_branch"`
+	SyncAggregate           apiSyncAggregate     `json:"sync_aggregate"`
+	SignatureSlot           uint64               `json:"signature_slot,string"`
+}
+
+// apiResponse is the envelope of the responses of the light client endpoints of the beacon API
+type apiResponse struct {
+	Version string          `json:"version"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// ParseLightClientBootstrapJSON parses a light client bootstrap returned by the beacon API
+// endpoint /eth/v1/beacon/light_client/bootstrap/{block_root}
+func ParseLightClientBootstrapJSON(data []byte) (LightClientBootstrap, error) {
+	var bootstrap apiLightClientBootstrap
+	if err := unmarshalAPIResponse(data, &bootstrap); err != nil {
+		return LightClientBootstrap{}, err
+	}
+	return LightClientBootstrap{
+		Header:                     bootstrap.Header.toProto(),
+		CurrentSyncCommittee:       bootstrap.CurrentSyncCommittee.toProto(),
+		CurrentSyncCommitteeBranch: toBytesList(bootstrap.CurrentSyncCommitteeBranch),
+	}, nil
+}
+
+// ParseLightClientUpdateJSON parses a light client update returned by the beacon API endpoints
+// /eth/v1/beacon/light_client/updates (a single element of the list) or /eth/v1/beacon/light_client/finality_update
+func ParseLightClientUpdateJSON(data []byte) (LightClientUpdate, error) {
+	var update apiLightClientUpdate
+	if err := unmarshalAPIResponse(data, &update); err != nil {
+		return LightClientUpdate{}, err
+	}
+	var nextSyncCommittee *SyncCommittee
+	if update.NextSyncCommittee != nil {
+		committee := update.NextSyncCommittee.toProto()
+		nextSyncCommittee = &committee
+	}
+	return LightClientUpdate{
+		AttestedHeader:          update.AttestedHeader.toProto(),
+		NextSyncCommittee:       nextSyncCommittee,
+		NextSyncCommitteeBranch: toBytesList(update.NextSyncCommitteeBranch),
+		FinalizedHeader:         update.FinalizedHeader.toProto(),
+		FinalityBranch:          toBytesList(update.FinalityBranch),
+		SyncAggregate: SyncAggregate{
+			SyncCommitteeBits:      update.SyncAggregate.SyncCommitteeBits,
+			SyncCommitteeSignature: update.SyncAggregate.SyncCommitteeSignature,
+		},
+		SignatureSlot: update.SignatureSlot,
+	}, nil
+}
+
+// unmarshalAPIResponse decodes the data of a beacon API response
+func unmarshalAPIResponse(data []byte, v interface{}) error {
+	var response apiResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return fmt.Errorf("cannot decode beacon API response (%s)", err)
+	}
+	if len(response.Data) == 0 {
+		return errors.New("missing data in beacon API response")
+	}
+	if err := json.Unmarshal(response.Data, v); err != nil {
+		return fmt.Errorf("cannot decode beacon API response data (%s)", err)
+	}
+	return nil
+}
+
+func (h apiLightClientHeader) toProto() LightClientHeader {
+	return LightClientHeader{
+		Beacon: BeaconBlockHeader{
+			Slot:          h.Beacon.Slot,
+			ProposerIndex: h.Beacon.ProposerIndex,
+			ParentRoot:    h.Beacon.ParentRoot,
+			StateRoot:     h.Beacon.StateRoot,
+			BodyRoot:      h.Beacon.BodyRoot,
+		},
+		Execution: ExecutionPayloadHeader{
+			ParentHash:       h.Execution.ParentHash,
+			FeeRecipient:     h.Execution.FeeRecipient,
+			StateRoot:        h.Execution.StateRoot,
+			ReceiptsRoot:     h.Execution.ReceiptsRoot,
+			LogsBloom:        h.Execution.LogsBloom,
+			PrevRandao:       h.Execution.PrevRandao,
+			BlockNumber:      h.Execution.BlockNumber,
+			GasLimit:         h.Execution.GasLimit,
+			GasUsed:          h.Execution.GasUsed,
+			Timestamp:        h.Execution.Timestamp,
+			ExtraData:        h.Execution.ExtraData,
+			BaseFeePerGas:    h.Execution.BaseFeePerGas,
+			BlockHash:        h.Execution.BlockHash,
+			TransactionsRoot: h.Execution.TransactionsRoot,
+			WithdrawalsRoot:  h.Execution.WithdrawalsRoot,
+			BlobGasUsed:      h.Execution.BlobGasUsed,
+			ExcessBlobGas:    h.Execution.ExcessBlobGas,
+		},
+		ExecutionBranch: toBytesList(h.ExecutionBranch),
+	}
+}
+
+func (c apiSyncCommittee) toProto() SyncCommittee {
+	return SyncCommittee{
+		Pubkeys:         toBytesList(c.Pubkeys),
+		AggregatePubkey: c.AggregatePubkey,
+	}
+}
+
+func toBytesList(list []hexutil.Bytes) [][]byte {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make([][]byte, len(list))
+	for i, b := range list {
+		out[i] = b
+	}
+	return out
+}
@@ -0,0 +1,304 @@
+package ethereum
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+)
+
+const (
+	// BLSPubkeyLength is the length of a compressed BLS public key
+	BLSPubkeyLength = 48
+
+	// BLSSignatureLength is the length of a compressed BLS signature
+	BLSSignatureLength = 96
+
+	// blsFieldElementLength is the length of an encoded element of the base field of BLS12-381
+	blsFieldElementLength = 48
+
+	// blsHashToFieldLength is the number of bytes expanded to hash a message to an element of the base field
+	blsHashToFieldLength = 64
+)
+
+// blsSignatureDST is the domain separation tag of the proof-of-possession BLS signatures of the consensus layer
+var blsSignatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+var (
+	// blsModulus is the modulus of the base field of BLS12-381
+	blsModulus, _ = new(big.Int).SetString(
+		"1a0111ea397fe69a4b1ba7b6434bacd764774b84f38512bf6730d2a0f6b0f6241eabfffeb153ffffb9feffffffffaaab",
+		16,
+	)
+
+	// blsHalfModulus is (p-1)/2, field elements greater than it are lexicographically largest
+	blsHalfModulus = new(big.Int).Rsh(blsModulus, 1)
+
+	// blsSqrtExponent is (p+1)/4, the exponent computing square roots in the base field since p = 3 mod 4
+	blsSqrtExponent = new(big.Int).Rsh(new(big.Int).Add(blsModulus, big.NewInt(1)), 2)
+
+	// blsFp2SqrtExponent is (p-3)/4, the exponent used to compute square roots in the quadratic extension field
+	blsFp2SqrtExponent = new(big.Int).Rsh(new(big.Int).Sub(blsModulus, big.NewInt(3)), 2)
+
+	// blsLegendreExponent is (p-1)/2
+	blsLegendreExponent = new(big.Int).Set(blsHalfModulus)
+)
+
+// FastAggregateVerify verifies an aggregate BLS signature of a message signed by all the public keys
+// public keys and signature are in the compressed form used by the consensus layer
+func FastAggregateVerify(pubkeys [][]byte, message []byte, signature []byte) error {
+	if len(pubkeys) == 0 {
+		return errors.New("no public key")
+	}
+	g1 := bls12381.NewG1()
+	aggregatePubkey := g1.Zero()
+	for i, pubkey := range pubkeys {
+		point, err := decompressG1(pubkey)
+		if err != nil {
+			return fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+		g1.Add(aggregatePubkey, aggregatePubkey, point)
+	}
+
+	g2 := bls12381.NewG2()
+	sig, err := decompressG2(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+	if !g2.InCorrectSubgroup(sig) {
+		return errors.New("invalid signature: point is not in the correct subgroup")
+	}
+	messagePoint, err := hashToG2(message, blsSignatureDST)
+	if err != nil {
+		return err
+	}
+
+	// e(pk, H(m)) == e(g1, sig)
+	engine := bls12381.NewPairingEngine()
+	engine.AddPair(aggregatePubkey, messagePoint)
+	engine.AddPairInv(g1.One(), sig)
+	if !engine.Check() {
+		return errors.New("signature verification failed")
+	}
+	return nil
+}
+
+// decompressG1 decodes a point of G1 in compressed form
+// the point at infinity is rejected since it is not a valid public key
+func decompressG1(in []byte) (*bls12381.PointG1, error) {
+	x, largest, err := decodeCompressedFlags(in, BLSPubkeyLength)
+	if err != nil {
+		return nil, err
+	}
+	xElement := new(big.Int).SetBytes(x)
+	if xElement.Cmp(blsModulus) >= 0 {
+		return nil, errors.New("invalid x coordinate")
+	}
+
+	// y^2 = x^3 + 4
+	y2 := new(big.Int).Exp(xElement, big.NewInt(3), blsModulus)
+	y2.Add(y2, big.NewInt(4)).Mod(y2, blsModulus)
+	y := new(big.Int).Exp(y2, blsSqrtExponent, blsModulus)
+	if new(big.Int).Exp(y, big.NewInt(2), blsModulus).Cmp(y2) != 0 {
+		return nil, errors.New("point is not on curve")
+	}
+	if (y.Cmp(blsHalfModulus) > 0) != largest {
+		y.Sub(blsModulus, y)
+	}
+
+	uncompressed := make([]byte, 2*blsFieldElementLength)
+	xElement.FillBytes(uncompressed[:blsFieldElementLength])
+	y.FillBytes(uncompressed[blsFieldElementLength:])
+	return bls12381.NewG1().FromBytes(uncompressed)
+}
+
+// decompressG2 decodes a point of G2 in compressed form
+// the point at infinity is rejected since it is not a valid signature for a public key
+func decompressG2(in []byte) (*bls12381.PointG2, error) {
+	x, largest, err := decodeCompressedFlags(in, BLSSignatureLength)
+	if err != nil {
+		return nil, err
+	}
+	xElement := fp2{
+		c0: new(big.Int).SetBytes(x[blsFieldElementLength:]),
+		c1: new(big.Int).SetBytes(x[:blsFieldElementLength]),
+	}
+	if xElement.c0.Cmp(blsModulus) >= 0 || xElement.c1.Cmp(blsModulus) >= 0 {
+		return nil, errors.New("invalid x coordinate")
+	}
+
+	// y^2 = x^3 + 4(1 + i)
+	y2 := xElement.mul(xElement).mul(xElement).add(fp2{c0: big.NewInt(4), c1: big.NewInt(4)})
+	y, ok := y2.sqrt()
+	if !ok {
+		return nil, errors.New("point is not on curve")
+	}
+	if y.isLexicographicallyLargest() != largest {
+		y = y.neg()
+	}
+
+	uncompressed := make([]byte, 4*blsFieldElementLength)
+	xElement.c1.FillBytes(uncompressed[:blsFieldElementLength])
+	xElement.c0.FillBytes(uncompressed[blsFieldElementLength : 2*blsFieldElementLength])
+	y.c1.FillBytes(uncompressed[2*blsFieldElementLength : 3*blsFieldElementLength])
+	y.c0.FillBytes(uncompressed[3*blsFieldElementLength:])
+	return bls12381.NewG2().FromBytes(uncompressed)
+}
+
+// decodeCompressedFlags decodes the flags of a point in compressed form
+// it returns the x coordinate without the flags and if the y coordinate is the lexicographically largest
+func decodeCompressedFlags(in []byte, length int) ([]byte, bool, error) {
+	if len(in) != length {
+		return nil, false, fmt.Errorf("invalid length %d, expected %d", len(in), length)
+	}
+	if in[0]&0x80 == 0 {
+		return nil, false, errors.New("point is not compressed")
+	}
+	if in[0]&0x40 != 0 {
+		return nil, false, errors.New("point at infinity")
+	}
+	x := make([]byte, length)
+	copy(x, in)
+	x[0] &= 0x1f
+	return x, in[0]&0x20 != 0, nil
+}
+
+// hashToG2 hashes a message to a point of G2 following the hash_to_curve random oracle construction of RFC 9380
+func hashToG2(message []byte, dst []byte) (*bls12381.PointG2, error) {
+	uniformBytes, err := expandMessageXMD(message, dst, 4*blsHashToFieldLength)
+	if err != nil {
+		return nil, err
+	}
+
+	// the points of the two field elements are mapped separately and added,
+	// the cofactor is cleared by the map which is equivalent to clearing it from the sum
+	g2 := bls12381.NewG2()
+	result := g2.Zero()
+	for i := 0; i < 2; i++ {
+		offset := 2 * i * blsHashToFieldLength
+		c0 := new(big.Int).SetBytes(uniformBytes[offset : offset+blsHashToFieldLength])
+		c1 := new(big.Int).SetBytes(uniformBytes[offset+blsHashToFieldLength : offset+2*blsHashToFieldLength])
+		in := make([]byte, 2*blsFieldElementLength)
+		c1.Mod(c1, blsModulus).FillBytes(in[:blsFieldElementLength])
+		c0.Mod(c0, blsModulus).FillBytes(in[blsFieldElementLength:])
+
+		point, err := g2.MapToCurve(in)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(result, result, point)
+	}
+	return g2.Affine(result), nil
+}
+
+// expandMessageXMD expands a message into uniform bytes with SHA-256 as defined in RFC 9380
+func expandMessageXMD(message []byte, dst []byte, length int) ([]byte, error) {
+	ell := (length + sha256.Size - 1) / sha256.Size
+	if ell > 255 || length > 65535 || len(dst) > 255 {
+		return nil, errors.New("invalid expand message parameters")
+	}
+	dstPrime := append(append([]byte{}, dst...), byte(len(dst)))
+
+	h := sha256.New()
+	h.Write(make([]byte, sha256.BlockSize))
+	h.Write(message)
+	h.Write([]byte{byte(length >> 8), byte(length), 0})
+	h.Write(dstPrime)
+	b0 := h.Sum(nil)
+
+	h.Reset()
+	h.Write(b0)
+	h.Write([]byte{1})
+	h.Write(dstPrime)
+	bi := h.Sum(nil)
+
+	uniformBytes := make([]byte, 0, ell*sha256.Size)
+	uniformBytes = append(uniformBytes, bi...)
+	for i := 2; i <= ell; i++ {
+		xored := make([]byte, sha256.Size)
+		for j := range xored {
+			xored[j] = b0[j] ^ bi[j]
+		}
+		h.Reset()
+		h.Write(xored)
+		h.Write([]byte{byte(i)})
+		h.Write(dstPrime)
+		bi = h.Sum(nil)
+		uniformBytes = append(uniformBytes, bi...)
+	}
+	return uniformBytes[:length], nil
+}
+
+// fp2 is an element c0 + c1*i of the quadratic extension field of BLS12-381 where i^2 = -1
+type fp2 struct {
+	c0, c1 *big.Int
+}
+
+func (a fp2) add(b fp2) fp2 {
+	return fp2{
+		c0: new(big.Int).Mod(new(big.Int).Add(a.c0, b.c0), blsModulus),
+		c1: new(big.Int).Mod(new(big.Int).Add(a.c1, b.c1), blsModulus),
+	}
+}
+
+func (a fp2) mul(b fp2) fp2 {
+	// (a0 + a1*i)(b0 + b1*i) = (a0*b0 - a1*b1) + (a0*b1 + a1*b0)*i
+	c0 := new(big.Int).Sub(new(big.Int).Mul(a.c0, b.c0), new(big.Int).Mul(a.c1, b.c1))
+	c1 := new(big.Int).Add(new(big.Int).Mul(a.c0, b.c1), new(big.Int).Mul(a.c1, b.c0))
+	return fp2{c0: c0.Mod(c0, blsModulus), c1: c1.Mod(c1, blsModulus)}
+}
+
+func (a fp2) neg() fp2 {
+	return fp2{
+		c0: new(big.Int).Mod(new(big.Int).Neg(a.c0), blsModulus),
+		c1: new(big.Int).Mod(new(big.Int).Neg(a.c1), blsModulus),
+	}
+}
+
+func (a fp2) equal(b fp2) bool {
+	return a.c0.Cmp(b.c0) == 0 && a.c1.Cmp(b.c1) == 0
+}
+
+func (a fp2) exp(e *big.Int) fp2 {
+	result := fp2{c0: big.NewInt(1), c1: big.NewInt(0)}
+	for i := e.BitLen() - 1; i >= 0; i-- {
+		result = result.mul(result)
+		if e.Bit(i) == 1 {
+			result = result.mul(a)
+		}
+	}
+	return result
+}
+
+// sqrt returns a square root of the element, false is returned if the element is not a square
+// it implements the algorithm 9 of https://eprint.iacr.org/2012/685.pdf for p = 3 mod 4
+func (a fp2) sqrt() (fp2, bool) {
+	a1 := a.exp(blsFp2SqrtExponent)
+	alpha := a1.mul(a1).mul(a)
+	x0 := a1.mul(a)
+
+	minusOne := fp2{c0: new(big.Int).Sub(blsModulus, big.NewInt(1)), c1: big.NewInt(0)}
+	var root fp2
+	if alpha.equal(minusOne) {
+		// x = i * x0
+		root = fp2{c0: new(big.Int).Mod(new(big.Int).Neg(x0.c1), blsModulus), c1: x0.c0}
+	} else {
+		b := alpha.add(fp2{c0: big.NewInt(1), c1: big.NewInt(0)}).exp(blsLegendreExponent)
+		root = b.mul(x0)
+	}
+	if !root.mul(root).equal(a) {
+		return fp2{}, false
+	}
+	return root, true
+}
+
+// isLexicographicallyLargest returns true if the element is greater than its negation
+// the imaginary part is compared first
+func (a fp2) isLexicographicallyLargest() bool {
+	if a.c1.Sign() != 0 {
+		return a.c1.Cmp(blsHalfModulus) > 0
+	}
+	return a.c0.Cmp(blsHalfModulus) > 0
+}
@@ -0,0 +1,69 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestExpandMessageXMD(t *testing.T) {
+	// RFC 9380 appendix K.1
+	out, err := expandMessageXMD([]byte{}, []byte("QUUX-V01-CS02-with-expander-SHA256-128"), 0x20)
+	require.NoError(t, err)
+	require.Equal(t, "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235", hex.EncodeToString(out))
+}
+
+func TestHashToG2(t *testing.T) {
+	// RFC 9380 appendix J.10.1, the x coordinate is encoded as x.c1 || x.c0
+	p, err := hashToG2([]byte{}, []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_"))
+	require.NoError(t, err)
+	encoded := bls12381.NewG2().ToBytes(p)
+	require.Equal(t,
+		"05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d"+
+			"0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a",
+		hex.EncodeToString(encoded[:96]),
+	)
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	// consensus spec BLS test vector for a single signer
+	pubkey := mustDecodeHex(t, "a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a")
+	signature := mustDecodeHex(t, "b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55")
+	message := make([]byte, 32)
+
+	t.Run("valid signature", func(t *testing.T) {
+		require.NoError(t, FastAggregateVerify([][]byte{pubkey}, message, signature))
+	})
+
+	t.Run("invalid message", func(t *testing.T) {
+		otherMessage := make([]byte, 32)
+		otherMessage[0] = 1
+		require.Error(t, FastAggregateVerify([][]byte{pubkey}, otherMessage, signature))
+	})
+
+	t.Run("invalid public key", func(t *testing.T) {
+		invalid := append([]byte{}, pubkey...)
+		invalid[0] &^= 0x80
+		require.Error(t, FastAggregateVerify([][]byte{invalid}, message, signature))
+		require.Error(t, FastAggregateVerify([][]byte{pubkey[:47]}, message, signature))
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		require.Error(t, FastAggregateVerify([][]byte{pubkey}, message, signature[:95]))
+		invalid := append([]byte{}, signature...)
+		invalid[95] ^= 0x01
+		require.Error(t, FastAggregateVerify([][]byte{pubkey}, message, invalid))
+	})
+
+	t.Run("no public key", func(t *testing.T) {
+		require.Error(t, FastAggregateVerify(nil, message, signature))
+	})
+}
@@ -0,0 +1,302 @@
+package ethereum
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// generalized indices of the light client proofs as depth and index in the merkle tree
+// the indices in the beacon state are changed by the Electra fork
+const (
+	executionPayloadDepth = 4
+	executionPayloadIndex = 9
+
+	finalizedRootDepth        = 6
+	finalizedRootDepthElectra = 7
+	finalizedRootIndex        = 41
+
+	syncCommitteeDepth        = 5
+	syncCommitteeDepthElectra = 6
+	currentSyncCommitteeIndex = 22
+	nextSyncCommitteeIndex    = 23
+)
+
+// domainSyncCommittee is the signature domain type of the sync committee
+var domainSyncCommittee = [4]byte{0x07, 0x00, 0x00, 0x00}
+
+// ValidateLightClientHeader checks the execution payload header is committed to by the beacon block header
+func (c NetworkConfig) ValidateLightClientHeader(header LightClientHeader) error {
+	slot := header.Beacon.Slot
+	if !c.isCapella(slot) {
+		return fmt.Errorf("slot %d is before the capella fork", slot)
+	}
+	if !c.isDeneb(slot) && (header.Execution.BlobGasUsed != 0 || header.Execution.ExcessBlobGas != 0) {
+		return errors.New("blob gas fields are not supported before the deneb fork")
+	}
+	executionRoot, err := header.Execution.HashTreeRoot(c.isDeneb(slot))
+	if err != nil {
+		return fmt.Errorf("invalid execution payload header: %w", err)
+	}
+	if err := isValidMerkleBranch(
+		executionRoot,
+		header.ExecutionBranch,
+		executionPayloadDepth,
+		executionPayloadIndex,
+		header.Beacon.BodyRoot,
+	); err != nil {
+		return fmt.Errorf("invalid execution branch: %w", err)
+	}
+	return nil
+}
+
+// InitializeLightClientStore initializes a light client store from a bootstrap of a trusted beacon block root
+func (c NetworkConfig) InitializeLightClientStore(trustedBlockRoot []byte, bootstrap LightClientBootstrap) (LightClientStore, error) {
+	if err := c.ValidateLightClientHeader(bootstrap.Header); err != nil {
+		return LightClientStore{}, err
+	}
+	blockRoot, err := bootstrap.Header.Beacon.HashTreeRoot()
+	if err != nil {
+		return LightClientStore{}, fmt.Errorf("invalid beacon block header: %w", err)
+	}
+	if !bytes.Equal(blockRoot[:], trustedBlockRoot) {
+		return LightClientStore{}, fmt.Errorf(
+			"block root %s differs from trusted block root %s",
+			ethcommon.Hash(blockRoot).Hex(),
+			ethcommon.BytesToHash(trustedBlockRoot).Hex(),
+		)
+	}
+
+	committeeRoot, err := bootstrap.CurrentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return LightClientStore{}, fmt.Errorf("invalid current sync committee: %w", err)
+	}
+	if err := isValidMerkleBranch(
+		committeeRoot,
+		bootstrap.CurrentSyncCommitteeBranch,
+		c.syncCommitteeDepth(bootstrap.Header.Beacon.Slot),
+		currentSyncCommitteeIndex,
+		bootstrap.Header.Beacon.StateRoot,
+	); err != nil {
+		return LightClientStore{}, fmt.Errorf("invalid current sync committee branch: %w", err)
+	}
+
+	return LightClientStore{
+		FinalizedHeader:      bootstrap.Header,
+		CurrentSyncCommittee: bootstrap.CurrentSyncCommittee,
+	}, nil
+}
+
+// ValidateLightClientUpdate validates a light client update against the light client store
+// only finality updates are accepted: the update must prove a finalized header from the attested header
+func (c NetworkConfig) ValidateLightClientUpdate(store LightClientStore, update LightClientUpdate) error {
+	participants, err := syncCommitteeParticipants(update.SyncAggregate)
+	if err != nil {
+		return err
+	}
+	if len(participants) == 0 {
+		return errors.New("no sync committee participant")
+	}
+	if err := c.ValidateLightClientHeader(update.AttestedHeader); err != nil {
+		return fmt.Errorf("invalid attested header: %w", err)
+	}
+
+	attestedSlot := update.AttestedHeader.Beacon.Slot
+	finalizedSlot := update.FinalizedHeader.Beacon.Slot
+	if !(update.SignatureSlot > attestedSlot && attestedSlot >= finalizedSlot) {
+		return fmt.Errorf(
+			"invalid slots: signature slot %d, attested slot %d, finalized slot %d",
+			update.SignatureSlot,
+			attestedSlot,
+			finalizedSlot,
+		)
+	}
+
+	storePeriod := SyncCommitteePeriodAtSlot(store.FinalizedHeader.Beacon.Slot)
+	signaturePeriod := SyncCommitteePeriodAtSlot(update.SignatureSlot)
+	if store.NextSyncCommittee != nil {
+		if signaturePeriod != storePeriod && signaturePeriod != storePeriod+1 {
+			return fmt.Errorf("signature period %d is not the store period %d or the next one", signaturePeriod, storePeriod)
+		}
+	} else if signaturePeriod != storePeriod {
+		return fmt.Errorf("signature period %d is not the store period %d", signaturePeriod, storePeriod)
+	}
+
+	// the update must either finalize a newer header or provide the next sync committee
+	attestedPeriod := SyncCommitteePeriodAtSlot(attestedSlot)
+	hasNextSyncCommittee := store.NextSyncCommittee == nil && update.NextSyncCommittee != nil && attestedPeriod == storePeriod
+	if attestedSlot <= store.FinalizedHeader.Beacon.Slot && !hasNextSyncCommittee {
+		return fmt.Errorf("attested slot %d is not newer than the finalized slot %d", attestedSlot, store.FinalizedHeader.Beacon.Slot)
+	}
+
+	// the finalized header is committed to by the attested beacon state
+	if finalizedSlot == 0 {
+		return errors.New("finalized header is the genesis header")
+	}
+	if err := c.ValidateLightClientHeader(update.FinalizedHeader); err != nil {
+		return fmt.Errorf("invalid finalized header: %w", err)
+	}
+	finalizedRoot, err := update.FinalizedHeader.Beacon.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("invalid finalized header: %w", err)
+	}
+	if err := isValidMerkleBranch(
+		finalizedRoot,
+		update.FinalityBranch,
+		c.finalizedRootDepth(attestedSlot),
+		finalizedRootIndex,
+		update.AttestedHeader.Beacon.StateRoot,
+	); err != nil {
+		return fmt.Errorf("invalid finality branch: %w", err)
+	}
+
+	// the next sync committee is committed to by the attested beacon state
+	if update.NextSyncCommittee != nil {
+		committeeRoot, err := update.NextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return fmt.Errorf("invalid next sync committee: %w", err)
+		}
+		if attestedPeriod == storePeriod && store.NextSyncCommittee != nil {
+			storeCommitteeRoot, err := store.NextSyncCommittee.HashTreeRoot()
+			if err != nil {
+				return fmt.Errorf("invalid store next sync committee: %w", err)
+			}
+			if committeeRoot != storeCommitteeRoot {
+				return errors.New("next sync committee differs from the known next sync committee")
+			}
+		}
+		if err := isValidMerkleBranch(
+			committeeRoot,
+			update.NextSyncCommitteeBranch,
+			c.syncCommitteeDepth(attestedSlot),
+			nextSyncCommitteeIndex,
+			update.AttestedHeader.Beacon.StateRoot,
+		); err != nil {
+			return fmt.Errorf("invalid next sync committee branch: %w", err)
+		}
+	}
+
+	// the attested header is signed by the sync committee of the signature period
+	committee := store.CurrentSyncCommittee
+	if signaturePeriod != storePeriod {
+		committee = *store.NextSyncCommittee
+	}
+	if len(committee.Pubkeys) != SyncCommitteeSize {
+		return fmt.Errorf("invalid sync committee size %d", len(committee.Pubkeys))
+	}
+	pubkeys := make([][]byte, len(participants))
+	for i, participant := range participants {
+		pubkeys[i] = committee.Pubkeys[participant]
+	}
+
+	forkVersionSlot := update.SignatureSlot
+	if forkVersionSlot > 0 {
+		forkVersionSlot--
+	}
+	forkVersion, err := c.ForkVersion(EpochAtSlot(forkVersionSlot))
+	if err != nil {
+		return err
+	}
+	attestedRoot, err := update.AttestedHeader.Beacon.HashTreeRoot()
+	if err != nil {
+		return fmt.Errorf("invalid attested header: %w", err)
+	}
+	domain := computeDomain(domainSyncCommittee, forkVersion, c.GenesisValidatorsRoot)
+	root := signingRoot(attestedRoot, domain)
+	if err := FastAggregateVerify(pubkeys, root[:], update.SyncAggregate.SyncCommitteeSignature); err != nil {
+		return fmt.Errorf("invalid sync committee signature: %w", err)
+	}
+	return nil
+}
+
+// ProcessLightClientUpdate validates a light client update and applies it to the light client store
+// the update must be signed by a supermajority of the sync committee and must either finalize a newer header
+// or provide the next sync committee from a finalized header
+// it returns the updated store and true if the finalized header of the store changed
+func (c NetworkConfig) ProcessLightClientUpdate(
+	store LightClientStore,
+	update LightClientUpdate,
+) (LightClientStore, bool, error) {
+	if err := c.ValidateLightClientUpdate(store, update); err != nil {
+		return store, false, err
+	}
+
+	participants, err := syncCommitteeParticipants(update.SyncAggregate)
+	if err != nil {
+		return store, false, err
+	}
+	if len(participants)*3 < SyncCommitteeSize*2 {
+		return store, false, fmt.Errorf(
+			"sync committee participation %d/%d is below the supermajority",
+			len(participants),
+			SyncCommitteeSize,
+		)
+	}
+
+	storePeriod := SyncCommitteePeriodAtSlot(store.FinalizedHeader.Beacon.Slot)
+	finalizedPeriod := SyncCommitteePeriodAtSlot(update.FinalizedHeader.Beacon.Slot)
+	attestedPeriod := SyncCommitteePeriodAtSlot(update.AttestedHeader.Beacon.Slot)
+	hasFinalizedNextSyncCommittee := store.NextSyncCommittee == nil &&
+		update.NextSyncCommittee != nil &&
+		finalizedPeriod == attestedPeriod
+	isNewerFinalizedHeader := update.FinalizedHeader.Beacon.Slot > store.FinalizedHeader.Beacon.Slot
+	if !isNewerFinalizedHeader && !hasFinalizedNextSyncCommittee {
+		return store, false, errors.New("update doesn't advance the light client")
+	}
+
+	// rotate the sync committees when the finalized header reaches the next period
+	if store.NextSyncCommittee == nil {
+		if finalizedPeriod != storePeriod {
+			return store, false, fmt.Errorf(
+				"finalized period %d is not the store period %d while the next sync committee is unknown",
+				finalizedPeriod,
+				storePeriod,
+			)
+		}
+		store.NextSyncCommittee = update.NextSyncCommittee
+	} else if finalizedPeriod == storePeriod+1 {
+		store.CurrentSyncCommittee = *store.NextSyncCommittee
+		store.NextSyncCommittee = update.NextSyncCommittee
+	}
+
+	if isNewerFinalizedHeader {
+		store.FinalizedHeader = update.FinalizedHeader
+	}
+	return store, isNewerFinalizedHeader, nil
+}
+
+// syncCommitteeParticipants returns the indices of the sync committee members participating in the sync aggregate
+func syncCommitteeParticipants(aggregate SyncAggregate) ([]int, error) {
+	if len(aggregate.SyncCommitteeBits) != syncCommitteeBitsLength {
+		return nil, fmt.Errorf(
+			"invalid sync committee bits length %d, expected %d",
+			len(aggregate.SyncCommitteeBits),
+			syncCommitteeBitsLength,
+		)
+	}
+	var participants []int
+	for i := 0; i < SyncCommitteeSize; i++ {
+		if aggregate.SyncCommitteeBits[i/8]>>(i%8)&1 == 1 {
+			participants = append(participants, i)
+		}
+	}
+	return participants, nil
+}
+
+// finalizedRootDepth returns the depth of the finalized root in the merkle tree of the beacon state at a slot
+func (c NetworkConfig) finalizedRootDepth(slot uint64) int {
+	if c.isElectra(slot) {
+		return finalizedRootDepthElectra
+	}
+	return finalizedRootDepth
+}
+
+// syncCommitteeDepth returns the depth of the sync committees in the merkle tree of the beacon state at a slot
+func (c NetworkConfig) syncCommitteeDepth(slot uint64) int {
+	if c.isElectra(slot) {
+		return syncCommitteeDepthElectra
+	}
+	return syncCommitteeDepth
+}
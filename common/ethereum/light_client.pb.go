@@ -0,0 +1,3252 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: common/ethereum/light_client.proto
+
+package ethereum
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BeaconBlockHeader is the header of a block of the beacon chain
+type BeaconBlockHeader struct {
+	Slot          uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex uint64 `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	ParentRoot    []byte `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	StateRoot     []byte `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	BodyRoot      []byte `protobuf:"bytes,5,opt,name=body_root,json=bodyRoot,proto3" json:"body_root,omitempty"`
+}
+
+func (m *BeaconBlockHeader) Reset()         { *m = BeaconBlockHeader{} }
+func (m *BeaconBlockHeader) String() string { return proto.CompactTextString(m) }
+func (*BeaconBlockHeader) ProtoMessage()    {}
+func (*BeaconBlockHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{0}
+}
+func (m *BeaconBlockHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeaconBlockHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeaconBlockHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeaconBlockHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconBlockHeader.Merge(m, src)
+}
+func (m *BeaconBlockHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeaconBlockHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconBlockHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconBlockHeader proto.InternalMessageInfo
+
+func (m *BeaconBlockHeader) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconBlockHeader) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
+	}
+	return 0
+}
+
+func (m *BeaconBlockHeader) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockHeader) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *BeaconBlockHeader) GetBodyRoot() []byte {
+	if m != nil {
+		return m.BodyRoot
+	}
+	return nil
+}
+
+// ExecutionPayloadHeader is the header of the execution payload of a beacon block
+// the blob gas fields are only part of the header since the Deneb fork
+type ExecutionPayloadHeader struct {
+	ParentHash   []byte `protobuf:"bytes,1,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	FeeRecipient []byte `protobuf:"bytes,2,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	StateRoot    []byte `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	ReceiptsRoot []byte `protobuf:"bytes,4,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	LogsBloom    []byte `protobuf:"bytes,5,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
+	PrevRandao   []byte `protobuf:"bytes,6,opt,name=prev_randao,json=prevRandao,proto3" json:"prev_randao,omitempty"`
+	BlockNumber  uint64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	GasLimit     uint64 `protobuf:"varint,8,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasUsed      uint64 `protobuf:"varint,9,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Timestamp    uint64 `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ExtraData    []byte `protobuf:"bytes,11,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	// base fee per gas in decimal
+	BaseFeePerGas    string `protobuf:"bytes,12,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3" json:"base_fee_per_gas,omitempty"`
+	BlockHash        []byte `protobuf:"bytes,13,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TransactionsRoot []byte `protobuf:"bytes,14,opt,name=transactions_root,json=transactionsRoot,proto3" json:"transactions_root,omitempty"`
+	WithdrawalsRoot  []byte `protobuf:"bytes,15,opt,name=withdrawals_root,json=withdrawalsRoot,proto3" json:"withdrawals_root,omitempty"`
+	BlobGasUsed      uint64 `protobuf:"varint,16,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	ExcessBlobGas    uint64 `protobuf:"varint,17,opt,name=excess_blob_gas,json=excessBlobGas,proto3" json:"excess_blob_gas,omitempty"`
+}
+
+func (m *ExecutionPayloadHeader) Reset()         { *m = ExecutionPayloadHeader{} }
+func (m *ExecutionPayloadHeader) String() string { return proto.CompactTextString(m) }
+func (*ExecutionPayloadHeader) ProtoMessage()    {}
+func (*ExecutionPayloadHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{1}
+}
+func (m *ExecutionPayloadHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionPayloadHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionPayloadHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionPayloadHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionPayloadHeader.Merge(m, src)
+}
+func (m *ExecutionPayloadHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionPayloadHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionPayloadHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionPayloadHeader proto.InternalMessageInfo
+
+func (m *ExecutionPayloadHeader) GetParentHash() []byte {
+	if m != nil {
+		return m.ParentHash
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetFeeRecipient() []byte {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetReceiptsRoot() []byte {
+	if m != nil {
+		return m.ReceiptsRoot
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetLogsBloom() []byte {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetPrevRandao() []byte {
+	if m != nil {
+		return m.PrevRandao
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetBlockNumber() uint64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *ExecutionPayloadHeader) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ExecutionPayloadHeader) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ExecutionPayloadHeader) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *ExecutionPayloadHeader) GetExtraData() []byte {
+	if m != nil {
+		return m.ExtraData
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetBaseFeePerGas() string {
+	if m != nil {
+		return m.BaseFeePerGas
+	}
+	return ""
+}
+
+func (m *ExecutionPayloadHeader) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetTransactionsRoot() []byte {
+	if m != nil {
+		return m.TransactionsRoot
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetWithdrawalsRoot() []byte {
+	if m != nil {
+		return m.WithdrawalsRoot
+	}
+	return nil
+}
+
+func (m *ExecutionPayloadHeader) GetBlobGasUsed() uint64 {
+	if m != nil {
+		return m.BlobGasUsed
+	}
+	return 0
+}
+
+func (m *ExecutionPayloadHeader) GetExcessBlobGas() uint64 {
+	if m != nil {
+		return m.ExcessBlobGas
+	}
+	return 0
+}
+
+// LightClientHeader is a beacon block header with the execution payload header committed to by its block body
+type LightClientHeader struct {
+	Beacon          BeaconBlockHeader      `protobuf:"bytes,1,opt,name=beacon,proto3" json:"beacon"`
+	Execution       ExecutionPayloadHeader `protobuf:"bytes,2,opt,name=execution,proto3" json:"execution"`
+	ExecutionBranch [][]byte               `protobuf:"bytes,3,rep,name=execution_branch,json=executionBranch,proto3" json:"execution_branch,omitempty"`
+}
+
+func (m *LightClientHeader) Reset()         { *m = LightClientHeader{} }
+func (m *LightClientHeader) String() string { return proto.CompactTextString(m) }
+func (*LightClientHeader) ProtoMessage()    {}
+func (*LightClientHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{2}
+}
+func (m *LightClientHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientHeader.Merge(m, src)
+}
+func (m *LightClientHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientHeader proto.InternalMessageInfo
+
+func (m *LightClientHeader) GetBeacon() BeaconBlockHeader {
+	if m != nil {
+		return m.Beacon
+	}
+	return BeaconBlockHeader{}
+}
+
+func (m *LightClientHeader) GetExecution() ExecutionPayloadHeader {
+	if m != nil {
+		return m.Execution
+	}
+	return ExecutionPayloadHeader{}
+}
+
+func (m *LightClientHeader) GetExecutionBranch() [][]byte {
+	if m != nil {
+		return m.ExecutionBranch
+	}
+	return nil
+}
+
+// SyncCommittee is the committee of validators signing the beacon block headers during a sync committee period
+type SyncCommittee struct {
+	Pubkeys         [][]byte `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	AggregatePubkey []byte   `protobuf:"bytes,2,opt,name=aggregate_pubkey,json=aggregatePubkey,proto3" json:"aggregate_pubkey,omitempty"`
+}
+
+func (m *SyncCommittee) Reset()         { *m = SyncCommittee{} }
+func (m *SyncCommittee) String() string { return proto.CompactTextString(m) }
+func (*SyncCommittee) ProtoMessage()    {}
+func (*SyncCommittee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{3}
+}
+func (m *SyncCommittee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncCommittee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncCommittee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncCommittee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCommittee.Merge(m, src)
+}
+func (m *SyncCommittee) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncCommittee) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCommittee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCommittee proto.InternalMessageInfo
+
+func (m *SyncCommittee) GetPubkeys() [][]byte {
+	if m != nil {
+		return m.Pubkeys
+	}
+	return nil
+}
+
+func (m *SyncCommittee) GetAggregatePubkey() []byte {
+	if m != nil {
+		return m.AggregatePubkey
+	}
+	return nil
+}
+
+// SyncAggregate is the aggregate signature of the sync committee members participating in the signature of a block
+type SyncAggregate struct {
+	SyncCommitteeBits      []byte `protobuf:"bytes,1,opt,name=sync_committee_bits,json=syncCommitteeBits,proto3" json:"sync_committee_bits,omitempty"`
+	SyncCommitteeSignature []byte `protobuf:"bytes,2,opt,name=sync_committee_signature,json=syncCommitteeSignature,proto3" json:"sync_committee_signature,omitempty"`
+}
+
+func (m *SyncAggregate) Reset()         { *m = SyncAggregate{} }
+func (m *SyncAggregate) String() string { return proto.CompactTextString(m) }
+func (*SyncAggregate) ProtoMessage()    {}
+func (*SyncAggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{4}
+}
+func (m *SyncAggregate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncAggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncAggregate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncAggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncAggregate.Merge(m, src)
+}
+func (m *SyncAggregate) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncAggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncAggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncAggregate proto.InternalMessageInfo
+
+func (m *SyncAggregate) GetSyncCommitteeBits() []byte {
+	if m != nil {
+		return m.SyncCommitteeBits
+	}
+	return nil
+}
+
+func (m *SyncAggregate) GetSyncCommitteeSignature() []byte {
+	if m != nil {
+		return m.SyncCommitteeSignature
+	}
+	return nil
+}
+
+// LightClientBootstrap initializes a light client from a trusted beacon block header
+type LightClientBootstrap struct {
+	Header                     LightClientHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header"`
+	CurrentSyncCommittee       SyncCommittee     `protobuf:"bytes,2,opt,name=current_sync_committee,json=currentSyncCommittee,proto3" json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch [][]byte          `protobuf:"bytes,3,rep,name=current_sync_committee_branch,json=currentSyncCommitteeBranch,proto3" json:"current_sync_committee_branch,omitempty"`
+}
+
+func (m *LightClientBootstrap) Reset()         { *m = LightClientBootstrap{} }
+func (m *LightClientBootstrap) String() string { return proto.CompactTextString(m) }
+func (*LightClientBootstrap) ProtoMessage()    {}
+func (*LightClientBootstrap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{5}
+}
+func (m *LightClientBootstrap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientBootstrap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientBootstrap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientBootstrap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientBootstrap.Merge(m, src)
+}
+func (m *LightClientBootstrap) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientBootstrap) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientBootstrap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientBootstrap proto.InternalMessageInfo
+
+func (m *LightClientBootstrap) GetHeader() LightClientHeader {
+	if m != nil {
+		return m.Header
+	}
+	return LightClientHeader{}
+}
+
+func (m *LightClientBootstrap) GetCurrentSyncCommittee() SyncCommittee {
+	if m != nil {
+		return m.CurrentSyncCommittee
+	}
+	return SyncCommittee{}
+}
+
+func (m *LightClientBootstrap) GetCurrentSyncCommitteeBranch() [][]byte {
+	if m != nil {
+		return m.CurrentSyncCommitteeBranch
+	}
+	return nil
+}
+
+// LightClientUpdate is an update of a light client signed by the sync committee
+// finality updates don't contain the next sync committee
+type LightClientUpdate struct {
+	AttestedHeader          LightClientHeader `protobuf:"bytes,1,opt,name=attested_header,json=attestedHeader,proto3" json:"attested_header"`
+	NextSyncCommittee       *SyncCommittee    `protobuf:"bytes,2,opt,name=next_sync_committee,json=nextSyncCommittee,proto3" json:"next_sync_committee,omitempty"`
+	NextSyncCommitteeBranch [][]byte          `protobuf:"bytes,3,rep,name=next_sync_committee_branch,json=nextSyncCommitteeBranch,proto3" json:"next_sync_committee_branch,omitempty"`
+	FinalizedHeader         LightClientHeader `protobuf:"bytes,4,opt,name=finalized_header,json=finalizedHeader,proto3" json:"finalized_header"`
+	FinalityBranch          [][]byte          `protobuf:"bytes,5,rep,name=finality_branch,json=finalityBranch,proto3" json:"finality_branch,omitempty"`
+	SyncAggregate           SyncAggregate     `protobuf:"bytes,6,opt,name=sync_aggregate,json=syncAggregate,proto3" json:"sync_aggregate"`
+	SignatureSlot           uint64            `protobuf:"varint,7,opt,name=signature_slot,json=signatureSlot,proto3" json:"signature_slot,omitempty"`
+}
+
+func (m *LightClientUpdate) Reset()         { *m = LightClientUpdate{} }
+func (m *LightClientUpdate) String() string { return proto.CompactTextString(m) }
+func (*LightClientUpdate) ProtoMessage()    {}
+func (*LightClientUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{6}
+}
+func (m *LightClientUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientUpdate.Merge(m, src)
+}
+func (m *LightClientUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientUpdate proto.InternalMessageInfo
+
+func (m *LightClientUpdate) GetAttestedHeader() LightClientHeader {
+	if m != nil {
+		return m.AttestedHeader
+	}
+	return LightClientHeader{}
+}
+
+func (m *LightClientUpdate) GetNextSyncCommittee() *SyncCommittee {
+	if m != nil {
+		return m.NextSyncCommittee
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetNextSyncCommitteeBranch() [][]byte {
+	if m != nil {
+		return m.NextSyncCommitteeBranch
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetFinalizedHeader() LightClientHeader {
+	if m != nil {
+		return m.FinalizedHeader
+	}
+	return LightClientHeader{}
+}
+
+func (m *LightClientUpdate) GetFinalityBranch() [][]byte {
+	if m != nil {
+		return m.FinalityBranch
+	}
+	return nil
+}
+
+func (m *LightClientUpdate) GetSyncAggregate() SyncAggregate {
+	if m != nil {
+		return m.SyncAggregate
+	}
+	return SyncAggregate{}
+}
+
+func (m *LightClientUpdate) GetSignatureSlot() uint64 {
+	if m != nil {
+		return m.SignatureSlot
+	}
+	return 0
+}
+
+// LightClientStore is the state of a light client following the finalized headers of the beacon chain
+type LightClientStore struct {
+	FinalizedHeader      LightClientHeader `protobuf:"bytes,1,opt,name=finalized_header,json=finalizedHeader,proto3" json:"finalized_header"`
+	CurrentSyncCommittee SyncCommittee     `protobuf:"bytes,2,opt,name=current_sync_committee,json=currentSyncCommittee,proto3" json:"current_sync_committee"`
+	NextSyncCommittee    *SyncCommittee    `protobuf:"bytes,3,opt,name=next_sync_committee,json=nextSyncCommittee,proto3" json:"next_sync_committee,omitempty"`
+}
+
+func (m *LightClientStore) Reset()         { *m = LightClientStore{} }
+func (m *LightClientStore) String() string { return proto.CompactTextString(m) }
+func (*LightClientStore) ProtoMessage()    {}
+func (*LightClientStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_42b63a649a33c7b6, []int{7}
+}
+func (m *LightClientStore) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientStore.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientStore.Merge(m, src)
+}
+func (m *LightClientStore) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientStore.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientStore proto.InternalMessageInfo
+
+func (m *LightClientStore) GetFinalizedHeader() LightClientHeader {
+	if m != nil {
+		return m.FinalizedHeader
+	}
+	return LightClientHeader{}
+}
+
+func (m *LightClientStore) GetCurrentSyncCommittee() SyncCommittee {
+	if m != nil {
+		return m.CurrentSyncCommittee
+	}
+	return SyncCommittee{}
+}
+
+func (m *LightClientStore) GetNextSyncCommittee() *SyncCommittee {
+	if m != nil {
+		return m.NextSyncCommittee
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BeaconBlockHeader)(nil), "ethereum.BeaconBlockHeader")
+	proto.RegisterType((*ExecutionPayloadHeader)(nil), "ethereum.ExecutionPayloadHeader")
+	proto.RegisterType((*LightClientHeader)(nil), "ethereum.LightClientHeader")
+	proto.RegisterType((*SyncCommittee)(nil), "ethereum.SyncCommittee")
+	proto.RegisterType((*SyncAggregate)(nil), "ethereum.SyncAggregate")
+	proto.RegisterType((*LightClientBootstrap)(nil), "ethereum.LightClientBootstrap")
+	proto.RegisterType((*LightClientUpdate)(nil), "ethereum.LightClientUpdate")
+	proto.RegisterType((*LightClientStore)(nil), "ethereum.LightClientStore")
+}
+
+func init() {
+	proto.RegisterFile("common/ethereum/light_client.proto", fileDescriptor_42b63a649a33c7b6)
+}
+
+var fileDescriptor_42b63a649a33c7b6 = []byte{
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xaf, 0x49, 0xd6, 0x36, 0xa7, 0xf9, 0xeb, 0x55, 0x9d, 0xe9, 0x58, 0x56, 0x32, 0x8d, 0x15,
+	0x21, 0x52, 0x69, 0xbc, 0x80, 0x78, 0x5a, 0x56, 0x68, 0x41, 0x15, 0xaa, 0x12, 0xf6, 0xc2, 0x8b,
+	0x75, 0x6d, 0x9f, 0xda, 0xd6, 0x6c, 0x5f, 0xeb, 0xde, 0x9b, 0x2d, 0xd9, 0xa7, 0xe0, 0x7b, 0xf0,
+	0x21, 0x78, 0x1d, 0x6f, 0x7b, 0xe4, 0x09, 0xa1, 0x56, 0x42, 0xe2, 0x4b, 0x20, 0x74, 0x8f, 0xaf,
+	0x9d, 0xa4, 0x0d, 0x12, 0x45, 0xe2, 0xcd, 0xfe, 0x9d, 0x7b, 0xfe, 0xfc, 0x7e, 0xe7, 0x77, 0x13,
+	0xc3, 0xc0, 0xe7, 0x69, 0xca, 0xb3, 0x23, 0x54, 0x11, 0x0a, 0x9c, 0xa6, 0x47, 0x49, 0x1c, 0x46,
+	0xca, 0xf5, 0x93, 0x18, 0x33, 0x35, 0xcc, 0x05, 0x57, 0xdc, 0xde, 0x2e, 0x83, 0xfb, 0xbb, 0x21,
+	0x0f, 0x39, 0x81, 0x47, 0xfa, 0xa9, 0x88, 0x0f, 0x7e, 0xb2, 0xa0, 0x37, 0x42, 0xe6, 0xf3, 0x6c,
+	0x94, 0x70, 0xff, 0xe5, 0x29, 0xb2, 0x00, 0x85, 0x6d, 0x43, 0x5d, 0x26, 0x5c, 0x39, 0xd6, 0x81,
+	0x75, 0x58, 0x1f, 0xd3, 0xb3, 0xfd, 0x18, 0xda, 0xb9, 0xe0, 0x39, 0x97, 0x28, 0xdc, 0x38, 0x0b,
+	0x70, 0xe6, 0xbc, 0x47, 0xd1, 0x56, 0x89, 0x7e, 0xa3, 0x41, 0xfb, 0x21, 0xec, 0xe4, 0x4c, 0x60,
+	0xa6, 0x5c, 0xc1, 0xb9, 0x72, 0x6a, 0x07, 0xd6, 0x61, 0x73, 0x0c, 0x05, 0x34, 0xe6, 0x5c, 0xd9,
+	0x0f, 0x00, 0xa4, 0x62, 0x0a, 0x8b, 0x78, 0x9d, 0xe2, 0x0d, 0x42, 0x28, 0x7c, 0x1f, 0x1a, 0x1e,
+	0x0f, 0xe6, 0x45, 0xf4, 0x0e, 0x45, 0xb7, 0x35, 0xa0, 0x83, 0x83, 0x3f, 0xea, 0xb0, 0xf7, 0xd5,
+	0x0c, 0xfd, 0xa9, 0x8a, 0x79, 0x76, 0xce, 0xe6, 0x09, 0x67, 0x81, 0x19, 0x79, 0xd1, 0x37, 0x62,
+	0x32, 0x72, 0xac, 0xe5, 0xbe, 0xa7, 0x4c, 0x46, 0xf6, 0x23, 0x68, 0x5d, 0x20, 0xba, 0x02, 0xfd,
+	0x38, 0xd7, 0x02, 0xd1, 0xf8, 0xcd, 0x71, 0xf3, 0x02, 0x71, 0x5c, 0x62, 0xd7, 0x86, 0xab, 0x5d,
+	0x1f, 0xee, 0x11, 0xb4, 0x04, 0xfa, 0x18, 0xe7, 0x4a, 0x2e, 0x8f, 0xdf, 0x2c, 0xc1, 0x92, 0x60,
+	0xc2, 0x43, 0xe9, 0x7a, 0x09, 0xe7, 0xa9, 0xa1, 0xd0, 0xd0, 0xc8, 0x48, 0x03, 0x34, 0xa8, 0xc0,
+	0x57, 0xae, 0x60, 0x59, 0xc0, 0xb8, 0xb3, 0x69, 0x06, 0x15, 0xf8, 0x6a, 0x4c, 0x88, 0xfd, 0x21,
+	0x34, 0x3d, 0xbd, 0x0b, 0x37, 0x9b, 0xa6, 0x1e, 0x0a, 0x67, 0x8b, 0x64, 0xde, 0x21, 0xec, 0x3b,
+	0x82, 0xb4, 0x48, 0x21, 0x93, 0x6e, 0x12, 0xa7, 0xb1, 0x72, 0xb6, 0x29, 0xbe, 0x1d, 0x32, 0x79,
+	0xa6, 0xdf, 0xed, 0xf7, 0x41, 0x3f, 0xbb, 0x53, 0x89, 0x81, 0xd3, 0xa0, 0xd8, 0x56, 0xc8, 0xe4,
+	0x0b, 0x89, 0x81, 0xfd, 0x01, 0x34, 0x54, 0x9c, 0xa2, 0x54, 0x2c, 0xcd, 0x1d, 0xa0, 0xd8, 0x02,
+	0xd0, 0x83, 0xe3, 0x4c, 0x09, 0xe6, 0x06, 0x4c, 0x31, 0x67, 0xa7, 0x18, 0x9c, 0x90, 0x63, 0xa6,
+	0x98, 0xfd, 0x04, 0xba, 0x1e, 0x93, 0xe8, 0x6a, 0x15, 0x73, 0x14, 0x6e, 0xc8, 0xa4, 0xd3, 0x3c,
+	0xb0, 0x0e, 0x1b, 0xe3, 0x96, 0xc6, 0xbf, 0x46, 0x3c, 0x47, 0x71, 0xc2, 0xa4, 0xae, 0x53, 0x10,
+	0xa0, 0x4d, 0xb4, 0x8a, 0x3a, 0x84, 0xd0, 0x22, 0x3e, 0x81, 0x9e, 0x12, 0x2c, 0x93, 0xcc, 0xd7,
+	0x5b, 0x34, 0x42, 0xb6, 0xe9, 0x54, 0x77, 0x39, 0x40, 0x62, 0x7e, 0x0c, 0xdd, 0xd7, 0xb1, 0x8a,
+	0x02, 0xc1, 0x5e, 0xb3, 0xc4, 0x9c, 0xed, 0xd0, 0xd9, 0xce, 0x12, 0x4e, 0x47, 0x07, 0xd0, 0xf2,
+	0x12, 0xee, 0xb9, 0x15, 0xf9, 0x6e, 0x25, 0x9c, 0x77, 0x62, 0x04, 0xf8, 0x08, 0x3a, 0x38, 0xf3,
+	0x51, 0x4a, 0xb7, 0x3c, 0xea, 0xf4, 0x0a, 0x17, 0x17, 0xf0, 0xa8, 0x38, 0x3b, 0xf8, 0xd9, 0x82,
+	0xde, 0x99, 0xbe, 0x4d, 0xcf, 0xe9, 0x32, 0x19, 0x8f, 0x7d, 0x01, 0x9b, 0x1e, 0xdd, 0x15, 0xb2,
+	0xd7, 0xce, 0xd3, 0xfb, 0xc3, 0xf2, 0x76, 0x0d, 0x6f, 0xdc, 0xa1, 0x51, 0xfd, 0xed, 0x6f, 0x0f,
+	0x37, 0xc6, 0x26, 0xc1, 0x3e, 0x86, 0x06, 0x96, 0xc6, 0x25, 0xe7, 0xed, 0x3c, 0x3d, 0x58, 0x64,
+	0xaf, 0xf7, 0xb4, 0x29, 0xb1, 0x48, 0xd4, 0x6a, 0x54, 0x2f, 0xae, 0x27, 0x58, 0xe6, 0x47, 0x4e,
+	0xed, 0xa0, 0xa6, 0xd5, 0xa8, 0xf0, 0x11, 0xc1, 0x83, 0xef, 0xa1, 0x35, 0x99, 0x67, 0xfe, 0x73,
+	0x9e, 0xa6, 0xb1, 0x52, 0x88, 0xb6, 0x03, 0x5b, 0xf9, 0xd4, 0x7b, 0x89, 0x73, 0xe9, 0x58, 0x94,
+	0x52, 0xbe, 0xea, 0xaa, 0x2c, 0x0c, 0x05, 0x86, 0xda, 0xf8, 0x05, 0x68, 0x2e, 0x47, 0xa7, 0xc2,
+	0xcf, 0x09, 0x1e, 0xcc, 0x8b, 0xaa, 0xcf, 0x4a, 0xd8, 0x1e, 0xc2, 0x5d, 0x39, 0xcf, 0x7c, 0xd7,
+	0x2f, 0xfb, 0xb8, 0x5e, 0xac, 0xa4, 0xb9, 0x7e, 0x3d, 0xb9, 0x3c, 0xc1, 0x28, 0x56, 0xd2, 0xfe,
+	0x1c, 0x9c, 0x6b, 0xe7, 0x65, 0x1c, 0x66, 0x4c, 0x4d, 0x05, 0x9a, 0x9e, 0x7b, 0x2b, 0x49, 0x93,
+	0x32, 0x3a, 0xf8, 0xd3, 0x82, 0xdd, 0xa5, 0x95, 0x8c, 0x38, 0x57, 0x52, 0x09, 0x96, 0xeb, 0xad,
+	0x44, 0xa4, 0xd7, 0xcd, 0xad, 0xdc, 0x58, 0x61, 0xb9, 0x95, 0x22, 0xc1, 0x9e, 0xc0, 0x9e, 0x3f,
+	0x15, 0xf4, 0xab, 0xb1, 0x3a, 0x95, 0x59, 0xd1, 0xbd, 0x45, 0xa9, 0x15, 0x31, 0x4d, 0x99, 0x5d,
+	0x93, 0xbc, 0x2a, 0xf4, 0x33, 0x78, 0xb0, 0xbe, 0xe8, 0xea, 0xc6, 0xf6, 0xd7, 0x25, 0x9b, 0xe5,
+	0xfd, 0x52, 0x5b, 0xb1, 0xdf, 0x8b, 0x3c, 0xd0, 0x5a, 0x7f, 0x0b, 0x1d, 0xa6, 0x14, 0x4a, 0x85,
+	0x81, 0x7b, 0x5b, 0xc6, 0xed, 0x32, 0xd3, 0x58, 0xf9, 0x04, 0xee, 0x66, 0x38, 0xbb, 0x25, 0xed,
+	0x71, 0x4f, 0xe7, 0xac, 0xb2, 0xfd, 0x12, 0xf6, 0xd7, 0x14, 0x5a, 0xa5, 0x7a, 0xef, 0x46, 0x5a,
+	0xc1, 0xd3, 0x3e, 0x83, 0xee, 0x45, 0x9c, 0xb1, 0x24, 0x7e, 0xb3, 0xa0, 0x54, 0xff, 0xb7, 0x94,
+	0x3a, 0x55, 0xaa, 0xe1, 0xf4, 0x04, 0x0c, 0xa4, 0xe6, 0x65, 0xff, 0x3b, 0xd4, 0xbf, 0x5d, 0xc2,
+	0xa6, 0xed, 0x31, 0xb4, 0x69, 0xdc, 0xca, 0xdd, 0xce, 0xe6, 0x3a, 0xde, 0x95, 0xcb, 0x4d, 0xc3,
+	0x96, 0x5c, 0xb1, 0xfe, 0x63, 0x68, 0x57, 0xde, 0x75, 0xe9, 0xef, 0xb2, 0xf8, 0xa5, 0x6e, 0x55,
+	0xe8, 0x24, 0xe1, 0x6a, 0xf0, 0x97, 0x05, 0xdd, 0x25, 0x0a, 0x13, 0xc5, 0x05, 0xae, 0x25, 0x6e,
+	0xfd, 0x67, 0xe2, 0xff, 0x8b, 0x8d, 0xff, 0xc1, 0x21, 0xb5, 0xdb, 0x3a, 0x64, 0x74, 0xfa, 0xf6,
+	0xb2, 0x6f, 0xbd, 0xbb, 0xec, 0x5b, 0xbf, 0x5f, 0xf6, 0xad, 0x1f, 0xaf, 0xfa, 0x1b, 0xef, 0xae,
+	0xfa, 0x1b, 0xbf, 0x5e, 0xf5, 0x37, 0x7e, 0x18, 0x86, 0xb1, 0x8a, 0xa6, 0xde, 0xd0, 0xe7, 0xe9,
+	0xd1, 0x1b, 0x54, 0xec, 0x53, 0x3f, 0x62, 0x71, 0x46, 0x8f, 0x3e, 0x17, 0x78, 0x74, 0xed, 0xfb,
+	0xc6, 0xdb, 0xa4, 0x6f, 0x96, 0xcf, 0xfe, 0x1e, 0x00, 0x66, 0x44, 0xeb, 0xbc, 0xf9, 0x08, 0x00,
+	0x00,
+}
+
+func (m *BeaconBlockHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeaconBlockHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeaconBlockHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BodyRoot) > 0 {
+		i -= len(m.BodyRoot)
+		copy(dAtA[i:], m.BodyRoot)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.BodyRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ParentRoot) > 0 {
+		i -= len(m.ParentRoot)
+		copy(dAtA[i:], m.ParentRoot)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.ParentRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ProposerIndex != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.ProposerIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Slot != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.Slot))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionPayloadHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionPayloadHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionPayloadHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExcessBlobGas != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.ExcessBlobGas))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.BlobGasUsed != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.BlobGasUsed))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.WithdrawalsRoot) > 0 {
+		i -= len(m.WithdrawalsRoot)
+		copy(dAtA[i:], m.WithdrawalsRoot)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.WithdrawalsRoot)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.TransactionsRoot) > 0 {
+		i -= len(m.TransactionsRoot)
+		copy(dAtA[i:], m.TransactionsRoot)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.TransactionsRoot)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.BaseFeePerGas) > 0 {
+		i -= len(m.BaseFeePerGas)
+		copy(dAtA[i:], m.BaseFeePerGas)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.BaseFeePerGas)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ExtraData) > 0 {
+		i -= len(m.ExtraData)
+		copy(dAtA[i:], m.ExtraData)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.ExtraData)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.PrevRandao) > 0 {
+		i -= len(m.PrevRandao)
+		copy(dAtA[i:], m.PrevRandao)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.PrevRandao)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LogsBloom) > 0 {
+		i -= len(m.LogsBloom)
+		copy(dAtA[i:], m.LogsBloom)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.LogsBloom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.ReceiptsRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParentHash) > 0 {
+		i -= len(m.ParentHash)
+		copy(dAtA[i:], m.ParentHash)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.ParentHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutionBranch) > 0 {
+		for iNdEx := len(m.ExecutionBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExecutionBranch[iNdEx])
+			copy(dAtA[i:], m.ExecutionBranch[iNdEx])
+			i = encodeVarintLightClient(dAtA, i, uint64(len(m.ExecutionBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Execution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Beacon.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncCommittee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncCommittee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncCommittee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePubkey) > 0 {
+		i -= len(m.AggregatePubkey)
+		copy(dAtA[i:], m.AggregatePubkey)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.AggregatePubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pubkeys) > 0 {
+		for iNdEx := len(m.Pubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pubkeys[iNdEx])
+			copy(dAtA[i:], m.Pubkeys[iNdEx])
+			i = encodeVarintLightClient(dAtA, i, uint64(len(m.Pubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SyncAggregate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncAggregate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SyncAggregate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SyncCommitteeSignature) > 0 {
+		i -= len(m.SyncCommitteeSignature)
+		copy(dAtA[i:], m.SyncCommitteeSignature)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.SyncCommitteeSignature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SyncCommitteeBits) > 0 {
+		i -= len(m.SyncCommitteeBits)
+		copy(dAtA[i:], m.SyncCommitteeBits)
+		i = encodeVarintLightClient(dAtA, i, uint64(len(m.SyncCommitteeBits)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientBootstrap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientBootstrap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientBootstrap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentSyncCommitteeBranch) > 0 {
+		for iNdEx := len(m.CurrentSyncCommitteeBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentSyncCommitteeBranch[iNdEx])
+			copy(dAtA[i:], m.CurrentSyncCommitteeBranch[iNdEx])
+			i = encodeVarintLightClient(dAtA, i, uint64(len(m.CurrentSyncCommitteeBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.CurrentSyncCommittee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Header.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SignatureSlot != 0 {
+		i = encodeVarintLightClient(dAtA, i, uint64(m.SignatureSlot))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.SyncAggregate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.FinalityBranch) > 0 {
+		for iNdEx := len(m.FinalityBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FinalityBranch[iNdEx])
+			copy(dAtA[i:], m.FinalityBranch[iNdEx])
+			i = encodeVarintLightClient(dAtA, i, uint64(len(m.FinalityBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.FinalizedHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.NextSyncCommitteeBranch) > 0 {
+		for iNdEx := len(m.NextSyncCommitteeBranch) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NextSyncCommitteeBranch[iNdEx])
+			copy(dAtA[i:], m.NextSyncCommitteeBranch[iNdEx])
+			i = encodeVarintLightClient(dAtA, i, uint64(len(m.NextSyncCommitteeBranch[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NextSyncCommittee != nil {
+		{
+			size, err := m.NextSyncCommittee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLightClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.AttestedHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LightClientStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientStore) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientStore) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSyncCommittee != nil {
+		{
+			size, err := m.NextSyncCommittee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLightClient(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.CurrentSyncCommittee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FinalizedHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLightClient(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLightClient(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLightClient(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BeaconBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovLightClient(uint64(m.Slot))
+	}
+	if m.ProposerIndex != 0 {
+		n += 1 + sovLightClient(uint64(m.ProposerIndex))
+	}
+	l = len(m.ParentRoot)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.BodyRoot)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecutionPayloadHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParentHash)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.LogsBloom)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.PrevRandao)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	if m.BlockNumber != 0 {
+		n += 1 + sovLightClient(uint64(m.BlockNumber))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovLightClient(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovLightClient(uint64(m.GasUsed))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovLightClient(uint64(m.Timestamp))
+	}
+	l = len(m.ExtraData)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.BaseFeePerGas)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.TransactionsRoot)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.WithdrawalsRoot)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	if m.BlobGasUsed != 0 {
+		n += 2 + sovLightClient(uint64(m.BlobGasUsed))
+	}
+	if m.ExcessBlobGas != 0 {
+		n += 2 + sovLightClient(uint64(m.ExcessBlobGas))
+	}
+	return n
+}
+
+func (m *LightClientHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Beacon.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	l = m.Execution.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	if len(m.ExecutionBranch) > 0 {
+		for _, b := range m.ExecutionBranch {
+			l = len(b)
+			n += 1 + l + sovLightClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SyncCommittee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pubkeys) > 0 {
+		for _, b := range m.Pubkeys {
+			l = len(b)
+			n += 1 + l + sovLightClient(uint64(l))
+		}
+	}
+	l = len(m.AggregatePubkey)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	return n
+}
+
+func (m *SyncAggregate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SyncCommitteeBits)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	l = len(m.SyncCommitteeSignature)
+	if l > 0 {
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	return n
+}
+
+func (m *LightClientBootstrap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Header.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	l = m.CurrentSyncCommittee.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	if len(m.CurrentSyncCommitteeBranch) > 0 {
+		for _, b := range m.CurrentSyncCommitteeBranch {
+			l = len(b)
+			n += 1 + l + sovLightClient(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LightClientUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AttestedHeader.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	if m.NextSyncCommittee != nil {
+		l = m.NextSyncCommittee.Size()
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	if len(m.NextSyncCommitteeBranch) > 0 {
+		for _, b := range m.NextSyncCommitteeBranch {
+			l = len(b)
+			n += 1 + l + sovLightClient(uint64(l))
+		}
+	}
+	l = m.FinalizedHeader.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	if len(m.FinalityBranch) > 0 {
+		for _, b := range m.FinalityBranch {
+			l = len(b)
+			n += 1 + l + sovLightClient(uint64(l))
+		}
+	}
+	l = m.SyncAggregate.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	if m.SignatureSlot != 0 {
+		n += 1 + sovLightClient(uint64(m.SignatureSlot))
+	}
+	return n
+}
+
+func (m *LightClientStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FinalizedHeader.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	l = m.CurrentSyncCommittee.Size()
+	n += 1 + l + sovLightClient(uint64(l))
+	if m.NextSyncCommittee != nil {
+		l = m.NextSyncCommittee.Size()
+		n += 1 + l + sovLightClient(uint64(l))
+	}
+	return n
+}
+
+func sovLightClient(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLightClient(x uint64) (n int) {
+	return sovLightClient(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BeaconBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeaconBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeaconBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerIndex", wireType)
+			}
+			m.ProposerIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentRoot = append(m.ParentRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentRoot == nil {
+				m.ParentRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BodyRoot = append(m.BodyRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BodyRoot == nil {
+				m.BodyRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionPayloadHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionPayloadHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionPayloadHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentHash = append(m.ParentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParentHash == nil {
+				m.ParentHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = append(m.FeeRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeRecipient == nil {
+				m.FeeRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptsRoot = append(m.ReceiptsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReceiptsRoot == nil {
+				m.ReceiptsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogsBloom", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogsBloom = append(m.LogsBloom[:0], dAtA[iNdEx:postIndex]...)
+			if m.LogsBloom == nil {
+				m.LogsBloom = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRandao", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevRandao = append(m.PrevRandao[:0], dAtA[iNdEx:postIndex]...)
+			if m.PrevRandao == nil {
+				m.PrevRandao = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+			}
+			m.BlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraData = append(m.ExtraData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtraData == nil {
+				m.ExtraData = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeePerGas", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeePerGas = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransactionsRoot = append(m.TransactionsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TransactionsRoot == nil {
+				m.TransactionsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawalsRoot = append(m.WithdrawalsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.WithdrawalsRoot == nil {
+				m.WithdrawalsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobGasUsed", wireType)
+			}
+			m.BlobGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcessBlobGas", wireType)
+			}
+			m.ExcessBlobGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExcessBlobGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beacon", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Beacon.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Execution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Execution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionBranch = append(m.ExecutionBranch, make([]byte, postIndex-iNdEx))
+			copy(m.ExecutionBranch[len(m.ExecutionBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncCommittee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncCommittee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncCommittee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkeys = append(m.Pubkeys, make([]byte, postIndex-iNdEx))
+			copy(m.Pubkeys[len(m.Pubkeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePubkey = append(m.AggregatePubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AggregatePubkey == nil {
+				m.AggregatePubkey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncAggregate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncAggregate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncAggregate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeBits", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeBits = append(m.SyncCommitteeBits[:0], dAtA[iNdEx:postIndex]...)
+			if m.SyncCommitteeBits == nil {
+				m.SyncCommitteeBits = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncCommitteeSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SyncCommitteeSignature = append(m.SyncCommitteeSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SyncCommitteeSignature == nil {
+				m.SyncCommitteeSignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientBootstrap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientBootstrap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientBootstrap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSyncCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSyncCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSyncCommitteeBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentSyncCommitteeBranch = append(m.CurrentSyncCommitteeBranch, make([]byte, postIndex-iNdEx))
+			copy(m.CurrentSyncCommitteeBranch[len(m.CurrentSyncCommitteeBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextSyncCommittee == nil {
+				m.NextSyncCommittee = &SyncCommittee{}
+			}
+			if err := m.NextSyncCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncCommitteeBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextSyncCommitteeBranch = append(m.NextSyncCommitteeBranch, make([]byte, postIndex-iNdEx))
+			copy(m.NextSyncCommitteeBranch[len(m.NextSyncCommitteeBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalizedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityBranch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityBranch = append(m.FinalityBranch, make([]byte, postIndex-iNdEx))
+			copy(m.FinalityBranch[len(m.FinalityBranch)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncAggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncAggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureSlot", wireType)
+			}
+			m.SignatureSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignatureSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LightClientStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FinalizedHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSyncCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSyncCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSyncCommittee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextSyncCommittee == nil {
+				m.NextSyncCommittee = &SyncCommittee{}
+			}
+			if err := m.NextSyncCommittee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLightClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLightClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLightClient(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLightClient
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLightClient
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLightClient
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLightClient
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLightClient
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLightClient        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLightClient          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLightClient = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,230 @@
+package ethereum_test
+
+import (
+	"os"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+)
+
+// the Sepolia light client fixtures are synthetic: they follow the Sepolia network configuration and the beacon API
+// format but the sync committees are made of test keys
+const (
+	bootstrapFile                 = "../testdata/sepolia_light_client_bootstrap.json"
+	periodUpdateFile              = "../testdata/sepolia_light_client_update_1069.json"
+	finalityUpdateFile            = "../testdata/sepolia_light_client_finality_update_1070.json"
+	lowParticipationFinalityFile  = "../testdata/sepolia_light_client_finality_update_low_participation.json"
+	trustedBlockRoot              = "0x45b51db36d8b00a679dd21a3063d3c516bb8d6faed95aa3dd356f5eb8821f971"
+	bootstrapSyncCommitteePeriod  = 1069
+	bootstrapExecutionBlockNumber = 9310000
+)
+
+func loadBootstrap(t *testing.T) ethereum.LightClientBootstrap {
+	data, err := os.ReadFile(bootstrapFile)
+	require.NoError(t, err)
+	bootstrap, err := ethereum.ParseLightClientBootstrapJSON(data)
+	require.NoError(t, err)
+	return bootstrap
+}
+
+func loadUpdate(t *testing.T, file string) ethereum.LightClientUpdate {
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	update, err := ethereum.ParseLightClientUpdateJSON(data)
+	require.NoError(t, err)
+	return update
+}
+
+func initializeStore(t *testing.T) ethereum.LightClientStore {
+	store, err := ethereum.SepoliaNetworkConfig().InitializeLightClientStore(
+		ethcommon.HexToHash(trustedBlockRoot).Bytes(),
+		loadBootstrap(t),
+	)
+	require.NoError(t, err)
+	return store
+}
+
+func TestGetNetworkConfig(t *testing.T) {
+	_, found := ethereum.GetNetworkConfig(1)
+	require.True(t, found)
+	config, found := ethereum.GetNetworkConfig(11155111)
+	require.True(t, found)
+	require.Equal(t, ethereum.SepoliaNetworkConfig(), config)
+	_, found = ethereum.GetNetworkConfig(5)
+	require.False(t, found)
+}
+
+func TestNetworkConfig_ForkVersion(t *testing.T) {
+	config := ethereum.MainnetNetworkConfig()
+	_, err := config.ForkVersion(config.CapellaForkEpoch - 1)
+	require.Error(t, err)
+
+	version, err := config.ForkVersion(config.CapellaForkEpoch)
+	require.NoError(t, err)
+	require.Equal(t, config.CapellaForkVersion, version)
+
+	version, err = config.ForkVersion(config.ElectraForkEpoch + 1)
+	require.NoError(t, err)
+	require.Equal(t, config.ElectraForkVersion, version)
+
+	version, err = config.ForkVersion(config.FuluForkEpoch)
+	require.NoError(t, err)
+	require.Equal(t, config.FuluForkVersion, version)
+}
+
+func TestParseLightClientJSON(t *testing.T) {
+	_, err := ethereum.ParseLightClientBootstrapJSON([]byte("invalid"))
+	require.Error(t, err)
+	_, err = ethereum.ParseLightClientUpdateJSON([]byte(`{"version":"fulu"}`))
+	require.Error(t, err)
+
+	bootstrap := loadBootstrap(t)
+	require.Len(t, bootstrap.CurrentSyncCommittee.Pubkeys, ethereum.SyncCommitteeSize)
+	require.EqualValues(t, bootstrapExecutionBlockNumber, bootstrap.Header.Execution.BlockNumber)
+
+	require.NotNil(t, loadUpdate(t, periodUpdateFile).NextSyncCommittee)
+	require.Nil(t, loadUpdate(t, finalityUpdateFile).NextSyncCommittee)
+}
+
+func TestNetworkConfig_InitializeLightClientStore(t *testing.T) {
+	config := ethereum.SepoliaNetworkConfig()
+	root := ethcommon.HexToHash(trustedBlockRoot).Bytes()
+
+	t.Run("can initialize the store from a trusted block root", func(t *testing.T) {
+		bootstrap := loadBootstrap(t)
+		store, err := config.InitializeLightClientStore(root, bootstrap)
+		require.NoError(t, err)
+		require.Equal(t, bootstrap.Header, store.FinalizedHeader)
+		require.Equal(t, bootstrap.CurrentSyncCommittee, store.CurrentSyncCommittee)
+		require.Nil(t, store.NextSyncCommittee)
+		require.EqualValues(t, bootstrapSyncCommitteePeriod, ethereum.SyncCommitteePeriodAtSlot(store.FinalizedHeader.Beacon.Slot))
+	})
+
+	t.Run("fail if the block root is not the trusted block root", func(t *testing.T) {
+		_, err := config.InitializeLightClientStore(ethcommon.HexToHash("0x01").Bytes(), loadBootstrap(t))
+		require.ErrorContains(t, err, "differs from trusted block root")
+	})
+
+	t.Run("fail if the current sync committee branch is invalid", func(t *testing.T) {
+		bootstrap := loadBootstrap(t)
+		bootstrap.CurrentSyncCommitteeBranch[0][0] ^= 0x01
+		_, err := config.InitializeLightClientStore(root, bootstrap)
+		require.ErrorContains(t, err, "invalid current sync committee branch")
+	})
+
+	t.Run("fail if the sync committee is modified", func(t *testing.T) {
+		bootstrap := loadBootstrap(t)
+		bootstrap.CurrentSyncCommittee.Pubkeys[0], bootstrap.CurrentSyncCommittee.Pubkeys[1] =
+			bootstrap.CurrentSyncCommittee.Pubkeys[1], bootstrap.CurrentSyncCommittee.Pubkeys[0]
+		_, err := config.InitializeLightClientStore(root, bootstrap)
+		require.ErrorContains(t, err, "invalid current sync committee branch")
+	})
+
+	t.Run("fail if the execution payload header is not committed to by the block", func(t *testing.T) {
+		bootstrap := loadBootstrap(t)
+		bootstrap.Header.Execution.BlockNumber++
+		_, err := config.InitializeLightClientStore(root, bootstrap)
+		require.ErrorContains(t, err, "invalid execution branch")
+	})
+
+	t.Run("fail with the configuration of another network", func(t *testing.T) {
+		_, err := ethereum.MainnetNetworkConfig().InitializeLightClientStore(root, loadBootstrap(t))
+		require.Error(t, err)
+	})
+}
+
+func TestNetworkConfig_ProcessLightClientUpdate(t *testing.T) {
+	config := ethereum.SepoliaNetworkConfig()
+
+	t.Run("can follow the finalized headers across sync committee periods", func(t *testing.T) {
+		store := initializeStore(t)
+		bootstrapCommittee := store.CurrentSyncCommittee
+
+		// the period update finalizes a newer header and provides the next sync committee
+		update := loadUpdate(t, periodUpdateFile)
+		store, finalizedChanged, err := config.ProcessLightClientUpdate(store, update)
+		require.NoError(t, err)
+		require.True(t, finalizedChanged)
+		require.Equal(t, update.FinalizedHeader, store.FinalizedHeader)
+		require.Equal(t, bootstrapCommittee, store.CurrentSyncCommittee)
+		require.Equal(t, update.NextSyncCommittee, store.NextSyncCommittee)
+
+		// the update can't be applied twice
+		_, _, err = config.ProcessLightClientUpdate(store, update)
+		require.Error(t, err)
+
+		// the finality update of the next period is signed by the next sync committee
+		finalityUpdate := loadUpdate(t, finalityUpdateFile)
+		store, finalizedChanged, err = config.ProcessLightClientUpdate(store, finalityUpdate)
+		require.NoError(t, err)
+		require.True(t, finalizedChanged)
+		require.Equal(t, finalityUpdate.FinalizedHeader, store.FinalizedHeader)
+		require.Equal(t, *update.NextSyncCommittee, store.CurrentSyncCommittee)
+		require.Nil(t, store.NextSyncCommittee)
+		require.EqualValues(t, bootstrapSyncCommitteePeriod+1, ethereum.SyncCommitteePeriodAtSlot(store.FinalizedHeader.Beacon.Slot))
+	})
+
+	t.Run("fail if the update is signed by the next sync committee while it is unknown", func(t *testing.T) {
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), loadUpdate(t, finalityUpdateFile))
+		require.ErrorContains(t, err, "is not the store period")
+	})
+
+	t.Run("fail if the participation is below the supermajority", func(t *testing.T) {
+		update := loadUpdate(t, lowParticipationFinalityFile)
+		store := initializeStore(t)
+		require.NoError(t, config.ValidateLightClientUpdate(store, update))
+		_, _, err := config.ProcessLightClientUpdate(store, update)
+		require.ErrorContains(t, err, "below the supermajority")
+	})
+
+	t.Run("fail if the signature is invalid", func(t *testing.T) {
+		update := loadUpdate(t, periodUpdateFile)
+		update.SyncAggregate.SyncCommitteeBits[0] ^= 0x01
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), update)
+		require.ErrorContains(t, err, "invalid sync committee signature")
+	})
+
+	t.Run("fail if the attested header is modified", func(t *testing.T) {
+		update := loadUpdate(t, periodUpdateFile)
+		update.AttestedHeader.Beacon.ProposerIndex++
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), update)
+		require.ErrorContains(t, err, "invalid sync committee signature")
+	})
+
+	t.Run("fail if the finalized header is not committed to by the attested header", func(t *testing.T) {
+		update := loadUpdate(t, periodUpdateFile)
+		update.FinalizedHeader.Beacon.Slot++
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), update)
+		require.ErrorContains(t, err, "invalid finality branch")
+	})
+
+	t.Run("fail if the finalized execution payload header is modified", func(t *testing.T) {
+		update := loadUpdate(t, periodUpdateFile)
+		update.FinalizedHeader.Execution.BlockHash[0] ^= 0x01
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), update)
+		require.ErrorContains(t, err, "invalid execution branch")
+	})
+
+	t.Run("fail if the next sync committee branch is invalid", func(t *testing.T) {
+		update := loadUpdate(t, periodUpdateFile)
+		update.NextSyncCommitteeBranch[2][0] ^= 0x01
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), update)
+		require.ErrorContains(t, err, "invalid next sync committee branch")
+	})
+
+	t.Run("fail if the slots are inconsistent", func(t *testing.T) {
+		update := loadUpdate(t, periodUpdateFile)
+		update.SignatureSlot = update.AttestedHeader.Beacon.Slot
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), update)
+		require.ErrorContains(t, err, "invalid slots")
+	})
+
+	t.Run("fail if the sync committee bits have an invalid length", func(t *testing.T) {
+		update := loadUpdate(t, periodUpdateFile)
+		update.SyncAggregate.SyncCommitteeBits = update.SyncAggregate.SyncCommitteeBits[:32]
+		_, _, err := config.ProcessLightClientUpdate(initializeStore(t), update)
+		require.ErrorContains(t, err, "invalid sync committee bits length")
+	})
+}
@@ -0,0 +1,120 @@
+package ethereum
+
+import (
+	"fmt"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// SlotsPerEpoch is the number of slots in an epoch of the beacon chain
+	SlotsPerEpoch = 32
+
+	// EpochsPerSyncCommitteePeriod is the number of epochs in a sync committee period
+	EpochsPerSyncCommitteePeriod = 256
+
+	// SyncCommitteeSize is the number of validators in a sync committee
+	SyncCommitteeSize = 512
+)
+
+// Version is the version of a fork of the beacon chain
+type Version [4]byte
+
+// NetworkConfig is the configuration of a beacon chain used to verify the light client updates
+// only the forks since Capella are defined, the light client headers contain the execution payload header since Capella
+type NetworkConfig struct {
+	GenesisValidatorsRoot ethcommon.Hash
+
+	CapellaForkVersion Version
+	CapellaForkEpoch   uint64
+	DenebForkVersion   Version
+	DenebForkEpoch     uint64
+	ElectraForkVersion Version
+	ElectraForkEpoch   uint64
+	FuluForkVersion    Version
+	FuluForkEpoch      uint64
+}
+
+// MainnetNetworkConfig returns the configuration of the Ethereum mainnet beacon chain
+func MainnetNetworkConfig() NetworkConfig {
+	return NetworkConfig{
+		GenesisValidatorsRoot: ethcommon.HexToHash("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"),
+		CapellaForkVersion:    Version{0x03, 0x00, 0x00, 0x00},
+		CapellaForkEpoch:      194048,
+		DenebForkVersion:      Version{0x04, 0x00, 0x00, 0x00},
+		DenebForkEpoch:        269568,
+		ElectraForkVersion:    Version{0x05, 0x00, 0x00, 0x00},
+		ElectraForkEpoch:      364032,
+		FuluForkVersion:       Version{0x06, 0x00, 0x00, 0x00},
+		FuluForkEpoch:         411392,
+	}
+}
+
+// SepoliaNetworkConfig returns the configuration of the Sepolia beacon chain
+func SepoliaNetworkConfig() NetworkConfig {
+	return NetworkConfig{
+		GenesisValidatorsRoot: ethcommon.HexToHash("0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078"),
+		CapellaForkVersion:    Version{0x90, 0x00, 0x00, 0x72},
+		CapellaForkEpoch:      56832,
+		DenebForkVersion:      Version{0x90, 0x00, 0x00, 0x73},
+		DenebForkEpoch:        132608,
+		ElectraForkVersion:    Version{0x90, 0x00, 0x00, 0x74},
+		ElectraForkEpoch:      222464,
+		FuluForkVersion:       Version{0x90, 0x00, 0x00, 0x75},
+		FuluForkEpoch:         272640,
+	}
+}
+
+// GetNetworkConfig returns the beacon chain configuration of an Ethereum chain
+// false is returned if the chain has no beacon chain light client support
+func GetNetworkConfig(chainID int64) (NetworkConfig, bool) {
+	switch chainID {
+	case 1: // eth mainnet
+		return MainnetNetworkConfig(), true
+	case 11155111: // sepolia
+		return SepoliaNetworkConfig(), true
+	default:
+		return NetworkConfig{}, false
+	}
+}
+
+// EpochAtSlot returns the epoch of a slot
+func EpochAtSlot(slot uint64) uint64 {
+	return slot / SlotsPerEpoch
+}
+
+// SyncCommitteePeriodAtSlot returns the sync committee period of a slot
+func SyncCommitteePeriodAtSlot(slot uint64) uint64 {
+	return EpochAtSlot(slot) / EpochsPerSyncCommitteePeriod
+}
+
+// ForkVersion returns the version of the fork active at an epoch
+func (c NetworkConfig) ForkVersion(epoch uint64) (Version, error) {
+	switch {
+	case epoch >= c.FuluForkEpoch:
+		return c.FuluForkVersion, nil
+	case epoch >= c.ElectraForkEpoch:
+		return c.ElectraForkVersion, nil
+	case epoch >= c.DenebForkEpoch:
+		return c.DenebForkVersion, nil
+	case epoch >= c.CapellaForkEpoch:
+		return c.CapellaForkVersion, nil
+	default:
+		return Version{}, fmt.Errorf("epoch %d is before the capella fork", epoch)
+	}
+}
+
+// isCapella returns true if the slot is after the Capella fork
+func (c NetworkConfig) isCapella(slot uint64) bool {
+	return EpochAtSlot(slot) >= c.CapellaForkEpoch
+}
+
+// isDeneb returns true if the slot is after the Deneb fork
+func (c NetworkConfig) isDeneb(slot uint64) bool {
+	return EpochAtSlot(slot) >= c.DenebForkEpoch
+}
+
+// isElectra returns true if the slot is after the Electra fork
+func (c NetworkConfig) isElectra(slot uint64) bool {
+	return EpochAtSlot(slot) >= c.ElectraForkEpoch
+}
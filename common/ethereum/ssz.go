@@ -0,0 +1,267 @@
+package ethereum
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// bytesPerChunk is the size of a chunk of a SSZ merkle tree
+	bytesPerChunk = 32
+
+	// logsBloomLength is the length of the logs bloom of an execution payload header
+	logsBloomLength = 256
+
+	// maxExtraDataBytes is the maximum length of the extra data of an execution payload header
+	maxExtraDataBytes = 32
+
+	// syncCommitteeBitsLength is the length of the bit vector of the sync committee participants
+	syncCommitteeBitsLength = SyncCommitteeSize / 8
+)
+
+// zeroHashes contains the roots of the merkle trees of zero chunks indexed by depth
+var zeroHashes = func() [][32]byte {
+	hashes := make([][32]byte, 64)
+	for i := 1; i < len(hashes); i++ {
+		hashes[i] = hashPair(hashes[i-1], hashes[i-1])
+	}
+	return hashes
+}()
+
+// hashPair returns the hash of the concatenation of two chunks
+func hashPair(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// merkleize returns the root of the merkle tree of the chunks padded with zero chunks up to the limit
+func merkleize(chunks [][32]byte, limit int) [32]byte {
+	depth := 0
+	for 1<<depth < limit {
+		depth++
+	}
+	layer := append([][32]byte{}, chunks...)
+	for d := 0; d < depth; d++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[d])
+		}
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+	if len(layer) == 0 {
+		return zeroHashes[depth]
+	}
+	return layer[0]
+}
+
+// mixInLength returns the root of a list from the root of its elements and its length
+func mixInLength(root [32]byte, length uint64) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:], length)
+	return hashPair(root, chunk)
+}
+
+// packBytes splits bytes into chunks, the last chunk is right-padded with zeros
+func packBytes(b []byte) [][32]byte {
+	chunks := make([][32]byte, (len(b)+bytesPerChunk-1)/bytesPerChunk)
+	for i := range chunks {
+		copy(chunks[i][:], b[i*bytesPerChunk:])
+	}
+	return chunks
+}
+
+// uint64Chunk returns the chunk of a uint64
+func uint64Chunk(v uint64) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:], v)
+	return chunk
+}
+
+// fixedBytesChunk returns the chunk of fixed size bytes fitting in a chunk
+func fixedBytesChunk(b []byte, length int, name string) ([32]byte, error) {
+	var chunk [32]byte
+	if len(b) != length {
+		return chunk, fmt.Errorf("invalid %s length %d, expected %d", name, len(b), length)
+	}
+	copy(chunk[:], b)
+	return chunk, nil
+}
+
+// rootChunk returns the chunk of a 32 bytes root
+func rootChunk(b []byte, name string) ([32]byte, error) {
+	return fixedBytesChunk(b, bytesPerChunk, name)
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the beacon block header
+func (m BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	parentRoot, err := rootChunk(m.ParentRoot, "parent root")
+	if err != nil {
+		return [32]byte{}, err
+	}
+	stateRoot, err := rootChunk(m.StateRoot, "state root")
+	if err != nil {
+		return [32]byte{}, err
+	}
+	bodyRoot, err := rootChunk(m.BodyRoot, "body root")
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return merkleize([][32]byte{
+		uint64Chunk(m.Slot),
+		uint64Chunk(m.ProposerIndex),
+		parentRoot,
+		stateRoot,
+		bodyRoot,
+	}, 5), nil
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the execution payload header
+// the blob gas fields are only part of the header since the Deneb fork
+func (m ExecutionPayloadHeader) HashTreeRoot(isDeneb bool) ([32]byte, error) {
+	var chunks [][32]byte
+	for _, field := range []struct {
+		value  []byte
+		length int
+		name   string
+	}{
+		{m.ParentHash, bytesPerChunk, "parent hash"},
+		{m.FeeRecipient, ethcommon.AddressLength, "fee recipient"},
+		{m.StateRoot, bytesPerChunk, "state root"},
+		{m.ReceiptsRoot, bytesPerChunk, "receipts root"},
+	} {
+		chunk, err := fixedBytesChunk(field.value, field.length, field.name)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	if len(m.LogsBloom) != logsBloomLength {
+		return [32]byte{}, fmt.Errorf("invalid logs bloom length %d, expected %d", len(m.LogsBloom), logsBloomLength)
+	}
+	chunks = append(chunks, merkleize(packBytes(m.LogsBloom), logsBloomLength/bytesPerChunk))
+
+	prevRandao, err := rootChunk(m.PrevRandao, "prev randao")
+	if err != nil {
+		return [32]byte{}, err
+	}
+	chunks = append(chunks,
+		prevRandao,
+		uint64Chunk(m.BlockNumber),
+		uint64Chunk(m.GasLimit),
+		uint64Chunk(m.GasUsed),
+		uint64Chunk(m.Timestamp),
+	)
+
+	if len(m.ExtraData) > maxExtraDataBytes {
+		return [32]byte{}, fmt.Errorf("extra data too long (%d)", len(m.ExtraData))
+	}
+	extraDataRoot := merkleize(packBytes(m.ExtraData), maxExtraDataBytes/bytesPerChunk)
+	chunks = append(chunks, mixInLength(extraDataRoot, uint64(len(m.ExtraData))))
+
+	baseFee, ok := new(big.Int).SetString(m.BaseFeePerGas, 10)
+	if !ok || baseFee.Sign() < 0 || baseFee.BitLen() > 256 {
+		return [32]byte{}, fmt.Errorf("invalid base fee per gas (%s)", m.BaseFeePerGas)
+	}
+	var baseFeeChunk [32]byte
+	baseFee.FillBytes(baseFeeChunk[:])
+	for i, j := 0, len(baseFeeChunk)-1; i < j; i, j = i+1, j-1 {
+		baseFeeChunk[i], baseFeeChunk[j] = baseFeeChunk[j], baseFeeChunk[i]
+	}
+	chunks = append(chunks, baseFeeChunk)
+
+	for _, field := range []struct {
+		value []byte
+		name  string
+	}{
+		{m.BlockHash, "block hash"},
+		{m.TransactionsRoot, "transactions root"},
+		{m.WithdrawalsRoot, "withdrawals root"},
+	} {
+		chunk, err := rootChunk(field.value, field.name)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	if isDeneb {
+		chunks = append(chunks, uint64Chunk(m.BlobGasUsed), uint64Chunk(m.ExcessBlobGas))
+	}
+	return merkleize(chunks, len(chunks)), nil
+}
+
+// HashTreeRoot returns the SSZ hash tree root of the sync committee
+func (m SyncCommittee) HashTreeRoot() ([32]byte, error) {
+	if len(m.Pubkeys) != SyncCommitteeSize {
+		return [32]byte{}, fmt.Errorf("invalid sync committee size %d, expected %d", len(m.Pubkeys), SyncCommitteeSize)
+	}
+	pubkeyRoots := make([][32]byte, len(m.Pubkeys))
+	for i, pubkey := range m.Pubkeys {
+		root, err := pubkeyRoot(pubkey)
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("invalid public key %d: %w", i, err)
+		}
+		pubkeyRoots[i] = root
+	}
+	aggregatePubkeyRoot, err := pubkeyRoot(m.AggregatePubkey)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("invalid aggregate public key: %w", err)
+	}
+	return hashPair(merkleize(pubkeyRoots, SyncCommitteeSize), aggregatePubkeyRoot), nil
+}
+
+// pubkeyRoot returns the SSZ hash tree root of a BLS public key
+func pubkeyRoot(pubkey []byte) ([32]byte, error) {
+	if len(pubkey) != BLSPubkeyLength {
+		return [32]byte{}, fmt.Errorf("invalid length %d, expected %d", len(pubkey), BLSPubkeyLength)
+	}
+	return merkleize(packBytes(pubkey), 2), nil
+}
+
+// signingRoot returns the root signed for an object in a domain
+func signingRoot(objectRoot [32]byte, domain [32]byte) [32]byte {
+	return hashPair(objectRoot, domain)
+}
+
+// computeDomain returns the signature domain of a domain type for a fork
+func computeDomain(domainType [4]byte, forkVersion Version, genesisValidatorsRoot ethcommon.Hash) [32]byte {
+	var versionChunk [32]byte
+	copy(versionChunk[:], forkVersion[:])
+	forkDataRoot := hashPair(versionChunk, genesisValidatorsRoot)
+
+	var domain [32]byte
+	copy(domain[:4], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return domain
+}
+
+// isValidMerkleBranch checks a leaf is at an index of a merkle tree of a given depth from its branch
+func isValidMerkleBranch(leaf [32]byte, branch [][]byte, depth int, index uint64, root []byte) error {
+	if len(branch) != depth {
+		return fmt.Errorf("invalid branch length %d, expected %d", len(branch), depth)
+	}
+	value := leaf
+	for i, node := range branch {
+		sibling, err := rootChunk(node, "branch node")
+		if err != nil {
+			return err
+		}
+		if (index>>i)&1 == 1 {
+			value = hashPair(sibling, value)
+		} else {
+			value = hashPair(value, sibling)
+		}
+	}
+	if ethcommon.BytesToHash(root) != value || len(root) != bytesPerChunk {
+		return errors.New("invalid merkle branch")
+	}
+	return nil
+}
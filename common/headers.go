@@ -54,6 +54,19 @@ func (h HeaderData) ParentHash() ([]byte, error) {
 	}
 }
 
+// ParseBitcoinHeader deserializes the Bitcoin header contained in the HeaderData
+func (h HeaderData) ParseBitcoinHeader() (*wire.BlockHeader, error) {
+	data, ok := h.Data.(*HeaderData_BitcoinHeader)
+	if !ok {
+		return nil, errors.New("not a bitcoin header")
+	}
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(data.BitcoinHeader)); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
 func (h HeaderData) ValidateTimestamp(zetaTime time.Time) error {
 	switch data := h.Data.(type) {
 	case *HeaderData_EthereumHeader:
@@ -90,7 +103,7 @@ func (h HeaderData) ValidateTimestamp(zetaTime time.Time) error {
 func (h HeaderData) Validate(blockHash []byte, chainID int64, height int64) error {
 	switch data := h.Data.(type) {
 	case *HeaderData_EthereumHeader:
-		return validateEthereumHeader(data.EthereumHeader, blockHash, height)
+		return validateEVMHeader(data.EthereumHeader, blockHash, chainID, height)
 	case *HeaderData_BitcoinHeader:
 		return ValidateBitcoinHeader(data.BitcoinHeader, blockHash, chainID)
 	default:
@@ -98,28 +111,24 @@ func (h HeaderData) Validate(blockHash []byte, chainID int64, height int64) erro
 	}
 }
 
-// validateEthereumHeader performs a basic validation of the Ethereum header
-func validateEthereumHeader(headerBytes []byte, blockHash []byte, height int64) error {
-	// on ethereum the block header is ~538 bytes in RLP encoding
-	if len(headerBytes) > 4096 {
-		return fmt.Errorf("header too long (%d)", len(headerBytes))
-	}
-
+// validateEthereumHeader performs a basic validation of the Ethereum header and returns the decoded header
+// the header format is shared by all EVM chains, the header length is checked by the caller
+func validateEthereumHeader(headerBytes []byte, blockHash []byte, height int64) (*ethtypes.Header, error) {
 	// RLP encoded block header
 	var header ethtypes.Header
 	if err := rlp.DecodeBytes(headerBytes, &header); err != nil {
-		return fmt.Errorf("cannot decode RLP (%s)", err)
+		return nil, fmt.Errorf("cannot decode RLP (%s)", err)
 	}
 	if err := header.SanityCheck(); err != nil {
-		return fmt.Errorf("sanity check failed (%s)", err)
+		return nil, fmt.Errorf("sanity check failed (%s)", err)
 	}
 	if !bytes.Equal(blockHash, header.Hash().Bytes()) {
-		return fmt.Errorf("block hash mismatch (%s) vs (%s)", hex.EncodeToString(blockHash), header.Hash().Hex())
+		return nil, fmt.Errorf("block hash mismatch (%s) vs (%s)", hex.EncodeToString(blockHash), header.Hash().Hex())
 	}
 	if height != header.Number.Int64() {
-		return fmt.Errorf("height mismatch (%d) vs (%d)", height, header.Number.Int64())
+		return nil, fmt.Errorf("height mismatch (%d) vs (%d)", height, header.Number.Int64())
 	}
-	return nil
+	return &header, nil
 }
 
 func ValidateBitcoinHeader(headerBytes []byte, blockHash []byte, chainID int64) error {
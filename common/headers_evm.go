@@ -0,0 +1,230 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// ethereumMaxHeaderLen is the maximum length of an Ethereum block header, the header is ~538 bytes in RLP encoding
+	ethereumMaxHeaderLen = 4096
+
+	// parliaMaxHeaderLen is the maximum length of a BSC block header, epoch headers contain the validator set
+	parliaMaxHeaderLen = 8192
+
+	// borMaxHeaderLen is the maximum length of a Polygon block header, sprint end headers contain the validator set
+	borMaxHeaderLen = 16384
+
+	// extraVanity is the number of extra data prefix bytes reserved for the signer vanity on BSC and Polygon
+	extraVanity = 32
+
+	// extraSeal is the number of extra data suffix bytes reserved for the signer seal on BSC and Polygon
+	extraSeal = crypto.SignatureLength
+
+	// parliaEpoch is the number of blocks after which the BSC validator set is updated
+	parliaEpoch = 200
+
+	// parliaValidatorBytesLength is the length of a validator in the validator set of a BSC epoch header
+	parliaValidatorBytesLength = ethcommon.AddressLength
+
+	// parliaValidatorBytesLengthWithBLS is the length of a validator with its BLS public key since the Luban fork
+	parliaValidatorBytesLengthWithBLS = ethcommon.AddressLength + 48
+
+	// parliaDiffInTurn and parliaDiffNoTurn are the difficulties of a BSC block sealed by an in-turn or out-of-turn validator
+	parliaDiffInTurn = 2
+	parliaDiffNoTurn = 1
+)
+
+// evmHeaderValidator validates the block headers of an EVM chain
+type evmHeaderValidator struct {
+	// maxHeaderLen is the maximum length of the RLP encoded block header
+	maxHeaderLen int
+
+	// validateConsensus validates the fields of the block header specific to the consensus of the chain
+	validateConsensus func(header *ethtypes.Header, chainID int64) error
+}
+
+// getEVMHeaderValidator returns the block header validator of an EVM chain
+func getEVMHeaderValidator(chainID int64) evmHeaderValidator {
+	switch {
+	case IsBscChain(chainID):
+		return evmHeaderValidator{
+			maxHeaderLen:      parliaMaxHeaderLen,
+			validateConsensus: validateParliaHeader,
+		}
+	case IsPolygonChain(chainID):
+		return evmHeaderValidator{
+			maxHeaderLen:      borMaxHeaderLen,
+			validateConsensus: validateBorHeader,
+		}
+	default:
+		return evmHeaderValidator{
+			maxHeaderLen: ethereumMaxHeaderLen,
+		}
+	}
+}
+
+// validateEVMHeader performs a basic validation of the header of an EVM chain
+// and validates the fields specific to the consensus of the chain
+func validateEVMHeader(headerBytes []byte, blockHash []byte, chainID int64, height int64) error {
+	validator := getEVMHeaderValidator(chainID)
+	if len(headerBytes) > validator.maxHeaderLen {
+		return fmt.Errorf("header too long (%d)", len(headerBytes))
+	}
+	header, err := validateEthereumHeader(headerBytes, blockHash, height)
+	if err != nil {
+		return err
+	}
+	if validator.validateConsensus != nil {
+		return validator.validateConsensus(header, chainID)
+	}
+	return nil
+}
+
+// validateParliaHeader validates the fields of a BSC block header specific to the Parlia consensus
+// the header must be sealed by its coinbase, the validator set of the chain is not checked
+func validateParliaHeader(header *ethtypes.Header, chainID int64) error {
+	if err := validateSealedHeader(header); err != nil {
+		return err
+	}
+	if header.Number.Sign() > 0 &&
+		header.Difficulty.Cmp(big.NewInt(parliaDiffInTurn)) != 0 &&
+		header.Difficulty.Cmp(big.NewInt(parliaDiffNoTurn)) != 0 {
+		return fmt.Errorf("invalid difficulty (%s)", header.Difficulty)
+	}
+
+	// epoch headers contain the new validator set
+	if header.Number.Uint64()%parliaEpoch == 0 {
+		validatorBytes := header.Extra[extraVanity : len(header.Extra)-extraSeal]
+		if !isParliaValidatorSet(validatorBytes) {
+			return fmt.Errorf("invalid validator set in epoch header (%d bytes)", len(validatorBytes))
+		}
+	}
+
+	signer, err := recoverSigner(parliaSealHash(header, chainID), header.Extra[len(header.Extra)-extraSeal:])
+	if err != nil {
+		return err
+	}
+	if signer != header.Coinbase {
+		return fmt.Errorf("signer mismatch (%s) vs coinbase (%s)", signer.Hex(), header.Coinbase.Hex())
+	}
+	return nil
+}
+
+// isParliaValidatorSet returns true if the bytes are a validator set of a BSC epoch header
+// the validators are either a list of addresses, or since the Luban fork a count followed by addresses and BLS public keys
+func isParliaValidatorSet(validatorBytes []byte) bool {
+	if len(validatorBytes) == 0 {
+		return false
+	}
+	if len(validatorBytes)%parliaValidatorBytesLength == 0 {
+		return true
+	}
+	count := int(validatorBytes[0])
+	if count == 0 {
+		return false
+	}
+	// the turn length of the validators may follow the validator set since the Bohr fork
+	length := 1 + count*parliaValidatorBytesLengthWithBLS
+	return len(validatorBytes) == length || len(validatorBytes) == length+1
+}
+
+// parliaSealHash returns the hash of a BSC block header signed by the validator, the chain ID is part of the hash
+func parliaSealHash(header *ethtypes.Header, chainID int64) ethcommon.Hash {
+	b, err := rlp.EncodeToBytes([]interface{}{
+		big.NewInt(chainID),
+		header.ParentHash,
+		header.UncleHash,
+		header.Coinbase,
+		header.Root,
+		header.TxHash,
+		header.ReceiptHash,
+		header.Bloom,
+		header.Difficulty,
+		header.Number,
+		header.GasLimit,
+		header.GasUsed,
+		header.Time,
+		header.Extra[:len(header.Extra)-extraSeal],
+		header.MixDigest,
+		header.Nonce,
+	})
+	if err != nil {
+		return ethcommon.Hash{}
+	}
+	return crypto.Keccak256Hash(b)
+}
+
+// validateBorHeader validates the fields of a Polygon block header specific to the Bor consensus
+// the header must be sealed with a valid signature, the validator set of the chain is not checked
+func validateBorHeader(header *ethtypes.Header, _ int64) error {
+	if err := validateSealedHeader(header); err != nil {
+		return err
+	}
+	if header.Number.Sign() > 0 && header.Difficulty.Sign() <= 0 {
+		return fmt.Errorf("invalid difficulty (%s)", header.Difficulty)
+	}
+	_, err := recoverSigner(borSealHash(header), header.Extra[len(header.Extra)-extraSeal:])
+	return err
+}
+
+// borSealHash returns the hash of a Polygon block header signed by the validator
+func borSealHash(header *ethtypes.Header) ethcommon.Hash {
+	enc := []interface{}{
+		header.ParentHash,
+		header.UncleHash,
+		header.Coinbase,
+		header.Root,
+		header.TxHash,
+		header.ReceiptHash,
+		header.Bloom,
+		header.Difficulty,
+		header.Number,
+		header.GasLimit,
+		header.GasUsed,
+		header.Time,
+		header.Extra[:len(header.Extra)-extraSeal],
+		header.MixDigest,
+		header.Nonce,
+	}
+	if header.BaseFee != nil {
+		enc = append(enc, header.BaseFee)
+	}
+	b, err := rlp.EncodeToBytes(enc)
+	if err != nil {
+		return ethcommon.Hash{}
+	}
+	return crypto.Keccak256Hash(b)
+}
+
+// validateSealedHeader validates the fields shared by the headers of the chains sealed by a validator
+func validateSealedHeader(header *ethtypes.Header) error {
+	if len(header.Extra) < extraVanity {
+		return errors.New("missing vanity in extra data")
+	}
+	if len(header.Extra) < extraVanity+extraSeal {
+		return errors.New("missing signature in extra data")
+	}
+	if header.MixDigest != (ethcommon.Hash{}) {
+		return fmt.Errorf("non-zero mix digest (%s)", header.MixDigest.Hex())
+	}
+	if header.UncleHash != ethtypes.EmptyUncleHash {
+		return fmt.Errorf("non-empty uncle hash (%s)", header.UncleHash.Hex())
+	}
+	return nil
+}
+
+// recoverSigner recovers the address of the signer of the seal hash
+func recoverSigner(sealHash ethcommon.Hash, signature []byte) (ethcommon.Address, error) {
+	pubKey, err := crypto.SigToPub(sealHash.Bytes(), signature)
+	if err != nil {
+		return ethcommon.Address{}, fmt.Errorf("cannot recover signer (%s)", err)
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
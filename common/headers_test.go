@@ -15,6 +15,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/rpcclient"
 	"github.com/btcsuite/btcd/wire"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/stretchr/testify/require"
@@ -75,6 +76,165 @@ func TestFalseEthereumHeader(t *testing.T) {
 	require.Error(t, err)
 }
 
+// NOTE: the BSC and Polygon headers in testdata are synthetic headers following the format of the chains,
+// they are sealed with a test key so the signature checks can be tested without network access
+
+func TestTrueBscHeader(t *testing.T) {
+	for _, height := range []int64{33000000, 33000001} {
+		header := loadEVMHeader(t, fmt.Sprintf("./testdata/bsc_header_%d.json", height))
+		headerData := common.NewEthereumHeader(encodeEVMHeader(t, header))
+		err := headerData.Validate(header.Hash().Bytes(), 56, height)
+		require.NoError(t, err)
+	}
+}
+
+func TestFalseBscHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		chainID int64
+		height  int64
+		mutate  func(header *ethtypes.Header)
+	}{
+		{
+			name:    "wrong chain id in seal",
+			file:    "./testdata/bsc_header_33000001.json",
+			chainID: 97,
+			height:  33000001,
+			mutate:  func(header *ethtypes.Header) {},
+		},
+		{
+			name:    "coinbase is not the signer",
+			file:    "./testdata/bsc_header_33000001.json",
+			chainID: 56,
+			height:  33000001,
+			mutate: func(header *ethtypes.Header) {
+				header.Coinbase = ethcommon.HexToAddress("0x1")
+			},
+		},
+		{
+			name:    "invalid difficulty",
+			file:    "./testdata/bsc_header_33000001.json",
+			chainID: 56,
+			height:  33000001,
+			mutate: func(header *ethtypes.Header) {
+				header.Difficulty = big.NewInt(3)
+			},
+		},
+		{
+			name:    "non-zero mix digest",
+			file:    "./testdata/bsc_header_33000001.json",
+			chainID: 56,
+			height:  33000001,
+			mutate: func(header *ethtypes.Header) {
+				header.MixDigest = ethcommon.HexToHash("0x1")
+			},
+		},
+		{
+			name:    "non-empty uncle hash",
+			file:    "./testdata/bsc_header_33000001.json",
+			chainID: 56,
+			height:  33000001,
+			mutate: func(header *ethtypes.Header) {
+				header.UncleHash = ethcommon.HexToHash("0x1")
+			},
+		},
+		{
+			name:    "missing signature",
+			file:    "./testdata/bsc_header_33000001.json",
+			chainID: 56,
+			height:  33000001,
+			mutate: func(header *ethtypes.Header) {
+				header.Extra = header.Extra[:32]
+			},
+		},
+		{
+			name:    "tampered header",
+			file:    "./testdata/bsc_header_33000001.json",
+			chainID: 56,
+			height:  33000001,
+			mutate: func(header *ethtypes.Header) {
+				header.GasUsed++
+			},
+		},
+		{
+			name:    "invalid validator set in epoch header",
+			file:    "./testdata/bsc_header_33000000.json",
+			chainID: 56,
+			height:  33000000,
+			mutate: func(header *ethtypes.Header) {
+				header.Extra = append(append(append([]byte{}, header.Extra[:32]...), 3, 1, 2), header.Extra[len(header.Extra)-65:]...)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := loadEVMHeader(t, tt.file)
+			tt.mutate(header)
+			headerData := common.NewEthereumHeader(encodeEVMHeader(t, header))
+			err := headerData.Validate(header.Hash().Bytes(), tt.chainID, tt.height)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestTruePolygonHeader(t *testing.T) {
+	header := loadEVMHeader(t, "./testdata/polygon_header_50000000.json")
+	headerData := common.NewEthereumHeader(encodeEVMHeader(t, header))
+	err := headerData.Validate(header.Hash().Bytes(), 137, 50000000)
+	require.NoError(t, err)
+}
+
+func TestFalsePolygonHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(header *ethtypes.Header)
+	}{
+		{
+			name: "zero difficulty",
+			mutate: func(header *ethtypes.Header) {
+				header.Difficulty = big.NewInt(0)
+			},
+		},
+		{
+			name: "non-zero mix digest",
+			mutate: func(header *ethtypes.Header) {
+				header.MixDigest = ethcommon.HexToHash("0x1")
+			},
+		},
+		{
+			name: "missing signature",
+			mutate: func(header *ethtypes.Header) {
+				header.Extra = header.Extra[:32]
+			},
+		},
+		{
+			name: "invalid signature",
+			mutate: func(header *ethtypes.Header) {
+				header.Extra = append(header.Extra[:len(header.Extra)-1:len(header.Extra)-1], 0xff)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := loadEVMHeader(t, "./testdata/polygon_header_50000000.json")
+			tt.mutate(header)
+			headerData := common.NewEthereumHeader(encodeEVMHeader(t, header))
+			err := headerData.Validate(header.Hash().Bytes(), 137, 50000000)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("hash mismatch", func(t *testing.T) {
+		header := loadEVMHeader(t, "./testdata/polygon_header_50000000.json")
+		hash := header.Hash()
+		header.Number = big.NewInt(50000001)
+		headerData := common.NewEthereumHeader(encodeEVMHeader(t, header))
+		err := headerData.Validate(hash.Bytes(), 137, 50000001)
+		require.Error(t, err)
+	})
+}
+
 func TestTrueBitcoinHeader(t *testing.T) {
 	blocks := LoadTestBlocks(t)
 
@@ -103,6 +263,25 @@ func TestFakeBitcoinHeader(t *testing.T) {
 	}
 }
 
+func TestParseBitcoinHeader(t *testing.T) {
+	blocks := LoadTestBlocks(t)
+
+	for _, b := range blocks.Blocks {
+		headerBytes, err := base64.StdEncoding.DecodeString(b.HeaderBase64)
+		require.NoError(t, err)
+		header := unmarshalHeader(t, headerBytes)
+
+		parsed, err := common.NewBitcoinHeader(headerBytes).ParseBitcoinHeader()
+		require.NoError(t, err)
+		require.Equal(t, header.BlockHash(), parsed.BlockHash())
+	}
+
+	_, err := common.NewEthereumHeader([]byte("header")).ParseBitcoinHeader()
+	require.Error(t, err)
+	_, err = common.NewBitcoinHeader([]byte{1, 2, 3}).ParseBitcoinHeader()
+	require.Error(t, err)
+}
+
 func BitcoinHeaderValidationLiveTest(t *testing.T) {
 	client := createBTCClient(t)
 	bn, err := client.GetBlockCount()
@@ -225,3 +404,19 @@ func validateFakeBitcoinHeader(t *testing.T, header *wire.BlockHeader, headerByt
 	err = common.ValidateBitcoinHeader(fakeBytes, fakeHash[:], 18332)
 	require.Error(t, err)
 }
+
+func loadEVMHeader(t *testing.T, path string) *ethtypes.Header {
+	headerJSON, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var header ethtypes.Header
+	err = header.UnmarshalJSON(headerJSON)
+	require.NoError(t, err)
+	return &header
+}
+
+func encodeEVMHeader(t *testing.T, header *ethtypes.Header) []byte {
+	var buffer bytes.Buffer
+	err := header.EncodeRLP(&buffer)
+	require.NoError(t, err)
+	return buffer.Bytes()
+}
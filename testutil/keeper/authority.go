@@ -34,6 +34,7 @@ func initAuthorityKeeper(
 		storeKey,
 		memKey,
 		AuthorityGovAddress,
+		nil,
 	)
 }
 
@@ -68,12 +69,23 @@ func AuthorityKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		storeKey,
 		memStoreKey,
 		AuthorityGovAddress,
+		nil,
 	)
 
 	return &k, ctx
 }
 
-// MockIsAuthorized mocks the IsAuthorized method of an authority keeper mock
-func MockIsAuthorized(m *mock.Mock, address string, policyType types.PolicyType, isAuthorized bool) {
-	m.On("IsAuthorized", mock.Anything, address, policyType).Return(isAuthorized).Once()
+// MockIsAuthorizedForMsgWithPolicy mocks the IsAuthorizedForMsgWithPolicy method of an authority keeper mock for any message
+func MockIsAuthorizedForMsgWithPolicy(m *mock.Mock, address string, policyType types.PolicyType, isAuthorized bool) {
+	m.On("IsAuthorizedForMsgWithPolicy", mock.Anything, address, mock.Anything, policyType).Return(isAuthorized).Once()
+}
+
+// MockIsAuthorizedForMsg mocks the IsAuthorizedForMsg method of an authority keeper mock for any message and chain
+func MockIsAuthorizedForMsg(m *mock.Mock, address string, isAuthorized bool) {
+	m.On("IsAuthorizedForMsg", mock.Anything, address, mock.Anything, mock.Anything).Return(isAuthorized).Once()
+}
+
+// MockIsAuthorizedForMsgForChain mocks the IsAuthorizedForMsg method of an authority keeper mock for any message and the chain
+func MockIsAuthorizedForMsgForChain(m *mock.Mock, address string, chainID int64, isAuthorized bool) {
+	m.On("IsAuthorizedForMsg", mock.Anything, address, mock.Anything, chainID).Return(isAuthorized).Once()
 }
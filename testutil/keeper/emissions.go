@@ -15,10 +15,11 @@ import (
 )
 
 type EmissionMockOptions struct {
-	UseBankMock     bool
-	UseStakingMock  bool
-	UseObserverMock bool
-	UseAccountMock  bool
+	UseBankMock      bool
+	UseStakingMock   bool
+	UseObserverMock  bool
+	UseAccountMock   bool
+	UseAuthorityMock bool
 }
 
 func EmissionsKeeper(t testing.TB) (*keeper.Keeper, sdk.Context, SDKKeepers, ZetaKeepers) {
@@ -45,6 +46,7 @@ func EmissionKeeperWithMockOptions(
 	sdkKeepers := NewSDKKeepers(cdc, db, stateStore)
 
 	// Create zeta keepers
+	authorityKeeperTmp := initAuthorityKeeper(cdc, db, stateStore)
 	observerKeeperTmp := initObserverKeeper(
 		cdc,
 		db,
@@ -52,13 +54,15 @@ func EmissionKeeperWithMockOptions(
 		sdkKeepers.StakingKeeper,
 		sdkKeepers.SlashingKeeper,
 		sdkKeepers.ParamsKeeper,
-		initAuthorityKeeper(cdc, db, stateStore),
+		authorityKeeperTmp,
 	)
 
 	zetaKeepers := ZetaKeepers{
-		ObserverKeeper: observerKeeperTmp,
+		ObserverKeeper:  observerKeeperTmp,
+		AuthorityKeeper: &authorityKeeperTmp,
 	}
 	var observerKeeper types.ObserverKeeper = observerKeeperTmp
+	var authorityKeeper types.AuthorityKeeper = authorityKeeperTmp
 
 	// Create the fungible keeper
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
@@ -90,6 +94,9 @@ func EmissionKeeperWithMockOptions(
 	if mockOptions.UseObserverMock {
 		observerKeeper = emissionsmocks.NewEmissionObserverKeeper(t)
 	}
+	if mockOptions.UseAuthorityMock {
+		authorityKeeper = emissionsmocks.NewEmissionAuthorityKeeper(t)
+	}
 
 	k := keeper.NewKeeper(
 		cdc,
@@ -101,6 +108,7 @@ func EmissionKeeperWithMockOptions(
 		stakingKeeper,
 		observerKeeper,
 		authKeeper,
+		authorityKeeper,
 	)
 	k.SetParams(ctx, types.DefaultParams())
 
@@ -112,3 +120,9 @@ func GetEmissionsBankMock(t testing.TB, keeper *keeper.Keeper) *emissionsmocks.E
 	require.True(t, ok)
 	return cbk
 }
+
+func GetEmissionsAuthorityMock(t testing.TB, keeper *keeper.Keeper) *emissionsmocks.EmissionAuthorityKeeper {
+	cok, ok := keeper.GetAuthorityKeeper().(*emissionsmocks.EmissionAuthorityKeeper)
+	require.True(t, ok)
+	return cok
+}
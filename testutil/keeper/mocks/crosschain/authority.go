@@ -32,6 +32,24 @@ func (_m *CrosschainAuthorityKeeper) IsAuthorized(ctx types.Context, address str
 	return r0
 }
 
+// IsAuthorizedForMsg provides a mock function with given fields: ctx, address, msg, chainID
+func (_m *CrosschainAuthorityKeeper) IsAuthorizedForMsg(ctx types.Context, address string, msg types.Msg, chainID int64) bool {
+	ret := _m.Called(ctx, address, msg, chainID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAuthorizedForMsg")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, string, types.Msg, int64) bool); ok {
+		r0 = rf(ctx, address, msg, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // NewCrosschainAuthorityKeeper creates a new instance of CrosschainAuthorityKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewCrosschainAuthorityKeeper(t interface {
@@ -329,6 +329,34 @@ func (_m *CrosschainObserverKeeper) GetBlockHeader(ctx types.Context, hash []byt
 	return r0, r1
 }
 
+// GetBlockHeaderConfirmations provides a mock function with given fields: ctx, chainID, hash
+func (_m *CrosschainObserverKeeper) GetBlockHeaderConfirmations(ctx types.Context, chainID int64, hash []byte) (int64, bool) {
+	ret := _m.Called(ctx, chainID, hash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockHeaderConfirmations")
+	}
+
+	var r0 int64
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(types.Context, int64, []byte) (int64, bool)); ok {
+		return rf(ctx, chainID, hash)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, int64, []byte) int64); ok {
+		r0 = rf(ctx, chainID, hash)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, int64, []byte) bool); ok {
+		r1 = rf(ctx, chainID, hash)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetChainNonces provides a mock function with given fields: ctx, index
 func (_m *CrosschainObserverKeeper) GetChainNonces(ctx types.Context, index string) (observertypes.ChainNonces, bool) {
 	ret := _m.Called(ctx, index)
@@ -740,11 +768,21 @@ func (_m *CrosschainObserverKeeper) RemoveAllExistingMigrators(ctx types.Context
 	_m.Called(ctx)
 }
 
+// RemoveBallot provides a mock function with given fields: ctx, index
+func (_m *CrosschainObserverKeeper) RemoveBallot(ctx types.Context, index string) {
+	_m.Called(ctx, index)
+}
+
 // RemoveFromPendingNonces provides a mock function with given fields: ctx, tss, chainID, nonce
 func (_m *CrosschainObserverKeeper) RemoveFromPendingNonces(ctx types.Context, tss string, chainID int64, nonce int64) {
 	_m.Called(ctx, tss, chainID, nonce)
 }
 
+// RemoveNonceToCctx provides a mock function with given fields: ctx, nonceToCctx
+func (_m *CrosschainObserverKeeper) RemoveNonceToCctx(ctx types.Context, nonceToCctx observertypes.NonceToCctx) {
+	_m.Called(ctx, nonceToCctx)
+}
+
 // SetChainNonces provides a mock function with given fields: ctx, chainNonces
 func (_m *CrosschainObserverKeeper) SetChainNonces(ctx types.Context, chainNonces observertypes.ChainNonces) {
 	_m.Called(ctx, chainNonces)
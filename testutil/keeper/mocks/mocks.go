@@ -94,6 +94,11 @@ type EmissionObserverKeeper interface {
 	emissionstypes.ObserverKeeper
 }
 
+//go:generate mockery --name EmissionAuthorityKeeper --filename authority.go --case underscore --output ./emissions
+type EmissionAuthorityKeeper interface {
+	emissionstypes.AuthorityKeeper
+}
+
 /**
  * Observer Mocks
  */
@@ -14,17 +14,35 @@ type ObserverAuthorityKeeper struct {
 	mock.Mock
 }
 
-// IsAuthorized provides a mock function with given fields: ctx, address, policyType
-func (_m *ObserverAuthorityKeeper) IsAuthorized(ctx types.Context, address string, policyType authoritytypes.PolicyType) bool {
-	ret := _m.Called(ctx, address, policyType)
+// IsAuthorizedForMsg provides a mock function with given fields: ctx, address, msg, chainID
+func (_m *ObserverAuthorityKeeper) IsAuthorizedForMsg(ctx types.Context, address string, msg types.Msg, chainID int64) bool {
+	ret := _m.Called(ctx, address, msg, chainID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsAuthorized")
+		panic("no return value specified for IsAuthorizedForMsg")
 	}
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(types.Context, string, authoritytypes.PolicyType) bool); ok {
-		r0 = rf(ctx, address, policyType)
+	if rf, ok := ret.Get(0).(func(types.Context, string, types.Msg, int64) bool); ok {
+		r0 = rf(ctx, address, msg, chainID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsAuthorizedForMsgWithPolicy provides a mock function with given fields: ctx, address, msg, policyType
+func (_m *ObserverAuthorityKeeper) IsAuthorizedForMsgWithPolicy(ctx types.Context, address string, msg types.Msg, policyType authoritytypes.PolicyType) bool {
+	ret := _m.Called(ctx, address, msg, policyType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsAuthorizedForMsgWithPolicy")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, string, types.Msg, authoritytypes.PolicyType) bool); ok {
+		r0 = rf(ctx, address, msg, policyType)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
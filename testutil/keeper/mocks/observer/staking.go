@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	math "cosmossdk.io/math"
 	mock "github.com/stretchr/testify/mock"
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -76,6 +77,24 @@ func (_m *ObserverStakingKeeper) SetValidator(ctx types.Context, validator staki
 	_m.Called(ctx, validator)
 }
 
+// Slash provides a mock function with given fields: ctx, consAddr, infractionHeight, power, slashFactor
+func (_m *ObserverStakingKeeper) Slash(ctx types.Context, consAddr types.ConsAddress, infractionHeight int64, power int64, slashFactor types.Dec) math.Int {
+	ret := _m.Called(ctx, consAddr, infractionHeight, power, slashFactor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Slash")
+	}
+
+	var r0 math.Int
+	if rf, ok := ret.Get(0).(func(types.Context, types.ConsAddress, int64, int64, types.Dec) math.Int); ok {
+		r0 = rf(ctx, consAddr, infractionHeight, power, slashFactor)
+	} else {
+		r0 = ret.Get(0).(math.Int)
+	}
+
+	return r0
+}
+
 // NewObserverStakingKeeper creates a new instance of ObserverStakingKeeper. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewObserverStakingKeeper(t interface {
@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
@@ -149,6 +150,10 @@ func (m *ObserverMockStakingKeeper) MockGetValidator(validator stakingtypes.Vali
 	m.On("GetValidator", mock.Anything, mock.Anything).Return(validator, true)
 }
 
+func (m *ObserverMockStakingKeeper) MockSlash(slashedAmount sdkmath.Int) {
+	m.On("Slash", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(slashedAmount)
+}
+
 // GetObserverSlashingMock returns a new observer slashing keeper mock
 func GetObserverSlashingMock(t testing.TB, keeper *keeper.Keeper) *ObserverMockSlashingKeeper {
 	k, ok := keeper.GetSlashingKeeper().(*observermocks.ObserverSlashingKeeper)
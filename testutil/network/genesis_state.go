@@ -158,6 +158,9 @@ func AddObserverData(t *testing.T, n int, genesisState map[string]json.RawMessag
 		IsOutboundEnabled:            true,
 		GasPriceIncreaseFlags:        &observertypes.DefaultGasPriceIncreaseFlags,
 		BlockHeaderVerificationFlags: &observertypes.DefaultBlockHeaderVerificationFlags,
+		PruningFlags:                 &observertypes.DefaultPruningFlags,
+		ChainFlags:                   []observertypes.ChainFlags{},
+		ForeignCoinFlags:             []observertypes.ForeignCoinFlags{},
 	}
 	nullify.Fill(&crosschainFlags)
 	state.CrosschainFlags = crosschainFlags
@@ -187,6 +190,7 @@ func AddCrosschainData(t *testing.T, n int, genesisState map[string]json.RawMess
 				Status:              types.CctxStatus_PendingInbound,
 				StatusMessage:       "",
 				LastUpdateTimestamp: 0,
+				History:             []*types.StatusTransition{},
 			},
 			InboundTxParams:  &types.InboundTxParams{InboundTxObservedHash: fmt.Sprintf("Hash-%d", i), Amount: math.OneUint()},
 			OutboundTxParams: []*types.OutboundTxParams{},
@@ -195,7 +199,7 @@ func AddCrosschainData(t *testing.T, n int, genesisState map[string]json.RawMess
 	}
 
 	for i := 0; i < n; i++ {
-		state.GasPriceList = append(state.GasPriceList, &types.GasPrice{Creator: "ANY", ChainId: int64(i), Index: strconv.Itoa(i), Prices: []uint64{}, BlockNums: []uint64{}, Signers: []string{}})
+		state.GasPriceList = append(state.GasPriceList, &types.GasPrice{Creator: "ANY", ChainId: int64(i), Index: strconv.Itoa(i), Prices: []uint64{}, BlockNums: []uint64{}, Signers: []string{}, PriorityFees: []uint64{}})
 	}
 	for i := 0; i < n; i++ {
 		state.LastBlockHeightList = append(state.LastBlockHeightList, &types.LastBlockHeight{Creator: "ANY", Index: strconv.Itoa(i)})
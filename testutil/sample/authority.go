@@ -1,6 +1,13 @@
 package sample
 
-import authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
 
 func Policies() authoritytypes.Policies {
 	return authoritytypes.Policies{
@@ -16,3 +23,67 @@ func Policies() authoritytypes.Policies {
 		},
 	}
 }
+
+// AuthorizationList returns a sample authorization list authorizing the admin policy and an address for a message
+func AuthorizationList(msgURL string) authoritytypes.AuthorizationList {
+	return authoritytypes.AuthorizationList{
+		Authorizations: []authoritytypes.Authorization{
+			{
+				MsgUrl:     msgURL,
+				PolicyType: authoritytypes.PolicyType_groupAdmin,
+			},
+			{
+				MsgUrl:  msgURL,
+				ChainId: 1,
+				Address: AccAddress(),
+			},
+		},
+	}
+}
+
+// QueuedAction returns a sample queued action updating the system contract
+func QueuedAction(t *testing.T, id uint64, executionHeight int64) authoritytypes.QueuedAction {
+	signer := AccAddress()
+	action, err := authoritytypes.NewQueuedAction(
+		id,
+		signer,
+		fungibletypes.NewMsgUpdateSystemContract(signer, EthAddress().Hex()),
+		executionHeight-100,
+		executionHeight,
+	)
+	require.NoError(t, err)
+	return action
+}
+
+// AuditEntry returns a sample audit entry of a message signed by the signer
+func AuditEntry(t *testing.T, id uint64, signer string, msg sdk.Msg) authoritytypes.AuditEntry {
+	entry, err := authoritytypes.NewAuditEntry(id, authoritytypes.PolicyType_groupAdmin, false, signer, msg, 100)
+	require.NoError(t, err)
+	return entry
+}
+
+// ThresholdPolicy returns a sample threshold policy of the policy type with the members and the threshold
+func ThresholdPolicy(policyType authoritytypes.PolicyType, members []string, threshold uint32) *authoritytypes.Policy {
+	return &authoritytypes.Policy{
+		PolicyType:           policyType,
+		Address:              authoritytypes.ThresholdPolicyAddress(policyType).String(),
+		Members:              members,
+		Threshold:            threshold,
+		ProposalExpiryBlocks: 100,
+	}
+}
+
+// ThresholdProposal returns a sample proposal of the admin threshold policy updating the system contract
+func ThresholdProposal(t *testing.T, id uint64, expiryHeight int64) authoritytypes.ThresholdProposal {
+	policyAddress := authoritytypes.ThresholdPolicyAddress(authoritytypes.PolicyType_groupAdmin).String()
+	proposal, err := authoritytypes.NewThresholdProposal(
+		id,
+		authoritytypes.PolicyType_groupAdmin,
+		AccAddress(),
+		fungibletypes.NewMsgUpdateSystemContract(policyAddress, EthAddress().Hex()),
+		expiryHeight-100,
+		expiryHeight,
+	)
+	require.NoError(t, err)
+	return proposal
+}
@@ -1,7 +1,14 @@
 package sample
 
 import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/zeta-chain/zetacore/common"
 )
@@ -27,3 +34,36 @@ func EventIndex() uint64 {
 	r := newRandFromSeed(1)
 	return r.Uint64()
 }
+
+// BitcoinBlockHeader returns a bitcoin block header extending the parent block hash with the given difficulty bits
+// the proof-of-work of the header is not valid
+func BitcoinBlockHeader(
+	t *testing.T,
+	chainID int64,
+	height int64,
+	parentHash []byte,
+	timestamp time.Time,
+	bits uint32,
+) common.BlockHeader {
+	prevBlock, err := chainhash.NewHash(parentHash)
+	require.NoError(t, err)
+
+	header := wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  *prevBlock,
+		MerkleRoot: chainhash.Hash(Hash()),
+		Timestamp:  timestamp,
+		Bits:       bits,
+	}
+	var headerBuf bytes.Buffer
+	require.NoError(t, header.Serialize(&headerBuf))
+	blockHash := header.BlockHash()
+
+	return common.BlockHeader{
+		Header:     common.NewBitcoinHeader(headerBuf.Bytes()),
+		Height:     height,
+		Hash:       blockHash[:],
+		ParentHash: parentHash,
+		ChainId:    chainID,
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
@@ -125,6 +126,21 @@ func ZetaAccounting(t *testing.T, index string) types.ZetaAccounting {
 	}
 }
 
+func RateLimit(t *testing.T, index string) types.RateLimit {
+	r := newRandFromStringSeed(t, index)
+	zrc20 := make([]byte, 20)
+	_, err := r.Read(zrc20)
+	require.NoError(t, err)
+
+	return types.RateLimit{
+		ChainId:      r.Int63n(1000) + 1,
+		Zrc20Address: ethcommon.BytesToAddress(zrc20).Hex(),
+		Limit:        math.NewUint(uint64(r.Int63())),
+		Window:       r.Int63n(1000) + 1,
+		Action:       types.RateLimitAction(r.Intn(2)),
+	}
+}
+
 func InboundVote(coinType common.CoinType, from, to int64) types.MsgVoteOnObservedInboundTx {
 	return types.MsgVoteOnObservedInboundTx{
 		Creator:       "",
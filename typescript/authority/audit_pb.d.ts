@@ -0,0 +1,73 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file authority/audit.proto (package zetachain.zetacore.authority, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import type { PolicyType } from "./policies_pb.js";
+
+/**
+ * AuditEntry records a message whose signer passed an authority check
+ *
+ * @generated from message zetachain.zetacore.authority.AuditEntry
+ */
+export declare class AuditEntry extends Message<AuditEntry> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * policy that authorized the signer, address if the signer is authorized by an authorization of its address
+   *
+   * @generated from field: zetachain.zetacore.authority.PolicyType policy_type = 2;
+   */
+  policyType: PolicyType;
+
+  /**
+   * true if the signer is authorized by an authorization of its address rather than by a policy
+   *
+   * @generated from field: bool authorized_by_address = 3;
+   */
+  authorizedByAddress: boolean;
+
+  /**
+   * @generated from field: string signer = 4;
+   */
+  signer: string;
+
+  /**
+   * type URL of the message, e.g. /zetachain.zetacore.fungible.MsgUpdateZRC20WithdrawFee
+   *
+   * @generated from field: string msg_type_url = 5;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * hex encoded sha256 hash of the protobuf encoding of the message
+   *
+   * @generated from field: string msg_hash = 6;
+   */
+  msgHash: string;
+
+  /**
+   * @generated from field: int64 block_height = 7;
+   */
+  blockHeight: bigint;
+
+  constructor(data?: PartialMessage<AuditEntry>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.AuditEntry";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEntry;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEntry;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEntry;
+
+  static equals(a: AuditEntry | PlainMessage<AuditEntry> | undefined, b: AuditEntry | PlainMessage<AuditEntry> | undefined): boolean;
+}
+
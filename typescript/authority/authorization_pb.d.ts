@@ -0,0 +1,86 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file authority/authorization.proto (package zetachain.zetacore.authority, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import type { PolicyType } from "./policies_pb.js";
+
+/**
+ * Authorization authorizes an address to execute a message
+ * the authorized address is the address of the policy if no address is set
+ * the authorization can be scoped to a chain for the messages targeting a chain
+ *
+ * @generated from message zetachain.zetacore.authority.Authorization
+ */
+export declare class Authorization extends Message<Authorization> {
+  /**
+   * type URL of the message, e.g. /zetachain.zetacore.fungible.MsgUpdateZRC20WithdrawFee
+   *
+   * @generated from field: string msg_url = 1;
+   */
+  msgUrl: string;
+
+  /**
+   * chain the authorization is scoped to, 0 for all chains
+   *
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * policy authorized to execute the message if no address is set
+   *
+   * @generated from field: zetachain.zetacore.authority.PolicyType policy_type = 3;
+   */
+  policyType: PolicyType;
+
+  /**
+   * address authorized to execute the message
+   *
+   * @generated from field: string address = 4;
+   */
+  address: string;
+
+  constructor(data?: PartialMessage<Authorization>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.Authorization";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Authorization;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Authorization;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Authorization;
+
+  static equals(a: Authorization | PlainMessage<Authorization> | undefined, b: Authorization | PlainMessage<Authorization> | undefined): boolean;
+}
+
+/**
+ * AuthorizationList contains the authorizations of the messages requiring an authority
+ *
+ * @generated from message zetachain.zetacore.authority.AuthorizationList
+ */
+export declare class AuthorizationList extends Message<AuthorizationList> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.Authorization authorizations = 1;
+   */
+  authorizations: Authorization[];
+
+  constructor(data?: PartialMessage<AuthorizationList>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.AuthorizationList";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuthorizationList;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuthorizationList;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuthorizationList;
+
+  static equals(a: AuthorizationList | PlainMessage<AuthorizationList> | undefined, b: AuthorizationList | PlainMessage<AuthorizationList> | undefined): boolean;
+}
+
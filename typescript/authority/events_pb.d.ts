@@ -0,0 +1,267 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file authority/events.proto (package zetachain.zetacore.authority, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import type { PolicyType } from "./policies_pb.js";
+
+/**
+ * @generated from message zetachain.zetacore.authority.EventTimelockedActionQueued
+ */
+export declare class EventTimelockedActionQueued extends Message<EventTimelockedActionQueued> {
+  /**
+   * @generated from field: uint64 action_id = 1;
+   */
+  actionId: bigint;
+
+  /**
+   * @generated from field: string msg_type_url = 2;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string signer = 3;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: int64 execution_height = 4;
+   */
+  executionHeight: bigint;
+
+  constructor(data?: PartialMessage<EventTimelockedActionQueued>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.EventTimelockedActionQueued";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventTimelockedActionQueued;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventTimelockedActionQueued;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventTimelockedActionQueued;
+
+  static equals(a: EventTimelockedActionQueued | PlainMessage<EventTimelockedActionQueued> | undefined, b: EventTimelockedActionQueued | PlainMessage<EventTimelockedActionQueued> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.authority.EventTimelockedActionCancelled
+ */
+export declare class EventTimelockedActionCancelled extends Message<EventTimelockedActionCancelled> {
+  /**
+   * @generated from field: uint64 action_id = 1;
+   */
+  actionId: bigint;
+
+  /**
+   * @generated from field: string msg_type_url = 2;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string signer = 3;
+   */
+  signer: string;
+
+  constructor(data?: PartialMessage<EventTimelockedActionCancelled>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.EventTimelockedActionCancelled";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventTimelockedActionCancelled;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventTimelockedActionCancelled;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventTimelockedActionCancelled;
+
+  static equals(a: EventTimelockedActionCancelled | PlainMessage<EventTimelockedActionCancelled> | undefined, b: EventTimelockedActionCancelled | PlainMessage<EventTimelockedActionCancelled> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.authority.EventTimelockedActionExecuted
+ */
+export declare class EventTimelockedActionExecuted extends Message<EventTimelockedActionExecuted> {
+  /**
+   * @generated from field: uint64 action_id = 1;
+   */
+  actionId: bigint;
+
+  /**
+   * @generated from field: string msg_type_url = 2;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: bool success = 3;
+   */
+  success: boolean;
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error: string;
+
+  constructor(data?: PartialMessage<EventTimelockedActionExecuted>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.EventTimelockedActionExecuted";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventTimelockedActionExecuted;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventTimelockedActionExecuted;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventTimelockedActionExecuted;
+
+  static equals(a: EventTimelockedActionExecuted | PlainMessage<EventTimelockedActionExecuted> | undefined, b: EventTimelockedActionExecuted | PlainMessage<EventTimelockedActionExecuted> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.authority.EventThresholdProposalSubmitted
+ */
+export declare class EventThresholdProposalSubmitted extends Message<EventThresholdProposalSubmitted> {
+  /**
+   * @generated from field: uint64 proposal_id = 1;
+   */
+  proposalId: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.PolicyType policy_type = 2;
+   */
+  policyType: PolicyType;
+
+  /**
+   * @generated from field: string msg_type_url = 3;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string proposer = 4;
+   */
+  proposer: string;
+
+  /**
+   * @generated from field: int64 expiry_height = 5;
+   */
+  expiryHeight: bigint;
+
+  constructor(data?: PartialMessage<EventThresholdProposalSubmitted>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.EventThresholdProposalSubmitted";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventThresholdProposalSubmitted;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventThresholdProposalSubmitted;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventThresholdProposalSubmitted;
+
+  static equals(a: EventThresholdProposalSubmitted | PlainMessage<EventThresholdProposalSubmitted> | undefined, b: EventThresholdProposalSubmitted | PlainMessage<EventThresholdProposalSubmitted> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.authority.EventThresholdProposalApproved
+ */
+export declare class EventThresholdProposalApproved extends Message<EventThresholdProposalApproved> {
+  /**
+   * @generated from field: uint64 proposal_id = 1;
+   */
+  proposalId: bigint;
+
+  /**
+   * @generated from field: string signer = 2;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: uint32 approvals = 3;
+   */
+  approvals: number;
+
+  constructor(data?: PartialMessage<EventThresholdProposalApproved>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.EventThresholdProposalApproved";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventThresholdProposalApproved;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventThresholdProposalApproved;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventThresholdProposalApproved;
+
+  static equals(a: EventThresholdProposalApproved | PlainMessage<EventThresholdProposalApproved> | undefined, b: EventThresholdProposalApproved | PlainMessage<EventThresholdProposalApproved> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.authority.EventThresholdProposalExecuted
+ */
+export declare class EventThresholdProposalExecuted extends Message<EventThresholdProposalExecuted> {
+  /**
+   * @generated from field: uint64 proposal_id = 1;
+   */
+  proposalId: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.PolicyType policy_type = 2;
+   */
+  policyType: PolicyType;
+
+  /**
+   * @generated from field: string msg_type_url = 3;
+   */
+  msgTypeUrl: string;
+
+  constructor(data?: PartialMessage<EventThresholdProposalExecuted>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.EventThresholdProposalExecuted";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventThresholdProposalExecuted;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventThresholdProposalExecuted;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventThresholdProposalExecuted;
+
+  static equals(a: EventThresholdProposalExecuted | PlainMessage<EventThresholdProposalExecuted> | undefined, b: EventThresholdProposalExecuted | PlainMessage<EventThresholdProposalExecuted> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.authority.EventThresholdProposalExpired
+ */
+export declare class EventThresholdProposalExpired extends Message<EventThresholdProposalExpired> {
+  /**
+   * @generated from field: uint64 proposal_id = 1;
+   */
+  proposalId: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.PolicyType policy_type = 2;
+   */
+  policyType: PolicyType;
+
+  /**
+   * @generated from field: string msg_type_url = 3;
+   */
+  msgTypeUrl: string;
+
+  constructor(data?: PartialMessage<EventThresholdProposalExpired>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.EventThresholdProposalExpired";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventThresholdProposalExpired;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventThresholdProposalExpired;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventThresholdProposalExpired;
+
+  static equals(a: EventThresholdProposalExpired | PlainMessage<EventThresholdProposalExpired> | undefined, b: EventThresholdProposalExpired | PlainMessage<EventThresholdProposalExpired> | undefined): boolean;
+}
+
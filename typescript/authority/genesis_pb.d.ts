@@ -6,6 +6,10 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { Policies } from "./policies_pb.js";
+import type { AuthorizationList } from "./authorization_pb.js";
+import type { QueuedAction, TimelockConfig } from "./timelock_pb.js";
+import type { AuditEntry } from "./audit_pb.js";
+import type { ThresholdProposal } from "./threshold_pb.js";
 
 /**
  * GenesisState defines the authority module's genesis state.
@@ -18,6 +22,31 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   policies?: Policies;
 
+  /**
+   * @generated from field: zetachain.zetacore.authority.AuthorizationList authorization_list = 2;
+   */
+  authorizationList?: AuthorizationList;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.TimelockConfig timelock_config = 3;
+   */
+  timelockConfig?: TimelockConfig;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.QueuedAction queued_actions = 4;
+   */
+  queuedActions: QueuedAction[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.AuditEntry audit_entries = 5;
+   */
+  auditEntries: AuditEntry[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.ThresholdProposal threshold_proposals = 6;
+   */
+  thresholdProposals: ThresholdProposal[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
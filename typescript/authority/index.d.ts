@@ -1,4 +1,9 @@
+export * from "./audit_pb";
+export * from "./authorization_pb";
+export * from "./events_pb";
 export * from "./genesis_pb";
 export * from "./policies_pb";
 export * from "./query_pb";
+export * from "./threshold_pb";
+export * from "./timelock_pb";
 export * from "./tx_pb";
@@ -21,9 +21,22 @@ export declare enum PolicyType {
    * @generated from enum value: groupAdmin = 1;
    */
   groupAdmin = 1,
+
+  /**
+   * address is not a policy, it is only set in the audit entries of the signers authorized by an authorization of
+   * their address
+   *
+   * @generated from enum value: address = 2;
+   */
+  address = 2,
 }
 
 /**
+ * Policy authorizes an address for a policy type
+ * a threshold policy is controlled by its members, its messages are submitted as proposals by the members and
+ * executed with the policy address as signer once the threshold of approvals is reached
+ * the address of a threshold policy is derived from the policy type so no key controls it
+ *
  * @generated from message zetachain.zetacore.authority.Policy
  */
 export declare class Policy extends Message<Policy> {
@@ -37,6 +50,27 @@ export declare class Policy extends Message<Policy> {
    */
   address: string;
 
+  /**
+   * members of a threshold policy, empty if the policy is controlled by its address
+   *
+   * @generated from field: repeated string members = 3;
+   */
+  members: string[];
+
+  /**
+   * number of member approvals required to execute a proposal of a threshold policy
+   *
+   * @generated from field: uint32 threshold = 4;
+   */
+  threshold: number;
+
+  /**
+   * number of blocks during which a proposal of a threshold policy can be approved
+   *
+   * @generated from field: int64 proposal_expiry_blocks = 5;
+   */
+  proposalExpiryBlocks: bigint;
+
   constructor(data?: PartialMessage<Policy>);
 
   static readonly runtime: typeof proto3;
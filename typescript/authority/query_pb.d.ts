@@ -6,6 +6,11 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { Policies } from "./policies_pb.js";
+import type { AuthorizationList } from "./authorization_pb.js";
+import type { QueuedAction, TimelockConfig } from "./timelock_pb.js";
+import type { PageRequest, PageResponse } from "../cosmos/base/query/v1beta1/pagination_pb.js";
+import type { AuditEntry } from "./audit_pb.js";
+import type { ThresholdProposal } from "./threshold_pb.js";
 
 /**
  * QueryGetPoliciesRequest is the request type for the Query/Policies RPC method.
@@ -54,3 +59,386 @@ export declare class QueryGetPoliciesResponse extends Message<QueryGetPoliciesRe
   static equals(a: QueryGetPoliciesResponse | PlainMessage<QueryGetPoliciesResponse> | undefined, b: QueryGetPoliciesResponse | PlainMessage<QueryGetPoliciesResponse> | undefined): boolean;
 }
 
+/**
+ * QueryAuthorizationListRequest is the request type for the Query/AuthorizationList RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAuthorizationListRequest
+ */
+export declare class QueryAuthorizationListRequest extends Message<QueryAuthorizationListRequest> {
+  constructor(data?: PartialMessage<QueryAuthorizationListRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAuthorizationListRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAuthorizationListRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAuthorizationListRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAuthorizationListRequest;
+
+  static equals(a: QueryAuthorizationListRequest | PlainMessage<QueryAuthorizationListRequest> | undefined, b: QueryAuthorizationListRequest | PlainMessage<QueryAuthorizationListRequest> | undefined): boolean;
+}
+
+/**
+ * QueryAuthorizationListResponse is the response type for the Query/AuthorizationList RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAuthorizationListResponse
+ */
+export declare class QueryAuthorizationListResponse extends Message<QueryAuthorizationListResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.authority.AuthorizationList authorization_list = 1;
+   */
+  authorizationList?: AuthorizationList;
+
+  constructor(data?: PartialMessage<QueryAuthorizationListResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAuthorizationListResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAuthorizationListResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAuthorizationListResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAuthorizationListResponse;
+
+  static equals(a: QueryAuthorizationListResponse | PlainMessage<QueryAuthorizationListResponse> | undefined, b: QueryAuthorizationListResponse | PlainMessage<QueryAuthorizationListResponse> | undefined): boolean;
+}
+
+/**
+ * QueryTimelockConfigRequest is the request type for the Query/TimelockConfig RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryTimelockConfigRequest
+ */
+export declare class QueryTimelockConfigRequest extends Message<QueryTimelockConfigRequest> {
+  constructor(data?: PartialMessage<QueryTimelockConfigRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryTimelockConfigRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryTimelockConfigRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryTimelockConfigRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryTimelockConfigRequest;
+
+  static equals(a: QueryTimelockConfigRequest | PlainMessage<QueryTimelockConfigRequest> | undefined, b: QueryTimelockConfigRequest | PlainMessage<QueryTimelockConfigRequest> | undefined): boolean;
+}
+
+/**
+ * QueryTimelockConfigResponse is the response type for the Query/TimelockConfig RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryTimelockConfigResponse
+ */
+export declare class QueryTimelockConfigResponse extends Message<QueryTimelockConfigResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.authority.TimelockConfig timelock_config = 1;
+   */
+  timelockConfig?: TimelockConfig;
+
+  constructor(data?: PartialMessage<QueryTimelockConfigResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryTimelockConfigResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryTimelockConfigResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryTimelockConfigResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryTimelockConfigResponse;
+
+  static equals(a: QueryTimelockConfigResponse | PlainMessage<QueryTimelockConfigResponse> | undefined, b: QueryTimelockConfigResponse | PlainMessage<QueryTimelockConfigResponse> | undefined): boolean;
+}
+
+/**
+ * QueryGetQueuedActionRequest is the request type for the Query/QueuedAction RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryGetQueuedActionRequest
+ */
+export declare class QueryGetQueuedActionRequest extends Message<QueryGetQueuedActionRequest> {
+  /**
+   * @generated from field: uint64 action_id = 1;
+   */
+  actionId: bigint;
+
+  constructor(data?: PartialMessage<QueryGetQueuedActionRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryGetQueuedActionRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetQueuedActionRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetQueuedActionRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetQueuedActionRequest;
+
+  static equals(a: QueryGetQueuedActionRequest | PlainMessage<QueryGetQueuedActionRequest> | undefined, b: QueryGetQueuedActionRequest | PlainMessage<QueryGetQueuedActionRequest> | undefined): boolean;
+}
+
+/**
+ * QueryGetQueuedActionResponse is the response type for the Query/QueuedAction RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryGetQueuedActionResponse
+ */
+export declare class QueryGetQueuedActionResponse extends Message<QueryGetQueuedActionResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.authority.QueuedAction queued_action = 1;
+   */
+  queuedAction?: QueuedAction;
+
+  constructor(data?: PartialMessage<QueryGetQueuedActionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryGetQueuedActionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetQueuedActionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetQueuedActionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetQueuedActionResponse;
+
+  static equals(a: QueryGetQueuedActionResponse | PlainMessage<QueryGetQueuedActionResponse> | undefined, b: QueryGetQueuedActionResponse | PlainMessage<QueryGetQueuedActionResponse> | undefined): boolean;
+}
+
+/**
+ * QueryAllQueuedActionRequest is the request type for the Query/QueuedActionAll RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAllQueuedActionRequest
+ */
+export declare class QueryAllQueuedActionRequest extends Message<QueryAllQueuedActionRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllQueuedActionRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAllQueuedActionRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllQueuedActionRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllQueuedActionRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllQueuedActionRequest;
+
+  static equals(a: QueryAllQueuedActionRequest | PlainMessage<QueryAllQueuedActionRequest> | undefined, b: QueryAllQueuedActionRequest | PlainMessage<QueryAllQueuedActionRequest> | undefined): boolean;
+}
+
+/**
+ * QueryAllQueuedActionResponse is the response type for the Query/QueuedActionAll RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAllQueuedActionResponse
+ */
+export declare class QueryAllQueuedActionResponse extends Message<QueryAllQueuedActionResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.QueuedAction queued_actions = 1;
+   */
+  queuedActions: QueuedAction[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllQueuedActionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAllQueuedActionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllQueuedActionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllQueuedActionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllQueuedActionResponse;
+
+  static equals(a: QueryAllQueuedActionResponse | PlainMessage<QueryAllQueuedActionResponse> | undefined, b: QueryAllQueuedActionResponse | PlainMessage<QueryAllQueuedActionResponse> | undefined): boolean;
+}
+
+/**
+ * QueryAuditLogRequest is the request type for the Query/AuditLog RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAuditLogRequest
+ */
+export declare class QueryAuditLogRequest extends Message<QueryAuditLogRequest> {
+  /**
+   * only return the entries of this signer if set
+   *
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * only return the entries of this message type URL if set
+   *
+   * @generated from field: string msg_type_url = 2;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 3;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAuditLogRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAuditLogRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAuditLogRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAuditLogRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAuditLogRequest;
+
+  static equals(a: QueryAuditLogRequest | PlainMessage<QueryAuditLogRequest> | undefined, b: QueryAuditLogRequest | PlainMessage<QueryAuditLogRequest> | undefined): boolean;
+}
+
+/**
+ * QueryAuditLogResponse is the response type for the Query/AuditLog RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAuditLogResponse
+ */
+export declare class QueryAuditLogResponse extends Message<QueryAuditLogResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.AuditEntry audit_entries = 1;
+   */
+  auditEntries: AuditEntry[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAuditLogResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAuditLogResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAuditLogResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAuditLogResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAuditLogResponse;
+
+  static equals(a: QueryAuditLogResponse | PlainMessage<QueryAuditLogResponse> | undefined, b: QueryAuditLogResponse | PlainMessage<QueryAuditLogResponse> | undefined): boolean;
+}
+
+/**
+ * QueryGetThresholdProposalRequest is the request type for the Query/ThresholdProposal RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryGetThresholdProposalRequest
+ */
+export declare class QueryGetThresholdProposalRequest extends Message<QueryGetThresholdProposalRequest> {
+  /**
+   * @generated from field: uint64 proposal_id = 1;
+   */
+  proposalId: bigint;
+
+  constructor(data?: PartialMessage<QueryGetThresholdProposalRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryGetThresholdProposalRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetThresholdProposalRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetThresholdProposalRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetThresholdProposalRequest;
+
+  static equals(a: QueryGetThresholdProposalRequest | PlainMessage<QueryGetThresholdProposalRequest> | undefined, b: QueryGetThresholdProposalRequest | PlainMessage<QueryGetThresholdProposalRequest> | undefined): boolean;
+}
+
+/**
+ * QueryGetThresholdProposalResponse is the response type for the Query/ThresholdProposal RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryGetThresholdProposalResponse
+ */
+export declare class QueryGetThresholdProposalResponse extends Message<QueryGetThresholdProposalResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.authority.ThresholdProposal threshold_proposal = 1;
+   */
+  thresholdProposal?: ThresholdProposal;
+
+  constructor(data?: PartialMessage<QueryGetThresholdProposalResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryGetThresholdProposalResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryGetThresholdProposalResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryGetThresholdProposalResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryGetThresholdProposalResponse;
+
+  static equals(a: QueryGetThresholdProposalResponse | PlainMessage<QueryGetThresholdProposalResponse> | undefined, b: QueryGetThresholdProposalResponse | PlainMessage<QueryGetThresholdProposalResponse> | undefined): boolean;
+}
+
+/**
+ * QueryAllThresholdProposalRequest is the request type for the Query/ThresholdProposalAll RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAllThresholdProposalRequest
+ */
+export declare class QueryAllThresholdProposalRequest extends Message<QueryAllThresholdProposalRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllThresholdProposalRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAllThresholdProposalRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllThresholdProposalRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllThresholdProposalRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllThresholdProposalRequest;
+
+  static equals(a: QueryAllThresholdProposalRequest | PlainMessage<QueryAllThresholdProposalRequest> | undefined, b: QueryAllThresholdProposalRequest | PlainMessage<QueryAllThresholdProposalRequest> | undefined): boolean;
+}
+
+/**
+ * QueryAllThresholdProposalResponse is the response type for the Query/ThresholdProposalAll RPC method.
+ *
+ * @generated from message zetachain.zetacore.authority.QueryAllThresholdProposalResponse
+ */
+export declare class QueryAllThresholdProposalResponse extends Message<QueryAllThresholdProposalResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.ThresholdProposal threshold_proposals = 1;
+   */
+  thresholdProposals: ThresholdProposal[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllThresholdProposalResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueryAllThresholdProposalResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllThresholdProposalResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllThresholdProposalResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllThresholdProposalResponse;
+
+  static equals(a: QueryAllThresholdProposalResponse | PlainMessage<QueryAllThresholdProposalResponse> | undefined, b: QueryAllThresholdProposalResponse | PlainMessage<QueryAllThresholdProposalResponse> | undefined): boolean;
+}
+
@@ -0,0 +1,72 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file authority/threshold.proto (package zetachain.zetacore.authority, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { Any, BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import type { PolicyType } from "./policies_pb.js";
+
+/**
+ * ThresholdProposal is a message of a threshold policy waiting for the approval of the policy members
+ * the message is executed with the policy address as signer once the threshold of approvals is reached
+ *
+ * @generated from message zetachain.zetacore.authority.ThresholdProposal
+ */
+export declare class ThresholdProposal extends Message<ThresholdProposal> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.PolicyType policy_type = 2;
+   */
+  policyType: PolicyType;
+
+  /**
+   * member that submitted the proposal
+   *
+   * @generated from field: string proposer = 3;
+   */
+  proposer: string;
+
+  /**
+   * @generated from field: google.protobuf.Any msg = 4;
+   */
+  msg?: Any;
+
+  /**
+   * members that approved the proposal, including the proposer
+   *
+   * @generated from field: repeated string approvals = 5;
+   */
+  approvals: string[];
+
+  /**
+   * @generated from field: int64 submit_height = 6;
+   */
+  submitHeight: bigint;
+
+  /**
+   * last height at which the proposal can be approved
+   *
+   * @generated from field: int64 expiry_height = 7;
+   */
+  expiryHeight: bigint;
+
+  constructor(data?: PartialMessage<ThresholdProposal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.ThresholdProposal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ThresholdProposal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ThresholdProposal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ThresholdProposal;
+
+  static equals(a: ThresholdProposal | PlainMessage<ThresholdProposal> | undefined, b: ThresholdProposal | PlainMessage<ThresholdProposal> | undefined): boolean;
+}
+
@@ -0,0 +1,118 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file authority/timelock.proto (package zetachain.zetacore.authority, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { Any, BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * TimelockedMessage sets the number of blocks a message is queued in the timelock before its execution
+ *
+ * @generated from message zetachain.zetacore.authority.TimelockedMessage
+ */
+export declare class TimelockedMessage extends Message<TimelockedMessage> {
+  /**
+   * type URL of the message, e.g. /zetachain.zetacore.fungible.MsgUpdateSystemContract
+   *
+   * @generated from field: string msg_url = 1;
+   */
+  msgUrl: string;
+
+  /**
+   * number of blocks between the submission and the execution of the message
+   *
+   * @generated from field: int64 delay_blocks = 2;
+   */
+  delayBlocks: bigint;
+
+  constructor(data?: PartialMessage<TimelockedMessage>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.TimelockedMessage";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimelockedMessage;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimelockedMessage;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimelockedMessage;
+
+  static equals(a: TimelockedMessage | PlainMessage<TimelockedMessage> | undefined, b: TimelockedMessage | PlainMessage<TimelockedMessage> | undefined): boolean;
+}
+
+/**
+ * TimelockConfig contains the messages that must be queued in the timelock before their execution
+ *
+ * @generated from message zetachain.zetacore.authority.TimelockConfig
+ */
+export declare class TimelockConfig extends Message<TimelockConfig> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.authority.TimelockedMessage messages = 1;
+   */
+  messages: TimelockedMessage[];
+
+  constructor(data?: PartialMessage<TimelockConfig>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.TimelockConfig";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimelockConfig;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimelockConfig;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimelockConfig;
+
+  static equals(a: TimelockConfig | PlainMessage<TimelockConfig> | undefined, b: TimelockConfig | PlainMessage<TimelockConfig> | undefined): boolean;
+}
+
+/**
+ * QueuedAction is a message queued in the timelock
+ * the message is executed at the execution height unless it is cancelled by the emergency group
+ *
+ * @generated from message zetachain.zetacore.authority.QueuedAction
+ */
+export declare class QueuedAction extends Message<QueuedAction> {
+  /**
+   * @generated from field: uint64 id = 1;
+   */
+  id: bigint;
+
+  /**
+   * address that submitted the message, the message is executed with this address as signer
+   *
+   * @generated from field: string signer = 2;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: google.protobuf.Any msg = 3;
+   */
+  msg?: Any;
+
+  /**
+   * @generated from field: int64 submit_height = 4;
+   */
+  submitHeight: bigint;
+
+  /**
+   * @generated from field: int64 execution_height = 5;
+   */
+  executionHeight: bigint;
+
+  constructor(data?: PartialMessage<QueuedAction>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.QueuedAction";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueuedAction;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueuedAction;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueuedAction;
+
+  static equals(a: QueuedAction | PlainMessage<QueuedAction> | undefined, b: QueuedAction | PlainMessage<QueuedAction> | undefined): boolean;
+}
+
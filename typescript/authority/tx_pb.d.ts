@@ -3,9 +3,11 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import type { Any, BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { Policies } from "./policies_pb.js";
+import type { Policies, PolicyType } from "./policies_pb.js";
+import type { Authorization } from "./authorization_pb.js";
+import type { TimelockConfig } from "./timelock_pb.js";
 
 /**
  * MsgUpdatePolicies defines the MsgUpdatePolicies service.
@@ -59,3 +61,405 @@ export declare class MsgUpdatePoliciesResponse extends Message<MsgUpdatePolicies
   static equals(a: MsgUpdatePoliciesResponse | PlainMessage<MsgUpdatePoliciesResponse> | undefined, b: MsgUpdatePoliciesResponse | PlainMessage<MsgUpdatePoliciesResponse> | undefined): boolean;
 }
 
+/**
+ * MsgAddAuthorization defines the MsgAddAuthorization service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgAddAuthorization
+ */
+export declare class MsgAddAuthorization extends Message<MsgAddAuthorization> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.Authorization authorization = 2;
+   */
+  authorization?: Authorization;
+
+  constructor(data?: PartialMessage<MsgAddAuthorization>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgAddAuthorization";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgAddAuthorization;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgAddAuthorization;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgAddAuthorization;
+
+  static equals(a: MsgAddAuthorization | PlainMessage<MsgAddAuthorization> | undefined, b: MsgAddAuthorization | PlainMessage<MsgAddAuthorization> | undefined): boolean;
+}
+
+/**
+ * MsgAddAuthorizationResponse defines the MsgAddAuthorizationResponse service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgAddAuthorizationResponse
+ */
+export declare class MsgAddAuthorizationResponse extends Message<MsgAddAuthorizationResponse> {
+  constructor(data?: PartialMessage<MsgAddAuthorizationResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgAddAuthorizationResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgAddAuthorizationResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgAddAuthorizationResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgAddAuthorizationResponse;
+
+  static equals(a: MsgAddAuthorizationResponse | PlainMessage<MsgAddAuthorizationResponse> | undefined, b: MsgAddAuthorizationResponse | PlainMessage<MsgAddAuthorizationResponse> | undefined): boolean;
+}
+
+/**
+ * MsgRemoveAuthorization defines the MsgRemoveAuthorization service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgRemoveAuthorization
+ */
+export declare class MsgRemoveAuthorization extends Message<MsgRemoveAuthorization> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.Authorization authorization = 2;
+   */
+  authorization?: Authorization;
+
+  constructor(data?: PartialMessage<MsgRemoveAuthorization>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgRemoveAuthorization";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRemoveAuthorization;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRemoveAuthorization;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRemoveAuthorization;
+
+  static equals(a: MsgRemoveAuthorization | PlainMessage<MsgRemoveAuthorization> | undefined, b: MsgRemoveAuthorization | PlainMessage<MsgRemoveAuthorization> | undefined): boolean;
+}
+
+/**
+ * MsgRemoveAuthorizationResponse defines the MsgRemoveAuthorizationResponse service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgRemoveAuthorizationResponse
+ */
+export declare class MsgRemoveAuthorizationResponse extends Message<MsgRemoveAuthorizationResponse> {
+  constructor(data?: PartialMessage<MsgRemoveAuthorizationResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgRemoveAuthorizationResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRemoveAuthorizationResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRemoveAuthorizationResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRemoveAuthorizationResponse;
+
+  static equals(a: MsgRemoveAuthorizationResponse | PlainMessage<MsgRemoveAuthorizationResponse> | undefined, b: MsgRemoveAuthorizationResponse | PlainMessage<MsgRemoveAuthorizationResponse> | undefined): boolean;
+}
+
+/**
+ * MsgUpdateTimelockConfig defines the MsgUpdateTimelockConfig service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgUpdateTimelockConfig
+ */
+export declare class MsgUpdateTimelockConfig extends Message<MsgUpdateTimelockConfig> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.TimelockConfig timelock_config = 2;
+   */
+  timelockConfig?: TimelockConfig;
+
+  constructor(data?: PartialMessage<MsgUpdateTimelockConfig>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgUpdateTimelockConfig";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateTimelockConfig;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateTimelockConfig;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateTimelockConfig;
+
+  static equals(a: MsgUpdateTimelockConfig | PlainMessage<MsgUpdateTimelockConfig> | undefined, b: MsgUpdateTimelockConfig | PlainMessage<MsgUpdateTimelockConfig> | undefined): boolean;
+}
+
+/**
+ * MsgUpdateTimelockConfigResponse defines the MsgUpdateTimelockConfigResponse service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgUpdateTimelockConfigResponse
+ */
+export declare class MsgUpdateTimelockConfigResponse extends Message<MsgUpdateTimelockConfigResponse> {
+  constructor(data?: PartialMessage<MsgUpdateTimelockConfigResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgUpdateTimelockConfigResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateTimelockConfigResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateTimelockConfigResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateTimelockConfigResponse;
+
+  static equals(a: MsgUpdateTimelockConfigResponse | PlainMessage<MsgUpdateTimelockConfigResponse> | undefined, b: MsgUpdateTimelockConfigResponse | PlainMessage<MsgUpdateTimelockConfigResponse> | undefined): boolean;
+}
+
+/**
+ * MsgSubmitTimelockedAction defines the MsgSubmitTimelockedAction service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgSubmitTimelockedAction
+ */
+export declare class MsgSubmitTimelockedAction extends Message<MsgSubmitTimelockedAction> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * message to queue in the timelock, its only signer must be the signer of the submission
+   *
+   * @generated from field: google.protobuf.Any msg = 2;
+   */
+  msg?: Any;
+
+  constructor(data?: PartialMessage<MsgSubmitTimelockedAction>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgSubmitTimelockedAction";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgSubmitTimelockedAction;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgSubmitTimelockedAction;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgSubmitTimelockedAction;
+
+  static equals(a: MsgSubmitTimelockedAction | PlainMessage<MsgSubmitTimelockedAction> | undefined, b: MsgSubmitTimelockedAction | PlainMessage<MsgSubmitTimelockedAction> | undefined): boolean;
+}
+
+/**
+ * MsgSubmitTimelockedActionResponse defines the MsgSubmitTimelockedActionResponse service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgSubmitTimelockedActionResponse
+ */
+export declare class MsgSubmitTimelockedActionResponse extends Message<MsgSubmitTimelockedActionResponse> {
+  /**
+   * @generated from field: uint64 action_id = 1;
+   */
+  actionId: bigint;
+
+  /**
+   * @generated from field: int64 execution_height = 2;
+   */
+  executionHeight: bigint;
+
+  constructor(data?: PartialMessage<MsgSubmitTimelockedActionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgSubmitTimelockedActionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgSubmitTimelockedActionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgSubmitTimelockedActionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgSubmitTimelockedActionResponse;
+
+  static equals(a: MsgSubmitTimelockedActionResponse | PlainMessage<MsgSubmitTimelockedActionResponse> | undefined, b: MsgSubmitTimelockedActionResponse | PlainMessage<MsgSubmitTimelockedActionResponse> | undefined): boolean;
+}
+
+/**
+ * MsgCancelTimelockedAction defines the MsgCancelTimelockedAction service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgCancelTimelockedAction
+ */
+export declare class MsgCancelTimelockedAction extends Message<MsgCancelTimelockedAction> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: uint64 action_id = 2;
+   */
+  actionId: bigint;
+
+  constructor(data?: PartialMessage<MsgCancelTimelockedAction>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgCancelTimelockedAction";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgCancelTimelockedAction;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgCancelTimelockedAction;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgCancelTimelockedAction;
+
+  static equals(a: MsgCancelTimelockedAction | PlainMessage<MsgCancelTimelockedAction> | undefined, b: MsgCancelTimelockedAction | PlainMessage<MsgCancelTimelockedAction> | undefined): boolean;
+}
+
+/**
+ * MsgCancelTimelockedActionResponse defines the MsgCancelTimelockedActionResponse service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgCancelTimelockedActionResponse
+ */
+export declare class MsgCancelTimelockedActionResponse extends Message<MsgCancelTimelockedActionResponse> {
+  constructor(data?: PartialMessage<MsgCancelTimelockedActionResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgCancelTimelockedActionResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgCancelTimelockedActionResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgCancelTimelockedActionResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgCancelTimelockedActionResponse;
+
+  static equals(a: MsgCancelTimelockedActionResponse | PlainMessage<MsgCancelTimelockedActionResponse> | undefined, b: MsgCancelTimelockedActionResponse | PlainMessage<MsgCancelTimelockedActionResponse> | undefined): boolean;
+}
+
+/**
+ * MsgSubmitThresholdProposal defines the MsgSubmitThresholdProposal service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgSubmitThresholdProposal
+ */
+export declare class MsgSubmitThresholdProposal extends Message<MsgSubmitThresholdProposal> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.authority.PolicyType policy_type = 2;
+   */
+  policyType: PolicyType;
+
+  /**
+   * message to execute, its only signer must be the address of the threshold policy
+   *
+   * @generated from field: google.protobuf.Any msg = 3;
+   */
+  msg?: Any;
+
+  constructor(data?: PartialMessage<MsgSubmitThresholdProposal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgSubmitThresholdProposal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgSubmitThresholdProposal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgSubmitThresholdProposal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgSubmitThresholdProposal;
+
+  static equals(a: MsgSubmitThresholdProposal | PlainMessage<MsgSubmitThresholdProposal> | undefined, b: MsgSubmitThresholdProposal | PlainMessage<MsgSubmitThresholdProposal> | undefined): boolean;
+}
+
+/**
+ * MsgSubmitThresholdProposalResponse defines the MsgSubmitThresholdProposalResponse service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgSubmitThresholdProposalResponse
+ */
+export declare class MsgSubmitThresholdProposalResponse extends Message<MsgSubmitThresholdProposalResponse> {
+  /**
+   * @generated from field: uint64 proposal_id = 1;
+   */
+  proposalId: bigint;
+
+  /**
+   * true if the threshold is reached with the approval of the proposer and the message is executed
+   *
+   * @generated from field: bool executed = 2;
+   */
+  executed: boolean;
+
+  constructor(data?: PartialMessage<MsgSubmitThresholdProposalResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgSubmitThresholdProposalResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgSubmitThresholdProposalResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgSubmitThresholdProposalResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgSubmitThresholdProposalResponse;
+
+  static equals(a: MsgSubmitThresholdProposalResponse | PlainMessage<MsgSubmitThresholdProposalResponse> | undefined, b: MsgSubmitThresholdProposalResponse | PlainMessage<MsgSubmitThresholdProposalResponse> | undefined): boolean;
+}
+
+/**
+ * MsgApproveThresholdProposal defines the MsgApproveThresholdProposal service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgApproveThresholdProposal
+ */
+export declare class MsgApproveThresholdProposal extends Message<MsgApproveThresholdProposal> {
+  /**
+   * @generated from field: string signer = 1;
+   */
+  signer: string;
+
+  /**
+   * @generated from field: uint64 proposal_id = 2;
+   */
+  proposalId: bigint;
+
+  constructor(data?: PartialMessage<MsgApproveThresholdProposal>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgApproveThresholdProposal";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgApproveThresholdProposal;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgApproveThresholdProposal;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgApproveThresholdProposal;
+
+  static equals(a: MsgApproveThresholdProposal | PlainMessage<MsgApproveThresholdProposal> | undefined, b: MsgApproveThresholdProposal | PlainMessage<MsgApproveThresholdProposal> | undefined): boolean;
+}
+
+/**
+ * MsgApproveThresholdProposalResponse defines the MsgApproveThresholdProposalResponse service.
+ *
+ * @generated from message zetachain.zetacore.authority.MsgApproveThresholdProposalResponse
+ */
+export declare class MsgApproveThresholdProposalResponse extends Message<MsgApproveThresholdProposalResponse> {
+  /**
+   * true if the threshold is reached with this approval and the message is executed
+   *
+   * @generated from field: bool executed = 1;
+   */
+  executed: boolean;
+
+  constructor(data?: PartialMessage<MsgApproveThresholdProposalResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.authority.MsgApproveThresholdProposalResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgApproveThresholdProposalResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgApproveThresholdProposalResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgApproveThresholdProposalResponse;
+
+  static equals(a: MsgApproveThresholdProposalResponse | PlainMessage<MsgApproveThresholdProposalResponse> | undefined, b: MsgApproveThresholdProposalResponse | PlainMessage<MsgApproveThresholdProposalResponse> | undefined): boolean;
+}
+
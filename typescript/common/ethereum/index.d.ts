@@ -1 +1,2 @@
 export * from "./ethereum_pb";
+export * from "./light_client_pb";
@@ -52,6 +52,13 @@ export declare enum CctxStatus {
    * @generated from enum value: Aborted = 6;
    */
   Aborted = 6,
+
+  /**
+   * outbound exceeds the rate limit of the receiver chain and asset; waiting for window capacity
+   *
+   * @generated from enum value: PendingRateLimited = 7;
+   */
+  PendingRateLimited = 7,
 }
 
 /**
@@ -225,6 +232,14 @@ export declare class OutboundTxParams extends Message<OutboundTxParams> {
    */
   outboundTxGasPrice: string;
 
+  /**
+   * priority fee (tip) per gas of the outbound tx on chains supporting EIP-1559
+   * when set, outbound_tx_gas_price is used as the max fee per gas
+   *
+   * @generated from field: string outbound_tx_gas_priority_fee = 23;
+   */
+  outboundTxGasPriorityFee: string;
+
   /**
    * the above are commands for zetaclients
    * the following fields are used when the outbound tx is mined
@@ -268,6 +283,14 @@ export declare class OutboundTxParams extends Message<OutboundTxParams> {
    */
   txFinalizationStatus: TxFinalizationStatus;
 
+  /**
+   * the outbound has expired and is cancelled, the observers sign a cancel tx with its nonce instead of the outbound
+   * the cctx is reverted once the cancel tx is observed, or completed if the outbound is observed instead
+   *
+   * @generated from field: bool cancelled = 24;
+   */
+  cancelled: boolean;
+
   constructor(data?: PartialMessage<OutboundTxParams>);
 
   static readonly runtime: typeof proto3;
@@ -283,6 +306,56 @@ export declare class OutboundTxParams extends Message<OutboundTxParams> {
   static equals(a: OutboundTxParams | PlainMessage<OutboundTxParams> | undefined, b: OutboundTxParams | PlainMessage<OutboundTxParams> | undefined): boolean;
 }
 
+/**
+ * StatusTransition records a single change of status of a CCTX
+ *
+ * @generated from message zetachain.zetacore.crosschain.StatusTransition
+ */
+export declare class StatusTransition extends Message<StatusTransition> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxStatus old_status = 1;
+   */
+  oldStatus: CctxStatus;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.CctxStatus new_status = 2;
+   */
+  newStatus: CctxStatus;
+
+  /**
+   * @generated from field: string message = 3;
+   */
+  message: string;
+
+  /**
+   * ZetaChain block height at which the transition happened
+   *
+   * @generated from field: int64 zeta_height = 4;
+   */
+  zetaHeight: bigint;
+
+  /**
+   * ZetaChain block time at which the transition happened
+   *
+   * @generated from field: int64 timestamp = 5;
+   */
+  timestamp: bigint;
+
+  constructor(data?: PartialMessage<StatusTransition>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.StatusTransition";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StatusTransition;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StatusTransition;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StatusTransition;
+
+  static equals(a: StatusTransition | PlainMessage<StatusTransition> | undefined, b: StatusTransition | PlainMessage<StatusTransition> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.Status
  */
@@ -307,6 +380,21 @@ export declare class Status extends Message<Status> {
    */
   isAbortRefunded: boolean;
 
+  /**
+   * history contains the latest status transitions of the CCTX, oldest first
+   * the number of entries is bounded by MaxStatusHistoryLength
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.StatusTransition history = 5;
+   */
+  history: StatusTransition[];
+
+  /**
+   * number of zeta blocks added by the emergency group to the outbound expiry deadline of the CCTX
+   *
+   * @generated from field: uint64 deadline_extension_blocks = 6;
+   */
+  deadlineExtensionBlocks: bigint;
+
   constructor(data?: PartialMessage<Status>);
 
   static readonly runtime: typeof proto3;
@@ -291,6 +291,102 @@ export declare class EventOutboundSuccess extends Message<EventOutboundSuccess>
   static equals(a: EventOutboundSuccess | PlainMessage<EventOutboundSuccess> | undefined, b: EventOutboundSuccess | PlainMessage<EventOutboundSuccess> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.EventCCTXExpired
+ */
+export declare class EventCCTXExpired extends Message<EventCCTXExpired> {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  /**
+   * the status of the cctx is unchanged when the outbound expires, the outbound is cancelled
+   * the cctx is reverted or aborted once the cancel tx is observed
+   *
+   * @generated from field: string status = 2;
+   */
+  status: string;
+
+  /**
+   * @generated from field: string status_message = 3;
+   */
+  statusMessage: string;
+
+  /**
+   * @generated from field: string receiver_chain = 4;
+   */
+  receiverChain: string;
+
+  /**
+   * @generated from field: string deadline_height = 5;
+   */
+  deadlineHeight: string;
+
+  constructor(data?: PartialMessage<EventCCTXExpired>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.EventCCTXExpired";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventCCTXExpired;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventCCTXExpired;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventCCTXExpired;
+
+  static equals(a: EventCCTXExpired | PlainMessage<EventCCTXExpired> | undefined, b: EventCCTXExpired | PlainMessage<EventCCTXExpired> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.EventCCTXRateLimited
+ */
+export declare class EventCCTXRateLimited extends Message<EventCCTXRateLimited> {
+  /**
+   * @generated from field: string cctx_index = 1;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: string receiver_chain = 2;
+   */
+  receiverChain: string;
+
+  /**
+   * @generated from field: string zrc20_address = 3;
+   */
+  zrc20Address: string;
+
+  /**
+   * @generated from field: string amount = 4;
+   */
+  amount: string;
+
+  /**
+   * @generated from field: string window_usage = 5;
+   */
+  windowUsage: string;
+
+  /**
+   * @generated from field: string limit = 6;
+   */
+  limit: string;
+
+  constructor(data?: PartialMessage<EventCCTXRateLimited>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.EventCCTXRateLimited";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventCCTXRateLimited;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventCCTXRateLimited;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventCCTXRateLimited;
+
+  static equals(a: EventCCTXRateLimited | PlainMessage<EventCCTXRateLimited> | undefined, b: EventCCTXRateLimited | PlainMessage<EventCCTXRateLimited> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.EventCCTXGasPriceIncreased
  */
@@ -45,6 +45,13 @@ export declare class GasPrice extends Message<GasPrice> {
    */
   medianIndex: bigint;
 
+  /**
+   * priority fees (EIP-1559) voted by each signer, aligned with prices
+   *
+   * @generated from field: repeated uint64 priority_fees = 8;
+   */
+  priorityFees: bigint[];
+
   constructor(data?: PartialMessage<GasPrice>);
 
   static readonly runtime: typeof proto3;
@@ -12,6 +12,7 @@ import type { CrossChainTx, ZetaAccounting } from "./cross_chain_tx_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
 import type { InTxHashToCctx } from "./in_tx_hash_to_cctx_pb.js";
 import type { InTxTracker } from "./in_tx_tracker_pb.js";
+import type { RateLimit } from "./rate_limit_pb.js";
 
 /**
  * GenesisState defines the metacore module's genesis state.
@@ -64,6 +65,11 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   FinalizedInbounds: string[];
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.RateLimit rate_limits = 17;
+   */
+  rateLimits: RateLimit[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
@@ -8,4 +8,5 @@ export * from "./last_block_height_pb";
 export * from "./out_tx_tracker_pb";
 export * from "./params_pb";
 export * from "./query_pb";
+export * from "./rate_limit_pb";
 export * from "./tx_pb";
@@ -10,9 +10,11 @@ import type { OutTxTracker } from "./out_tx_tracker_pb.js";
 import type { PageRequest, PageResponse } from "../cosmos/base/query/v1beta1/pagination_pb.js";
 import type { InTxTracker } from "./in_tx_tracker_pb.js";
 import type { InTxHashToCctx } from "./in_tx_hash_to_cctx_pb.js";
-import type { CrossChainTx } from "./cross_chain_tx_pb.js";
+import type { CctxStatus, CrossChainTx } from "./cross_chain_tx_pb.js";
 import type { GasPrice } from "./gas_price_pb.js";
 import type { LastBlockHeight } from "./last_block_height_pb.js";
+import type { RateLimit } from "./rate_limit_pb.js";
+import type { CoinType } from "../common/common_pb.js";
 
 /**
  * Deprecated: Moved to observer
@@ -976,6 +978,287 @@ export declare class QueryListCctxPendingResponse extends Message<QueryListCctxP
   static equals(a: QueryListCctxPendingResponse | PlainMessage<QueryListCctxPendingResponse> | undefined, b: QueryListCctxPendingResponse | PlainMessage<QueryListCctxPendingResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryListCctxFilteredRequest
+ */
+export declare class QueryListCctxFilteredRequest extends Message<QueryListCctxFilteredRequest> {
+  /**
+   * sender matches the inbound sender or the inbound tx origin
+   *
+   * @generated from field: string sender = 1;
+   */
+  sender: string;
+
+  /**
+   * receiver matches the receiver of the original outbound
+   *
+   * @generated from field: string receiver = 2;
+   */
+  receiver: string;
+
+  /**
+   * status matches any of the provided statuses
+   *
+   * @generated from field: repeated zetachain.zetacore.crosschain.CctxStatus status = 3;
+   */
+  status: CctxStatus[];
+
+  /**
+   * @generated from field: int64 sender_chain_id = 4;
+   */
+  senderChainId: bigint;
+
+  /**
+   * @generated from field: int64 receiver_chain_id = 5;
+   */
+  receiverChainId: bigint;
+
+  /**
+   * min_height and max_height define an inclusive range of zeta heights at which the cctx has been created
+   * a zero value means no bound
+   *
+   * @generated from field: uint64 min_height = 6;
+   */
+  minHeight: bigint;
+
+  /**
+   * @generated from field: uint64 max_height = 7;
+   */
+  maxHeight: bigint;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 8;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryListCctxFilteredRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryListCctxFilteredRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryListCctxFilteredRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryListCctxFilteredRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryListCctxFilteredRequest;
+
+  static equals(a: QueryListCctxFilteredRequest | PlainMessage<QueryListCctxFilteredRequest> | undefined, b: QueryListCctxFilteredRequest | PlainMessage<QueryListCctxFilteredRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryListCctxFilteredResponse
+ */
+export declare class QueryListCctxFilteredResponse extends Message<QueryListCctxFilteredResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx CrossChainTx = 1;
+   */
+  CrossChainTx: CrossChainTx[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryListCctxFilteredResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryListCctxFilteredResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryListCctxFilteredResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryListCctxFilteredResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryListCctxFilteredResponse;
+
+  static equals(a: QueryListCctxFilteredResponse | PlainMessage<QueryListCctxFilteredResponse> | undefined, b: QueryListCctxFilteredResponse | PlainMessage<QueryListCctxFilteredResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryListCctxPrunableRequest
+ */
+export declare class QueryListCctxPrunableRequest extends Message<QueryListCctxPrunableRequest> {
+  /**
+   * min_height and max_height define an inclusive range of zeta heights at which the cctx became prunable
+   * a zero value means no bound
+   *
+   * @generated from field: uint64 min_height = 1;
+   */
+  minHeight: bigint;
+
+  /**
+   * @generated from field: uint64 max_height = 2;
+   */
+  maxHeight: bigint;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 3;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryListCctxPrunableRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryListCctxPrunableRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryListCctxPrunableRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryListCctxPrunableRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryListCctxPrunableRequest;
+
+  static equals(a: QueryListCctxPrunableRequest | PlainMessage<QueryListCctxPrunableRequest> | undefined, b: QueryListCctxPrunableRequest | PlainMessage<QueryListCctxPrunableRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryListCctxPrunableResponse
+ */
+export declare class QueryListCctxPrunableResponse extends Message<QueryListCctxPrunableResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.CrossChainTx CrossChainTx = 1;
+   */
+  CrossChainTx: CrossChainTx[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryListCctxPrunableResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryListCctxPrunableResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryListCctxPrunableResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryListCctxPrunableResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryListCctxPrunableResponse;
+
+  static equals(a: QueryListCctxPrunableResponse | PlainMessage<QueryListCctxPrunableResponse> | undefined, b: QueryListCctxPrunableResponse | PlainMessage<QueryListCctxPrunableResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryAllRateLimitRequest
+ */
+export declare class QueryAllRateLimitRequest extends Message<QueryAllRateLimitRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryAllRateLimitRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryAllRateLimitRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllRateLimitRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllRateLimitRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllRateLimitRequest;
+
+  static equals(a: QueryAllRateLimitRequest | PlainMessage<QueryAllRateLimitRequest> | undefined, b: QueryAllRateLimitRequest | PlainMessage<QueryAllRateLimitRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryAllRateLimitResponse
+ */
+export declare class QueryAllRateLimitResponse extends Message<QueryAllRateLimitResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.crosschain.RateLimit rate_limits = 1;
+   */
+  rateLimits: RateLimit[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryAllRateLimitResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryAllRateLimitResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryAllRateLimitResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryAllRateLimitResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryAllRateLimitResponse;
+
+  static equals(a: QueryAllRateLimitResponse | PlainMessage<QueryAllRateLimitResponse> | undefined, b: QueryAllRateLimitResponse | PlainMessage<QueryAllRateLimitResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryRateLimitCapacityRequest
+ */
+export declare class QueryRateLimitCapacityRequest extends Message<QueryRateLimitCapacityRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * empty for ZETA
+   *
+   * @generated from field: string zrc20_address = 2;
+   */
+  zrc20Address: string;
+
+  constructor(data?: PartialMessage<QueryRateLimitCapacityRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryRateLimitCapacityRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryRateLimitCapacityRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryRateLimitCapacityRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryRateLimitCapacityRequest;
+
+  static equals(a: QueryRateLimitCapacityRequest | PlainMessage<QueryRateLimitCapacityRequest> | undefined, b: QueryRateLimitCapacityRequest | PlainMessage<QueryRateLimitCapacityRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryRateLimitCapacityResponse
+ */
+export declare class QueryRateLimitCapacityResponse extends Message<QueryRateLimitCapacityResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.RateLimit rate_limit = 1;
+   */
+  rateLimit?: RateLimit;
+
+  /**
+   * @generated from field: string window_usage = 2;
+   */
+  windowUsage: string;
+
+  /**
+   * @generated from field: string remaining_capacity = 3;
+   */
+  remainingCapacity: string;
+
+  constructor(data?: PartialMessage<QueryRateLimitCapacityResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryRateLimitCapacityResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryRateLimitCapacityResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryRateLimitCapacityResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryRateLimitCapacityResponse;
+
+  static equals(a: QueryRateLimitCapacityResponse | PlainMessage<QueryRateLimitCapacityResponse> | undefined, b: QueryRateLimitCapacityResponse | PlainMessage<QueryRateLimitCapacityResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.crosschain.QueryLastZetaHeightRequest
  */
@@ -1125,3 +1408,130 @@ export declare class QueryMessagePassingProtocolFeeResponse extends Message<Quer
   static equals(a: QueryMessagePassingProtocolFeeResponse | PlainMessage<QueryMessagePassingProtocolFeeResponse> | undefined, b: QueryMessagePassingProtocolFeeResponse | PlainMessage<QueryMessagePassingProtocolFeeResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.QueryEstimateCctxFeeRequest
+ */
+export declare class QueryEstimateCctxFeeRequest extends Message<QueryEstimateCctxFeeRequest> {
+  /**
+   * @generated from field: int64 sender_chain_id = 1;
+   */
+  senderChainId: bigint;
+
+  /**
+   * @generated from field: int64 receiver_chain_id = 2;
+   */
+  receiverChainId: bigint;
+
+  /**
+   * @generated from field: common.CoinType coin_type = 3;
+   */
+  coinType: CoinType;
+
+  /**
+   * asset address on the receiver chain for ERC20
+   *
+   * @generated from field: string asset = 4;
+   */
+  asset: string;
+
+  /**
+   * @generated from field: string amount = 5;
+   */
+  amount: string;
+
+  /**
+   * outbound gas limit, only used for ZETA, the gas limit of the ZRC20 is used otherwise
+   *
+   * @generated from field: uint64 gas_limit = 6;
+   */
+  gasLimit: bigint;
+
+  constructor(data?: PartialMessage<QueryEstimateCctxFeeRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryEstimateCctxFeeRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryEstimateCctxFeeRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryEstimateCctxFeeRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryEstimateCctxFeeRequest;
+
+  static equals(a: QueryEstimateCctxFeeRequest | PlainMessage<QueryEstimateCctxFeeRequest> | undefined, b: QueryEstimateCctxFeeRequest | PlainMessage<QueryEstimateCctxFeeRequest> | undefined): boolean;
+}
+
+/**
+ * QueryEstimateCctxFeeResponse contains the itemized fees of a prospective cctx
+ * the gas fee and protocol flat fee are denominated in the gas token of the receiver chain
+ * the total fee is denominated in the sent asset, except for ZRC20 withdrawals where the fees are paid in the gas ZRC20
+ *
+ * @generated from message zetachain.zetacore.crosschain.QueryEstimateCctxFeeResponse
+ */
+export declare class QueryEstimateCctxFeeResponse extends Message<QueryEstimateCctxFeeResponse> {
+  /**
+   * @generated from field: uint64 gas_limit = 1;
+   */
+  gasLimit: bigint;
+
+  /**
+   * @generated from field: string gas_price = 2;
+   */
+  gasPrice: string;
+
+  /**
+   * @generated from field: string gas_fee = 3;
+   */
+  gasFee: string;
+
+  /**
+   * @generated from field: string protocol_flat_fee = 4;
+   */
+  protocolFlatFee: string;
+
+  /**
+   * gas fee converted in ZETA through the system uniswap pool, for ZETA
+   *
+   * @generated from field: string gas_fee_in_zeta = 5;
+   */
+  gasFeeInZeta: string;
+
+  /**
+   * @generated from field: string protocol_fee_in_zeta = 6;
+   */
+  protocolFeeInZeta: string;
+
+  /**
+   * amount of the ERC20 swapped through the system uniswap pool to pay the gas fee and protocol flat fee, for ERC20
+   *
+   * @generated from field: string swap_amount_in = 7;
+   */
+  swapAmountIn: string;
+
+  /**
+   * @generated from field: string total_fee = 8;
+   */
+  totalFee: string;
+
+  /**
+   * zero if the amount doesn't cover the total fee, the cctx would then be aborted
+   *
+   * @generated from field: string receive_amount = 9;
+   */
+  receiveAmount: string;
+
+  constructor(data?: PartialMessage<QueryEstimateCctxFeeResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.QueryEstimateCctxFeeResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryEstimateCctxFeeResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryEstimateCctxFeeResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryEstimateCctxFeeResponse;
+
+  static equals(a: QueryEstimateCctxFeeResponse | PlainMessage<QueryEstimateCctxFeeResponse> | undefined, b: QueryEstimateCctxFeeResponse | PlainMessage<QueryEstimateCctxFeeResponse> | undefined): boolean;
+}
+
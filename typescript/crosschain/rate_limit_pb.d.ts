@@ -0,0 +1,79 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file crosschain/rate_limit.proto (package zetachain.zetacore.crosschain, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * RateLimitAction defines the action taken for an outbound exceeding the rate limit
+ *
+ * @generated from enum zetachain.zetacore.crosschain.RateLimitAction
+ */
+export declare enum RateLimitAction {
+  /**
+   * the cctx is queued as pending rate limited until the window has enough capacity
+   *
+   * @generated from enum value: Queue = 0;
+   */
+  Queue = 0,
+
+  /**
+   * the withdrawal is rejected and the zEVM transaction is reverted
+   *
+   * @generated from enum value: Reject = 1;
+   */
+  Reject = 1,
+}
+
+/**
+ * RateLimit defines the maximum outbound value to a receiver chain for an asset over a sliding window of zeta blocks
+ *
+ * @generated from message zetachain.zetacore.crosschain.RateLimit
+ */
+export declare class RateLimit extends Message<RateLimit> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * empty for ZETA
+   *
+   * @generated from field: string zrc20_address = 2;
+   */
+  zrc20Address: string;
+
+  /**
+   * @generated from field: string limit = 3;
+   */
+  limit: string;
+
+  /**
+   * number of zeta blocks of the sliding window
+   *
+   * @generated from field: int64 window = 4;
+   */
+  window: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.RateLimitAction action = 5;
+   */
+  action: RateLimitAction;
+
+  constructor(data?: PartialMessage<RateLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.RateLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RateLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RateLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RateLimit;
+
+  static equals(a: RateLimit | PlainMessage<RateLimit> | undefined, b: RateLimit | PlainMessage<RateLimit> | undefined): boolean;
+}
+
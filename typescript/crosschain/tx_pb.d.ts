@@ -6,6 +6,7 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { CoinType, Proof, ReceiveStatus } from "../common/common_pb.js";
+import type { RateLimit } from "./rate_limit_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.crosschain.MsgCreateTSSVoter
@@ -484,6 +485,13 @@ export declare class MsgGasPriceVoter extends Message<MsgGasPriceVoter> {
    */
   supply: string;
 
+  /**
+   * priority fee (EIP-1559), zero for chains not supporting it
+   *
+   * @generated from field: uint64 priority_fee = 6;
+   */
+  priorityFee: bigint;
+
   constructor(data?: PartialMessage<MsgGasPriceVoter>);
 
   static readonly runtime: typeof proto3;
@@ -833,3 +841,212 @@ export declare class MsgRefundAbortedCCTXResponse extends Message<MsgRefundAbort
   static equals(a: MsgRefundAbortedCCTXResponse | PlainMessage<MsgRefundAbortedCCTXResponse> | undefined, b: MsgRefundAbortedCCTXResponse | PlainMessage<MsgRefundAbortedCCTXResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgClaimAbortedCCTXRefund
+ */
+export declare class MsgClaimAbortedCCTXRefund extends Message<MsgClaimAbortedCCTXRefund> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  /**
+   * if not provided, the refund will be sent to the txOrigin
+   *
+   * @generated from field: string refund_address = 3;
+   */
+  refundAddress: string;
+
+  constructor(data?: PartialMessage<MsgClaimAbortedCCTXRefund>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgClaimAbortedCCTXRefund";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgClaimAbortedCCTXRefund;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgClaimAbortedCCTXRefund;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgClaimAbortedCCTXRefund;
+
+  static equals(a: MsgClaimAbortedCCTXRefund | PlainMessage<MsgClaimAbortedCCTXRefund> | undefined, b: MsgClaimAbortedCCTXRefund | PlainMessage<MsgClaimAbortedCCTXRefund> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgClaimAbortedCCTXRefundResponse
+ */
+export declare class MsgClaimAbortedCCTXRefundResponse extends Message<MsgClaimAbortedCCTXRefundResponse> {
+  constructor(data?: PartialMessage<MsgClaimAbortedCCTXRefundResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgClaimAbortedCCTXRefundResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgClaimAbortedCCTXRefundResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgClaimAbortedCCTXRefundResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgClaimAbortedCCTXRefundResponse;
+
+  static equals(a: MsgClaimAbortedCCTXRefundResponse | PlainMessage<MsgClaimAbortedCCTXRefundResponse> | undefined, b: MsgClaimAbortedCCTXRefundResponse | PlainMessage<MsgClaimAbortedCCTXRefundResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgExtendCctxDeadline
+ */
+export declare class MsgExtendCctxDeadline extends Message<MsgExtendCctxDeadline> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: string cctx_index = 2;
+   */
+  cctxIndex: string;
+
+  /**
+   * @generated from field: uint64 extension_blocks = 3;
+   */
+  extensionBlocks: bigint;
+
+  constructor(data?: PartialMessage<MsgExtendCctxDeadline>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgExtendCctxDeadline";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgExtendCctxDeadline;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgExtendCctxDeadline;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgExtendCctxDeadline;
+
+  static equals(a: MsgExtendCctxDeadline | PlainMessage<MsgExtendCctxDeadline> | undefined, b: MsgExtendCctxDeadline | PlainMessage<MsgExtendCctxDeadline> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgExtendCctxDeadlineResponse
+ */
+export declare class MsgExtendCctxDeadlineResponse extends Message<MsgExtendCctxDeadlineResponse> {
+  constructor(data?: PartialMessage<MsgExtendCctxDeadlineResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgExtendCctxDeadlineResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgExtendCctxDeadlineResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgExtendCctxDeadlineResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgExtendCctxDeadlineResponse;
+
+  static equals(a: MsgExtendCctxDeadlineResponse | PlainMessage<MsgExtendCctxDeadlineResponse> | undefined, b: MsgExtendCctxDeadlineResponse | PlainMessage<MsgExtendCctxDeadlineResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateRateLimit
+ */
+export declare class MsgUpdateRateLimit extends Message<MsgUpdateRateLimit> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: zetachain.zetacore.crosschain.RateLimit rate_limit = 2;
+   */
+  rateLimit?: RateLimit;
+
+  constructor(data?: PartialMessage<MsgUpdateRateLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateRateLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateRateLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateRateLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateRateLimit;
+
+  static equals(a: MsgUpdateRateLimit | PlainMessage<MsgUpdateRateLimit> | undefined, b: MsgUpdateRateLimit | PlainMessage<MsgUpdateRateLimit> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgUpdateRateLimitResponse
+ */
+export declare class MsgUpdateRateLimitResponse extends Message<MsgUpdateRateLimitResponse> {
+  constructor(data?: PartialMessage<MsgUpdateRateLimitResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgUpdateRateLimitResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateRateLimitResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateRateLimitResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateRateLimitResponse;
+
+  static equals(a: MsgUpdateRateLimitResponse | PlainMessage<MsgUpdateRateLimitResponse> | undefined, b: MsgUpdateRateLimitResponse | PlainMessage<MsgUpdateRateLimitResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgRemoveRateLimit
+ */
+export declare class MsgRemoveRateLimit extends Message<MsgRemoveRateLimit> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: string zrc20_address = 3;
+   */
+  zrc20Address: string;
+
+  constructor(data?: PartialMessage<MsgRemoveRateLimit>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgRemoveRateLimit";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRemoveRateLimit;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRemoveRateLimit;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRemoveRateLimit;
+
+  static equals(a: MsgRemoveRateLimit | PlainMessage<MsgRemoveRateLimit> | undefined, b: MsgRemoveRateLimit | PlainMessage<MsgRemoveRateLimit> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.crosschain.MsgRemoveRateLimitResponse
+ */
+export declare class MsgRemoveRateLimitResponse extends Message<MsgRemoveRateLimitResponse> {
+  constructor(data?: PartialMessage<MsgRemoveRateLimitResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.crosschain.MsgRemoveRateLimitResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgRemoveRateLimitResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgRemoveRateLimitResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgRemoveRateLimitResponse;
+
+  static equals(a: MsgRemoveRateLimitResponse | PlainMessage<MsgRemoveRateLimitResponse> | undefined, b: MsgRemoveRateLimitResponse | PlainMessage<MsgRemoveRateLimitResponse> | undefined): boolean;
+}
+
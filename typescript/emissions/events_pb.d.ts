@@ -93,21 +93,11 @@ export declare class EventBlockEmissions extends Message<EventBlockEmissions> {
    */
   msgTypeUrl: string;
 
-  /**
-   * @generated from field: string bond_factor = 2;
-   */
-  bondFactor: string;
-
   /**
    * @generated from field: string reserves_factor = 3;
    */
   reservesFactor: string;
 
-  /**
-   * @generated from field: string duration_factor = 4;
-   */
-  durationFactor: string;
-
   /**
    * @generated from field: string validator_rewards_for_block = 5;
    */
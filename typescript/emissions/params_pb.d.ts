@@ -6,6 +6,33 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 
+/**
+ * @generated from enum zetachain.zetacore.emissions.EmissionDecayType
+ */
+export declare enum EmissionDecayType {
+  /**
+   * the block rewards don't decrease
+   *
+   * @generated from enum value: Constant = 0;
+   */
+  Constant = 0,
+
+  /**
+   * the block rewards are reduced by the decay rate at the end of each decay interval,
+   * a decay rate of 0.5 halves the block rewards at each interval
+   *
+   * @generated from enum value: Step = 1;
+   */
+  Step = 1,
+
+  /**
+   * the block rewards are reduced by the decay rate at each block
+   *
+   * @generated from enum value: Exponential = 2;
+   */
+  Exponential = 2,
+}
+
 /**
  * Params defines the parameters for the module.
  *
@@ -13,49 +40,59 @@ import { Message, proto3 } from "@bufbuild/protobuf";
  */
 export declare class Params extends Message<Params> {
   /**
-   * @generated from field: string max_bond_factor = 1;
+   * @generated from field: string validator_emission_percentage = 5;
    */
-  maxBondFactor: string;
+  validatorEmissionPercentage: string;
 
   /**
-   * @generated from field: string min_bond_factor = 2;
+   * @generated from field: string observer_emission_percentage = 6;
    */
-  minBondFactor: string;
+  observerEmissionPercentage: string;
 
   /**
-   * @generated from field: string avg_block_time = 3;
+   * @generated from field: string tss_signer_emission_percentage = 7;
    */
-  avgBlockTime: string;
+  tssSignerEmissionPercentage: string;
 
   /**
-   * @generated from field: string target_bond_ratio = 4;
+   * amount of azeta slashed from the withdrawable emissions of an observer for an incorrect vote
+   *
+   * @generated from field: string observer_slash_amount = 9;
    */
-  targetBondRatio: string;
+  observerSlashAmount: string;
 
   /**
-   * @generated from field: string validator_emission_percentage = 5;
+   * block rewards in azeta before any decay, distributed among validators, observers and tss signers
+   *
+   * @generated from field: string block_reward_amount = 10;
    */
-  validatorEmissionPercentage: string;
+  blockRewardAmount: string;
 
   /**
-   * @generated from field: string observer_emission_percentage = 6;
+   * @generated from field: zetachain.zetacore.emissions.EmissionDecayType decay_type = 11;
    */
-  observerEmissionPercentage: string;
+  decayType: EmissionDecayType;
 
   /**
-   * @generated from field: string tss_signer_emission_percentage = 7;
+   * fraction of the block rewards removed at each decay, between 0 and 1
+   *
+   * @generated from field: string decay_rate = 12;
    */
-  tssSignerEmissionPercentage: string;
+  decayRate: string;
 
   /**
-   * @generated from field: string duration_factor_constant = 8;
+   * number of blocks between two decays of the step decay type
+   *
+   * @generated from field: int64 decay_interval_blocks = 13;
    */
-  durationFactorConstant: string;
+  decayIntervalBlocks: bigint;
 
   /**
-   * @generated from field: string observer_slash_amount = 9;
+   * height from which the block rewards start decreasing
+   *
+   * @generated from field: int64 decay_start_height = 14;
    */
-  observerSlashAmount: string;
+  decayStartHeight: bigint;
 
   constructor(data?: PartialMessage<Params>);
 
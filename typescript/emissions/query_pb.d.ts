@@ -137,16 +137,6 @@ export declare class QueryGetEmissionsFactorsResponse extends Message<QueryGetEm
    */
   reservesFactor: string;
 
-  /**
-   * @generated from field: string bondFactor = 2;
-   */
-  bondFactor: string;
-
-  /**
-   * @generated from field: string durationFactor = 3;
-   */
-  durationFactor: string;
-
   constructor(data?: PartialMessage<QueryGetEmissionsFactorsResponse>);
 
   static readonly runtime: typeof proto3;
@@ -210,3 +200,73 @@ export declare class QueryShowAvailableEmissionsResponse extends Message<QuerySh
   static equals(a: QueryShowAvailableEmissionsResponse | PlainMessage<QueryShowAvailableEmissionsResponse> | undefined, b: QueryShowAvailableEmissionsResponse | PlainMessage<QueryShowAvailableEmissionsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.emissions.QueryBlockRewardsRequest
+ */
+export declare class QueryBlockRewardsRequest extends Message<QueryBlockRewardsRequest> {
+  /**
+   * the current height is used if not set
+   *
+   * @generated from field: int64 height = 1;
+   */
+  height: bigint;
+
+  constructor(data?: PartialMessage<QueryBlockRewardsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.emissions.QueryBlockRewardsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBlockRewardsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBlockRewardsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBlockRewardsRequest;
+
+  static equals(a: QueryBlockRewardsRequest | PlainMessage<QueryBlockRewardsRequest> | undefined, b: QueryBlockRewardsRequest | PlainMessage<QueryBlockRewardsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.emissions.QueryBlockRewardsResponse
+ */
+export declare class QueryBlockRewardsResponse extends Message<QueryBlockRewardsResponse> {
+  /**
+   * @generated from field: int64 height = 1;
+   */
+  height: bigint;
+
+  /**
+   * @generated from field: string block_rewards = 2;
+   */
+  blockRewards: string;
+
+  /**
+   * @generated from field: string validator_rewards = 3;
+   */
+  validatorRewards: string;
+
+  /**
+   * @generated from field: string observer_rewards = 4;
+   */
+  observerRewards: string;
+
+  /**
+   * @generated from field: string tss_signer_rewards = 5;
+   */
+  tssSignerRewards: string;
+
+  constructor(data?: PartialMessage<QueryBlockRewardsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.emissions.QueryBlockRewardsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBlockRewardsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBlockRewardsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBlockRewardsResponse;
+
+  static equals(a: QueryBlockRewardsResponse | PlainMessage<QueryBlockRewardsResponse> | undefined, b: QueryBlockRewardsResponse | PlainMessage<QueryBlockRewardsResponse> | undefined): boolean;
+}
+
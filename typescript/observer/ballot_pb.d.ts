@@ -93,6 +93,21 @@ export declare class Ballot extends Message<Ballot> {
    */
   ballotCreationHeight: bigint;
 
+  /**
+   * chain observed by the ballot, 0 for ballots not related to a chain
+   *
+   * @generated from field: int64 chain_id = 9;
+   */
+  chainId: bigint;
+
+  /**
+   * bonded stake of each voter of voter_list at the creation of the ballot
+   * empty if the votes are not weighted by stake
+   *
+   * @generated from field: repeated string voter_weights = 10;
+   */
+  voterWeights: string[];
+
   constructor(data?: PartialMessage<Ballot>);
 
   static readonly runtime: typeof proto3;
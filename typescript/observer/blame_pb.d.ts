@@ -59,6 +59,20 @@ export declare class Blame extends Message<Blame> {
    */
   nodes: Node[];
 
+  /**
+   * chain of the blame vote, set when the blame is finalized
+   *
+   * @generated from field: int64 chain_id = 4;
+   */
+  chainId: bigint;
+
+  /**
+   * zeta height at which the blame was finalized, the blame is pruned once the retention period is elapsed
+   *
+   * @generated from field: int64 finalized_height = 5;
+   */
+  finalizedHeight: bigint;
+
   constructor(data?: PartialMessage<Blame>);
 
   static readonly runtime: typeof proto3;
@@ -74,3 +88,72 @@ export declare class Blame extends Message<Blame> {
   static equals(a: Blame | PlainMessage<Blame> | undefined, b: Blame | PlainMessage<Blame> | undefined): boolean;
 }
 
+
+/**
+ * BlameCount is the number of blame records of a chain a node was blamed in
+ *
+ * @generated from message zetachain.zetacore.observer.BlameCount
+ */
+export declare class BlameCount extends Message<BlameCount> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: uint64 count = 2;
+   */
+  count: bigint;
+
+  constructor(data?: PartialMessage<BlameCount>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.BlameCount";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlameCount;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlameCount;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlameCount;
+
+  static equals(a: BlameCount | PlainMessage<BlameCount> | undefined, b: BlameCount | PlainMessage<BlameCount> | undefined): boolean;
+}
+
+/**
+ * NodeBlameStatistics contains the number of times a node was blamed per chain
+ *
+ * @generated from message zetachain.zetacore.observer.NodeBlameStatistics
+ */
+export declare class NodeBlameStatistics extends Message<NodeBlameStatistics> {
+  /**
+   * @generated from field: string pub_key = 1;
+   */
+  pubKey: string;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.BlameCount blame_counts = 2;
+   */
+  blameCounts: BlameCount[];
+
+  /**
+   * number of times the node was blamed on all the chains
+   *
+   * @generated from field: uint64 total = 3;
+   */
+  total: bigint;
+
+  constructor(data?: PartialMessage<NodeBlameStatistics>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.NodeBlameStatistics";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NodeBlameStatistics;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NodeBlameStatistics;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NodeBlameStatistics;
+
+  static equals(a: NodeBlameStatistics | PlainMessage<NodeBlameStatistics> | undefined, b: NodeBlameStatistics | PlainMessage<NodeBlameStatistics> | undefined): boolean;
+}
@@ -30,6 +30,13 @@ export declare class BlockHeaderState extends Message<BlockHeaderState> {
    */
   latestBlockHash: Uint8Array;
 
+  /**
+   * accumulated proof-of-work of the latest block header, only tracked for bitcoin chains
+   *
+   * @generated from field: string latest_chain_work = 5;
+   */
+  latestChainWork: string;
+
   constructor(data?: PartialMessage<BlockHeaderState>);
 
   static readonly runtime: typeof proto3;
@@ -45,3 +52,39 @@ export declare class BlockHeaderState extends Message<BlockHeaderState> {
   static equals(a: BlockHeaderState | PlainMessage<BlockHeaderState> | undefined, b: BlockHeaderState | PlainMessage<BlockHeaderState> | undefined): boolean;
 }
 
+/**
+ * BlockHeaderWork is the accumulated proof-of-work of a bitcoin block header and its ancestors
+ *
+ * @generated from message zetachain.zetacore.observer.BlockHeaderWork
+ */
+export declare class BlockHeaderWork extends Message<BlockHeaderWork> {
+  /**
+   * @generated from field: bytes block_hash = 1;
+   */
+  blockHash: Uint8Array;
+
+  /**
+   * @generated from field: int64 height = 2;
+   */
+  height: bigint;
+
+  /**
+   * @generated from field: string chain_work = 3;
+   */
+  chainWork: string;
+
+  constructor(data?: PartialMessage<BlockHeaderWork>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.BlockHeaderWork";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BlockHeaderWork;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BlockHeaderWork;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BlockHeaderWork;
+
+  static equals(a: BlockHeaderWork | PlainMessage<BlockHeaderWork> | undefined, b: BlockHeaderWork | PlainMessage<BlockHeaderWork> | undefined): boolean;
+}
+
@@ -69,6 +69,16 @@ export declare class BlockHeaderVerificationFlags extends Message<BlockHeaderVer
    */
   isBtcTypeChainEnabled: boolean;
 
+  /**
+   * @generated from field: bool isBscTypeChainEnabled = 3;
+   */
+  isBscTypeChainEnabled: boolean;
+
+  /**
+   * @generated from field: bool isPolygonTypeChainEnabled = 4;
+   */
+  isPolygonTypeChainEnabled: boolean;
+
   constructor(data?: PartialMessage<BlockHeaderVerificationFlags>);
 
   static readonly runtime: typeof proto3;
@@ -84,6 +94,119 @@ export declare class BlockHeaderVerificationFlags extends Message<BlockHeaderVer
   static equals(a: BlockHeaderVerificationFlags | PlainMessage<BlockHeaderVerificationFlags> | undefined, b: BlockHeaderVerificationFlags | PlainMessage<BlockHeaderVerificationFlags> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.PruningFlags
+ */
+export declare class PruningFlags extends Message<PruningFlags> {
+  /**
+   * Number of blocks a terminal cctx is kept in the state before being pruned
+   * Pruning is disabled if 0
+   *
+   * @generated from field: int64 retentionBlocks = 1;
+   */
+  retentionBlocks: bigint;
+
+  /**
+   * Maximum number of cctxs pruned per block
+   *
+   * @generated from field: uint32 maxPrunedPerBlock = 2;
+   */
+  maxPrunedPerBlock: number;
+
+  constructor(data?: PartialMessage<PruningFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.PruningFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PruningFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PruningFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PruningFlags;
+
+  static equals(a: PruningFlags | PlainMessage<PruningFlags> | undefined, b: PruningFlags | PlainMessage<PruningFlags> | undefined): boolean;
+}
+
+/**
+ * ChainFlags enables or disables the inbounds and outbounds of a chain
+ *
+ * @generated from message zetachain.zetacore.observer.ChainFlags
+ */
+export declare class ChainFlags extends Message<ChainFlags> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: bool isInboundEnabled = 2;
+   */
+  isInboundEnabled: boolean;
+
+  /**
+   * @generated from field: bool isOutboundEnabled = 3;
+   */
+  isOutboundEnabled: boolean;
+
+  constructor(data?: PartialMessage<ChainFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ChainFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ChainFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ChainFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ChainFlags;
+
+  static equals(a: ChainFlags | PlainMessage<ChainFlags> | undefined, b: ChainFlags | PlainMessage<ChainFlags> | undefined): boolean;
+}
+
+/**
+ * ForeignCoinFlags enables or disables the inbounds and outbounds of a foreign coin
+ *
+ * @generated from message zetachain.zetacore.observer.ForeignCoinFlags
+ */
+export declare class ForeignCoinFlags extends Message<ForeignCoinFlags> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * asset of the foreign coin on its chain, empty for the gas coin
+   *
+   * @generated from field: string asset = 2;
+   */
+  asset: string;
+
+  /**
+   * @generated from field: bool isInboundEnabled = 3;
+   */
+  isInboundEnabled: boolean;
+
+  /**
+   * @generated from field: bool isOutboundEnabled = 4;
+   */
+  isOutboundEnabled: boolean;
+
+  constructor(data?: PartialMessage<ForeignCoinFlags>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ForeignCoinFlags";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForeignCoinFlags;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForeignCoinFlags;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForeignCoinFlags;
+
+  static equals(a: ForeignCoinFlags | PlainMessage<ForeignCoinFlags> | undefined, b: ForeignCoinFlags | PlainMessage<ForeignCoinFlags> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.CrosschainFlags
  */
@@ -108,6 +231,23 @@ export declare class CrosschainFlags extends Message<CrosschainFlags> {
    */
   blockHeaderVerificationFlags?: BlockHeaderVerificationFlags;
 
+  /**
+   * @generated from field: zetachain.zetacore.observer.PruningFlags pruningFlags = 5;
+   */
+  pruningFlags?: PruningFlags;
+
+  /**
+   * chainFlags and foreignCoinFlags list the chains and foreign coins with inbounds or outbounds disabled
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ChainFlags chainFlags = 6;
+   */
+  chainFlags: ChainFlags[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ForeignCoinFlags foreignCoinFlags = 7;
+   */
+  foreignCoinFlags: ForeignCoinFlags[];
+
   constructor(data?: PartialMessage<CrosschainFlags>);
 
   static readonly runtime: typeof proto3;
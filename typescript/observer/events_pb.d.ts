@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { BlockHeaderVerificationFlags, GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { BlockHeaderVerificationFlags, ChainFlags, ForeignCoinFlags, GasPriceIncreaseFlags, PruningFlags } from "./crosschain_flags_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.EventBallotCreated
@@ -163,6 +163,21 @@ export declare class EventCrosschainFlagsUpdated extends Message<EventCrosschain
    */
   blockHeaderVerificationFlags?: BlockHeaderVerificationFlags;
 
+  /**
+   * @generated from field: zetachain.zetacore.observer.PruningFlags pruningFlags = 7;
+   */
+  pruningFlags?: PruningFlags;
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ChainFlags chainFlags = 8;
+   */
+  chainFlags: ChainFlags[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ForeignCoinFlags foreignCoinFlags = 9;
+   */
+  foreignCoinFlags: ForeignCoinFlags[];
+
   constructor(data?: PartialMessage<EventCrosschainFlagsUpdated>);
 
   static readonly runtime: typeof proto3;
@@ -178,3 +193,139 @@ export declare class EventCrosschainFlagsUpdated extends Message<EventCrosschain
   static equals(a: EventCrosschainFlagsUpdated | PlainMessage<EventCrosschainFlagsUpdated> | undefined, b: EventCrosschainFlagsUpdated | PlainMessage<EventCrosschainFlagsUpdated> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverJailed
+ */
+export declare class EventObserverJailed extends Message<EventObserverJailed> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 window_ballots = 2;
+   */
+  windowBallots: bigint;
+
+  /**
+   * @generated from field: int64 window_missed_ballots = 3;
+   */
+  windowMissedBallots: bigint;
+
+  /**
+   * @generated from field: int64 jailed_until = 4;
+   */
+  jailedUntil: bigint;
+
+  /**
+   * @generated from field: string slashed_amount = 5;
+   */
+  slashedAmount: string;
+
+  constructor(data?: PartialMessage<EventObserverJailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverJailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverJailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverJailed;
+
+  static equals(a: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined, b: EventObserverJailed | PlainMessage<EventObserverJailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventObserverUnjailed
+ */
+export declare class EventObserverUnjailed extends Message<EventObserverUnjailed> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: string observer_address = 2;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<EventObserverUnjailed>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventObserverUnjailed";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventObserverUnjailed;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventObserverUnjailed;
+
+  static equals(a: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined, b: EventObserverUnjailed | PlainMessage<EventObserverUnjailed> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventChainParamsScheduled
+ */
+export declare class EventChainParamsScheduled extends Message<EventChainParamsScheduled> {
+  /**
+   * @generated from field: string msg_type_url = 1;
+   */
+  msgTypeUrl: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: int64 activation_height = 3;
+   */
+  activationHeight: bigint;
+
+  constructor(data?: PartialMessage<EventChainParamsScheduled>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventChainParamsScheduled";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventChainParamsScheduled;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventChainParamsScheduled;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventChainParamsScheduled;
+
+  static equals(a: EventChainParamsScheduled | PlainMessage<EventChainParamsScheduled> | undefined, b: EventChainParamsScheduled | PlainMessage<EventChainParamsScheduled> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.EventChainParamsActivated
+ */
+export declare class EventChainParamsActivated extends Message<EventChainParamsActivated> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: int64 activation_height = 2;
+   */
+  activationHeight: bigint;
+
+  constructor(data?: PartialMessage<EventChainParamsActivated>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EventChainParamsActivated";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EventChainParamsActivated;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EventChainParamsActivated;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EventChainParamsActivated;
+
+  static equals(a: EventChainParamsActivated | PlainMessage<EventChainParamsActivated> | undefined, b: EventChainParamsActivated | PlainMessage<EventChainParamsActivated> | undefined): boolean;
+}
+
@@ -6,10 +6,10 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
 import type { Ballot } from "./ballot_pb.js";
-import type { LastObserverCount, ObserverSet } from "./observer_pb.js";
+import type { LastObserverCount, ObserverChains, ObserverSet } from "./observer_pb.js";
 import type { NodeAccount } from "./node_account_pb.js";
 import type { CrosschainFlags } from "./crosschain_flags_pb.js";
-import type { ChainParamsList, Params } from "./params_pb.js";
+import type { ChainParamsList, Params, PendingChainParams } from "./params_pb.js";
 import type { Keygen } from "./keygen_pb.js";
 import type { TSS } from "./tss_pb.js";
 import type { TssFundMigratorInfo } from "./tss_funds_migrator_pb.js";
@@ -17,6 +17,7 @@ import type { Blame } from "./blame_pb.js";
 import type { PendingNonces } from "./pending_nonces_pb.js";
 import type { ChainNonces } from "./chain_nonces_pb.js";
 import type { NonceToCctx } from "./nonce_to_cctx_pb.js";
+import type { ObserverLiveness } from "./observer_liveness_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.GenesisState
@@ -97,6 +98,21 @@ export declare class GenesisState extends Message<GenesisState> {
    */
   nonceToCctx: NonceToCctx[];
 
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverChains observer_chains = 16;
+   */
+  observerChains: ObserverChains[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLiveness observer_liveness = 17;
+   */
+  observerLiveness: ObserverLiveness[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.PendingChainParams pending_chain_params = 18;
+   */
+  pendingChainParams: PendingChainParams[];
+
   constructor(data?: PartialMessage<GenesisState>);
 
   static readonly runtime: typeof proto3;
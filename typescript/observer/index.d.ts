@@ -6,9 +6,12 @@ export * from "./crosschain_flags_pb";
 export * from "./events_pb";
 export * from "./genesis_pb";
 export * from "./keygen_pb";
+export * from "./light_client_pb";
 export * from "./node_account_pb";
 export * from "./nonce_to_cctx_pb";
 export * from "./observer_pb";
+export * from "./observer_liveness_pb";
+export * from "./observer_performance_pb";
 export * from "./params_pb";
 export * from "./pending_nonces_pb";
 export * from "./query_pb";
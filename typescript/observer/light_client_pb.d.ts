@@ -0,0 +1,41 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file observer/light_client.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import type { LightClientStore } from "../common/ethereum/light_client_pb.js";
+
+/**
+ * EthereumLightClient is the beacon chain light client of an Ethereum chain
+ * when the light client is set, only the execution block headers committed to by a finalized beacon header are accepted
+ *
+ * @generated from message zetachain.zetacore.observer.EthereumLightClient
+ */
+export declare class EthereumLightClient extends Message<EthereumLightClient> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: ethereum.LightClientStore store = 2;
+   */
+  store?: LightClientStore;
+
+  constructor(data?: PartialMessage<EthereumLightClient>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.EthereumLightClient";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EthereumLightClient;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EthereumLightClient;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EthereumLightClient;
+
+  static equals(a: EthereumLightClient | PlainMessage<EthereumLightClient> | undefined, b: EthereumLightClient | PlainMessage<EthereumLightClient> | undefined): boolean;
+}
+
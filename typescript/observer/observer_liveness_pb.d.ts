@@ -0,0 +1,70 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file observer/observer_liveness.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * ObserverLiveness contains the liveness state of an observer
+ * the observer is jailed if it misses too many ballots in a window of matured ballots
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverLiveness
+ */
+export declare class ObserverLiveness extends Message<ObserverLiveness> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * number of matured ballots the observer was eligible to vote on in the current window
+   *
+   * @generated from field: int64 window_ballots = 2;
+   */
+  windowBallots: bigint;
+
+  /**
+   * number of matured ballots the observer missed in the current window
+   *
+   * @generated from field: int64 window_missed_ballots = 3;
+   */
+  windowMissedBallots: bigint;
+
+  /**
+   * a jailed observer is not a voter of the new ballots
+   *
+   * @generated from field: bool jailed = 4;
+   */
+  jailed: boolean;
+
+  /**
+   * zeta height from which a jailed observer can be unjailed
+   *
+   * @generated from field: int64 jailed_until = 5;
+   */
+  jailedUntil: bigint;
+
+  /**
+   * number of times the observer has been jailed
+   *
+   * @generated from field: uint64 jail_count = 6;
+   */
+  jailCount: bigint;
+
+  constructor(data?: PartialMessage<ObserverLiveness>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverLiveness";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverLiveness;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverLiveness;
+
+  static equals(a: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined, b: ObserverLiveness | PlainMessage<ObserverLiveness> | undefined): boolean;
+}
+
@@ -115,6 +115,38 @@ export declare class ObserverSet extends Message<ObserverSet> {
   static equals(a: ObserverSet | PlainMessage<ObserverSet> | undefined, b: ObserverSet | PlainMessage<ObserverSet> | undefined): boolean;
 }
 
+/**
+ * ObserverChains contains the chains an observer is assigned to
+ * an observer without assigned chains observes all the supported chains
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverChains
+ */
+export declare class ObserverChains extends Message<ObserverChains> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: repeated int64 chain_ids = 2;
+   */
+  chainIds: bigint[];
+
+  constructor(data?: PartialMessage<ObserverChains>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverChains";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverChains;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverChains;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverChains;
+
+  static equals(a: ObserverChains | PlainMessage<ObserverChains> | undefined, b: ObserverChains | PlainMessage<ObserverChains> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.LastObserverCount
  */
@@ -0,0 +1,88 @@
+// @generated by protoc-gen-es v1.3.0 with parameter "target=dts"
+// @generated from file observer/observer_performance.proto (package zetachain.zetacore.observer, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import type { ObservationType } from "./observer_pb.js";
+
+/**
+ * ObserverPerformance contains the participation counters of an observer for a chain and an observation type
+ * the counters are updated when the ballots mature
+ * store key is observer_address/chain_id/observation_type
+ *
+ * @generated from message zetachain.zetacore.observer.ObserverPerformance
+ */
+export declare class ObserverPerformance extends Message<ObserverPerformance> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObservationType observation_type = 3;
+   */
+  observationType: ObservationType;
+
+  /**
+   * number of matured ballots the observer was eligible to vote on
+   *
+   * @generated from field: uint64 ballots_eligible = 4;
+   */
+  ballotsEligible: bigint;
+
+  /**
+   * number of matured ballots the observer voted on
+   *
+   * @generated from field: uint64 ballots_voted = 5;
+   */
+  ballotsVoted: bigint;
+
+  /**
+   * number of matured finalized ballots the observer voted on with the finalized outcome
+   *
+   * @generated from field: uint64 ballots_voted_with_majority = 6;
+   */
+  ballotsVotedWithMajority: bigint;
+
+  /**
+   * number of votes cast after the ballot was finalized
+   *
+   * @generated from field: uint64 ballots_voted_late = 7;
+   */
+  ballotsVotedLate: bigint;
+
+  /**
+   * number of matured ballots the observer didn't vote on
+   *
+   * @generated from field: uint64 ballots_missed = 8;
+   */
+  ballotsMissed: bigint;
+
+  /**
+   * zeta height of the last update of the counters
+   *
+   * @generated from field: int64 last_updated_height = 9;
+   */
+  lastUpdatedHeight: bigint;
+
+  constructor(data?: PartialMessage<ObserverPerformance>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.ObserverPerformance";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ObserverPerformance;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ObserverPerformance;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ObserverPerformance;
+
+  static equals(a: ObserverPerformance | PlainMessage<ObserverPerformance> | undefined, b: ObserverPerformance | PlainMessage<ObserverPerformance> | undefined): boolean;
+}
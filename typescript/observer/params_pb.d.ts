@@ -122,6 +122,21 @@ export declare class ChainParams extends Message<ChainParams> {
    */
   isSupported: boolean;
 
+  /**
+   * maximum number of zeta blocks a CCTX can stay in PendingOutbound before it expires
+   * 0 disables the expiry
+   *
+   * @generated from field: uint64 max_outbound_pending_blocks = 17;
+   */
+  maxOutboundPendingBlocks: bigint;
+
+  /**
+   * if true, the ballots of the chain are finalized on the bonded stake of the voters instead of the number of votes
+   *
+   * @generated from field: bool stake_weighted_ballots = 18;
+   */
+  stakeWeightedBallots: boolean;
+
   constructor(data?: PartialMessage<ChainParams>);
 
   static readonly runtime: typeof proto3;
@@ -137,6 +152,39 @@ export declare class ChainParams extends Message<ChainParams> {
   static equals(a: ChainParams | PlainMessage<ChainParams> | undefined, b: ChainParams | PlainMessage<ChainParams> | undefined): boolean;
 }
 
+/**
+ * PendingChainParams contains chain params scheduled to replace the chain params of a chain at the activation height
+ *
+ * @generated from message zetachain.zetacore.observer.PendingChainParams
+ */
+export declare class PendingChainParams extends Message<PendingChainParams> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ChainParams chain_params = 1;
+   */
+  chainParams?: ChainParams;
+
+  /**
+   * zeta height at which the chain params are applied
+   *
+   * @generated from field: int64 activation_height = 2;
+   */
+  activationHeight: bigint;
+
+  constructor(data?: PartialMessage<PendingChainParams>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.PendingChainParams";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PendingChainParams;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PendingChainParams;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PendingChainParams;
+
+  static equals(a: PendingChainParams | PlainMessage<PendingChainParams> | undefined, b: PendingChainParams | PlainMessage<PendingChainParams> | undefined): boolean;
+}
+
 /**
  * Deprecated(v13): Use ChainParamsList
  *
@@ -234,6 +282,42 @@ export declare class Params extends Message<Params> {
    */
   ballotMaturityBlocks: bigint;
 
+  /**
+   * liveness policy of the observers, the policy is disabled if missed_ballots_window is zero
+   * number of matured ballots an observer must be eligible to vote on to complete a liveness window
+   *
+   * @generated from field: int64 missed_ballots_window = 4;
+   */
+  missedBallotsWindow: bigint;
+
+  /**
+   * maximum ratio of missed ballots in a liveness window, the observer is jailed above this ratio
+   *
+   * @generated from field: string max_missed_ballots_ratio = 5;
+   */
+  maxMissedBallotsRatio: string;
+
+  /**
+   * number of blocks a jailed observer is removed from the ballots before it can be unjailed
+   *
+   * @generated from field: int64 observer_jail_duration = 6;
+   */
+  observerJailDuration: bigint;
+
+  /**
+   * fraction of the stake of the validator slashed when the observer is jailed
+   *
+   * @generated from field: string observer_slash_fraction = 7;
+   */
+  observerSlashFraction: string;
+
+  /**
+   * number of blocks a blame record is kept after its finalization before it is pruned, 0 disables the pruning
+   *
+   * @generated from field: int64 blame_retention_blocks = 8;
+   */
+  blameRetentionBlocks: bigint;
+
   constructor(data?: PartialMessage<Params>);
 
   static readonly runtime: typeof proto3;
@@ -5,19 +5,87 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3 } from "@bufbuild/protobuf";
-import type { ChainNonces } from "./chain_nonces_pb.js";
 import type { PageRequest, PageResponse } from "../cosmos/base/query/v1beta1/pagination_pb.js";
+import type { ObserverPerformance } from "./observer_performance_pb.js";
+import type { ChainNonces } from "./chain_nonces_pb.js";
 import type { PendingNonces } from "./pending_nonces_pb.js";
 import type { TSS } from "./tss_pb.js";
 import type { BlockHeader, Chain, Proof } from "../common/common_pb.js";
-import type { ChainParams, ChainParamsList, Params } from "./params_pb.js";
-import type { BallotStatus, VoteType } from "./ballot_pb.js";
+import type { ChainParams, ChainParamsList, Params, PendingChainParams } from "./params_pb.js";
+import type { Ballot, BallotStatus, VoteType } from "./ballot_pb.js";
 import type { LastObserverCount, ObservationType } from "./observer_pb.js";
+import type { ObserverLiveness } from "./observer_liveness_pb.js";
 import type { NodeAccount } from "./node_account_pb.js";
 import type { CrosschainFlags } from "./crosschain_flags_pb.js";
 import type { Keygen } from "./keygen_pb.js";
-import type { Blame } from "./blame_pb.js";
+import type { Blame, NodeBlameStatistics } from "./blame_pb.js";
 import type { BlockHeaderState } from "./block_header_pb.js";
+import type { EthereumLightClient } from "./light_client_pb.js";
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverPerformanceRequest
+ */
+export declare class QueryObserverPerformanceRequest extends Message<QueryObserverPerformanceRequest> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  /**
+   * chain to get the counters for, 0 for all chains
+   *
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 3;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryObserverPerformanceRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverPerformanceRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverPerformanceRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverPerformanceRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverPerformanceRequest;
+
+  static equals(a: QueryObserverPerformanceRequest | PlainMessage<QueryObserverPerformanceRequest> | undefined, b: QueryObserverPerformanceRequest | PlainMessage<QueryObserverPerformanceRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverPerformanceResponse
+ */
+export declare class QueryObserverPerformanceResponse extends Message<QueryObserverPerformanceResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverPerformance observer_performances = 1;
+   */
+  observerPerformances: ObserverPerformance[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryObserverPerformanceResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverPerformanceResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverPerformanceResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverPerformanceResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverPerformanceResponse;
+
+  static equals(a: QueryObserverPerformanceResponse | PlainMessage<QueryObserverPerformanceResponse> | undefined, b: QueryObserverPerformanceResponse | PlainMessage<QueryObserverPerformanceResponse> | undefined): boolean;
+}
 
 /**
  * @generated from message zetachain.zetacore.observer.QueryGetChainNoncesRequest
@@ -688,6 +756,199 @@ export declare class QueryBallotByIdentifierResponse extends Message<QueryBallot
   static equals(a: QueryBallotByIdentifierResponse | PlainMessage<QueryBallotByIdentifierResponse> | undefined, b: QueryBallotByIdentifierResponse | PlainMessage<QueryBallotByIdentifierResponse> | undefined): boolean;
 }
 
+/**
+ * max_height is the current height if zero, the ballots are returned in the order of their creation
+ *
+ * @generated from message zetachain.zetacore.observer.QueryBallotsByHeightRequest
+ */
+export declare class QueryBallotsByHeightRequest extends Message<QueryBallotsByHeightRequest> {
+  /**
+   * @generated from field: int64 min_height = 1;
+   */
+  minHeight: bigint;
+
+  /**
+   * @generated from field: int64 max_height = 2;
+   */
+  maxHeight: bigint;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 3;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryBallotsByHeightRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBallotsByHeightRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBallotsByHeightRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBallotsByHeightRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBallotsByHeightRequest;
+
+  static equals(a: QueryBallotsByHeightRequest | PlainMessage<QueryBallotsByHeightRequest> | undefined, b: QueryBallotsByHeightRequest | PlainMessage<QueryBallotsByHeightRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBallotsByHeightResponse
+ */
+export declare class QueryBallotsByHeightResponse extends Message<QueryBallotsByHeightResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.Ballot ballots = 1;
+   */
+  ballots: Ballot[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryBallotsByHeightResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBallotsByHeightResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBallotsByHeightResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBallotsByHeightResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBallotsByHeightResponse;
+
+  static equals(a: QueryBallotsByHeightResponse | PlainMessage<QueryBallotsByHeightResponse> | undefined, b: QueryBallotsByHeightResponse | PlainMessage<QueryBallotsByHeightResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBallotsByVoterRequest
+ */
+export declare class QueryBallotsByVoterRequest extends Message<QueryBallotsByVoterRequest> {
+  /**
+   * @generated from field: string voter_address = 1;
+   */
+  voterAddress: string;
+
+  /**
+   * @generated from field: bool has_voted = 2;
+   */
+  hasVoted: boolean;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 3;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryBallotsByVoterRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBallotsByVoterRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBallotsByVoterRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBallotsByVoterRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBallotsByVoterRequest;
+
+  static equals(a: QueryBallotsByVoterRequest | PlainMessage<QueryBallotsByVoterRequest> | undefined, b: QueryBallotsByVoterRequest | PlainMessage<QueryBallotsByVoterRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBallotsByVoterResponse
+ */
+export declare class QueryBallotsByVoterResponse extends Message<QueryBallotsByVoterResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.Ballot ballots = 1;
+   */
+  ballots: Ballot[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryBallotsByVoterResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBallotsByVoterResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBallotsByVoterResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBallotsByVoterResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBallotsByVoterResponse;
+
+  static equals(a: QueryBallotsByVoterResponse | PlainMessage<QueryBallotsByVoterResponse> | undefined, b: QueryBallotsByVoterResponse | PlainMessage<QueryBallotsByVoterResponse> | undefined): boolean;
+}
+
+/**
+ * all the observation types are returned if observation_type is EmptyObserverType
+ *
+ * @generated from message zetachain.zetacore.observer.QueryBallotsByStatusRequest
+ */
+export declare class QueryBallotsByStatusRequest extends Message<QueryBallotsByStatusRequest> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.BallotStatus ballot_status = 1;
+   */
+  ballotStatus: BallotStatus;
+
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObservationType observation_type = 2;
+   */
+  observationType: ObservationType;
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 3;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryBallotsByStatusRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBallotsByStatusRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBallotsByStatusRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBallotsByStatusRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBallotsByStatusRequest;
+
+  static equals(a: QueryBallotsByStatusRequest | PlainMessage<QueryBallotsByStatusRequest> | undefined, b: QueryBallotsByStatusRequest | PlainMessage<QueryBallotsByStatusRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBallotsByStatusResponse
+ */
+export declare class QueryBallotsByStatusResponse extends Message<QueryBallotsByStatusResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.Ballot ballots = 1;
+   */
+  ballots: Ballot[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryBallotsByStatusResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBallotsByStatusResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBallotsByStatusResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBallotsByStatusResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBallotsByStatusResponse;
+
+  static equals(a: QueryBallotsByStatusResponse | PlainMessage<QueryBallotsByStatusResponse> | undefined, b: QueryBallotsByStatusResponse | PlainMessage<QueryBallotsByStatusResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryObserverSet
  */
@@ -731,6 +992,157 @@ export declare class QueryObserverSetResponse extends Message<QueryObserverSetRe
   static equals(a: QueryObserverSetResponse | PlainMessage<QueryObserverSetResponse> | undefined, b: QueryObserverSetResponse | PlainMessage<QueryObserverSetResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverChainsRequest
+ */
+export declare class QueryObserverChainsRequest extends Message<QueryObserverChainsRequest> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<QueryObserverChainsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverChainsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverChainsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverChainsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverChainsRequest;
+
+  static equals(a: QueryObserverChainsRequest | PlainMessage<QueryObserverChainsRequest> | undefined, b: QueryObserverChainsRequest | PlainMessage<QueryObserverChainsRequest> | undefined): boolean;
+}
+
+/**
+ * chain_ids is empty if the observer is assigned to all the supported chains
+ *
+ * @generated from message zetachain.zetacore.observer.QueryObserverChainsResponse
+ */
+export declare class QueryObserverChainsResponse extends Message<QueryObserverChainsResponse> {
+  /**
+   * @generated from field: repeated int64 chain_ids = 1;
+   */
+  chainIds: bigint[];
+
+  constructor(data?: PartialMessage<QueryObserverChainsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverChainsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverChainsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverChainsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverChainsResponse;
+
+  static equals(a: QueryObserverChainsResponse | PlainMessage<QueryObserverChainsResponse> | undefined, b: QueryObserverChainsResponse | PlainMessage<QueryObserverChainsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverLivenessRequest
+ */
+export declare class QueryObserverLivenessRequest extends Message<QueryObserverLivenessRequest> {
+  /**
+   * @generated from field: string observer_address = 1;
+   */
+  observerAddress: string;
+
+  constructor(data?: PartialMessage<QueryObserverLivenessRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverLivenessRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverLivenessRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverLivenessRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverLivenessRequest;
+
+  static equals(a: QueryObserverLivenessRequest | PlainMessage<QueryObserverLivenessRequest> | undefined, b: QueryObserverLivenessRequest | PlainMessage<QueryObserverLivenessRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryObserverLivenessResponse
+ */
+export declare class QueryObserverLivenessResponse extends Message<QueryObserverLivenessResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.ObserverLiveness observer_liveness = 1;
+   */
+  observerLiveness?: ObserverLiveness;
+
+  constructor(data?: PartialMessage<QueryObserverLivenessResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryObserverLivenessResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryObserverLivenessResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryObserverLivenessResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryObserverLivenessResponse;
+
+  static equals(a: QueryObserverLivenessResponse | PlainMessage<QueryObserverLivenessResponse> | undefined, b: QueryObserverLivenessResponse | PlainMessage<QueryObserverLivenessResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryJailedObserversRequest
+ */
+export declare class QueryJailedObserversRequest extends Message<QueryJailedObserversRequest> {
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageRequest pagination = 1;
+   */
+  pagination?: PageRequest;
+
+  constructor(data?: PartialMessage<QueryJailedObserversRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryJailedObserversRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryJailedObserversRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryJailedObserversRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryJailedObserversRequest;
+
+  static equals(a: QueryJailedObserversRequest | PlainMessage<QueryJailedObserversRequest> | undefined, b: QueryJailedObserversRequest | PlainMessage<QueryJailedObserversRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryJailedObserversResponse
+ */
+export declare class QueryJailedObserversResponse extends Message<QueryJailedObserversResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ObserverLiveness observer_liveness = 1;
+   */
+  observerLiveness: ObserverLiveness[];
+
+  /**
+   * @generated from field: cosmos.base.query.v1beta1.PageResponse pagination = 2;
+   */
+  pagination?: PageResponse;
+
+  constructor(data?: PartialMessage<QueryJailedObserversResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryJailedObserversResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryJailedObserversResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryJailedObserversResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryJailedObserversResponse;
+
+  static equals(a: QueryJailedObserversResponse | PlainMessage<QueryJailedObserversResponse> | undefined, b: QueryJailedObserversResponse | PlainMessage<QueryJailedObserversResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QuerySupportedChains
  */
@@ -865,6 +1277,97 @@ export declare class QueryGetChainParamsResponse extends Message<QueryGetChainPa
   static equals(a: QueryGetChainParamsResponse | PlainMessage<QueryGetChainParamsResponse> | undefined, b: QueryGetChainParamsResponse | PlainMessage<QueryGetChainParamsResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPendingChainParamsRequest
+ */
+export declare class QueryPendingChainParamsRequest extends Message<QueryPendingChainParamsRequest> {
+  constructor(data?: PartialMessage<QueryPendingChainParamsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPendingChainParamsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPendingChainParamsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPendingChainParamsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPendingChainParamsRequest;
+
+  static equals(a: QueryPendingChainParamsRequest | PlainMessage<QueryPendingChainParamsRequest> | undefined, b: QueryPendingChainParamsRequest | PlainMessage<QueryPendingChainParamsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPendingChainParamsResponse
+ */
+export declare class QueryPendingChainParamsResponse extends Message<QueryPendingChainParamsResponse> {
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.PendingChainParams pending_chain_params = 1;
+   */
+  pendingChainParams: PendingChainParams[];
+
+  constructor(data?: PartialMessage<QueryPendingChainParamsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPendingChainParamsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPendingChainParamsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPendingChainParamsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPendingChainParamsResponse;
+
+  static equals(a: QueryPendingChainParamsResponse | PlainMessage<QueryPendingChainParamsResponse> | undefined, b: QueryPendingChainParamsResponse | PlainMessage<QueryPendingChainParamsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPendingChainParamsForChainRequest
+ */
+export declare class QueryPendingChainParamsForChainRequest extends Message<QueryPendingChainParamsForChainRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryPendingChainParamsForChainRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPendingChainParamsForChainRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPendingChainParamsForChainRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPendingChainParamsForChainRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPendingChainParamsForChainRequest;
+
+  static equals(a: QueryPendingChainParamsForChainRequest | PlainMessage<QueryPendingChainParamsForChainRequest> | undefined, b: QueryPendingChainParamsForChainRequest | PlainMessage<QueryPendingChainParamsForChainRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryPendingChainParamsForChainResponse
+ */
+export declare class QueryPendingChainParamsForChainResponse extends Message<QueryPendingChainParamsForChainResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.PendingChainParams pending_chain_params = 1;
+   */
+  pendingChainParams?: PendingChainParams;
+
+  constructor(data?: PartialMessage<QueryPendingChainParamsForChainResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryPendingChainParamsForChainResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryPendingChainParamsForChainResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryPendingChainParamsForChainResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryPendingChainParamsForChainResponse;
+
+  static equals(a: QueryPendingChainParamsForChainResponse | PlainMessage<QueryPendingChainParamsForChainResponse> | undefined, b: QueryPendingChainParamsForChainResponse | PlainMessage<QueryPendingChainParamsForChainResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryGetNodeAccountRequest
  */
@@ -1249,6 +1752,72 @@ export declare class QueryBlameByChainAndNonceResponse extends Message<QueryBlam
   static equals(a: QueryBlameByChainAndNonceResponse | PlainMessage<QueryBlameByChainAndNonceResponse> | undefined, b: QueryBlameByChainAndNonceResponse | PlainMessage<QueryBlameByChainAndNonceResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBlameStatisticsRequest
+ */
+export declare class QueryBlameStatisticsRequest extends Message<QueryBlameStatisticsRequest> {
+  /**
+   * chain to count the blames for, 0 for all chains
+   *
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  /**
+   * number of recent blocks the blames are counted in, 0 for all the retained blames
+   *
+   * @generated from field: int64 window_blocks = 2;
+   */
+  windowBlocks: bigint;
+
+  constructor(data?: PartialMessage<QueryBlameStatisticsRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBlameStatisticsRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBlameStatisticsRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBlameStatisticsRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBlameStatisticsRequest;
+
+  static equals(a: QueryBlameStatisticsRequest | PlainMessage<QueryBlameStatisticsRequest> | undefined, b: QueryBlameStatisticsRequest | PlainMessage<QueryBlameStatisticsRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryBlameStatisticsResponse
+ */
+export declare class QueryBlameStatisticsResponse extends Message<QueryBlameStatisticsResponse> {
+  /**
+   * nodes ordered by decreasing number of blames
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.NodeBlameStatistics node_statistics = 1;
+   */
+  nodeStatistics: NodeBlameStatistics[];
+
+  /**
+   * first zeta height of the window
+   *
+   * @generated from field: int64 from_height = 2;
+   */
+  fromHeight: bigint;
+
+  constructor(data?: PartialMessage<QueryBlameStatisticsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryBlameStatisticsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryBlameStatisticsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryBlameStatisticsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryBlameStatisticsResponse;
+
+  static equals(a: QueryBlameStatisticsResponse | PlainMessage<QueryBlameStatisticsResponse> | undefined, b: QueryBlameStatisticsResponse | PlainMessage<QueryBlameStatisticsResponse> | undefined): boolean;
+}
+
 /**
  * @generated from message zetachain.zetacore.observer.QueryAllBlockHeaderRequest
  */
@@ -1398,3 +1967,51 @@ export declare class QueryGetBlockHeaderStateResponse extends Message<QueryGetBl
   static equals(a: QueryGetBlockHeaderStateResponse | PlainMessage<QueryGetBlockHeaderStateResponse> | undefined, b: QueryGetBlockHeaderStateResponse | PlainMessage<QueryGetBlockHeaderStateResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message zetachain.zetacore.observer.QueryEthereumLightClientRequest
+ */
+export declare class QueryEthereumLightClientRequest extends Message<QueryEthereumLightClientRequest> {
+  /**
+   * @generated from field: int64 chain_id = 1;
+   */
+  chainId: bigint;
+
+  constructor(data?: PartialMessage<QueryEthereumLightClientRequest>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryEthereumLightClientRequest";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryEthereumLightClientRequest;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryEthereumLightClientRequest;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryEthereumLightClientRequest;
+
+  static equals(a: QueryEthereumLightClientRequest | PlainMessage<QueryEthereumLightClientRequest> | undefined, b: QueryEthereumLightClientRequest | PlainMessage<QueryEthereumLightClientRequest> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.QueryEthereumLightClientResponse
+ */
+export declare class QueryEthereumLightClientResponse extends Message<QueryEthereumLightClientResponse> {
+  /**
+   * @generated from field: zetachain.zetacore.observer.EthereumLightClient light_client = 1;
+   */
+  lightClient?: EthereumLightClient;
+
+  constructor(data?: PartialMessage<QueryEthereumLightClientResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.QueryEthereumLightClientResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryEthereumLightClientResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryEthereumLightClientResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryEthereumLightClientResponse;
+
+  static equals(a: QueryEthereumLightClientResponse | PlainMessage<QueryEthereumLightClientResponse> | undefined, b: QueryEthereumLightClientResponse | PlainMessage<QueryEthereumLightClientResponse> | undefined): boolean;
+}
+
@@ -9,7 +9,8 @@ import type { ObserverUpdateReason } from "./observer_pb.js";
 import type { HeaderData } from "../common/common_pb.js";
 import type { ChainParams } from "./params_pb.js";
 import type { Blame } from "./blame_pb.js";
-import type { BlockHeaderVerificationFlags, GasPriceIncreaseFlags } from "./crosschain_flags_pb.js";
+import type { BlockHeaderVerificationFlags, ChainFlags, ForeignCoinFlags, GasPriceIncreaseFlags, PruningFlags } from "./crosschain_flags_pb.js";
+import type { LightClientBootstrap, LightClientUpdate } from "../common/ethereum/light_client_pb.js";
 
 /**
  * @generated from message zetachain.zetacore.observer.MsgUpdateObserver
@@ -146,6 +147,13 @@ export declare class MsgUpdateChainParams extends Message<MsgUpdateChainParams>
    */
   chainParams?: ChainParams;
 
+  /**
+   * zeta height at which the chain params are applied, the chain params are applied immediately if zero
+   *
+   * @generated from field: int64 activation_height = 3;
+   */
+  activationHeight: bigint;
+
   constructor(data?: PartialMessage<MsgUpdateChainParams>);
 
   static readonly runtime: typeof proto3;
@@ -368,6 +376,23 @@ export declare class MsgUpdateCrosschainFlags extends Message<MsgUpdateCrosschai
    */
   blockHeaderVerificationFlags?: BlockHeaderVerificationFlags;
 
+  /**
+   * @generated from field: zetachain.zetacore.observer.PruningFlags pruningFlags = 7;
+   */
+  pruningFlags?: PruningFlags;
+
+  /**
+   * chainFlags and foreignCoinFlags replace the flags of the provided chains and foreign coins
+   *
+   * @generated from field: repeated zetachain.zetacore.observer.ChainFlags chainFlags = 8;
+   */
+  chainFlags: ChainFlags[];
+
+  /**
+   * @generated from field: repeated zetachain.zetacore.observer.ForeignCoinFlags foreignCoinFlags = 9;
+   */
+  foreignCoinFlags: ForeignCoinFlags[];
+
   constructor(data?: PartialMessage<MsgUpdateCrosschainFlags>);
 
   static readonly runtime: typeof proto3;
@@ -450,3 +475,214 @@ export declare class MsgUpdateKeygenResponse extends Message<MsgUpdateKeygenResp
   static equals(a: MsgUpdateKeygenResponse | PlainMessage<MsgUpdateKeygenResponse> | undefined, b: MsgUpdateKeygenResponse | PlainMessage<MsgUpdateKeygenResponse> | undefined): boolean;
 }
 
+/**
+ * MsgUpdateObserverChains sets the chains observed by the observer
+ * an empty list of chains assigns the observer to all the supported chains
+ *
+ * @generated from message zetachain.zetacore.observer.MsgUpdateObserverChains
+ */
+export declare class MsgUpdateObserverChains extends Message<MsgUpdateObserverChains> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: repeated int64 chain_ids = 2;
+   */
+  chainIds: bigint[];
+
+  constructor(data?: PartialMessage<MsgUpdateObserverChains>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateObserverChains";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateObserverChains;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateObserverChains;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateObserverChains;
+
+  static equals(a: MsgUpdateObserverChains | PlainMessage<MsgUpdateObserverChains> | undefined, b: MsgUpdateObserverChains | PlainMessage<MsgUpdateObserverChains> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateObserverChainsResponse
+ */
+export declare class MsgUpdateObserverChainsResponse extends Message<MsgUpdateObserverChainsResponse> {
+  constructor(data?: PartialMessage<MsgUpdateObserverChainsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateObserverChainsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateObserverChainsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateObserverChainsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateObserverChainsResponse;
+
+  static equals(a: MsgUpdateObserverChainsResponse | PlainMessage<MsgUpdateObserverChainsResponse> | undefined, b: MsgUpdateObserverChainsResponse | PlainMessage<MsgUpdateObserverChainsResponse> | undefined): boolean;
+}
+
+/**
+ * MsgUnjailObserver adds back a jailed observer to the voters of the new ballots once the jail duration has elapsed
+ *
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserver
+ */
+export declare class MsgUnjailObserver extends Message<MsgUnjailObserver> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  constructor(data?: PartialMessage<MsgUnjailObserver>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserver";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserver;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserver;
+
+  static equals(a: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined, b: MsgUnjailObserver | PlainMessage<MsgUnjailObserver> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUnjailObserverResponse
+ */
+export declare class MsgUnjailObserverResponse extends Message<MsgUnjailObserverResponse> {
+  constructor(data?: PartialMessage<MsgUnjailObserverResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUnjailObserverResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUnjailObserverResponse;
+
+  static equals(a: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined, b: MsgUnjailObserverResponse | PlainMessage<MsgUnjailObserverResponse> | undefined): boolean;
+}
+
+/**
+ * MsgBootstrapEthereumLightClient initializes the beacon chain light client of an Ethereum chain from a trusted block root
+ *
+ * @generated from message zetachain.zetacore.observer.MsgBootstrapEthereumLightClient
+ */
+export declare class MsgBootstrapEthereumLightClient extends Message<MsgBootstrapEthereumLightClient> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: bytes trusted_block_root = 3;
+   */
+  trustedBlockRoot: Uint8Array;
+
+  /**
+   * @generated from field: ethereum.LightClientBootstrap bootstrap = 4;
+   */
+  bootstrap?: LightClientBootstrap;
+
+  constructor(data?: PartialMessage<MsgBootstrapEthereumLightClient>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgBootstrapEthereumLightClient";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgBootstrapEthereumLightClient;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgBootstrapEthereumLightClient;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgBootstrapEthereumLightClient;
+
+  static equals(a: MsgBootstrapEthereumLightClient | PlainMessage<MsgBootstrapEthereumLightClient> | undefined, b: MsgBootstrapEthereumLightClient | PlainMessage<MsgBootstrapEthereumLightClient> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgBootstrapEthereumLightClientResponse
+ */
+export declare class MsgBootstrapEthereumLightClientResponse extends Message<MsgBootstrapEthereumLightClientResponse> {
+  constructor(data?: PartialMessage<MsgBootstrapEthereumLightClientResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgBootstrapEthereumLightClientResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgBootstrapEthereumLightClientResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgBootstrapEthereumLightClientResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgBootstrapEthereumLightClientResponse;
+
+  static equals(a: MsgBootstrapEthereumLightClientResponse | PlainMessage<MsgBootstrapEthereumLightClientResponse> | undefined, b: MsgBootstrapEthereumLightClientResponse | PlainMessage<MsgBootstrapEthereumLightClientResponse> | undefined): boolean;
+}
+
+/**
+ * MsgUpdateEthereumLightClient applies a sync committee period update or a finality update to the beacon chain light client
+ *
+ * @generated from message zetachain.zetacore.observer.MsgUpdateEthereumLightClient
+ */
+export declare class MsgUpdateEthereumLightClient extends Message<MsgUpdateEthereumLightClient> {
+  /**
+   * @generated from field: string creator = 1;
+   */
+  creator: string;
+
+  /**
+   * @generated from field: int64 chain_id = 2;
+   */
+  chainId: bigint;
+
+  /**
+   * @generated from field: ethereum.LightClientUpdate update = 3;
+   */
+  update?: LightClientUpdate;
+
+  constructor(data?: PartialMessage<MsgUpdateEthereumLightClient>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateEthereumLightClient";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateEthereumLightClient;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateEthereumLightClient;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateEthereumLightClient;
+
+  static equals(a: MsgUpdateEthereumLightClient | PlainMessage<MsgUpdateEthereumLightClient> | undefined, b: MsgUpdateEthereumLightClient | PlainMessage<MsgUpdateEthereumLightClient> | undefined): boolean;
+}
+
+/**
+ * @generated from message zetachain.zetacore.observer.MsgUpdateEthereumLightClientResponse
+ */
+export declare class MsgUpdateEthereumLightClientResponse extends Message<MsgUpdateEthereumLightClientResponse> {
+  constructor(data?: PartialMessage<MsgUpdateEthereumLightClientResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "zetachain.zetacore.observer.MsgUpdateEthereumLightClientResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MsgUpdateEthereumLightClientResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MsgUpdateEthereumLightClientResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MsgUpdateEthereumLightClientResponse;
+
+  static equals(a: MsgUpdateEthereumLightClientResponse | PlainMessage<MsgUpdateEthereumLightClientResponse> | undefined, b: MsgUpdateEthereumLightClientResponse | PlainMessage<MsgUpdateEthereumLightClientResponse> | undefined): boolean;
+}
+
@@ -21,6 +21,13 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(
 		CmdShowPolicies(),
+		CmdShowAuthorizations(),
+		CmdShowTimelockConfig(),
+		CmdShowQueuedAction(),
+		CmdListQueuedActions(),
+		CmdListAuditLog(),
+		CmdShowThresholdProposal(),
+		CmdListThresholdProposals(),
 	)
 
 	return cmd
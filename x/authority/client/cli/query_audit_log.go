@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+const (
+	FlagSigner     = "signer"
+	FlagMsgTypeURL = "msg-type-url"
+)
+
+func CmdListAuditLog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-audit-log",
+		Short: "list the messages that passed an authority check, optionally filtered by signer and message type",
+		Example: `zetacored q authority list-audit-log --signer zeta1...
+zetacored q authority list-audit-log --msg-type-url /zetachain.zetacore.fungible.MsgUpdateZRC20PausedStatus`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryAuditLogRequest{
+				Pagination: pageReq,
+			}
+			if params.Signer, err = cmd.Flags().GetString(FlagSigner); err != nil {
+				return err
+			}
+			if params.MsgTypeUrl, err = cmd.Flags().GetString(FlagMsgTypeURL); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AuditLog(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSigner, "", "signer of the messages")
+	cmd.Flags().String(FlagMsgTypeURL, "", "type URL of the messages")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// CmdShowThresholdProposal returns the command to show a pending threshold proposal
+func CmdShowThresholdProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-threshold-proposal [proposal-id]",
+		Short: "show a pending threshold proposal and its approvals",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ThresholdProposal(context.Background(), &types.QueryGetThresholdProposalRequest{
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdListThresholdProposals returns the command to list the pending threshold proposals
+func CmdListThresholdProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-threshold-proposals",
+		Short: "list the pending threshold proposals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ThresholdProposalAll(context.Background(), &types.QueryAllThresholdProposalRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
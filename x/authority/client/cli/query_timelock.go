@@ -0,0 +1,101 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// CmdShowTimelockConfig returns the command to show the timelock config
+func CmdShowTimelockConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-timelock-config",
+		Short: "show the messages queued in the timelock and their delay",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TimelockConfig(context.Background(), &types.QueryTimelockConfigRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdShowQueuedAction returns the command to show a queued action of the timelock
+func CmdShowQueuedAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-queued-action [action-id]",
+		Short: "show a queued action of the timelock",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			actionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueuedAction(context.Background(), &types.QueryGetQueuedActionRequest{
+				ActionId: actionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdListQueuedActions returns the command to list the queued actions of the timelock
+func CmdListQueuedActions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-queued-actions",
+		Short: "list the queued actions of the timelock",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueuedActionAll(context.Background(), &types.QueryAllQueuedActionRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
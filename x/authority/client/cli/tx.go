@@ -20,6 +20,13 @@ func GetTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		CmdUpdatePolices(),
+		CmdAddAuthorization(),
+		CmdRemoveAuthorization(),
+		CmdUpdateTimelockConfig(),
+		CmdSubmitTimelockedAction(),
+		CmdCancelTimelockedAction(),
+		CmdSubmitThresholdProposal(),
+		CmdApproveThresholdProposal(),
 	)
 
 	return cmd
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func CmdAddAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-authorization [msg-url] [chain-id] [policy-type-or-address]",
+		Short: "Add an authorization for a message",
+		Long: `Add an authorization for a message type url on a chain, chain id 0 applies the authorization to all chains.
+The authorized account is either a policy type (groupEmergency, groupAdmin) or an address.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			authorization, err := parseAuthorization(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddAuthorization(
+				clientCtx.GetFromAddress().String(),
+				authorization,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseAuthorization parses an authorization from the arguments of the authorization commands
+func parseAuthorization(msgURL, chainID, policyTypeOrAddress string) (types.Authorization, error) {
+	authorization := types.Authorization{
+		MsgUrl: msgURL,
+	}
+
+	var err error
+	authorization.ChainId, err = strconv.ParseInt(chainID, 10, 64)
+	if err != nil {
+		return types.Authorization{}, fmt.Errorf("invalid chain id %s: %w", chainID, err)
+	}
+
+	if policyType, ok := types.PolicyType_value[policyTypeOrAddress]; ok {
+		authorization.PolicyType = types.PolicyType(policyType)
+	} else if _, err := sdk.AccAddressFromBech32(policyTypeOrAddress); err == nil {
+		authorization.Address = policyTypeOrAddress
+	} else {
+		return types.Authorization{}, fmt.Errorf("%s is neither a policy type nor an address", policyTypeOrAddress)
+	}
+
+	return authorization, nil
+}
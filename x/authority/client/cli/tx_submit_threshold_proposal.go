@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func CmdSubmitThresholdProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-threshold-proposal [policy-type] [msg-json-file]",
+		Short: "Submit a message to be approved by the members of a threshold policy",
+		Long: `Submit a message to be approved by the members of a threshold policy, the message is executed once the threshold of approvals is reached.
+The message is provided as JSON with its type url, e.g. {"@type": "/zetachain.zetacore.fungible.MsgUpdateSystemContract", ...}, and must be signed by the address of the policy.
+The submitter must be a member of the policy and counts as the first approval.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			policyType, ok := types.PolicyType_value[args[0]]
+			if !ok {
+				return fmt.Errorf("invalid policy type %s", args[0])
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var proposedMsg sdk.Msg
+			msgBytes, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(msgBytes, &proposedMsg); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitThresholdProposal(
+				clientCtx.GetFromAddress().String(),
+				types.PolicyType(policyType),
+				proposedMsg,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
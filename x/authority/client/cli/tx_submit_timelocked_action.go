@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func CmdSubmitTimelockedAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-timelocked-action [msg-json-file]",
+		Short: "Queue a timelocked message in the timelock",
+		Long: `Queue a timelocked message in the timelock, the message is executed once its delay has elapsed unless the emergency group cancels it.
+The message is provided as JSON with its type url, e.g. {"@type": "/zetachain.zetacore.fungible.MsgUpdateSystemContract", ...}, and must be signed by the sender.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var timelockedMsg sdk.Msg
+			msgBytes, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(msgBytes, &timelockedMsg); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgSubmitTimelockedAction(
+				clientCtx.GetFromAddress().String(),
+				timelockedMsg,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func CmdUpdateTimelockConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-timelock-config [timelock-config-json-file]",
+		Short: "Update the messages queued in the timelock and their delay",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var config types.TimelockConfig
+			configBytes, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.Codec.UnmarshalJSON(configBytes, &config); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateTimelockConfig(
+				clientCtx.GetFromAddress().String(),
+				config,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -9,6 +9,17 @@ import (
 // InitGenesis initializes the authority module's state from a provided genesis state
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetPolicies(ctx, genState.Policies)
+	k.SetAuthorizationList(ctx, genState.AuthorizationList)
+	k.SetTimelockConfig(ctx, genState.TimelockConfig)
+	for _, action := range genState.QueuedActions {
+		k.SetQueuedAction(ctx, action)
+	}
+	for _, entry := range genState.AuditEntries {
+		k.SetAuditEntry(ctx, entry)
+	}
+	for _, proposal := range genState.ThresholdProposals {
+		k.SetThresholdProposal(ctx, proposal)
+	}
 }
 
 // ExportGenesis returns the authority module's exported genesis.
@@ -20,5 +31,19 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.Policies = policies
 	}
 
+	authorizationList, found := k.GetAuthorizationList(ctx)
+	if found {
+		genesis.AuthorizationList = authorizationList
+	}
+
+	timelockConfig, found := k.GetTimelockConfig(ctx)
+	if found {
+		genesis.TimelockConfig = timelockConfig
+	}
+
+	genesis.QueuedActions = k.GetAllQueuedActions(ctx)
+	genesis.AuditEntries = k.GetAllAuditEntries(ctx)
+	genesis.ThresholdProposals = k.GetAllThresholdProposals(ctx)
+
 	return &genesis
 }
@@ -13,7 +13,20 @@ import (
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Policies: sample.Policies(),
+		Policies:          sample.Policies(),
+		AuthorizationList: sample.AuthorizationList("/zetachain.zetacore.observer.MsgUpdateChainParams"),
+		TimelockConfig:    types.DefaultTimelockConfig(),
+		QueuedActions: []types.QueuedAction{
+			sample.QueuedAction(t, 0, 1000),
+			sample.QueuedAction(t, 1, 1001),
+		},
+		AuditEntries: []types.AuditEntry{
+			sample.AuditEntry(t, 0, sample.AccAddress(), &types.MsgUpdateTimelockConfig{}),
+			sample.AuditEntry(t, 1, sample.AccAddress(), &types.MsgCancelTimelockedAction{}),
+		},
+		ThresholdProposals: []types.ThresholdProposal{
+			sample.ThresholdProposal(t, 0, 1000),
+		},
 	}
 
 	// Init
@@ -25,6 +38,23 @@ func TestGenesis(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, genesisState.Policies, policies)
 
+	// Check authorization list is set
+	authorizationList, found := k.GetAuthorizationList(ctx)
+	require.True(t, found)
+	require.Equal(t, genesisState.AuthorizationList, authorizationList)
+
+	// Check timelock is set
+	timelockConfig, found := k.GetTimelockConfig(ctx)
+	require.True(t, found)
+	require.Equal(t, genesisState.TimelockConfig, timelockConfig)
+	require.Len(t, k.GetAllQueuedActions(ctx), 2)
+
+	// Check audit log is set
+	require.Equal(t, genesisState.AuditEntries, k.GetAllAuditEntries(ctx))
+
+	// Check threshold proposals are set
+	require.Len(t, k.GetAllThresholdProposals(ctx), 1)
+
 	// Export
 	got := authority.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// SetAuditEntry sets an audit entry to the store and adds it to the signer and message type URL indexes
+func (k Keeper) SetAuditEntry(ctx sdk.Context, entry types.AuditEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntryKey))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.AuditEntryIDKey(entry.Id), b)
+
+	signerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntrySignerIndexKeyPrefix))
+	signerStore.Set(types.AuditEntryIndexKey(entry.Signer, entry.Id), []byte{0})
+
+	msgURLStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntryMsgURLIndexKeyPrefix))
+	msgURLStore.Set(types.AuditEntryIndexKey(entry.MsgTypeUrl, entry.Id), []byte{0})
+
+	if entry.Id >= k.getNextAuditEntryID(ctx) {
+		k.setNextAuditEntryID(ctx, entry.Id+1)
+	}
+}
+
+// GetAuditEntry returns an audit entry from the store
+func (k Keeper) GetAuditEntry(ctx sdk.Context, id uint64) (val types.AuditEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntryKey))
+	b := store.Get(types.AuditEntryIDKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllAuditEntries returns all the audit entries ordered by id
+func (k Keeper) GetAllAuditEntries(ctx sdk.Context) (list []types.AuditEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntryKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuditEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// recordAuditEntry appends an entry for a message whose signer passed an authority check to the audit log
+// the entry is reverted with the other state changes if the execution of the message fails
+func (k Keeper) recordAuditEntry(
+	ctx sdk.Context,
+	signer string,
+	msg sdk.Msg,
+	policyType types.PolicyType,
+	authorizedByAddress bool,
+) {
+	entry, err := types.NewAuditEntry(
+		k.getNextAuditEntryID(ctx),
+		policyType,
+		authorizedByAddress,
+		signer,
+		msg,
+		ctx.BlockHeight(),
+	)
+	if err != nil {
+		k.Logger(ctx).Error("recordAuditEntry: failed to create audit entry",
+			"signer", signer,
+			"msg", sdk.MsgTypeURL(msg),
+			"err", err.Error(),
+		)
+		return
+	}
+	k.SetAuditEntry(ctx, entry)
+}
+
+func (k Keeper) getNextAuditEntryID(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntryCounterKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (k Keeper) setNextAuditEntryID(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntryCounterKey))
+	store.Set([]byte{0}, types.AuditEntryIDKey(id))
+}
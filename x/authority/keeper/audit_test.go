@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestKeeper_SetAuditEntry(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+	signer := sample.AccAddress()
+	entry := sample.AuditEntry(t, 5, signer, types.NewMsgCancelTimelockedAction(signer, 1))
+
+	_, found := k.GetAuditEntry(ctx, entry.Id)
+	require.False(t, found)
+
+	k.SetAuditEntry(ctx, entry)
+
+	got, found := k.GetAuditEntry(ctx, entry.Id)
+	require.True(t, found)
+	require.Equal(t, entry, got)
+	require.Equal(t, []types.AuditEntry{entry}, k.GetAllAuditEntries(ctx))
+
+	// the next recorded entry follows the entry set
+	policies := sample.Policies()
+	k.SetPolicies(ctx, policies)
+	emergency := policies.Items[0].Address
+	require.True(t, k.IsAuthorizedForMsgWithPolicy(
+		ctx,
+		emergency,
+		types.NewMsgCancelTimelockedAction(emergency, 1),
+		types.PolicyType_groupEmergency,
+	))
+	_, found = k.GetAuditEntry(ctx, entry.Id+1)
+	require.True(t, found)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// SetAuthorizationList sets the authorization list to the store
+func (k Keeper) SetAuthorizationList(ctx sdk.Context, list types.AuthorizationList) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationListKey))
+	b := k.cdc.MustMarshal(&list)
+	store.Set([]byte{0}, b)
+}
+
+// GetAuthorizationList returns the authorization list from the store
+func (k Keeper) GetAuthorizationList(ctx sdk.Context) (val types.AuthorizationList, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuthorizationListKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsAuthorizedForMsg checks if the address is authorized to execute the message on the given chain
+// the address is authorized if an authorization of the message for the chain or for all chains authorizes the address
+// or a policy of the address, the chain ID is 0 for the messages not targeting a chain
+// a timelocked message is only authorized when it is executed from the timelock queue
+// the message is recorded in the audit log if the address is authorized
+func (k Keeper) IsAuthorizedForMsg(ctx sdk.Context, address string, msg sdk.Msg, chainID int64) bool {
+	msgURL := sdk.MsgTypeURL(msg)
+	if k.IsTimelocked(ctx, msgURL) && !isTimelockExecution(ctx) {
+		return false
+	}
+	authorization, found := k.getMsgURLAuthorization(ctx, address, msgURL, chainID, false)
+	if !found {
+		return false
+	}
+	k.recordAuditEntry(ctx, address, msg, authorization.PolicyType, authorization.Address != "")
+	return true
+}
+
+// getMsgURLAuthorization returns the authorization authorizing the address to execute the message with the type URL
+// on the given chain, any chain is accepted when anyChain is true
+func (k Keeper) getMsgURLAuthorization(
+	ctx sdk.Context,
+	address string,
+	msgURL string,
+	chainID int64,
+	anyChain bool,
+) (types.Authorization, bool) {
+	list, found := k.GetAuthorizationList(ctx)
+	if !found {
+		return types.Authorization{}, false
+	}
+	for _, authorization := range list.Authorizations {
+		if authorization.MsgUrl != msgURL {
+			continue
+		}
+		if !anyChain && authorization.ChainId != 0 && authorization.ChainId != chainID {
+			continue
+		}
+		if authorization.Address != "" {
+			if authorization.Address == address {
+				return authorization, true
+			}
+			continue
+		}
+		if k.IsAuthorized(ctx, address, authorization.PolicyType) {
+			return authorization, true
+		}
+	}
+	return types.Authorization{}, false
+}
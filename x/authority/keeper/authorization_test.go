@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_SetAuthorizationList(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+	list := sample.AuthorizationList("/zetachain.zetacore.observer.MsgUpdateChainParams")
+
+	_, found := k.GetAuthorizationList(ctx)
+	require.False(t, found)
+
+	k.SetAuthorizationList(ctx, list)
+
+	got, found := k.GetAuthorizationList(ctx)
+	require.True(t, found)
+	require.Equal(t, list, got)
+}
+
+func TestKeeper_IsAuthorizedForMsg(t *testing.T) {
+	msg := &observertypes.MsgUpdateChainParams{}
+	msgURL := "/zetachain.zetacore.observer.MsgUpdateChainParams"
+
+	t.Run("not authorized if no authorization list", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+
+		require.False(t, k.IsAuthorizedForMsg(ctx, policies.Items[1].Address, msg, 0))
+	})
+
+	t.Run("policy authorized by default", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+		k.SetAuthorizationList(ctx, types.DefaultAuthorizationList())
+
+		admin := policies.Items[1].Address
+		emergency := policies.Items[0].Address
+		require.True(t, k.IsAuthorizedForMsg(ctx, admin, msg, 0))
+		require.True(t, k.IsAuthorizedForMsg(ctx, admin, msg, 1))
+		require.False(t, k.IsAuthorizedForMsg(ctx, emergency, msg, 1))
+		require.False(t, k.IsAuthorizedForMsg(ctx, sample.AccAddress(), msg, 1))
+
+		// a message without authorization is not authorized
+		require.False(t, k.IsAuthorizedForMsg(ctx, admin, &observertypes.MsgAddBlameVote{}, 0))
+	})
+
+	t.Run("address authorized for a chain", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+		list := sample.AuthorizationList(msgURL)
+		k.SetAuthorizationList(ctx, list)
+
+		operator := list.Authorizations[1].Address
+		require.True(t, k.IsAuthorizedForMsg(ctx, operator, msg, 1))
+		require.False(t, k.IsAuthorizedForMsg(ctx, operator, msg, 2))
+		require.False(t, k.IsAuthorizedForMsg(ctx, operator, msg, 0))
+		require.False(t, k.IsAuthorizedForMsg(ctx, operator, &observertypes.MsgRemoveChainParams{}, 1))
+
+		// the admin policy is still authorized for all chains
+		require.True(t, k.IsAuthorizedForMsg(ctx, policies.Items[1].Address, msg, 2))
+	})
+
+	t.Run("address authorized for all chains", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		operator := sample.AccAddress()
+		k.SetAuthorizationList(ctx, types.AuthorizationList{
+			Authorizations: []types.Authorization{
+				{MsgUrl: msgURL, Address: operator},
+			},
+		})
+
+		require.True(t, k.IsAuthorizedForMsg(ctx, operator, msg, 0))
+		require.True(t, k.IsAuthorizedForMsg(ctx, operator, msg, 1))
+	})
+}
+
+func TestKeeper_IsAuthorizedForMsg_AuditLog(t *testing.T) {
+	msgURL := "/zetachain.zetacore.observer.MsgUpdateChainParams"
+
+	t.Run("authorized message is recorded with the policy", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+		k.SetAuthorizationList(ctx, sample.AuthorizationList(msgURL))
+		admin := policies.Items[1].Address
+		msg := &observertypes.MsgUpdateChainParams{Creator: admin}
+
+		require.True(t, k.IsAuthorizedForMsg(ctx.WithBlockHeight(42), admin, msg, 1))
+
+		entries := k.GetAllAuditEntries(ctx)
+		require.Len(t, entries, 1)
+		hash, err := types.MsgHash(msg)
+		require.NoError(t, err)
+		require.Equal(t, types.AuditEntry{
+			Id:          0,
+			PolicyType:  types.PolicyType_groupAdmin,
+			Signer:      admin,
+			MsgTypeUrl:  msgURL,
+			MsgHash:     hash,
+			BlockHeight: 42,
+		}, entries[0])
+	})
+
+	t.Run("authorized message is recorded as authorized by address", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		list := sample.AuthorizationList(msgURL)
+		k.SetAuthorizationList(ctx, list)
+		operator := list.Authorizations[1].Address
+
+		require.True(t, k.IsAuthorizedForMsg(ctx, operator, &observertypes.MsgUpdateChainParams{}, 1))
+		require.True(t, k.IsAuthorizedForMsg(ctx, operator, &observertypes.MsgUpdateChainParams{}, 1))
+
+		entries := k.GetAllAuditEntries(ctx)
+		require.Len(t, entries, 2)
+		require.EqualValues(t, 1, entries[1].Id)
+		require.Equal(t, operator, entries[1].Signer)
+		require.True(t, entries[1].AuthorizedByAddress)
+	})
+
+	t.Run("unauthorized message is not recorded", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		k.SetPolicies(ctx, sample.Policies())
+		k.SetAuthorizationList(ctx, sample.AuthorizationList(msgURL))
+
+		require.False(t, k.IsAuthorizedForMsg(ctx, sample.AccAddress(), &observertypes.MsgUpdateChainParams{}, 1))
+		require.Empty(t, k.GetAllAuditEntries(ctx))
+	})
+}
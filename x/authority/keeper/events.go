@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// EmitEventTimelockedActionQueued emits an event when a message is queued in the timelock
+func EmitEventTimelockedActionQueued(ctx sdk.Context, action types.QueuedAction) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTimelockedActionQueued{
+		ActionId:        action.Id,
+		MsgTypeUrl:      action.GetMsgURL(),
+		Signer:          action.Signer,
+		ExecutionHeight: action.ExecutionHeight,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventTimelockedActionQueued :", err)
+	}
+}
+
+// EmitEventTimelockedActionCancelled emits an event when a queued action is cancelled by the emergency group
+func EmitEventTimelockedActionCancelled(ctx sdk.Context, action types.QueuedAction, signer string) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventTimelockedActionCancelled{
+		ActionId:   action.Id,
+		MsgTypeUrl: action.GetMsgURL(),
+		Signer:     signer,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventTimelockedActionCancelled :", err)
+	}
+}
+
+// EmitEventTimelockedActionExecuted emits an event when a queued action is executed
+func EmitEventTimelockedActionExecuted(ctx sdk.Context, action types.QueuedAction, executionErr error) {
+	event := &types.EventTimelockedActionExecuted{
+		ActionId:   action.Id,
+		MsgTypeUrl: action.GetMsgURL(),
+		Success:    executionErr == nil,
+	}
+	if executionErr != nil {
+		event.Error = executionErr.Error()
+	}
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		ctx.Logger().Error("Error emitting EventTimelockedActionExecuted :", err)
+	}
+}
+
+// EmitEventThresholdProposalSubmitted emits an event when a proposal of a threshold policy is submitted
+func EmitEventThresholdProposalSubmitted(ctx sdk.Context, proposal types.ThresholdProposal) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventThresholdProposalSubmitted{
+		ProposalId:   proposal.Id,
+		PolicyType:   proposal.PolicyType,
+		MsgTypeUrl:   proposal.GetMsgURL(),
+		Proposer:     proposal.Proposer,
+		ExpiryHeight: proposal.ExpiryHeight,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventThresholdProposalSubmitted :", err)
+	}
+}
+
+// EmitEventThresholdProposalApproved emits an event when a member approves a proposal of a threshold policy
+func EmitEventThresholdProposalApproved(ctx sdk.Context, proposal types.ThresholdProposal, signer string, approvals uint32) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventThresholdProposalApproved{
+		ProposalId: proposal.Id,
+		Signer:     signer,
+		Approvals:  approvals,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventThresholdProposalApproved :", err)
+	}
+}
+
+// EmitEventThresholdProposalExecuted emits an event when the message of an approved threshold proposal is executed
+func EmitEventThresholdProposalExecuted(ctx sdk.Context, proposal types.ThresholdProposal) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventThresholdProposalExecuted{
+		ProposalId: proposal.Id,
+		PolicyType: proposal.PolicyType,
+		MsgTypeUrl: proposal.GetMsgURL(),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventThresholdProposalExecuted :", err)
+	}
+}
+
+// EmitEventThresholdProposalExpired emits an event when a threshold proposal expires without reaching the threshold
+func EmitEventThresholdProposalExpired(ctx sdk.Context, proposal types.ThresholdProposal) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventThresholdProposalExpired{
+		ProposalId: proposal.Id,
+		PolicyType: proposal.PolicyType,
+		MsgTypeUrl: proposal.GetMsgURL(),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventThresholdProposalExpired :", err)
+	}
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuditLog queries the audit log of the messages that passed an authority check ordered by id
+// the signer index is iterated if a signer is provided and the message type URL filter is applied on each entry,
+// otherwise the message type URL index is iterated if a message type URL is provided
+func (k Keeper) AuditLog(c context.Context, req *types.QueryAuditLogRequest) (*types.QueryAuditLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var entries []types.AuditEntry
+	var pageRes *query.PageResponse
+	var err error
+
+	switch {
+	case req.Signer != "" || req.MsgTypeUrl != "":
+		keyPrefix, field := types.AuditEntrySignerIndexKeyPrefix, req.Signer
+		if req.Signer == "" {
+			keyPrefix, field = types.AuditEntryMsgURLIndexKeyPrefix, req.MsgTypeUrl
+		}
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+		fieldStore := prefix.NewStore(store, types.AuditEntryIndexFieldKey(field))
+
+		pageRes, err = query.FilteredPaginate(fieldStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+			id, err := types.ParseAuditEntryIndexKey(key)
+			if err != nil {
+				return false, err
+			}
+			entry, found := k.GetAuditEntry(ctx, id)
+			if !found {
+				return false, fmt.Errorf("audit entry not found: id %d", id)
+			}
+			if req.MsgTypeUrl != "" && entry.MsgTypeUrl != req.MsgTypeUrl {
+				return false, nil
+			}
+
+			if accumulate {
+				entries = append(entries, entry)
+			}
+			return true, nil
+		})
+	default:
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuditEntryKey))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+			var entry types.AuditEntry
+			if err := k.cdc.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuditLogResponse{AuditEntries: entries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestKeeper_AuditLog(t *testing.T) {
+	setup := func(t *testing.T) (sdk.Context, types.QueryServer, []types.AuditEntry, string) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		signer := sample.AccAddress()
+		other := sample.AccAddress()
+		entries := []types.AuditEntry{
+			sample.AuditEntry(t, 0, signer, types.NewMsgCancelTimelockedAction(signer, 1)),
+			sample.AuditEntry(t, 1, other, types.NewMsgCancelTimelockedAction(other, 2)),
+			sample.AuditEntry(t, 2, signer, types.NewMsgUpdateTimelockConfig(signer, types.TimelockConfig{})),
+			sample.AuditEntry(t, 3, signer, types.NewMsgCancelTimelockedAction(signer, 3)),
+		}
+		for _, entry := range entries {
+			k.SetAuditEntry(ctx, entry)
+		}
+		return ctx, k, entries, signer
+	}
+	cancelURL := sdk.MsgTypeURL(&types.MsgCancelTimelockedAction{})
+
+	t.Run("invalid request", func(t *testing.T) {
+		ctx, k, _, _ := setup(t)
+		_, err := k.AuditLog(sdk.WrapSDKContext(ctx), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("returns all entries without filter", func(t *testing.T) {
+		ctx, k, entries, _ := setup(t)
+		res, err := k.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{})
+		require.NoError(t, err)
+		require.Equal(t, entries, res.AuditEntries)
+	})
+
+	t.Run("filters by signer", func(t *testing.T) {
+		ctx, k, entries, signer := setup(t)
+		res, err := k.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{Signer: signer})
+		require.NoError(t, err)
+		require.Equal(t, []types.AuditEntry{entries[0], entries[2], entries[3]}, res.AuditEntries)
+	})
+
+	t.Run("filters by message type", func(t *testing.T) {
+		ctx, k, entries, _ := setup(t)
+		res, err := k.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{MsgTypeUrl: cancelURL})
+		require.NoError(t, err)
+		require.Equal(t, []types.AuditEntry{entries[0], entries[1], entries[3]}, res.AuditEntries)
+	})
+
+	t.Run("filters by signer and message type with pagination", func(t *testing.T) {
+		ctx, k, entries, signer := setup(t)
+		res, err := k.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{
+			Signer:     signer,
+			MsgTypeUrl: cancelURL,
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []types.AuditEntry{entries[0]}, res.AuditEntries)
+		require.EqualValues(t, 2, res.Pagination.Total)
+
+		res, err = k.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{
+			Signer:     signer,
+			MsgTypeUrl: cancelURL,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []types.AuditEntry{entries[3]}, res.AuditEntries)
+	})
+
+	t.Run("returns no entry for unknown signer", func(t *testing.T) {
+		ctx, k, _, _ := setup(t)
+		res, err := k.AuditLog(sdk.WrapSDKContext(ctx), &types.QueryAuditLogRequest{Signer: sample.AccAddress()})
+		require.NoError(t, err)
+		require.Empty(t, res.AuditEntries)
+	})
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthorizationList queries the authorizations of the messages
+func (k Keeper) AuthorizationList(c context.Context, req *types.QueryAuthorizationListRequest) (*types.QueryAuthorizationListResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// fetch the authorization list
+	list, found := k.GetAuthorizationList(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "authorization list not found")
+	}
+
+	return &types.QueryAuthorizationListResponse{AuthorizationList: list}, nil
+}
@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestKeeper_AuthorizationList(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		_, err := k.AuthorizationList(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("authorization list not found", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		_, err := k.AuthorizationList(ctx, &types.QueryAuthorizationListRequest{})
+		require.ErrorContains(t, err, "authorization list not found")
+	})
+
+	t.Run("can retrieve authorization list", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		list := types.DefaultAuthorizationList()
+		k.SetAuthorizationList(ctx, list)
+
+		res, err := k.AuthorizationList(ctx, &types.QueryAuthorizationListRequest{})
+		require.NoError(t, err)
+		require.Equal(t, list, res.AuthorizationList)
+	})
+}
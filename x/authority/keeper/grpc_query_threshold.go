@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ThresholdProposal queries a proposal of a threshold policy by id
+func (k Keeper) ThresholdProposal(c context.Context, req *types.QueryGetThresholdProposalRequest) (*types.QueryGetThresholdProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	proposal, found := k.GetThresholdProposal(ctx, req.ProposalId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "threshold proposal not found")
+	}
+
+	return &types.QueryGetThresholdProposalResponse{ThresholdProposal: proposal}, nil
+}
+
+// ThresholdProposalAll queries all the pending proposals of the threshold policies
+func (k Keeper) ThresholdProposalAll(c context.Context, req *types.QueryAllThresholdProposalRequest) (*types.QueryAllThresholdProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var proposals []types.ThresholdProposal
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalKey))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var proposal types.ThresholdProposal
+		if err := k.cdc.Unmarshal(value, &proposal); err != nil {
+			return err
+		}
+		proposals = append(proposals, proposal)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllThresholdProposalResponse{ThresholdProposals: proposals, Pagination: pageRes}, nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestKeeper_ThresholdProposal(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		_, err := k.ThresholdProposal(sdk.WrapSDKContext(ctx), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("proposal not found", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		_, err := k.ThresholdProposal(sdk.WrapSDKContext(ctx), &types.QueryGetThresholdProposalRequest{ProposalId: 1})
+		require.Error(t, err)
+	})
+
+	t.Run("can query a proposal", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		proposal := sample.ThresholdProposal(t, 1, 1000)
+		k.SetThresholdProposal(ctx, proposal)
+
+		res, err := k.ThresholdProposal(sdk.WrapSDKContext(ctx), &types.QueryGetThresholdProposalRequest{ProposalId: 1})
+		require.NoError(t, err)
+		require.Equal(t, proposal.Proposer, res.ThresholdProposal.Proposer)
+		require.Equal(t, proposal.GetMsgURL(), res.ThresholdProposal.GetMsgURL())
+	})
+}
+
+func TestKeeper_ThresholdProposalAll(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		_, err := k.ThresholdProposalAll(sdk.WrapSDKContext(ctx), nil)
+		require.Error(t, err)
+	})
+
+	t.Run("can query all proposals with pagination", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		for i := uint64(0); i < 3; i++ {
+			k.SetThresholdProposal(ctx, sample.ThresholdProposal(t, i, 1000))
+		}
+
+		res, err := k.ThresholdProposalAll(sdk.WrapSDKContext(ctx), &types.QueryAllThresholdProposalRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.ThresholdProposals, 2)
+		require.EqualValues(t, 3, res.Pagination.Total)
+		require.EqualValues(t, 0, res.ThresholdProposals[0].Id)
+	})
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TimelockConfig queries the messages that must be queued in the timelock
+func (k Keeper) TimelockConfig(c context.Context, req *types.QueryTimelockConfigRequest) (*types.QueryTimelockConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// an unset config timelocks no message
+	config, _ := k.GetTimelockConfig(ctx)
+
+	return &types.QueryTimelockConfigResponse{TimelockConfig: config}, nil
+}
+
+// QueuedAction queries a queued action of the timelock by id
+func (k Keeper) QueuedAction(c context.Context, req *types.QueryGetQueuedActionRequest) (*types.QueryGetQueuedActionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	action, found := k.GetQueuedAction(ctx, req.ActionId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "queued action not found")
+	}
+
+	return &types.QueryGetQueuedActionResponse{QueuedAction: action}, nil
+}
+
+// QueuedActionAll queries all the queued actions of the timelock
+func (k Keeper) QueuedActionAll(c context.Context, req *types.QueryAllQueuedActionRequest) (*types.QueryAllQueuedActionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var actions []types.QueuedAction
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionKey))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var action types.QueuedAction
+		if err := k.cdc.Unmarshal(value, &action); err != nil {
+			return err
+		}
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllQueuedActionResponse{QueuedActions: actions, Pagination: pageRes}, nil
+}
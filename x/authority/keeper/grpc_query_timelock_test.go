@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestKeeper_TimelockConfig(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		_, err := k.TimelockConfig(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("empty config if not set", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		res, err := k.TimelockConfig(ctx, &types.QueryTimelockConfigRequest{})
+		require.NoError(t, err)
+		require.Empty(t, res.TimelockConfig.Messages)
+	})
+
+	t.Run("can retrieve timelock config", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		k.SetTimelockConfig(ctx, types.DefaultTimelockConfig())
+
+		res, err := k.TimelockConfig(ctx, &types.QueryTimelockConfigRequest{})
+		require.NoError(t, err)
+		require.Equal(t, types.DefaultTimelockConfig(), res.TimelockConfig)
+	})
+}
+
+func TestKeeper_QueuedAction(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		_, err := k.QueuedAction(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("queued action not found", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		_, err := k.QueuedAction(ctx, &types.QueryGetQueuedActionRequest{ActionId: 1})
+		require.ErrorContains(t, err, "queued action not found")
+	})
+
+	t.Run("can retrieve queued action", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		action := sample.QueuedAction(t, 1, 1000)
+		k.SetQueuedAction(ctx, action)
+
+		res, err := k.QueuedAction(ctx, &types.QueryGetQueuedActionRequest{ActionId: 1})
+		require.NoError(t, err)
+		require.Equal(t, action.Signer, res.QueuedAction.Signer)
+		require.Equal(t, action.GetMsgURL(), res.QueuedAction.GetMsgURL())
+	})
+}
+
+func TestKeeper_QueuedActionAll(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+
+		_, err := k.QueuedActionAll(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("can retrieve queued actions with pagination", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		for i := uint64(0); i < 5; i++ {
+			k.SetQueuedAction(ctx, sample.QueuedAction(t, i, 1000))
+		}
+
+		res, err := k.QueuedActionAll(ctx, &types.QueryAllQueuedActionRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.QueuedActions, 5)
+
+		res, err = k.QueuedActionAll(ctx, &types.QueryAllQueuedActionRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.QueuedActions, 2)
+		require.EqualValues(t, 0, res.QueuedActions[0].Id)
+		require.EqualValues(t, 5, res.Pagination.Total)
+	})
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,8 @@ type Keeper struct {
 	memKey   storetypes.StoreKey
 	// the address capable of executing a MsgUpdatePolicies message. Typically, this should be the x/gov module account.
 	govAddr sdk.AccAddress
+	// the router used to execute the actions queued in the timelock and the approved threshold proposals
+	router types.MsgRouter
 }
 
 // NewKeeper creates new instances of the authority Keeper
@@ -25,12 +28,14 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	govAddr sdk.AccAddress,
+	router types.MsgRouter,
 ) Keeper {
 	return Keeper{
 		cdc:      cdc,
 		storeKey: storeKey,
 		memKey:   memKey,
 		govAddr:  govAddr,
+		router:   router,
 	}
 }
 
@@ -53,3 +58,34 @@ func (k Keeper) GetMemKey() storetypes.StoreKey {
 func (k Keeper) GetCodec() codec.Codec {
 	return k.cdc
 }
+
+// executeMsg executes a message through the message router, the state changes are only committed if the
+// execution succeeds
+func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) error {
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return fmt.Errorf("no handler for message %s", sdk.MsgTypeURL(msg))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	res, err := safeExecuteHandler(cacheCtx, msg, handler)
+	if err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(res.GetEvents())
+
+	return nil
+}
+
+// safeExecuteHandler executes the handler of a message and returns an error if the handler panics
+// the queued actions are executed in the end blocker where a panic would halt the chain
+func safeExecuteHandler(ctx sdk.Context, msg sdk.Msg, handler baseapp.MsgServiceHandler) (res *sdk.Result, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handling message %s panicked: %v", sdk.MsgTypeURL(msg), r)
+		}
+	}()
+	res, err = handler(ctx, msg)
+	return
+}
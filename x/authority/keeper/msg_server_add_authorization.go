@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// AddAuthorization authorizes an address or a policy to execute a message, optionally for a single chain
+func (k msgServer) AddAuthorization(goCtx context.Context, msg *types.MsgAddAuthorization) (*types.MsgAddAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check called by governance
+	if k.govAddr.String() != msg.Signer {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority, expected %s, got %s",
+			k.govAddr.String(),
+			msg.Signer,
+		)
+	}
+
+	// add the authorization
+	list, _ := k.GetAuthorizationList(ctx)
+	if list.Find(msg.Authorization) >= 0 {
+		return nil, errorsmod.Wrapf(types.ErrAuthorizationAlreadyExists, "message %s", msg.Authorization.MsgUrl)
+	}
+	list.Authorizations = append(list.Authorizations, msg.Authorization)
+	k.SetAuthorizationList(ctx, list)
+
+	return &types.MsgAddAuthorizationResponse{}, nil
+}
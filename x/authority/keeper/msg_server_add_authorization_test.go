@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMsgServer_AddAuthorization(t *testing.T) {
+	authorization := types.Authorization{
+		MsgUrl:  "/zetachain.zetacore.observer.MsgUpdateChainParams",
+		ChainId: 1,
+		Address: sample.AccAddress(),
+	}
+
+	t.Run("can't add authorization with invalid signer", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.AddAuthorization(sdk.WrapSDKContext(ctx), &types.MsgAddAuthorization{
+			Signer:        sample.AccAddress(),
+			Authorization: authorization,
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	t.Run("can add authorization", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		k.SetAuthorizationList(ctx, types.DefaultAuthorizationList())
+
+		res, err := msgServer.AddAuthorization(sdk.WrapSDKContext(ctx), &types.MsgAddAuthorization{
+			Signer:        keepertest.AuthorityGovAddress.String(),
+			Authorization: authorization,
+		})
+		require.NotNil(t, res)
+		require.NoError(t, err)
+
+		// Check authorization is added
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Len(t, list.Authorizations, len(types.DefaultAuthorizationList().Authorizations)+1)
+		require.True(t, list.Find(authorization) >= 0)
+	})
+
+	t.Run("can add authorization if no authorization list", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.AddAuthorization(sdk.WrapSDKContext(ctx), &types.MsgAddAuthorization{
+			Signer:        keepertest.AuthorityGovAddress.String(),
+			Authorization: authorization,
+		})
+		require.NoError(t, err)
+
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Equal(t, []types.Authorization{authorization}, list.Authorizations)
+	})
+
+	t.Run("can't add existing authorization", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		k.SetAuthorizationList(ctx, types.AuthorizationList{Authorizations: []types.Authorization{authorization}})
+
+		_, err := msgServer.AddAuthorization(sdk.WrapSDKContext(ctx), &types.MsgAddAuthorization{
+			Signer:        keepertest.AuthorityGovAddress.String(),
+			Authorization: authorization,
+		})
+		require.ErrorIs(t, err, types.ErrAuthorizationAlreadyExists)
+	})
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// ApproveThresholdProposal approves a proposal of a threshold policy before its expiry
+// the message of the proposal is executed with the policy address as signer when the approval reaches the threshold,
+// the transaction fails and the approval is not recorded if the execution fails
+// Authorized: the members of the threshold policy
+func (k msgServer) ApproveThresholdProposal(goCtx context.Context, msg *types.MsgApproveThresholdProposal) (*types.MsgApproveThresholdProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposal, found := k.GetThresholdProposal(ctx, msg.ProposalId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrThresholdProposalNotFound, "proposal %d", msg.ProposalId)
+	}
+	if ctx.BlockHeight() > proposal.ExpiryHeight {
+		return nil, errorsmod.Wrapf(
+			types.ErrThresholdProposalExpired,
+			"proposal %d expired at height %d",
+			proposal.Id,
+			proposal.ExpiryHeight,
+		)
+	}
+
+	// the membership is checked against the current policy as the members may have changed since the submission
+	policy, found := k.GetPolicy(ctx, proposal.PolicyType)
+	if !found || !policy.IsThreshold() {
+		return nil, errorsmod.Wrapf(types.ErrNotThresholdPolicy, "policy %s", proposal.PolicyType)
+	}
+	if !policy.IsMember(msg.Signer) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a member of the policy %s", msg.Signer, proposal.PolicyType)
+	}
+	if proposal.HasApproved(msg.Signer) {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyApproved, "proposal %d approved by %s", proposal.Id, msg.Signer)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, msg.Signer)
+	k.SetThresholdProposal(ctx, proposal)
+	EmitEventThresholdProposalApproved(ctx, proposal, msg.Signer, policy.CountApprovals(proposal.Approvals))
+
+	executed, err := k.ExecuteThresholdProposalIfApproved(ctx, policy, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveThresholdProposalResponse{Executed: executed}, nil
+}
@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMsgServer_ApproveThresholdProposal(t *testing.T) {
+	members := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	policyAddress := types.ThresholdPolicyAddress(types.PolicyType_groupEmergency).String()
+
+	// setup sets a 2 of 3 emergency policy and a proposal approved by the first member cancelling a queued action
+	setup := func(t *testing.T) (keeper.Keeper, sdk.Context, types.ThresholdProposal) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policy := sample.ThresholdPolicy(types.PolicyType_groupEmergency, members, 2)
+		k.SetPolicies(ctx, types.Policies{Items: []*types.Policy{policy}})
+		k.SetQueuedAction(ctx, sample.QueuedAction(t, 1, 1000))
+
+		kr := authorityKeeperWithRouter(k, cancelTimelockedActionHandler(*k))
+		ctx = ctx.WithBlockHeight(100)
+		proposal, err := kr.CreateThresholdProposal(
+			ctx,
+			*policy,
+			members[0],
+			types.NewMsgCancelTimelockedAction(policyAddress, 1),
+		)
+		require.NoError(t, err)
+		return kr, ctx, proposal
+	}
+
+	t.Run("proposal is executed once the threshold is reached", func(t *testing.T) {
+		k, ctx, proposal := setup(t)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		res, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(members[1], proposal.Id),
+		)
+		require.NoError(t, err)
+		require.True(t, res.Executed)
+
+		_, found := k.GetThresholdProposal(ctx, proposal.Id)
+		require.False(t, found)
+		_, found = k.GetQueuedAction(ctx, 1)
+		require.False(t, found)
+	})
+
+	t.Run("approval is recorded if the threshold is not reached", func(t *testing.T) {
+		k, ctx, proposal := setup(t)
+		policy := sample.ThresholdPolicy(types.PolicyType_groupEmergency, members, 3)
+		k.SetPolicies(ctx, types.Policies{Items: []*types.Policy{policy}})
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		res, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(members[1], proposal.Id),
+		)
+		require.NoError(t, err)
+		require.False(t, res.Executed)
+
+		got, found := k.GetThresholdProposal(ctx, proposal.Id)
+		require.True(t, found)
+		require.Equal(t, []string{members[0], members[1]}, got.Approvals)
+	})
+
+	t.Run("approvals of removed members are not counted", func(t *testing.T) {
+		k, ctx, proposal := setup(t)
+		newMember := sample.AccAddress()
+		policy := sample.ThresholdPolicy(types.PolicyType_groupEmergency, []string{members[1], members[2], newMember}, 2)
+		k.SetPolicies(ctx, types.Policies{Items: []*types.Policy{policy}})
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		res, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(members[1], proposal.Id),
+		)
+		require.NoError(t, err)
+		require.False(t, res.Executed)
+
+		res, err = msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(newMember, proposal.Id),
+		)
+		require.NoError(t, err)
+		require.True(t, res.Executed)
+	})
+
+	t.Run("can't approve if not a member", func(t *testing.T) {
+		k, ctx, proposal := setup(t)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		_, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(sample.AccAddress(), proposal.Id),
+		)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	})
+
+	t.Run("can't approve twice", func(t *testing.T) {
+		k, ctx, proposal := setup(t)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		_, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(members[0], proposal.Id),
+		)
+		require.ErrorIs(t, err, types.ErrAlreadyApproved)
+	})
+
+	t.Run("can't approve an expired proposal", func(t *testing.T) {
+		k, ctx, proposal := setup(t)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		_, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx.WithBlockHeight(proposal.ExpiryHeight+1)),
+			types.NewMsgApproveThresholdProposal(members[1], proposal.Id),
+		)
+		require.ErrorIs(t, err, types.ErrThresholdProposalExpired)
+	})
+
+	t.Run("can't approve a proposal not found", func(t *testing.T) {
+		k, ctx, _ := setup(t)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		_, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(members[1], 42),
+		)
+		require.ErrorIs(t, err, types.ErrThresholdProposalNotFound)
+	})
+
+	t.Run("can't approve if the policy is no longer a threshold policy", func(t *testing.T) {
+		k, ctx, proposal := setup(t)
+		k.SetPolicies(ctx, sample.Policies())
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		_, err := msgServer.ApproveThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgApproveThresholdProposal(members[1], proposal.Id),
+		)
+		require.ErrorIs(t, err, types.ErrNotThresholdPolicy)
+	})
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// CancelTimelockedAction removes an action from the timelock queue before its execution
+// Authorized: emergency policy group
+func (k msgServer) CancelTimelockedAction(goCtx context.Context, msg *types.MsgCancelTimelockedAction) (*types.MsgCancelTimelockedActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAuthorizedForMsgWithPolicy(ctx, msg.Signer, msg, types.PolicyType_groupEmergency) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "cancel can only be executed by the emergency policy account")
+	}
+
+	action, found := k.GetQueuedAction(ctx, msg.ActionId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrQueuedActionNotFound, "action %d", msg.ActionId)
+	}
+	k.RemoveQueuedAction(ctx, action)
+	EmitEventTimelockedActionCancelled(ctx, action, msg.Signer)
+
+	return &types.MsgCancelTimelockedActionResponse{}, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMsgServer_CancelTimelockedAction(t *testing.T) {
+	t.Run("emergency group can cancel a queued action", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+		action := sample.QueuedAction(t, 1, 1000)
+		k.SetQueuedAction(ctx, action)
+
+		_, err := msgServer.CancelTimelockedAction(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgCancelTimelockedAction(policies.Items[0].Address, action.Id),
+		)
+		require.NoError(t, err)
+
+		_, found := k.GetQueuedAction(ctx, action.Id)
+		require.False(t, found)
+
+		// the action is not executed
+		require.Equal(t, 0, k.ExecuteQueuedActions(ctx.WithBlockHeight(action.ExecutionHeight)))
+
+		// the cancellation is recorded in the audit log
+		entries := k.GetAllAuditEntries(ctx)
+		require.Len(t, entries, 1)
+		require.Equal(t, policies.Items[0].Address, entries[0].Signer)
+		require.Equal(t, types.PolicyType_groupEmergency, entries[0].PolicyType)
+	})
+
+	t.Run("admin group can't cancel a queued action", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+		action := sample.QueuedAction(t, 1, 1000)
+		k.SetQueuedAction(ctx, action)
+
+		_, err := msgServer.CancelTimelockedAction(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgCancelTimelockedAction(policies.Items[1].Address, action.Id),
+		)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+		_, found := k.GetQueuedAction(ctx, action.Id)
+		require.True(t, found)
+	})
+
+	t.Run("can't cancel a non-existing action", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+
+		_, err := msgServer.CancelTimelockedAction(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgCancelTimelockedAction(policies.Items[0].Address, 1),
+		)
+		require.ErrorIs(t, err, types.ErrQueuedActionNotFound)
+	})
+}
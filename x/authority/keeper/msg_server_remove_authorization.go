@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// RemoveAuthorization removes an authorization of a message
+func (k msgServer) RemoveAuthorization(goCtx context.Context, msg *types.MsgRemoveAuthorization) (*types.MsgRemoveAuthorizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check called by governance
+	if k.govAddr.String() != msg.Signer {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority, expected %s, got %s",
+			k.govAddr.String(),
+			msg.Signer,
+		)
+	}
+
+	// remove the authorization
+	list, _ := k.GetAuthorizationList(ctx)
+	i := list.Find(msg.Authorization)
+	if i < 0 {
+		return nil, errorsmod.Wrapf(types.ErrAuthorizationNotFound, "message %s", msg.Authorization.MsgUrl)
+	}
+	list.Authorizations = append(list.Authorizations[:i], list.Authorizations[i+1:]...)
+	k.SetAuthorizationList(ctx, list)
+
+	return &types.MsgRemoveAuthorizationResponse{}, nil
+}
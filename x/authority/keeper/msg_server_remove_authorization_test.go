@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMsgServer_RemoveAuthorization(t *testing.T) {
+	const msgURL = "/zetachain.zetacore.observer.MsgUpdateChainParams"
+
+	t.Run("can't remove authorization with invalid signer", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		list := sample.AuthorizationList(msgURL)
+		k.SetAuthorizationList(ctx, list)
+
+		_, err := msgServer.RemoveAuthorization(sdk.WrapSDKContext(ctx), &types.MsgRemoveAuthorization{
+			Signer:        sample.AccAddress(),
+			Authorization: list.Authorizations[1],
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	})
+
+	t.Run("can remove authorization", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		list := sample.AuthorizationList(msgURL)
+		k.SetAuthorizationList(ctx, list)
+
+		res, err := msgServer.RemoveAuthorization(sdk.WrapSDKContext(ctx), &types.MsgRemoveAuthorization{
+			Signer:        keepertest.AuthorityGovAddress.String(),
+			Authorization: list.Authorizations[0],
+		})
+		require.NotNil(t, res)
+		require.NoError(t, err)
+
+		// Check authorization is removed
+		got, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Equal(t, []types.Authorization{list.Authorizations[1]}, got.Authorizations)
+	})
+
+	t.Run("can't remove non-existing authorization", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		k.SetAuthorizationList(ctx, sample.AuthorizationList(msgURL))
+
+		_, err := msgServer.RemoveAuthorization(sdk.WrapSDKContext(ctx), &types.MsgRemoveAuthorization{
+			Signer: keepertest.AuthorityGovAddress.String(),
+			Authorization: types.Authorization{
+				MsgUrl:     msgURL,
+				PolicyType: types.PolicyType_groupEmergency,
+			},
+		})
+		require.ErrorIs(t, err, types.ErrAuthorizationNotFound)
+	})
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// SubmitThresholdProposal submits a message of a threshold policy for the approval of the policy members
+// the submission counts as the approval of the proposer, the message is executed with the policy address as signer
+// once the threshold of approvals is reached
+// Authorized: the members of the threshold policy
+func (k msgServer) SubmitThresholdProposal(goCtx context.Context, msg *types.MsgSubmitThresholdProposal) (*types.MsgSubmitThresholdProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	policy, found := k.GetPolicy(ctx, msg.PolicyType)
+	if !found || !policy.IsThreshold() {
+		return nil, errorsmod.Wrapf(types.ErrNotThresholdPolicy, "policy %s", msg.PolicyType)
+	}
+	if !policy.IsMember(msg.Signer) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not a member of the policy %s", msg.Signer, msg.PolicyType)
+	}
+
+	proposedMsg, err := msg.GetProposedMsg()
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidThresholdProposal, err.Error())
+	}
+
+	proposal, err := k.CreateThresholdProposal(ctx, policy, msg.Signer, proposedMsg)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidThresholdProposal, err.Error())
+	}
+	EmitEventThresholdProposalSubmitted(ctx, proposal)
+
+	executed, err := k.ExecuteThresholdProposalIfApproved(ctx, policy, proposal)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitThresholdProposalResponse{
+		ProposalId: proposal.Id,
+		Executed:   executed,
+	}, nil
+}
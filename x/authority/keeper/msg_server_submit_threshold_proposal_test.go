@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// cancelTimelockedActionHandler returns a handler executing the cancellations of queued actions with the msg server
+func cancelTimelockedActionHandler(k keeper.Keeper) func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		res, err := keeper.NewMsgServerImpl(k).CancelTimelockedAction(
+			sdk.WrapSDKContext(ctx),
+			msg.(*types.MsgCancelTimelockedAction),
+		)
+		return sdk.WrapServiceResult(ctx, res, err)
+	}
+}
+
+func TestMsgServer_SubmitThresholdProposal(t *testing.T) {
+	members := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	policyAddress := types.ThresholdPolicyAddress(types.PolicyType_groupEmergency).String()
+
+	setup := func(t *testing.T, threshold uint32) (keeper.Keeper, sdk.Context) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		k.SetPolicies(ctx, types.Policies{
+			Items: []*types.Policy{
+				sample.ThresholdPolicy(types.PolicyType_groupEmergency, members, threshold),
+			},
+		})
+		k.SetQueuedAction(ctx, sample.QueuedAction(t, 1, 1000))
+		return authorityKeeperWithRouter(k, cancelTimelockedActionHandler(*k)), ctx.WithBlockHeight(100)
+	}
+
+	newMsg := func(t *testing.T, signer string, policyType types.PolicyType) *types.MsgSubmitThresholdProposal {
+		msg, err := types.NewMsgSubmitThresholdProposal(
+			signer,
+			policyType,
+			types.NewMsgCancelTimelockedAction(policyAddress, 1),
+		)
+		require.NoError(t, err)
+		return msg
+	}
+
+	t.Run("member can submit a proposal", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		res, err := msgServer.SubmitThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			newMsg(t, members[0], types.PolicyType_groupEmergency),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.ProposalId)
+		require.False(t, res.Executed)
+
+		proposal, found := k.GetThresholdProposal(ctx, res.ProposalId)
+		require.True(t, found)
+		require.Equal(t, members[0], proposal.Proposer)
+		require.Equal(t, []string{members[0]}, proposal.Approvals)
+		require.EqualValues(t, 200, proposal.ExpiryHeight)
+
+		// the message is not executed
+		_, found = k.GetQueuedAction(ctx, 1)
+		require.True(t, found)
+	})
+
+	t.Run("proposal is executed with the policy address if the threshold is one", func(t *testing.T) {
+		k, ctx := setup(t, 1)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		res, err := msgServer.SubmitThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			newMsg(t, members[0], types.PolicyType_groupEmergency),
+		)
+		require.NoError(t, err)
+		require.True(t, res.Executed)
+
+		_, found := k.GetThresholdProposal(ctx, res.ProposalId)
+		require.False(t, found)
+		_, found = k.GetQueuedAction(ctx, 1)
+		require.False(t, found)
+
+		// the policy address passed the authority check of the message
+		entries := k.GetAllAuditEntries(ctx)
+		require.Len(t, entries, 1)
+		require.Equal(t, policyAddress, entries[0].Signer)
+	})
+
+	t.Run("can't submit a proposal if not a member", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		_, err := msgServer.SubmitThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			newMsg(t, sample.AccAddress(), types.PolicyType_groupEmergency),
+		)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.Empty(t, k.GetAllThresholdProposals(ctx))
+	})
+
+	t.Run("can't submit a proposal for a policy without members", func(t *testing.T) {
+		k, ctx := setup(t, 2)
+		k.SetPolicies(ctx, sample.Policies())
+		msgServer := keeper.NewMsgServerImpl(k)
+
+		_, err := msgServer.SubmitThresholdProposal(
+			sdk.WrapSDKContext(ctx),
+			newMsg(t, members[0], types.PolicyType_groupEmergency),
+		)
+		require.ErrorIs(t, err, types.ErrNotThresholdPolicy)
+	})
+
+	t.Run("can't submit a proposal if the execution fails", func(t *testing.T) {
+		k, ctx := setup(t, 1)
+		msgServer := keeper.NewMsgServerImpl(k)
+		msg, err := types.NewMsgSubmitThresholdProposal(
+			members[0],
+			types.PolicyType_groupEmergency,
+			types.NewMsgCancelTimelockedAction(policyAddress, 42),
+		)
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitThresholdProposal(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrQueuedActionNotFound)
+	})
+}
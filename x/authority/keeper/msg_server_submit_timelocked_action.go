@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// SubmitTimelockedAction queues a timelocked message, the message is executed in the end blocker once its delay
+// has elapsed unless the emergency group cancels it
+// Authorized: the addresses authorized to execute the message on any chain, the authorization for the chain of the
+// message is checked when the message is executed
+func (k msgServer) SubmitTimelockedAction(goCtx context.Context, msg *types.MsgSubmitTimelockedAction) (*types.MsgSubmitTimelockedActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	timelockedMsg, err := msg.GetTimelockedMsg()
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTimelockedMsg, err.Error())
+	}
+	msgURL := sdk.MsgTypeURL(timelockedMsg)
+
+	config, _ := k.GetTimelockConfig(ctx)
+	delayBlocks, found := config.GetDelayBlocks(msgURL)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrMsgNotTimelocked, "message %s", msgURL)
+	}
+
+	authorization, found := k.getMsgURLAuthorization(ctx, msg.Signer, msgURL, 0, true)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not authorized to execute %s", msg.Signer, msgURL)
+	}
+	k.recordAuditEntry(ctx, msg.Signer, msg, authorization.PolicyType, authorization.Address != "")
+
+	action, err := k.QueueAction(ctx, msg.Signer, timelockedMsg, delayBlocks)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTimelockedMsg, err.Error())
+	}
+	EmitEventTimelockedActionQueued(ctx, action)
+
+	return &types.MsgSubmitTimelockedActionResponse{
+		ActionId:        action.Id,
+		ExecutionHeight: action.ExecutionHeight,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestMsgServer_SubmitTimelockedAction(t *testing.T) {
+	setup := func(t *testing.T) (*keeper.Keeper, sdk.Context, types.Policies) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+		k.SetAuthorizationList(ctx, types.DefaultAuthorizationList())
+		k.SetTimelockConfig(ctx, types.DefaultTimelockConfig())
+		return k, ctx.WithBlockHeight(100), policies
+	}
+
+	t.Run("can queue a timelocked message", func(t *testing.T) {
+		k, ctx, policies := setup(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := policies.Items[1].Address
+
+		msg, err := types.NewMsgSubmitTimelockedAction(
+			admin,
+			fungibletypes.NewMsgUpdateSystemContract(admin, sample.EthAddress().Hex()),
+		)
+		require.NoError(t, err)
+
+		res, err := msgServer.SubmitTimelockedAction(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, res.ActionId)
+		require.EqualValues(t, 100+types.DefaultTimelockDelayBlocks, res.ExecutionHeight)
+
+		action, found := k.GetQueuedAction(ctx, res.ActionId)
+		require.True(t, found)
+		require.Equal(t, admin, action.Signer)
+		require.Equal(t, "/zetachain.zetacore.fungible.MsgUpdateSystemContract", action.GetMsgURL())
+
+		// the submission is recorded in the audit log
+		entries := k.GetAllAuditEntries(ctx)
+		require.Len(t, entries, 1)
+		require.Equal(t, admin, entries[0].Signer)
+		require.Equal(t, sdk.MsgTypeURL(msg), entries[0].MsgTypeUrl)
+		require.Equal(t, types.PolicyType_groupAdmin, entries[0].PolicyType)
+	})
+
+	t.Run("can't queue a message not timelocked", func(t *testing.T) {
+		k, ctx, policies := setup(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := policies.Items[1].Address
+
+		msg, err := types.NewMsgSubmitTimelockedAction(
+			admin,
+			fungibletypes.NewMsgDeploySystemContracts(admin),
+		)
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitTimelockedAction(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, types.ErrMsgNotTimelocked)
+		require.Empty(t, k.GetAllQueuedActions(ctx))
+	})
+
+	t.Run("can't queue a message if not authorized", func(t *testing.T) {
+		k, ctx, policies := setup(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		emergency := policies.Items[0].Address
+
+		msg, err := types.NewMsgSubmitTimelockedAction(
+			emergency,
+			fungibletypes.NewMsgUpdateSystemContract(emergency, sample.EthAddress().Hex()),
+		)
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitTimelockedAction(sdk.WrapSDKContext(ctx), msg)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.Empty(t, k.GetAllQueuedActions(ctx))
+	})
+
+	t.Run("can queue a message if authorized on a single chain", func(t *testing.T) {
+		k, ctx, _ := setup(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		address := sample.AccAddress()
+		k.SetAuthorizationList(ctx, types.AuthorizationList{
+			Authorizations: []types.Authorization{
+				{MsgUrl: "/zetachain.zetacore.crosschain.MsgMigrateTssFunds", ChainId: 1, Address: address},
+			},
+		})
+
+		msg, err := types.NewMsgSubmitTimelockedAction(
+			address,
+			crosschaintypes.NewMsgMigrateTssFunds(address, 1, sdkmath.NewUint(1000)),
+		)
+		require.NoError(t, err)
+
+		_, err = msgServer.SubmitTimelockedAction(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(t, err)
+		require.Len(t, k.GetAllQueuedActions(ctx), 1)
+	})
+}
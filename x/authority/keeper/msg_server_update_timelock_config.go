@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// UpdateTimelockConfig updates the messages that must be queued in the timelock and their delay
+// the actions already queued are executed at their initial execution height
+func (k msgServer) UpdateTimelockConfig(goCtx context.Context, msg *types.MsgUpdateTimelockConfig) (*types.MsgUpdateTimelockConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check called by governance
+	if k.govAddr.String() != msg.Signer {
+		return nil, errorsmod.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"invalid authority, expected %s, got %s",
+			k.govAddr.String(),
+			msg.Signer,
+		)
+	}
+
+	// set the timelock config
+	k.SetTimelockConfig(ctx, msg.TimelockConfig)
+
+	return &types.MsgUpdateTimelockConfigResponse{}, nil
+}
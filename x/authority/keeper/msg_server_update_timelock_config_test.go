@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMsgServer_UpdateTimelockConfig(t *testing.T) {
+	t.Run("can't update timelock config with invalid signer", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.UpdateTimelockConfig(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateTimelockConfig(sample.AccAddress(), types.DefaultTimelockConfig()),
+		)
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+		_, found := k.GetTimelockConfig(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("can update timelock config", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		k.SetTimelockConfig(ctx, types.DefaultTimelockConfig())
+
+		config := types.TimelockConfig{
+			Messages: []types.TimelockedMessage{
+				{MsgUrl: "/zetachain.zetacore.fungible.MsgUpdateSystemContract", DelayBlocks: 100},
+			},
+		}
+		_, err := msgServer.UpdateTimelockConfig(
+			sdk.WrapSDKContext(ctx),
+			types.NewMsgUpdateTimelockConfig(keepertest.AuthorityGovAddress.String(), config),
+		)
+		require.NoError(t, err)
+
+		got, found := k.GetTimelockConfig(ctx)
+		require.True(t, found)
+		require.Equal(t, config, got)
+	})
+}
@@ -37,3 +37,18 @@ func (k Keeper) IsAuthorized(ctx sdk.Context, address string, policyType types.P
 	}
 	return false
 }
+
+// IsAuthorizedForMsgWithPolicy checks if the address is authorized for the given policy type to execute the message
+// the message is recorded in the audit log if the address is authorized
+func (k Keeper) IsAuthorizedForMsgWithPolicy(
+	ctx sdk.Context,
+	address string,
+	msg sdk.Msg,
+	policyType types.PolicyType,
+) bool {
+	if !k.IsAuthorized(ctx, address, policyType) {
+		return false
+	}
+	k.recordAuditEntry(ctx, address, msg, policyType, false)
+	return true
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/x/authority/types"
 
 	"github.com/stretchr/testify/require"
@@ -57,3 +58,24 @@ func TestKeeper_IsAuthorized(t *testing.T) {
 	require.False(t, k.IsAuthorized(ctx, sample.AccAddress(), types.PolicyType_groupAdmin))
 	require.False(t, k.IsAuthorized(ctx, sample.AccAddress(), types.PolicyType_groupEmergency))
 }
+
+func TestKeeper_IsAuthorizedForMsgWithPolicy(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+	policies := sample.Policies()
+	k.SetPolicies(ctx, policies)
+	emergency := policies.Items[0].Address
+	msg := types.NewMsgCancelTimelockedAction(emergency, 1)
+
+	// not authorized for another policy
+	require.False(t, k.IsAuthorizedForMsgWithPolicy(ctx, emergency, msg, types.PolicyType_groupAdmin))
+	require.Empty(t, k.GetAllAuditEntries(ctx))
+
+	// the message is recorded if authorized
+	require.True(t, k.IsAuthorizedForMsgWithPolicy(ctx, emergency, msg, types.PolicyType_groupEmergency))
+	entries := k.GetAllAuditEntries(ctx)
+	require.Len(t, entries, 1)
+	require.Equal(t, emergency, entries[0].Signer)
+	require.Equal(t, types.PolicyType_groupEmergency, entries[0].PolicyType)
+	require.False(t, entries[0].AuthorizedByAddress)
+	require.Equal(t, sdk.MsgTypeURL(msg), entries[0].MsgTypeUrl)
+}
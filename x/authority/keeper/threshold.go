@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// MaxThresholdProposalsExpiredPerBlock is the maximum number of expired threshold proposals removed in a block
+// the remaining expired proposals are removed in the next blocks
+const MaxThresholdProposalsExpiredPerBlock = 20
+
+// SetThresholdProposal sets a threshold proposal to the store and adds it to the expiry queue
+func (k Keeper) SetThresholdProposal(ctx sdk.Context, proposal types.ThresholdProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalKey))
+	b := k.cdc.MustMarshal(&proposal)
+	store.Set(types.ThresholdProposalIDKey(proposal.Id), b)
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalExpiryQueueKeyPrefix))
+	// #nosec G701 always positive
+	queueStore.Set(types.ThresholdProposalExpiryQueueKey(uint64(proposal.ExpiryHeight), proposal.Id), []byte{0})
+
+	if proposal.Id >= k.getNextThresholdProposalID(ctx) {
+		k.setNextThresholdProposalID(ctx, proposal.Id+1)
+	}
+}
+
+// GetThresholdProposal returns a threshold proposal from the store
+func (k Keeper) GetThresholdProposal(ctx sdk.Context, id uint64) (val types.ThresholdProposal, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalKey))
+	b := store.Get(types.ThresholdProposalIDKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveThresholdProposal removes a threshold proposal from the store and from the expiry queue
+func (k Keeper) RemoveThresholdProposal(ctx sdk.Context, proposal types.ThresholdProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalKey))
+	store.Delete(types.ThresholdProposalIDKey(proposal.Id))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalExpiryQueueKeyPrefix))
+	// #nosec G701 always positive
+	queueStore.Delete(types.ThresholdProposalExpiryQueueKey(uint64(proposal.ExpiryHeight), proposal.Id))
+}
+
+// GetAllThresholdProposals returns all the threshold proposals ordered by id
+func (k Keeper) GetAllThresholdProposals(ctx sdk.Context) (list []types.ThresholdProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ThresholdProposal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// GetPolicy returns the policy of the policy type
+func (k Keeper) GetPolicy(ctx sdk.Context, policyType types.PolicyType) (types.Policy, bool) {
+	policies, found := k.GetPolicies(ctx)
+	if !found {
+		return types.Policy{}, false
+	}
+	return policies.GetPolicy(policyType)
+}
+
+// CreateThresholdProposal creates a proposal of a threshold policy approved by its proposer
+func (k Keeper) CreateThresholdProposal(
+	ctx sdk.Context,
+	policy types.Policy,
+	proposer string,
+	msg sdk.Msg,
+) (types.ThresholdProposal, error) {
+	proposal, err := types.NewThresholdProposal(
+		k.getNextThresholdProposalID(ctx),
+		policy.PolicyType,
+		proposer,
+		msg,
+		ctx.BlockHeight(),
+		ctx.BlockHeight()+policy.ProposalExpiryBlocks,
+	)
+	if err != nil {
+		return types.ThresholdProposal{}, err
+	}
+	k.SetThresholdProposal(ctx, proposal)
+	return proposal, nil
+}
+
+// ExecuteThresholdProposalIfApproved executes the message of the proposal if the threshold of approvals of the
+// current policy members is reached, the proposal is removed once executed
+// The function returns true if the message is executed
+func (k Keeper) ExecuteThresholdProposalIfApproved(
+	ctx sdk.Context,
+	policy types.Policy,
+	proposal types.ThresholdProposal,
+) (bool, error) {
+	if policy.CountApprovals(proposal.Approvals) < policy.Threshold {
+		return false, nil
+	}
+
+	msg, err := proposal.GetProposedMsg()
+	if err != nil {
+		return false, errorsmod.Wrap(types.ErrInvalidThresholdProposal, err.Error())
+	}
+	k.RemoveThresholdProposal(ctx, proposal)
+
+	if err := k.executeMsg(ctx, msg); err != nil {
+		return false, errorsmod.Wrapf(err, "failed to execute threshold proposal %d", proposal.Id)
+	}
+	EmitEventThresholdProposalExecuted(ctx, proposal)
+
+	return true, nil
+}
+
+// RemoveExpiredThresholdProposals removes the threshold proposals whose expiry height is reached
+// The function returns the number of proposals removed
+func (k Keeper) RemoveExpiredThresholdProposals(ctx sdk.Context) int {
+	// collect the expired proposals, a proposal can still be approved at its expiry height
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalExpiryQueueKeyPrefix))
+	// #nosec G701 always positive
+	end := types.ThresholdProposalExpiryQueueHeightKey(uint64(ctx.BlockHeight() + 1))
+	iterator := queueStore.Iterator(nil, end)
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < MaxThresholdProposalsExpiredPerBlock; iterator.Next() {
+		key := iterator.Key()
+		if len(key) != 16 {
+			continue
+		}
+		ids = append(ids, binary.BigEndian.Uint64(key[8:]))
+	}
+	iterator.Close()
+
+	removed := 0
+	for _, id := range ids {
+		proposal, found := k.GetThresholdProposal(ctx, id)
+		if !found {
+			continue
+		}
+		k.RemoveThresholdProposal(ctx, proposal)
+		EmitEventThresholdProposalExpired(ctx, proposal)
+		removed++
+	}
+
+	return removed
+}
+
+func (k Keeper) getNextThresholdProposalID(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalCounterKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (k Keeper) setNextThresholdProposalID(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ThresholdProposalCounterKey))
+	store.Set([]byte{0}, types.ThresholdProposalIDKey(id))
+}
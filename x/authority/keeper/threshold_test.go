@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestKeeper_SetThresholdProposal(t *testing.T) {
+	t.Run("can set, get and remove threshold proposals", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		proposal := sample.ThresholdProposal(t, 5, 1000)
+
+		_, found := k.GetThresholdProposal(ctx, 5)
+		require.False(t, found)
+
+		k.SetThresholdProposal(ctx, proposal)
+		got, found := k.GetThresholdProposal(ctx, 5)
+		require.True(t, found)
+		require.Equal(t, proposal.Proposer, got.Proposer)
+		require.Equal(t, proposal.Approvals, got.Approvals)
+
+		// the message is unpacked when read from the store
+		msg, err := got.GetProposedMsg()
+		require.NoError(t, err)
+		require.Equal(t, "/zetachain.zetacore.fungible.MsgUpdateSystemContract", sdk.MsgTypeURL(msg))
+		require.Len(t, k.GetAllThresholdProposals(ctx), 1)
+
+		k.RemoveThresholdProposal(ctx, got)
+		_, found = k.GetThresholdProposal(ctx, 5)
+		require.False(t, found)
+		require.Empty(t, k.GetAllThresholdProposals(ctx))
+	})
+
+	t.Run("threshold proposals get increasing ids", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policy := sample.ThresholdPolicy(types.PolicyType_groupAdmin, []string{sample.AccAddress()}, 1)
+		msg := types.NewMsgCancelTimelockedAction(policy.Address, 1)
+
+		first, err := k.CreateThresholdProposal(ctx, *policy, policy.Members[0], msg)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, first.Id)
+		require.EqualValues(t, ctx.BlockHeight()+policy.ProposalExpiryBlocks, first.ExpiryHeight)
+
+		k.SetThresholdProposal(ctx, sample.ThresholdProposal(t, 10, 1000))
+		next, err := k.CreateThresholdProposal(ctx, *policy, policy.Members[0], msg)
+		require.NoError(t, err)
+		require.EqualValues(t, 11, next.Id)
+	})
+}
+
+func TestKeeper_RemoveExpiredThresholdProposals(t *testing.T) {
+	t.Run("removes the proposals whose expiry height is reached", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		k.SetThresholdProposal(ctx, sample.ThresholdProposal(t, 0, 1000))
+		k.SetThresholdProposal(ctx, sample.ThresholdProposal(t, 1, 1001))
+		k.SetThresholdProposal(ctx, sample.ThresholdProposal(t, 2, 999))
+
+		require.Equal(t, 0, k.RemoveExpiredThresholdProposals(ctx.WithBlockHeight(998)))
+		require.Equal(t, 2, k.RemoveExpiredThresholdProposals(ctx.WithBlockHeight(1000)))
+
+		proposals := k.GetAllThresholdProposals(ctx)
+		require.Len(t, proposals, 1)
+		require.EqualValues(t, 1, proposals[0].Id)
+	})
+
+	t.Run("removes a limited number of proposals per block", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		for i := 0; i < keeper.MaxThresholdProposalsExpiredPerBlock+5; i++ {
+			k.SetThresholdProposal(ctx, sample.ThresholdProposal(t, uint64(i), 1000))
+		}
+
+		ctx = ctx.WithBlockHeight(1000)
+		require.Equal(t, keeper.MaxThresholdProposalsExpiredPerBlock, k.RemoveExpiredThresholdProposals(ctx))
+		require.Equal(t, 5, k.RemoveExpiredThresholdProposals(ctx))
+		require.Empty(t, k.GetAllThresholdProposals(ctx))
+	})
+}
+
+func TestKeeper_ExecuteThresholdProposalIfApproved(t *testing.T) {
+	members := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	policy := sample.ThresholdPolicy(types.PolicyType_groupAdmin, members, 2)
+
+	t.Run("not executed if the threshold is not reached", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		var executed []sdk.Msg
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			executed = append(executed, msg)
+			return &sdk.Result{}, nil
+		})
+		proposal := sample.ThresholdProposal(t, 0, 1000)
+		proposal.Approvals = []string{members[0], sample.AccAddress()}
+		kr.SetThresholdProposal(ctx, proposal)
+
+		ok, err := kr.ExecuteThresholdProposalIfApproved(ctx, *policy, proposal)
+		require.NoError(t, err)
+		require.False(t, ok)
+		require.Empty(t, executed)
+
+		_, found := kr.GetThresholdProposal(ctx, proposal.Id)
+		require.True(t, found)
+	})
+
+	t.Run("executed and removed if the threshold is reached", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		var executed []sdk.Msg
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			executed = append(executed, msg)
+			return &sdk.Result{}, nil
+		})
+		proposal := sample.ThresholdProposal(t, 0, 1000)
+		proposal.Approvals = []string{members[0], members[2]}
+		kr.SetThresholdProposal(ctx, proposal)
+
+		ok, err := kr.ExecuteThresholdProposalIfApproved(ctx, *policy, proposal)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Len(t, executed, 1)
+		require.Equal(t, "/zetachain.zetacore.fungible.MsgUpdateSystemContract", sdk.MsgTypeURL(executed[0]))
+
+		_, found := kr.GetThresholdProposal(ctx, proposal.Id)
+		require.False(t, found)
+	})
+
+	t.Run("returns the error of a failed execution", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return nil, errors.New("execution failed")
+		})
+		proposal := sample.ThresholdProposal(t, 0, 1000)
+		proposal.Approvals = []string{members[0], members[1]}
+
+		ok, err := kr.ExecuteThresholdProposalIfApproved(ctx, *policy, proposal)
+		require.ErrorContains(t, err, "execution failed")
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// MaxQueuedActionsExecutedPerBlock is the maximum number of queued actions executed in a block
+// the remaining actions ready for execution are executed in the next blocks
+const MaxQueuedActionsExecutedPerBlock = 20
+
+// timelockExecutionKey is the context key set when a queued action is executed
+type timelockExecutionKey struct{}
+
+// isTimelockExecution returns true if the context is the context of the execution of a queued action
+func isTimelockExecution(ctx sdk.Context) bool {
+	executing, ok := ctx.Value(timelockExecutionKey{}).(bool)
+	return ok && executing
+}
+
+// SetTimelockConfig sets the timelock config to the store
+func (k Keeper) SetTimelockConfig(ctx sdk.Context, config types.TimelockConfig) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimelockConfigKey))
+	b := k.cdc.MustMarshal(&config)
+	store.Set([]byte{0}, b)
+}
+
+// GetTimelockConfig returns the timelock config from the store
+func (k Keeper) GetTimelockConfig(ctx sdk.Context) (val types.TimelockConfig, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TimelockConfigKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// IsTimelocked returns true if the message with the type URL must be queued in the timelock before its execution
+func (k Keeper) IsTimelocked(ctx sdk.Context, msgURL string) bool {
+	config, found := k.GetTimelockConfig(ctx)
+	if !found {
+		return false
+	}
+	_, timelocked := config.GetDelayBlocks(msgURL)
+	return timelocked
+}
+
+// SetQueuedAction sets a queued action to the store and adds it to the execution queue
+func (k Keeper) SetQueuedAction(ctx sdk.Context, action types.QueuedAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionKey))
+	b := k.cdc.MustMarshal(&action)
+	store.Set(types.QueuedActionIDKey(action.Id), b)
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionExecutionQueueKeyPrefix))
+	// #nosec G701 always positive
+	queueStore.Set(types.QueuedActionExecutionQueueKey(uint64(action.ExecutionHeight), action.Id), []byte{0})
+
+	if action.Id >= k.getNextQueuedActionID(ctx) {
+		k.setNextQueuedActionID(ctx, action.Id+1)
+	}
+}
+
+// GetQueuedAction returns a queued action from the store
+func (k Keeper) GetQueuedAction(ctx sdk.Context, id uint64) (val types.QueuedAction, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionKey))
+	b := store.Get(types.QueuedActionIDKey(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveQueuedAction removes a queued action from the store and from the execution queue
+func (k Keeper) RemoveQueuedAction(ctx sdk.Context, action types.QueuedAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionKey))
+	store.Delete(types.QueuedActionIDKey(action.Id))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionExecutionQueueKeyPrefix))
+	// #nosec G701 always positive
+	queueStore.Delete(types.QueuedActionExecutionQueueKey(uint64(action.ExecutionHeight), action.Id))
+}
+
+// GetAllQueuedActions returns all the queued actions ordered by id
+func (k Keeper) GetAllQueuedActions(ctx sdk.Context) (list []types.QueuedAction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionKey))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.QueuedAction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// QueueAction queues a message in the timelock, the message is executed once the delay of the message has elapsed
+func (k Keeper) QueueAction(ctx sdk.Context, signer string, msg sdk.Msg, delayBlocks int64) (types.QueuedAction, error) {
+	action, err := types.NewQueuedAction(
+		k.getNextQueuedActionID(ctx),
+		signer,
+		msg,
+		ctx.BlockHeight(),
+		ctx.BlockHeight()+delayBlocks,
+	)
+	if err != nil {
+		return types.QueuedAction{}, err
+	}
+	k.SetQueuedAction(ctx, action)
+	return action, nil
+}
+
+// ExecuteQueuedActions executes the queued actions whose execution height is reached
+// the actions are removed from the queue whether their execution succeeds or not
+// The function returns the number of actions executed
+func (k Keeper) ExecuteQueuedActions(ctx sdk.Context) int {
+	// collect the actions ready for execution
+	queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionExecutionQueueKeyPrefix))
+	// #nosec G701 always positive
+	end := types.QueuedActionExecutionQueueHeightKey(uint64(ctx.BlockHeight() + 1))
+	iterator := queueStore.Iterator(nil, end)
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < MaxQueuedActionsExecutedPerBlock; iterator.Next() {
+		key := iterator.Key()
+		if len(key) != 16 {
+			continue
+		}
+		ids = append(ids, binary.BigEndian.Uint64(key[8:]))
+	}
+	iterator.Close()
+
+	executed := 0
+	for _, id := range ids {
+		action, found := k.GetQueuedAction(ctx, id)
+		if !found {
+			continue
+		}
+		k.RemoveQueuedAction(ctx, action)
+
+		err := k.executeQueuedAction(ctx, action)
+		if err != nil {
+			k.Logger(ctx).Error("ExecuteQueuedActions: failed to execute queued action",
+				"action", action.Id,
+				"msg", action.GetMsgURL(),
+				"err", err.Error(),
+			)
+		}
+		EmitEventTimelockedActionExecuted(ctx, action, err)
+		executed++
+	}
+
+	return executed
+}
+
+// executeQueuedAction executes the message of a queued action, the state changes are only committed if the
+// execution succeeds
+func (k Keeper) executeQueuedAction(ctx sdk.Context, action types.QueuedAction) error {
+	msg, err := action.GetTimelockedMsg()
+	if err != nil {
+		return err
+	}
+
+	// the message is authorized again when executed as the authorizations may have changed during the delay
+	return k.executeMsg(ctx.WithValue(timelockExecutionKey{}, true), msg)
+}
+
+func (k Keeper) getNextQueuedActionID(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionCounterKey))
+	b := store.Get([]byte{0})
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (k Keeper) setNextQueuedActionID(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.QueuedActionCounterKey))
+	store.Set([]byte{0}, types.QueuedActionIDKey(id))
+}
@@ -0,0 +1,237 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/keeper"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+// msgRouter routes all the messages to the same handler
+type msgRouter struct {
+	handler baseapp.MsgServiceHandler
+}
+
+func (r msgRouter) Handler(_ sdk.Msg) baseapp.MsgServiceHandler {
+	return r.handler
+}
+
+// authorityKeeperWithRouter returns an authority keeper using the store of k and executing the queued actions with the handler
+func authorityKeeperWithRouter(k *keeper.Keeper, handler baseapp.MsgServiceHandler) keeper.Keeper {
+	return keeper.NewKeeper(
+		k.GetCodec(),
+		k.GetStoreKey(),
+		k.GetMemKey(),
+		keepertest.AuthorityGovAddress,
+		msgRouter{handler: handler},
+	)
+}
+
+func TestKeeper_SetTimelockConfig(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+	config := types.DefaultTimelockConfig()
+
+	_, found := k.GetTimelockConfig(ctx)
+	require.False(t, found)
+	require.False(t, k.IsTimelocked(ctx, "/zetachain.zetacore.fungible.MsgUpdateSystemContract"))
+
+	k.SetTimelockConfig(ctx, config)
+
+	got, found := k.GetTimelockConfig(ctx)
+	require.True(t, found)
+	require.Equal(t, config, got)
+	require.True(t, k.IsTimelocked(ctx, "/zetachain.zetacore.fungible.MsgUpdateSystemContract"))
+	require.False(t, k.IsTimelocked(ctx, "/zetachain.zetacore.fungible.MsgDeploySystemContracts"))
+}
+
+func TestKeeper_SetQueuedAction(t *testing.T) {
+	t.Run("can set, get and remove queued actions", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		action := sample.QueuedAction(t, 5, 1000)
+
+		_, found := k.GetQueuedAction(ctx, 5)
+		require.False(t, found)
+
+		k.SetQueuedAction(ctx, action)
+		got, found := k.GetQueuedAction(ctx, 5)
+		require.True(t, found)
+		require.Equal(t, action.Signer, got.Signer)
+		require.Equal(t, action.ExecutionHeight, got.ExecutionHeight)
+
+		// the message is unpacked when read from the store
+		msg, err := got.GetTimelockedMsg()
+		require.NoError(t, err)
+		require.Equal(t, "/zetachain.zetacore.fungible.MsgUpdateSystemContract", sdk.MsgTypeURL(msg))
+		require.Len(t, k.GetAllQueuedActions(ctx), 1)
+
+		k.RemoveQueuedAction(ctx, got)
+		_, found = k.GetQueuedAction(ctx, 5)
+		require.False(t, found)
+		require.Empty(t, k.GetAllQueuedActions(ctx))
+	})
+
+	t.Run("queued actions get increasing ids", func(t *testing.T) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		signer := sample.AccAddress()
+		msg := fungibletypes.NewMsgUpdateSystemContract(signer, sample.EthAddress().Hex())
+
+		action, err := k.QueueAction(ctx, signer, msg, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, action.Id)
+		require.EqualValues(t, 100, action.SubmitHeight)
+		require.EqualValues(t, 110, action.ExecutionHeight)
+
+		action, err = k.QueueAction(ctx, signer, msg, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, action.Id)
+
+		// the ids set from genesis are not reused
+		k.SetQueuedAction(ctx, sample.QueuedAction(t, 10, 1000))
+		action, err = k.QueueAction(ctx, signer, msg, 10)
+		require.NoError(t, err)
+		require.EqualValues(t, 11, action.Id)
+	})
+}
+
+func TestKeeper_IsAuthorizedForMsg_Timelock(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+	policies := sample.Policies()
+	k.SetPolicies(ctx, policies)
+	k.SetAuthorizationList(ctx, types.DefaultAuthorizationList())
+	admin := policies.Items[1].Address
+	msg := fungibletypes.NewMsgUpdateSystemContract(admin, sample.EthAddress().Hex())
+
+	require.True(t, k.IsAuthorizedForMsg(ctx, admin, msg, 0))
+
+	// the message can't be executed directly once timelocked
+	k.SetTimelockConfig(ctx, types.DefaultTimelockConfig())
+	require.False(t, k.IsAuthorizedForMsg(ctx, admin, msg, 0))
+}
+
+func TestKeeper_ExecuteQueuedActions(t *testing.T) {
+	setup := func(t *testing.T) (*keeper.Keeper, sdk.Context, string) {
+		k, ctx := keepertest.AuthorityKeeper(t)
+		policies := sample.Policies()
+		k.SetPolicies(ctx, policies)
+		k.SetAuthorizationList(ctx, types.DefaultAuthorizationList())
+		k.SetTimelockConfig(ctx, types.DefaultTimelockConfig())
+		return k, ctx, policies.Items[1].Address
+	}
+
+	queue := func(t *testing.T, k keeper.Keeper, ctx sdk.Context, signer string, delay int64) types.QueuedAction {
+		msg := fungibletypes.NewMsgUpdateSystemContract(signer, sample.EthAddress().Hex())
+		action, err := k.QueueAction(ctx, signer, msg, delay)
+		require.NoError(t, err)
+		return action
+	}
+
+	t.Run("execute the actions once their execution height is reached", func(t *testing.T) {
+		k, ctx, admin := setup(t)
+		var executed []string
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			// the timelocked message is authorized when executed from the timelock
+			require.True(t, k.IsAuthorizedForMsg(ctx, admin, msg, 0))
+			executed = append(executed, msg.(*fungibletypes.MsgUpdateSystemContract).NewSystemContractAddress)
+			return &sdk.Result{}, nil
+		})
+
+		ctx = ctx.WithBlockHeight(100)
+		first := queue(t, kr, ctx, admin, 10)
+		second := queue(t, kr, ctx, admin, 20)
+
+		require.Equal(t, 0, kr.ExecuteQueuedActions(ctx.WithBlockHeight(109)))
+		require.Equal(t, 1, kr.ExecuteQueuedActions(ctx.WithBlockHeight(110)))
+		require.Len(t, executed, 1)
+		_, found := kr.GetQueuedAction(ctx, first.Id)
+		require.False(t, found)
+
+		// actions passed their execution height are executed
+		require.Equal(t, 1, kr.ExecuteQueuedActions(ctx.WithBlockHeight(125)))
+		require.Len(t, executed, 2)
+		_, found = kr.GetQueuedAction(ctx, second.Id)
+		require.False(t, found)
+
+		// the message is not authorized outside the timelock execution
+		require.False(t, k.IsAuthorizedForMsg(ctx, admin, &fungibletypes.MsgUpdateSystemContract{}, 0))
+	})
+
+	t.Run("state changes are reverted and action removed if execution fails", func(t *testing.T) {
+		k, ctx, admin := setup(t)
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			k.SetAuthorizationList(ctx, types.AuthorizationList{})
+			return nil, errors.New("execution failed")
+		})
+
+		ctx = ctx.WithBlockHeight(100)
+		action := queue(t, kr, ctx, admin, 10)
+
+		require.Equal(t, 1, kr.ExecuteQueuedActions(ctx.WithBlockHeight(110)))
+		_, found := kr.GetQueuedAction(ctx, action.Id)
+		require.False(t, found)
+
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Equal(t, types.DefaultAuthorizationList(), list)
+	})
+
+	t.Run("state changes are reverted and action removed if execution panics", func(t *testing.T) {
+		k, ctx, admin := setup(t)
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			k.SetAuthorizationList(ctx, types.AuthorizationList{})
+			panic("execution panicked")
+		})
+
+		ctx = ctx.WithBlockHeight(100)
+		action := queue(t, kr, ctx, admin, 10)
+
+		require.NotPanics(t, func() {
+			require.Equal(t, 1, kr.ExecuteQueuedActions(ctx.WithBlockHeight(110)))
+		})
+		_, found := kr.GetQueuedAction(ctx, action.Id)
+		require.False(t, found)
+
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Equal(t, types.DefaultAuthorizationList(), list)
+	})
+
+	t.Run("state changes are committed if execution succeeds", func(t *testing.T) {
+		k, ctx, admin := setup(t)
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			k.SetAuthorizationList(ctx, types.AuthorizationList{})
+			return &sdk.Result{}, nil
+		})
+
+		ctx = ctx.WithBlockHeight(100)
+		queue(t, kr, ctx, admin, 10)
+
+		require.Equal(t, 1, kr.ExecuteQueuedActions(ctx.WithBlockHeight(110)))
+		list, found := k.GetAuthorizationList(ctx)
+		require.True(t, found)
+		require.Empty(t, list.Authorizations)
+	})
+
+	t.Run("execute at most the maximum number of actions per block", func(t *testing.T) {
+		k, ctx, admin := setup(t)
+		kr := authorityKeeperWithRouter(k, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		})
+
+		ctx = ctx.WithBlockHeight(100)
+		for i := 0; i < keeper.MaxQueuedActionsExecutedPerBlock+5; i++ {
+			queue(t, kr, ctx, admin, 10)
+		}
+
+		require.Equal(t, keeper.MaxQueuedActionsExecutedPerBlock, kr.ExecuteQueuedActions(ctx.WithBlockHeight(110)))
+		require.Equal(t, 5, kr.ExecuteQueuedActions(ctx.WithBlockHeight(111)))
+		require.Empty(t, kr.GetAllQueuedActions(ctx))
+	})
+}
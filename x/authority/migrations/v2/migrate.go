@@ -0,0 +1,28 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// authorityKeeper prevents circular dependency
+type authorityKeeper interface {
+	SetAuthorizationList(ctx sdk.Context, list types.AuthorizationList)
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2
+func MigrateStore(ctx sdk.Context, authorityKeeper authorityKeeper) error {
+	ctx.Logger().Info("Migrating authority store from v1 to v2")
+	return MigrateAuthorizationList(ctx, authorityKeeper)
+}
+
+// MigrateAuthorizationList sets the default authorization list, the messages remain authorized for the policies
+// they required before the authorizations were introduced
+func MigrateAuthorizationList(ctx sdk.Context, authorityKeeper authorityKeeper) error {
+	list := types.DefaultAuthorizationList()
+	if err := list.Validate(); err != nil {
+		return err
+	}
+	authorityKeeper.SetAuthorizationList(ctx, list)
+	return nil
+}
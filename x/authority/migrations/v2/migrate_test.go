@@ -0,0 +1,21 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v2 "github.com/zeta-chain/zetacore/x/authority/migrations/v2"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+
+	err := v2.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	list, found := k.GetAuthorizationList(ctx)
+	require.True(t, found)
+	require.Equal(t, types.DefaultAuthorizationList(), list)
+}
@@ -0,0 +1,28 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+// authorityKeeper prevents circular dependency
+type authorityKeeper interface {
+	SetTimelockConfig(ctx sdk.Context, config types.TimelockConfig)
+}
+
+// MigrateStore performs in-place store migrations from v2 to v3
+func MigrateStore(ctx sdk.Context, authorityKeeper authorityKeeper) error {
+	ctx.Logger().Info("Migrating authority store from v2 to v3")
+	return MigrateTimelockConfig(ctx, authorityKeeper)
+}
+
+// MigrateTimelockConfig sets the default timelock config, the admin messages changing the contracts or the funds
+// integrators rely on are queued in the timelock before their execution
+func MigrateTimelockConfig(ctx sdk.Context, authorityKeeper authorityKeeper) error {
+	config := types.DefaultTimelockConfig()
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	authorityKeeper.SetTimelockConfig(ctx, config)
+	return nil
+}
@@ -0,0 +1,22 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v3 "github.com/zeta-chain/zetacore/x/authority/migrations/v3"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx := keepertest.AuthorityKeeper(t)
+
+	err := v3.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	config, found := k.GetTimelockConfig(ctx)
+	require.True(t, found)
+	require.Equal(t, types.DefaultTimelockConfig(), config)
+	require.True(t, k.IsTimelocked(ctx, "/zetachain.zetacore.fungible.MsgUpdateSystemContract"))
+}
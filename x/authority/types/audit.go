@@ -0,0 +1,65 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// NewAuditEntry returns a new audit entry for a message whose signer passed an authority check
+// the policy type is set to address if the signer is authorized by an authorization of its address
+func NewAuditEntry(
+	id uint64,
+	policyType PolicyType,
+	authorizedByAddress bool,
+	signer string,
+	msg sdk.Msg,
+	blockHeight int64,
+) (AuditEntry, error) {
+	msgHash, err := MsgHash(msg)
+	if err != nil {
+		return AuditEntry{}, err
+	}
+	if authorizedByAddress {
+		policyType = PolicyType_address
+	}
+	return AuditEntry{
+		Id:                  id,
+		PolicyType:          policyType,
+		AuthorizedByAddress: authorizedByAddress,
+		Signer:              signer,
+		MsgTypeUrl:          sdk.MsgTypeURL(msg),
+		MsgHash:             msgHash,
+		BlockHeight:         blockHeight,
+	}, nil
+}
+
+// MsgHash returns the hex encoded sha256 hash of the protobuf encoding of the message
+func MsgHash(msg sdk.Msg) (string, error) {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(b)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// Validate performs basic validation of the audit entry
+func (e AuditEntry) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(e.Signer); err != nil {
+		return fmt.Errorf("invalid signer address for audit entry %d: %s", e.Id, err)
+	}
+	if len(e.MsgTypeUrl) == 0 || e.MsgTypeUrl[0] != '/' {
+		return fmt.Errorf("invalid message url for audit entry %d: %s", e.Id, e.MsgTypeUrl)
+	}
+	if hash, err := hex.DecodeString(e.MsgHash); err != nil || len(hash) != sha256.Size {
+		return fmt.Errorf("invalid message hash for audit entry %d: %s", e.Id, e.MsgHash)
+	}
+	if e.BlockHeight < 0 {
+		return fmt.Errorf("invalid block height for audit entry %d: %d", e.Id, e.BlockHeight)
+	}
+	return nil
+}
@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: authority/audit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuditEntry records a message whose signer passed an authority check
+type AuditEntry struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// policy that authorized the signer, address if the signer is authorized by an authorization of its address
+	PolicyType PolicyType `protobuf:"varint,2,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.authority.PolicyType" json:"policy_type,omitempty"`
+	// true if the signer is authorized by an authorization of its address rather than by a policy
+	AuthorizedByAddress bool   `protobuf:"varint,3,opt,name=authorized_by_address,json=authorizedByAddress,proto3" json:"authorized_by_address,omitempty"`
+	Signer              string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+	// type URL of the message, e.g. /zetachain.zetacore.fungible.MsgUpdateZRC20WithdrawFee
+	MsgTypeUrl string `protobuf:"bytes,5,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// hex encoded sha256 hash of the protobuf encoding of the message
+	MsgHash     string `protobuf:"bytes,6,opt,name=msg_hash,json=msgHash,proto3" json:"msg_hash,omitempty"`
+	BlockHeight int64  `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9da2e4788bfd8bb, []int{0}
+}
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetPolicyType() PolicyType {
+	if m != nil {
+		return m.PolicyType
+	}
+	return PolicyType_groupEmergency
+}
+
+func (m *AuditEntry) GetAuthorizedByAddress() bool {
+	if m != nil {
+		return m.AuthorizedByAddress
+	}
+	return false
+}
+
+func (m *AuditEntry) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetMsgHash() string {
+	if m != nil {
+		return m.MsgHash
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AuditEntry)(nil), "zetachain.zetacore.authority.AuditEntry")
+}
+
+func init() { proto.RegisterFile("authority/audit.proto", fileDescriptor_f9da2e4788bfd8bb) }
+
+var fileDescriptor_f9da2e4788bfd8bb = []byte{
+	// 324 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xeb, 0xb4, 0x7f, 0xdb, 0xdf, 0xad, 0x3a, 0x18, 0x81, 0x0c, 0x42, 0x51, 0x60, 0xca,
+	0x42, 0x82, 0xca, 0x13, 0xb4, 0x12, 0x52, 0x61, 0x42, 0x11, 0x2c, 0x2c, 0x91, 0x93, 0x58, 0xb6,
+	0x45, 0x52, 0x47, 0xb6, 0x23, 0xe1, 0x3e, 0x05, 0x12, 0x2f, 0xc5, 0xd8, 0x91, 0x11, 0xb5, 0x2f,
+	0x82, 0xe2, 0x42, 0xbb, 0xb1, 0x5d, 0xdf, 0x73, 0x8f, 0xef, 0xb9, 0x1f, 0x3c, 0x26, 0x8d, 0xe1,
+	0x52, 0x09, 0x63, 0x63, 0xd2, 0x14, 0xc2, 0x44, 0xb5, 0x92, 0x46, 0xa2, 0xf3, 0x15, 0x35, 0x24,
+	0xe7, 0x44, 0x2c, 0x23, 0x57, 0x49, 0x45, 0xa3, 0xfd, 0xe4, 0x19, 0x3e, 0x98, 0x6a, 0x59, 0x8a,
+	0x5c, 0x50, 0xbd, 0xf3, 0x5d, 0xbe, 0x7b, 0x10, 0xce, 0xda, 0x7f, 0x6e, 0x97, 0x46, 0x59, 0x34,
+	0x81, 0x9e, 0x28, 0x30, 0x08, 0x40, 0xd8, 0x4b, 0x3c, 0x51, 0xa0, 0x3b, 0x38, 0x72, 0x06, 0x9b,
+	0x1a, 0x5b, 0x53, 0xec, 0x05, 0x20, 0x9c, 0x4c, 0xc3, 0xe8, 0xaf, 0x65, 0xd1, 0x83, 0x33, 0x3c,
+	0xda, 0x9a, 0x26, 0xb0, 0xde, 0xd7, 0x68, 0xba, 0x8f, 0xbe, 0xa2, 0x45, 0x9a, 0xd9, 0x94, 0x14,
+	0x85, 0xa2, 0x5a, 0xe3, 0x6e, 0x00, 0xc2, 0x61, 0x72, 0x74, 0x10, 0xe7, 0x76, 0xb6, 0x93, 0xd0,
+	0x09, 0xec, 0x6b, 0xc1, 0x96, 0x54, 0xe1, 0x5e, 0x00, 0xc2, 0xff, 0xc9, 0xcf, 0x0b, 0x05, 0x70,
+	0x5c, 0x69, 0xe6, 0x32, 0xa5, 0x8d, 0x2a, 0xf1, 0x3f, 0xa7, 0xc2, 0x4a, 0xb3, 0x76, 0xd5, 0x93,
+	0x2a, 0xd1, 0x29, 0x1c, 0xb6, 0x13, 0x9c, 0x68, 0x8e, 0xfb, 0x4e, 0x1d, 0x54, 0x9a, 0x2d, 0x88,
+	0xe6, 0xe8, 0x02, 0x8e, 0xb3, 0x52, 0xe6, 0x2f, 0x29, 0xa7, 0x82, 0x71, 0x83, 0x07, 0x01, 0x08,
+	0xbb, 0xc9, 0xc8, 0xf5, 0x16, 0xae, 0x35, 0xbf, 0xff, 0xd8, 0xf8, 0x60, 0xbd, 0xf1, 0xc1, 0xd7,
+	0xc6, 0x07, 0x6f, 0x5b, 0xbf, 0xb3, 0xde, 0xfa, 0x9d, 0xcf, 0xad, 0xdf, 0x79, 0xbe, 0x66, 0xc2,
+	0xf0, 0x26, 0x8b, 0x72, 0x59, 0xc5, 0xed, 0xed, 0x57, 0x0e, 0x43, 0xfc, 0x8b, 0x21, 0x7e, 0x8d,
+	0x0f, 0xa8, 0xdb, 0x78, 0x3a, 0xeb, 0x3b, 0xd0, 0x37, 0xdf, 0x03, 0x00, 0x86, 0xd4, 0x87, 0xd8,
+	0xb9, 0x01, 0x00, 0x00,
+}
+
+func (m *AuditEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MsgHash) > 0 {
+		i -= len(m.MsgHash)
+		copy(dAtA[i:], m.MsgHash)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.MsgHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintAudit(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AuthorizedByAddress {
+		i--
+		if m.AuthorizedByAddress {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PolicyType != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.PolicyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintAudit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAudit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAudit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAudit(uint64(m.Id))
+	}
+	if m.PolicyType != 0 {
+		n += 1 + sovAudit(uint64(m.PolicyType))
+	}
+	if m.AuthorizedByAddress {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	l = len(m.MsgHash)
+	if l > 0 {
+		n += 1 + l + sovAudit(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAudit(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovAudit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAudit(x uint64) (n int) {
+	return sovAudit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuditEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			m.PolicyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyType |= PolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedByAddress", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AuthorizedByAddress = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAudit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAudit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAudit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAudit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAudit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAudit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAudit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAudit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAudit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAudit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAudit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAudit = fmt.Errorf("proto: unexpected end of group")
+)
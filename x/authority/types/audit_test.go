@@ -0,0 +1,125 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	signer := sample.AccAddress()
+	msg := types.NewMsgCancelTimelockedAction(signer, 42)
+
+	t.Run("policy type is set if authorized by a policy", func(t *testing.T) {
+		entry, err := types.NewAuditEntry(1, types.PolicyType_groupAdmin, false, signer, msg, 100)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, entry.Id)
+		require.Equal(t, types.PolicyType_groupAdmin, entry.PolicyType)
+		require.False(t, entry.AuthorizedByAddress)
+		require.Equal(t, signer, entry.Signer)
+		require.Equal(t, "/zetachain.zetacore.authority.MsgCancelTimelockedAction", entry.MsgTypeUrl)
+		require.EqualValues(t, 100, entry.BlockHeight)
+		require.NoError(t, entry.Validate())
+
+		hash, err := types.MsgHash(msg)
+		require.NoError(t, err)
+		require.Equal(t, hash, entry.MsgHash)
+	})
+
+	t.Run("policy type is address if authorized by address", func(t *testing.T) {
+		for _, policyType := range []types.PolicyType{types.PolicyType_groupEmergency, types.PolicyType_groupAdmin} {
+			entry, err := types.NewAuditEntry(1, policyType, true, signer, msg, 100)
+			require.NoError(t, err)
+			require.Equal(t, types.PolicyType_address, entry.PolicyType)
+			require.True(t, entry.AuthorizedByAddress)
+		}
+	})
+}
+
+func TestMsgHash(t *testing.T) {
+	signer := sample.AccAddress()
+
+	hash, err := types.MsgHash(types.NewMsgCancelTimelockedAction(signer, 42))
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	same, err := types.MsgHash(types.NewMsgCancelTimelockedAction(signer, 42))
+	require.NoError(t, err)
+	require.Equal(t, hash, same)
+
+	other, err := types.MsgHash(types.NewMsgCancelTimelockedAction(signer, 43))
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other)
+}
+
+func TestAuditEntry_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		entry       func(types.AuditEntry) types.AuditEntry
+		errContains string
+	}{
+		{
+			name:  "valid entry",
+			entry: func(e types.AuditEntry) types.AuditEntry { return e },
+		},
+		{
+			name: "invalid signer",
+			entry: func(e types.AuditEntry) types.AuditEntry {
+				e.Signer = "invalid"
+				return e
+			},
+			errContains: "invalid signer address",
+		},
+		{
+			name: "invalid message url",
+			entry: func(e types.AuditEntry) types.AuditEntry {
+				e.MsgTypeUrl = "zetachain.zetacore.authority.MsgCancelTimelockedAction"
+				return e
+			},
+			errContains: "invalid message url",
+		},
+		{
+			name: "invalid message hash",
+			entry: func(e types.AuditEntry) types.AuditEntry {
+				e.MsgHash = "abcd"
+				return e
+			},
+			errContains: "invalid message hash",
+		},
+		{
+			name: "invalid block height",
+			entry: func(e types.AuditEntry) types.AuditEntry {
+				e.BlockHeight = -1
+				return e
+			},
+			errContains: "invalid block height",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signer := sample.AccAddress()
+			entry := tt.entry(sample.AuditEntry(t, 1, signer, types.NewMsgCancelTimelockedAction(signer, 1)))
+			err := entry.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseAuditEntryIndexKey(t *testing.T) {
+	key := types.AuditEntryIndexKey("field", 42)
+	fieldKey := types.AuditEntryIndexFieldKey("field")
+	require.Equal(t, fieldKey, key[:len(fieldKey)])
+
+	id, err := types.ParseAuditEntryIndexKey(key[len(fieldKey):])
+	require.NoError(t, err)
+	require.EqualValues(t, 42, id)
+
+	_, err = types.ParseAuditEntryIndexKey([]byte("invalid"))
+	require.Error(t, err)
+}
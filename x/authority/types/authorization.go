@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// defaultMsgPolicyTypes contains the policy authorized by default to execute each message requiring an authority
+// the messages whose required policy depends on their content check the policy directly and are not listed
+var defaultMsgPolicyTypes = []struct {
+	msgURL     string
+	policyType PolicyType
+}{
+	// crosschain
+	{"/zetachain.zetacore.crosschain.MsgAddToOutTxTracker", PolicyType_groupEmergency},
+	{"/zetachain.zetacore.crosschain.MsgAddToInTxTracker", PolicyType_groupEmergency},
+	{"/zetachain.zetacore.crosschain.MsgRemoveFromOutTxTracker", PolicyType_groupEmergency},
+	{"/zetachain.zetacore.crosschain.MsgWhitelistERC20", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.crosschain.MsgUpdateTssAddress", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.crosschain.MsgMigrateTssFunds", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.crosschain.MsgAbortStuckCCTX", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.crosschain.MsgRefundAbortedCCTX", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.crosschain.MsgExtendCctxDeadline", PolicyType_groupEmergency},
+	{"/zetachain.zetacore.crosschain.MsgUpdateRateLimit", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.crosschain.MsgRemoveRateLimit", PolicyType_groupAdmin},
+
+	// emissions
+	{"/zetachain.zetacore.emissions.MsgUpdateParams", PolicyType_groupAdmin},
+
+	// fungible
+	{"/zetachain.zetacore.fungible.MsgDeploySystemContracts", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.fungible.MsgDeployFungibleCoinZRC20", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.fungible.MsgRemoveForeignCoin", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.fungible.MsgUpdateSystemContract", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.fungible.MsgUpdateContractBytecode", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.fungible.MsgUpdateZRC20WithdrawFee", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.fungible.MsgUpdateZRC20LiquidityCap", PolicyType_groupAdmin},
+
+	// observer
+	{"/zetachain.zetacore.observer.MsgAddObserver", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.observer.MsgUpdateObserver", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.observer.MsgUpdateChainParams", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.observer.MsgRemoveChainParams", PolicyType_groupAdmin},
+	{"/zetachain.zetacore.observer.MsgUpdateKeygen", PolicyType_groupEmergency},
+	{"/zetachain.zetacore.observer.MsgBootstrapEthereumLightClient", PolicyType_groupAdmin},
+}
+
+// DefaultAuthorizationList returns the default authorizations, each message requiring an authority is authorized
+// for the policy that was required to execute it before the authorizations were introduced
+func DefaultAuthorizationList() AuthorizationList {
+	authorizations := make([]Authorization, len(defaultMsgPolicyTypes))
+	for i, msgPolicyType := range defaultMsgPolicyTypes {
+		authorizations[i] = Authorization{
+			MsgUrl:     msgPolicyType.msgURL,
+			PolicyType: msgPolicyType.policyType,
+		}
+	}
+	return AuthorizationList{Authorizations: authorizations}
+}
+
+// Validate performs basic validation of an authorization
+func (a Authorization) Validate() error {
+	if !strings.HasPrefix(a.MsgUrl, "/") {
+		return fmt.Errorf("invalid message url: %s", a.MsgUrl)
+	}
+	if a.ChainId < 0 {
+		return fmt.Errorf("invalid chain id: %d", a.ChainId)
+	}
+	if a.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(a.Address); err != nil {
+			return fmt.Errorf("invalid address: %s", err)
+		}
+		return nil
+	}
+	if a.PolicyType != PolicyType_groupEmergency && a.PolicyType != PolicyType_groupAdmin {
+		return fmt.Errorf("invalid policy type: %s", a.PolicyType)
+	}
+	return nil
+}
+
+// IsSame returns true if the authorizations authorize the same address or policy to execute the same message on the same chain
+// the policy type is not compared if an address is set
+func (a Authorization) IsSame(other Authorization) bool {
+	if a.MsgUrl != other.MsgUrl || a.ChainId != other.ChainId || a.Address != other.Address {
+		return false
+	}
+	return a.Address != "" || a.PolicyType == other.PolicyType
+}
+
+// Validate performs basic validation of the authorizations and ensures there are no duplicate authorizations
+func (l AuthorizationList) Validate() error {
+	for i, authorization := range l.Authorizations {
+		if err := authorization.Validate(); err != nil {
+			return err
+		}
+		for _, other := range l.Authorizations[:i] {
+			if authorization.IsSame(other) {
+				return fmt.Errorf("duplicate authorization for message %s", authorization.MsgUrl)
+			}
+		}
+	}
+	return nil
+}
+
+// Find returns the index of an authorization in the list, -1 is returned if the authorization is not found
+func (l AuthorizationList) Find(authorization Authorization) int {
+	for i, other := range l.Authorizations {
+		if authorization.IsSame(other) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,636 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: authority/authorization.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Authorization authorizes an address to execute a message
+// the authorized address is the address of the policy if no address is set
+// the authorization can be scoped to a chain for the messages targeting a chain
+type Authorization struct {
+	// type URL of the message, e.g. /zetachain.zetacore.fungible.MsgUpdateZRC20WithdrawFee
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+	// chain the authorization is scoped to, 0 for all chains
+	ChainId int64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// policy authorized to execute the message if no address is set
+	PolicyType PolicyType `protobuf:"varint,3,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.authority.PolicyType" json:"policy_type,omitempty"`
+	// address authorized to execute the message
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *Authorization) Reset()         { *m = Authorization{} }
+func (m *Authorization) String() string { return proto.CompactTextString(m) }
+func (*Authorization) ProtoMessage()    {}
+func (*Authorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db88f077e676a050, []int{0}
+}
+func (m *Authorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Authorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Authorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Authorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authorization.Merge(m, src)
+}
+func (m *Authorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *Authorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Authorization proto.InternalMessageInfo
+
+func (m *Authorization) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+func (m *Authorization) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *Authorization) GetPolicyType() PolicyType {
+	if m != nil {
+		return m.PolicyType
+	}
+	return PolicyType_groupEmergency
+}
+
+func (m *Authorization) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// AuthorizationList contains the authorizations of the messages requiring an authority
+type AuthorizationList struct {
+	Authorizations []Authorization `protobuf:"bytes,1,rep,name=authorizations,proto3" json:"authorizations"`
+}
+
+func (m *AuthorizationList) Reset()         { *m = AuthorizationList{} }
+func (m *AuthorizationList) String() string { return proto.CompactTextString(m) }
+func (*AuthorizationList) ProtoMessage()    {}
+func (*AuthorizationList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db88f077e676a050, []int{1}
+}
+func (m *AuthorizationList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizationList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizationList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizationList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizationList.Merge(m, src)
+}
+func (m *AuthorizationList) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizationList) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizationList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizationList proto.InternalMessageInfo
+
+func (m *AuthorizationList) GetAuthorizations() []Authorization {
+	if m != nil {
+		return m.Authorizations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Authorization)(nil), "zetachain.zetacore.authority.Authorization")
+	proto.RegisterType((*AuthorizationList)(nil), "zetachain.zetacore.authority.AuthorizationList")
+}
+
+func init() { proto.RegisterFile("authority/authorization.proto", fileDescriptor_db88f077e676a050) }
+
+var fileDescriptor_db88f077e676a050 = []byte{
+	// 305 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xfb, 0x30,
+	0x1c, 0xc7, 0x9b, 0xff, 0xc6, 0xf6, 0x37, 0xc3, 0x81, 0x41, 0x30, 0x0e, 0x8d, 0x65, 0xa7, 0x82,
+	0x98, 0xc8, 0x7c, 0x02, 0x77, 0x9b, 0x78, 0x90, 0xa2, 0x07, 0xbd, 0x8c, 0x6e, 0x0d, 0x5d, 0x60,
+	0x6d, 0x4a, 0x92, 0x82, 0xdd, 0x53, 0xf8, 0x18, 0x3e, 0xca, 0x8e, 0x3b, 0x7a, 0x12, 0x69, 0x5f,
+	0x44, 0x9a, 0xb9, 0xcd, 0x7a, 0xd8, 0xed, 0xf3, 0x0b, 0x7c, 0x7f, 0x9f, 0x1f, 0xdf, 0xc0, 0xf3,
+	0x20, 0x33, 0x33, 0xa9, 0x84, 0xc9, 0xd9, 0x0f, 0x2d, 0x02, 0x23, 0x64, 0x42, 0x53, 0x25, 0x8d,
+	0x44, 0x67, 0x0b, 0x6e, 0x82, 0xe9, 0x2c, 0x10, 0x09, 0xb5, 0x24, 0x15, 0xa7, 0xdb, 0x44, 0x0f,
+	0xef, 0xc2, 0xa9, 0x9c, 0x8b, 0xa9, 0xe0, 0x7a, 0x9d, 0xeb, 0x1d, 0x47, 0x32, 0x92, 0x16, 0x59,
+	0x45, 0xeb, 0xd7, 0xfe, 0x3b, 0x80, 0x87, 0xb7, 0xbf, 0x2d, 0xe8, 0x04, 0xb6, 0x63, 0x1d, 0x8d,
+	0x33, 0x35, 0xc7, 0xc0, 0x05, 0xde, 0x81, 0xdf, 0x8a, 0x75, 0xf4, 0xa4, 0xe6, 0xe8, 0x14, 0xfe,
+	0xb7, 0xda, 0xb1, 0x08, 0xf1, 0x3f, 0x17, 0x78, 0x0d, 0xbf, 0x6d, 0xe7, 0x51, 0x88, 0x46, 0xb0,
+	0x63, 0x6d, 0xf9, 0xd8, 0xe4, 0x29, 0xc7, 0x0d, 0x17, 0x78, 0xdd, 0x81, 0x47, 0xf7, 0x5d, 0x4a,
+	0x1f, 0x6c, 0xe0, 0x31, 0x4f, 0xb9, 0x0f, 0xd3, 0x2d, 0x23, 0x0c, 0xdb, 0x41, 0x18, 0x2a, 0xae,
+	0x35, 0x6e, 0x5a, 0xfd, 0x66, 0xec, 0x27, 0xf0, 0xa8, 0x76, 0xe9, 0xbd, 0xd0, 0x06, 0x3d, 0xc3,
+	0x6e, 0xad, 0x24, 0x8d, 0x81, 0xdb, 0xf0, 0x3a, 0x83, 0xcb, 0xfd, 0xf2, 0xda, 0xa2, 0x61, 0x73,
+	0xf9, 0x79, 0xe1, 0xf8, 0x7f, 0x16, 0x0d, 0xef, 0x96, 0x05, 0x01, 0xab, 0x82, 0x80, 0xaf, 0x82,
+	0x80, 0xb7, 0x92, 0x38, 0xab, 0x92, 0x38, 0x1f, 0x25, 0x71, 0x5e, 0xae, 0x23, 0x61, 0x66, 0xd9,
+	0x84, 0x4e, 0x65, 0xcc, 0xaa, 0xe5, 0x57, 0xd6, 0xc3, 0x36, 0x1e, 0xf6, 0xca, 0x76, 0xbf, 0x50,
+	0x15, 0xa2, 0x27, 0x2d, 0xdb, 0xf6, 0xcd, 0xf7, 0x00, 0x99, 0x33, 0xc0, 0xef, 0xdc, 0x01, 0x00,
+	0x00,
+}
+
+func (m *Authorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorization(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PolicyType != 0 {
+		i = encodeVarintAuthorization(dAtA, i, uint64(m.PolicyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintAuthorization(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintAuthorization(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizationList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizationList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizationList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for iNdEx := len(m.Authorizations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authorizations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorization(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthorization(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthorization(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Authorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthorization(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovAuthorization(uint64(m.ChainId))
+	}
+	if m.PolicyType != 0 {
+		n += 1 + sovAuthorization(uint64(m.PolicyType))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorization(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizationList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authorizations) > 0 {
+		for _, e := range m.Authorizations {
+			l = e.Size()
+			n += 1 + l + sovAuthorization(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthorization(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthorization(x uint64) (n int) {
+	return sovAuthorization(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Authorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Authorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Authorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			m.PolicyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyType |= PolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizationList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorization
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizationList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizationList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorizations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorization
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authorizations = append(m.Authorizations, Authorization{})
+			if err := m.Authorizations[len(m.Authorizations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorization(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorization
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthorization(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthorization
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthorization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthorization
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthorization
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthorization
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthorization
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthorization        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthorization          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthorization = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,131 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestAuthorizationList_Validate(t *testing.T) {
+	const msgURL = "/zetachain.zetacore.observer.MsgUpdateChainParams"
+	address := sample.AccAddress()
+
+	tests := []struct {
+		name        string
+		list        types.AuthorizationList
+		errContains string
+	}{
+		{
+			name:        "empty is valid",
+			list:        types.AuthorizationList{},
+			errContains: "",
+		},
+		{
+			name:        "default is valid",
+			list:        types.DefaultAuthorizationList(),
+			errContains: "",
+		},
+		{
+			name:        "regular valid authorizations",
+			list:        sample.AuthorizationList(msgURL),
+			errContains: "",
+		},
+		{
+			name: "valid if the same address is authorized on different chains",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: msgURL, ChainId: 1, Address: address},
+					{MsgUrl: msgURL, ChainId: 2, Address: address},
+				},
+			},
+			errContains: "",
+		},
+		{
+			name: "invalid if message url is invalid",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: "MsgUpdateChainParams", PolicyType: types.PolicyType_groupAdmin},
+				},
+			},
+			errContains: "invalid message url",
+		},
+		{
+			name: "invalid if chain id is negative",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: msgURL, ChainId: -1, PolicyType: types.PolicyType_groupAdmin},
+				},
+			},
+			errContains: "invalid chain id",
+		},
+		{
+			name: "invalid if address is invalid",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: msgURL, Address: "invalid"},
+				},
+			},
+			errContains: "invalid address",
+		},
+		{
+			name: "invalid if policy type is invalid",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: msgURL, PolicyType: 1000},
+				},
+			},
+			errContains: "invalid policy type",
+		},
+		{
+			name: "invalid if policy type is address",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: msgURL, PolicyType: types.PolicyType_address},
+				},
+			},
+			errContains: "invalid policy type",
+		},
+		{
+			name: "invalid if duplicate policy authorization",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: msgURL, PolicyType: types.PolicyType_groupAdmin},
+					{MsgUrl: msgURL, PolicyType: types.PolicyType_groupAdmin},
+				},
+			},
+			errContains: "duplicate authorization",
+		},
+		{
+			name: "invalid if duplicate address authorization",
+			list: types.AuthorizationList{
+				Authorizations: []types.Authorization{
+					{MsgUrl: msgURL, Address: address, PolicyType: types.PolicyType_groupAdmin},
+					{MsgUrl: msgURL, Address: address},
+				},
+			},
+			errContains: "duplicate authorization",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.list.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuthorizationList_Find(t *testing.T) {
+	const msgURL = "/zetachain.zetacore.observer.MsgUpdateChainParams"
+	list := sample.AuthorizationList(msgURL)
+
+	require.Equal(t, 0, list.Find(types.Authorization{MsgUrl: msgURL, PolicyType: types.PolicyType_groupAdmin}))
+	require.Equal(t, 1, list.Find(types.Authorization{MsgUrl: msgURL, ChainId: 1, Address: list.Authorizations[1].Address}))
+	require.Equal(t, -1, list.Find(types.Authorization{MsgUrl: msgURL, PolicyType: types.PolicyType_groupEmergency}))
+	require.Equal(t, -1, list.Find(types.Authorization{MsgUrl: msgURL, Address: list.Authorizations[1].Address}))
+}
@@ -9,11 +9,25 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdatePolicies{}, "authority/UpdatePolicies", nil)
+	cdc.RegisterConcrete(&MsgAddAuthorization{}, "authority/AddAuthorization", nil)
+	cdc.RegisterConcrete(&MsgRemoveAuthorization{}, "authority/RemoveAuthorization", nil)
+	cdc.RegisterConcrete(&MsgUpdateTimelockConfig{}, "authority/UpdateTimelockConfig", nil)
+	cdc.RegisterConcrete(&MsgSubmitTimelockedAction{}, "authority/SubmitTimelockedAction", nil)
+	cdc.RegisterConcrete(&MsgCancelTimelockedAction{}, "authority/CancelTimelockedAction", nil)
+	cdc.RegisterConcrete(&MsgSubmitThresholdProposal{}, "authority/SubmitThresholdProposal", nil)
+	cdc.RegisterConcrete(&MsgApproveThresholdProposal{}, "authority/ApproveThresholdProposal", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdatePolicies{},
+		&MsgAddAuthorization{},
+		&MsgRemoveAuthorization{},
+		&MsgUpdateTimelockConfig{},
+		&MsgSubmitTimelockedAction{},
+		&MsgCancelTimelockedAction{},
+		&MsgSubmitThresholdProposal{},
+		&MsgApproveThresholdProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
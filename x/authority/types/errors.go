@@ -1 +1,16 @@
 package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrAuthorizationAlreadyExists = errorsmod.Register(ModuleName, 1101, "authorization already exists")
+	ErrAuthorizationNotFound      = errorsmod.Register(ModuleName, 1102, "authorization not found")
+	ErrMsgNotTimelocked           = errorsmod.Register(ModuleName, 1103, "message is not timelocked")
+	ErrQueuedActionNotFound       = errorsmod.Register(ModuleName, 1104, "queued action not found")
+	ErrInvalidTimelockedMsg       = errorsmod.Register(ModuleName, 1105, "invalid timelocked message")
+	ErrNotThresholdPolicy         = errorsmod.Register(ModuleName, 1106, "policy is not a threshold policy")
+	ErrInvalidThresholdProposal   = errorsmod.Register(ModuleName, 1107, "invalid threshold proposal")
+	ErrThresholdProposalNotFound  = errorsmod.Register(ModuleName, 1108, "threshold proposal not found")
+	ErrThresholdProposalExpired   = errorsmod.Register(ModuleName, 1109, "threshold proposal expired")
+	ErrAlreadyApproved            = errorsmod.Register(ModuleName, 1110, "threshold proposal already approved")
+)
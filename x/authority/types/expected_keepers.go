@@ -0,0 +1,11 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgRouter routes the messages to their handler, it is used to execute the actions queued in the timelock
+type MsgRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+}
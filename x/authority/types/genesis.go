@@ -1,13 +1,80 @@
 package types
 
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = GenesisState{}
+
 // DefaultGenesis returns the default authority genesis state
+// no message is timelocked by default, the networks enable the timelock by setting the timelocked messages
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Policies: DefaultPolicies(),
+		Policies:          DefaultPolicies(),
+		AuthorizationList: DefaultAuthorizationList(),
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any failure
 func (gs GenesisState) Validate() error {
-	return gs.Policies.Validate()
+	if err := gs.Policies.Validate(); err != nil {
+		return err
+	}
+	if err := gs.AuthorizationList.Validate(); err != nil {
+		return err
+	}
+	if err := gs.TimelockConfig.Validate(); err != nil {
+		return err
+	}
+
+	actionIDs := make(map[uint64]bool)
+	for _, action := range gs.QueuedActions {
+		if actionIDs[action.Id] {
+			return fmt.Errorf("duplicate queued action %d", action.Id)
+		}
+		actionIDs[action.Id] = true
+		if err := action.Validate(); err != nil {
+			return err
+		}
+	}
+
+	auditEntryIDs := make(map[uint64]bool)
+	for _, entry := range gs.AuditEntries {
+		if auditEntryIDs[entry.Id] {
+			return fmt.Errorf("duplicate audit entry %d", entry.Id)
+		}
+		auditEntryIDs[entry.Id] = true
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+	}
+
+	proposalIDs := make(map[uint64]bool)
+	for _, proposal := range gs.ThresholdProposals {
+		if proposalIDs[proposal.Id] {
+			return fmt.Errorf("duplicate threshold proposal %d", proposal.Id)
+		}
+		proposalIDs[proposal.Id] = true
+		if err := proposal.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, action := range gs.QueuedActions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	for _, proposal := range gs.ThresholdProposals {
+		if err := proposal.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
 }
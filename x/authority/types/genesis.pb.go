@@ -26,7 +26,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the authority module's genesis state.
 type GenesisState struct {
-	Policies Policies `protobuf:"bytes,1,opt,name=policies,proto3" json:"policies"`
+	Policies           Policies            `protobuf:"bytes,1,opt,name=policies,proto3" json:"policies"`
+	AuthorizationList  AuthorizationList   `protobuf:"bytes,2,opt,name=authorization_list,json=authorizationList,proto3" json:"authorization_list"`
+	TimelockConfig     TimelockConfig      `protobuf:"bytes,3,opt,name=timelock_config,json=timelockConfig,proto3" json:"timelock_config"`
+	QueuedActions      []QueuedAction      `protobuf:"bytes,4,rep,name=queued_actions,json=queuedActions,proto3" json:"queued_actions"`
+	AuditEntries       []AuditEntry        `protobuf:"bytes,5,rep,name=audit_entries,json=auditEntries,proto3" json:"audit_entries"`
+	ThresholdProposals []ThresholdProposal `protobuf:"bytes,6,rep,name=threshold_proposals,json=thresholdProposals,proto3" json:"threshold_proposals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -69,6 +74,41 @@ func (m *GenesisState) GetPolicies() Policies {
 	return Policies{}
 }
 
+func (m *GenesisState) GetAuthorizationList() AuthorizationList {
+	if m != nil {
+		return m.AuthorizationList
+	}
+	return AuthorizationList{}
+}
+
+func (m *GenesisState) GetTimelockConfig() TimelockConfig {
+	if m != nil {
+		return m.TimelockConfig
+	}
+	return TimelockConfig{}
+}
+
+func (m *GenesisState) GetQueuedActions() []QueuedAction {
+	if m != nil {
+		return m.QueuedActions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditEntries() []AuditEntry {
+	if m != nil {
+		return m.AuditEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetThresholdProposals() []ThresholdProposal {
+	if m != nil {
+		return m.ThresholdProposals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.authority.GenesisState")
 }
@@ -76,20 +116,33 @@ func init() {
 func init() { proto.RegisterFile("authority/genesis.proto", fileDescriptor_72622afc33ec94d4) }
 
 var fileDescriptor_72622afc33ec94d4 = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0x2c, 0x2d, 0xc9,
-	0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0x92, 0xa9, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x03,
-	0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0xe0, 0x6a, 0xa5, 0x24, 0x10, 0xda, 0x0a, 0xf2, 0x73, 0x32, 0x93,
-	0x33, 0x53, 0xa1, 0xfa, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22,
-	0xaa, 0x14, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x3e, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x83, 0x8b,
-	0x03, 0xa6, 0x4f, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x4d, 0x0f, 0x9f, 0x85, 0x7a, 0x01,
-	0x50, 0xd5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xc1, 0x75, 0x3b, 0x79, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x41, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xc8, 0x44, 0x5d, 0xb0, 0xe1, 0xfa, 0x30, 0xc3, 0xf5, 0x2b, 0xf4, 0x11,
-	0x9e, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xd6, 0x18, 0x10, 0x00, 0x00, 0xff,
-	0xff, 0x70, 0x61, 0x2d, 0x81, 0x15, 0x01, 0x00, 0x00,
+	// 412 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x13, 0x5b, 0x8b, 0x4c, 0xff, 0x88, 0xa3, 0x62, 0x2c, 0x1a, 0x8b, 0x17, 0x52, 0x44,
+	0x13, 0xa9, 0x4f, 0xd0, 0x8a, 0x28, 0xe2, 0x45, 0xb5, 0x05, 0x41, 0x2f, 0xc2, 0x34, 0x99, 0x26,
+	0x83, 0x69, 0x26, 0xcd, 0x9c, 0xc0, 0xb6, 0x4f, 0xb1, 0xaf, 0xb2, 0x6f, 0xd1, 0xcb, 0x5e, 0xee,
+	0xd5, 0xb2, 0xb4, 0x2f, 0xb2, 0x64, 0x3a, 0x69, 0xd3, 0x2e, 0xa4, 0x77, 0x87, 0xf3, 0x9d, 0xdf,
+	0xf7, 0x71, 0x0e, 0x07, 0xbd, 0x20, 0x29, 0x04, 0x3c, 0x61, 0xb0, 0xb0, 0x7d, 0x1a, 0x51, 0xc1,
+	0x84, 0x15, 0x27, 0x1c, 0x38, 0x7e, 0xb5, 0xa4, 0x40, 0xdc, 0x80, 0xb0, 0xc8, 0x92, 0x15, 0x4f,
+	0xa8, 0xb5, 0x9f, 0x6d, 0x3f, 0x3f, 0x60, 0x24, 0xf5, 0x18, 0xec, 0xa0, 0xf6, 0xeb, 0x62, 0x5b,
+	0x56, 0x4b, 0x02, 0x8c, 0x47, 0x4a, 0x36, 0x0e, 0x72, 0xcc, 0x43, 0xe6, 0x32, 0xaa, 0xd2, 0xda,
+	0x2f, 0x0f, 0x0a, 0x04, 0x09, 0x15, 0x01, 0x0f, 0xbd, 0xfb, 0x10, 0xb0, 0x19, 0x0d, 0xb9, 0xfb,
+	0x5f, 0x29, 0xcf, 0x7c, 0xee, 0x73, 0x59, 0xda, 0x59, 0xb5, 0xeb, 0xbe, 0xbd, 0xaa, 0xa2, 0xc6,
+	0xb7, 0xdd, 0x2a, 0x23, 0x20, 0x40, 0xf1, 0x77, 0xf4, 0x28, 0x4f, 0x33, 0xf4, 0x8e, 0xde, 0xad,
+	0xf7, 0xde, 0x59, 0x65, 0xcb, 0x59, 0x43, 0x35, 0x3d, 0xa8, 0xae, 0x6e, 0xde, 0x68, 0xbf, 0xf7,
+	0x34, 0xf6, 0x10, 0x3e, 0x5a, 0xcb, 0x09, 0x99, 0x00, 0xe3, 0x81, 0xf4, 0xb4, 0xcb, 0x3d, 0xfb,
+	0x45, 0xee, 0x27, 0x13, 0xa0, 0xcc, 0x9f, 0x90, 0x53, 0x01, 0xff, 0x43, 0x8f, 0xf3, 0x45, 0x1d,
+	0x97, 0x47, 0x53, 0xe6, 0x1b, 0x15, 0x19, 0xf1, 0xa1, 0x3c, 0x62, 0xac, 0xa0, 0x2f, 0x92, 0x51,
+	0xfe, 0x2d, 0x38, 0xea, 0xe2, 0x3f, 0xa8, 0x35, 0x4f, 0x69, 0x4a, 0x3d, 0x87, 0xb8, 0x59, 0xa2,
+	0x30, 0xaa, 0x9d, 0x4a, 0xb7, 0xde, 0x7b, 0x5f, 0xee, 0xfd, 0x4b, 0x32, 0x7d, 0x89, 0x28, 0xe7,
+	0xe6, 0xbc, 0xd0, 0x13, 0x78, 0x84, 0x9a, 0xf2, 0x13, 0x1c, 0x1a, 0x41, 0x92, 0x9d, 0xfa, 0xa1,
+	0xf4, 0xed, 0x9e, 0x3b, 0x8b, 0xc7, 0xe0, 0x6b, 0x04, 0xc9, 0x42, 0xb9, 0x36, 0x48, 0xde, 0xc9,
+	0x0e, 0x3e, 0x45, 0x4f, 0xf7, 0xef, 0xe0, 0xc4, 0x09, 0x8f, 0xb9, 0x20, 0xa1, 0x30, 0x6a, 0x9d,
+	0xca, 0xf9, 0x8b, 0x8f, 0x73, 0x70, 0xa8, 0x38, 0x95, 0x80, 0xe1, 0x54, 0x10, 0x83, 0x1f, 0xab,
+	0x8d, 0xa9, 0xaf, 0x37, 0xa6, 0x7e, 0xbb, 0x31, 0xf5, 0xcb, 0xad, 0xa9, 0xad, 0xb7, 0xa6, 0x76,
+	0xbd, 0x35, 0xb5, 0xbf, 0x9f, 0x7c, 0x06, 0x41, 0x3a, 0xb1, 0x5c, 0x3e, 0xb3, 0xb3, 0x90, 0x8f,
+	0x32, 0xcf, 0xce, 0xf3, 0xec, 0x0b, 0xbb, 0xf0, 0x9e, 0x8b, 0x98, 0x8a, 0x49, 0x4d, 0xbe, 0xe1,
+	0xe7, 0xbb, 0x01, 0x00, 0x90, 0x50, 0x29, 0xc1, 0x5a, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +165,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ThresholdProposals) > 0 {
+		for iNdEx := len(m.ThresholdProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ThresholdProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AuditEntries) > 0 {
+		for iNdEx := len(m.AuditEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.QueuedActions) > 0 {
+		for iNdEx := len(m.QueuedActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.TimelockConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.AuthorizationList.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +259,28 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Policies.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.AuthorizationList.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TimelockConfig.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.QueuedActions) > 0 {
+		for _, e := range m.QueuedActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditEntries) > 0 {
+		for _, e := range m.AuditEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ThresholdProposals) > 0 {
+		for _, e := range m.ThresholdProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +352,174 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorizationList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimelockConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedActions = append(m.QueuedActions, QueuedAction{})
+			if err := m.QueuedActions[len(m.QueuedActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEntries = append(m.AuditEntries, AuditEntry{})
+			if err := m.AuditEntries[len(m.AuditEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThresholdProposals = append(m.ThresholdProposals, ThresholdProposal{})
+			if err := m.ThresholdProposals[len(m.ThresholdProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -28,6 +28,108 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			errContains: "",
 		},
+		{
+			name: "invalid if authorization list is invalid",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				AuthorizationList: types.AuthorizationList{
+					Authorizations: []types.Authorization{
+						{
+							MsgUrl:  "/zetachain.zetacore.observer.MsgUpdateChainParams",
+							Address: "invalid",
+						},
+					},
+				},
+			},
+			errContains: "invalid address",
+		},
+		{
+			name: "valid genesis with timelock",
+			gs: &types.GenesisState{
+				Policies:       sample.Policies(),
+				TimelockConfig: types.DefaultTimelockConfig(),
+				QueuedActions: []types.QueuedAction{
+					sample.QueuedAction(t, 0, 1000),
+					sample.QueuedAction(t, 1, 1000),
+				},
+			},
+			errContains: "",
+		},
+		{
+			name: "invalid if timelock config is invalid",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				TimelockConfig: types.TimelockConfig{
+					Messages: []types.TimelockedMessage{
+						{MsgUrl: "/zetachain.zetacore.fungible.MsgUpdateSystemContract", DelayBlocks: 0},
+					},
+				},
+			},
+			errContains: "invalid delay",
+		},
+		{
+			name: "invalid if queued action is duplicated",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				QueuedActions: []types.QueuedAction{
+					sample.QueuedAction(t, 1, 1000),
+					sample.QueuedAction(t, 1, 1000),
+				},
+			},
+			errContains: "duplicate queued action 1",
+		},
+		{
+			name: "invalid if queued action is invalid",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				QueuedActions: []types.QueuedAction{
+					{Id: 1, Signer: sample.AccAddress()},
+				},
+			},
+			errContains: "missing message",
+		},
+		{
+			name: "invalid if audit entry is duplicated",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				AuditEntries: []types.AuditEntry{
+					sample.AuditEntry(t, 1, sample.AccAddress(), &types.MsgUpdateTimelockConfig{}),
+					sample.AuditEntry(t, 1, sample.AccAddress(), &types.MsgUpdateTimelockConfig{}),
+				},
+			},
+			errContains: "duplicate audit entry 1",
+		},
+		{
+			name: "invalid if audit entry is invalid",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				AuditEntries: []types.AuditEntry{
+					{Id: 1, Signer: "invalid"},
+				},
+			},
+			errContains: "invalid signer address for audit entry 1",
+		},
+		{
+			name: "invalid if threshold proposal is duplicated",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				ThresholdProposals: []types.ThresholdProposal{
+					sample.ThresholdProposal(t, 1, 1000),
+					sample.ThresholdProposal(t, 1, 1000),
+				},
+			},
+			errContains: "duplicate threshold proposal 1",
+		},
+		{
+			name: "invalid if threshold proposal is invalid",
+			gs: &types.GenesisState{
+				Policies: sample.Policies(),
+				ThresholdProposals: []types.ThresholdProposal{
+					{Id: 1, Proposer: sample.AccAddress()},
+				},
+			},
+			errContains: "invalid threshold proposal 1",
+		},
 		{
 			name: "invalid if policies is invalid",
 			gs: &types.GenesisState{
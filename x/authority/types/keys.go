@@ -1,5 +1,10 @@
 package types
 
+import (
+	"encoding/binary"
+	"errors"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "authority"
@@ -25,4 +30,117 @@ func KeyPrefix(p string) []byte {
 const (
 	// PoliciesKey is the key for the policies store
 	PoliciesKey = "Policies-value-"
+
+	// AuthorizationListKey is the key for the authorization list store
+	AuthorizationListKey = "AuthorizationList-value-"
+
+	// TimelockConfigKey is the key for the timelock config store
+	TimelockConfigKey = "TimelockConfig-value-"
+
+	// QueuedActionKey is the key prefix for the actions queued in the timelock
+	QueuedActionKey = "QueuedAction-value-"
+
+	// QueuedActionCounterKey is the key for the counter used to assign the queued action ids
+	QueuedActionCounterKey = "QueuedActionCounter-value-"
+
+	// QueuedActionExecutionQueueKeyPrefix is the key prefix for the queued actions ordered by execution height
+	QueuedActionExecutionQueueKeyPrefix = "QueuedActionExecutionQueue-value-"
+
+	// AuditEntryKey is the key prefix for the entries of the audit log
+	AuditEntryKey = "AuditEntry-value-"
+
+	// AuditEntryCounterKey is the key for the counter used to assign the audit entry ids
+	AuditEntryCounterKey = "AuditEntryCounter-value-"
+
+	// AuditEntrySignerIndexKeyPrefix is the key prefix to retrieve the audit entry ids by signer
+	AuditEntrySignerIndexKeyPrefix = "AuditEntrySignerIndex-value-"
+
+	// AuditEntryMsgURLIndexKeyPrefix is the key prefix to retrieve the audit entry ids by message type URL
+	AuditEntryMsgURLIndexKeyPrefix = "AuditEntryMsgURLIndex-value-"
+
+	// ThresholdProposalKey is the key prefix for the pending proposals of the threshold policies
+	ThresholdProposalKey = "ThresholdProposal-value-"
+
+	// ThresholdProposalCounterKey is the key for the counter used to assign the threshold proposal ids
+	ThresholdProposalCounterKey = "ThresholdProposalCounter-value-"
+
+	// ThresholdProposalExpiryQueueKeyPrefix is the key prefix for the threshold proposals ordered by expiry height
+	ThresholdProposalExpiryQueueKeyPrefix = "ThresholdProposalExpiryQueue-value-"
 )
+
+// QueuedActionIDKey returns the store key of a queued action
+func QueuedActionIDKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+
+	return key
+}
+
+// QueuedActionExecutionQueueKey returns the store key of a queued action in the execution queue
+// the height is big endian encoded so entries are ordered by execution height then by id
+func QueuedActionExecutionQueueKey(executionHeight uint64, id uint64) []byte {
+	return append(QueuedActionExecutionQueueHeightKey(executionHeight), QueuedActionIDKey(id)...)
+}
+
+// QueuedActionExecutionQueueHeightKey returns the store key prefix of the queued actions executed at a height
+func QueuedActionExecutionQueueHeightKey(executionHeight uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, executionHeight)
+
+	return key
+}
+
+// ThresholdProposalIDKey returns the store key of a threshold proposal
+func ThresholdProposalIDKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+
+	return key
+}
+
+// ThresholdProposalExpiryQueueKey returns the store key of a threshold proposal in the expiry queue
+// the height is big endian encoded so entries are ordered by expiry height then by id
+func ThresholdProposalExpiryQueueKey(expiryHeight uint64, id uint64) []byte {
+	return append(ThresholdProposalExpiryQueueHeightKey(expiryHeight), ThresholdProposalIDKey(id)...)
+}
+
+// ThresholdProposalExpiryQueueHeightKey returns the store key prefix of the threshold proposals expiring at a height
+func ThresholdProposalExpiryQueueHeightKey(expiryHeight uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, expiryHeight)
+
+	return key
+}
+
+// AuditEntryIDKey returns the store key of an audit entry
+func AuditEntryIDKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+
+	return key
+}
+
+// AuditEntryIndexFieldKey returns the store key prefix of all the audit entry index entries for a field value
+func AuditEntryIndexFieldKey(field string) []byte {
+	var key []byte
+
+	key = append(key, []byte(field)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuditEntryIndexKey returns the store key of an audit entry index entry
+// the id is big endian encoded so entries of a field value are ordered by id
+func AuditEntryIndexKey(field string, id uint64) []byte {
+	return append(AuditEntryIndexFieldKey(field), AuditEntryIDKey(id)...)
+}
+
+// ParseAuditEntryIndexKey returns the audit entry id from an audit entry index entry key
+// the key must be relative to the field value prefix returned by AuditEntryIndexFieldKey
+func ParseAuditEntryIndexKey(key []byte) (uint64, error) {
+	if len(key) != 8 {
+		return 0, errors.New("invalid audit entry index key")
+	}
+	return binary.BigEndian.Uint64(key), nil
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgAddAuthorization = "AddAuthorization"
+
+var _ sdk.Msg = &MsgAddAuthorization{}
+
+func NewMsgAddAuthorization(signer string, authorization Authorization) *MsgAddAuthorization {
+	return &MsgAddAuthorization{
+		Signer:        signer,
+		Authorization: authorization,
+	}
+}
+
+func (msg *MsgAddAuthorization) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddAuthorization) Type() string {
+	return TypeMsgAddAuthorization
+}
+
+func (msg *MsgAddAuthorization) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAddAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddAuthorization) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if err := msg.Authorization.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authorization (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgApproveThresholdProposal = "ApproveThresholdProposal"
+
+var _ sdk.Msg = &MsgApproveThresholdProposal{}
+
+func NewMsgApproveThresholdProposal(signer string, proposalID uint64) *MsgApproveThresholdProposal {
+	return &MsgApproveThresholdProposal{
+		Signer:     signer,
+		ProposalId: proposalID,
+	}
+}
+
+func (msg *MsgApproveThresholdProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgApproveThresholdProposal) Type() string {
+	return TypeMsgApproveThresholdProposal
+}
+
+func (msg *MsgApproveThresholdProposal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgApproveThresholdProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgApproveThresholdProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	return nil
+}
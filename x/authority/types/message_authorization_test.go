@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestMsgAddAuthorization_ValidateBasic(t *testing.T) {
+	authorization := types.Authorization{
+		MsgUrl:  "/zetachain.zetacore.observer.MsgUpdateChainParams",
+		ChainId: 1,
+		Address: sample.AccAddress(),
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgAddAuthorization
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgAddAuthorization(sample.AccAddress(), authorization),
+		},
+		{
+			name: "invalid signer address",
+			msg:  types.NewMsgAddAuthorization("invalid", authorization),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid authorization",
+			msg: types.NewMsgAddAuthorization(sample.AccAddress(), types.Authorization{
+				MsgUrl:  "/zetachain.zetacore.observer.MsgUpdateChainParams",
+				Address: "invalid",
+			}),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveAuthorization_ValidateBasic(t *testing.T) {
+	authorization := types.Authorization{
+		MsgUrl:     "/zetachain.zetacore.observer.MsgUpdateChainParams",
+		PolicyType: types.PolicyType_groupAdmin,
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgRemoveAuthorization
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgRemoveAuthorization(sample.AccAddress(), authorization),
+		},
+		{
+			name: "invalid signer address",
+			msg:  types.NewMsgRemoveAuthorization("invalid", authorization),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid authorization",
+			msg: types.NewMsgRemoveAuthorization(sample.AccAddress(), types.Authorization{
+				MsgUrl:     "",
+				PolicyType: types.PolicyType_groupAdmin,
+			}),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
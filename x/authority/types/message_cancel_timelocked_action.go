@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCancelTimelockedAction = "CancelTimelockedAction"
+
+var _ sdk.Msg = &MsgCancelTimelockedAction{}
+
+func NewMsgCancelTimelockedAction(signer string, actionID uint64) *MsgCancelTimelockedAction {
+	return &MsgCancelTimelockedAction{
+		Signer:   signer,
+		ActionId: actionID,
+	}
+}
+
+func (msg *MsgCancelTimelockedAction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelTimelockedAction) Type() string {
+	return TypeMsgCancelTimelockedAction
+}
+
+func (msg *MsgCancelTimelockedAction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelTimelockedAction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelTimelockedAction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+	return nil
+}
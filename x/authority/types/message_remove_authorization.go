@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRemoveAuthorization = "RemoveAuthorization"
+
+var _ sdk.Msg = &MsgRemoveAuthorization{}
+
+func NewMsgRemoveAuthorization(signer string, authorization Authorization) *MsgRemoveAuthorization {
+	return &MsgRemoveAuthorization{
+		Signer:        signer,
+		Authorization: authorization,
+	}
+}
+
+func (msg *MsgRemoveAuthorization) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveAuthorization) Type() string {
+	return TypeMsgRemoveAuthorization
+}
+
+func (msg *MsgRemoveAuthorization) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveAuthorization) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if err := msg.Authorization.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid authorization (%s)", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitThresholdProposal = "SubmitThresholdProposal"
+
+var (
+	_ sdk.Msg                            = &MsgSubmitThresholdProposal{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitThresholdProposal{}
+)
+
+func NewMsgSubmitThresholdProposal(
+	signer string,
+	policyType PolicyType,
+	msg sdk.Msg,
+) (*MsgSubmitThresholdProposal, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitThresholdProposal{
+		Signer:     signer,
+		PolicyType: policyType,
+		Msg:        anyMsg,
+	}, nil
+}
+
+func (msg *MsgSubmitThresholdProposal) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitThresholdProposal) Type() string {
+	return TypeMsgSubmitThresholdProposal
+}
+
+func (msg *MsgSubmitThresholdProposal) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitThresholdProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitThresholdProposal) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if msg.PolicyType != PolicyType_groupEmergency && msg.PolicyType != PolicyType_groupAdmin {
+		return errorsmod.Wrap(ErrInvalidThresholdProposal, fmt.Sprintf("invalid policy type %d", msg.PolicyType))
+	}
+
+	proposedMsg, err := msg.GetProposedMsg()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidThresholdProposal, err.Error())
+	}
+
+	// the message is executed with the address of the threshold policy as its only signer
+	policyAddress := ThresholdPolicyAddress(msg.PolicyType).String()
+	signers := proposedMsg.GetSigners()
+	if len(signers) != 1 || signers[0].String() != policyAddress {
+		return errorsmod.Wrapf(ErrInvalidThresholdProposal, "the only signer of the message must be %s", policyAddress)
+	}
+
+	if err := proposedMsg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidThresholdProposal, err.Error())
+	}
+
+	return nil
+}
+
+// GetProposedMsg returns the message to execute once the proposal is approved
+func (msg MsgSubmitThresholdProposal) GetProposedMsg() (sdk.Msg, error) {
+	return ThresholdProposal{Msg: msg.Msg}.GetProposedMsg()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitThresholdProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var proposedMsg sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &proposedMsg)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgSubmitTimelockedAction = "SubmitTimelockedAction"
+
+var (
+	_ sdk.Msg                            = &MsgSubmitTimelockedAction{}
+	_ codectypes.UnpackInterfacesMessage = MsgSubmitTimelockedAction{}
+)
+
+func NewMsgSubmitTimelockedAction(signer string, msg sdk.Msg) (*MsgSubmitTimelockedAction, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSubmitTimelockedAction{
+		Signer: signer,
+		Msg:    anyMsg,
+	}, nil
+}
+
+func (msg *MsgSubmitTimelockedAction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSubmitTimelockedAction) Type() string {
+	return TypeMsgSubmitTimelockedAction
+}
+
+func (msg *MsgSubmitTimelockedAction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgSubmitTimelockedAction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgSubmitTimelockedAction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	timelockedMsg, err := msg.GetTimelockedMsg()
+	if err != nil {
+		return errorsmod.Wrap(ErrInvalidTimelockedMsg, err.Error())
+	}
+
+	// the message is executed with the signer of the submission as its only signer
+	signers := timelockedMsg.GetSigners()
+	if len(signers) != 1 || signers[0].String() != msg.Signer {
+		return errorsmod.Wrapf(ErrInvalidTimelockedMsg, "the only signer of the message must be %s", msg.Signer)
+	}
+
+	if err := timelockedMsg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(ErrInvalidTimelockedMsg, err.Error())
+	}
+
+	return nil
+}
+
+// GetTimelockedMsg returns the message to queue in the timelock
+func (msg MsgSubmitTimelockedAction) GetTimelockedMsg() (sdk.Msg, error) {
+	return QueuedAction{Msg: msg.Msg}.GetTimelockedMsg()
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgSubmitTimelockedAction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var timelockedMsg sdk.Msg
+	return unpacker.UnpackAny(msg.Msg, &timelockedMsg)
+}
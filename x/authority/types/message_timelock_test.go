@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestMsgUpdateTimelockConfig_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateTimelockConfig
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  types.NewMsgUpdateTimelockConfig(sample.AccAddress(), types.DefaultTimelockConfig()),
+		},
+		{
+			name: "invalid signer address",
+			msg:  types.NewMsgUpdateTimelockConfig("invalid", types.DefaultTimelockConfig()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid timelock config",
+			msg: types.NewMsgUpdateTimelockConfig(sample.AccAddress(), types.TimelockConfig{
+				Messages: []types.TimelockedMessage{
+					{MsgUrl: "/zetachain.zetacore.fungible.MsgUpdateSystemContract", DelayBlocks: -1},
+				},
+			}),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitTimelockedAction_ValidateBasic(t *testing.T) {
+	signer := sample.AccAddress()
+
+	newMsg := func(signer string, timelockedMsg *fungibletypes.MsgUpdateSystemContract) *types.MsgSubmitTimelockedAction {
+		msg, err := types.NewMsgSubmitTimelockedAction(signer, timelockedMsg)
+		require.NoError(t, err)
+		return msg
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgSubmitTimelockedAction
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg:  newMsg(signer, fungibletypes.NewMsgUpdateSystemContract(signer, sample.EthAddress().Hex())),
+		},
+		{
+			name: "invalid signer address",
+			msg:  newMsg("invalid", fungibletypes.NewMsgUpdateSystemContract(signer, sample.EthAddress().Hex())),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "missing message",
+			msg:  &types.MsgSubmitTimelockedAction{Signer: signer},
+			err:  types.ErrInvalidTimelockedMsg,
+		},
+		{
+			name: "signer of the message is not the signer",
+			msg:  newMsg(signer, fungibletypes.NewMsgUpdateSystemContract(sample.AccAddress(), sample.EthAddress().Hex())),
+			err:  types.ErrInvalidTimelockedMsg,
+		},
+		{
+			name: "invalid message",
+			msg:  newMsg(signer, fungibletypes.NewMsgUpdateSystemContract(signer, "invalid")),
+			err:  types.ErrInvalidTimelockedMsg,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCancelTimelockedAction_ValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgCancelTimelockedAction(sample.AccAddress(), 1).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgCancelTimelockedAction("invalid", 1).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUpdateTimelockConfig = "UpdateTimelockConfig"
+
+var _ sdk.Msg = &MsgUpdateTimelockConfig{}
+
+func NewMsgUpdateTimelockConfig(signer string, timelockConfig TimelockConfig) *MsgUpdateTimelockConfig {
+	return &MsgUpdateTimelockConfig{
+		Signer:         signer,
+		TimelockConfig: timelockConfig,
+	}
+}
+
+func (msg *MsgUpdateTimelockConfig) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateTimelockConfig) Type() string {
+	return TypeMsgUpdateTimelockConfig
+}
+
+func (msg *MsgUpdateTimelockConfig) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateTimelockConfig) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateTimelockConfig) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address (%s)", err)
+	}
+
+	if err := msg.TimelockConfig.Validate(); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid timelock config (%s)", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -46,6 +47,92 @@ func (p Policies) Validate() error {
 			return fmt.Errorf("duplicate policy type: %s", policy.PolicyType)
 		}
 		policyTypeMap[policy.PolicyType] = true
+
+		if err := policy.validateThreshold(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetPolicy returns the policy of the policy type
+func (p Policies) GetPolicy(policyType PolicyType) (Policy, bool) {
+	for _, policy := range p.Items {
+		if policy != nil && policy.PolicyType == policyType {
+			return *policy, true
+		}
+	}
+	return Policy{}, false
+}
+
+// ThresholdPolicyAddress returns the address of the threshold policy of the policy type
+// the address is derived from the policy type so the messages of the policy can only be executed through proposals
+func ThresholdPolicyAddress(policyType PolicyType) sdk.AccAddress {
+	return address.Module(ModuleName, []byte(policyType.String()))
+}
+
+// IsThreshold returns true if the policy is controlled by the approvals of its members
+func (p Policy) IsThreshold() bool {
+	return len(p.Members) > 0
+}
+
+// IsMember returns true if the address is a member of the threshold policy
+func (p Policy) IsMember(address string) bool {
+	for _, member := range p.Members {
+		if member == address {
+			return true
+		}
+	}
+	return false
+}
+
+// CountApprovals returns the number of approvals given by the current members of the threshold policy
+// the approvals of the addresses removed from the members are not counted
+func (p Policy) CountApprovals(approvals []string) uint32 {
+	var count uint32
+	for _, approval := range approvals {
+		if p.IsMember(approval) {
+			count++
+		}
+	}
+	return count
+}
+
+// validateThreshold checks the members, threshold and proposal expiry of a threshold policy
+// a policy controlled by its address must not set them
+func (p Policy) validateThreshold() error {
+	if !p.IsThreshold() {
+		if p.Threshold != 0 || p.ProposalExpiryBlocks != 0 {
+			return fmt.Errorf("policy %s without members can't have a threshold or a proposal expiry", p.PolicyType)
+		}
+		return nil
+	}
+
+	if expected := ThresholdPolicyAddress(p.PolicyType).String(); p.Address != expected {
+		return fmt.Errorf("threshold policy %s must use the address %s", p.PolicyType, expected)
+	}
+
+	members := make(map[string]bool)
+	for _, member := range p.Members {
+		if _, err := sdk.AccAddressFromBech32(member); err != nil {
+			return fmt.Errorf("invalid member address: %s", err)
+		}
+		if member == p.Address {
+			return fmt.Errorf("threshold policy %s can't be a member of itself", p.PolicyType)
+		}
+		if members[member] {
+			return fmt.Errorf("duplicate member %s in policy %s", member, p.PolicyType)
+		}
+		members[member] = true
+	}
+
+	// #nosec G701 len always positive
+	if p.Threshold == 0 || p.Threshold > uint32(len(p.Members)) {
+		return fmt.Errorf("invalid threshold %d for policy %s with %d members", p.Threshold, p.PolicyType, len(p.Members))
+	}
+	if p.ProposalExpiryBlocks <= 0 {
+		return fmt.Errorf("invalid proposal expiry for policy %s: %d", p.PolicyType, p.ProposalExpiryBlocks)
 	}
 
 	return nil
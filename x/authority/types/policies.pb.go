@@ -30,16 +30,21 @@ type PolicyType int32
 const (
 	PolicyType_groupEmergency PolicyType = 0
 	PolicyType_groupAdmin     PolicyType = 1
+	// address is not a policy, it is only set in the audit entries of the signers authorized by an authorization of
+	// their address
+	PolicyType_address PolicyType = 2
 )
 
 var PolicyType_name = map[int32]string{
 	0: "groupEmergency",
 	1: "groupAdmin",
+	2: "address",
 }
 
 var PolicyType_value = map[string]int32{
 	"groupEmergency": 0,
 	"groupAdmin":     1,
+	"address":        2,
 }
 
 func (x PolicyType) String() string {
@@ -50,9 +55,19 @@ func (PolicyType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_cf39aa57ca0776f1, []int{0}
 }
 
+// Policy authorizes an address for a policy type
+// a threshold policy is controlled by its members, its messages are submitted as proposals by the members and
+// executed with the policy address as signer once the threshold of approvals is reached
+// the address of a threshold policy is derived from the policy type so no key controls it
 type Policy struct {
 	PolicyType PolicyType `protobuf:"varint,1,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.authority.PolicyType" json:"policy_type,omitempty"`
 	Address    string     `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// members of a threshold policy, empty if the policy is controlled by its address
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	// number of member approvals required to execute a proposal of a threshold policy
+	Threshold uint32 `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// number of blocks during which a proposal of a threshold policy can be approved
+	ProposalExpiryBlocks int64 `protobuf:"varint,5,opt,name=proposal_expiry_blocks,json=proposalExpiryBlocks,proto3" json:"proposal_expiry_blocks,omitempty"`
 }
 
 func (m *Policy) Reset()         { *m = Policy{} }
@@ -102,6 +117,27 @@ func (m *Policy) GetAddress() string {
 	return ""
 }
 
+func (m *Policy) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *Policy) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Policy) GetProposalExpiryBlocks() int64 {
+	if m != nil {
+		return m.ProposalExpiryBlocks
+	}
+	return 0
+}
+
 // Policy contains info about authority policies
 type Policies struct {
 	Items []*Policy `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -156,25 +192,30 @@ func init() {
 func init() { proto.RegisterFile("authority/policies.proto", fileDescriptor_cf39aa57ca0776f1) }
 
 var fileDescriptor_cf39aa57ca0776f1 = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x2c, 0x2d, 0xc9,
-	0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0x2f, 0xc8, 0xcf, 0xc9, 0x4c, 0xce, 0x4c, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xa9, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3,
-	0x03, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0xe0, 0x8a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x0a,
-	0xf5, 0x41, 0x2c, 0x88, 0x1e, 0xa5, 0x5c, 0x2e, 0xb6, 0x00, 0x90, 0x29, 0x95, 0x42, 0x9e, 0x5c,
-	0xdc, 0x60, 0xf3, 0x2a, 0xe3, 0x4b, 0x2a, 0x0b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0xf8, 0x8c,
-	0x34, 0xf4, 0xf0, 0x99, 0xa9, 0x07, 0xd1, 0x1a, 0x52, 0x59, 0x90, 0x1a, 0xc4, 0x55, 0x00, 0x67,
-	0x0b, 0x49, 0x70, 0xb1, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x29, 0x30, 0x6a,
-	0x70, 0x06, 0xc1, 0xb8, 0x4a, 0x6e, 0x5c, 0x1c, 0x01, 0x50, 0x47, 0x0b, 0x59, 0x71, 0xb1, 0x66,
-	0x96, 0xa4, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x1b, 0xa9, 0x10, 0x63, 0x55, 0x10,
-	0x44, 0x8b, 0x96, 0x19, 0x17, 0x17, 0xc2, 0x6e, 0x21, 0x21, 0x2e, 0xbe, 0xf4, 0xa2, 0xfc, 0xd2,
-	0x02, 0xd7, 0xdc, 0xd4, 0xa2, 0xf4, 0xd4, 0xbc, 0xe4, 0x4a, 0x01, 0x06, 0x21, 0x3e, 0x2e, 0x2e,
-	0xb0, 0x98, 0x63, 0x4a, 0x6e, 0x66, 0x9e, 0x00, 0xa3, 0x14, 0xcb, 0x8a, 0x25, 0x72, 0x8c, 0x4e,
-	0x5e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
-	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x90, 0x9e, 0x59, 0x92,
-	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0xb2, 0x5e, 0x17, 0xec, 0x12, 0x7d, 0x98, 0x4b,
-	0xf4, 0x2b, 0xf4, 0x11, 0xe1, 0x0e, 0x0a, 0xa0, 0xe2, 0x24, 0x36, 0x70, 0x08, 0x1a, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x08, 0x31, 0x60, 0x2c, 0x91, 0x01, 0x00, 0x00,
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0xcd, 0x34, 0x6d, 0xbf, 0xaf, 0x53, 0xbe, 0x52, 0x86, 0xf2, 0x31, 0x94, 0x12, 0x42, 0x71,
+	0x11, 0x04, 0x13, 0xa9, 0xae, 0xdc, 0x59, 0xa9, 0xa0, 0xab, 0x12, 0x5c, 0xb9, 0x29, 0xf9, 0x19,
+	0x92, 0xc1, 0xa4, 0x33, 0xcc, 0x4c, 0xa1, 0xe3, 0x53, 0xf8, 0x10, 0x2e, 0x7c, 0x14, 0x97, 0x5d,
+	0x0a, 0x6e, 0xa4, 0x7d, 0x11, 0xc9, 0xf4, 0x6f, 0x27, 0xee, 0xee, 0xbd, 0xe7, 0x9e, 0xc3, 0xb9,
+	0xf7, 0x40, 0x1c, 0x2d, 0x54, 0xce, 0x04, 0x55, 0x3a, 0xe0, 0xac, 0xa0, 0x09, 0x25, 0xd2, 0xe7,
+	0x82, 0x29, 0x86, 0x06, 0xcf, 0x44, 0x45, 0x49, 0x1e, 0xd1, 0xb9, 0x6f, 0x2a, 0x26, 0x88, 0x7f,
+	0x58, 0xee, 0xf7, 0x32, 0x96, 0x31, 0xb3, 0x18, 0x54, 0xd5, 0x96, 0x33, 0xfc, 0x04, 0xb0, 0x39,
+	0xad, 0x64, 0x34, 0xba, 0x83, 0x6d, 0x23, 0xa8, 0x67, 0x4a, 0x73, 0x82, 0x81, 0x0b, 0xbc, 0xce,
+	0xc8, 0xf3, 0x7f, 0x12, 0xf5, 0xb7, 0xd4, 0x07, 0xcd, 0x49, 0x08, 0xf9, 0xa1, 0x46, 0x18, 0xfe,
+	0x89, 0xd2, 0x54, 0x10, 0x29, 0x71, 0xcd, 0x05, 0x5e, 0x2b, 0xdc, 0xb7, 0x15, 0x52, 0x92, 0x32,
+	0x26, 0x42, 0x62, 0xdb, 0xb5, 0x2b, 0x64, 0xd7, 0xa2, 0x01, 0x6c, 0xa9, 0x5c, 0x10, 0x99, 0xb3,
+	0x22, 0xc5, 0x75, 0x17, 0x78, 0xff, 0xc2, 0xe3, 0x00, 0x5d, 0xc2, 0xff, 0x5c, 0x30, 0xce, 0x64,
+	0x54, 0xcc, 0xc8, 0x92, 0x53, 0xa1, 0x67, 0x71, 0xc1, 0x92, 0x27, 0x89, 0x1b, 0x2e, 0xf0, 0xec,
+	0xb0, 0xb7, 0x47, 0x27, 0x06, 0x1c, 0x1b, 0x6c, 0x78, 0x0b, 0xff, 0x4e, 0x77, 0x3f, 0x42, 0x57,
+	0xb0, 0x41, 0x15, 0x29, 0x25, 0x06, 0xae, 0xed, 0xb5, 0x47, 0x27, 0xbf, 0x39, 0x2c, 0xdc, 0x52,
+	0x4e, 0x6f, 0x20, 0x3c, 0x5e, 0x8a, 0x10, 0xec, 0x64, 0x82, 0x2d, 0xf8, 0xa4, 0x24, 0x22, 0x23,
+	0xf3, 0x44, 0x77, 0x2d, 0xd4, 0x81, 0xd0, 0xcc, 0xae, 0xd3, 0x92, 0xce, 0xbb, 0x00, 0xb5, 0x0f,
+	0x1f, 0xe8, 0xd6, 0xfa, 0xf5, 0xb7, 0x57, 0x07, 0x8c, 0xef, 0xdf, 0xd7, 0x0e, 0x58, 0xad, 0x1d,
+	0xf0, 0xb5, 0x76, 0xc0, 0xcb, 0xc6, 0xb1, 0x56, 0x1b, 0xc7, 0xfa, 0xd8, 0x38, 0xd6, 0xe3, 0x79,
+	0x46, 0x55, 0xbe, 0x88, 0xfd, 0x84, 0x95, 0x41, 0xe5, 0xe5, 0xcc, 0xd8, 0x0a, 0xf6, 0xb6, 0x82,
+	0x65, 0x70, 0xcc, 0xbc, 0xca, 0x46, 0xc6, 0x4d, 0x93, 0xde, 0xc5, 0xf7, 0x00, 0xef, 0xf7, 0x82,
+	0xbc, 0x0d, 0x02, 0x00, 0x00,
 }
 
 func (m *Policy) Marshal() (dAtA []byte, err error) {
@@ -197,6 +238,25 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalExpiryBlocks != 0 {
+		i = encodeVarintPolicies(dAtA, i, uint64(m.ProposalExpiryBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintPolicies(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintPolicies(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -273,6 +333,18 @@ func (m *Policy) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPolicies(uint64(l))
 	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovPolicies(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovPolicies(uint64(m.Threshold))
+	}
+	if m.ProposalExpiryBlocks != 0 {
+		n += 1 + sovPolicies(uint64(m.ProposalExpiryBlocks))
+	}
 	return n
 }
 
@@ -377,6 +449,76 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicies
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPolicies
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPolicies
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicies
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExpiryBlocks", wireType)
+			}
+			m.ProposalExpiryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPolicies
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalExpiryBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPolicies(dAtA[iNdEx:])
@@ -18,6 +18,8 @@ func setConfig() {
 }
 
 func TestPolicies_Validate(t *testing.T) {
+	member := sample.AccAddress()
+
 	// use table driven tests to test the validation of policies
 	tests := []struct {
 		name        string
@@ -75,6 +77,18 @@ func TestPolicies_Validate(t *testing.T) {
 			},
 			errContains: "invalid policy type",
 		},
+		{
+			name: "invalid if policy type is address",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					{
+						Address:    sample.AccAddress(),
+						PolicyType: types.PolicyType_address,
+					},
+				},
+			},
+			errContains: "invalid policy type",
+		},
 		{
 			name: "invalid if duplicated policy type",
 			policies: types.Policies{
@@ -95,6 +109,112 @@ func TestPolicies_Validate(t *testing.T) {
 			},
 			errContains: "duplicate policy type",
 		},
+		{
+			name: "valid threshold policy",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					sample.ThresholdPolicy(
+						types.PolicyType_groupAdmin,
+						[]string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()},
+						2,
+					),
+				},
+			},
+		},
+		{
+			name: "invalid if threshold set without members",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					{
+						Address:    sample.AccAddress(),
+						PolicyType: types.PolicyType_groupAdmin,
+						Threshold:  1,
+					},
+				},
+			},
+			errContains: "can't have a threshold",
+		},
+		{
+			name: "invalid if threshold policy address is not the derived address",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					{
+						Address:              sample.AccAddress(),
+						PolicyType:           types.PolicyType_groupAdmin,
+						Members:              []string{sample.AccAddress()},
+						Threshold:            1,
+						ProposalExpiryBlocks: 100,
+					},
+				},
+			},
+			errContains: "must use the address",
+		},
+		{
+			name: "invalid if member address is invalid",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					sample.ThresholdPolicy(types.PolicyType_groupAdmin, []string{"invalid"}, 1),
+				},
+			},
+			errContains: "invalid member address",
+		},
+		{
+			name: "invalid if the policy address is a member",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					sample.ThresholdPolicy(
+						types.PolicyType_groupAdmin,
+						[]string{types.ThresholdPolicyAddress(types.PolicyType_groupAdmin).String()},
+						1,
+					),
+				},
+			},
+			errContains: "can't be a member of itself",
+		},
+		{
+			name: "invalid if duplicated member",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					sample.ThresholdPolicy(
+						types.PolicyType_groupAdmin,
+						[]string{member, member},
+						1,
+					),
+				},
+			},
+			errContains: "duplicate member",
+		},
+		{
+			name: "invalid if threshold is zero",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					sample.ThresholdPolicy(types.PolicyType_groupAdmin, []string{sample.AccAddress()}, 0),
+				},
+			},
+			errContains: "invalid threshold",
+		},
+		{
+			name: "invalid if threshold is greater than the number of members",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					sample.ThresholdPolicy(types.PolicyType_groupAdmin, []string{sample.AccAddress()}, 2),
+				},
+			},
+			errContains: "invalid threshold",
+		},
+		{
+			name: "invalid if proposal expiry is not set",
+			policies: types.Policies{
+				Items: []*types.Policy{
+					func() *types.Policy {
+						policy := sample.ThresholdPolicy(types.PolicyType_groupAdmin, []string{sample.AccAddress()}, 1)
+						policy.ProposalExpiryBlocks = 0
+						return policy
+					}(),
+				},
+			},
+			errContains: "invalid proposal expiry",
+		},
 	}
 
 	for _, tt := range tests {
@@ -108,3 +228,37 @@ func TestPolicies_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicies_GetPolicy(t *testing.T) {
+	policies := sample.Policies()
+
+	policy, found := policies.GetPolicy(types.PolicyType_groupAdmin)
+	require.True(t, found)
+	require.Equal(t, *policies.Items[1], policy)
+
+	_, found = types.Policies{}.GetPolicy(types.PolicyType_groupAdmin)
+	require.False(t, found)
+}
+
+func TestPolicy_CountApprovals(t *testing.T) {
+	members := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	policy := sample.ThresholdPolicy(types.PolicyType_groupAdmin, members, 2)
+
+	require.True(t, policy.IsThreshold())
+	require.True(t, policy.IsMember(members[0]))
+	require.False(t, policy.IsMember(sample.AccAddress()))
+	require.False(t, sample.Policies().Items[0].IsThreshold())
+
+	// the approvals of non members are not counted
+	require.EqualValues(t, 0, policy.CountApprovals(nil))
+	require.EqualValues(t, 2, policy.CountApprovals([]string{members[0], sample.AccAddress(), members[2]}))
+}
+
+func TestThresholdPolicyAddress(t *testing.T) {
+	emergency := types.ThresholdPolicyAddress(types.PolicyType_groupEmergency)
+	admin := types.ThresholdPolicyAddress(types.PolicyType_groupAdmin)
+
+	require.NotEqual(t, emergency, admin)
+	require.Equal(t, admin, types.ThresholdPolicyAddress(types.PolicyType_groupAdmin))
+	require.NoError(t, sdk.VerifyAddressFormat(admin))
+}
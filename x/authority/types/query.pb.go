@@ -10,7 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -113,213 +113,3061 @@ func (m *QueryGetPoliciesResponse) GetPolicies() Policies {
 	return Policies{}
 }
 
-func init() {
-	proto.RegisterType((*QueryGetPoliciesRequest)(nil), "zetachain.zetacore.authority.QueryGetPoliciesRequest")
-	proto.RegisterType((*QueryGetPoliciesResponse)(nil), "zetachain.zetacore.authority.QueryGetPoliciesResponse")
+// QueryAuthorizationListRequest is the request type for the Query/AuthorizationList RPC method.
+type QueryAuthorizationListRequest struct {
 }
 
-func init() { proto.RegisterFile("authority/query.proto", fileDescriptor_b64d9e7f9da035b5) }
-
-var fileDescriptor_b64d9e7f9da035b5 = []byte{
-	// 318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0x2c, 0x2d, 0xc9,
-	0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0xa9, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2,
-	0x8b, 0x52, 0xf5, 0xe0, 0x2a, 0xa5, 0x24, 0x10, 0x9a, 0x0a, 0xf2, 0x73, 0x32, 0x93, 0x33, 0x53,
-	0x8b, 0x21, 0xfa, 0xa4, 0xb4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53,
-	0x21, 0x06, 0xea, 0x97, 0x19, 0x26, 0xa5, 0x96, 0x24, 0x1a, 0xea, 0x17, 0x24, 0xa6, 0x67, 0xe6,
-	0x25, 0x96, 0x64, 0xe6, 0xe7, 0x41, 0xd5, 0x8a, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20,
-	0x16, 0x54, 0x54, 0x26, 0x3d, 0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x3f, 0xb1, 0x20, 0x53, 0x3f, 0x31,
-	0x2f, 0x2f, 0xbf, 0x04, 0xac, 0x05, 0x6a, 0xbe, 0x92, 0x24, 0x97, 0x78, 0x20, 0xc8, 0x54, 0xf7,
-	0xd4, 0x92, 0x00, 0xa8, 0xcd, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x4a, 0x29, 0x5c, 0x12,
-	0x98, 0x52, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x1e, 0x5c, 0x1c, 0x30, 0x87, 0x4a, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xa9, 0xe9, 0xe1, 0xf3, 0xa1, 0x1e, 0xcc, 0x04, 0x27, 0x96, 0x13,
-	0xf7, 0xe4, 0x19, 0x82, 0xe0, 0xba, 0x8d, 0x56, 0x33, 0x72, 0xb1, 0x82, 0xad, 0x11, 0x5a, 0xc8,
-	0xc8, 0xc5, 0x01, 0x53, 0x26, 0x64, 0x8a, 0xdf, 0x38, 0x1c, 0x6e, 0x96, 0x32, 0x23, 0x55, 0x1b,
-	0xc4, 0x3f, 0x4a, 0x6a, 0x4d, 0x97, 0x9f, 0x4c, 0x66, 0x52, 0x10, 0x92, 0xd3, 0x07, 0xe9, 0xd2,
-	0x05, 0x1b, 0xa0, 0x8f, 0x19, 0x29, 0x4e, 0x5e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7,
-	0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c,
-	0xc7, 0x10, 0x65, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x8b, 0x6c, 0x06,
-	0xcc, 0x11, 0xfa, 0x15, 0x48, 0xc6, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xc0,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x24, 0x39, 0xaa, 0x53, 0x32, 0x02, 0x00, 0x00,
+func (m *QueryAuthorizationListRequest) Reset()         { *m = QueryAuthorizationListRequest{} }
+func (m *QueryAuthorizationListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthorizationListRequest) ProtoMessage()    {}
+func (*QueryAuthorizationListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{2}
+}
+func (m *QueryAuthorizationListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthorizationListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthorizationListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthorizationListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthorizationListRequest.Merge(m, src)
+}
+func (m *QueryAuthorizationListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthorizationListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthorizationListRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryAuthorizationListRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryAuthorizationListResponse is the response type for the Query/AuthorizationList RPC method.
+type QueryAuthorizationListResponse struct {
+	AuthorizationList AuthorizationList `protobuf:"bytes,1,opt,name=authorization_list,json=authorizationList,proto3" json:"authorization_list"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Queries Policies
-	Policies(ctx context.Context, in *QueryGetPoliciesRequest, opts ...grpc.CallOption) (*QueryGetPoliciesResponse, error)
+func (m *QueryAuthorizationListResponse) Reset()         { *m = QueryAuthorizationListResponse{} }
+func (m *QueryAuthorizationListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuthorizationListResponse) ProtoMessage()    {}
+func (*QueryAuthorizationListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{3}
+}
+func (m *QueryAuthorizationListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuthorizationListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuthorizationListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuthorizationListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuthorizationListResponse.Merge(m, src)
+}
+func (m *QueryAuthorizationListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuthorizationListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuthorizationListResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryAuthorizationListResponse proto.InternalMessageInfo
+
+func (m *QueryAuthorizationListResponse) GetAuthorizationList() AuthorizationList {
+	if m != nil {
+		return m.AuthorizationList
+	}
+	return AuthorizationList{}
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryTimelockConfigRequest is the request type for the Query/TimelockConfig RPC method.
+type QueryTimelockConfigRequest struct {
 }
 
-func (c *queryClient) Policies(ctx context.Context, in *QueryGetPoliciesRequest, opts ...grpc.CallOption) (*QueryGetPoliciesResponse, error) {
-	out := new(QueryGetPoliciesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/Policies", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTimelockConfigRequest) Reset()         { *m = QueryTimelockConfigRequest{} }
+func (m *QueryTimelockConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockConfigRequest) ProtoMessage()    {}
+func (*QueryTimelockConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{4}
+}
+func (m *QueryTimelockConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTimelockConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockConfigRequest.Merge(m, src)
+}
+func (m *QueryTimelockConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockConfigRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Queries Policies
-	Policies(context.Context, *QueryGetPoliciesRequest) (*QueryGetPoliciesResponse, error)
+var xxx_messageInfo_QueryTimelockConfigRequest proto.InternalMessageInfo
+
+// QueryTimelockConfigResponse is the response type for the Query/TimelockConfig RPC method.
+type QueryTimelockConfigResponse struct {
+	TimelockConfig TimelockConfig `protobuf:"bytes,1,opt,name=timelock_config,json=timelockConfig,proto3" json:"timelock_config"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryTimelockConfigResponse) Reset()         { *m = QueryTimelockConfigResponse{} }
+func (m *QueryTimelockConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimelockConfigResponse) ProtoMessage()    {}
+func (*QueryTimelockConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{5}
+}
+func (m *QueryTimelockConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimelockConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimelockConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimelockConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimelockConfigResponse.Merge(m, src)
+}
+func (m *QueryTimelockConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimelockConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimelockConfigResponse.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Policies(ctx context.Context, req *QueryGetPoliciesRequest) (*QueryGetPoliciesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Policies not implemented")
+var xxx_messageInfo_QueryTimelockConfigResponse proto.InternalMessageInfo
+
+func (m *QueryTimelockConfigResponse) GetTimelockConfig() TimelockConfig {
+	if m != nil {
+		return m.TimelockConfig
+	}
+	return TimelockConfig{}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryGetQueuedActionRequest is the request type for the Query/QueuedAction RPC method.
+type QueryGetQueuedActionRequest struct {
+	ActionId uint64 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 }
 
-func _Query_Policies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPoliciesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryGetQueuedActionRequest) Reset()         { *m = QueryGetQueuedActionRequest{} }
+func (m *QueryGetQueuedActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQueuedActionRequest) ProtoMessage()    {}
+func (*QueryGetQueuedActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{6}
+}
+func (m *QueryGetQueuedActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQueuedActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQueuedActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Policies(ctx, in)
+}
+func (m *QueryGetQueuedActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQueuedActionRequest.Merge(m, src)
+}
+func (m *QueryGetQueuedActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQueuedActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQueuedActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetQueuedActionRequest proto.InternalMessageInfo
+
+func (m *QueryGetQueuedActionRequest) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.authority.Query/Policies",
+	return 0
+}
+
+// QueryGetQueuedActionResponse is the response type for the Query/QueuedAction RPC method.
+type QueryGetQueuedActionResponse struct {
+	QueuedAction QueuedAction `protobuf:"bytes,1,opt,name=queued_action,json=queuedAction,proto3" json:"queued_action"`
+}
+
+func (m *QueryGetQueuedActionResponse) Reset()         { *m = QueryGetQueuedActionResponse{} }
+func (m *QueryGetQueuedActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetQueuedActionResponse) ProtoMessage()    {}
+func (*QueryGetQueuedActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{7}
+}
+func (m *QueryGetQueuedActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetQueuedActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetQueuedActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Policies(ctx, req.(*QueryGetPoliciesRequest))
+}
+func (m *QueryGetQueuedActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetQueuedActionResponse.Merge(m, src)
+}
+func (m *QueryGetQueuedActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetQueuedActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetQueuedActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetQueuedActionResponse proto.InternalMessageInfo
+
+func (m *QueryGetQueuedActionResponse) GetQueuedAction() QueuedAction {
+	if m != nil {
+		return m.QueuedAction
 	}
-	return interceptor(ctx, in, info, handler)
+	return QueuedAction{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.authority.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Policies",
-			Handler:    _Query_Policies_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "authority/query.proto",
+// QueryAllQueuedActionRequest is the request type for the Query/QueuedActionAll RPC method.
+type QueryAllQueuedActionRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPoliciesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllQueuedActionRequest) Reset()         { *m = QueryAllQueuedActionRequest{} }
+func (m *QueryAllQueuedActionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedActionRequest) ProtoMessage()    {}
+func (*QueryAllQueuedActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{8}
+}
+func (m *QueryAllQueuedActionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQueuedActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueuedActionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAllQueuedActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedActionRequest.Merge(m, src)
+}
+func (m *QueryAllQueuedActionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueuedActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedActionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllQueuedActionRequest proto.InternalMessageInfo
+
+func (m *QueryAllQueuedActionRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryAllQueuedActionResponse is the response type for the Query/QueuedActionAll RPC method.
+type QueryAllQueuedActionResponse struct {
+	QueuedActions []QueuedAction      `protobuf:"bytes,1,rep,name=queued_actions,json=queuedActions,proto3" json:"queued_actions"`
+	Pagination    *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetPoliciesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAllQueuedActionResponse) Reset()         { *m = QueryAllQueuedActionResponse{} }
+func (m *QueryAllQueuedActionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllQueuedActionResponse) ProtoMessage()    {}
+func (*QueryAllQueuedActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{9}
+}
+func (m *QueryAllQueuedActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllQueuedActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllQueuedActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryAllQueuedActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllQueuedActionResponse.Merge(m, src)
+}
+func (m *QueryAllQueuedActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllQueuedActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllQueuedActionResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryAllQueuedActionResponse proto.InternalMessageInfo
+
+func (m *QueryAllQueuedActionResponse) GetQueuedActions() []QueuedAction {
+	if m != nil {
+		return m.QueuedActions
+	}
+	return nil
 }
 
-func (m *QueryGetPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QueryAllQueuedActionResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuditLogRequest is the request type for the Query/AuditLog RPC method.
+type QueryAuditLogRequest struct {
+	// only return the entries of this signer if set
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// only return the entries of this message type URL if set
+	MsgTypeUrl string             `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogRequest) Reset()         { *m = QueryAuditLogRequest{} }
+func (m *QueryAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogRequest) ProtoMessage()    {}
+func (*QueryAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{10}
+}
+func (m *QueryAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogRequest.Merge(m, src)
+}
+func (m *QueryAuditLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogRequest proto.InternalMessageInfo
+
+func (m *QueryAuditLogRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *QueryAuditLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuditLogResponse is the response type for the Query/AuditLog RPC method.
+type QueryAuditLogResponse struct {
+	AuditEntries []AuditEntry        `protobuf:"bytes,1,rep,name=audit_entries,json=auditEntries,proto3" json:"audit_entries"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuditLogResponse) Reset()         { *m = QueryAuditLogResponse{} }
+func (m *QueryAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuditLogResponse) ProtoMessage()    {}
+func (*QueryAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{11}
+}
+func (m *QueryAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuditLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuditLogResponse.Merge(m, src)
+}
+func (m *QueryAuditLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryAuditLogResponse) GetAuditEntries() []AuditEntry {
+	if m != nil {
+		return m.AuditEntries
+	}
+	return nil
+}
+
+func (m *QueryAuditLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetThresholdProposalRequest is the request type for the Query/ThresholdProposal RPC method.
+type QueryGetThresholdProposalRequest struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryGetThresholdProposalRequest) Reset()         { *m = QueryGetThresholdProposalRequest{} }
+func (m *QueryGetThresholdProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetThresholdProposalRequest) ProtoMessage()    {}
+func (*QueryGetThresholdProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{12}
+}
+func (m *QueryGetThresholdProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetThresholdProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetThresholdProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetThresholdProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetThresholdProposalRequest.Merge(m, src)
+}
+func (m *QueryGetThresholdProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetThresholdProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetThresholdProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetThresholdProposalRequest proto.InternalMessageInfo
+
+func (m *QueryGetThresholdProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryGetThresholdProposalResponse is the response type for the Query/ThresholdProposal RPC method.
+type QueryGetThresholdProposalResponse struct {
+	ThresholdProposal ThresholdProposal `protobuf:"bytes,1,opt,name=threshold_proposal,json=thresholdProposal,proto3" json:"threshold_proposal"`
+}
+
+func (m *QueryGetThresholdProposalResponse) Reset()         { *m = QueryGetThresholdProposalResponse{} }
+func (m *QueryGetThresholdProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetThresholdProposalResponse) ProtoMessage()    {}
+func (*QueryGetThresholdProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{13}
+}
+func (m *QueryGetThresholdProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetThresholdProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetThresholdProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetThresholdProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetThresholdProposalResponse.Merge(m, src)
+}
+func (m *QueryGetThresholdProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetThresholdProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetThresholdProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetThresholdProposalResponse proto.InternalMessageInfo
+
+func (m *QueryGetThresholdProposalResponse) GetThresholdProposal() ThresholdProposal {
+	if m != nil {
+		return m.ThresholdProposal
+	}
+	return ThresholdProposal{}
+}
+
+// QueryAllThresholdProposalRequest is the request type for the Query/ThresholdProposalAll RPC method.
+type QueryAllThresholdProposalRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllThresholdProposalRequest) Reset()         { *m = QueryAllThresholdProposalRequest{} }
+func (m *QueryAllThresholdProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllThresholdProposalRequest) ProtoMessage()    {}
+func (*QueryAllThresholdProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{14}
+}
+func (m *QueryAllThresholdProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllThresholdProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllThresholdProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllThresholdProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllThresholdProposalRequest.Merge(m, src)
+}
+func (m *QueryAllThresholdProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllThresholdProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllThresholdProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllThresholdProposalRequest proto.InternalMessageInfo
+
+func (m *QueryAllThresholdProposalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllThresholdProposalResponse is the response type for the Query/ThresholdProposalAll RPC method.
+type QueryAllThresholdProposalResponse struct {
+	ThresholdProposals []ThresholdProposal `protobuf:"bytes,1,rep,name=threshold_proposals,json=thresholdProposals,proto3" json:"threshold_proposals"`
+	Pagination         *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllThresholdProposalResponse) Reset()         { *m = QueryAllThresholdProposalResponse{} }
+func (m *QueryAllThresholdProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllThresholdProposalResponse) ProtoMessage()    {}
+func (*QueryAllThresholdProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b64d9e7f9da035b5, []int{15}
+}
+func (m *QueryAllThresholdProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllThresholdProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllThresholdProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllThresholdProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllThresholdProposalResponse.Merge(m, src)
+}
+func (m *QueryAllThresholdProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllThresholdProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllThresholdProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllThresholdProposalResponse proto.InternalMessageInfo
+
+func (m *QueryAllThresholdProposalResponse) GetThresholdProposals() []ThresholdProposal {
+	if m != nil {
+		return m.ThresholdProposals
+	}
+	return nil
+}
+
+func (m *QueryAllThresholdProposalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryGetPoliciesRequest)(nil), "zetachain.zetacore.authority.QueryGetPoliciesRequest")
+	proto.RegisterType((*QueryGetPoliciesResponse)(nil), "zetachain.zetacore.authority.QueryGetPoliciesResponse")
+	proto.RegisterType((*QueryAuthorizationListRequest)(nil), "zetachain.zetacore.authority.QueryAuthorizationListRequest")
+	proto.RegisterType((*QueryAuthorizationListResponse)(nil), "zetachain.zetacore.authority.QueryAuthorizationListResponse")
+	proto.RegisterType((*QueryTimelockConfigRequest)(nil), "zetachain.zetacore.authority.QueryTimelockConfigRequest")
+	proto.RegisterType((*QueryTimelockConfigResponse)(nil), "zetachain.zetacore.authority.QueryTimelockConfigResponse")
+	proto.RegisterType((*QueryGetQueuedActionRequest)(nil), "zetachain.zetacore.authority.QueryGetQueuedActionRequest")
+	proto.RegisterType((*QueryGetQueuedActionResponse)(nil), "zetachain.zetacore.authority.QueryGetQueuedActionResponse")
+	proto.RegisterType((*QueryAllQueuedActionRequest)(nil), "zetachain.zetacore.authority.QueryAllQueuedActionRequest")
+	proto.RegisterType((*QueryAllQueuedActionResponse)(nil), "zetachain.zetacore.authority.QueryAllQueuedActionResponse")
+	proto.RegisterType((*QueryAuditLogRequest)(nil), "zetachain.zetacore.authority.QueryAuditLogRequest")
+	proto.RegisterType((*QueryAuditLogResponse)(nil), "zetachain.zetacore.authority.QueryAuditLogResponse")
+	proto.RegisterType((*QueryGetThresholdProposalRequest)(nil), "zetachain.zetacore.authority.QueryGetThresholdProposalRequest")
+	proto.RegisterType((*QueryGetThresholdProposalResponse)(nil), "zetachain.zetacore.authority.QueryGetThresholdProposalResponse")
+	proto.RegisterType((*QueryAllThresholdProposalRequest)(nil), "zetachain.zetacore.authority.QueryAllThresholdProposalRequest")
+	proto.RegisterType((*QueryAllThresholdProposalResponse)(nil), "zetachain.zetacore.authority.QueryAllThresholdProposalResponse")
+}
+
+func init() { proto.RegisterFile("authority/query.proto", fileDescriptor_b64d9e7f9da035b5) }
+
+var fileDescriptor_b64d9e7f9da035b5 = []byte{
+	// 1002 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x33, 0x6d, 0xa9, 0x92, 0x97, 0x1f, 0x55, 0x86, 0x14, 0xd2, 0x6d, 0xea, 0xa4, 0x0b,
+	0x4d, 0xa2, 0xd0, 0x7a, 0x9b, 0x54, 0x94, 0x36, 0xa0, 0x56, 0x6e, 0x05, 0xa1, 0xa8, 0x87, 0xd4,
+	0xa4, 0x42, 0x82, 0x83, 0xb5, 0xf1, 0x4e, 0xd7, 0x03, 0xeb, 0x9d, 0xcd, 0xee, 0x18, 0xe1, 0x54,
+	0xbd, 0x70, 0x40, 0xe2, 0x86, 0xc4, 0x85, 0x03, 0x17, 0xfe, 0x07, 0x7a, 0x43, 0x88, 0x63, 0xb9,
+	0x55, 0xa2, 0x07, 0x0e, 0x08, 0xa1, 0x84, 0x3f, 0xa4, 0xf2, 0xf8, 0x8d, 0xbd, 0xeb, 0x5d, 0x3b,
+	0xdb, 0x55, 0x6e, 0xdb, 0x79, 0xfe, 0xbe, 0xf9, 0x7c, 0x67, 0xde, 0xbc, 0xd7, 0xc0, 0x59, 0xbb,
+	0x25, 0x1b, 0x22, 0xe4, 0xb2, 0x6d, 0xed, 0xb5, 0x58, 0xd8, 0x2e, 0x07, 0xa1, 0x90, 0x82, 0x2e,
+	0xec, 0x33, 0x69, 0xd7, 0x1b, 0x36, 0xf7, 0xcb, 0xea, 0x4b, 0x84, 0xac, 0xdc, 0xfb, 0xa5, 0x11,
+	0x13, 0xd9, 0x2d, 0x87, 0xcb, 0xae, 0xc8, 0xb8, 0x10, 0x5f, 0x56, 0x5f, 0xfb, 0xb6, 0xe4, 0xc2,
+	0xc7, 0xf0, 0x7c, 0x3f, 0x1c, 0x08, 0x8f, 0xd7, 0x39, 0x8b, 0x30, 0x72, 0xae, 0x1f, 0x91, 0x8d,
+	0x90, 0x45, 0x0d, 0xe1, 0x39, 0x69, 0x91, 0xe4, 0x4d, 0xe6, 0x89, 0xfa, 0x57, 0x18, 0x59, 0xab,
+	0x8b, 0xa8, 0x29, 0x22, 0x6b, 0xd7, 0x8e, 0x58, 0x97, 0xdd, 0xfa, 0x7a, 0x7d, 0x97, 0x49, 0x7b,
+	0xdd, 0x0a, 0x6c, 0x97, 0xfb, 0xf1, 0xad, 0xe7, 0x5c, 0xe1, 0x0a, 0xf5, 0x69, 0x75, 0xbe, 0x70,
+	0x75, 0xc1, 0x15, 0xc2, 0xf5, 0x98, 0x65, 0x07, 0xdc, 0xb2, 0x7d, 0x5f, 0x48, 0x25, 0x41, 0x28,
+	0xf3, 0x1c, 0xbc, 0xf9, 0xa0, 0x93, 0x75, 0x8b, 0xc9, 0x6d, 0xc4, 0xad, 0xb2, 0xbd, 0x16, 0x8b,
+	0xa4, 0xe9, 0xc0, 0x7c, 0x3a, 0x14, 0x05, 0xc2, 0x8f, 0x18, 0xfd, 0x18, 0xc6, 0xb5, 0xbb, 0x79,
+	0xb2, 0x44, 0x56, 0x27, 0x37, 0x96, 0xcb, 0xa3, 0x0e, 0xb3, 0xac, 0x33, 0xdc, 0x39, 0xf5, 0xec,
+	0xdf, 0xc5, 0xb1, 0x6a, 0x4f, 0x6d, 0x2e, 0xc2, 0x05, 0xb5, 0x4b, 0x25, 0x7e, 0x96, 0xf7, 0x79,
+	0x24, 0x35, 0xc6, 0x77, 0x04, 0x4a, 0xc3, 0x7e, 0x81, 0x34, 0x0e, 0xd0, 0xc4, 0x55, 0xd4, 0x3c,
+	0x1e, 0x49, 0xe4, 0xb2, 0x46, 0x73, 0xa5, 0x92, 0x22, 0xe0, 0xac, 0x3d, 0x18, 0x30, 0x17, 0xc0,
+	0x50, 0x1c, 0x3b, 0x78, 0x43, 0x77, 0x85, 0xff, 0x88, 0xbb, 0x1a, 0x73, 0x1f, 0xce, 0x67, 0x46,
+	0x11, 0xf1, 0x0b, 0x38, 0xa3, 0x6f, 0xb6, 0x56, 0x57, 0x21, 0xe4, 0xbb, 0x3c, 0x9a, 0x2f, 0x99,
+	0x0e, 0xe1, 0x66, 0x64, 0x62, 0xd5, 0xdc, 0xc4, 0xbd, 0xb7, 0x98, 0x7c, 0xd0, 0x62, 0x2d, 0xe6,
+	0x54, 0xea, 0x1d, 0x6a, 0x44, 0xa3, 0xe7, 0x61, 0xc2, 0x56, 0x0b, 0x35, 0xee, 0xa8, 0x5d, 0x4f,
+	0x55, 0xc7, 0xbb, 0x0b, 0xf7, 0x1c, 0xb3, 0x05, 0x0b, 0xd9, 0x5a, 0x04, 0x7f, 0x08, 0xd3, 0x7b,
+	0x6a, 0xbd, 0xd6, 0x95, 0x20, 0xf6, 0xda, 0x68, 0xec, 0x78, 0x2a, 0x84, 0x9e, 0xda, 0x8b, 0xad,
+	0x99, 0x0c, 0x91, 0x2b, 0x9e, 0x97, 0x85, 0xfc, 0x11, 0x40, 0xbf, 0xbc, 0x7b, 0x15, 0xd6, 0x7d,
+	0x0b, 0xe5, 0xce, 0x5b, 0x28, 0x77, 0xdf, 0x31, 0xbe, 0x85, 0xf2, 0xb6, 0xed, 0x32, 0xd4, 0x56,
+	0x63, 0x4a, 0xf3, 0x0f, 0x02, 0x0b, 0xd9, 0xfb, 0xa0, 0xbd, 0xcf, 0x60, 0x26, 0x61, 0xaf, 0x53,
+	0xce, 0x27, 0x0b, 0xf9, 0x9b, 0x8e, 0xfb, 0x8b, 0xe8, 0x56, 0xc2, 0xc1, 0x09, 0xe5, 0x60, 0xe5,
+	0x48, 0x07, 0x5d, 0xaa, 0x84, 0x85, 0x9f, 0x08, 0xcc, 0x61, 0xfd, 0x3b, 0x5c, 0xde, 0x17, 0xba,
+	0xe2, 0xe8, 0x1b, 0x70, 0x3a, 0xe2, 0xae, 0xcf, 0x42, 0x75, 0x3e, 0x13, 0x55, 0xfc, 0x17, 0x5d,
+	0x82, 0xa9, 0x66, 0xe4, 0xd6, 0x64, 0x3b, 0x60, 0xb5, 0x56, 0xe8, 0xa9, 0xbd, 0x27, 0xaa, 0xd0,
+	0x8c, 0xdc, 0x9d, 0x76, 0xc0, 0x1e, 0x86, 0xde, 0xc0, 0xe9, 0x9e, 0x2c, 0x7c, 0xba, 0x4f, 0x09,
+	0x9c, 0x1d, 0x40, 0xc3, 0x63, 0xfd, 0x14, 0xa6, 0x55, 0xcf, 0xac, 0x31, 0x5f, 0x86, 0x9c, 0xe9,
+	0x53, 0x5d, 0x3d, 0xea, 0x31, 0x3a, 0x5c, 0x7e, 0xe8, 0xcb, 0xb0, 0xad, 0x6b, 0xc6, 0xd6, 0x2b,
+	0x9c, 0x1d, 0xe3, 0x91, 0xde, 0x85, 0x25, 0x5d, 0xf3, 0x3b, 0xba, 0x13, 0x6f, 0x87, 0x22, 0x10,
+	0x91, 0xed, 0xe9, 0xd3, 0x5d, 0x84, 0xc9, 0x00, 0x97, 0xfa, 0xcf, 0x06, 0xf4, 0xd2, 0x3d, 0xc7,
+	0xfc, 0x9e, 0xc0, 0xc5, 0x11, 0x59, 0xfa, 0xad, 0xa9, 0xd7, 0xec, 0x6b, 0x5a, 0x9d, 0xaf, 0x35,
+	0xa5, 0x92, 0xea, 0xd6, 0x24, 0x07, 0x03, 0xe6, 0x97, 0x68, 0xa8, 0xe2, 0x79, 0x43, 0x0d, 0x1d,
+	0xd7, 0x93, 0x7a, 0xa1, 0x7d, 0x67, 0x6f, 0x86, 0xbe, 0x1f, 0xc1, 0xeb, 0x69, 0xdf, 0xba, 0x0c,
+	0x0a, 0x1a, 0xa7, 0x29, 0xe3, 0xc7, 0x57, 0x13, 0x1b, 0xff, 0x4c, 0xc2, 0x6b, 0xca, 0x16, 0xfd,
+	0x85, 0xc0, 0xb8, 0x1e, 0x57, 0xf4, 0xdd, 0x23, 0xfb, 0x40, 0xd6, 0xec, 0x34, 0xae, 0xbf, 0xaa,
+	0xac, 0x4b, 0x64, 0x2e, 0x7f, 0xfb, 0xd7, 0xff, 0x3f, 0x9e, 0x58, 0xa2, 0x25, 0xab, 0xa3, 0xba,
+	0xa2, 0x12, 0x58, 0xe9, 0xff, 0x51, 0xd0, 0xdf, 0x08, 0xcc, 0xa6, 0x46, 0x17, 0x7d, 0x3f, 0xc7,
+	0xae, 0xc3, 0xe6, 0xac, 0xf1, 0x41, 0x31, 0x31, 0x82, 0x5f, 0x56, 0xe0, 0xcb, 0xf4, 0xed, 0x6c,
+	0xf0, 0xc4, 0x34, 0x8d, 0xe8, 0xaf, 0x04, 0x66, 0x92, 0x93, 0x8d, 0xde, 0xc8, 0xb1, 0x7d, 0xe6,
+	0xe4, 0x35, 0x6e, 0x16, 0x50, 0x22, 0xf5, 0x15, 0x45, 0xbd, 0x42, 0x2f, 0x65, 0x53, 0x0f, 0x4c,
+	0x6c, 0xfa, 0x3b, 0x81, 0xa9, 0x78, 0xe7, 0xa7, 0x37, 0xf3, 0x5d, 0x73, 0xc6, 0x84, 0x33, 0x36,
+	0x8b, 0x48, 0x11, 0xfb, 0x3d, 0x85, 0xbd, 0x4e, 0xad, 0x6c, 0xec, 0xc4, 0x40, 0xb3, 0x1e, 0xf7,
+	0x66, 0xff, 0x13, 0xfa, 0x94, 0xc0, 0x99, 0x78, 0xc6, 0x8a, 0xe7, 0xe5, 0xf2, 0x90, 0x3d, 0xa5,
+	0x8d, 0xcd, 0x22, 0x52, 0xf4, 0xf0, 0x8e, 0xf2, 0x70, 0x89, 0xbe, 0x95, 0xc3, 0x03, 0xfd, 0x99,
+	0xc0, 0xb8, 0x9e, 0x31, 0x74, 0x23, 0x57, 0xa1, 0x26, 0x66, 0xa5, 0x71, 0xed, 0x95, 0x34, 0x88,
+	0xb8, 0xa2, 0x10, 0x2f, 0xd2, 0xc5, 0x61, 0x35, 0xdd, 0x19, 0x70, 0x9e, 0x70, 0xe9, 0x0b, 0x02,
+	0xb3, 0xa9, 0xa6, 0x45, 0x6f, 0xe5, 0xbb, 0xe1, 0x61, 0x0d, 0xdb, 0xb8, 0x5d, 0x58, 0x8f, 0xfc,
+	0xb7, 0x14, 0xff, 0x0d, 0x7a, 0x7d, 0x48, 0x75, 0xa7, 0xfa, 0xb3, 0xf5, 0x38, 0x36, 0xf2, 0x9e,
+	0xd0, 0x3f, 0x09, 0xcc, 0xa5, 0xb2, 0x57, 0xbc, 0x7c, 0xce, 0x46, 0x8c, 0x22, 0xe3, 0x76, 0x61,
+	0x3d, 0x3a, 0xbb, 0xaa, 0x9c, 0xad, 0xd1, 0xd5, 0xbc, 0xce, 0xee, 0x7c, 0xf2, 0xec, 0xa0, 0x44,
+	0x9e, 0x1f, 0x94, 0xc8, 0x7f, 0x07, 0x25, 0xf2, 0xc3, 0x61, 0x69, 0xec, 0xf9, 0x61, 0x69, 0xec,
+	0xef, 0xc3, 0xd2, 0xd8, 0xe7, 0x57, 0x5d, 0x2e, 0x1b, 0xad, 0xdd, 0x72, 0x5d, 0x34, 0xe3, 0xd9,
+	0x34, 0x97, 0xf5, 0x4d, 0x3c, 0x71, 0x3b, 0x60, 0xd1, 0xee, 0x69, 0xf5, 0xa7, 0xd3, 0xb5, 0x97,
+	0x03, 0x00, 0xe7, 0xe1, 0x5f, 0x1d, 0x56, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Queries Policies
+	Policies(ctx context.Context, in *QueryGetPoliciesRequest, opts ...grpc.CallOption) (*QueryGetPoliciesResponse, error)
+	// Queries the authorizations of the messages
+	AuthorizationList(ctx context.Context, in *QueryAuthorizationListRequest, opts ...grpc.CallOption) (*QueryAuthorizationListResponse, error)
+	// Queries the messages that must be queued in the timelock
+	TimelockConfig(ctx context.Context, in *QueryTimelockConfigRequest, opts ...grpc.CallOption) (*QueryTimelockConfigResponse, error)
+	// Queries a queued action of the timelock by id
+	QueuedAction(ctx context.Context, in *QueryGetQueuedActionRequest, opts ...grpc.CallOption) (*QueryGetQueuedActionResponse, error)
+	// Queries all the queued actions of the timelock
+	QueuedActionAll(ctx context.Context, in *QueryAllQueuedActionRequest, opts ...grpc.CallOption) (*QueryAllQueuedActionResponse, error)
+	// Queries the audit log of the messages that passed an authority check, optionally filtered by signer and message type
+	AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error)
+	// Queries a proposal of a threshold policy by id
+	ThresholdProposal(ctx context.Context, in *QueryGetThresholdProposalRequest, opts ...grpc.CallOption) (*QueryGetThresholdProposalResponse, error)
+	// Queries all the pending proposals of the threshold policies
+	ThresholdProposalAll(ctx context.Context, in *QueryAllThresholdProposalRequest, opts ...grpc.CallOption) (*QueryAllThresholdProposalResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Policies(ctx context.Context, in *QueryGetPoliciesRequest, opts ...grpc.CallOption) (*QueryGetPoliciesResponse, error) {
+	out := new(QueryGetPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/Policies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuthorizationList(ctx context.Context, in *QueryAuthorizationListRequest, opts ...grpc.CallOption) (*QueryAuthorizationListResponse, error) {
+	out := new(QueryAuthorizationListResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/AuthorizationList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimelockConfig(ctx context.Context, in *QueryTimelockConfigRequest, opts ...grpc.CallOption) (*QueryTimelockConfigResponse, error) {
+	out := new(QueryTimelockConfigResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/TimelockConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedAction(ctx context.Context, in *QueryGetQueuedActionRequest, opts ...grpc.CallOption) (*QueryGetQueuedActionResponse, error) {
+	out := new(QueryGetQueuedActionResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/QueuedAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedActionAll(ctx context.Context, in *QueryAllQueuedActionRequest, opts ...grpc.CallOption) (*QueryAllQueuedActionResponse, error) {
+	out := new(QueryAllQueuedActionResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/QueuedActionAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuditLog(ctx context.Context, in *QueryAuditLogRequest, opts ...grpc.CallOption) (*QueryAuditLogResponse, error) {
+	out := new(QueryAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/AuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ThresholdProposal(ctx context.Context, in *QueryGetThresholdProposalRequest, opts ...grpc.CallOption) (*QueryGetThresholdProposalResponse, error) {
+	out := new(QueryGetThresholdProposalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/ThresholdProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ThresholdProposalAll(ctx context.Context, in *QueryAllThresholdProposalRequest, opts ...grpc.CallOption) (*QueryAllThresholdProposalResponse, error) {
+	out := new(QueryAllThresholdProposalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Query/ThresholdProposalAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Queries Policies
+	Policies(context.Context, *QueryGetPoliciesRequest) (*QueryGetPoliciesResponse, error)
+	// Queries the authorizations of the messages
+	AuthorizationList(context.Context, *QueryAuthorizationListRequest) (*QueryAuthorizationListResponse, error)
+	// Queries the messages that must be queued in the timelock
+	TimelockConfig(context.Context, *QueryTimelockConfigRequest) (*QueryTimelockConfigResponse, error)
+	// Queries a queued action of the timelock by id
+	QueuedAction(context.Context, *QueryGetQueuedActionRequest) (*QueryGetQueuedActionResponse, error)
+	// Queries all the queued actions of the timelock
+	QueuedActionAll(context.Context, *QueryAllQueuedActionRequest) (*QueryAllQueuedActionResponse, error)
+	// Queries the audit log of the messages that passed an authority check, optionally filtered by signer and message type
+	AuditLog(context.Context, *QueryAuditLogRequest) (*QueryAuditLogResponse, error)
+	// Queries a proposal of a threshold policy by id
+	ThresholdProposal(context.Context, *QueryGetThresholdProposalRequest) (*QueryGetThresholdProposalResponse, error)
+	// Queries all the pending proposals of the threshold policies
+	ThresholdProposalAll(context.Context, *QueryAllThresholdProposalRequest) (*QueryAllThresholdProposalResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Policies(ctx context.Context, req *QueryGetPoliciesRequest) (*QueryGetPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Policies not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizationList(ctx context.Context, req *QueryAuthorizationListRequest) (*QueryAuthorizationListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizationList not implemented")
+}
+func (*UnimplementedQueryServer) TimelockConfig(ctx context.Context, req *QueryTimelockConfigRequest) (*QueryTimelockConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimelockConfig not implemented")
+}
+func (*UnimplementedQueryServer) QueuedAction(ctx context.Context, req *QueryGetQueuedActionRequest) (*QueryGetQueuedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedAction not implemented")
+}
+func (*UnimplementedQueryServer) QueuedActionAll(ctx context.Context, req *QueryAllQueuedActionRequest) (*QueryAllQueuedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedActionAll not implemented")
+}
+func (*UnimplementedQueryServer) AuditLog(ctx context.Context, req *QueryAuditLogRequest) (*QueryAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLog not implemented")
+}
+func (*UnimplementedQueryServer) ThresholdProposal(ctx context.Context, req *QueryGetThresholdProposalRequest) (*QueryGetThresholdProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThresholdProposal not implemented")
+}
+func (*UnimplementedQueryServer) ThresholdProposalAll(ctx context.Context, req *QueryAllThresholdProposalRequest) (*QueryAllThresholdProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThresholdProposalAll not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Policies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Policies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/Policies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Policies(ctx, req.(*QueryGetPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuthorizationList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthorizationListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizationList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/AuthorizationList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizationList(ctx, req.(*QueryAuthorizationListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimelockConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimelockConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimelockConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/TimelockConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimelockConfig(ctx, req.(*QueryTimelockConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetQueuedActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/QueuedAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedAction(ctx, req.(*QueryGetQueuedActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedActionAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllQueuedActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedActionAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/QueuedActionAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedActionAll(ctx, req.(*QueryAllQueuedActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/AuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuditLog(ctx, req.(*QueryAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ThresholdProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetThresholdProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ThresholdProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/ThresholdProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ThresholdProposal(ctx, req.(*QueryGetThresholdProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ThresholdProposalAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllThresholdProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ThresholdProposalAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Query/ThresholdProposalAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ThresholdProposalAll(ctx, req.(*QueryAllThresholdProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.authority.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Policies",
+			Handler:    _Query_Policies_Handler,
+		},
+		{
+			MethodName: "AuthorizationList",
+			Handler:    _Query_AuthorizationList_Handler,
+		},
+		{
+			MethodName: "TimelockConfig",
+			Handler:    _Query_TimelockConfig_Handler,
+		},
+		{
+			MethodName: "QueuedAction",
+			Handler:    _Query_QueuedAction_Handler,
+		},
+		{
+			MethodName: "QueuedActionAll",
+			Handler:    _Query_QueuedActionAll_Handler,
+		},
+		{
+			MethodName: "AuditLog",
+			Handler:    _Query_AuditLog_Handler,
+		},
+		{
+			MethodName: "ThresholdProposal",
+			Handler:    _Query_ThresholdProposal_Handler,
+		},
+		{
+			MethodName: "ThresholdProposalAll",
+			Handler:    _Query_ThresholdProposalAll_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "authority/query.proto",
+}
+
+func (m *QueryGetPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizationListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizationListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizationListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuthorizationListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuthorizationListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizationListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorizationList.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimelockConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimelockConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimelockConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TimelockConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQueuedActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQueuedActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQueuedActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetQueuedActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetQueuedActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetQueuedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedAction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueuedActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueuedActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueuedActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllQueuedActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllQueuedActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllQueuedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedActions) > 0 {
+		for iNdEx := len(m.QueuedActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuditLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AuditEntries) > 0 {
+		for iNdEx := len(m.AuditEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetThresholdProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetThresholdProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetThresholdProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetThresholdProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetThresholdProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetThresholdProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ThresholdProposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllThresholdProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllThresholdProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllThresholdProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllThresholdProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllThresholdProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllThresholdProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ThresholdProposals) > 0 {
+		for iNdEx := len(m.ThresholdProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ThresholdProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policies.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuthorizationListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuthorizationListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorizationList.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTimelockConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTimelockConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TimelockConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetQueuedActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		n += 1 + sovQuery(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *QueryGetQueuedActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QueuedAction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllQueuedActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllQueuedActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedActions) > 0 {
+		for _, e := range m.QueuedActions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuditLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuditLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AuditEntries) > 0 {
+		for _, e := range m.AuditEntries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetThresholdProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryGetThresholdProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ThresholdProposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllThresholdProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllThresholdProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ThresholdProposals) > 0 {
+		for _, e := range m.ThresholdProposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetPoliciesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoliciesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizationListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizationListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizationListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizationListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizationListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizationListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorizationList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimelockConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimelockConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimelockConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimelockConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetQueuedActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetQueuedActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetQueuedActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetQueuedActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetQueuedActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetQueuedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedAction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueuedActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueuedActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueuedActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllQueuedActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllQueuedActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllQueuedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedActions = append(m.QueuedActions, QueuedAction{})
+			if err := m.QueuedActions[len(m.QueuedActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuditLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditEntries = append(m.AuditEntries, AuditEntry{})
+			if err := m.AuditEntries[len(m.AuditEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryGetPoliciesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetThresholdProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetThresholdProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetThresholdProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryGetPoliciesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Policies.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryGetThresholdProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetThresholdProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetThresholdProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdProposal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ThresholdProposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetPoliciesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllThresholdProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -342,12 +3190,48 @@ func (m *QueryGetPoliciesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoliciesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllThresholdProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllThresholdProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -369,7 +3253,7 @@ func (m *QueryGetPoliciesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPoliciesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllThresholdProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -392,15 +3276,15 @@ func (m *QueryGetPoliciesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPoliciesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllThresholdProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllThresholdProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdProposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -427,7 +3311,44 @@ func (m *QueryGetPoliciesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ThresholdProposals = append(m.ThresholdProposals, ThresholdProposal{})
+			if err := m.ThresholdProposals[len(m.ThresholdProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ codectypes.UnpackInterfacesMessage = ThresholdProposal{}
+
+// NewThresholdProposal returns a new proposal of a threshold policy approved by its proposer
+func NewThresholdProposal(
+	id uint64,
+	policyType PolicyType,
+	proposer string,
+	msg sdk.Msg,
+	submitHeight, expiryHeight int64,
+) (ThresholdProposal, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return ThresholdProposal{}, err
+	}
+	return ThresholdProposal{
+		Id:           id,
+		PolicyType:   policyType,
+		Proposer:     proposer,
+		Msg:          anyMsg,
+		Approvals:    []string{proposer},
+		SubmitHeight: submitHeight,
+		ExpiryHeight: expiryHeight,
+	}, nil
+}
+
+// GetProposedMsg returns the message of the proposal
+func (p ThresholdProposal) GetProposedMsg() (sdk.Msg, error) {
+	if p.Msg == nil {
+		return nil, errors.New("missing message")
+	}
+	msg, ok := p.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("message %s is not unpacked", p.Msg.TypeUrl)
+	}
+	return msg, nil
+}
+
+// GetMsgURL returns the type URL of the message of the proposal
+func (p ThresholdProposal) GetMsgURL() string {
+	if p.Msg == nil {
+		return ""
+	}
+	return p.Msg.TypeUrl
+}
+
+// HasApproved returns true if the address approved the proposal
+func (p ThresholdProposal) HasApproved(address string) bool {
+	for _, approval := range p.Approvals {
+		if approval == address {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate performs basic validation of the proposal
+func (p ThresholdProposal) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Proposer); err != nil {
+		return fmt.Errorf("invalid proposer address: %s", err)
+	}
+	if _, err := p.GetProposedMsg(); err != nil {
+		return fmt.Errorf("invalid threshold proposal %d: %s", p.Id, err)
+	}
+	approvals := make(map[string]bool)
+	for _, approval := range p.Approvals {
+		if approvals[approval] {
+			return fmt.Errorf("duplicate approval %s for threshold proposal %d", approval, p.Id)
+		}
+		approvals[approval] = true
+	}
+	if p.ExpiryHeight < p.SubmitHeight {
+		return fmt.Errorf("expiry height %d is before submit height %d", p.ExpiryHeight, p.SubmitHeight)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (p ThresholdProposal) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(p.Msg, &msg)
+}
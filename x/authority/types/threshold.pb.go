@@ -0,0 +1,587 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: authority/threshold.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ThresholdProposal is a message of a threshold policy waiting for the approval of the policy members
+// the message is executed with the policy address as signer once the threshold of approvals is reached
+type ThresholdProposal struct {
+	Id         uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	PolicyType PolicyType `protobuf:"varint,2,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.authority.PolicyType" json:"policy_type,omitempty"`
+	// member that submitted the proposal
+	Proposer string     `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Msg      *types.Any `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	// members that approved the proposal, including the proposer
+	Approvals    []string `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	SubmitHeight int64    `protobuf:"varint,6,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// last height at which the proposal can be approved
+	ExpiryHeight int64 `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *ThresholdProposal) Reset()         { *m = ThresholdProposal{} }
+func (m *ThresholdProposal) String() string { return proto.CompactTextString(m) }
+func (*ThresholdProposal) ProtoMessage()    {}
+func (*ThresholdProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a00e00b13e470a1d, []int{0}
+}
+func (m *ThresholdProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThresholdProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThresholdProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThresholdProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThresholdProposal.Merge(m, src)
+}
+func (m *ThresholdProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThresholdProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThresholdProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThresholdProposal proto.InternalMessageInfo
+
+func (m *ThresholdProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ThresholdProposal) GetPolicyType() PolicyType {
+	if m != nil {
+		return m.PolicyType
+	}
+	return PolicyType_groupEmergency
+}
+
+func (m *ThresholdProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *ThresholdProposal) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *ThresholdProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *ThresholdProposal) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *ThresholdProposal) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ThresholdProposal)(nil), "zetachain.zetacore.authority.ThresholdProposal")
+}
+
+func init() { proto.RegisterFile("authority/threshold.proto", fileDescriptor_a00e00b13e470a1d) }
+
+var fileDescriptor_a00e00b13e470a1d = []byte{
+	// 336 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0xeb, 0xa4, 0xb7, 0xf7, 0xd6, 0xbd, 0x54, 0x22, 0x62, 0x48, 0xab, 0x2a, 0x8a, 0x40,
+	0x42, 0x59, 0xb0, 0x51, 0x79, 0x02, 0x98, 0x80, 0xa9, 0x8a, 0x3a, 0xb1, 0x54, 0x4e, 0x6b, 0x62,
+	0x4b, 0x69, 0x6d, 0xd9, 0x0e, 0xaa, 0x79, 0x0a, 0x66, 0x9e, 0x88, 0xb1, 0x23, 0x23, 0x6a, 0x5f,
+	0x04, 0xd5, 0x56, 0x5a, 0x26, 0xb6, 0x73, 0xfe, 0x7c, 0x7f, 0xce, 0x7f, 0x8e, 0xe1, 0x80, 0xd4,
+	0x86, 0x09, 0xc5, 0x8d, 0xc5, 0x86, 0x29, 0xaa, 0x99, 0xa8, 0x16, 0x48, 0x2a, 0x61, 0x44, 0x34,
+	0x7a, 0xa5, 0x86, 0xcc, 0x19, 0xe1, 0x2b, 0xe4, 0x2a, 0xa1, 0x28, 0x3a, 0xd0, 0xc3, 0xf8, 0x68,
+	0x94, 0xa2, 0xe2, 0x73, 0x4e, 0xb5, 0xf7, 0x0d, 0x07, 0xa5, 0x10, 0x65, 0x45, 0xb1, 0xeb, 0x8a,
+	0xfa, 0x19, 0x93, 0x95, 0xf5, 0x9f, 0xce, 0xdf, 0x03, 0x78, 0x3a, 0x6d, 0xc6, 0x4c, 0x94, 0x90,
+	0x42, 0x93, 0x2a, 0xea, 0xc3, 0x80, 0x2f, 0x62, 0x90, 0x82, 0xac, 0x9d, 0x07, 0x7c, 0x11, 0x3d,
+	0xc0, 0x9e, 0xfb, 0xa5, 0x9d, 0x19, 0x2b, 0x69, 0x1c, 0xa4, 0x20, 0xeb, 0x8f, 0x33, 0xf4, 0x5b,
+	0x1c, 0x34, 0x71, 0x86, 0xa9, 0x95, 0x34, 0x87, 0xf2, 0x50, 0x47, 0x43, 0xf8, 0x4f, 0xba, 0x31,
+	0x54, 0xc5, 0x61, 0x0a, 0xb2, 0x6e, 0x7e, 0xe8, 0xa3, 0x4b, 0x18, 0x2e, 0x75, 0x19, 0xb7, 0x53,
+	0x90, 0xf5, 0xc6, 0x67, 0xc8, 0xa7, 0x46, 0x4d, 0x6a, 0x74, 0xbb, 0xb2, 0xf9, 0x1e, 0x88, 0x46,
+	0xb0, 0x4b, 0xa4, 0x54, 0xe2, 0x85, 0x54, 0x3a, 0xfe, 0x93, 0x86, 0x59, 0x37, 0x3f, 0x0a, 0xd1,
+	0x05, 0x3c, 0xd1, 0x75, 0xb1, 0xe4, 0x66, 0xc6, 0x28, 0x2f, 0x99, 0x89, 0x3b, 0x29, 0xc8, 0xc2,
+	0xfc, 0xbf, 0x17, 0xef, 0x9d, 0xb6, 0x87, 0xe8, 0x5a, 0x72, 0x65, 0x1b, 0xe8, 0xaf, 0x87, 0xbc,
+	0xe8, 0xa1, 0xbb, 0xc7, 0x8f, 0x6d, 0x02, 0x36, 0xdb, 0x04, 0x7c, 0x6d, 0x13, 0xf0, 0xb6, 0x4b,
+	0x5a, 0x9b, 0x5d, 0xd2, 0xfa, 0xdc, 0x25, 0xad, 0xa7, 0xeb, 0x92, 0x1b, 0x56, 0x17, 0x68, 0x2e,
+	0x96, 0x78, 0xbf, 0xfb, 0x95, 0x3b, 0x03, 0x6e, 0xce, 0x80, 0xd7, 0xf8, 0xc7, 0x2b, 0x5a, 0x49,
+	0x75, 0xd1, 0x71, 0x6b, 0xdc, 0x7c, 0x0f, 0x00, 0x6e, 0x2e, 0xc2, 0xb8, 0xdf, 0x01, 0x00, 0x00,
+}
+
+func (m *ThresholdProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThresholdProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThresholdProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintThreshold(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintThreshold(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintThreshold(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintThreshold(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintThreshold(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PolicyType != 0 {
+		i = encodeVarintThreshold(dAtA, i, uint64(m.PolicyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintThreshold(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintThreshold(dAtA []byte, offset int, v uint64) int {
+	offset -= sovThreshold(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ThresholdProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovThreshold(uint64(m.Id))
+	}
+	if m.PolicyType != 0 {
+		n += 1 + sovThreshold(uint64(m.PolicyType))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovThreshold(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovThreshold(uint64(l))
+		}
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovThreshold(uint64(m.SubmitHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovThreshold(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovThreshold(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozThreshold(x uint64) (n int) {
+	return sovThreshold(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ThresholdProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ThresholdProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ThresholdProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			m.PolicyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyType |= PolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipThreshold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthThreshold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipThreshold(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowThreshold
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowThreshold
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthThreshold
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupThreshold
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthThreshold
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthThreshold        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowThreshold          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupThreshold = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,192 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestNewThresholdProposal(t *testing.T) {
+	proposer := sample.AccAddress()
+	policyAddress := types.ThresholdPolicyAddress(types.PolicyType_groupAdmin).String()
+	msg := fungibletypes.NewMsgUpdateSystemContract(policyAddress, sample.EthAddress().Hex())
+
+	proposal, err := types.NewThresholdProposal(1, types.PolicyType_groupAdmin, proposer, msg, 100, 200)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, proposal.Id)
+	require.Equal(t, types.PolicyType_groupAdmin, proposal.PolicyType)
+	require.Equal(t, proposer, proposal.Proposer)
+	require.EqualValues(t, 100, proposal.SubmitHeight)
+	require.EqualValues(t, 200, proposal.ExpiryHeight)
+	require.Equal(t, "/zetachain.zetacore.fungible.MsgUpdateSystemContract", proposal.GetMsgURL())
+
+	// the proposer approves the proposal
+	require.Equal(t, []string{proposer}, proposal.Approvals)
+	require.True(t, proposal.HasApproved(proposer))
+	require.False(t, proposal.HasApproved(sample.AccAddress()))
+
+	proposedMsg, err := proposal.GetProposedMsg()
+	require.NoError(t, err)
+	require.Equal(t, msg, proposedMsg)
+}
+
+func TestThresholdProposal_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		proposal    func(types.ThresholdProposal) types.ThresholdProposal
+		errContains string
+	}{
+		{
+			name:     "valid proposal",
+			proposal: func(p types.ThresholdProposal) types.ThresholdProposal { return p },
+		},
+		{
+			name: "invalid proposer",
+			proposal: func(p types.ThresholdProposal) types.ThresholdProposal {
+				p.Proposer = "invalid"
+				return p
+			},
+			errContains: "invalid proposer address",
+		},
+		{
+			name: "missing message",
+			proposal: func(p types.ThresholdProposal) types.ThresholdProposal {
+				p.Msg = nil
+				return p
+			},
+			errContains: "missing message",
+		},
+		{
+			name: "duplicate approval",
+			proposal: func(p types.ThresholdProposal) types.ThresholdProposal {
+				p.Approvals = append(p.Approvals, p.Proposer)
+				return p
+			},
+			errContains: "duplicate approval",
+		},
+		{
+			name: "expiry before submission",
+			proposal: func(p types.ThresholdProposal) types.ThresholdProposal {
+				p.ExpiryHeight = p.SubmitHeight - 1
+				return p
+			},
+			errContains: "is before submit height",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.proposal(sample.ThresholdProposal(t, 1, 1000)).Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSubmitThresholdProposal_ValidateBasic(t *testing.T) {
+	signer := sample.AccAddress()
+	policyAddress := types.ThresholdPolicyAddress(types.PolicyType_groupAdmin).String()
+
+	newMsg := func(signer string, policyType types.PolicyType, proposedMsg sdk.Msg) *types.MsgSubmitThresholdProposal {
+		msg, err := types.NewMsgSubmitThresholdProposal(signer, policyType, proposedMsg)
+		require.NoError(t, err)
+		return msg
+	}
+
+	tests := []struct {
+		name string
+		msg  *types.MsgSubmitThresholdProposal
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: newMsg(
+				signer,
+				types.PolicyType_groupAdmin,
+				fungibletypes.NewMsgUpdateSystemContract(policyAddress, sample.EthAddress().Hex()),
+			),
+		},
+		{
+			name: "invalid signer address",
+			msg: newMsg(
+				"invalid",
+				types.PolicyType_groupAdmin,
+				fungibletypes.NewMsgUpdateSystemContract(policyAddress, sample.EthAddress().Hex()),
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid policy type",
+			msg: newMsg(
+				signer,
+				types.PolicyType(1000),
+				fungibletypes.NewMsgUpdateSystemContract(policyAddress, sample.EthAddress().Hex()),
+			),
+			err: types.ErrInvalidThresholdProposal,
+		},
+		{
+			name: "address policy type",
+			msg: newMsg(
+				signer,
+				types.PolicyType_address,
+				fungibletypes.NewMsgUpdateSystemContract(policyAddress, sample.EthAddress().Hex()),
+			),
+			err: types.ErrInvalidThresholdProposal,
+		},
+		{
+			name: "missing message",
+			msg:  &types.MsgSubmitThresholdProposal{Signer: signer},
+			err:  types.ErrInvalidThresholdProposal,
+		},
+		{
+			name: "signer of the message is not the policy address",
+			msg: newMsg(
+				signer,
+				types.PolicyType_groupAdmin,
+				fungibletypes.NewMsgUpdateSystemContract(signer, sample.EthAddress().Hex()),
+			),
+			err: types.ErrInvalidThresholdProposal,
+		},
+		{
+			name: "signer of the message is the address of another policy",
+			msg: newMsg(
+				signer,
+				types.PolicyType_groupEmergency,
+				fungibletypes.NewMsgUpdateSystemContract(policyAddress, sample.EthAddress().Hex()),
+			),
+			err: types.ErrInvalidThresholdProposal,
+		},
+		{
+			name: "invalid message",
+			msg: newMsg(
+				signer,
+				types.PolicyType_groupAdmin,
+				fungibletypes.NewMsgUpdateSystemContract(policyAddress, "invalid"),
+			),
+			err: types.ErrInvalidThresholdProposal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgApproveThresholdProposal_ValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewMsgApproveThresholdProposal(sample.AccAddress(), 1).ValidateBasic())
+	require.ErrorIs(t, types.NewMsgApproveThresholdProposal("invalid", 1).ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultTimelockDelayBlocks is the default number of blocks a timelocked message is queued before its execution
+// it is about one day with 6 seconds blocks
+const DefaultTimelockDelayBlocks = 14400
+
+// defaultTimelockedMsgURLs contains the messages timelocked by default, their execution changes the contracts or
+// the funds integrators rely on
+var defaultTimelockedMsgURLs = []string{
+	"/zetachain.zetacore.fungible.MsgUpdateContractBytecode",
+	"/zetachain.zetacore.fungible.MsgUpdateSystemContract",
+	"/zetachain.zetacore.crosschain.MsgMigrateTssFunds",
+	"/zetachain.zetacore.crosschain.MsgUpdateTssAddress",
+}
+
+var _ codectypes.UnpackInterfacesMessage = QueuedAction{}
+
+// DefaultTimelockConfig returns the default timelock config, the default timelocked messages are queued for
+// DefaultTimelockDelayBlocks blocks
+func DefaultTimelockConfig() TimelockConfig {
+	messages := make([]TimelockedMessage, len(defaultTimelockedMsgURLs))
+	for i, msgURL := range defaultTimelockedMsgURLs {
+		messages[i] = TimelockedMessage{
+			MsgUrl:      msgURL,
+			DelayBlocks: DefaultTimelockDelayBlocks,
+		}
+	}
+	return TimelockConfig{Messages: messages}
+}
+
+// Validate performs basic validation of the timelock config and ensures each message is timelocked once
+func (c TimelockConfig) Validate() error {
+	for i, message := range c.Messages {
+		if !strings.HasPrefix(message.MsgUrl, "/") {
+			return fmt.Errorf("invalid message url: %s", message.MsgUrl)
+		}
+		if message.DelayBlocks <= 0 {
+			return fmt.Errorf("invalid delay for message %s: %d", message.MsgUrl, message.DelayBlocks)
+		}
+		for _, other := range c.Messages[:i] {
+			if message.MsgUrl == other.MsgUrl {
+				return fmt.Errorf("duplicate timelocked message %s", message.MsgUrl)
+			}
+		}
+	}
+	return nil
+}
+
+// GetDelayBlocks returns the delay of a message, false is returned if the message is not timelocked
+func (c TimelockConfig) GetDelayBlocks(msgURL string) (int64, bool) {
+	for _, message := range c.Messages {
+		if message.MsgUrl == msgURL {
+			return message.DelayBlocks, true
+		}
+	}
+	return 0, false
+}
+
+// NewQueuedAction returns a new queued action for a message
+func NewQueuedAction(id uint64, signer string, msg sdk.Msg, submitHeight, executionHeight int64) (QueuedAction, error) {
+	anyMsg, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return QueuedAction{}, err
+	}
+	return QueuedAction{
+		Id:              id,
+		Signer:          signer,
+		Msg:             anyMsg,
+		SubmitHeight:    submitHeight,
+		ExecutionHeight: executionHeight,
+	}, nil
+}
+
+// GetTimelockedMsg returns the message of the queued action
+func (a QueuedAction) GetTimelockedMsg() (sdk.Msg, error) {
+	if a.Msg == nil {
+		return nil, errors.New("missing message")
+	}
+	msg, ok := a.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("message %s is not unpacked", a.Msg.TypeUrl)
+	}
+	return msg, nil
+}
+
+// GetMsgURL returns the type URL of the message of the queued action
+func (a QueuedAction) GetMsgURL() string {
+	if a.Msg == nil {
+		return ""
+	}
+	return a.Msg.TypeUrl
+}
+
+// Validate performs basic validation of the queued action
+func (a QueuedAction) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(a.Signer); err != nil {
+		return fmt.Errorf("invalid signer address: %s", err)
+	}
+	if _, err := a.GetTimelockedMsg(); err != nil {
+		return fmt.Errorf("invalid queued action %d: %s", a.Id, err)
+	}
+	if a.ExecutionHeight < a.SubmitHeight {
+		return fmt.Errorf("execution height %d is before submit height %d", a.ExecutionHeight, a.SubmitHeight)
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a QueuedAction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(a.Msg, &msg)
+}
@@ -0,0 +1,890 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: authority/timelock.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TimelockedMessage sets the number of blocks a message is queued in the timelock before its execution
+type TimelockedMessage struct {
+	// type URL of the message, e.g. /zetachain.zetacore.fungible.MsgUpdateSystemContract
+	MsgUrl string `protobuf:"bytes,1,opt,name=msg_url,json=msgUrl,proto3" json:"msg_url,omitempty"`
+	// number of blocks between the submission and the execution of the message
+	DelayBlocks int64 `protobuf:"varint,2,opt,name=delay_blocks,json=delayBlocks,proto3" json:"delay_blocks,omitempty"`
+}
+
+func (m *TimelockedMessage) Reset()         { *m = TimelockedMessage{} }
+func (m *TimelockedMessage) String() string { return proto.CompactTextString(m) }
+func (*TimelockedMessage) ProtoMessage()    {}
+func (*TimelockedMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85c921e1bc496fcd, []int{0}
+}
+func (m *TimelockedMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockedMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockedMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockedMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockedMessage.Merge(m, src)
+}
+func (m *TimelockedMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockedMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockedMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockedMessage proto.InternalMessageInfo
+
+func (m *TimelockedMessage) GetMsgUrl() string {
+	if m != nil {
+		return m.MsgUrl
+	}
+	return ""
+}
+
+func (m *TimelockedMessage) GetDelayBlocks() int64 {
+	if m != nil {
+		return m.DelayBlocks
+	}
+	return 0
+}
+
+// TimelockConfig contains the messages that must be queued in the timelock before their execution
+type TimelockConfig struct {
+	Messages []TimelockedMessage `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+}
+
+func (m *TimelockConfig) Reset()         { *m = TimelockConfig{} }
+func (m *TimelockConfig) String() string { return proto.CompactTextString(m) }
+func (*TimelockConfig) ProtoMessage()    {}
+func (*TimelockConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85c921e1bc496fcd, []int{1}
+}
+func (m *TimelockConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelockConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelockConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelockConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockConfig.Merge(m, src)
+}
+func (m *TimelockConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelockConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelockConfig proto.InternalMessageInfo
+
+func (m *TimelockConfig) GetMessages() []TimelockedMessage {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+// QueuedAction is a message queued in the timelock
+// the message is executed at the execution height unless it is cancelled by the emergency group
+type QueuedAction struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address that submitted the message, the message is executed with this address as signer
+	Signer          string     `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Msg             *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+	SubmitHeight    int64      `protobuf:"varint,4,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	ExecutionHeight int64      `protobuf:"varint,5,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+}
+
+func (m *QueuedAction) Reset()         { *m = QueuedAction{} }
+func (m *QueuedAction) String() string { return proto.CompactTextString(m) }
+func (*QueuedAction) ProtoMessage()    {}
+func (*QueuedAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_85c921e1bc496fcd, []int{2}
+}
+func (m *QueuedAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedAction.Merge(m, src)
+}
+func (m *QueuedAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedAction proto.InternalMessageInfo
+
+func (m *QueuedAction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedAction) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QueuedAction) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+func (m *QueuedAction) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *QueuedAction) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TimelockedMessage)(nil), "zetachain.zetacore.authority.TimelockedMessage")
+	proto.RegisterType((*TimelockConfig)(nil), "zetachain.zetacore.authority.TimelockConfig")
+	proto.RegisterType((*QueuedAction)(nil), "zetachain.zetacore.authority.QueuedAction")
+}
+
+func init() { proto.RegisterFile("authority/timelock.proto", fileDescriptor_85c921e1bc496fcd) }
+
+var fileDescriptor_85c921e1bc496fcd = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xdf, 0x8a, 0xd3, 0x40,
+	0x14, 0xc6, 0x33, 0x4d, 0xad, 0x76, 0x5a, 0xab, 0x0e, 0x45, 0x63, 0x91, 0x18, 0x2b, 0x48, 0xbc,
+	0x70, 0x46, 0xea, 0x13, 0xb4, 0xde, 0x88, 0x20, 0xd2, 0xa0, 0x37, 0xde, 0x94, 0xfc, 0x99, 0x4e,
+	0x06, 0x93, 0x4c, 0xc9, 0xcc, 0x40, 0xb3, 0x4f, 0xb1, 0x4f, 0xb2, 0xcf, 0xd1, 0xcb, 0x5e, 0xee,
+	0xd5, 0xb2, 0xb4, 0x2f, 0xb2, 0x64, 0xd2, 0x94, 0x85, 0x85, 0xbd, 0x3b, 0xe7, 0xcb, 0xef, 0x9c,
+	0x7c, 0xe7, 0x63, 0xa0, 0x13, 0x6a, 0x95, 0x8a, 0x92, 0xab, 0x8a, 0x28, 0x9e, 0xd3, 0x4c, 0xc4,
+	0xff, 0xf1, 0xa6, 0x14, 0x4a, 0xa0, 0x77, 0x17, 0x54, 0x85, 0x71, 0x1a, 0xf2, 0x02, 0x9b, 0x4a,
+	0x94, 0x14, 0x9f, 0xe1, 0xc9, 0x98, 0x09, 0x26, 0x0c, 0x48, 0xea, 0xaa, 0x99, 0x99, 0xbc, 0x65,
+	0x42, 0xb0, 0x8c, 0x12, 0xd3, 0x45, 0x7a, 0x4d, 0xc2, 0xa2, 0x6a, 0x3e, 0x4d, 0x7f, 0xc3, 0x57,
+	0x7f, 0x4e, 0x3f, 0xa0, 0xc9, 0x2f, 0x2a, 0x65, 0xc8, 0x28, 0x7a, 0x03, 0x9f, 0xe6, 0x92, 0xad,
+	0x74, 0x99, 0x39, 0xc0, 0x03, 0x7e, 0x3f, 0xe8, 0xe5, 0x92, 0xfd, 0x2d, 0x33, 0xf4, 0x01, 0x0e,
+	0x13, 0x9a, 0x85, 0xd5, 0x2a, 0xaa, 0x07, 0xa4, 0xd3, 0xf1, 0x80, 0x6f, 0x07, 0x03, 0xa3, 0x2d,
+	0x8c, 0x34, 0x8d, 0xe1, 0xa8, 0x5d, 0xf8, 0x5d, 0x14, 0x6b, 0xce, 0xd0, 0x12, 0x3e, 0xcb, 0x9b,
+	0xc5, 0xd2, 0x01, 0x9e, 0xed, 0x0f, 0x66, 0x04, 0x3f, 0x76, 0x04, 0x7e, 0x60, 0x68, 0xd1, 0xdd,
+	0xdd, 0xbc, 0xb7, 0x82, 0xf3, 0x9a, 0xe9, 0x15, 0x80, 0xc3, 0xa5, 0xa6, 0x9a, 0x26, 0xf3, 0x58,
+	0x71, 0x51, 0xa0, 0x11, 0xec, 0xf0, 0xc4, 0x98, 0xed, 0x06, 0x1d, 0x9e, 0xa0, 0xd7, 0xb0, 0x27,
+	0x39, 0x2b, 0x68, 0x69, 0x2c, 0xf6, 0x83, 0x53, 0x87, 0x3e, 0x41, 0x3b, 0x97, 0xcc, 0xb1, 0x3d,
+	0xe0, 0x0f, 0x66, 0x63, 0xdc, 0xe4, 0x82, 0xdb, 0x5c, 0xf0, 0xbc, 0xa8, 0x82, 0x1a, 0x40, 0x1f,
+	0xe1, 0x73, 0xa9, 0xa3, 0x9c, 0xab, 0x55, 0x4a, 0x39, 0x4b, 0x95, 0xd3, 0x35, 0x97, 0x0e, 0x1b,
+	0xf1, 0x87, 0xd1, 0xd0, 0x67, 0xf8, 0x92, 0x6e, 0x69, 0xac, 0x6b, 0x07, 0x2d, 0xf7, 0xc4, 0x70,
+	0x2f, 0xce, 0x7a, 0x83, 0x2e, 0x7e, 0xee, 0x0e, 0x2e, 0xd8, 0x1f, 0x5c, 0x70, 0x7b, 0x70, 0xc1,
+	0xe5, 0xd1, 0xb5, 0xf6, 0x47, 0xd7, 0xba, 0x3e, 0xba, 0xd6, 0xbf, 0xaf, 0x8c, 0xab, 0x54, 0x47,
+	0x38, 0x16, 0x39, 0xa9, 0xb3, 0xf8, 0x62, 0x62, 0x21, 0x6d, 0x2c, 0x64, 0x4b, 0xee, 0x3d, 0x85,
+	0x6a, 0x43, 0x65, 0xd4, 0x33, 0x76, 0xbf, 0xdd, 0x0d, 0x00, 0x19, 0x87, 0x35, 0x06, 0x24, 0x02,
+	0x00, 0x00,
+}
+
+func (m *TimelockedMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockedMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockedMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelayBlocks != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.DelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgUrl) > 0 {
+		i -= len(m.MsgUrl)
+		copy(dAtA[i:], m.MsgUrl)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.MsgUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimelockConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelockConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelockConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTimelock(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SubmitHeight != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTimelock(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTimelock(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTimelock(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTimelock(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTimelock(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TimelockedMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgUrl)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.DelayBlocks != 0 {
+		n += 1 + sovTimelock(uint64(m.DelayBlocks))
+	}
+	return n
+}
+
+func (m *TimelockConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTimelock(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueuedAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTimelock(uint64(m.Id))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTimelock(uint64(l))
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovTimelock(uint64(m.SubmitHeight))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovTimelock(uint64(m.ExecutionHeight))
+	}
+	return n
+}
+
+func sovTimelock(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTimelock(x uint64) (n int) {
+	return sovTimelock(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TimelockedMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockedMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockedMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayBlocks", wireType)
+			}
+			m.DelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelockConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelockConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelockConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, TimelockedMessage{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTimelock(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTimelock
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTimelock(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTimelock
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTimelock
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTimelock
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTimelock
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTimelock
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTimelock        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTimelock          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTimelock = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/authority/types"
+)
+
+func TestTimelockConfig_Validate(t *testing.T) {
+	const msgURL = "/zetachain.zetacore.fungible.MsgUpdateSystemContract"
+
+	tests := []struct {
+		name        string
+		config      types.TimelockConfig
+		errContains string
+	}{
+		{
+			name:        "empty is valid",
+			config:      types.TimelockConfig{},
+			errContains: "",
+		},
+		{
+			name:        "default is valid",
+			config:      types.DefaultTimelockConfig(),
+			errContains: "",
+		},
+		{
+			name: "invalid message url",
+			config: types.TimelockConfig{
+				Messages: []types.TimelockedMessage{
+					{MsgUrl: "zetachain.zetacore.fungible.MsgUpdateSystemContract", DelayBlocks: 100},
+				},
+			},
+			errContains: "invalid message url",
+		},
+		{
+			name: "invalid delay",
+			config: types.TimelockConfig{
+				Messages: []types.TimelockedMessage{
+					{MsgUrl: msgURL, DelayBlocks: 0},
+				},
+			},
+			errContains: "invalid delay",
+		},
+		{
+			name: "duplicate message",
+			config: types.TimelockConfig{
+				Messages: []types.TimelockedMessage{
+					{MsgUrl: msgURL, DelayBlocks: 100},
+					{MsgUrl: msgURL, DelayBlocks: 200},
+				},
+			},
+			errContains: "duplicate timelocked message",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTimelockConfig_GetDelayBlocks(t *testing.T) {
+	config := types.DefaultTimelockConfig()
+
+	delay, found := config.GetDelayBlocks("/zetachain.zetacore.crosschain.MsgMigrateTssFunds")
+	require.True(t, found)
+	require.EqualValues(t, types.DefaultTimelockDelayBlocks, delay)
+
+	_, found = config.GetDelayBlocks("/zetachain.zetacore.crosschain.MsgAddToOutTxTracker")
+	require.False(t, found)
+}
+
+func TestQueuedAction_Validate(t *testing.T) {
+	t.Run("valid queued action", func(t *testing.T) {
+		require.NoError(t, sample.QueuedAction(t, 1, 1000).Validate())
+	})
+
+	t.Run("invalid signer", func(t *testing.T) {
+		action := sample.QueuedAction(t, 1, 1000)
+		action.Signer = "invalid"
+		require.ErrorContains(t, action.Validate(), "invalid signer address")
+	})
+
+	t.Run("missing message", func(t *testing.T) {
+		action := sample.QueuedAction(t, 1, 1000)
+		action.Msg = nil
+		require.ErrorContains(t, action.Validate(), "missing message")
+	})
+
+	t.Run("execution height before submit height", func(t *testing.T) {
+		action := sample.QueuedAction(t, 1, 1000)
+		action.SubmitHeight = 1001
+		require.ErrorContains(t, action.Validate(), "execution height 1000 is before submit height 1001")
+	})
+}
@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -119,219 +120,2882 @@ func (m *MsgUpdatePoliciesResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdatePoliciesResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdatePolicies)(nil), "zetachain.zetacore.authority.MsgUpdatePolicies")
-	proto.RegisterType((*MsgUpdatePoliciesResponse)(nil), "zetachain.zetacore.authority.MsgUpdatePoliciesResponse")
+// MsgAddAuthorization defines the MsgAddAuthorization service.
+type MsgAddAuthorization struct {
+	Signer        string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Authorization Authorization `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization"`
 }
 
-func init() { proto.RegisterFile("authority/tx.proto", fileDescriptor_dccbf6440c31743d) }
+func (m *MsgAddAuthorization) Reset()         { *m = MsgAddAuthorization{} }
+func (m *MsgAddAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAuthorization) ProtoMessage()    {}
+func (*MsgAddAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{2}
+}
+func (m *MsgAddAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAuthorization.Merge(m, src)
+}
+func (m *MsgAddAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAuthorization.DiscardUnknown(m)
+}
 
-var fileDescriptor_dccbf6440c31743d = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0x2c, 0x2d, 0xc9,
-	0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
-	0xa9, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2, 0x8b, 0x52, 0xf5,
-	0xe0, 0xca, 0xa4, 0x24, 0x10, 0x3a, 0x0a, 0xf2, 0x73, 0x32, 0x93, 0x33, 0x53, 0x8b, 0x21, 0xfa,
-	0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0x54, 0xca, 0x25,
-	0xe8, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x00, 0xd5, 0x20, 0x24, 0xc6, 0xc5,
-	0x56, 0x9c, 0x99, 0x9e, 0x97, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe5, 0x09,
-	0x79, 0x70, 0x71, 0xc0, 0x0c, 0x95, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd3, 0xc3, 0xe7,
-	0x1a, 0x3d, 0x98, 0x89, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0xc1, 0x75, 0x2b, 0x49, 0x73,
-	0x49, 0x62, 0x58, 0x1b, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0xd4, 0xc8, 0xc8, 0xc5,
-	0xec, 0x5b, 0x9c, 0x2e, 0x54, 0xc5, 0xc5, 0x87, 0xe6, 0x30, 0x7d, 0xfc, 0xd6, 0x61, 0x18, 0x29,
-	0x65, 0x4e, 0xa2, 0x06, 0x98, 0x1b, 0x9c, 0xbc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0xca, 0x20, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x64,
-	0xa4, 0x2e, 0xd8, 0x74, 0x7d, 0x98, 0xe9, 0xfa, 0x15, 0xfa, 0x48, 0x91, 0x56, 0x59, 0x90, 0x5a,
-	0x9c, 0xc4, 0x06, 0x0e, 0x6a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x8b, 0x6c, 0x4f,
-	0xce, 0x01, 0x00, 0x00,
+var xxx_messageInfo_MsgAddAuthorization proto.InternalMessageInfo
+
+func (m *MsgAddAuthorization) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgAddAuthorization) GetAuthorization() Authorization {
+	if m != nil {
+		return m.Authorization
+	}
+	return Authorization{}
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// MsgAddAuthorizationResponse defines the MsgAddAuthorizationResponse service.
+type MsgAddAuthorizationResponse struct {
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdatePolicies(ctx context.Context, in *MsgUpdatePolicies, opts ...grpc.CallOption) (*MsgUpdatePoliciesResponse, error)
+func (m *MsgAddAuthorizationResponse) Reset()         { *m = MsgAddAuthorizationResponse{} }
+func (m *MsgAddAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddAuthorizationResponse) ProtoMessage()    {}
+func (*MsgAddAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{3}
+}
+func (m *MsgAddAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgAddAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddAuthorizationResponse.DiscardUnknown(m)
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_MsgAddAuthorizationResponse proto.InternalMessageInfo
+
+// MsgRemoveAuthorization defines the MsgRemoveAuthorization service.
+type MsgRemoveAuthorization struct {
+	Signer        string        `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Authorization Authorization `protobuf:"bytes,2,opt,name=authorization,proto3" json:"authorization"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRemoveAuthorization) Reset()         { *m = MsgRemoveAuthorization{} }
+func (m *MsgRemoveAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthorization) ProtoMessage()    {}
+func (*MsgRemoveAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{4}
+}
+func (m *MsgRemoveAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthorization.Merge(m, src)
+}
+func (m *MsgRemoveAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthorization.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdatePolicies(ctx context.Context, in *MsgUpdatePolicies, opts ...grpc.CallOption) (*MsgUpdatePoliciesResponse, error) {
-	out := new(MsgUpdatePoliciesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/UpdatePolicies", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveAuthorization proto.InternalMessageInfo
+
+func (m *MsgRemoveAuthorization) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdatePolicies(context.Context, *MsgUpdatePolicies) (*MsgUpdatePoliciesResponse, error)
+func (m *MsgRemoveAuthorization) GetAuthorization() Authorization {
+	if m != nil {
+		return m.Authorization
+	}
+	return Authorization{}
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgRemoveAuthorizationResponse defines the MsgRemoveAuthorizationResponse service.
+type MsgRemoveAuthorizationResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdatePolicies(ctx context.Context, req *MsgUpdatePolicies) (*MsgUpdatePoliciesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicies not implemented")
+func (m *MsgRemoveAuthorizationResponse) Reset()         { *m = MsgRemoveAuthorizationResponse{} }
+func (m *MsgRemoveAuthorizationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveAuthorizationResponse) ProtoMessage()    {}
+func (*MsgRemoveAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{5}
+}
+func (m *MsgRemoveAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveAuthorizationResponse.Merge(m, src)
+}
+func (m *MsgRemoveAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveAuthorizationResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRemoveAuthorizationResponse proto.InternalMessageInfo
+
+// MsgUpdateTimelockConfig defines the MsgUpdateTimelockConfig service.
+type MsgUpdateTimelockConfig struct {
+	Signer         string         `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	TimelockConfig TimelockConfig `protobuf:"bytes,2,opt,name=timelock_config,json=timelockConfig,proto3" json:"timelock_config"`
 }
 
-func _Msg_UpdatePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdatePolicies)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdatePolicies(ctx, in)
+func (m *MsgUpdateTimelockConfig) Reset()         { *m = MsgUpdateTimelockConfig{} }
+func (m *MsgUpdateTimelockConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTimelockConfig) ProtoMessage()    {}
+func (*MsgUpdateTimelockConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{6}
+}
+func (m *MsgUpdateTimelockConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTimelockConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTimelockConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.authority.Msg/UpdatePolicies",
+}
+func (m *MsgUpdateTimelockConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTimelockConfig.Merge(m, src)
+}
+func (m *MsgUpdateTimelockConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTimelockConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTimelockConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateTimelockConfig proto.InternalMessageInfo
+
+func (m *MsgUpdateTimelockConfig) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdatePolicies(ctx, req.(*MsgUpdatePolicies))
+	return ""
+}
+
+func (m *MsgUpdateTimelockConfig) GetTimelockConfig() TimelockConfig {
+	if m != nil {
+		return m.TimelockConfig
 	}
-	return interceptor(ctx, in, info, handler)
+	return TimelockConfig{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.authority.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdatePolicies",
-			Handler:    _Msg_UpdatePolicies_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "authority/tx.proto",
+// MsgUpdateTimelockConfigResponse defines the MsgUpdateTimelockConfigResponse service.
+type MsgUpdateTimelockConfigResponse struct {
 }
 
-func (m *MsgUpdatePolicies) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateTimelockConfigResponse) Reset()         { *m = MsgUpdateTimelockConfigResponse{} }
+func (m *MsgUpdateTimelockConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTimelockConfigResponse) ProtoMessage()    {}
+func (*MsgUpdateTimelockConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{7}
+}
+func (m *MsgUpdateTimelockConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTimelockConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTimelockConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateTimelockConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTimelockConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdateTimelockConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTimelockConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTimelockConfigResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdatePolicies) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateTimelockConfigResponse proto.InternalMessageInfo
+
+// MsgSubmitTimelockedAction defines the MsgSubmitTimelockedAction service.
+type MsgSubmitTimelockedAction struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// message to queue in the timelock, its only signer must be the signer of the submission
+	Msg *types.Any `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *MsgUpdatePolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSubmitTimelockedAction) Reset()         { *m = MsgSubmitTimelockedAction{} }
+func (m *MsgSubmitTimelockedAction) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTimelockedAction) ProtoMessage()    {}
+func (*MsgSubmitTimelockedAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{8}
+}
+func (m *MsgSubmitTimelockedAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitTimelockedAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitTimelockedAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitTimelockedAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTimelockedAction.Merge(m, src)
+}
+func (m *MsgSubmitTimelockedAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitTimelockedAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTimelockedAction.DiscardUnknown(m)
 }
 
-func (m *MsgUpdatePoliciesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitTimelockedAction proto.InternalMessageInfo
+
+func (m *MsgSubmitTimelockedAction) GetSigner() string {
+	if m != nil {
+		return m.Signer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdatePoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitTimelockedAction) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
 }
 
-func (m *MsgUpdatePoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSubmitTimelockedActionResponse defines the MsgSubmitTimelockedActionResponse service.
+type MsgSubmitTimelockedActionResponse struct {
+	ActionId        uint64 `protobuf:"varint,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+	ExecutionHeight int64  `protobuf:"varint,2,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *MsgSubmitTimelockedActionResponse) Reset()         { *m = MsgSubmitTimelockedActionResponse{} }
+func (m *MsgSubmitTimelockedActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitTimelockedActionResponse) ProtoMessage()    {}
+func (*MsgSubmitTimelockedActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{9}
+}
+func (m *MsgSubmitTimelockedActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitTimelockedActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitTimelockedActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitTimelockedActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitTimelockedActionResponse.Merge(m, src)
+}
+func (m *MsgSubmitTimelockedActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitTimelockedActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitTimelockedActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitTimelockedActionResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitTimelockedActionResponse) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+func (m *MsgSubmitTimelockedActionResponse) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+// MsgCancelTimelockedAction defines the MsgCancelTimelockedAction service.
+type MsgCancelTimelockedAction struct {
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ActionId uint64 `protobuf:"varint,2,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
+}
+
+func (m *MsgCancelTimelockedAction) Reset()         { *m = MsgCancelTimelockedAction{} }
+func (m *MsgCancelTimelockedAction) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedAction) ProtoMessage()    {}
+func (*MsgCancelTimelockedAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{10}
+}
+func (m *MsgCancelTimelockedAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedAction.Merge(m, src)
+}
+func (m *MsgCancelTimelockedAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedAction proto.InternalMessageInfo
+
+func (m *MsgCancelTimelockedAction) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCancelTimelockedAction) GetActionId() uint64 {
+	if m != nil {
+		return m.ActionId
+	}
+	return 0
+}
+
+// MsgCancelTimelockedActionResponse defines the MsgCancelTimelockedActionResponse service.
+type MsgCancelTimelockedActionResponse struct {
+}
+
+func (m *MsgCancelTimelockedActionResponse) Reset()         { *m = MsgCancelTimelockedActionResponse{} }
+func (m *MsgCancelTimelockedActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelTimelockedActionResponse) ProtoMessage()    {}
+func (*MsgCancelTimelockedActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{11}
+}
+func (m *MsgCancelTimelockedActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelTimelockedActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelTimelockedActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelTimelockedActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelTimelockedActionResponse.Merge(m, src)
+}
+func (m *MsgCancelTimelockedActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelTimelockedActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelTimelockedActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelTimelockedActionResponse proto.InternalMessageInfo
+
+// MsgSubmitThresholdProposal defines the MsgSubmitThresholdProposal service.
+type MsgSubmitThresholdProposal struct {
+	Signer     string     `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	PolicyType PolicyType `protobuf:"varint,2,opt,name=policy_type,json=policyType,proto3,enum=zetachain.zetacore.authority.PolicyType" json:"policy_type,omitempty"`
+	// message to execute, its only signer must be the address of the threshold policy
+	Msg *types.Any `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *MsgSubmitThresholdProposal) Reset()         { *m = MsgSubmitThresholdProposal{} }
+func (m *MsgSubmitThresholdProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitThresholdProposal) ProtoMessage()    {}
+func (*MsgSubmitThresholdProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{12}
+}
+func (m *MsgSubmitThresholdProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitThresholdProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitThresholdProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitThresholdProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitThresholdProposal.Merge(m, src)
+}
+func (m *MsgSubmitThresholdProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitThresholdProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitThresholdProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitThresholdProposal proto.InternalMessageInfo
+
+func (m *MsgSubmitThresholdProposal) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgSubmitThresholdProposal) GetPolicyType() PolicyType {
+	if m != nil {
+		return m.PolicyType
+	}
+	return PolicyType_groupEmergency
+}
+
+func (m *MsgSubmitThresholdProposal) GetMsg() *types.Any {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+// MsgSubmitThresholdProposalResponse defines the MsgSubmitThresholdProposalResponse service.
+type MsgSubmitThresholdProposalResponse struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// true if the threshold is reached with the approval of the proposer and the message is executed
+	Executed bool `protobuf:"varint,2,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgSubmitThresholdProposalResponse) Reset()         { *m = MsgSubmitThresholdProposalResponse{} }
+func (m *MsgSubmitThresholdProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitThresholdProposalResponse) ProtoMessage()    {}
+func (*MsgSubmitThresholdProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{13}
+}
+func (m *MsgSubmitThresholdProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitThresholdProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitThresholdProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitThresholdProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitThresholdProposalResponse.Merge(m, src)
+}
+func (m *MsgSubmitThresholdProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitThresholdProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitThresholdProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitThresholdProposalResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitThresholdProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgSubmitThresholdProposalResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+// MsgApproveThresholdProposal defines the MsgApproveThresholdProposal service.
+type MsgApproveThresholdProposal struct {
+	Signer     string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgApproveThresholdProposal) Reset()         { *m = MsgApproveThresholdProposal{} }
+func (m *MsgApproveThresholdProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveThresholdProposal) ProtoMessage()    {}
+func (*MsgApproveThresholdProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{14}
+}
+func (m *MsgApproveThresholdProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveThresholdProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveThresholdProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveThresholdProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveThresholdProposal.Merge(m, src)
+}
+func (m *MsgApproveThresholdProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveThresholdProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveThresholdProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveThresholdProposal proto.InternalMessageInfo
+
+func (m *MsgApproveThresholdProposal) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgApproveThresholdProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgApproveThresholdProposalResponse defines the MsgApproveThresholdProposalResponse service.
+type MsgApproveThresholdProposalResponse struct {
+	// true if the threshold is reached with this approval and the message is executed
+	Executed bool `protobuf:"varint,1,opt,name=executed,proto3" json:"executed,omitempty"`
+}
+
+func (m *MsgApproveThresholdProposalResponse) Reset()         { *m = MsgApproveThresholdProposalResponse{} }
+func (m *MsgApproveThresholdProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveThresholdProposalResponse) ProtoMessage()    {}
+func (*MsgApproveThresholdProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dccbf6440c31743d, []int{15}
+}
+func (m *MsgApproveThresholdProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveThresholdProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveThresholdProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveThresholdProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveThresholdProposalResponse.Merge(m, src)
+}
+func (m *MsgApproveThresholdProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveThresholdProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveThresholdProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveThresholdProposalResponse proto.InternalMessageInfo
+
+func (m *MsgApproveThresholdProposalResponse) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdatePolicies)(nil), "zetachain.zetacore.authority.MsgUpdatePolicies")
+	proto.RegisterType((*MsgUpdatePoliciesResponse)(nil), "zetachain.zetacore.authority.MsgUpdatePoliciesResponse")
+	proto.RegisterType((*MsgAddAuthorization)(nil), "zetachain.zetacore.authority.MsgAddAuthorization")
+	proto.RegisterType((*MsgAddAuthorizationResponse)(nil), "zetachain.zetacore.authority.MsgAddAuthorizationResponse")
+	proto.RegisterType((*MsgRemoveAuthorization)(nil), "zetachain.zetacore.authority.MsgRemoveAuthorization")
+	proto.RegisterType((*MsgRemoveAuthorizationResponse)(nil), "zetachain.zetacore.authority.MsgRemoveAuthorizationResponse")
+	proto.RegisterType((*MsgUpdateTimelockConfig)(nil), "zetachain.zetacore.authority.MsgUpdateTimelockConfig")
+	proto.RegisterType((*MsgUpdateTimelockConfigResponse)(nil), "zetachain.zetacore.authority.MsgUpdateTimelockConfigResponse")
+	proto.RegisterType((*MsgSubmitTimelockedAction)(nil), "zetachain.zetacore.authority.MsgSubmitTimelockedAction")
+	proto.RegisterType((*MsgSubmitTimelockedActionResponse)(nil), "zetachain.zetacore.authority.MsgSubmitTimelockedActionResponse")
+	proto.RegisterType((*MsgCancelTimelockedAction)(nil), "zetachain.zetacore.authority.MsgCancelTimelockedAction")
+	proto.RegisterType((*MsgCancelTimelockedActionResponse)(nil), "zetachain.zetacore.authority.MsgCancelTimelockedActionResponse")
+	proto.RegisterType((*MsgSubmitThresholdProposal)(nil), "zetachain.zetacore.authority.MsgSubmitThresholdProposal")
+	proto.RegisterType((*MsgSubmitThresholdProposalResponse)(nil), "zetachain.zetacore.authority.MsgSubmitThresholdProposalResponse")
+	proto.RegisterType((*MsgApproveThresholdProposal)(nil), "zetachain.zetacore.authority.MsgApproveThresholdProposal")
+	proto.RegisterType((*MsgApproveThresholdProposalResponse)(nil), "zetachain.zetacore.authority.MsgApproveThresholdProposalResponse")
+}
+
+func init() { proto.RegisterFile("authority/tx.proto", fileDescriptor_dccbf6440c31743d) }
+
+var fileDescriptor_dccbf6440c31743d = []byte{
+	// 759 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0x51, 0x4b, 0xdc, 0x4a,
+	0x14, 0xc7, 0x77, 0x5c, 0x91, 0xf5, 0xc8, 0x55, 0x6f, 0x14, 0x5d, 0xe3, 0x35, 0x6a, 0x04, 0xf1,
+	0x72, 0x6f, 0x93, 0xd6, 0xb6, 0xb4, 0x85, 0x16, 0xbb, 0xfa, 0xa2, 0x85, 0x05, 0x49, 0x6d, 0x0b,
+	0xf5, 0x41, 0xb2, 0xc9, 0x98, 0x04, 0x77, 0x33, 0x21, 0x99, 0x15, 0xe3, 0x53, 0x9f, 0x0a, 0x42,
+	0x29, 0xa5, 0x50, 0xe8, 0x37, 0xe8, 0x57, 0xf1, 0xd1, 0xc7, 0x3e, 0x95, 0xa2, 0x5f, 0xa3, 0x0f,
+	0xc5, 0x49, 0x32, 0x6b, 0x76, 0x93, 0xdd, 0x75, 0x5f, 0xfa, 0x36, 0x39, 0x33, 0xff, 0xf3, 0xff,
+	0xcd, 0x99, 0x99, 0x43, 0x40, 0xd0, 0x9b, 0xd4, 0x26, 0xbe, 0x43, 0x43, 0x95, 0x9e, 0x28, 0x9e,
+	0x4f, 0x28, 0x11, 0xfe, 0x39, 0xc5, 0x54, 0x37, 0x6c, 0xdd, 0x71, 0x15, 0x36, 0x22, 0x3e, 0x56,
+	0xf8, 0x32, 0x71, 0xa1, 0xa5, 0x88, 0x47, 0xa7, 0x3a, 0x75, 0x88, 0x1b, 0x89, 0xc5, 0x72, 0x6b,
+	0xda, 0x23, 0x75, 0xc7, 0x70, 0x70, 0xd0, 0x39, 0x43, 0x9d, 0x06, 0xae, 0x13, 0xe3, 0x28, 0x9e,
+	0x99, 0xb6, 0x88, 0x45, 0xd8, 0x50, 0xbd, 0x1e, 0xc5, 0xd1, 0x39, 0x8b, 0x10, 0xab, 0x8e, 0x55,
+	0xf6, 0x55, 0x6b, 0x1e, 0xaa, 0xba, 0x1b, 0x46, 0x53, 0x72, 0x13, 0xfe, 0xae, 0x06, 0xd6, 0x2b,
+	0xcf, 0xd4, 0x29, 0xde, 0x8d, 0x5d, 0x84, 0x19, 0x18, 0x09, 0x1c, 0xcb, 0xc5, 0x7e, 0x19, 0x2d,
+	0xa1, 0xb5, 0x51, 0x2d, 0xfe, 0x12, 0xb6, 0xa1, 0x94, 0x90, 0x94, 0x87, 0x96, 0xd0, 0xda, 0xd8,
+	0xfa, 0xaa, 0xd2, 0x6d, 0x87, 0x4a, 0x92, 0x71, 0x73, 0xf8, 0xfc, 0xc7, 0x62, 0x41, 0xe3, 0x6a,
+	0x79, 0x1e, 0xe6, 0x3a, 0x6c, 0x35, 0x1c, 0x78, 0xc4, 0x0d, 0xb0, 0xfc, 0x1e, 0xc1, 0x54, 0x35,
+	0xb0, 0x2a, 0xa6, 0x59, 0xb9, 0x59, 0x96, 0x5c, 0xac, 0x37, 0xf0, 0x57, 0xaa, 0x7e, 0x31, 0xdb,
+	0x7f, 0xdd, 0xd9, 0x52, 0xb9, 0x63, 0xc0, 0x74, 0x1e, 0x79, 0x01, 0xe6, 0x33, 0x38, 0x38, 0xe7,
+	0x19, 0x82, 0x99, 0x6a, 0x60, 0x69, 0xb8, 0x41, 0x8e, 0xf1, 0x1f, 0x46, 0x5d, 0x02, 0x29, 0x1b,
+	0x85, 0xd3, 0x7e, 0x44, 0x30, 0xcb, 0x6b, 0xbe, 0x17, 0x5f, 0x9b, 0x2d, 0xe2, 0x1e, 0x3a, 0x56,
+	0x2e, 0xee, 0x3e, 0x4c, 0x24, 0x17, 0xec, 0xc0, 0x60, 0x4b, 0x63, 0xe0, 0xff, 0xbb, 0x03, 0xa7,
+	0xd3, 0xc7, 0xc4, 0xe3, 0x34, 0x15, 0x95, 0x97, 0x61, 0x31, 0x87, 0x87, 0x33, 0xef, 0xb3, 0x6b,
+	0xf2, 0xb2, 0x59, 0x6b, 0x38, 0x34, 0x59, 0x82, 0xcd, 0x8a, 0xd1, 0xb5, 0xc6, 0xab, 0x50, 0x6c,
+	0x04, 0x09, 0xe8, 0xb4, 0x12, 0xdd, 0x7d, 0x25, 0xb9, 0xfb, 0x4a, 0xc5, 0x0d, 0xb5, 0xeb, 0x05,
+	0xf2, 0x11, 0x2c, 0xe7, 0x26, 0x4f, 0x08, 0x84, 0x79, 0x18, 0xd5, 0x59, 0xe4, 0xc0, 0x31, 0x99,
+	0xcf, 0xb0, 0x56, 0x8a, 0x02, 0x3b, 0xa6, 0xf0, 0x2f, 0x4c, 0xe2, 0x13, 0x6c, 0x34, 0xd9, 0xbc,
+	0x8d, 0x1d, 0xcb, 0xa6, 0xcc, 0xb6, 0xa8, 0x4d, 0xf0, 0xf8, 0x36, 0x0b, 0xcb, 0xbb, 0x6c, 0x27,
+	0x5b, 0xba, 0x6b, 0xe0, 0x7a, 0xdf, 0x3b, 0x49, 0x99, 0x0f, 0xa5, 0xcd, 0xe5, 0x15, 0x58, 0xce,
+	0xcd, 0xc8, 0x0b, 0xf8, 0x0d, 0x81, 0xd8, 0xda, 0xa4, 0xed, 0xe3, 0xc0, 0x26, 0x75, 0x73, 0xd7,
+	0x27, 0x1e, 0x09, 0xf4, 0x7a, 0xae, 0xf1, 0x0e, 0x8c, 0xb1, 0xa7, 0x1a, 0x1e, 0xd0, 0xd0, 0xc3,
+	0xcc, 0x7a, 0x7c, 0x7d, 0xad, 0x8f, 0xb7, 0x1e, 0xee, 0x85, 0x1e, 0xd6, 0xc0, 0xe3, 0xe3, 0xe4,
+	0x34, 0x8a, 0xbd, 0x4e, 0x43, 0x07, 0x39, 0x1f, 0x94, 0x1f, 0xc7, 0x22, 0x8c, 0x79, 0x71, 0xac,
+	0x75, 0x20, 0x90, 0x84, 0x76, 0x4c, 0x41, 0x84, 0x52, 0x54, 0x7a, 0x1c, 0x55, 0xac, 0xa4, 0xf1,
+	0x6f, 0xf9, 0x75, 0xf4, 0x9c, 0x3d, 0xcf, 0x27, 0xc7, 0xb8, 0xff, 0x62, 0xb4, 0x79, 0x0e, 0xb5,
+	0x7b, 0xca, 0x15, 0x58, 0xe9, 0x92, 0x97, 0xb3, 0xdf, 0x44, 0x43, 0x69, 0xb4, 0xf5, 0x5f, 0x25,
+	0x28, 0x56, 0x03, 0x4b, 0x38, 0x85, 0xf1, 0xb6, 0x5e, 0xac, 0x76, 0xaf, 0x7a, 0x47, 0x17, 0x15,
+	0x1f, 0xdd, 0x52, 0xc0, 0xf9, 0xde, 0x21, 0x98, 0xec, 0xe8, 0xb9, 0xf7, 0x7a, 0x66, 0x6b, 0x97,
+	0x88, 0x4f, 0x6e, 0x2d, 0xe1, 0x08, 0x67, 0x08, 0xa6, 0xb2, 0xda, 0xe9, 0x83, 0x9e, 0x29, 0x33,
+	0x54, 0xe2, 0xd3, 0x41, 0x54, 0x9c, 0xe5, 0x03, 0x82, 0xe9, 0xcc, 0x66, 0xf9, 0xb0, 0xcf, 0x02,
+	0xa7, 0x65, 0xe2, 0xb3, 0x81, 0x64, 0x1c, 0xe7, 0x33, 0x82, 0x99, 0x9c, 0x46, 0xd8, 0xfb, 0xc4,
+	0xb3, 0x85, 0xe2, 0xc6, 0x80, 0xc2, 0x14, 0x54, 0x4e, 0x4f, 0xeb, 0x0d, 0x95, 0x2d, 0x14, 0x37,
+	0x06, 0x14, 0x72, 0xa8, 0x2f, 0x08, 0x66, 0xf3, 0x1a, 0xde, 0xe3, 0x7e, 0x77, 0xdc, 0xae, 0x14,
+	0x9f, 0x0f, 0xaa, 0xe4, 0x5c, 0x5f, 0x11, 0x94, 0x73, 0x9b, 0x4f, 0x1f, 0x8f, 0x26, 0x47, 0x2a,
+	0x56, 0x06, 0x96, 0x26, 0x68, 0x9b, 0x2f, 0xce, 0x2f, 0x25, 0x74, 0x71, 0x29, 0xa1, 0x9f, 0x97,
+	0x12, 0xfa, 0x74, 0x25, 0x15, 0x2e, 0xae, 0xa4, 0xc2, 0xf7, 0x2b, 0xa9, 0xf0, 0xf6, 0xae, 0xe5,
+	0x50, 0xbb, 0x59, 0x53, 0x0c, 0xd2, 0x50, 0xaf, 0x93, 0xdf, 0x61, 0x3e, 0x6a, 0xe2, 0xa3, 0x9e,
+	0xa8, 0x37, 0xfe, 0x45, 0x43, 0x0f, 0x07, 0xb5, 0x11, 0xd6, 0xdb, 0xef, 0xff, 0x1e, 0x00, 0x2c,
+	0x42, 0x56, 0xc4, 0x10, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdatePolicies(ctx context.Context, in *MsgUpdatePolicies, opts ...grpc.CallOption) (*MsgUpdatePoliciesResponse, error)
+	AddAuthorization(ctx context.Context, in *MsgAddAuthorization, opts ...grpc.CallOption) (*MsgAddAuthorizationResponse, error)
+	RemoveAuthorization(ctx context.Context, in *MsgRemoveAuthorization, opts ...grpc.CallOption) (*MsgRemoveAuthorizationResponse, error)
+	UpdateTimelockConfig(ctx context.Context, in *MsgUpdateTimelockConfig, opts ...grpc.CallOption) (*MsgUpdateTimelockConfigResponse, error)
+	SubmitTimelockedAction(ctx context.Context, in *MsgSubmitTimelockedAction, opts ...grpc.CallOption) (*MsgSubmitTimelockedActionResponse, error)
+	CancelTimelockedAction(ctx context.Context, in *MsgCancelTimelockedAction, opts ...grpc.CallOption) (*MsgCancelTimelockedActionResponse, error)
+	SubmitThresholdProposal(ctx context.Context, in *MsgSubmitThresholdProposal, opts ...grpc.CallOption) (*MsgSubmitThresholdProposalResponse, error)
+	ApproveThresholdProposal(ctx context.Context, in *MsgApproveThresholdProposal, opts ...grpc.CallOption) (*MsgApproveThresholdProposalResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdatePolicies(ctx context.Context, in *MsgUpdatePolicies, opts ...grpc.CallOption) (*MsgUpdatePoliciesResponse, error) {
+	out := new(MsgUpdatePoliciesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/UpdatePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAuthorization(ctx context.Context, in *MsgAddAuthorization, opts ...grpc.CallOption) (*MsgAddAuthorizationResponse, error) {
+	out := new(MsgAddAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/AddAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAuthorization(ctx context.Context, in *MsgRemoveAuthorization, opts ...grpc.CallOption) (*MsgRemoveAuthorizationResponse, error) {
+	out := new(MsgRemoveAuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/RemoveAuthorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTimelockConfig(ctx context.Context, in *MsgUpdateTimelockConfig, opts ...grpc.CallOption) (*MsgUpdateTimelockConfigResponse, error) {
+	out := new(MsgUpdateTimelockConfigResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/UpdateTimelockConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitTimelockedAction(ctx context.Context, in *MsgSubmitTimelockedAction, opts ...grpc.CallOption) (*MsgSubmitTimelockedActionResponse, error) {
+	out := new(MsgSubmitTimelockedActionResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/SubmitTimelockedAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTimelockedAction(ctx context.Context, in *MsgCancelTimelockedAction, opts ...grpc.CallOption) (*MsgCancelTimelockedActionResponse, error) {
+	out := new(MsgCancelTimelockedActionResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/CancelTimelockedAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitThresholdProposal(ctx context.Context, in *MsgSubmitThresholdProposal, opts ...grpc.CallOption) (*MsgSubmitThresholdProposalResponse, error) {
+	out := new(MsgSubmitThresholdProposalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/SubmitThresholdProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveThresholdProposal(ctx context.Context, in *MsgApproveThresholdProposal, opts ...grpc.CallOption) (*MsgApproveThresholdProposalResponse, error) {
+	out := new(MsgApproveThresholdProposalResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.authority.Msg/ApproveThresholdProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdatePolicies(context.Context, *MsgUpdatePolicies) (*MsgUpdatePoliciesResponse, error)
+	AddAuthorization(context.Context, *MsgAddAuthorization) (*MsgAddAuthorizationResponse, error)
+	RemoveAuthorization(context.Context, *MsgRemoveAuthorization) (*MsgRemoveAuthorizationResponse, error)
+	UpdateTimelockConfig(context.Context, *MsgUpdateTimelockConfig) (*MsgUpdateTimelockConfigResponse, error)
+	SubmitTimelockedAction(context.Context, *MsgSubmitTimelockedAction) (*MsgSubmitTimelockedActionResponse, error)
+	CancelTimelockedAction(context.Context, *MsgCancelTimelockedAction) (*MsgCancelTimelockedActionResponse, error)
+	SubmitThresholdProposal(context.Context, *MsgSubmitThresholdProposal) (*MsgSubmitThresholdProposalResponse, error)
+	ApproveThresholdProposal(context.Context, *MsgApproveThresholdProposal) (*MsgApproveThresholdProposalResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdatePolicies(ctx context.Context, req *MsgUpdatePolicies) (*MsgUpdatePoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicies not implemented")
+}
+func (*UnimplementedMsgServer) AddAuthorization(ctx context.Context, req *MsgAddAuthorization) (*MsgAddAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) RemoveAuthorization(ctx context.Context, req *MsgRemoveAuthorization) (*MsgRemoveAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAuthorization not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTimelockConfig(ctx context.Context, req *MsgUpdateTimelockConfig) (*MsgUpdateTimelockConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTimelockConfig not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTimelockedAction(ctx context.Context, req *MsgSubmitTimelockedAction) (*MsgSubmitTimelockedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTimelockedAction not implemented")
+}
+func (*UnimplementedMsgServer) CancelTimelockedAction(ctx context.Context, req *MsgCancelTimelockedAction) (*MsgCancelTimelockedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTimelockedAction not implemented")
+}
+func (*UnimplementedMsgServer) SubmitThresholdProposal(ctx context.Context, req *MsgSubmitThresholdProposal) (*MsgSubmitThresholdProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitThresholdProposal not implemented")
+}
+func (*UnimplementedMsgServer) ApproveThresholdProposal(ctx context.Context, req *MsgApproveThresholdProposal) (*MsgApproveThresholdProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveThresholdProposal not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdatePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/UpdatePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePolicies(ctx, req.(*MsgUpdatePolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/AddAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAuthorization(ctx, req.(*MsgAddAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAuthorization)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/RemoveAuthorization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAuthorization(ctx, req.(*MsgRemoveAuthorization))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTimelockConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTimelockConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTimelockConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/UpdateTimelockConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTimelockConfig(ctx, req.(*MsgUpdateTimelockConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitTimelockedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTimelockedAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTimelockedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/SubmitTimelockedAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTimelockedAction(ctx, req.(*MsgSubmitTimelockedAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTimelockedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTimelockedAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTimelockedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/CancelTimelockedAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTimelockedAction(ctx, req.(*MsgCancelTimelockedAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitThresholdProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitThresholdProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitThresholdProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/SubmitThresholdProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitThresholdProposal(ctx, req.(*MsgSubmitThresholdProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveThresholdProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveThresholdProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveThresholdProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.authority.Msg/ApproveThresholdProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveThresholdProposal(ctx, req.(*MsgApproveThresholdProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.authority.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdatePolicies",
+			Handler:    _Msg_UpdatePolicies_Handler,
+		},
+		{
+			MethodName: "AddAuthorization",
+			Handler:    _Msg_AddAuthorization_Handler,
+		},
+		{
+			MethodName: "RemoveAuthorization",
+			Handler:    _Msg_RemoveAuthorization_Handler,
+		},
+		{
+			MethodName: "UpdateTimelockConfig",
+			Handler:    _Msg_UpdateTimelockConfig_Handler,
+		},
+		{
+			MethodName: "SubmitTimelockedAction",
+			Handler:    _Msg_SubmitTimelockedAction_Handler,
+		},
+		{
+			MethodName: "CancelTimelockedAction",
+			Handler:    _Msg_CancelTimelockedAction_Handler,
+		},
+		{
+			MethodName: "SubmitThresholdProposal",
+			Handler:    _Msg_SubmitThresholdProposal_Handler,
+		},
+		{
+			MethodName: "ApproveThresholdProposal",
+			Handler:    _Msg_ApproveThresholdProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "authority/tx.proto",
+}
+
+func (m *MsgUpdatePolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policies.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveAuthorizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTimelockConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTimelockConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTimelockConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TimelockConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTimelockConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTimelockConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTimelockConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTimelockedAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTimelockedAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTimelockedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTimelockedActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitTimelockedActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitTimelockedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTimelockedAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTimelockedAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTimelockedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTimelockedActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTimelockedActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTimelockedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitThresholdProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitThresholdProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitThresholdProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PolicyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PolicyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitThresholdProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitThresholdProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitThresholdProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveThresholdProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveThresholdProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveThresholdProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveThresholdProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveThresholdProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveThresholdProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
-func (m *MsgUpdatePolicies) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdatePolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policies.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Authorization.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Authorization.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRemoveAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTimelockConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TimelockConfig.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateTimelockConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitTimelockedAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitTimelockedActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelTimelockedAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActionId != 0 {
+		n += 1 + sovTx(uint64(m.ActionId))
+	}
+	return n
+}
+
+func (m *MsgCancelTimelockedActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitThresholdProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PolicyType != 0 {
+		n += 1 + sovTx(uint64(m.PolicyType))
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitThresholdProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgApproveThresholdProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveThresholdProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Executed {
+		n += 2
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdatePolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTimelockConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTimelockConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTimelockConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimelockConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimelockConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTimelockConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTimelockConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTimelockConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitTimelockedAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitTimelockedAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitTimelockedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitTimelockedActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitTimelockedActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitTimelockedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionId", wireType)
+			}
+			m.ActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTimelockedActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTimelockedActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTimelockedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Policies.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdatePoliciesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdatePolicies) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitThresholdProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -354,10 +3018,10 @@ func (m *MsgUpdatePolicies) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePolicies: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitThresholdProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitThresholdProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -393,8 +3057,27 @@ func (m *MsgUpdatePolicies) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyType", wireType)
+			}
+			m.PolicyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyType |= PolicyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -421,7 +3104,10 @@ func (m *MsgUpdatePolicies) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Policies.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Msg == nil {
+				m.Msg = &types.Any{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -446,7 +3132,7 @@ func (m *MsgUpdatePolicies) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePoliciesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitThresholdProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -469,12 +3155,222 @@ func (m *MsgUpdatePoliciesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePoliciesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitThresholdProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitThresholdProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveThresholdProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveThresholdProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveThresholdProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveThresholdProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveThresholdProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveThresholdProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
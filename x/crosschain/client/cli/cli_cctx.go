@@ -88,6 +88,86 @@ func CmdPendingCctx() *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagSender          = "sender"
+	FlagReceiver        = "receiver"
+	FlagStatus          = "status"
+	FlagSenderChainID   = "sender-chain-id"
+	FlagReceiverChainID = "receiver-chain-id"
+	FlagMinHeight       = "min-height"
+	FlagMaxHeight       = "max-height"
+)
+
+func CmdListCctxFiltered() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-cctx-filtered",
+		Short: "list CCTX filtered by sender, receiver, status, chain and zeta height range",
+		Example: `zetacored q crosschain list-cctx-filtered --sender 0x96B05C238b99768F349135de0653b687f9c13fEE --status PendingOutbound,PendingRevert
+zetacored q crosschain list-cctx-filtered --receiver-chain-id 5 --min-height 1000 --max-height 2000`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryListCctxFilteredRequest{
+				Pagination: pageReq,
+			}
+			if params.Sender, err = cmd.Flags().GetString(FlagSender); err != nil {
+				return err
+			}
+			if params.Receiver, err = cmd.Flags().GetString(FlagReceiver); err != nil {
+				return err
+			}
+			if params.SenderChainId, err = cmd.Flags().GetInt64(FlagSenderChainID); err != nil {
+				return err
+			}
+			if params.ReceiverChainId, err = cmd.Flags().GetInt64(FlagReceiverChainID); err != nil {
+				return err
+			}
+			if params.MinHeight, err = cmd.Flags().GetUint64(FlagMinHeight); err != nil {
+				return err
+			}
+			if params.MaxHeight, err = cmd.Flags().GetUint64(FlagMaxHeight); err != nil {
+				return err
+			}
+			statuses, err := cmd.Flags().GetStringSlice(FlagStatus)
+			if err != nil {
+				return err
+			}
+			for _, s := range statuses {
+				status, ok := types.CctxStatus_value[s]
+				if !ok {
+					return fmt.Errorf("invalid status %s", s)
+				}
+				params.Status = append(params.Status, types.CctxStatus(status))
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CctxListFiltered(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSender, "", "inbound sender or tx origin of the CCTX")
+	cmd.Flags().String(FlagReceiver, "", "receiver of the original outbound of the CCTX")
+	cmd.Flags().StringSlice(FlagStatus, nil, "comma separated list of CCTX statuses")
+	cmd.Flags().Int64(FlagSenderChainID, 0, "sender chain ID")
+	cmd.Flags().Int64(FlagReceiverChainID, 0, "receiver chain ID")
+	cmd.Flags().Uint64(FlagMinHeight, 0, "minimum zeta height at which the CCTX was created")
+	cmd.Flags().Uint64(FlagMaxHeight, 0, "maximum zeta height at which the CCTX was created")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdShowSend() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "show-cctx [index]",
@@ -278,6 +358,30 @@ func CmdCCTXOutboundVoter() *cobra.Command {
 	return cmd
 }
 
+func CmdExtendCctxDeadline() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-cctx-deadline [index] [extension-blocks]",
+		Short: "extend the outbound expiry deadline of a CCTX",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			extensionBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExtendCctxDeadline(clientCtx.GetFromAddress().String(), args[0], extensionBlocks)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdAbortStuckCCTX() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "abort-stuck-cctx [index]",
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func CmdClaimAbortedRefund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-aborted-refund [cctx-index] [refund-address]",
+		Short: `Claim the refund of an aborted tx as its tx origin, the refund address is optional, if not provided, the refund will be sent to the tx origin of the cctx.`,
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			refundAddress := ""
+			if len(args) > 1 {
+				refundAddress = args[1]
+			}
+			msg := types.NewMsgClaimAbortedCCTXRefund(clientCtx.GetFromAddress().String(), args[0], refundAddress)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -74,6 +74,8 @@ func CmdShowGasPrice() *cobra.Command {
 
 // Transaction CLI /////////////////////////
 
+const flagPriorityFee = "priority-fee"
+
 func CmdGasPriceVoter() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "gas-price-voter [chain] [price] [supply] [blockNumber]",
@@ -94,17 +96,29 @@ func CmdGasPriceVoter() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			argsPriorityFee, err := cmd.Flags().GetUint64(flagPriorityFee)
+			if err != nil {
+				return err
+			}
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgGasPriceVoter(clientCtx.GetFromAddress().String(), argsChain, argsPrice, argsSupply, argsBlockNumber)
+			msg := types.NewMsgGasPriceVoter(
+				clientCtx.GetFromAddress().String(),
+				argsChain,
+				argsPrice,
+				argsPriorityFee,
+				argsSupply,
+				argsBlockNumber,
+			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Uint64(flagPriorityFee, 0, "priority fee (EIP-1559) of the chain, zero if not supported")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+const flagZRC20Address = "zrc20-address"
+
+func CmdUpdateRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rate-limit [chain-id] [limit] [window] [action]",
+		Short: "create or update the outbound rate limit of a receiver chain and asset, action is queue or reject, the rate limit applies to ZETA if no zrc20 address is provided",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			limit, err := sdkmath.ParseUint(args[1])
+			if err != nil {
+				return err
+			}
+			window, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			var action types.RateLimitAction
+			switch strings.ToLower(args[3]) {
+			case "queue":
+				action = types.RateLimitAction_Queue
+			case "reject":
+				action = types.RateLimitAction_Reject
+			default:
+				return fmt.Errorf("invalid action %s, must be queue or reject", args[3])
+			}
+			zrc20Address, err := cmd.Flags().GetString(flagZRC20Address)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRateLimit(clientCtx.GetFromAddress().String(), types.RateLimit{
+				ChainId:      chainID,
+				Zrc20Address: zrc20Address,
+				Limit:        limit,
+				Window:       window,
+				Action:       action,
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagZRC20Address, "", "address of the ZRC20 of the asset, empty for ZETA")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRemoveRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-limit [chain-id]",
+		Short: "remove the outbound rate limit of a receiver chain and asset, the rate limit of ZETA is removed if no zrc20 address is provided",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			zrc20Address, err := cmd.Flags().GetString(flagZRC20Address)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveRateLimit(clientCtx.GetFromAddress().String(), chainID, zrc20Address)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagZRC20Address, "", "address of the ZRC20 of the asset, empty for ZETA")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdListRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-rate-limit",
+		Short: "list all the outbound rate limits",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAllRateLimitRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimitAll(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowRateLimitCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-rate-limit-capacity [chain-id]",
+		Short: "shows the usage and remaining capacity of the current window of an outbound rate limit, the rate limit of ZETA is shown if no zrc20 address is provided",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			zrc20Address, err := cmd.Flags().GetString(flagZRC20Address)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryRateLimitCapacityRequest{
+				ChainId:      chainID,
+				Zrc20Address: zrc20Address,
+			}
+
+			res, err := queryClient.RateLimitCapacity(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagZRC20Address, "", "address of the ZRC20 of the asset, empty for ZETA")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
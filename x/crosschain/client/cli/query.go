@@ -40,9 +40,13 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdQueryParams(),
 
 		CmdPendingCctx(),
+		CmdListCctxFiltered(),
 		CmdListInTxTrackerByChain(),
 		CmdListInTxTrackers(),
 		CmdGetZetaAccounting(),
+		CmdListRateLimit(),
+		CmdShowRateLimitCapacity(),
+		CmdEstimateCctxFee(),
 	)
 
 	return cmd
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+const (
+	flagAsset    = "asset"
+	flagGasLimit = "gas-limit"
+)
+
+func CmdEstimateCctxFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-cctx-fee [sender-chain-id] [receiver-chain-id] [coin-type] [amount]",
+		Short: "estimate the fees paid for a cctx and the amount received on the receiver chain, coin type is Zeta, Gas or ERC20",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			senderChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			receiverChainID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			coinType, ok := common.CoinType_value[args[2]]
+			if !ok {
+				return fmt.Errorf("wrong coin type %s", args[2])
+			}
+			asset, err := cmd.Flags().GetString(flagAsset)
+			if err != nil {
+				return err
+			}
+			gasLimit, err := cmd.Flags().GetUint64(flagGasLimit)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.EstimateCctxFee(cmd.Context(), &types.QueryEstimateCctxFeeRequest{
+				SenderChainId:   senderChainID,
+				ReceiverChainId: receiverChainID,
+				CoinType:        common.CoinType(coinType),
+				Asset:           asset,
+				Amount:          args[3],
+				GasLimit:        gasLimit,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagAsset, "", "address of the asset on the receiver chain, required for ERC20")
+	cmd.Flags().Uint64(flagGasLimit, 0, "gas limit of the outbound, required for Zeta")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -32,7 +32,11 @@ func GetTxCmd() *cobra.Command {
 		CmdAddToInTxTracker(),
 		CmdWhitelistERC20(),
 		CmdAbortStuckCCTX(),
+		CmdExtendCctxDeadline(),
 		CmdRefundAborted(),
+		CmdClaimAbortedRefund(),
+		CmdUpdateRateLimit(),
+		CmdRemoveRateLimit(),
 	)
 
 	return cmd
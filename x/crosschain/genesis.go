@@ -53,6 +53,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.FinalizedInbounds {
 		k.SetFinalizedInbound(ctx, elem)
 	}
+
+	// Set all the rate limits
+	for _, elem := range genState.RateLimits {
+		k.SetRateLimit(ctx, elem)
+	}
 }
 
 // ExportGenesis returns the crosschain module's exported genesis.
@@ -90,6 +95,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.ZetaAccounting = amount
 	}
 	genesis.FinalizedInbounds = k.GetAllFinalizedInbound(ctx)
+	genesis.RateLimits = k.GetAllRateLimits(ctx)
 
 	return &genesis
 }
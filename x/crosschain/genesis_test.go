@@ -40,6 +40,11 @@ func TestGenesis(t *testing.T) {
 			sample.InTxHashToCctx(t, "0x1"),
 			sample.InTxHashToCctx(t, "0x2"),
 		},
+		RateLimits: []types.RateLimit{
+			sample.RateLimit(t, "0"),
+			sample.RateLimit(t, "1"),
+			sample.RateLimit(t, "2"),
+		},
 	}
 
 	// Init and export
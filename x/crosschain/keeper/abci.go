@@ -49,8 +49,9 @@ func (k Keeper) IterateAndUpdateCctxGasPrice(
 
 IterateChains:
 	for _, chain := range chains {
-		// support only external evm chains
-		if common.IsEVMChain(chain.ChainId) && !common.IsZetaChain(chain.ChainId) {
+		// support only external evm chains and bitcoin chains
+		// for bitcoin, the outbound is replaced by fee (BIP-125) once its gas price is increased
+		if (common.IsEVMChain(chain.ChainId) && !common.IsZetaChain(chain.ChainId)) || common.IsBitcoinChain(chain.ChainId) {
 			res, err := k.CctxListPending(sdk.UnwrapSDKContext(ctx), &types.QueryListCctxPendingRequest{
 				ChainId: chain.ChainId,
 				Limit:   gasPriceIncreaseFlags.MaxPendingCctxs,
@@ -154,6 +155,19 @@ func CheckAndUpdateCctxGasPrice(
 		)
 	}
 
+	// increase the priority fee as well so the outbound can be replaced on chains supporting EIP-1559
+	if cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee != "" {
+		priorityFee, err := cctx.GetCurrentOutTxParam().GetGasPriorityFee()
+		if err != nil {
+			return math.ZeroUint(), math.ZeroUint(), err
+		}
+		newPriorityFee := math.NewUint(priorityFee).Add(gasPriceIncrease)
+		if newPriorityFee.GT(newGasPrice) {
+			newPriorityFee = newGasPrice
+		}
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = newPriorityFee.String()
+	}
+
 	// set new gas price and last update timestamp
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = newGasPrice.String()
 	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
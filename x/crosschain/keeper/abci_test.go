@@ -87,15 +87,21 @@ func TestKeeper_IterateAndUpdateCctxGasPrice(t *testing.T) {
 	ctx = ctx.WithBlockHeight(observertypes.DefaultCrosschainFlags().GasPriceIncreaseFlags.EpochLength * 2)
 	cctxCount, flags = k.IterateAndUpdateCctxGasPrice(ctx, supportedChains, updateFunc)
 
-	// 2 eth + 5 bsc = 7
-	require.Equal(t, 7, cctxCount)
+	// 2 eth + 5 btc + 5 bsc = 12
+	require.Equal(t, 12, cctxCount)
 	require.Equal(t, customFlags, flags)
 
 	// check that the update function was called with the cctx index
-	require.Equal(t, 7, len(updateFuncMap))
+	require.Equal(t, 12, len(updateFuncMap))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-10"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("1-11"))
 
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-20"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-21"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-22"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-23"))
+	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("8332-24"))
+
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-30"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-31"))
 	require.Contains(t, updateFuncMap, sample.GetCctxIndexFromString("56-32"))
@@ -118,6 +124,7 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 		expectWithdrawFromGasStabilityPoolCall bool
 		expectedGasPriceIncrease               math.Uint
 		expectedAdditionalFees                 math.Uint
+		expectedPriorityFee                    string
 		isError                                bool
 	}{
 		{
@@ -143,6 +150,31 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
 			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
 		},
+		{
+			name: "can update priority fee along with gas price",
+			cctx: types.CrossChainTx{
+				Index: "a1-1",
+				CctxStatus: &types.Status{
+					LastUpdateTimestamp: sampleTimestamp.Unix(),
+				},
+				OutboundTxParams: []*types.OutboundTxParams{
+					{
+						ReceiverChainId:          42,
+						OutboundTxGasLimit:       1000,
+						OutboundTxGasPrice:       "100",
+						OutboundTxGasPriorityFee: "10",
+					},
+				},
+			},
+			flags:                                  observertypes.DefaultGasPriceIncreaseFlags,
+			blockTimestamp:                         retryIntervalReached,
+			medianGasPrice:                         50,
+			withdrawFromGasStabilityPoolReturn:     nil,
+			expectWithdrawFromGasStabilityPoolCall: true,
+			expectedGasPriceIncrease:               math.NewUint(50),    // 100% medianGasPrice
+			expectedAdditionalFees:                 math.NewUint(50000), // gasLimit * increase
+			expectedPriorityFee:                    "60",                // priority fee + increase
+		},
 		{
 			name: "can update gas price at max limit",
 			cctx: types.CrossChainTx{
@@ -393,6 +425,7 @@ func TestCheckAndUpdateCctxGasPrice(t *testing.T) {
 				require.NoError(t, err)
 				require.EqualValues(t, tc.expectedGasPriceIncrease.AddUint64(previousGasPrice).Uint64(), newGasPrice, "%d - %d", tc.expectedGasPriceIncrease.Uint64(), previousGasPrice)
 				require.EqualValues(t, tc.blockTimestamp.Unix(), cctx.CctxStatus.LastUpdateTimestamp)
+				require.Equal(t, tc.expectedPriorityFee, cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee)
 			}
 		})
 	}
@@ -16,6 +16,7 @@ import (
 // 2. set the mapping inTxHash -> cctxIndex , one inTxHash can be connected to multiple cctxindex
 // 3. set the mapping nonce => cctx
 // 4. update the zeta accounting
+// the secondary indexes of the cctx (sender, receiver, status, chains) are updated by SetCrossChainTx
 func (k Keeper) SetCctxAndNonceToCctxAndInTxHashToCctx(ctx sdk.Context, cctx types.CrossChainTx) {
 	k.SetCrossChainTx(ctx, cctx)
 
@@ -54,11 +55,24 @@ func (k Keeper) SetCctxAndNonceToCctxAndInTxHashToCctx(ctx sdk.Context, cctx typ
 }
 
 // SetCrossChainTx set a specific send in the store from its index
+// the secondary indexes of the previous version of the cctx are replaced
 func (k Keeper) SetCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx) {
+	previous, found := k.GetCrossChainTx(ctx, cctx.Index)
+	if found {
+		k.removeCctxIndexes(ctx, previous)
+	}
+
+	// the cctx is queued for pruning once it reaches a terminal state
+	if cctx.IsPrunable() && (!found || !previous.IsPrunable()) {
+		k.AddCctxToPruneQueue(ctx, cctx.Index)
+	}
+
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.SendKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
 	b := k.cdc.MustMarshal(&cctx)
 	store.Set(types.KeyPrefix(cctx.Index), b)
+
+	k.setCctxIndexes(ctx, cctx)
 }
 
 // GetCrossChainTx returns a send from its index
@@ -92,8 +106,12 @@ func (k Keeper) GetAllCrossChainTx(ctx sdk.Context) (list []types.CrossChainTx)
 	return list
 }
 
-// RemoveCrossChainTx removes a send from the store
+// RemoveCrossChainTx removes a send from the store along with its secondary indexes
 func (k Keeper) RemoveCrossChainTx(ctx sdk.Context, index string) {
+	if cctx, found := k.GetCrossChainTx(ctx, index); found {
+		k.removeCctxIndexes(ctx, cctx)
+	}
+
 	p := types.KeyPrefix(fmt.Sprintf("%s", types.SendKey))
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), p)
 	store.Delete(types.KeyPrefix(index))
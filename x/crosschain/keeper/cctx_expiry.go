@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+const (
+	// MaxExpiredCctxsPerBlock is the maximum number of expired cctxs processed in a block
+	MaxExpiredCctxsPerBlock = 100
+
+	// MaxPendingCctxsScannedPerBlock is the maximum number of cctxs pending outbound scanned for expiry in a block
+	// the scan resumes in the next block from the last scanned cctx
+	MaxPendingCctxsScannedPerBlock = 500
+
+	// ExpiredCancelMessage is the status message of an expired cctx whose outbound is cancelled
+	ExpiredCancelMessage = "Outbound expired, cancel outbound"
+
+	// ExpiredRevertMessage is the status message of an expired cctx moved to pending revert
+	ExpiredRevertMessage = "Outbound expired, start revert"
+
+	// ExpiredAbortMessage is the status message of an expired cctx that can't be reverted
+	ExpiredAbortMessage = "Outbound expired, revert not possible"
+)
+
+// GetOutboundDeadline returns the zeta height after which a cctx pending outbound is expired
+// the deadline is computed from the zeta height at which the cctx was created, the max pending blocks of the receiver chain
+// and the extension granted to the cctx by the emergency group
+func GetOutboundDeadline(cctx types.CrossChainTx, maxPendingBlocks uint64) uint64 {
+	return cctx.InboundTxParams.InboundTxFinalizedZetaHeight + maxPendingBlocks + cctx.CctxStatus.DeadlineExtensionBlocks
+}
+
+// ExpirePendingOutbounds iterates through the cctxs pending outbound and expires the ones past their deadline
+// at most MaxPendingCctxsScannedPerBlock cctxs are scanned in a block, the scan starts from the cursor saved in the
+// previous block and wraps around once the end of the cctxs that can be expired is reached
+// cctxs created at an unknown zeta height and cctxs with a cancelled outbound are never expired
+// The function returns the number of cctxs expired
+func (k Keeper) ExpirePendingOutbounds(ctx sdk.Context) int {
+	// get the max pending blocks of the supported chains with an expiry
+	maxPendingBlocks := make(map[int64]uint64)
+	minPendingBlocks := uint64(0)
+	for _, chain := range k.zetaObserverKeeper.GetSupportedChains(ctx) {
+		chainParams, found := k.zetaObserverKeeper.GetChainParamsByChainID(ctx, chain.ChainId)
+		if !found || chainParams.MaxOutboundPendingBlocks == 0 {
+			continue
+		}
+		maxPendingBlocks[chain.ChainId] = chainParams.MaxOutboundPendingBlocks
+		if minPendingBlocks == 0 || chainParams.MaxOutboundPendingBlocks < minPendingBlocks {
+			minPendingBlocks = chainParams.MaxOutboundPendingBlocks
+		}
+	}
+	if len(maxPendingBlocks) == 0 {
+		k.RemoveCctxExpiryCursor(ctx)
+		return 0
+	}
+
+	// #nosec G701 always positive
+	height := uint64(ctx.BlockHeight())
+
+	// collect the expired cctxs from the status index, ordered by creation height
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxStatusIndexKeyPrefix))
+	pendingStore := prefix.NewStore(store, types.CctxIndexFieldKey(types.CctxIndexStatus(types.CctxStatus_PendingOutbound)))
+	var start []byte
+	if cursor, found := k.GetCctxExpiryCursor(ctx); found {
+		// start after the last scanned entry
+		start = append(cursor, 0x00)
+	}
+	iterator := pendingStore.Iterator(start, nil)
+
+	var expired []types.CrossChainTx
+	var cursor []byte
+	for scanned := 0; iterator.Valid(); iterator.Next() {
+		if len(expired) == MaxExpiredCctxsPerBlock || scanned == MaxPendingCctxsScannedPerBlock {
+			break
+		}
+		scanned++
+		cursor = append([]byte{}, iterator.Key()...)
+
+		creationHeight, index, err := types.ParseCctxIndexKey(iterator.Key())
+		if err != nil {
+			ctx.Logger().Error("ExpirePendingOutbounds: invalid cctx index key", "err", err.Error())
+			continue
+		}
+		if creationHeight == 0 {
+			continue
+		}
+
+		// the following cctxs have been created later and can't be expired, the next scan starts from the beginning
+		if creationHeight+minPendingBlocks >= height {
+			cursor = nil
+			break
+		}
+
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		if !found || cctx.GetCurrentOutTxParam().Cancelled {
+			continue
+		}
+		chainPendingBlocks, ok := maxPendingBlocks[cctx.GetCurrentOutTxParam().ReceiverChainId]
+		if ok && GetOutboundDeadline(cctx, chainPendingBlocks) < height {
+			expired = append(expired, cctx)
+		}
+	}
+	if !iterator.Valid() {
+		cursor = nil
+	}
+	iterator.Close()
+
+	if cursor != nil {
+		k.SetCctxExpiryCursor(ctx, cursor)
+	} else {
+		k.RemoveCctxExpiryCursor(ctx)
+	}
+
+	for _, cctx := range expired {
+		receiverChainID := cctx.GetCurrentOutTxParam().ReceiverChainId
+		deadline := GetOutboundDeadline(cctx, maxPendingBlocks[receiverChainID])
+
+		k.ExpireCctx(ctx, &cctx)
+		k.SetCrossChainTx(ctx, cctx)
+		EmitEventCCTXExpired(ctx, cctx, receiverChainID, deadline)
+	}
+
+	return len(expired)
+}
+
+// ExpireCctx cancels the outbound of an expired cctx pending outbound
+// the TSS nonce of the outbound is already assigned on the receiver chain and can't be skipped without halting the
+// following outbounds, and the outbound might already be signed and broadcasted. Therefore the cctx stays pending
+// outbound and the observers sign a cancel tx with the nonce of the outbound instead of the outbound.
+// Once a tx with the nonce is observed, the cctx is moved to PendingRevert, or Aborted with the ZetaAccounting updated
+// if the revert is not possible, if the tx is the cancel tx, or completed if the tx is the outbound.
+// If the cancel tx can't be observed because the receiver chain is halted or TSS can't sign, the cctx stays pending
+// outbound and can be aborted with MsgAbortStuckCCTX.
+func (k Keeper) ExpireCctx(ctx sdk.Context, cctx *types.CrossChainTx) {
+	cctx.GetCurrentOutTxParam().Cancelled = true
+	cctx.CctxStatus.StatusMessage = ExpiredCancelMessage
+	cctx.CctxStatus.LastUpdateTimestamp = ctx.BlockHeader().Time.Unix()
+}
+
+// SetCctxExpiryCursor sets the last entry of the pending outbound status index scanned for expired cctxs
+func (k Keeper) SetCctxExpiryCursor(ctx sdk.Context, cursor []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.CctxExpiryCursorKey), cursor)
+}
+
+// GetCctxExpiryCursor returns the last entry of the pending outbound status index scanned for expired cctxs
+func (k Keeper) GetCctxExpiryCursor(ctx sdk.Context) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get([]byte(types.CctxExpiryCursorKey))
+	return cursor, cursor != nil
+}
+
+// RemoveCctxExpiryCursor removes the cursor so the next scan for expired cctxs starts from the beginning
+func (k Keeper) RemoveCctxExpiryCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.CctxExpiryCursorKey))
+}
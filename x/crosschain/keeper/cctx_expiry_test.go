@@ -0,0 +1,330 @@
+package keeper_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	zetacommon "github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setChainsWithExpiry sets the chains as supported with the provided max outbound pending blocks
+func setChainsWithExpiry(ctx sdk.Context, zk keepertest.ZetaKeepers, maxPendingBlocks uint64, chainIDs ...int64) {
+	chainParamsList := make([]*observertypes.ChainParams, len(chainIDs))
+	for i, chainID := range chainIDs {
+		chainParams := sample.ChainParamsSupported(chainID)
+		chainParams.MaxOutboundPendingBlocks = maxPendingBlocks
+		chainParamsList[i] = chainParams
+	}
+	zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+		ChainParams: chainParamsList,
+	})
+}
+
+// voteOutbound votes with all the observers on the outbound of the cctx with the provided status and value
+func voteOutbound(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	observers []string,
+	cctx types.CrossChainTx,
+	status zetacommon.ReceiveStatus,
+	value math.Uint,
+) {
+	msgServer := keeper.NewMsgServerImpl(*k)
+	for _, observer := range observers {
+		_, err := msgServer.VoteOnObservedOutboundTx(ctx, &types.MsgVoteOnObservedOutboundTx{
+			Creator:                        observer,
+			CctxHash:                       cctx.Index,
+			ObservedOutTxHash:              sample.Hash().String(),
+			ObservedOutTxBlockHeight:       42,
+			ObservedOutTxEffectiveGasPrice: math.ZeroInt(),
+			ValueReceived:                  value,
+			Status:                         status,
+			OutTxChain:                     cctx.GetCurrentOutTxParam().ReceiverChainId,
+			OutTxTssNonce:                  cctx.GetCurrentOutTxParam().OutboundTxTssNonce,
+			CoinType:                       cctx.GetCurrentOutTxParam().CoinType,
+		})
+		require.NoError(t, err)
+	}
+}
+
+// setPendingOutboundCctx sets a cctx pending outbound created at the provided height
+func setPendingOutboundCctx(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	index string,
+	senderChainID int64,
+	receiverChainID int64,
+	coinType zetacommon.CoinType,
+	height uint64,
+) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingOutbound}
+	cctx.InboundTxParams.SenderChainId = senderChainID
+	cctx.InboundTxParams.CoinType = coinType
+	cctx.InboundTxParams.Amount = math.NewUint(inputAmount)
+	cctx.InboundTxParams.InboundTxFinalizedZetaHeight = height
+	cctx.OutboundTxParams = cctx.OutboundTxParams[:1]
+	cctx.GetCurrentOutTxParam().ReceiverChainId = receiverChainID
+	cctx.GetCurrentOutTxParam().CoinType = coinType
+	cctx.GetCurrentOutTxParam().Amount = math.NewUint(inputAmount)
+	k.SetCrossChainTx(ctx, *cctx)
+	return *cctx
+}
+
+func TestKeeper_ExpirePendingOutbounds(t *testing.T) {
+	t.Run("should not expire cctxs if no chain has an expiry", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		chainID := getValidEthChainID(t)
+		setChainsWithExpiry(ctx, zk, 0, chainID)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Zeta, 10)
+
+		require.Equal(t, 0, k.ExpirePendingOutbounds(ctx))
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.False(t, cctx.GetCurrentOutTxParam().Cancelled)
+	})
+
+	t.Run("should cancel the outbound of the expired cctxs", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		chainID := getValidEthChainID(t)
+		zetaChainID := zetacommon.ZetaPrivnetChain().ChainId
+		setChainsWithExpiry(ctx, zk, 100, chainID)
+
+		// expired
+		cctx1 := setPendingOutboundCctx(t, ctx, k, "1", zetaChainID, chainID, zetacommon.CoinType_Zeta, 10)
+		cctx2 := setPendingOutboundCctx(t, ctx, k, "2", chainID, chainID, zetacommon.CoinType_Cmd, 20)
+		// not expired because of the extension
+		cctx3 := setPendingOutboundCctx(t, ctx, k, "3", zetaChainID, chainID, zetacommon.CoinType_Zeta, 30)
+		cctx3.CctxStatus.DeadlineExtensionBlocks = 1000
+		k.SetCrossChainTx(ctx, cctx3)
+		// not expired yet
+		cctx4 := setPendingOutboundCctx(t, ctx, k, "4", zetaChainID, chainID, zetacommon.CoinType_Zeta, 900)
+		// unknown creation height
+		cctx5 := setPendingOutboundCctx(t, ctx, k, "5", zetaChainID, chainID, zetacommon.CoinType_Zeta, 0)
+
+		require.Equal(t, 2, k.ExpirePendingOutbounds(ctx))
+
+		// the cctxs stay pending outbound until a tx with the nonce of the outbound is observed
+		for _, expected := range []types.CrossChainTx{cctx1, cctx2} {
+			cctx, found := k.GetCrossChainTx(ctx, expected.Index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+			require.Equal(t, keeper.ExpiredCancelMessage, cctx.CctxStatus.StatusMessage)
+			require.True(t, cctx.GetCurrentOutTxParam().Cancelled)
+			require.Len(t, cctx.OutboundTxParams, 1)
+			require.Equal(t, expected.GetCurrentOutTxParam().OutboundTxTssNonce, cctx.GetCurrentOutTxParam().OutboundTxTssNonce)
+		}
+		for _, index := range []string{cctx3.Index, cctx4.Index, cctx5.Index} {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+			require.False(t, cctx.GetCurrentOutTxParam().Cancelled)
+		}
+
+		// an event is emitted for each expired cctx, reporting the unchanged status
+		events := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "zetachain.zetacore.crosschain.EventCCTXExpired" {
+				events++
+				typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				require.Equal(t, types.CctxStatus_PendingOutbound.String(), typedEvent.(*types.EventCCTXExpired).Status)
+			}
+		}
+		require.Equal(t, 2, events)
+
+		// the cctxs with a cancelled outbound are not expired again
+		require.Equal(t, 0, k.ExpirePendingOutbounds(ctx))
+	})
+
+	t.Run("should scan a bounded number of cctxs per block", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		chainID := getValidEthChainID(t)
+		noExpiryChainID := getValidBtcChainID()
+		chainParams := sample.ChainParamsSupported(chainID)
+		chainParams.MaxOutboundPendingBlocks = 100
+		zk.ObserverKeeper.SetChainParamsList(ctx, observertypes.ChainParamsList{
+			ChainParams: []*observertypes.ChainParams{chainParams, sample.ChainParamsSupported(noExpiryChainID)},
+		})
+
+		// the cctxs of the chain without expiry fill the scan of the first block
+		for i := 0; i < keeper.MaxPendingCctxsScannedPerBlock; i++ {
+			setPendingOutboundCctx(t, ctx, k, fmt.Sprintf("no-expiry-%d", i), chainID, noExpiryChainID, zetacommon.CoinType_Gas, 10)
+		}
+		cctx := setPendingOutboundCctx(t, ctx, k, "expired", chainID, chainID, zetacommon.CoinType_Gas, 20)
+
+		require.Equal(t, 0, k.ExpirePendingOutbounds(ctx))
+		_, found := k.GetCctxExpiryCursor(ctx)
+		require.True(t, found)
+
+		// the scan resumes from the cursor in the next block
+		ctx = ctx.WithBlockHeight(1001)
+		require.Equal(t, 1, k.ExpirePendingOutbounds(ctx))
+		cctx, found = k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.True(t, cctx.GetCurrentOutTxParam().Cancelled)
+
+		// the scan starts from the beginning once all the cctxs have been scanned
+		_, found = k.GetCctxExpiryCursor(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("should abort the expired cctx that can't be reverted once the cancel tx is observed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+		observers := setObservers(t, k, ctx, zk)
+		chainID := getValidEthChainID(t)
+		zetaChainID := zetacommon.ZetaPrivnetChain().ChainId
+		setChainsWithExpiry(ctx, zk, 100, chainID)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetaChainID, chainID, zetacommon.CoinType_Zeta, 10)
+
+		require.Equal(t, 1, k.ExpirePendingOutbounds(ctx))
+		cctx, _ = k.GetCrossChainTx(ctx, cctx.Index)
+		voteOutbound(t, ctx, k, observers, cctx, zetacommon.ReceiveStatus_Failed, math.ZeroUint())
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+		require.Equal(t, keeper.ExpiredAbortMessage, cctx.CctxStatus.StatusMessage)
+
+		// the aborted zeta amount is added to the accounting
+		zetaAccounting, found := k.GetZetaAccounting(ctx)
+		require.True(t, found)
+		require.Equal(t, keeper.GetAbortedAmount(cctx), zetaAccounting.AbortedZetaAmount)
+	})
+
+	// setupRevert sets a cctx pending outbound from an evm chain to bitcoin with the pending nonce and out tx tracker
+	// of its outbound, and the gas payment and nonces of the sender chain
+	setupRevert := func(t *testing.T) (*keeper.Keeper, sdk.Context, keepertest.ZetaKeepers, []string, types.CrossChainTx) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		ctx = ctx.WithBlockHeight(1000)
+		tss := sample.Tss()
+		zk.ObserverKeeper.SetTSS(ctx, tss)
+		observers := setObservers(t, k, ctx, zk)
+		senderChainID := getValidEthChainID(t)
+		receiverChainID := getValidBtcChainID()
+		setChainsWithExpiry(ctx, zk, 100, senderChainID, receiverChainID)
+
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, senderChainID, "foobar", "foobar")
+		_, err := zk.FungibleKeeper.UpdateZRC20ProtocolFlatFee(ctx, zrc20, big.NewInt(withdrawFee))
+		require.NoError(t, err)
+		k.SetGasPrice(ctx, types.GasPrice{
+			ChainId:     senderChainID,
+			MedianIndex: 0,
+			Prices:      []uint64{gasPrice},
+		})
+		senderChain := zetacommon.GetChainFromChainID(senderChainID)
+		zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{
+			Index:   senderChain.ChainName.String(),
+			ChainId: senderChainID,
+			Nonce:   42,
+		})
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   senderChainID,
+			NonceLow:  42,
+			NonceHigh: 42,
+			Tss:       tss.TssPubkey,
+		})
+
+		// the outbound nonce is pending on the receiver chain and the outbound might have been broadcasted
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", senderChainID, receiverChainID, zetacommon.CoinType_Gas, 10)
+		cctx.GetCurrentOutTxParam().OutboundTxTssNonce = 7
+		k.SetCrossChainTx(ctx, cctx)
+		zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+			ChainId:   receiverChainID,
+			NonceLow:  7,
+			NonceHigh: 8,
+			Tss:       tss.TssPubkey,
+		})
+		k.SetOutTxTracker(ctx, types.OutTxTracker{
+			ChainId:  receiverChainID,
+			Nonce:    7,
+			HashList: []*types.TxHashList{{TxHash: sample.Hash().String()}},
+		})
+
+		require.Equal(t, 1, k.ExpirePendingOutbounds(ctx))
+		cctx, _ = k.GetCrossChainTx(ctx, cctx.Index)
+
+		// the outbound nonce is still pending until a tx with the nonce is observed
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, receiverChainID)
+		require.True(t, found)
+		require.EqualValues(t, 7, pendingNonces.NonceLow)
+		_, found = k.GetOutTxTracker(ctx, receiverChainID, 7)
+		require.True(t, found)
+
+		return k, ctx, zk, observers, cctx
+	}
+
+	t.Run("should revert the expired cctx once the cancel tx is observed", func(t *testing.T) {
+		k, ctx, zk, observers, cctx := setupRevert(t)
+		senderChainID := cctx.InboundTxParams.SenderChainId
+		receiverChainID := cctx.GetCurrentOutTxParam().ReceiverChainId
+
+		// the cancel tx consuming the outbound nonce is observed as a failed outbound
+		voteOutbound(t, ctx, k, observers, cctx, zetacommon.ReceiveStatus_Failed, math.ZeroUint())
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingRevert, cctx.CctxStatus.Status)
+		require.Equal(t, keeper.ExpiredRevertMessage, cctx.CctxStatus.StatusMessage)
+		require.Len(t, cctx.OutboundTxParams, 2)
+		require.Equal(t, senderChainID, cctx.GetCurrentOutTxParam().ReceiverChainId)
+		require.Equal(t, cctx.InboundTxParams.Sender, cctx.GetCurrentOutTxParam().Receiver)
+		require.EqualValues(t, 42, cctx.GetCurrentOutTxParam().OutboundTxTssNonce)
+		require.False(t, cctx.GetCurrentOutTxParam().Cancelled)
+
+		// the revert is mapped to the nonce of the sender chain
+		tss, _ := zk.ObserverKeeper.GetTSS(ctx)
+		nonceToCctx, found := zk.ObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, senderChainID, 42)
+		require.True(t, found)
+		require.Equal(t, cctx.Index, nonceToCctx.CctxIndex)
+
+		// the nonce of the cancelled outbound is consumed on the receiver chain
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, receiverChainID)
+		require.True(t, found)
+		require.EqualValues(t, 8, pendingNonces.NonceLow)
+		_, found = k.GetOutTxTracker(ctx, receiverChainID, 7)
+		require.False(t, found)
+	})
+
+	t.Run("should complete the expired cctx if the outbound is observed instead of the cancel tx", func(t *testing.T) {
+		k, ctx, zk, observers, cctx := setupRevert(t)
+		receiverChainID := cctx.GetCurrentOutTxParam().ReceiverChainId
+
+		// the outbound broadcasted before the expiry is mined instead of the cancel tx
+		voteOutbound(t, ctx, k, observers, cctx, zetacommon.ReceiveStatus_Success, cctx.GetCurrentOutTxParam().Amount)
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_OutboundMined, cctx.CctxStatus.Status)
+		require.Len(t, cctx.OutboundTxParams, 1)
+
+		// the nonce of the outbound is consumed on the receiver chain and no revert is created
+		tss, _ := zk.ObserverKeeper.GetTSS(ctx)
+		pendingNonces, found := zk.ObserverKeeper.GetPendingNonces(ctx, tss.TssPubkey, receiverChainID)
+		require.True(t, found)
+		require.EqualValues(t, 8, pendingNonces.NonceLow)
+		_, found = zk.ObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, cctx.InboundTxParams.SenderChainId, 42)
+		require.False(t, found)
+	})
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// cctxIndexEntry is a secondary index entry of a CCTX
+type cctxIndexEntry struct {
+	keyPrefix string
+	field     string
+}
+
+// cctxIndexEntries returns the secondary index entries of a CCTX
+// the sender index contains both the sender and the tx origin of the inbound
+// the receiver index only contains the receiver of the original outbound, reverts are not indexed
+func cctxIndexEntries(cctx types.CrossChainTx) []cctxIndexEntry {
+	var entries []cctxIndexEntry
+
+	if cctx.InboundTxParams != nil {
+		sender := types.CctxIndexAddress(cctx.InboundTxParams.Sender)
+		txOrigin := types.CctxIndexAddress(cctx.InboundTxParams.TxOrigin)
+		if sender != "" {
+			entries = append(entries, cctxIndexEntry{types.CctxSenderIndexKeyPrefix, sender})
+		}
+		if txOrigin != "" && txOrigin != sender {
+			entries = append(entries, cctxIndexEntry{types.CctxSenderIndexKeyPrefix, txOrigin})
+		}
+		entries = append(entries, cctxIndexEntry{
+			types.CctxSenderChainIndexKeyPrefix,
+			types.CctxIndexChainID(cctx.InboundTxParams.SenderChainId),
+		})
+	}
+	if len(cctx.OutboundTxParams) > 0 {
+		receiver := types.CctxIndexAddress(cctx.OutboundTxParams[0].Receiver)
+		if receiver != "" {
+			entries = append(entries, cctxIndexEntry{types.CctxReceiverIndexKeyPrefix, receiver})
+		}
+		entries = append(entries, cctxIndexEntry{
+			types.CctxReceiverChainIndexKeyPrefix,
+			types.CctxIndexChainID(cctx.OriginalDestinationChainID()),
+		})
+	}
+	if cctx.CctxStatus != nil {
+		entries = append(entries, cctxIndexEntry{
+			types.CctxStatusIndexKeyPrefix,
+			types.CctxIndexStatus(cctx.CctxStatus.Status),
+		})
+	}
+
+	return entries
+}
+
+// cctxIndexHeight returns the zeta height used to order the CCTX in the secondary indexes
+func cctxIndexHeight(cctx types.CrossChainTx) uint64 {
+	if cctx.InboundTxParams == nil {
+		return 0
+	}
+	return cctx.InboundTxParams.InboundTxFinalizedZetaHeight
+}
+
+// setCctxIndexes sets the secondary index entries of a CCTX
+func (k Keeper) setCctxIndexes(ctx sdk.Context, cctx types.CrossChainTx) {
+	height := cctxIndexHeight(cctx)
+	for _, entry := range cctxIndexEntries(cctx) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(entry.keyPrefix))
+		store.Set(types.CctxIndexKey(entry.field, height, cctx.Index), []byte{})
+	}
+}
+
+// removeCctxIndexes removes the secondary index entries of a CCTX
+func (k Keeper) removeCctxIndexes(ctx sdk.Context, cctx types.CrossChainTx) {
+	height := cctxIndexHeight(cctx)
+	for _, entry := range cctxIndexEntries(cctx) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(entry.keyPrefix))
+		store.Delete(types.CctxIndexKey(entry.field, height, cctx.Index))
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// AddCctxToPruneQueue adds a cctx to the prune queue at the current zeta height
+// the cctx is pruned once the retention period defined in the pruning flags has elapsed
+func (k Keeper) AddCctxToPruneQueue(ctx sdk.Context, cctxIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruneQueueKeyPrefix))
+	// #nosec G701 always positive
+	store.Set(types.CctxPruneQueueKey(uint64(ctx.BlockHeight()), cctxIndex), []byte(cctxIndex))
+}
+
+// PruneCctxs removes from the state the cctxs that reached a terminal state before the retention period
+// the finalized inbounds are kept to prevent an inbound from being processed twice
+// the retention period is at least one block longer than the ballot maturity so the ballots of the pruned cctxs
+// have been processed for the observer rewards, even if the ballot maturity has been increased after the pruning flags were set
+// The function returns the number of cctxs pruned
+func (k Keeper) PruneCctxs(ctx sdk.Context) int {
+	flags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
+	if !found || flags.PruningFlags == nil || flags.PruningFlags.RetentionBlocks <= 0 {
+		return 0
+	}
+	retentionBlocks := flags.PruningFlags.RetentionBlocks
+	if ballotMaturityBlocks := k.zetaObserverKeeper.GetParams(ctx).BallotMaturityBlocks; retentionBlocks <= ballotMaturityBlocks {
+		retentionBlocks = ballotMaturityBlocks + 1
+	}
+	if ctx.BlockHeight() <= retentionBlocks {
+		return 0
+	}
+	maxPruned := int(flags.PruningFlags.MaxPrunedPerBlock)
+
+	// collect the cctxs that became prunable before the retention period
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruneQueueKeyPrefix))
+	// #nosec G701 always positive
+	end := types.CctxPruneQueueHeightKey(uint64(ctx.BlockHeight() - retentionBlocks))
+	iterator := store.Iterator(nil, end)
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < maxPruned; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	pruned := 0
+	for _, key := range keys {
+		store.Delete(key)
+
+		_, index, err := types.ParseCctxPruneQueueKey(key)
+		if err != nil {
+			ctx.Logger().Error("PruneCctxs: invalid cctx prune queue key", "err", err.Error())
+			continue
+		}
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		if !found || !cctx.IsPrunable() {
+			continue
+		}
+		k.PruneCctx(ctx, cctx)
+		pruned++
+	}
+
+	return pruned
+}
+
+// PruneCctx removes a cctx from the state along with its inbound hash and nonce mappings and its ballots
+// the secondary indexes of the cctx are removed by RemoveCrossChainTx
+func (k Keeper) PruneCctx(ctx sdk.Context, cctx types.CrossChainTx) {
+	k.RemoveCrossChainTx(ctx, cctx.Index)
+
+	// remove the cctx from the inbound hash mapping and its inbound ballot
+	if cctx.InboundTxParams != nil {
+		k.removeCctxFromInTxHashToCctx(ctx, cctx.InboundTxParams.InboundTxObservedHash, cctx.Index)
+		if cctx.InboundTxParams.InboundTxBallotIndex != "" {
+			k.zetaObserverKeeper.RemoveBallot(ctx, cctx.InboundTxParams.InboundTxBallotIndex)
+		}
+	}
+
+	// remove the nonce mappings and the ballots of the outbounds
+	for _, outTxParams := range cctx.OutboundTxParams {
+		// #nosec G701 always in range
+		nonce := int64(outTxParams.OutboundTxTssNonce)
+		nonceToCctx, found := k.zetaObserverKeeper.GetNonceToCctx(ctx, outTxParams.TssPubkey, outTxParams.ReceiverChainId, nonce)
+		if found && nonceToCctx.CctxIndex == cctx.Index {
+			k.zetaObserverKeeper.RemoveNonceToCctx(ctx, nonceToCctx)
+		}
+		if outTxParams.OutboundTxBallotIndex != "" {
+			k.zetaObserverKeeper.RemoveBallot(ctx, outTxParams.OutboundTxBallotIndex)
+		}
+	}
+}
+
+// removeCctxFromInTxHashToCctx removes a cctx index from the inbound hash mapping, the mapping is removed if empty
+func (k Keeper) removeCctxFromInTxHashToCctx(ctx sdk.Context, inTxHash string, cctxIndex string) {
+	in, found := k.GetInTxHashToCctx(ctx, inTxHash)
+	if !found {
+		return
+	}
+	cctxIndexes := make([]string, 0, len(in.CctxIndex))
+	for _, index := range in.CctxIndex {
+		if index != cctxIndex {
+			cctxIndexes = append(cctxIndexes, index)
+		}
+	}
+	if len(cctxIndexes) == 0 {
+		k.RemoveInTxHashToCctx(ctx, inTxHash)
+		return
+	}
+	in.CctxIndex = cctxIndexes
+	k.SetInTxHashToCctx(ctx, in)
+}
@@ -0,0 +1,259 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setPruningFlags sets the crosschain flags with the provided pruning flags
+func setPruningFlags(ctx sdk.Context, zk keepertest.ZetaKeepers, retentionBlocks int64, maxPrunedPerBlock uint32) {
+	flags := observertypes.DefaultCrosschainFlags()
+	flags.PruningFlags = &observertypes.PruningFlags{
+		RetentionBlocks:   retentionBlocks,
+		MaxPrunedPerBlock: maxPrunedPerBlock,
+	}
+	zk.ObserverKeeper.SetCrosschainFlags(ctx, *flags)
+}
+
+// setCctxWithStatus sets a cctx with the provided status along with its inbound hash and nonce mappings and its ballots
+func setCctxWithStatus(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	zk keepertest.ZetaKeepers,
+	index string,
+	status types.CctxStatus,
+) types.CrossChainTx {
+	cctx := sample.CrossChainTx(t, index)
+	cctx.CctxStatus = &types.Status{Status: status}
+	cctx.InboundTxParams.InboundTxBallotIndex = index
+	cctx.OutboundTxParams = cctx.OutboundTxParams[:1]
+	cctx.GetCurrentOutTxParam().OutboundTxBallotIndex = index + "-outbound"
+	cctx.GetCurrentOutTxParam().TssPubkey = sample.Tss().TssPubkey
+	k.SetCrossChainTx(ctx, *cctx)
+	k.SetInTxHashToCctx(ctx, types.InTxHashToCctx{
+		InTxHash:  cctx.InboundTxParams.InboundTxObservedHash,
+		CctxIndex: []string{cctx.Index},
+	})
+
+	// #nosec G701 always in range
+	zk.ObserverKeeper.SetNonceToCctx(ctx, observertypes.NonceToCctx{
+		ChainId:   cctx.GetCurrentOutTxParam().ReceiverChainId,
+		Nonce:     int64(cctx.GetCurrentOutTxParam().OutboundTxTssNonce),
+		CctxIndex: cctx.Index,
+		Tss:       cctx.GetCurrentOutTxParam().TssPubkey,
+	})
+	for _, ballotIndex := range []string{index, index + "-outbound"} {
+		zk.ObserverKeeper.SetBallot(ctx, &observertypes.Ballot{
+			BallotIdentifier:     ballotIndex,
+			BallotCreationHeight: ctx.BlockHeight(),
+		})
+	}
+	return *cctx
+}
+
+// requireCctxPruned checks the cctx and its related records are removed from the state
+func requireCctxPruned(t *testing.T, ctx sdk.Context, k *keeper.Keeper, zk keepertest.ZetaKeepers, cctx types.CrossChainTx) {
+	_, found := k.GetCrossChainTx(ctx, cctx.Index)
+	require.False(t, found)
+	_, found = k.GetInTxHashToCctx(ctx, cctx.InboundTxParams.InboundTxObservedHash)
+	require.False(t, found)
+	// #nosec G701 always in range
+	_, found = zk.ObserverKeeper.GetNonceToCctx(
+		ctx,
+		cctx.GetCurrentOutTxParam().TssPubkey,
+		cctx.GetCurrentOutTxParam().ReceiverChainId,
+		int64(cctx.GetCurrentOutTxParam().OutboundTxTssNonce),
+	)
+	require.False(t, found)
+	_, found = zk.ObserverKeeper.GetBallot(ctx, cctx.InboundTxParams.InboundTxBallotIndex)
+	require.False(t, found)
+	_, found = zk.ObserverKeeper.GetBallot(ctx, cctx.GetCurrentOutTxParam().OutboundTxBallotIndex)
+	require.False(t, found)
+}
+
+func TestKeeper_PruneCctxs(t *testing.T) {
+	t.Run("should not prune cctxs if pruning is disabled", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(10)
+		cctx := setCctxWithStatus(t, ctx, k, zk, "1", types.CctxStatus_OutboundMined)
+
+		setPruningFlags(ctx, zk, 0, 100)
+		ctx = ctx.WithBlockHeight(1000)
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		_, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+	})
+
+	t.Run("should prune the terminal cctxs older than the retention period", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setPruningFlags(ctx, zk, 150, 100)
+
+		ctx = ctx.WithBlockHeight(10)
+		mined := setCctxWithStatus(t, ctx, k, zk, "1", types.CctxStatus_OutboundMined)
+		reverted := setCctxWithStatus(t, ctx, k, zk, "2", types.CctxStatus_Reverted)
+		aborted := setCctxWithStatus(t, ctx, k, zk, "3", types.CctxStatus_Aborted)
+		pending := setCctxWithStatus(t, ctx, k, zk, "4", types.CctxStatus_PendingOutbound)
+		ctx = ctx.WithBlockHeight(50)
+		recent := setCctxWithStatus(t, ctx, k, zk, "5", types.CctxStatus_OutboundMined)
+
+		// the inbound is kept as finalized
+		k.AddFinalizedInbound(ctx, mined.InboundTxParams.InboundTxObservedHash, mined.InboundTxParams.SenderChainId, 0)
+
+		// nothing to prune before the retention period
+		ctx = ctx.WithBlockHeight(160)
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+
+		ctx = ctx.WithBlockHeight(161)
+		require.Equal(t, 2, k.PruneCctxs(ctx))
+		requireCctxPruned(t, ctx, k, zk, mined)
+		requireCctxPruned(t, ctx, k, zk, reverted)
+		require.True(t, k.IsFinalizedInbound(ctx, mined.InboundTxParams.InboundTxObservedHash, mined.InboundTxParams.SenderChainId, 0))
+
+		// aborted cctxs not refunded and pending cctxs are kept
+		for _, cctx := range []types.CrossChainTx{aborted, pending, recent} {
+			_, found := k.GetCrossChainTx(ctx, cctx.Index)
+			require.True(t, found)
+		}
+
+		// the refunded aborted cctx is pruned after the retention period
+		aborted.CctxStatus.AbortRefunded(ctx.BlockTime().Unix())
+		k.SetCrossChainTx(ctx, aborted)
+
+		ctx = ctx.WithBlockHeight(201)
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		requireCctxPruned(t, ctx, k, zk, recent)
+
+		ctx = ctx.WithBlockHeight(312)
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		requireCctxPruned(t, ctx, k, zk, aborted)
+
+		_, found := k.GetCrossChainTx(ctx, pending.Index)
+		require.True(t, found)
+	})
+
+	t.Run("should keep the cctxs and their ballots until the ballots are matured", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		maturityBlocks := zk.ObserverKeeper.GetParams(ctx).BallotMaturityBlocks
+		setPruningFlags(ctx, zk, 10, 100)
+
+		ctx = ctx.WithBlockHeight(10)
+		cctx := setCctxWithStatus(t, ctx, k, zk, "1", types.CctxStatus_OutboundMined)
+
+		// the retention period is extended beyond the ballot maturity
+		ctx = ctx.WithBlockHeight(10 + maturityBlocks + 1)
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		_, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		_, found = zk.ObserverKeeper.GetBallot(ctx, cctx.InboundTxParams.InboundTxBallotIndex)
+		require.True(t, found)
+
+		ctx = ctx.WithBlockHeight(10 + maturityBlocks + 2)
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		requireCctxPruned(t, ctx, k, zk, cctx)
+	})
+
+	t.Run("should prune at most max pruned per block", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setPruningFlags(ctx, zk, 100, 2)
+
+		ctx = ctx.WithBlockHeight(10)
+		for _, index := range []string{"1", "2", "3"} {
+			setCctxWithStatus(t, ctx, k, zk, index, types.CctxStatus_OutboundMined)
+		}
+
+		ctx = ctx.WithBlockHeight(200)
+		require.Equal(t, 2, k.PruneCctxs(ctx))
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		require.Equal(t, 0, k.PruneCctxs(ctx))
+		require.Empty(t, k.GetAllCrossChainTx(ctx))
+	})
+
+	t.Run("should keep the other cctxs of the inbound hash", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		setPruningFlags(ctx, zk, 100, 100)
+
+		ctx = ctx.WithBlockHeight(10)
+		cctx := setCctxWithStatus(t, ctx, k, zk, "1", types.CctxStatus_OutboundMined)
+		k.SetInTxHashToCctx(ctx, types.InTxHashToCctx{
+			InTxHash:  cctx.InboundTxParams.InboundTxObservedHash,
+			CctxIndex: []string{cctx.Index, "2"},
+		})
+
+		ctx = ctx.WithBlockHeight(200)
+		require.Equal(t, 1, k.PruneCctxs(ctx))
+		in, found := k.GetInTxHashToCctx(ctx, cctx.InboundTxParams.InboundTxObservedHash)
+		require.True(t, found)
+		require.Equal(t, []string{"2"}, in.CctxIndex)
+	})
+}
+
+func TestKeeper_CctxListPrunable(t *testing.T) {
+	t.Run("should fail for empty req", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxListPrunable(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("should fail if min height is greater than max height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxListPrunable(ctx, &types.QueryListCctxPrunableRequest{MinHeight: 2, MaxHeight: 1})
+		require.ErrorContains(t, err, "min height is greater than max height")
+	})
+
+	t.Run("should list the prunable cctxs in the height range", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+
+		ctx = ctx.WithBlockHeight(10)
+		cctx1 := setCctxWithStatus(t, ctx, k, zk, "1", types.CctxStatus_OutboundMined)
+		setCctxWithStatus(t, ctx, k, zk, "2", types.CctxStatus_PendingOutbound)
+		ctx = ctx.WithBlockHeight(20)
+		cctx3 := setCctxWithStatus(t, ctx, k, zk, "3", types.CctxStatus_Reverted)
+		ctx = ctx.WithBlockHeight(30)
+		cctx4 := setCctxWithStatus(t, ctx, k, zk, "4", types.CctxStatus_OutboundMined)
+
+		res, err := k.CctxListPrunable(ctx, &types.QueryListCctxPrunableRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []*types.CrossChainTx{&cctx1, &cctx3, &cctx4}, res.CrossChainTx)
+
+		res, err = k.CctxListPrunable(ctx, &types.QueryListCctxPrunableRequest{MinHeight: 20, MaxHeight: 20})
+		require.NoError(t, err)
+		require.Equal(t, []*types.CrossChainTx{&cctx3}, res.CrossChainTx)
+
+		res, err = k.CctxListPrunable(ctx, &types.QueryListCctxPrunableRequest{
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 2)
+		require.EqualValues(t, 3, res.Pagination.Total)
+	})
+
+	t.Run("should only iterate the prune queue entries of the height range", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+
+		ctx = ctx.WithBlockHeight(20)
+		cctx := setCctxWithStatus(t, ctx, k, zk, "1", types.CctxStatus_OutboundMined)
+
+		// set prune queue entries outside the height range that would fail the query if iterated
+		store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), types.KeyPrefix(types.CctxPruneQueueKeyPrefix))
+		for _, height := range []uint64{10, 30} {
+			store.Set(types.CctxPruneQueueHeightKey(height), []byte{0x01})
+		}
+
+		res, err := k.CctxListPrunable(ctx, &types.QueryListCctxPrunableRequest{MinHeight: 15, MaxHeight: 25})
+		require.NoError(t, err)
+		require.Equal(t, []*types.CrossChainTx{&cctx}, res.CrossChainTx)
+
+		_, err = k.CctxListPrunable(ctx, &types.QueryListCctxPrunableRequest{})
+		require.Error(t, err)
+	})
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"strconv"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/zeta-chain/zetacore/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -85,3 +87,30 @@ func EmitOutboundFailure(ctx sdk.Context, msg *types.MsgVoteOnObservedOutboundTx
 		ctx.Logger().Error("Error emitting MsgVoteOnObservedOutboundTx :", err)
 	}
 }
+
+func EmitEventCCTXExpired(ctx sdk.Context, cctx types.CrossChainTx, receiverChainID int64, deadline uint64) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCCTXExpired{
+		CctxIndex:      cctx.Index,
+		Status:         cctx.CctxStatus.Status.String(),
+		StatusMessage:  cctx.CctxStatus.StatusMessage,
+		ReceiverChain:  common.GetChainFromChainID(receiverChainID).ChainName.String(),
+		DeadlineHeight: strconv.FormatUint(deadline, 10),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventCCTXExpired :", err)
+	}
+}
+
+func EmitEventCCTXRateLimited(ctx sdk.Context, cctx types.CrossChainTx, rateLimit types.RateLimit, windowUsage sdkmath.Uint) {
+	err := ctx.EventManager().EmitTypedEvent(&types.EventCCTXRateLimited{
+		CctxIndex:     cctx.Index,
+		ReceiverChain: common.GetChainFromChainID(rateLimit.ChainId).ChainName.String(),
+		Zrc20Address:  rateLimit.Zrc20Address,
+		Amount:        cctx.GetCurrentOutTxParam().Amount.String(),
+		WindowUsage:   windowUsage.String(),
+		Limit:         rateLimit.Limit.String(),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventCCTXRateLimited :", err)
+	}
+}
@@ -108,6 +108,9 @@ func (k Keeper) ProcessLogs(ctx sdk.Context, logs []*ethtypes.Log, emittingContr
 			if err := ValidateZrc20WithdrawEvent(eventZrc20Withdrawal, coin.ForeignChainId); err != nil {
 				return err
 			}
+			if err := k.checkForeignCoinOutboundEnabled(ctx, coin.ForeignChainId, coin.CoinType, coin.Asset); err != nil {
+				return err
+			}
 			// If the event is valid, we will process it and create a new CCTX
 			// If the process fails, we will return an error and roll back the transaction
 			if err := k.ProcessZRC20WithdrawalEvent(ctx, eventZrc20Withdrawal, emittingContract, txOrigin, tss); err != nil {
@@ -116,6 +119,9 @@ func (k Keeper) ProcessLogs(ctx sdk.Context, logs []*ethtypes.Log, emittingContr
 		}
 		// if eventZetaSent is not nil we will try to validate it and see if it can be processed
 		if eventZetaSent != nil {
+			if err := k.checkChainOutboundEnabled(ctx, eventZetaSent.DestinationChainId.Int64()); err != nil {
+				return err
+			}
 			if err := k.ProcessZetaSentEvent(ctx, eventZetaSent, emittingContract, txOrigin, tss); err != nil {
 				return err
 			}
@@ -124,6 +130,30 @@ func (k Keeper) ProcessLogs(ctx sdk.Context, logs []*ethtypes.Log, emittingContr
 	return nil
 }
 
+// checkChainOutboundEnabled returns an error if the outbounds to the chain are disabled in the crosschain flags
+func (k Keeper) checkChainOutboundEnabled(ctx sdk.Context, chainID int64) error {
+	flags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
+	if found && !flags.IsChainOutboundEnabled(chainID) {
+		return errorsmod.Wrapf(observertypes.ErrOutboundDisabled, "chain %d", chainID)
+	}
+	return nil
+}
+
+// checkForeignCoinOutboundEnabled returns an error if the outbounds of the foreign coin are disabled in the crosschain flags
+func (k Keeper) checkForeignCoinOutboundEnabled(ctx sdk.Context, chainID int64, coinType common.CoinType, asset string) error {
+	flags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx)
+	if found && !flags.IsForeignCoinOutboundEnabled(chainID, coinType, asset) {
+		return errorsmod.Wrapf(
+			observertypes.ErrOutboundDisabled,
+			"chain %d, coin type %s, asset %s",
+			chainID,
+			coinType.String(),
+			asset,
+		)
+	}
+	return nil
+}
+
 // ProcessZRC20WithdrawalEvent creates a new CCTX to process the withdrawal event
 // error indicates system error and non-recoverable; should abort
 func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20Withdrawal, emittingContract ethcommon.Address, txOrigin string, tss observertypes.TSS) error {
@@ -186,6 +216,11 @@ func (k Keeper) ProcessZRC20WithdrawalEvent(ctx sdk.Context, event *zrc20.ZRC20W
 		return fmt.Errorf("gasprice not found for %s", receiverChain)
 	}
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = fmt.Sprintf("%d", gasprice.Prices[gasprice.MedianIndex])
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(
+		ctx,
+		receiverChain.ChainId,
+		math.NewUint(gasprice.Prices[gasprice.MedianIndex]),
+	)
 	cctx.GetCurrentOutTxParam().Amount = cctx.InboundTxParams.Amount
 
 	EmitZRCWithdrawCreated(ctx, cctx)
@@ -279,8 +314,18 @@ func (k Keeper) ProcessCCTX(ctx sdk.Context, cctx types.CrossChainTx, receiverCh
 		cctx.InboundTxParams.InboundTxObservedHash = inCctxIndex
 	}
 
-	if err := k.UpdateNonce(ctx, receiverChain.ChainId, &cctx); err != nil {
-		return fmt.Errorf("ProcessWithdrawalEvent: update nonce failed: %s", err.Error())
+	// #nosec G701 always positive
+	cctx.InboundTxParams.InboundTxFinalizedZetaHeight = uint64(ctx.BlockHeight())
+
+	// the cctx is queued without nonce if the outbound exceeds the rate limit of the receiver chain and asset
+	rateLimited, err := k.ApplyOutboundRateLimit(ctx, &cctx)
+	if err != nil {
+		return fmt.Errorf("ProcessWithdrawalEvent: %w", err)
+	}
+	if !rateLimited {
+		if err := k.UpdateNonce(ctx, receiverChain.ChainId, &cctx); err != nil {
+			return fmt.Errorf("ProcessWithdrawalEvent: update nonce failed: %s", err.Error())
+		}
 	}
 
 	k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
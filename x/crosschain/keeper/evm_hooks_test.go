@@ -457,6 +457,67 @@ func TestKeeper_ProcessZetaSentEvent(t *testing.T) {
 		require.Equal(t, txOrigin.Hex(), cctxList[0].InboundTxParams.TxOrigin)
 	})
 
+	t.Run("should queue the cctx of ZetaSentEvent exceeding the rate limit", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		chain := common.EthChain()
+		chainID := chain.ChainId
+		setSupportedChain(ctx, zk, chainID)
+
+		SetupStateForProcessLogs(t, ctx, k, zk, sdkk, chain)
+		SetupStateForProcessLogsZetaSent(t, ctx, k, zk, sdkk, chain)
+		amount, ok := sdkmath.NewIntFromString("20000000000000000000000")
+		require.True(t, ok)
+		err := sdkk.BankKeeper.MintCoins(ctx, fungibletypes.ModuleName, sdk.NewCoins(sdk.NewCoin(config.BaseDenom, amount)))
+		require.NoError(t, err)
+		// the window is used up by previous outbounds
+		limit := sdkmath.NewUintFromBigInt(amount.BigInt())
+		rateLimit := crosschaintypes.RateLimit{ChainId: chainID, Limit: limit, Window: 100}
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, limit)
+
+		event, err := crosschainkeeper.ParseZetaSentEvent(*sample.GetValidZetaSentDestinationExternal(t).Logs[4], sample.GetValidZetaSentDestinationExternal(t).Logs[4].Address)
+		require.NoError(t, err)
+		tss := sample.Tss()
+
+		err = k.ProcessZetaSentEvent(ctx, event, sample.EthAddress(), sample.EthAddress().Hex(), tss)
+		require.NoError(t, err)
+		cctxList := k.GetAllCrossChainTx(ctx)
+		require.Len(t, cctxList, 1)
+		require.Equal(t, crosschaintypes.CctxStatus_PendingRateLimited, cctxList[0].CctxStatus.Status)
+		_, found := zk.ObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, chainID, 0)
+		require.False(t, found)
+	})
+
+	t.Run("should reject ZetaSentEvent exceeding the rate limit", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		chain := common.EthChain()
+		chainID := chain.ChainId
+		setSupportedChain(ctx, zk, chainID)
+
+		SetupStateForProcessLogs(t, ctx, k, zk, sdkk, chain)
+		SetupStateForProcessLogsZetaSent(t, ctx, k, zk, sdkk, chain)
+		amount, ok := sdkmath.NewIntFromString("20000000000000000000000")
+		require.True(t, ok)
+		err := sdkk.BankKeeper.MintCoins(ctx, fungibletypes.ModuleName, sdk.NewCoins(sdk.NewCoin(config.BaseDenom, amount)))
+		require.NoError(t, err)
+		k.SetRateLimit(ctx, crosschaintypes.RateLimit{
+			ChainId: chainID,
+			Limit:   sdkmath.NewUint(1),
+			Window:  100,
+			Action:  crosschaintypes.RateLimitAction_Reject,
+		})
+
+		event, err := crosschainkeeper.ParseZetaSentEvent(*sample.GetValidZetaSentDestinationExternal(t).Logs[4], sample.GetValidZetaSentDestinationExternal(t).Logs[4].Address)
+		require.NoError(t, err)
+
+		err = k.ProcessZetaSentEvent(ctx, event, sample.EthAddress(), sample.EthAddress().Hex(), sample.Tss())
+		require.ErrorIs(t, err, crosschaintypes.ErrRateLimitExceeded)
+	})
+
 	t.Run("unable to process ZetaSentEvent if fungible module does not have enough balance", func(t *testing.T) {
 		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -714,6 +775,65 @@ func TestKeeper_ProcessLogs(t *testing.T) {
 		require.Len(t, cctxList, 0)
 	})
 
+	t.Run("no cctx created for ZRC20Withdrawal if the outbounds of the foreign coin are disabled", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		chain := common.BtcMainnetChain()
+		chainID := chain.ChainId
+		setSupportedChain(ctx, zk, chainID)
+		SetupStateForProcessLogs(t, ctx, k, zk, sdkk, chain)
+
+		block := sample.GetValidZRC20WithdrawToBTC(t)
+		gasZRC20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "bitcoin", "BTC")
+		for _, log := range block.Logs {
+			log.Address = gasZRC20
+		}
+		flags := observertypes.DefaultCrosschainFlags()
+		flags.SetForeignCoinFlags(observertypes.ForeignCoinFlags{
+			ChainId:           chainID,
+			Asset:             "",
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: false,
+		})
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, *flags)
+
+		err := k.ProcessLogs(ctx, block.Logs, sample.EthAddress(), "")
+		require.ErrorIs(t, err, observertypes.ErrOutboundDisabled)
+		cctxList := k.GetAllCrossChainTx(ctx)
+		require.Len(t, cctxList, 0)
+	})
+
+	t.Run("no cctx created for ZetaSentEvent if the outbounds of the receiver chain are disabled", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		chain := common.EthChain()
+		chainID := chain.ChainId
+		setSupportedChain(ctx, zk, chainID)
+		SetupStateForProcessLogs(t, ctx, k, zk, sdkk, chain)
+		SetupStateForProcessLogsZetaSent(t, ctx, k, zk, sdkk, chain)
+
+		block := sample.GetValidZetaSentDestinationExternal(t)
+		system, found := zk.FungibleKeeper.GetSystemContract(ctx)
+		require.True(t, found)
+		for _, log := range block.Logs {
+			log.Address = ethcommon.HexToAddress(system.ConnectorZevm)
+		}
+		flags := observertypes.DefaultCrosschainFlags()
+		flags.SetChainFlags(observertypes.ChainFlags{
+			ChainId:           chainID,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: false,
+		})
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, *flags)
+
+		err := k.ProcessLogs(ctx, block.Logs, sample.EthAddress(), sample.EthAddress().Hex())
+		require.ErrorIs(t, err, observertypes.ErrOutboundDisabled)
+		cctxList := k.GetAllCrossChainTx(ctx)
+		require.Len(t, cctxList, 0)
+	})
+
 	t.Run("error returned for invalid event data", func(t *testing.T) {
 		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -121,6 +121,7 @@ func (k Keeper) PayGasNativeAndUpdateCctx(
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	cctx.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit.Uint64()
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(ctx, chainID, gasPrice)
 
 	return nil
 }
@@ -247,6 +248,7 @@ func (k Keeper) PayGasInERC20AndUpdateCctx(
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	cctx.GetCurrentOutTxParam().OutboundTxGasLimit = gasLimit.Uint64()
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(ctx, chainID, gasPrice)
 
 	return nil
 }
@@ -349,6 +351,7 @@ func (k Keeper) PayGasInZetaAndUpdateCctx(
 
 	// Update the cctx
 	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = gasPrice.String()
+	cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = k.GetOutboundPriorityFee(ctx, chainID, gasPrice)
 	cctx.GetCurrentOutTxParam().Amount = newAmount
 	if cctx.ZetaFees.IsNil() {
 		cctx.ZetaFees = feeInZeta
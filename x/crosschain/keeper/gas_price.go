@@ -37,6 +37,29 @@ func (k Keeper) GetMedianGasPriceInUint(ctx sdk.Context, chainID int64) (sdk.Uin
 	return sdk.NewUint(gasPrice.Prices[mi]), true
 }
 
+// GetMedianPriorityFeeInUint returns the median priority fee (EIP-1559) voted for the chain
+func (k Keeper) GetMedianPriorityFeeInUint(ctx sdk.Context, chainID int64) (sdk.Uint, bool) {
+	gasPrice, isFound := k.GetGasPrice(ctx, chainID)
+	if !isFound || len(gasPrice.PriorityFees) == 0 {
+		return math.ZeroUint(), false
+	}
+	mi := medianOfArray(gasPrice.PriorityFees)
+	return sdk.NewUint(gasPrice.PriorityFees[mi]), true
+}
+
+// GetOutboundPriorityFee returns the priority fee to set in an outbound tx paying the given gas price
+// the priority fee is capped by the gas price, and empty if no priority fee was voted for the chain
+func (k Keeper) GetOutboundPriorityFee(ctx sdk.Context, chainID int64, gasPrice sdk.Uint) string {
+	priorityFee, isFound := k.GetMedianPriorityFeeInUint(ctx, chainID)
+	if !isFound {
+		return ""
+	}
+	if priorityFee.GT(gasPrice) {
+		priorityFee = gasPrice
+	}
+	return priorityFee.String()
+}
+
 // RemoveGasPrice removes a gasPrice from the store
 func (k Keeper) RemoveGasPrice(ctx sdk.Context, index string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GasPriceKey))
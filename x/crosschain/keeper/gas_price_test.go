@@ -45,3 +45,33 @@ func TestGasPriceGetAll(t *testing.T) {
 	items := createNGasPrice(keeper, ctx, 10)
 	require.Equal(t, items, keeper.GetAllGasPrice(ctx))
 }
+
+func TestKeeper_GetMedianPriorityFeeInUint(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	_, found := keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	require.False(t, found)
+
+	// no priority fee voted
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10}})
+	_, found = keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	require.False(t, found)
+
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10, 30, 20}, PriorityFees: []uint64{3, 1, 2}})
+	priorityFee, found := keeper.GetMedianPriorityFeeInUint(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, sdk.NewUint(2), priorityFee)
+}
+
+func TestKeeper_GetOutboundPriorityFee(t *testing.T) {
+	keeper, ctx := setupKeeper(t)
+
+	// empty if no priority fee voted
+	require.Equal(t, "", keeper.GetOutboundPriorityFee(ctx, 1, sdk.NewUint(10)))
+
+	keeper.SetGasPrice(ctx, types.GasPrice{ChainId: 1, Prices: []uint64{10}, PriorityFees: []uint64{5}})
+	require.Equal(t, "5", keeper.GetOutboundPriorityFee(ctx, 1, sdk.NewUint(10)))
+
+	// capped by the gas price
+	require.Equal(t, "4", keeper.GetOutboundPriorityFee(ctx, 1, sdk.NewUint(4)))
+}
@@ -135,14 +135,15 @@ func (k Keeper) CctxListPending(c context.Context, req *types.QueryListCctxPendi
 	if startNonce < 0 {
 		startNonce = 0
 	}
+	// the cctxs of the nonces below NonceLow may have been pruned, they are skipped
 	for i := startNonce; i < pendingNonces.NonceLow; i++ {
 		nonceToCctx, found := k.GetObserverKeeper().GetNonceToCctx(ctx, tss.TssPubkey, req.ChainId, i)
 		if !found {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("nonceToCctx not found: nonce %d, chainid %d", i, req.ChainId))
+			continue
 		}
 		cctx, found := k.GetCrossChainTx(ctx, nonceToCctx.CctxIndex)
 		if !found {
-			return nil, status.Error(codes.Internal, fmt.Sprintf("cctx not found: index %s", nonceToCctx.CctxIndex))
+			continue
 		}
 		if cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound || cctx.CctxStatus.Status == types.CctxStatus_PendingRevert {
 			totalPending++
@@ -177,3 +178,141 @@ func (k Keeper) CctxListPending(c context.Context, req *types.QueryListCctxPendi
 		TotalPending: totalPending,
 	}, nil
 }
+
+// CctxListFiltered returns a list of cctxs filtered by sender, receiver, status, sender chain, receiver chain and zeta height range
+// the most selective index among the provided filters is iterated and the remaining filters are applied on each cctx
+// at least one filter other than the height range must be provided
+func (k Keeper) CctxListFiltered(c context.Context, req *types.QueryListCctxFilteredRequest) (*types.QueryListCctxFilteredResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MaxHeight != 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Error(codes.InvalidArgument, "min height is greater than max height")
+	}
+
+	// select the index to iterate
+	var keyPrefix, field string
+	switch {
+	case req.Sender != "":
+		keyPrefix, field = types.CctxSenderIndexKeyPrefix, types.CctxIndexAddress(req.Sender)
+	case req.Receiver != "":
+		keyPrefix, field = types.CctxReceiverIndexKeyPrefix, types.CctxIndexAddress(req.Receiver)
+	case req.SenderChainId != 0:
+		keyPrefix, field = types.CctxSenderChainIndexKeyPrefix, types.CctxIndexChainID(req.SenderChainId)
+	case req.ReceiverChainId != 0:
+		keyPrefix, field = types.CctxReceiverChainIndexKeyPrefix, types.CctxIndexChainID(req.ReceiverChainId)
+	case len(req.Status) == 1:
+		keyPrefix, field = types.CctxStatusIndexKeyPrefix, types.CctxIndexStatus(req.Status[0])
+	default:
+		return nil, status.Error(
+			codes.InvalidArgument,
+			"a sender, a receiver, a sender chain, a receiver chain or a single status must be provided",
+		)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(keyPrefix))
+	fieldStore := prefix.NewStore(store, types.CctxIndexFieldKey(field))
+
+	// the index entries of a field value are ordered by height, only the entries of the height range are iterated
+	start, end := types.CctxIndexHeightRange(req.MinHeight, req.MaxHeight)
+	rangeStore := newBoundedStore(fieldStore, start, end)
+
+	var cctxs []*types.CrossChainTx
+	pageRes, err := query.FilteredPaginate(rangeStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		_, index, err := types.ParseCctxIndexKey(key)
+		if err != nil {
+			return false, err
+		}
+
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		if !found {
+			return false, fmt.Errorf("cctx not found: index %s", index)
+		}
+		if !cctxMatchesFilter(cctx, req) {
+			return false, nil
+		}
+
+		if accumulate {
+			cctxs = append(cctxs, &cctx)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListCctxFilteredResponse{CrossChainTx: cctxs, Pagination: pageRes}, nil
+}
+
+// cctxMatchesFilter returns true if the cctx matches all the filters of the request
+func cctxMatchesFilter(cctx types.CrossChainTx, req *types.QueryListCctxFilteredRequest) bool {
+	if req.Sender != "" {
+		sender := types.CctxIndexAddress(req.Sender)
+		if types.CctxIndexAddress(cctx.InboundTxParams.Sender) != sender &&
+			types.CctxIndexAddress(cctx.InboundTxParams.TxOrigin) != sender {
+			return false
+		}
+	}
+	if req.Receiver != "" {
+		if len(cctx.OutboundTxParams) == 0 ||
+			types.CctxIndexAddress(cctx.OutboundTxParams[0].Receiver) != types.CctxIndexAddress(req.Receiver) {
+			return false
+		}
+	}
+	if req.SenderChainId != 0 && cctx.InboundTxParams.SenderChainId != req.SenderChainId {
+		return false
+	}
+	if req.ReceiverChainId != 0 && cctx.OriginalDestinationChainID() != req.ReceiverChainId {
+		return false
+	}
+	if len(req.Status) > 0 {
+		for _, s := range req.Status {
+			if cctx.CctxStatus.Status == s {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// CctxListPrunable returns the cctxs queued for pruning, ordered by the zeta height at which they became prunable
+func (k Keeper) CctxListPrunable(c context.Context, req *types.QueryListCctxPrunableRequest) (*types.QueryListCctxPrunableResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MaxHeight != 0 && req.MinHeight > req.MaxHeight {
+		return nil, status.Error(codes.InvalidArgument, "min height is greater than max height")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxPruneQueueKeyPrefix))
+
+	// the prune queue is ordered by height like the index entries, only the entries of the height range are iterated
+	start, end := types.CctxIndexHeightRange(req.MinHeight, req.MaxHeight)
+	rangeStore := newBoundedStore(store, start, end)
+
+	var cctxs []*types.CrossChainTx
+	pageRes, err := query.FilteredPaginate(rangeStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		_, index, err := types.ParseCctxPruneQueueKey(key)
+		if err != nil {
+			return false, err
+		}
+
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		if !found {
+			return false, nil
+		}
+
+		if accumulate {
+			cctxs = append(cctxs, &cctx)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListCctxPrunableResponse{CrossChainTx: cctxs, Pagination: pageRes}, nil
+}
@@ -2,9 +2,12 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
@@ -156,3 +159,215 @@ func TestKeeper_CctxListPending(t *testing.T) {
 		require.EqualValues(t, uint64(1002), res.TotalPending)
 	})
 }
+
+func TestKeeper_CctxListFiltered(t *testing.T) {
+	// setCctx sets a cctx with the provided sender, receiver chain, status and creation height
+	setCctx := func(
+		t *testing.T,
+		ctx sdk.Context,
+		k *keeper.Keeper,
+		index string,
+		sender string,
+		receiverChainID int64,
+		status types.CctxStatus,
+		height uint64,
+	) types.CrossChainTx {
+		cctx := sample.CrossChainTx(t, index)
+		cctx.InboundTxParams.Sender = sender
+		cctx.InboundTxParams.SenderChainId = 1337
+		cctx.InboundTxParams.InboundTxFinalizedZetaHeight = height
+		cctx.OutboundTxParams = cctx.OutboundTxParams[:1]
+		cctx.GetCurrentOutTxParam().ReceiverChainId = receiverChainID
+		cctx.CctxStatus.Status = status
+		k.SetCrossChainTx(ctx, *cctx)
+		return *cctx
+	}
+
+	t.Run("should fail for empty req", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxListFiltered(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("should fail if no indexed filter is provided", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Status:    []types.CctxStatus{types.CctxStatus_PendingOutbound, types.CctxStatus_PendingRevert},
+			MinHeight: 10,
+		})
+		require.ErrorContains(t, err, "must be provided")
+	})
+
+	t.Run("should fail if min height is greater than max height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			SenderChainId: 1337,
+			MinHeight:     10,
+			MaxHeight:     5,
+		})
+		require.ErrorContains(t, err, "min height is greater than max height")
+	})
+
+	t.Run("can filter cctxs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		sender := sample.EthAddress().String()
+		otherSender := sample.EthAddress().String()
+
+		cctx1 := setCctx(t, ctx, k, "1", sender, 5, types.CctxStatus_PendingOutbound, 10)
+		cctx2 := setCctx(t, ctx, k, "2", sender, 97, types.CctxStatus_OutboundMined, 20)
+		cctx3 := setCctx(t, ctx, k, "3", sender, 5, types.CctxStatus_Aborted, 30)
+		cctx4 := setCctx(t, ctx, k, "4", otherSender, 5, types.CctxStatus_PendingOutbound, 40)
+
+		// by sender, case insensitive, ordered by height
+		res, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{Sender: strings.ToUpper(sender)})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx1, &cctx2, &cctx3}, res.CrossChainTx)
+
+		// by sender and receiver chain
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{Sender: sender, ReceiverChainId: 5})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx1, &cctx3}, res.CrossChainTx)
+
+		// by receiver chain and statuses
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			ReceiverChainId: 5,
+			Status:          []types.CctxStatus{types.CctxStatus_PendingOutbound, types.CctxStatus_Aborted},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx1, &cctx3, &cctx4}, res.CrossChainTx)
+
+		// by single status
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Status: []types.CctxStatus{types.CctxStatus_PendingOutbound},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx1, &cctx4}, res.CrossChainTx)
+
+		// by receiver
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Receiver: cctx2.GetCurrentOutTxParam().Receiver,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx2}, res.CrossChainTx)
+
+		// by sender chain and height range
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			SenderChainId: 1337,
+			MinHeight:     20,
+			MaxHeight:     30,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx2, &cctx3}, res.CrossChainTx)
+	})
+
+	t.Run("can paginate filtered cctxs", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		sender := sample.EthAddress().String()
+		for i := 0; i < 10; i++ {
+			setCctx(t, ctx, k, fmt.Sprintf("%d", i), sender, 5, types.CctxStatus_PendingOutbound, uint64(i))
+		}
+
+		res, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Sender:     sender,
+			Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 4)
+		require.EqualValues(t, 10, res.Pagination.Total)
+
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Sender:     sender,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 8},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 6)
+	})
+
+	t.Run("should only iterate the index entries of the height range", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		sender := sample.EthAddress().String()
+		cctx := setCctx(t, ctx, k, "1", sender, 5, types.CctxStatus_PendingOutbound, 20)
+
+		// set index entries outside the height range that would fail the query if iterated
+		store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), types.KeyPrefix(types.CctxSenderIndexKeyPrefix))
+		for _, height := range []uint64{10, 30} {
+			key := types.CctxIndexKey(types.CctxIndexAddress(sender), height, "")
+			store.Set(key[:len(key)-1], []byte{0x01})
+		}
+
+		res, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Sender:    sender,
+			MinHeight: 15,
+			MaxHeight: 25,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx}, res.CrossChainTx)
+
+		_, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{Sender: sender})
+		require.Error(t, err)
+	})
+
+	t.Run("can paginate filtered cctxs in a height range", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		sender := sample.EthAddress().String()
+		for i := 0; i < 10; i++ {
+			setCctx(t, ctx, k, fmt.Sprintf("%d", i), sender, 5, types.CctxStatus_PendingOutbound, uint64(i))
+		}
+
+		res, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Sender:     sender,
+			MinHeight:  2,
+			MaxHeight:  7,
+			Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 4)
+		require.EqualValues(t, 6, res.Pagination.Total)
+		require.EqualValues(t, 2, res.CrossChainTx[0].InboundTxParams.InboundTxFinalizedZetaHeight)
+
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Sender:     sender,
+			MinHeight:  2,
+			MaxHeight:  7,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 4},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.CrossChainTx, 2)
+		require.EqualValues(t, 7, res.CrossChainTx[1].InboundTxParams.InboundTxFinalizedZetaHeight)
+		require.Nil(t, res.Pagination.NextKey)
+
+		// reverse order
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Sender:     sender,
+			MinHeight:  2,
+			MaxHeight:  7,
+			Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 7, res.CrossChainTx[0].InboundTxParams.InboundTxFinalizedZetaHeight)
+	})
+
+	t.Run("should update the indexes when the cctx is updated or removed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := setCctx(t, ctx, k, "1", sample.EthAddress().String(), 5, types.CctxStatus_PendingOutbound, 10)
+
+		cctx.CctxStatus.Status = types.CctxStatus_OutboundMined
+		k.SetCrossChainTx(ctx, cctx)
+
+		res, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Status: []types.CctxStatus{types.CctxStatus_PendingOutbound},
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{
+			Status: []types.CctxStatus{types.CctxStatus_OutboundMined},
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, []*types.CrossChainTx{&cctx}, res.CrossChainTx)
+
+		k.RemoveCrossChainTx(ctx, cctx.Index)
+		res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{SenderChainId: 1337})
+		require.NoError(t, err)
+		require.Empty(t, res.CrossChainTx)
+	})
+}
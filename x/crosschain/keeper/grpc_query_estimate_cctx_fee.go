@@ -0,0 +1,219 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	cosmoserrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EstimateCctxFee estimates the fees paid for a prospective cctx and the amount received on the receiver chain
+// the estimation follows the gas payment performed when the inbound is finalized without modifying the state
+func (k Keeper) EstimateCctxFee(c context.Context, req *types.QueryEstimateCctxFeeRequest) (*types.QueryEstimateCctxFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	amount, err := math.ParseUint(req.Amount)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid amount %s", req.Amount))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if chain := k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, req.SenderChainId); chain == nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("sender chain %d not supported", req.SenderChainId))
+	}
+
+	// no fee is paid for deposits to ZetaChain
+	estimation := newCctxFeeEstimation(amount)
+	if common.IsZetaChain(req.ReceiverChainId) {
+		return estimation, nil
+	}
+	if chain := k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, req.ReceiverChainId); chain == nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("receiver chain %d not supported", req.ReceiverChainId))
+	}
+
+	switch {
+	case common.IsZetaChain(req.SenderChainId) && req.CoinType != common.CoinType_Zeta:
+		err = k.estimateZRC20WithdrawFee(ctx, req, estimation)
+	case req.CoinType == common.CoinType_Zeta:
+		err = k.estimateFeeInZeta(ctx, req, estimation)
+	case req.CoinType == common.CoinType_Gas:
+		err = k.estimateFeeNative(ctx, req, estimation)
+	case req.CoinType == common.CoinType_ERC20:
+		err = k.estimateFeeInERC20(ctx, req, estimation)
+	default:
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("can't pay gas with coin type %s", req.CoinType.String()))
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return estimation, nil
+}
+
+// newCctxFeeEstimation returns a fee estimation with no fee
+func newCctxFeeEstimation(amount math.Uint) *types.QueryEstimateCctxFeeResponse {
+	return &types.QueryEstimateCctxFeeResponse{
+		GasPrice:          math.ZeroUint(),
+		GasFee:            math.ZeroUint(),
+		ProtocolFlatFee:   math.ZeroUint(),
+		GasFeeInZeta:      math.ZeroUint(),
+		ProtocolFeeInZeta: math.ZeroUint(),
+		SwapAmountIn:      math.ZeroUint(),
+		TotalFee:          math.ZeroUint(),
+		ReceiveAmount:     amount,
+	}
+}
+
+// subtractTotalFee sets the receive amount to the amount minus the total fee, zero if the amount doesn't cover the fee
+func subtractTotalFee(estimation *types.QueryEstimateCctxFeeResponse, amount math.Uint) {
+	if estimation.TotalFee.GT(amount) {
+		estimation.ReceiveAmount = math.ZeroUint()
+		return
+	}
+	estimation.ReceiveAmount = amount.Sub(estimation.TotalFee)
+}
+
+// estimateFeeNative estimates the fee of a cctx paying gas in native gas, see PayGasNativeAndUpdateCctx
+func (k Keeper) estimateFeeNative(ctx sdk.Context, req *types.QueryEstimateCctxFeeRequest, estimation *types.QueryEstimateCctxFeeResponse) error {
+	_, gasLimit, gasPrice, protocolFlatFee, err := k.ChainGasParams(ctx, req.ReceiverChainId)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, err.Error())
+	}
+
+	estimation.GasLimit = gasLimit.Uint64()
+	estimation.GasPrice = gasPrice
+	estimation.GasFee = gasLimit.Mul(gasPrice)
+	estimation.ProtocolFlatFee = protocolFlatFee
+	estimation.TotalFee = estimation.GasFee.Add(protocolFlatFee)
+	subtractTotalFee(estimation, estimation.ReceiveAmount)
+
+	return nil
+}
+
+// estimateFeeInERC20 estimates the fee of a cctx paying gas in ERC20, see PayGasInERC20AndUpdateCctx
+func (k Keeper) estimateFeeInERC20(ctx sdk.Context, req *types.QueryEstimateCctxFeeRequest, estimation *types.QueryEstimateCctxFeeResponse) error {
+	gasZRC20, gasLimit, gasPrice, protocolFlatFee, err := k.ChainGasParams(ctx, req.ReceiverChainId)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, err.Error())
+	}
+	outTxGasFee := gasLimit.Mul(gasPrice).Add(protocolFlatFee)
+
+	zrc20, err := k.zrc20FromAsset(ctx, req.Asset, req.ReceiverChainId)
+	if err != nil {
+		return err
+	}
+
+	// get the necessary ERC20 amount for gas
+	feeInZRC20, err := k.fungibleKeeper.QueryUniswapV2RouterGetZRC4ToZRC4AmountsIn(ctx, outTxGasFee.BigInt(), zrc20, gasZRC20)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrNoLiquidityPool, err.Error())
+	}
+
+	estimation.GasLimit = gasLimit.Uint64()
+	estimation.GasPrice = gasPrice
+	estimation.GasFee = gasLimit.Mul(gasPrice)
+	estimation.ProtocolFlatFee = protocolFlatFee
+	estimation.SwapAmountIn = math.NewUintFromBigInt(feeInZRC20)
+	estimation.TotalFee = estimation.SwapAmountIn
+	subtractTotalFee(estimation, estimation.ReceiveAmount)
+
+	return nil
+}
+
+// estimateFeeInZeta estimates the fee of a cctx paying gas in ZETA, see PayGasInZetaAndUpdateCctx
+func (k Keeper) estimateFeeInZeta(ctx sdk.Context, req *types.QueryEstimateCctxFeeRequest, estimation *types.QueryEstimateCctxFeeResponse) error {
+	gasZRC20, err := k.fungibleKeeper.QuerySystemContractGasCoinZRC20(ctx, big.NewInt(req.ReceiverChainId))
+	if err != nil {
+		return cosmoserrors.Wrapf(err, "unable to get system contract gas coin, chain ID %d", req.ReceiverChainId)
+	}
+
+	gasPrice, isFound := k.GetMedianGasPriceInUint(ctx, req.ReceiverChainId)
+	if !isFound {
+		return cosmoserrors.Wrapf(types.ErrUnableToGetGasPrice, "chain %d", req.ReceiverChainId)
+	}
+	gasPrice = gasPrice.MulUint64(2) // overpays gas price by 2x
+
+	gasLimit := math.NewUint(req.GasLimit)
+	outTxGasFee := gasLimit.Mul(gasPrice)
+	outTxGasFeeInZeta, err := k.fungibleKeeper.QueryUniswapV2RouterGetZetaAmountsIn(ctx, outTxGasFee.BigInt(), gasZRC20)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrNoLiquidityPool, err.Error())
+	}
+
+	estimation.GasLimit = req.GasLimit
+	estimation.GasPrice = gasPrice
+	estimation.GasFee = outTxGasFee
+	estimation.GasFeeInZeta = math.NewUintFromBigInt(outTxGasFeeInZeta)
+	estimation.ProtocolFeeInZeta = types.GetProtocolFee()
+	estimation.TotalFee = estimation.GasFeeInZeta.Add(estimation.ProtocolFeeInZeta)
+	subtractTotalFee(estimation, estimation.ReceiveAmount)
+
+	return nil
+}
+
+// estimateZRC20WithdrawFee estimates the withdraw fee of a ZRC20 withdrawn from ZetaChain
+// the fee is paid in gas ZRC20 on top of the withdrawn amount, the receiver therefore gets the full amount
+func (k Keeper) estimateZRC20WithdrawFee(ctx sdk.Context, req *types.QueryEstimateCctxFeeRequest, estimation *types.QueryEstimateCctxFeeResponse) error {
+	var zrc20 ethcommon.Address
+	var err error
+	if req.CoinType == common.CoinType_Gas {
+		zrc20, err = k.fungibleKeeper.QuerySystemContractGasCoinZRC20(ctx, big.NewInt(req.ReceiverChainId))
+		if err != nil {
+			return cosmoserrors.Wrapf(err, "unable to get system contract gas coin, chain ID %d", req.ReceiverChainId)
+		}
+	} else {
+		zrc20, err = k.zrc20FromAsset(ctx, req.Asset, req.ReceiverChainId)
+		if err != nil {
+			return err
+		}
+	}
+
+	gasLimit, err := k.fungibleKeeper.QueryGasLimit(ctx, zrc20)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, err.Error())
+	}
+	if gasLimit == nil {
+		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, "gas limit is nil")
+	}
+	protocolFlatFee, err := k.fungibleKeeper.QueryProtocolFlatFee(ctx, zrc20)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, err.Error())
+	}
+	if protocolFlatFee == nil {
+		return cosmoserrors.Wrap(types.ErrCannotFindGasParams, "protocol flat fee is nil")
+	}
+	gasPrice, isFound := k.GetMedianGasPriceInUint(ctx, req.ReceiverChainId)
+	if !isFound {
+		return cosmoserrors.Wrapf(types.ErrUnableToGetGasPrice, "chain %d", req.ReceiverChainId)
+	}
+
+	estimation.GasLimit = gasLimit.Uint64()
+	estimation.GasPrice = gasPrice
+	estimation.GasFee = math.NewUintFromBigInt(gasLimit).Mul(gasPrice)
+	estimation.ProtocolFlatFee = math.NewUintFromBigInt(protocolFlatFee)
+	estimation.TotalFee = estimation.GasFee.Add(estimation.ProtocolFlatFee)
+
+	return nil
+}
+
+// zrc20FromAsset returns the address of the ZRC20 of an asset of a foreign chain
+func (k Keeper) zrc20FromAsset(ctx sdk.Context, asset string, chainID int64) (ethcommon.Address, error) {
+	fc, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, asset, chainID)
+	if !found {
+		return ethcommon.Address{}, cosmoserrors.Wrapf(types.ErrForeignCoinNotFound, "zrc20 from asset %s not found", asset)
+	}
+	zrc20 := ethcommon.HexToAddress(fc.Zrc20ContractAddress)
+	if zrc20 == (ethcommon.Address{}) {
+		return ethcommon.Address{}, cosmoserrors.Wrapf(types.ErrForeignCoinNotFound, "zrc20 from asset %s invalid address", asset)
+	}
+	return zrc20, nil
+}
@@ -0,0 +1,263 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	zetacommon "github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestKeeper_EstimateCctxFee(t *testing.T) {
+	t.Run("should fail for empty req", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.EstimateCctxFee(ctx, nil)
+		require.ErrorContains(t, err, "invalid request")
+	})
+
+	t.Run("should fail for invalid amount", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{Amount: "invalid"})
+		require.ErrorContains(t, err, "invalid amount")
+	})
+
+	t.Run("should fail if sender chain is not supported", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   999999,
+			ReceiverChainId: getValidEthChainID(t),
+			Amount:          "1000",
+		})
+		require.ErrorContains(t, err, "sender chain 999999 not supported")
+	})
+
+	t.Run("should fail if receiver chain is not supported", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		_, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: 999999,
+			Amount:          "1000",
+		})
+		require.ErrorContains(t, err, "receiver chain 999999 not supported")
+	})
+
+	t.Run("should return no fee for deposits to ZetaChain", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		res, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: zetacommon.ZetaPrivnetChain().ChainId,
+			CoinType:        zetacommon.CoinType_Gas,
+			Amount:          "1000",
+		})
+		require.NoError(t, err)
+		require.True(t, res.TotalFee.IsZero())
+		require.Equal(t, "1000", res.ReceiveAmount.String())
+	})
+
+	t.Run("should fail if gas price is not set", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+
+		_, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_Gas,
+			Amount:          "1000",
+		})
+		require.ErrorContains(t, err, types.ErrCannotFindGasParams.Error())
+	})
+
+	t.Run("should estimate the fee paid in native gas", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		_, err := zk.FungibleKeeper.UpdateZRC20ProtocolFlatFee(ctx, zrc20, big.NewInt(withdrawFee))
+		require.NoError(t, err)
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, Prices: []uint64{gasPrice}})
+
+		res, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_Gas,
+			Amount:          math.NewUint(inputAmount).String(),
+		})
+		require.NoError(t, err)
+
+		// total fees must be 21000*2+1000=43000
+		require.EqualValues(t, 21_000, res.GasLimit)
+		require.Equal(t, "2", res.GasPrice.String())
+		require.Equal(t, "42000", res.GasFee.String())
+		require.Equal(t, "1000", res.ProtocolFlatFee.String())
+		require.Equal(t, "43000", res.TotalFee.String())
+
+		// the receive amount must match the amount after paying gas
+		cctx := types.CrossChainTx{
+			InboundTxParams:  &types.InboundTxParams{CoinType: zetacommon.CoinType_Gas},
+			OutboundTxParams: []*types.OutboundTxParams{{ReceiverChainId: chainID}},
+		}
+		err = k.PayGasNativeAndUpdateCctx(ctx, chainID, &cctx, math.NewUint(inputAmount))
+		require.NoError(t, err)
+		require.Equal(t, cctx.GetCurrentOutTxParam().Amount, res.ReceiveAmount)
+
+		// the receive amount is zero if the amount doesn't cover the fee
+		res, err = k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_Gas,
+			Amount:          "1000",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "43000", res.TotalFee.String())
+		require.True(t, res.ReceiveAmount.IsZero())
+	})
+
+	t.Run("should estimate the fee paid in erc20", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		assetAddress := sample.EthAddress().String()
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		gasZRC20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foo", "foo")
+		zrc20Addr := deployZRC20(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "bar", assetAddress, "bar")
+		_, err := zk.FungibleKeeper.UpdateZRC20ProtocolFlatFee(ctx, gasZRC20, big.NewInt(withdrawFee))
+		require.NoError(t, err)
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, Prices: []uint64{gasPrice}})
+		setupZRC20Pool(t, ctx, zk.FungibleKeeper, sdkk.BankKeeper, zrc20Addr)
+
+		res, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_ERC20,
+			Asset:           assetAddress,
+			Amount:          math.NewUint(inputAmount).String(),
+		})
+		require.NoError(t, err)
+
+		// total fees in gas must be 21000*2+1000=43000, swapped from the erc20
+		expectedInZeta, err := zk.FungibleKeeper.QueryUniswapV2RouterGetZetaAmountsIn(ctx, big.NewInt(43000), gasZRC20)
+		require.NoError(t, err)
+		expectedInZRC20, err := zk.FungibleKeeper.QueryUniswapV2RouterGetZRC4AmountsIn(ctx, expectedInZeta, zrc20Addr)
+		require.NoError(t, err)
+		require.Equal(t, "42000", res.GasFee.String())
+		require.Equal(t, "1000", res.ProtocolFlatFee.String())
+		require.Equal(t, expectedInZRC20.String(), res.SwapAmountIn.String())
+		require.Equal(t, expectedInZRC20.String(), res.TotalFee.String())
+
+		// the receive amount must match the amount after paying gas
+		cctx := types.CrossChainTx{
+			InboundTxParams:  &types.InboundTxParams{CoinType: zetacommon.CoinType_ERC20, Asset: assetAddress},
+			OutboundTxParams: []*types.OutboundTxParams{{ReceiverChainId: chainID}},
+		}
+		err = k.PayGasInERC20AndUpdateCctx(ctx, chainID, &cctx, math.NewUint(inputAmount), false)
+		require.NoError(t, err)
+		require.Equal(t, cctx.GetCurrentOutTxParam().Amount, res.ReceiveAmount)
+	})
+
+	t.Run("should fail to estimate the fee paid in erc20 if the asset is not found", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foo", "foo")
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, Prices: []uint64{gasPrice}})
+
+		_, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_ERC20,
+			Asset:           sample.EthAddress().String(),
+			Amount:          math.NewUint(inputAmount).String(),
+		})
+		require.ErrorContains(t, err, types.ErrForeignCoinNotFound.Error())
+	})
+
+	t.Run("should estimate the fee paid in zeta", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, Prices: []uint64{gasPrice}})
+
+		// gasLimit * gasPrice * 2 = 1000 * 2 * 2 = 4000
+		expectedGasFeeInZeta, err := zk.FungibleKeeper.QueryUniswapV2RouterGetZetaAmountsIn(ctx, big.NewInt(4000), zrc20)
+		require.NoError(t, err)
+		expectedFeeInZeta := types.GetProtocolFee().Add(math.NewUintFromBigInt(expectedGasFeeInZeta))
+		amount := expectedFeeInZeta.Add(math.NewUint(100000))
+
+		res, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_Zeta,
+			Amount:          amount.String(),
+			GasLimit:        1000,
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1000, res.GasLimit)
+		require.Equal(t, "4", res.GasPrice.String()) // gas price is doubled
+		require.Equal(t, "4000", res.GasFee.String())
+		require.Equal(t, expectedGasFeeInZeta.String(), res.GasFeeInZeta.String())
+		require.Equal(t, types.GetProtocolFee(), res.ProtocolFeeInZeta)
+		require.Equal(t, expectedFeeInZeta, res.TotalFee)
+		require.Equal(t, "100000", res.ReceiveAmount.String())
+	})
+
+	t.Run("should estimate the withdraw fee of a zrc20 withdrawn from ZetaChain", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+		chainID := getValidEthChainID(t)
+		zetaChainID := zetacommon.ZetaPrivnetChain().ChainId
+		setSupportedChain(ctx, zk, chainID, zetaChainID)
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, chainID, "foobar", "foobar")
+		_, err := zk.FungibleKeeper.UpdateZRC20ProtocolFlatFee(ctx, zrc20, big.NewInt(withdrawFee))
+		require.NoError(t, err)
+		k.SetGasPrice(ctx, types.GasPrice{ChainId: chainID, Prices: []uint64{gasPrice}})
+
+		res, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   zetaChainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_Gas,
+			Amount:          "1000",
+		})
+		require.NoError(t, err)
+
+		// the withdraw fee is paid on top of the amount
+		require.Equal(t, "42000", res.GasFee.String())
+		require.Equal(t, "1000", res.ProtocolFlatFee.String())
+		require.Equal(t, "43000", res.TotalFee.String())
+		require.Equal(t, "1000", res.ReceiveAmount.String())
+	})
+
+	t.Run("should fail for unsupported coin type", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		setSupportedChain(ctx, zk, chainID)
+		_, err := k.EstimateCctxFee(ctx, &types.QueryEstimateCctxFeeRequest{
+			SenderChainId:   chainID,
+			ReceiverChainId: chainID,
+			CoinType:        zetacommon.CoinType_Cmd,
+			Amount:          "1000",
+		})
+		require.ErrorContains(t, err, "can't pay gas with coin type")
+	})
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimitAll returns all the outbound rate limits
+func (k Keeper) RateLimitAll(c context.Context, req *types.QueryAllRateLimitRequest) (*types.QueryAllRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var rateLimits []types.RateLimit
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllRateLimitResponse{RateLimits: rateLimits, Pagination: pageRes}, nil
+}
+
+// RateLimitCapacity returns the outbound value recorded in the current window of a rate limit and the remaining capacity
+func (k Keeper) RateLimitCapacity(c context.Context, req *types.QueryRateLimitCapacityRequest) (*types.QueryRateLimitCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.ChainId, req.Zrc20Address)
+	if !found {
+		return nil, status.Error(codes.NotFound, "rate limit not found")
+	}
+	windowUsage := k.GetRateLimitWindowUsage(ctx, rateLimit)
+
+	return &types.QueryRateLimitCapacityResponse{
+		RateLimit:         rateLimit,
+		WindowUsage:       windowUsage,
+		RemainingCapacity: RemainingRateLimitCapacity(rateLimit, windowUsage),
+	}, nil
+}
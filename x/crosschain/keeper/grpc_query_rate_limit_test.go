@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_RateLimitAll(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.RateLimitAll(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("should return all rate limits", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		rateLimits := []types.RateLimit{
+			sample.RateLimit(t, "foo"),
+			sample.RateLimit(t, "bar"),
+		}
+		for _, rateLimit := range rateLimits {
+			k.SetRateLimit(ctx, rateLimit)
+		}
+
+		res, err := k.RateLimitAll(ctx, &types.QueryAllRateLimitRequest{
+			Pagination: &query.PageRequest{Limit: 10},
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, nullify.Fill(rateLimits), nullify.Fill(res.RateLimits))
+	})
+}
+
+func TestKeeper_RateLimitCapacity(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.RateLimitCapacity(ctx, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if the rate limit doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		_, err := k.RateLimitCapacity(ctx, &types.QueryRateLimitCapacityRequest{ChainId: 1})
+		require.Error(t, err)
+	})
+
+	t.Run("should return the usage and remaining capacity of the window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		rateLimit := types.RateLimit{ChainId: 1, Limit: math.NewUint(1000), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, math.NewUint(300))
+
+		res, err := k.RateLimitCapacity(ctx, &types.QueryRateLimitCapacityRequest{ChainId: 1})
+		require.NoError(t, err)
+		require.Equal(t, rateLimit, res.RateLimit)
+		require.Equal(t, math.NewUint(300), res.WindowUsage)
+		require.Equal(t, math.NewUint(700), res.RemainingCapacity)
+	})
+
+	t.Run("should return no remaining capacity if the usage exceeds the limit", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		rateLimit := types.RateLimit{ChainId: 1, Limit: math.NewUint(1000), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, math.NewUint(1300))
+
+		res, err := k.RateLimitCapacity(ctx, &types.QueryRateLimitCapacityRequest{ChainId: 1})
+		require.NoError(t, err)
+		require.True(t, res.RemainingCapacity.IsZero())
+	})
+}
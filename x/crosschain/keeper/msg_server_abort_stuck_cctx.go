@@ -4,7 +4,6 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -15,6 +14,8 @@ const (
 )
 
 // AbortStuckCCTX aborts a stuck CCTX
+// It is the fallback for an expired CCTX whose cancel tx can't be observed, because the receiver chain is halted or
+// TSS can't sign. The aborted ZETA amount of such a CCTX is added to the ZetaAccounting so it can be refunded.
 // Authorized: admin policy group 2
 func (k msgServer) AbortStuckCCTX(
 	goCtx context.Context,
@@ -22,30 +23,33 @@ func (k msgServer) AbortStuckCCTX(
 ) (*types.MsgAbortStuckCCTXResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check if authorized
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
-		return nil, observertypes.ErrNotAuthorized
-	}
-
 	// check if the cctx exists
 	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
 	if !found {
 		return nil, types.ErrCannotFindCctx
 	}
 
+	// check if authorized for the receiver chain of the cctx
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, cctx.GetCurrentOutTxParam().ReceiverChainId) {
+		return nil, observertypes.ErrNotAuthorized
+	}
+
 	// check if the cctx is pending
 	isPending := cctx.CctxStatus.Status == types.CctxStatus_PendingOutbound ||
 		cctx.CctxStatus.Status == types.CctxStatus_PendingInbound ||
-		cctx.CctxStatus.Status == types.CctxStatus_PendingRevert
+		cctx.CctxStatus.Status == types.CctxStatus_PendingRevert ||
+		cctx.CctxStatus.Status == types.CctxStatus_PendingRateLimited
 	if !isPending {
 		return nil, types.ErrStatusNotPending
 	}
 
-	cctx.CctxStatus = &types.Status{
-		Status:        types.CctxStatus_Aborted,
-		StatusMessage: AbortMessage,
-	}
+	cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, AbortMessage)
 
+	// the cctx with an expired outbound is aborted as it would be once the cancel tx is observed
+	if cctx.GetCurrentOutTxParam().Cancelled {
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+		return &types.MsgAbortStuckCCTXResponse{}, nil
+	}
 	k.SetCrossChainTx(ctx, cctx)
 
 	return &types.MsgAbortStuckCCTXResponse{}, nil
@@ -3,10 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -21,7 +22,7 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// create a cctx
 		cctx := sample.CrossChainTx(t, "cctx_index")
@@ -42,6 +43,9 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, crosschaintypes.CctxStatus_Aborted, cctxFound.CctxStatus.Status)
 		require.Equal(t, crosschainkeeper.AbortMessage, cctxFound.CctxStatus.StatusMessage)
+		require.Len(t, cctxFound.CctxStatus.History, 1)
+		require.Equal(t, crosschaintypes.CctxStatus_PendingInbound, cctxFound.CctxStatus.History[0].OldStatus)
+		require.Equal(t, crosschaintypes.CctxStatus_Aborted, cctxFound.CctxStatus.History[0].NewStatus)
 	})
 
 	t.Run("can abort a cctx in pending outbound", func(t *testing.T) {
@@ -52,7 +56,7 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// create a cctx
 		cctx := sample.CrossChainTx(t, "cctx_index")
@@ -75,6 +79,43 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		require.Equal(t, crosschainkeeper.AbortMessage, cctxFound.CctxStatus.StatusMessage)
 	})
 
+	t.Run("can abort a cctx with a cancelled outbound and update the zeta accounting", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+		zk.ObserverKeeper.SetTSS(ctx, sample.Tss())
+
+		// create a cctx whose outbound expired and is cancelled
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status:        crosschaintypes.CctxStatus_PendingOutbound,
+			StatusMessage: crosschainkeeper.ExpiredCancelMessage,
+		}
+		cctx.GetCurrentOutTxParam().CoinType = common.CoinType_Zeta
+		cctx.GetCurrentOutTxParam().Amount = math.NewUint(42)
+		cctx.GetCurrentOutTxParam().Cancelled = true
+		k.SetCrossChainTx(ctx, *cctx)
+
+		// abort the cctx
+		_, err := msgServer.AbortStuckCCTX(ctx, &crosschaintypes.MsgAbortStuckCCTX{
+			Creator:   admin,
+			CctxIndex: sample.GetCctxIndexFromString("cctx_index"),
+		})
+
+		require.NoError(t, err)
+		cctxFound, found := k.GetCrossChainTx(ctx, sample.GetCctxIndexFromString("cctx_index"))
+		require.True(t, found)
+		require.Equal(t, crosschaintypes.CctxStatus_Aborted, cctxFound.CctxStatus.Status)
+		zetaAccounting, found := k.GetZetaAccounting(ctx)
+		require.True(t, found)
+		require.Equal(t, math.NewUint(42), zetaAccounting.AbortedZetaAmount)
+	})
+
 	t.Run("can abort a cctx in pending revert", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
 			UseAuthorityMock: true,
@@ -83,7 +124,7 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// create a cctx
 		cctx := sample.CrossChainTx(t, "cctx_index")
@@ -114,7 +155,7 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, false)
 
 		// create a cctx
 		cctx := sample.CrossChainTx(t, "cctx_index")
@@ -139,8 +180,6 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
 
 		admin := sample.AccAddress()
-		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		// abort the cctx
 		_, err := msgServer.AbortStuckCCTX(ctx, &crosschaintypes.MsgAbortStuckCCTX{
@@ -150,6 +189,32 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 		require.ErrorIs(t, err, crosschaintypes.ErrCannotFindCctx)
 	})
 
+	t.Run("checks the authorization for the receiver chain of the cctx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+
+		// create a cctx
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status:        crosschaintypes.CctxStatus_PendingOutbound,
+			StatusMessage: "pending outbound",
+		}
+		k.SetCrossChainTx(ctx, *cctx)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsgForChain(&authorityMock.Mock, admin, cctx.GetCurrentOutTxParam().ReceiverChainId, true)
+
+		// abort the cctx
+		_, err := msgServer.AbortStuckCCTX(ctx, &crosschaintypes.MsgAbortStuckCCTX{
+			Creator:   admin,
+			CctxIndex: sample.GetCctxIndexFromString("cctx_index"),
+		})
+		require.NoError(t, err)
+	})
+
 	t.Run("cannot abort a cctx if not pending", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
 			UseAuthorityMock: true,
@@ -158,7 +223,7 @@ func TestMsgServer_AbortStuckCCTX(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// create a cctx
 		cctx := sample.CrossChainTx(t, "cctx_index")
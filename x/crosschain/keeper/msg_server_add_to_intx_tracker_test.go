@@ -9,7 +9,6 @@ import (
 	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -73,7 +72,7 @@ func TestMsgServer_AddToInTxTracker(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		tx_hash := "string"
 		chainID := getValidEthChainID(t)
@@ -102,7 +101,7 @@ func TestMsgServer_AddToInTxTracker(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		tx_hash := "string"
 		chainID := getValidEthChainID(t)
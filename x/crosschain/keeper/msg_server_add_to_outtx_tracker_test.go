@@ -7,7 +7,6 @@ import (
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -67,7 +66,7 @@ func TestMsgServer_AddToOutTxTracker(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		chainID := getEthereumChainID()
 		setupTssAndNonceToCctx(k, ctx, chainID, 0)
@@ -96,7 +95,7 @@ func TestMsgServer_AddToOutTxTracker(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		chainID := getEthereumChainID()
 		setupTssAndNonceToCctx(k, ctx, chainID, 0)
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// ClaimAbortedCCTXRefund refunds the aborted CCTX to its tx origin.
+// The creator must be the ZetaChain account of the tx origin of the inbound (same EVM address).
+// The refund is sent to the tx origin, or to the refund address provided in the message and therefore signed by the tx origin.
+// Tx origins that are not EVM addresses (e.g. Bitcoin senders) can't claim the refund and must be refunded by
+// the admin policy with RefundAbortedCCTX.
+func (k msgServer) ClaimAbortedCCTXRefund(
+	goCtx context.Context,
+	msg *types.MsgClaimAbortedCCTXRefund,
+) (*types.MsgClaimAbortedCCTXRefundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the cctx exists
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, types.ErrCannotFindCctx
+	}
+
+	// check if the creator is the tx origin of the cctx
+	txOrigin := cctx.InboundTxParams.TxOrigin
+	if !ethcommon.IsHexAddress(txOrigin) {
+		return nil, errorsmod.Wrap(types.ErrNotTxOrigin, fmt.Sprintf("tx origin %s is not an EVM address", txOrigin))
+	}
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	if ethcommon.BytesToAddress(creator.Bytes()) != ethcommon.HexToAddress(txOrigin) {
+		return nil, errorsmod.Wrap(types.ErrNotTxOrigin, fmt.Sprintf("creator %s, tx origin %s", msg.Creator, txOrigin))
+	}
+
+	// refund to the tx origin if no refund address is provided
+	refundAddress := msg.RefundAddress
+	if refundAddress == "" {
+		refundAddress = txOrigin
+	}
+
+	if err := k.RefundAbortedCCTXToAddress(ctx, cctx, refundAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimAbortedCCTXRefundResponse{}, nil
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+)
+
+func TestMsgServer_ClaimAbortedCCTXRefund(t *testing.T) {
+	t.Run("successfully claim refund to tx origin for coin-type gas", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		txOrigin := sample.EthAddress()
+		cctx := sample.CrossChainTx(t, "sample-index")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_Aborted
+		cctx.CctxStatus.IsAbortRefunded = false
+		cctx.InboundTxParams.TxOrigin = txOrigin.String()
+		cctx.InboundTxParams.SenderChainId = getValidEthChainID(t)
+		cctx.InboundTxParams.CoinType = common.CoinType_Gas
+		k.SetCrossChainTx(ctx, *cctx)
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+		zrc20 := setupGasCoin(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper, cctx.InboundTxParams.SenderChainId, "foobar", "foobar")
+
+		_, err := msgServer.ClaimAbortedCCTXRefund(ctx, crosschaintypes.NewMsgClaimAbortedCCTXRefund(
+			sdk.AccAddress(txOrigin.Bytes()).String(),
+			cctx.Index,
+			"",
+		))
+		require.NoError(t, err)
+
+		balance, err := zk.FungibleKeeper.BalanceOfZRC4(ctx, zrc20, txOrigin)
+		require.NoError(t, err)
+		require.Equal(t, cctx.GetCurrentOutTxParam().Amount.Uint64(), balance.Uint64())
+		c, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.True(t, c.CctxStatus.IsAbortRefunded)
+	})
+
+	t.Run("successfully claim refund to refund address for coin-type zeta", func(t *testing.T) {
+		k, ctx, sdkk, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
+
+		txOrigin := sample.EthAddress()
+		cctx := sample.CrossChainTx(t, "sample-index")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_Aborted
+		cctx.CctxStatus.IsAbortRefunded = false
+		cctx.InboundTxParams.TxOrigin = txOrigin.String()
+		cctx.InboundTxParams.SenderChainId = getValidEthChainID(t)
+		cctx.InboundTxParams.CoinType = common.CoinType_Zeta
+		k.SetCrossChainTx(ctx, *cctx)
+		k.SetZetaAccounting(ctx, crosschaintypes.ZetaAccounting{AbortedZetaAmount: cctx.InboundTxParams.Amount})
+		deploySystemContracts(t, ctx, zk.FungibleKeeper, sdkk.EvmKeeper)
+
+		refundAddress := sample.EthAddress()
+		_, err := msgServer.ClaimAbortedCCTXRefund(ctx, crosschaintypes.NewMsgClaimAbortedCCTXRefund(
+			sdk.AccAddress(txOrigin.Bytes()).String(),
+			cctx.Index,
+			refundAddress.String(),
+		))
+		require.NoError(t, err)
+
+		balance := sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(refundAddress.Bytes()), config.BaseDenom)
+		require.Equal(t, cctx.GetCurrentOutTxParam().Amount.Uint64(), balance.Amount.Uint64())
+		balance = sdkk.BankKeeper.GetBalance(ctx, sdk.AccAddress(txOrigin.Bytes()), config.BaseDenom)
+		require.True(t, balance.IsZero())
+		c, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.True(t, c.CctxStatus.IsAbortRefunded)
+	})
+
+	t.Run("fail claim if creator is not the tx origin", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		cctx := sample.CrossChainTx(t, "sample-index")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_Aborted
+		cctx.InboundTxParams.TxOrigin = sample.EthAddress().String()
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ClaimAbortedCCTXRefund(ctx, crosschaintypes.NewMsgClaimAbortedCCTXRefund(
+			sample.AccAddress(),
+			cctx.Index,
+			"",
+		))
+		require.ErrorIs(t, err, crosschaintypes.ErrNotTxOrigin)
+	})
+
+	t.Run("fail claim if tx origin is not an evm address", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		cctx := sample.CrossChainTx(t, "sample-index")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_Aborted
+		cctx.InboundTxParams.TxOrigin = "bcrt1qs758ursh4q9z627kt3pp5yysm78ddny6txaqgw"
+		cctx.InboundTxParams.SenderChainId = getValidBtcChainID()
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ClaimAbortedCCTXRefund(ctx, crosschaintypes.NewMsgClaimAbortedCCTXRefund(
+			sample.AccAddress(),
+			cctx.Index,
+			sample.EthAddress().String(),
+		))
+		require.ErrorIs(t, err, crosschaintypes.ErrNotTxOrigin)
+	})
+
+	t.Run("fail claim if status is not aborted", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		txOrigin := sample.EthAddress()
+		cctx := sample.CrossChainTx(t, "sample-index")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_PendingOutbound
+		cctx.InboundTxParams.TxOrigin = txOrigin.String()
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ClaimAbortedCCTXRefund(ctx, crosschaintypes.NewMsgClaimAbortedCCTXRefund(
+			sdk.AccAddress(txOrigin.Bytes()).String(),
+			cctx.Index,
+			"",
+		))
+		require.ErrorIs(t, err, crosschaintypes.ErrInvalidStatus)
+	})
+
+	t.Run("fail claim if already refunded", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		txOrigin := sample.EthAddress()
+		cctx := sample.CrossChainTx(t, "sample-index")
+		cctx.CctxStatus.Status = crosschaintypes.CctxStatus_Aborted
+		cctx.CctxStatus.IsAbortRefunded = true
+		cctx.InboundTxParams.TxOrigin = txOrigin.String()
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ClaimAbortedCCTXRefund(ctx, crosschaintypes.NewMsgClaimAbortedCCTXRefund(
+			sdk.AccAddress(txOrigin.Bytes()).String(),
+			cctx.Index,
+			"",
+		))
+		require.ErrorIs(t, err, crosschaintypes.ErrUnableProcessRefund)
+	})
+
+	t.Run("fail claim if cctx not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+
+		_, err := msgServer.ClaimAbortedCCTXRefund(ctx, crosschaintypes.NewMsgClaimAbortedCCTXRefund(
+			sdk.AccAddress(ethcommon.Address{}.Bytes()).String(),
+			sample.GetCctxIndexFromString("sample-index"),
+			"",
+		))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotFindCctx)
+	})
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// ExtendCctxDeadline extends the deadline after which a CCTX pending outbound expires
+// The extension is added to the previous extensions of the CCTX, the deadline of an expired CCTX can't be extended
+// Authorized: emergency policy group
+func (k msgServer) ExtendCctxDeadline(
+	goCtx context.Context,
+	msg *types.MsgExtendCctxDeadline,
+) (*types.MsgExtendCctxDeadlineResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if the cctx exists
+	cctx, found := k.GetCrossChainTx(ctx, msg.CctxIndex)
+	if !found {
+		return nil, types.ErrCannotFindCctx
+	}
+
+	// check if authorized for the receiver chain of the cctx
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, cctx.GetCurrentOutTxParam().ReceiverChainId) {
+		return nil, observertypes.ErrNotAuthorized
+	}
+
+	// only a cctx pending outbound can expire
+	if cctx.CctxStatus.Status != types.CctxStatus_PendingOutbound {
+		return nil, types.ErrStatusNotPending
+	}
+
+	// the outbound of an expired cctx is already cancelled
+	if cctx.GetCurrentOutTxParam().Cancelled {
+		return nil, types.ErrOutboundCancelled
+	}
+
+	cctx.CctxStatus.DeadlineExtensionBlocks += msg.ExtensionBlocks
+	k.SetCrossChainTx(ctx, cctx)
+
+	return &types.MsgExtendCctxDeadlineResponse{}, nil
+}
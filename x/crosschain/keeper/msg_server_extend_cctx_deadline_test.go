@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_ExtendCctxDeadline(t *testing.T) {
+	t.Run("can extend the deadline of a cctx pending outbound", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		emergency := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, emergency, true)
+
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status:                  crosschaintypes.CctxStatus_PendingOutbound,
+			DeadlineExtensionBlocks: 100,
+		}
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ExtendCctxDeadline(ctx, crosschaintypes.NewMsgExtendCctxDeadline(emergency, cctx.Index, 50))
+		require.NoError(t, err)
+
+		cctxFound, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.EqualValues(t, 150, cctxFound.CctxStatus.DeadlineExtensionBlocks)
+	})
+
+	t.Run("cannot extend the deadline if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		creator := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, creator, false)
+
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status: crosschaintypes.CctxStatus_PendingOutbound,
+		}
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ExtendCctxDeadline(ctx, crosschaintypes.NewMsgExtendCctxDeadline(creator, cctx.Index, 50))
+		require.ErrorIs(t, err, observertypes.ErrNotAuthorized)
+	})
+
+	t.Run("cannot extend the deadline if the cctx doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		emergency := sample.AccAddress()
+
+		_, err := msgServer.ExtendCctxDeadline(ctx, crosschaintypes.NewMsgExtendCctxDeadline(emergency, "cctx_index", 50))
+		require.ErrorIs(t, err, crosschaintypes.ErrCannotFindCctx)
+	})
+
+	t.Run("cannot extend the deadline if the cctx is not pending outbound", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		emergency := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, emergency, true)
+
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status: crosschaintypes.CctxStatus_PendingRevert,
+		}
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ExtendCctxDeadline(ctx, crosschaintypes.NewMsgExtendCctxDeadline(emergency, cctx.Index, 50))
+		require.ErrorIs(t, err, crosschaintypes.ErrStatusNotPending)
+	})
+	t.Run("cannot extend the deadline if the outbound is cancelled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		emergency := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, emergency, true)
+
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status: crosschaintypes.CctxStatus_PendingOutbound,
+		}
+		cctx.GetCurrentOutTxParam().Cancelled = true
+		k.SetCrossChainTx(ctx, *cctx)
+
+		_, err := msgServer.ExtendCctxDeadline(ctx, crosschaintypes.NewMsgExtendCctxDeadline(emergency, cctx.Index, 50))
+		require.ErrorIs(t, err, crosschaintypes.ErrOutboundCancelled)
+	})
+	t.Run("checks the authorization for the receiver chain of the cctx", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		emergency := sample.AccAddress()
+		cctx := sample.CrossChainTx(t, "cctx_index")
+		cctx.CctxStatus = &crosschaintypes.Status{
+			Status: crosschaintypes.CctxStatus_PendingOutbound,
+		}
+		k.SetCrossChainTx(ctx, *cctx)
+
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsgForChain(&authorityMock.Mock, emergency, cctx.GetCurrentOutTxParam().ReceiverChainId, true)
+
+		_, err := msgServer.ExtendCctxDeadline(ctx, crosschaintypes.NewMsgExtendCctxDeadline(emergency, cctx.Index, 50))
+		require.NoError(t, err)
+	})
+}
@@ -17,7 +17,9 @@ import (
 
 // GasPriceVoter submits information about the connected chain's gas price at a specific block
 // height. Gas price submitted by each validator is recorded separately and a
-// median index is updated.
+// median index is updated. The priority fee (EIP-1559) submitted along is recorded
+// separately as well. On chains supporting EIP-1559, the gas price covers the base fee
+// and the priority fee and is used as the max fee per gas of the outbounds.
 //
 // Only observer validators are authorized to broadcast this message.
 func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVoter) (*types.MsgGasPriceVoterResponse, error) {
@@ -37,21 +39,28 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 	gasPrice, isFound := k.GetGasPrice(ctx, chain.ChainId)
 	if !isFound {
 		gasPrice = types.GasPrice{
-			Creator:     msg.Creator,
-			Index:       strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
-			ChainId:     chain.ChainId,
-			Prices:      []uint64{msg.Price},
-			BlockNums:   []uint64{msg.BlockNumber},
-			Signers:     []string{msg.Creator},
-			MedianIndex: 0,
+			Creator:      msg.Creator,
+			Index:        strconv.FormatInt(chain.ChainId, 10), // TODO : Not needed index set at keeper
+			ChainId:      chain.ChainId,
+			Prices:       []uint64{msg.Price},
+			PriorityFees: []uint64{msg.PriorityFee},
+			BlockNums:    []uint64{msg.BlockNumber},
+			Signers:      []string{msg.Creator},
+			MedianIndex:  0,
 		}
 	} else {
+		// priority fees are not set for gas prices voted before they were introduced
+		for len(gasPrice.PriorityFees) < len(gasPrice.Prices) {
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, 0)
+		}
+
 		signers := gasPrice.Signers
 		exist := false
 		for i, s := range signers {
 			if s == msg.Creator { // update existing entry
 				gasPrice.BlockNums[i] = msg.BlockNumber
 				gasPrice.Prices[i] = msg.Price
+				gasPrice.PriorityFees[i] = msg.PriorityFee
 				exist = true
 				break
 			}
@@ -60,6 +69,7 @@ func (k msgServer) GasPriceVoter(goCtx context.Context, msg *types.MsgGasPriceVo
 			gasPrice.Signers = append(gasPrice.Signers, msg.Creator)
 			gasPrice.BlockNums = append(gasPrice.BlockNums, msg.BlockNumber)
 			gasPrice.Prices = append(gasPrice.Prices, msg.Price)
+			gasPrice.PriorityFees = append(gasPrice.PriorityFees, msg.PriorityFee)
 		}
 		// recompute the median gas price
 		mi := medianOfArray(gasPrice.Prices)
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgServer_GasPriceVoter(t *testing.T) {
+	chain := common.EthChain()
+
+	t.Run("should record the gas price and the priority fee of each voter", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+			UseFungibleMock: true,
+		})
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, chain.ChainId).Return(&chain)
+		observerMock.On("IsAuthorized", mock.Anything, mock.Anything).Return(true)
+		fungibleMock.On("SetGasPrice", mock.Anything, mock.Anything, mock.Anything).Return(uint64(1), nil)
+
+		voters := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+		for i, voter := range voters {
+			// #nosec G701 always in range
+			price := uint64(100 * (i + 1))
+			_, err := msgServer.GasPriceVoter(ctx, crosschaintypes.NewMsgGasPriceVoter(voter, chain.ChainId, price, price/10, "100", 42))
+			require.NoError(t, err)
+		}
+
+		gasPrice, found := k.GetGasPrice(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, []uint64{100, 200, 300}, gasPrice.Prices)
+		require.Equal(t, []uint64{10, 20, 30}, gasPrice.PriorityFees)
+
+		// update the vote of a voter
+		_, err := msgServer.GasPriceVoter(ctx, crosschaintypes.NewMsgGasPriceVoter(voters[0], chain.ChainId, 400, 40, "100", 43))
+		require.NoError(t, err)
+		gasPrice, found = k.GetGasPrice(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, []uint64{400, 200, 300}, gasPrice.Prices)
+		require.Equal(t, []uint64{40, 20, 30}, gasPrice.PriorityFees)
+
+		priorityFee, found := k.GetMedianPriorityFeeInUint(ctx, chain.ChainId)
+		require.True(t, found)
+		require.EqualValues(t, 30, priorityFee.Uint64())
+	})
+
+	t.Run("should align the priority fees of gas prices voted without them", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+			UseFungibleMock: true,
+		})
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		fungibleMock := keepertest.GetCrosschainFungibleMock(t, k)
+		observerMock.On("GetSupportedChainFromChainID", mock.Anything, chain.ChainId).Return(&chain)
+		observerMock.On("IsAuthorized", mock.Anything, mock.Anything).Return(true)
+		fungibleMock.On("SetGasPrice", mock.Anything, mock.Anything, mock.Anything).Return(uint64(1), nil)
+
+		voters := []string{sample.AccAddress(), sample.AccAddress()}
+		k.SetGasPrice(ctx, crosschaintypes.GasPrice{
+			ChainId:   chain.ChainId,
+			Signers:   voters,
+			Prices:    []uint64{100, 200},
+			BlockNums: []uint64{42, 42},
+		})
+
+		_, err := msgServer.GasPriceVoter(ctx, crosschaintypes.NewMsgGasPriceVoter(voters[1], chain.ChainId, 300, 30, "100", 43))
+		require.NoError(t, err)
+
+		gasPrice, found := k.GetGasPrice(ctx, chain.ChainId)
+		require.True(t, found)
+		require.Equal(t, []uint64{100, 300}, gasPrice.Prices)
+		require.Equal(t, []uint64{0, 30}, gasPrice.PriorityFees)
+	})
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -84,7 +83,7 @@ func TestKeeper_MigrateTSSFundsForChain(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain(t)
@@ -117,7 +116,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain(t)
@@ -145,7 +144,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain(t)
@@ -170,7 +169,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain(t)
@@ -195,7 +194,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain(t)
@@ -227,7 +226,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain(t)
@@ -268,7 +267,7 @@ func TestMsgServer_MigrateTssFunds(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		chain := getValidEthChain(t)
@@ -7,7 +7,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/zeta-chain/zetacore/common"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 	"golang.org/x/net/context"
@@ -18,12 +17,16 @@ import (
 // It refunds the amount to the refund address and sets the CCTX as refunded.
 // Refer to documentation for GetRefundAddress for the refund address logic.
 // Refer to documentation for GetAbortedAmount for the aborted amount logic.
+// The tx origin of the CCTX can also claim the refund itself with ClaimAbortedCCTXRefund, this message is kept for the
+// cases where the tx origin can't sign on ZetaChain (e.g. Bitcoin senders).
+//
+// Authorized: admin policy group 2.
 func (k msgServer) RefundAbortedCCTX(goCtx context.Context, msg *types.MsgRefundAbortedCCTX) (*types.MsgRefundAbortedCCTXResponse, error) {
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check if authorized
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, 0) {
 		return nil, observertypes.ErrNotAuthorized
 	}
 
@@ -33,13 +36,23 @@ func (k msgServer) RefundAbortedCCTX(goCtx context.Context, msg *types.MsgRefund
 		return nil, types.ErrCannotFindCctx
 	}
 
+	if err := k.RefundAbortedCCTXToAddress(ctx, cctx, msg.RefundAddress); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundAbortedCCTXResponse{}, nil
+}
+
+// RefundAbortedCCTXToAddress refunds the aborted amount of the CCTX to the refund address and sets the CCTX as refunded.
+// It verifies if the CCTX is aborted and not refunded, and if the refund address is valid.
+func (k Keeper) RefundAbortedCCTXToAddress(ctx sdk.Context, cctx types.CrossChainTx, refundAddress string) error {
 	// check if the cctx is aborted
 	if cctx.CctxStatus.Status != types.CctxStatus_Aborted {
-		return nil, errorsmod.Wrap(types.ErrInvalidStatus, "CCTX is not aborted")
+		return errorsmod.Wrap(types.ErrInvalidStatus, "CCTX is not aborted")
 	}
 	// check if the cctx is not refunded
 	if cctx.CctxStatus.IsAbortRefunded {
-		return nil, errorsmod.Wrap(types.ErrUnableProcessRefund, "CCTX is already refunded")
+		return errorsmod.Wrap(types.ErrUnableProcessRefund, "CCTX is already refunded")
 	}
 
 	// Check if aborted amount is available to maintain zeta accounting
@@ -47,7 +60,7 @@ func (k msgServer) RefundAbortedCCTX(goCtx context.Context, msg *types.MsgRefund
 		err := k.RemoveZetaAbortedAmount(ctx, GetAbortedAmount(cctx))
 		// if the zeta accounting is not found, it means the zeta accounting is not set yet and the refund should not be processed
 		if errors.Is(err, types.ErrUnableToFindZetaAccounting) {
-			return nil, errorsmod.Wrap(types.ErrUnableProcessRefund, err.Error())
+			return errorsmod.Wrap(types.ErrUnableProcessRefund, err.Error())
 		}
 		// if the zeta accounting is found but the amount is insufficient, it means the refund can be processed but the zeta accounting is not maintained properly
 		if errors.Is(err, types.ErrInsufficientZetaAmount) {
@@ -55,16 +68,16 @@ func (k msgServer) RefundAbortedCCTX(goCtx context.Context, msg *types.MsgRefund
 		}
 	}
 
-	refundAddress, err := GetRefundAddress(msg.RefundAddress)
+	ethRefundAddress, err := GetRefundAddress(refundAddress)
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
+		return errorsmod.Wrap(types.ErrInvalidAddress, err.Error())
 	}
 	// refund the amount
 	// use temporary context to avoid gas refunding issues and side effects
 	tmpCtx, commit := ctx.CacheContext()
-	err = k.RefundAbortedAmountOnZetaChain(tmpCtx, cctx, refundAddress)
+	err = k.RefundAbortedAmountOnZetaChain(tmpCtx, cctx, ethRefundAddress)
 	if err != nil {
-		return nil, errorsmod.Wrap(types.ErrUnableProcessRefund, err.Error())
+		return errorsmod.Wrap(types.ErrUnableProcessRefund, err.Error())
 	}
 	commit()
 
@@ -73,7 +86,7 @@ func (k msgServer) RefundAbortedCCTX(goCtx context.Context, msg *types.MsgRefund
 
 	k.SetCrossChainTx(ctx, cctx)
 
-	return &types.MsgRefundAbortedCCTXResponse{}, nil
+	return nil
 }
 
 // GetRefundAddress gets the proper refund address.
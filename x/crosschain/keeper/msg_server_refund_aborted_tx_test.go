@@ -10,7 +10,6 @@ import (
 	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
 	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
 	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
@@ -45,7 +44,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -84,7 +83,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -123,7 +122,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -163,7 +162,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -203,7 +202,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		chainID := getValidEthChainID(t)
 		asset := sample.EthAddress().String()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -252,7 +251,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidBtcChainID()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -291,7 +290,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -322,7 +321,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -353,7 +352,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -386,7 +385,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -415,7 +414,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidBtcChainID()
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -446,7 +445,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -476,7 +475,7 @@ func TestMsgServer_RefundAbortedCCTX(t *testing.T) {
 		admin := sample.AccAddress()
 		chainID := getValidEthChainID(t)
 		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, false)
 
 		msgServer := keeper.NewMsgServerImpl(*k)
 		k.GetAuthKeeper().GetModuleAccount(ctx, fungibletypes.ModuleName)
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// RemoveRateLimit removes the outbound rate limit of a receiver chain and asset
+// the cctxs queued for the rate limit are released in the following blocks
+// Authorized: admin policy group 2
+func (k msgServer) RemoveRateLimit(
+	goCtx context.Context,
+	msg *types.MsgRemoveRateLimit,
+) (*types.MsgRemoveRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if authorized
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, msg.ChainId) {
+		return nil, observertypes.ErrNotAuthorized
+	}
+
+	if _, found := k.GetRateLimit(ctx, msg.ChainId, msg.Zrc20Address); !found {
+		return nil, types.ErrRateLimitNotFound
+	}
+
+	k.Keeper.RemoveRateLimit(ctx, msg.ChainId, msg.Zrc20Address)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_RemoveRateLimit(t *testing.T) {
+	t.Run("can remove a rate limit", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		rateLimit := sample.RateLimit(t, "foo")
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, math.NewUint(100))
+
+		_, err := msgServer.RemoveRateLimit(ctx, crosschaintypes.NewMsgRemoveRateLimit(admin, rateLimit.ChainId, rateLimit.Zrc20Address))
+		require.NoError(t, err)
+
+		_, found := k.GetRateLimit(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+		require.False(t, found)
+		require.True(t, k.GetRateLimitWindowUsage(ctx, rateLimit).IsZero())
+	})
+
+	t.Run("cannot remove a rate limit if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		creator := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, creator, false)
+
+		rateLimit := sample.RateLimit(t, "foo")
+		k.SetRateLimit(ctx, rateLimit)
+
+		_, err := msgServer.RemoveRateLimit(ctx, crosschaintypes.NewMsgRemoveRateLimit(creator, rateLimit.ChainId, rateLimit.Zrc20Address))
+		require.ErrorIs(t, err, observertypes.ErrNotAuthorized)
+	})
+
+	t.Run("cannot remove a rate limit that doesn't exist", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		_, err := msgServer.RemoveRateLimit(ctx, crosschaintypes.NewMsgRemoveRateLimit(admin, 1, ""))
+		require.ErrorIs(t, err, crosschaintypes.ErrRateLimitNotFound)
+	})
+}
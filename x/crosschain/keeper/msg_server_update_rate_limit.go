@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UpdateRateLimit creates or updates the outbound rate limit of a receiver chain and asset
+// the outbound value already recorded in the window is kept
+// Authorized: admin policy group 2
+func (k msgServer) UpdateRateLimit(
+	goCtx context.Context,
+	msg *types.MsgUpdateRateLimit,
+) (*types.MsgUpdateRateLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check if authorized
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, msg.RateLimit.ChainId) {
+		return nil, observertypes.ErrNotAuthorized
+	}
+
+	// check the receiver chain is supported
+	if k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, msg.RateLimit.ChainId) == nil {
+		return nil, observertypes.ErrSupportedChains
+	}
+
+	k.SetRateLimit(ctx, msg.RateLimit)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	crosschainkeeper "github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	crosschaintypes "github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_UpdateRateLimit(t *testing.T) {
+	t.Run("can create and update a rate limit", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+		chainID := getValidEthChainID(t)
+		zrc20 := sample.EthAddress().Hex()
+
+		rateLimit := crosschaintypes.RateLimit{
+			ChainId:      chainID,
+			Zrc20Address: zrc20,
+			Limit:        math.NewUint(1000),
+			Window:       100,
+		}
+		_, err := msgServer.UpdateRateLimit(ctx, crosschaintypes.NewMsgUpdateRateLimit(admin, rateLimit))
+		require.NoError(t, err)
+
+		got, found := k.GetRateLimit(ctx, chainID, zrc20)
+		require.True(t, found)
+		require.Equal(t, rateLimit, got)
+
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+		rateLimit.Limit = math.NewUint(500)
+		rateLimit.Action = crosschaintypes.RateLimitAction_Reject
+		_, err = msgServer.UpdateRateLimit(ctx, crosschaintypes.NewMsgUpdateRateLimit(admin, rateLimit))
+		require.NoError(t, err)
+
+		got, found = k.GetRateLimit(ctx, chainID, zrc20)
+		require.True(t, found)
+		require.Equal(t, rateLimit, got)
+	})
+
+	t.Run("cannot update a rate limit if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		creator := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, creator, false)
+
+		rateLimit := sample.RateLimit(t, "foo")
+		_, err := msgServer.UpdateRateLimit(ctx, crosschaintypes.NewMsgUpdateRateLimit(creator, rateLimit))
+		require.ErrorIs(t, err, observertypes.ErrNotAuthorized)
+	})
+
+	t.Run("cannot update a rate limit for an unsupported chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := crosschainkeeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetCrosschainAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		rateLimit := sample.RateLimit(t, "foo")
+		rateLimit.ChainId = 999999
+		_, err := msgServer.UpdateRateLimit(ctx, crosschaintypes.NewMsgUpdateRateLimit(admin, rateLimit))
+		require.ErrorIs(t, err, observertypes.ErrSupportedChains)
+	})
+}
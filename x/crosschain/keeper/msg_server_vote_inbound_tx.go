@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 // FIXME: use more specific error types & codes
@@ -57,6 +58,18 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	index := msg.Digest()
 
+	// check the inbounds of the sender chain and the coin are enabled
+	if flags, found := k.zetaObserverKeeper.GetCrosschainFlags(ctx); found &&
+		!flags.IsForeignCoinInboundEnabled(msg.SenderChainId, msg.CoinType, msg.Asset) {
+		return nil, cosmoserrors.Wrapf(
+			observertypes.ErrInboundDisabled,
+			"chain %d, coin type %s, asset %s",
+			msg.SenderChainId,
+			msg.CoinType.String(),
+			msg.Asset,
+		)
+	}
+
 	// vote on inbound ballot
 	// use a temporary context to not commit any ballot state change in case of error
 	tmpCtx, commit := ctx.CacheContext()
@@ -126,19 +139,19 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 		isContractReverted, err := k.HandleEVMDeposit(tmpCtx, &cctx, *msg, msg.SenderChainId)
 
 		if err != nil && !isContractReverted { // exceptional case; internal error; should abort CCTX
-			cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
+			cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, err.Error())
 			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 		} else if err != nil && isContractReverted { // contract call reverted; should refund
 			revertMessage := err.Error()
 			chain := k.zetaObserverKeeper.GetSupportedChainFromChainID(ctx, cctx.InboundTxParams.SenderChainId)
 			if chain == nil {
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "invalid sender chain")
+				cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, "invalid sender chain")
 				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 			}
 
 			gasLimit, err := k.GetRevertGasLimit(ctx, cctx)
 			if err != nil {
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "can't get revert tx gas limit"+err.Error())
+				cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, "can't get revert tx gas limit"+err.Error())
 				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 			}
 			if gasLimit == 0 {
@@ -172,16 +185,16 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 				return k.UpdateNonce(tmpCtx, chain.ChainId, &cctx)
 			}()
 			if err != nil {
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error()+" deposit revert message: "+revertMessage)
+				cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, err.Error()+" deposit revert message: "+revertMessage)
 				return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 			}
 			commit()
-			cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingRevert, revertMessage)
+			cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_PendingRevert, revertMessage)
 			return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 		}
 		// successful HandleEVMDeposit;
 		commit()
-		cctx.CctxStatus.ChangeStatus(types.CctxStatus_OutboundMined, "Remote omnichain contract call completed")
+		cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_OutboundMined, "Remote omnichain contract call completed")
 		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 	}
 
@@ -202,10 +215,10 @@ func (k msgServer) VoteOnObservedInboundTx(goCtx context.Context, msg *types.Msg
 	}()
 	if err != nil {
 		// do not commit anything here as the CCTX should be aborted
-		cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
+		cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, err.Error())
 		return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 	}
 	commit()
-	cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingOutbound, "")
+	cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_PendingOutbound, "")
 	return &types.MsgVoteOnObservedInboundTxResponse{}, nil
 }
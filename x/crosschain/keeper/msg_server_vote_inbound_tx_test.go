@@ -73,6 +73,69 @@ func TestKeeper_VoteOnObservedInboundTx(t *testing.T) {
 		require.Equal(t, cctx.InboundTxParams.TxFinalizationStatus, types.TxFinalizationStatus_Executed)
 	})
 
+	t.Run("should fail if the inbounds of the sender chain are disabled", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		validatorList := setObservers(t, k, ctx, zk)
+		to, from := int64(1337), int64(101)
+		chains := zk.ObserverKeeper.GetSupportedChains(ctx)
+		for _, chain := range chains {
+			if common.IsEVMChain(chain.ChainId) {
+				from = chain.ChainId
+			}
+			if common.IsZetaChain(chain.ChainId) {
+				to = chain.ChainId
+			}
+		}
+		flags := observertypes.DefaultCrosschainFlags()
+		flags.SetChainFlags(observertypes.ChainFlags{
+			ChainId:           from,
+			IsInboundEnabled:  false,
+			IsOutboundEnabled: true,
+		})
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, *flags)
+
+		msg := sample.InboundVote(0, from, to)
+		msg.Creator = validatorList[0]
+		_, err := msgServer.VoteOnObservedInboundTx(ctx, &msg)
+		require.ErrorIs(t, err, observertypes.ErrInboundDisabled)
+		_, found := zk.ObserverKeeper.GetBallot(ctx, msg.Digest())
+		require.False(t, found)
+	})
+
+	t.Run("should fail if the inbounds of the foreign coin are disabled", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		msgServer := keeper.NewMsgServerImpl(*k)
+		validatorList := setObservers(t, k, ctx, zk)
+		to, from := int64(1337), int64(101)
+		chains := zk.ObserverKeeper.GetSupportedChains(ctx)
+		for _, chain := range chains {
+			if common.IsEVMChain(chain.ChainId) {
+				from = chain.ChainId
+			}
+			if common.IsZetaChain(chain.ChainId) {
+				to = chain.ChainId
+			}
+		}
+		msg := sample.InboundVote(common.CoinType_ERC20, from, to)
+		msg.Creator = validatorList[0]
+		msg.Asset = sample.EthAddress().Hex()
+
+		flags := observertypes.DefaultCrosschainFlags()
+		flags.SetForeignCoinFlags(observertypes.ForeignCoinFlags{
+			ChainId:           from,
+			Asset:             msg.Asset,
+			IsInboundEnabled:  false,
+			IsOutboundEnabled: true,
+		})
+		zk.ObserverKeeper.SetCrosschainFlags(ctx, *flags)
+
+		_, err := msgServer.VoteOnObservedInboundTx(ctx, &msg)
+		require.ErrorIs(t, err, observertypes.ErrInboundDisabled)
+		_, found := zk.ObserverKeeper.GetBallot(ctx, msg.Digest())
+		require.False(t, found)
+	})
+
 	t.Run("prevent double event submission", func(t *testing.T) {
 		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
 
@@ -187,15 +250,18 @@ func TestStatus_ChangeStatus(t *testing.T) {
 			IsErr:        false,
 		},
 	}
+	_, ctx, _, _ := keepertest.CrosschainKeeper(t)
 	for _, test := range tt {
 		test := test
 		t.Run(test.Name, func(t *testing.T) {
-			test.Status.ChangeStatus(test.NonErrStatus, test.Msg)
+			test.Status.ChangeStatus(ctx, test.NonErrStatus, test.Msg)
 			if test.IsErr {
 				require.Equal(t, test.ErrStatus, test.Status.Status)
 			} else {
 				require.Equal(t, test.NonErrStatus, test.Status.Status)
 			}
+			require.Len(t, test.Status.History, 1)
+			require.Equal(t, test.Status.Status, test.Status.History[0].NewStatus)
 		})
 	}
 }
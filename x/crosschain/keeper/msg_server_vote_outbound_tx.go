@@ -41,6 +41,10 @@ import (
 //
 // If the previous status was `PendingRevert`, the CCTX is aborted.
 //
+// The outbound of an expired CCTX is cancelled and observed as unsuccessful
+// once the cancel transaction consuming its nonce is mined. If the outbound
+// itself was mined before, the observation is successful and the CCTX is completed.
+//
 // ```mermaid
 // stateDiagram-v2
 //
@@ -135,16 +139,21 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 		case observertypes.BallotStatus_BallotFinalized_SuccessObservation:
 			switch oldStatus {
 			case types.CctxStatus_PendingRevert:
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Reverted, "")
+				cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Reverted, "")
 			case types.CctxStatus_PendingOutbound:
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_OutboundMined, "")
+				cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_OutboundMined, "")
 			}
 			newStatus := cctx.CctxStatus.Status.String()
 			EmitOutboundSuccess(tmpCtx, msg, oldStatus.String(), newStatus, cctx)
 		case observertypes.BallotStatus_BallotFinalized_FailureObservation:
+			// a cancelled outbound is observed as failed when the cancel tx consuming its nonce is mined
+			abortMessage, revertMessage := "", "Outbound failed, start revert"
+			if cctx.GetCurrentOutTxParam().Cancelled {
+				abortMessage, revertMessage = ExpiredAbortMessage, ExpiredRevertMessage
+			}
 			if msg.CoinType == common.CoinType_Cmd || common.IsZetaChain(cctx.InboundTxParams.SenderChainId) {
 				// if the cctx is of coin type cmd or the sender chain is zeta chain, then we do not revert, the cctx is aborted
-				cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "")
+				cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, abortMessage)
 			} else {
 				switch oldStatus {
 				case types.CctxStatus_PendingOutbound:
@@ -182,9 +191,9 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 					if err != nil {
 						return err
 					}
-					cctx.CctxStatus.ChangeStatus(types.CctxStatus_PendingRevert, "Outbound failed, start revert")
+					cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_PendingRevert, revertMessage)
 				case types.CctxStatus_PendingRevert:
-					cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, "Outbound failed: revert failed; abort TX")
+					cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, "Outbound failed: revert failed; abort TX")
 				}
 			}
 			newStatus := cctx.CctxStatus.Status.String()
@@ -194,7 +203,7 @@ func (k msgServer) VoteOnObservedOutboundTx(goCtx context.Context, msg *types.Ms
 	}()
 	if err != nil {
 		// do not commit tmpCtx
-		cctx.CctxStatus.ChangeStatus(types.CctxStatus_Aborted, err.Error())
+		cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, err.Error())
 		cctx.GetCurrentOutTxParam().TxFinalizationStatus = types.TxFinalizationStatus_Executed
 		ctx.Logger().Error(err.Error())
 		// #nosec G701 always in range
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"math/big"
 
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
-
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
@@ -28,7 +26,7 @@ func (k msgServer) WhitelistERC20(goCtx context.Context, msg *types.MsgWhitelist
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check if authorized
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, msg.ChainId) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "Deploy can only be executed by the correct policy account")
 	}
 
@@ -131,7 +129,8 @@ func (k msgServer) WhitelistERC20(goCtx context.Context, msg *types.MsgWhitelist
 			InboundTxObservedHash:           hash.String(), // all Upper case Cosmos TX HEX, with no 0x prefix
 			InboundTxObservedExternalHeight: 0,
 			InboundTxBallotIndex:            "",
-			InboundTxFinalizedZetaHeight:    0,
+			// #nosec G701 always positive
+			InboundTxFinalizedZetaHeight: uint64(ctx.BlockHeight()),
 		},
 		OutboundTxParams: []*types.OutboundTxParams{
 			{
@@ -0,0 +1,262 @@
+package keeper
+
+import (
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+const (
+	// MaxRateLimitedCctxsReleasedPerBlock is the maximum number of rate limited cctxs released in a block
+	MaxRateLimitedCctxsReleasedPerBlock = 100
+
+	// MaxRateLimitedCctxsReadPerBlock is the maximum number of rate limited cctxs read from the queue in a block
+	// the scan resumes in the next block from the last read cctx
+	MaxRateLimitedCctxsReadPerBlock = 1000
+
+	// RateLimitedMessage is the status message of a cctx queued because its outbound exceeds the rate limit
+	RateLimitedMessage = "Outbound rate limit exceeded, queued until the window has enough capacity"
+
+	// RateLimitReleasedMessage is the status message of a rate limited cctx released for outbound
+	RateLimitReleasedMessage = "Outbound released from the rate limit queue"
+)
+
+// RemainingRateLimitCapacity returns the outbound value that can still be sent in the current window of a rate limit
+func RemainingRateLimitCapacity(rateLimit types.RateLimit, windowUsage sdkmath.Uint) sdkmath.Uint {
+	if windowUsage.GTE(rateLimit.Limit) {
+		return sdkmath.ZeroUint()
+	}
+	return rateLimit.Limit.Sub(windowUsage)
+}
+
+// GetCctxRateLimit returns the rate limit applying to the current outbound of a cctx
+// the rate limit is identified by the receiver chain and the ZRC20 of the asset, the ZRC20 address is empty for ZETA
+func (k Keeper) GetCctxRateLimit(ctx sdk.Context, cctx types.CrossChainTx) (types.RateLimit, bool) {
+	outTxParams := cctx.GetCurrentOutTxParam()
+	chainID := outTxParams.ReceiverChainId
+
+	zrc20Address := ""
+	switch outTxParams.CoinType {
+	case common.CoinType_Zeta:
+	case common.CoinType_Gas:
+		fc, found := k.fungibleKeeper.GetGasCoinForForeignCoin(ctx, chainID)
+		if !found {
+			return types.RateLimit{}, false
+		}
+		zrc20Address = fc.Zrc20ContractAddress
+	case common.CoinType_ERC20:
+		fc, found := k.fungibleKeeper.GetForeignCoinFromAsset(ctx, cctx.InboundTxParams.Asset, chainID)
+		if !found {
+			return types.RateLimit{}, false
+		}
+		zrc20Address = fc.Zrc20ContractAddress
+	default:
+		return types.RateLimit{}, false
+	}
+
+	return k.GetRateLimit(ctx, chainID, zrc20Address)
+}
+
+// ApplyOutboundRateLimit checks the outbound of a new cctx against the rate limit of its receiver chain and asset
+// if the window has enough capacity, the outbound value is recorded in the window
+// otherwise, depending on the rate limit action, the cctx is moved to PendingRateLimited or an error is returned to reject the withdrawal
+// an outbound above the limit never fits in the window and is always rejected
+// The function returns true if the cctx has been queued
+func (k Keeper) ApplyOutboundRateLimit(ctx sdk.Context, cctx *types.CrossChainTx) (bool, error) {
+	rateLimit, found := k.GetCctxRateLimit(ctx, *cctx)
+	if !found {
+		return false, nil
+	}
+
+	amount := cctx.GetCurrentOutTxParam().Amount
+	if amount.GT(rateLimit.Limit) {
+		return false, cosmoserrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"%s: amount %s above limit %s",
+			rateLimit.Target(),
+			amount,
+			rateLimit.Limit,
+		)
+	}
+
+	windowUsage := k.GetRateLimitWindowUsage(ctx, rateLimit)
+	if amount.LTE(RemainingRateLimitCapacity(rateLimit, windowUsage)) {
+		k.AddRateLimitUsage(ctx, rateLimit, amount)
+		return false, nil
+	}
+
+	if rateLimit.Action == types.RateLimitAction_Reject {
+		return false, cosmoserrors.Wrapf(
+			types.ErrRateLimitExceeded,
+			"%s: amount %s, window usage %s, limit %s",
+			rateLimit.Target(),
+			amount,
+			windowUsage,
+			rateLimit.Limit,
+		)
+	}
+
+	cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_PendingRateLimited, RateLimitedMessage)
+	EmitEventCCTXRateLimited(ctx, *cctx, rateLimit, windowUsage)
+	return true, nil
+}
+
+// ReleaseRateLimitedCctxs iterates through the rate limited cctxs by creation height and releases the ones fitting in the window of their rate limit
+// at most MaxRateLimitedCctxsReadPerBlock cctxs are read in a block, the scan starts from the cursor saved in the previous
+// block and starts again from the beginning once the end of the queue is reached
+// the cctxs of a rate limit are released in order: a cctx is not released while an older cctx of the same rate limit is still queued,
+// the rate limits blocked by a cctx not fitting in the window are saved until the scan starts again from the beginning
+// a cctx with an amount above the limit never fits in the window and is skipped, it can be aborted by the admin group or released by updating the limit
+// the cctxs are released without condition if their rate limit has been removed
+// The function returns the number of cctxs released
+func (k Keeper) ReleaseRateLimitedCctxs(ctx sdk.Context) int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CctxStatusIndexKeyPrefix))
+	rateLimitedStore := prefix.NewStore(store, types.CctxIndexFieldKey(types.CctxIndexStatus(types.CctxStatus_PendingRateLimited)))
+	var start []byte
+	if cursor, found := k.GetRateLimitReleaseCursor(ctx); found {
+		// start after the last read entry
+		start = append(cursor, 0x00)
+	}
+	iterator := rateLimitedStore.Iterator(start, nil)
+
+	type release struct {
+		cctx      types.CrossChainTx
+		rateLimit *types.RateLimit
+	}
+	var releases []release
+	var cursor []byte
+	blocked := k.GetRateLimitReleaseBlocked(ctx)
+	windowUsages := make(map[string]sdkmath.Uint)
+	for read := 0; iterator.Valid(); iterator.Next() {
+		if read == MaxRateLimitedCctxsReadPerBlock || len(releases) == MaxRateLimitedCctxsReleasedPerBlock {
+			break
+		}
+		read++
+		cursor = append([]byte{}, iterator.Key()...)
+
+		_, index, err := types.ParseCctxIndexKey(iterator.Key())
+		if err != nil {
+			ctx.Logger().Error("ReleaseRateLimitedCctxs: invalid cctx index key", "err", err.Error())
+			continue
+		}
+		cctx, found := k.GetCrossChainTx(ctx, index)
+		if !found {
+			continue
+		}
+		rateLimit, found := k.GetCctxRateLimit(ctx, cctx)
+		if !found {
+			releases = append(releases, release{cctx: cctx})
+			continue
+		}
+
+		key := string(types.RateLimitKey(rateLimit.ChainId, rateLimit.Zrc20Address))
+		amount := cctx.GetCurrentOutTxParam().Amount
+		if blocked[key] || amount.GT(rateLimit.Limit) {
+			continue
+		}
+		windowUsage, ok := windowUsages[key]
+		if !ok {
+			windowUsage = k.GetRateLimitWindowUsage(ctx, rateLimit)
+		}
+		if amount.GT(RemainingRateLimitCapacity(rateLimit, windowUsage)) {
+			blocked[key] = true
+			continue
+		}
+		windowUsages[key] = windowUsage.Add(amount)
+		releases = append(releases, release{cctx: cctx, rateLimit: &rateLimit})
+	}
+	if !iterator.Valid() {
+		cursor = nil
+	}
+	iterator.Close()
+
+	if cursor != nil {
+		k.SetRateLimitReleaseCursor(ctx, cursor, blocked)
+	} else {
+		k.RemoveRateLimitReleaseCursor(ctx)
+	}
+
+	for _, r := range releases {
+		cctx := r.cctx
+		if r.rateLimit != nil {
+			k.AddRateLimitUsage(ctx, *r.rateLimit, cctx.GetCurrentOutTxParam().Amount)
+		}
+		k.ReleaseRateLimitedCctx(ctx, &cctx)
+		k.SetCctxAndNonceToCctxAndInTxHashToCctx(ctx, cctx)
+	}
+
+	return len(releases)
+}
+
+// ReleaseRateLimitedCctx moves a rate limited cctx to pending outbound by assigning its nonce
+// the outbound deadline of the cctx is extended by the time spent in the queue
+// the cctx is aborted if the nonce can't be assigned
+func (k Keeper) ReleaseRateLimitedCctx(ctx sdk.Context, cctx *types.CrossChainTx) {
+	tmpCtx, commit := ctx.CacheContext()
+	if err := k.UpdateNonce(tmpCtx, cctx.GetCurrentOutTxParam().ReceiverChainId, cctx); err != nil {
+		cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_Aborted, fmt.Sprintf("can't release rate limited outbound: %s", err.Error()))
+		return
+	}
+	commit()
+
+	// #nosec G701 always positive
+	height := uint64(ctx.BlockHeight())
+	if creationHeight := cctx.InboundTxParams.InboundTxFinalizedZetaHeight; creationHeight != 0 && height > creationHeight {
+		cctx.CctxStatus.DeadlineExtensionBlocks += height - creationHeight
+	}
+	cctx.CctxStatus.ChangeStatus(ctx, types.CctxStatus_PendingOutbound, RateLimitReleasedMessage)
+}
+
+// SetRateLimitReleaseCursor sets the last entry of the rate limited status index scanned for release
+// and the rate limits blocked since the scan started from the beginning
+func (k Keeper) SetRateLimitReleaseCursor(ctx sdk.Context, cursor []byte, blocked map[string]bool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.RateLimitReleaseCursorKey), cursor)
+
+	blockedStore := prefix.NewStore(store, types.KeyPrefix(types.RateLimitReleaseBlockedKeyPrefix))
+	for key := range blocked {
+		blockedStore.Set([]byte(key), []byte{0x01})
+	}
+}
+
+// GetRateLimitReleaseCursor returns the last entry of the rate limited status index scanned for release
+func (k Keeper) GetRateLimitReleaseCursor(ctx sdk.Context) ([]byte, bool) {
+	store := ctx.KVStore(k.storeKey)
+	cursor := store.Get([]byte(types.RateLimitReleaseCursorKey))
+	return cursor, cursor != nil
+}
+
+// GetRateLimitReleaseBlocked returns the keys of the rate limits blocked since the release scan started from the beginning
+func (k Keeper) GetRateLimitReleaseBlocked(ctx sdk.Context) map[string]bool {
+	blockedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitReleaseBlockedKeyPrefix))
+	iterator := blockedStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	blocked := make(map[string]bool)
+	for ; iterator.Valid(); iterator.Next() {
+		blocked[string(iterator.Key())] = true
+	}
+	return blocked
+}
+
+// RemoveRateLimitReleaseCursor removes the cursor and the blocked rate limits so the next release scan starts from the beginning
+func (k Keeper) RemoveRateLimitReleaseCursor(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte(types.RateLimitReleaseCursorKey))
+
+	blockedStore := prefix.NewStore(store, types.KeyPrefix(types.RateLimitReleaseBlockedKeyPrefix))
+	iterator := blockedStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		blockedStore.Delete(key)
+	}
+}
@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	zetacommon "github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/keeper"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	fungibletypes "github.com/zeta-chain/zetacore/x/fungible/types"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setRateLimitedCctx sets a ZETA cctx pending rate limited created at the provided height
+func setRateLimitedCctx(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	index string,
+	receiverChainID int64,
+	amount uint64,
+	height uint64,
+) types.CrossChainTx {
+	cctx := setPendingOutboundCctx(t, ctx, k, index, zetacommon.ZetaPrivnetChain().ChainId, receiverChainID, zetacommon.CoinType_Zeta, height)
+	cctx.CctxStatus.Status = types.CctxStatus_PendingRateLimited
+	cctx.GetCurrentOutTxParam().Amount = math.NewUint(amount)
+	k.SetCrossChainTx(ctx, cctx)
+	return cctx
+}
+
+// setNonces sets the nonces of the chain to allow assigning outbound nonces
+func setNonces(ctx sdk.Context, zk keepertest.ZetaKeepers, chainID int64, nonce uint64) observertypes.TSS {
+	tss := sample.Tss()
+	zk.ObserverKeeper.SetTSS(ctx, tss)
+	zk.ObserverKeeper.SetChainNonces(ctx, observertypes.ChainNonces{
+		Index:   zetacommon.GetChainFromChainID(chainID).ChainName.String(),
+		ChainId: chainID,
+		Nonce:   nonce,
+	})
+	zk.ObserverKeeper.SetPendingNonces(ctx, observertypes.PendingNonces{
+		ChainId: chainID,
+		// #nosec G701 test
+		NonceLow: int64(nonce),
+		// #nosec G701 test
+		NonceHigh: int64(nonce),
+		Tss:       tss.TssPubkey,
+	})
+	return tss
+}
+
+func TestKeeper_GetCctxRateLimit(t *testing.T) {
+	t.Run("should get the rate limit of ZETA", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		rateLimit := types.RateLimit{ChainId: chainID, Limit: math.NewUint(1000), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Zeta, 10)
+
+		got, found := k.GetCctxRateLimit(ctx, cctx)
+		require.True(t, found)
+		require.Equal(t, rateLimit.Limit, got.Limit)
+	})
+
+	t.Run("should get the rate limit of the gas ZRC20", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		zrc20 := sample.EthAddress().Hex()
+		zk.FungibleKeeper.SetForeignCoins(ctx, fungibletypes.ForeignCoins{
+			Zrc20ContractAddress: zrc20,
+			ForeignChainId:       chainID,
+			CoinType:             zetacommon.CoinType_Gas,
+		})
+		rateLimit := types.RateLimit{ChainId: chainID, Zrc20Address: zrc20, Limit: math.NewUint(1000), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Gas, 10)
+
+		got, found := k.GetCctxRateLimit(ctx, cctx)
+		require.True(t, found)
+		require.Equal(t, zrc20, got.Zrc20Address)
+
+		// the rate limit of ZETA doesn't apply
+		k.RemoveRateLimit(ctx, chainID, zrc20)
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: chainID, Limit: math.NewUint(1000), Window: 10})
+		_, found = k.GetCctxRateLimit(ctx, cctx)
+		require.False(t, found)
+	})
+
+	t.Run("should not find a rate limit for another chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		chainID := getValidEthChainID(t)
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: chainID + 1, Limit: math.NewUint(1000), Window: 10})
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Zeta, 10)
+
+		_, found := k.GetCctxRateLimit(ctx, cctx)
+		require.False(t, found)
+	})
+}
+
+func TestKeeper_ApplyOutboundRateLimit(t *testing.T) {
+	t.Run("should not limit the outbound if no rate limit", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, getValidEthChainID(t), zetacommon.CoinType_Zeta, 10)
+
+		rateLimited, err := k.ApplyOutboundRateLimit(ctx, &cctx)
+		require.NoError(t, err)
+		require.False(t, rateLimited)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+	})
+
+	t.Run("should record the outbound if the window has capacity", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		rateLimit := types.RateLimit{ChainId: chainID, Limit: math.NewUint(inputAmount * 2), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Zeta, 100)
+
+		rateLimited, err := k.ApplyOutboundRateLimit(ctx, &cctx)
+		require.NoError(t, err)
+		require.False(t, rateLimited)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.Equal(t, math.NewUint(inputAmount), k.GetRateLimitWindowUsage(ctx, rateLimit))
+	})
+
+	t.Run("should queue the outbound if the window has no capacity", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		rateLimit := types.RateLimit{ChainId: chainID, Limit: math.NewUint(inputAmount * 2), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, math.NewUint(inputAmount+1))
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Zeta, 100)
+
+		rateLimited, err := k.ApplyOutboundRateLimit(ctx, &cctx)
+		require.NoError(t, err)
+		require.True(t, rateLimited)
+		require.Equal(t, types.CctxStatus_PendingRateLimited, cctx.CctxStatus.Status)
+		require.Equal(t, keeper.RateLimitedMessage, cctx.CctxStatus.StatusMessage)
+		require.Equal(t, math.NewUint(inputAmount+1), k.GetRateLimitWindowUsage(ctx, rateLimit))
+	})
+
+	t.Run("should reject the outbound if the window has no capacity", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		rateLimit := types.RateLimit{
+			ChainId: chainID,
+			Limit:   math.NewUint(inputAmount - 1),
+			Window:  10,
+			Action:  types.RateLimitAction_Reject,
+		}
+		k.SetRateLimit(ctx, rateLimit)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Zeta, 100)
+
+		_, err := k.ApplyOutboundRateLimit(ctx, &cctx)
+		require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.True(t, k.GetRateLimitWindowUsage(ctx, rateLimit).IsZero())
+	})
+
+	t.Run("should reject the outbound above the limit even if the action is queue", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		rateLimit := types.RateLimit{
+			ChainId: chainID,
+			Limit:   math.NewUint(inputAmount - 1),
+			Window:  10,
+			Action:  types.RateLimitAction_Queue,
+		}
+		k.SetRateLimit(ctx, rateLimit)
+		cctx := setPendingOutboundCctx(t, ctx, k, "1", zetacommon.ZetaPrivnetChain().ChainId, chainID, zetacommon.CoinType_Zeta, 100)
+
+		_, err := k.ApplyOutboundRateLimit(ctx, &cctx)
+		require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		require.True(t, k.GetRateLimitWindowUsage(ctx, rateLimit).IsZero())
+	})
+}
+
+func TestKeeper_ReleaseRateLimitedCctxs(t *testing.T) {
+	t.Run("should release the cctxs fitting in the window in order", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		setChainsWithExpiry(ctx, zk, 0, chainID)
+		tss := setNonces(ctx, zk, chainID, 42)
+		rateLimit := types.RateLimit{ChainId: chainID, Limit: math.NewUint(100), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+
+		cctx1 := setRateLimitedCctx(t, ctx, k, "1", chainID, 60, 50)
+		cctx2 := setRateLimitedCctx(t, ctx, k, "2", chainID, 50, 60)
+		cctx3 := setRateLimitedCctx(t, ctx, k, "3", chainID, 10, 70)
+
+		// only the first cctx is released, the third one waits for the second one
+		require.Equal(t, 1, k.ReleaseRateLimitedCctxs(ctx))
+
+		cctx1, found := k.GetCrossChainTx(ctx, cctx1.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx1.CctxStatus.Status)
+		require.Equal(t, keeper.RateLimitReleasedMessage, cctx1.CctxStatus.StatusMessage)
+		require.EqualValues(t, 42, cctx1.GetCurrentOutTxParam().OutboundTxTssNonce)
+		require.EqualValues(t, 50, cctx1.CctxStatus.DeadlineExtensionBlocks)
+		nonceToCctx, found := zk.ObserverKeeper.GetNonceToCctx(ctx, tss.TssPubkey, chainID, 42)
+		require.True(t, found)
+		require.Equal(t, cctx1.Index, nonceToCctx.CctxIndex)
+		require.Equal(t, math.NewUint(60), k.GetRateLimitWindowUsage(ctx, rateLimit))
+
+		for _, index := range []string{cctx2.Index, cctx3.Index} {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_PendingRateLimited, cctx.CctxStatus.Status)
+		}
+
+		// the other cctxs are released once the window has moved
+		ctx = ctx.WithBlockHeight(110)
+		require.Equal(t, 2, k.ReleaseRateLimitedCctxs(ctx))
+		for i, index := range []string{cctx2.Index, cctx3.Index} {
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+			require.EqualValues(t, 43+i, cctx.GetCurrentOutTxParam().OutboundTxTssNonce)
+		}
+		require.Equal(t, math.NewUint(60), k.GetRateLimitWindowUsage(ctx, rateLimit))
+	})
+
+	t.Run("should skip the cctxs above the limit", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		setChainsWithExpiry(ctx, zk, 0, chainID)
+		setNonces(ctx, zk, chainID, 42)
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: chainID, Limit: math.NewUint(100), Window: 10})
+
+		cctx1 := setRateLimitedCctx(t, ctx, k, "1", chainID, 1000, 50)
+		cctx2 := setRateLimitedCctx(t, ctx, k, "2", chainID, 50, 60)
+
+		require.Equal(t, 1, k.ReleaseRateLimitedCctxs(ctx))
+
+		cctx1, found := k.GetCrossChainTx(ctx, cctx1.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingRateLimited, cctx1.CctxStatus.Status)
+		cctx2, found = k.GetCrossChainTx(ctx, cctx2.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx2.CctxStatus.Status)
+	})
+
+	t.Run("should resume the scan from the cursor after cctxs above the limit filling the scan", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		chainID := getValidEthChainID(t)
+		setChainsWithExpiry(ctx, zk, 0, chainID)
+		setNonces(ctx, zk, chainID, 42)
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: chainID, Limit: math.NewUint(100), Window: 10})
+
+		// queued before the limit has been lowered
+		for i := 0; i < keeper.MaxRateLimitedCctxsReadPerBlock; i++ {
+			// #nosec G701 test
+			setRateLimitedCctx(t, ctx, k, fmt.Sprintf("oversized-%d", i), chainID, 1000, uint64(i+1))
+		}
+		cctx := setRateLimitedCctx(t, ctx, k, "1", chainID, 50, 5000)
+
+		// the read cctxs are all above the limit
+		require.Equal(t, 0, k.ReleaseRateLimitedCctxs(ctx))
+		_, found := k.GetRateLimitReleaseCursor(ctx)
+		require.True(t, found)
+
+		// the scan resumes after the cctxs above the limit
+		require.Equal(t, 1, k.ReleaseRateLimitedCctxs(ctx))
+		cctx, found = k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+		_, found = k.GetRateLimitReleaseCursor(ctx)
+		require.False(t, found)
+	})
+
+	t.Run("should not block the release of another rate limit with a full window", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		chainID := getValidEthChainID(t)
+		otherChainID := getValidBtcChainID()
+		setChainsWithExpiry(ctx, zk, 0, chainID, otherChainID)
+		setNonces(ctx, zk, otherChainID, 42)
+		rateLimit := types.RateLimit{ChainId: chainID, Limit: math.NewUint(100), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, math.NewUint(100))
+		k.SetRateLimit(ctx, types.RateLimit{ChainId: otherChainID, Limit: math.NewUint(100), Window: 10})
+
+		for i := 0; i < keeper.MaxRateLimitedCctxsReleasedPerBlock+1; i++ {
+			// #nosec G701 test
+			setRateLimitedCctx(t, ctx, k, fmt.Sprintf("full-%d", i), chainID, 10, uint64(i+1))
+		}
+		cctx := setRateLimitedCctx(t, ctx, k, "1", otherChainID, 50, 500)
+
+		require.Equal(t, 1, k.ReleaseRateLimitedCctxs(ctx))
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+	})
+
+	t.Run("should release the cctxs of a rate limit in order across scans", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(1000)
+		chainID := getValidEthChainID(t)
+		setChainsWithExpiry(ctx, zk, 0, chainID)
+		setNonces(ctx, zk, chainID, 42)
+		rateLimit := types.RateLimit{ChainId: chainID, Limit: math.NewUint(100), Window: 10}
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, math.NewUint(100))
+
+		cctx1 := setRateLimitedCctx(t, ctx, k, "1", chainID, 50, 1)
+		for i := 0; i < keeper.MaxRateLimitedCctxsReadPerBlock-1; i++ {
+			// #nosec G701 test
+			setRateLimitedCctx(t, ctx, k, fmt.Sprintf("oversized-%d", i), chainID, 1000, uint64(i+2))
+		}
+		cctx2 := setRateLimitedCctx(t, ctx, k, "2", chainID, 10, 5000)
+
+		// the first cctx blocks the rate limit
+		require.Equal(t, 0, k.ReleaseRateLimitedCctxs(ctx))
+
+		// the window has moved but the rate limit stays blocked until the scan starts again from the beginning
+		ctx = ctx.WithBlockHeight(1011)
+		require.Equal(t, 0, k.ReleaseRateLimitedCctxs(ctx))
+
+		// the first cctx is released first
+		for i, index := range []string{cctx1.Index, cctx2.Index} {
+			require.Equal(t, 1, k.ReleaseRateLimitedCctxs(ctx))
+			cctx, found := k.GetCrossChainTx(ctx, index)
+			require.True(t, found)
+			require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+			require.EqualValues(t, 42+i, cctx.GetCurrentOutTxParam().OutboundTxTssNonce)
+		}
+	})
+
+	t.Run("should release the cctxs if the rate limit has been removed", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		setChainsWithExpiry(ctx, zk, 0, chainID)
+		setNonces(ctx, zk, chainID, 42)
+
+		cctx := setRateLimitedCctx(t, ctx, k, "1", chainID, 1000, 50)
+
+		require.Equal(t, 1, k.ReleaseRateLimitedCctxs(ctx))
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_PendingOutbound, cctx.CctxStatus.Status)
+	})
+
+	t.Run("should abort the cctx if the nonce can't be assigned", func(t *testing.T) {
+		k, ctx, _, zk := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		chainID := getValidEthChainID(t)
+		setChainsWithExpiry(ctx, zk, 0, chainID)
+
+		cctx := setRateLimitedCctx(t, ctx, k, "1", chainID, 1000, 50)
+
+		require.Equal(t, 1, k.ReleaseRateLimitedCctxs(ctx))
+
+		cctx, found := k.GetCrossChainTx(ctx, cctx.Index)
+		require.True(t, found)
+		require.Equal(t, types.CctxStatus_Aborted, cctx.CctxStatus.Status)
+	})
+}
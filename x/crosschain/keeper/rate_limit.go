@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// SetRateLimit set a rate limit in the store from its receiver chain and ZRC20 address
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	b := k.cdc.MustMarshal(&rateLimit)
+	store.Set(types.RateLimitKey(rateLimit.ChainId, rateLimit.Zrc20Address), b)
+}
+
+// GetRateLimit returns the rate limit of a receiver chain and ZRC20 address
+// the ZRC20 address is empty for ZETA
+func (k Keeper) GetRateLimit(ctx sdk.Context, chainID int64, zrc20Address string) (val types.RateLimit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	b := store.Get(types.RateLimitKey(chainID, zrc20Address))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveRateLimit removes the rate limit of a receiver chain and ZRC20 address and its recorded usage
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, chainID int64, zrc20Address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	store.Delete(types.RateLimitKey(chainID, zrc20Address))
+
+	usageStore := k.rateLimitUsageStore(ctx, chainID, zrc20Address)
+	iterator := usageStore.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		usageStore.Delete(key)
+	}
+}
+
+// GetAllRateLimits returns all the rate limits
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) (list []types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRateLimitWindowUsage returns the outbound value recorded for a rate limit in the current window
+// the window contains the last rateLimit.Window zeta blocks including the current one
+func (k Keeper) GetRateLimitWindowUsage(ctx sdk.Context, rateLimit types.RateLimit) sdkmath.Uint {
+	usageStore := k.rateLimitUsageStore(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+	iterator := usageStore.Iterator(types.RateLimitUsageHeightKey(rateLimitWindowStart(ctx, rateLimit)), nil)
+	defer iterator.Close()
+
+	usage := sdkmath.ZeroUint()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Uint
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			ctx.Logger().Error("GetRateLimitWindowUsage: invalid usage amount", "err", err.Error())
+			continue
+		}
+		usage = usage.Add(amount)
+	}
+	return usage
+}
+
+// AddRateLimitUsage records an outbound value at the current zeta height for a rate limit
+// the usage recorded before the current window is pruned
+func (k Keeper) AddRateLimitUsage(ctx sdk.Context, rateLimit types.RateLimit, amount sdkmath.Uint) {
+	usageStore := k.rateLimitUsageStore(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+
+	// prune the usage outside the window
+	iterator := usageStore.Iterator(nil, types.RateLimitUsageHeightKey(rateLimitWindowStart(ctx, rateLimit)))
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range expiredKeys {
+		usageStore.Delete(key)
+	}
+
+	// #nosec G701 always positive
+	heightKey := types.RateLimitUsageHeightKey(uint64(ctx.BlockHeight()))
+	usage := sdkmath.ZeroUint()
+	if b := usageStore.Get(heightKey); b != nil {
+		if err := usage.Unmarshal(b); err != nil {
+			ctx.Logger().Error("AddRateLimitUsage: invalid usage amount", "err", err.Error())
+			usage = sdkmath.ZeroUint()
+		}
+	}
+	usage = usage.Add(amount)
+	b, err := usage.Marshal()
+	if err != nil {
+		ctx.Logger().Error("AddRateLimitUsage: can't marshal usage amount", "err", err.Error())
+		return
+	}
+	usageStore.Set(heightKey, b)
+}
+
+// rateLimitUsageStore returns the store of the usage recorded per zeta height for a rate limit
+func (k Keeper) rateLimitUsageStore(ctx sdk.Context, chainID int64, zrc20Address string) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RateLimitUsageKeyPrefix))
+	return prefix.NewStore(store, types.RateLimitKey(chainID, zrc20Address))
+}
+
+// rateLimitWindowStart returns the first zeta height of the current window of a rate limit
+func rateLimitWindowStart(ctx sdk.Context, rateLimit types.RateLimit) uint64 {
+	start := ctx.BlockHeight() - rateLimit.Window + 1
+	if start < 0 {
+		return 0
+	}
+	// #nosec G701 always positive
+	return uint64(start)
+}
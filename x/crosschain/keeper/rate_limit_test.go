@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/nullify"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestKeeper_RateLimit(t *testing.T) {
+	t.Run("can set, get and remove a rate limit", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		rateLimit := sample.RateLimit(t, "foo")
+
+		_, found := k.GetRateLimit(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+		require.False(t, found)
+
+		k.SetRateLimit(ctx, rateLimit)
+		got, found := k.GetRateLimit(ctx, rateLimit.ChainId, strings.ToLower(rateLimit.Zrc20Address))
+		require.True(t, found)
+		require.Equal(t, nullify.Fill(&rateLimit), nullify.Fill(&got))
+
+		k.RemoveRateLimit(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+		_, found = k.GetRateLimit(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+		require.False(t, found)
+	})
+
+	t.Run("can get all rate limits", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		rateLimits := []types.RateLimit{
+			sample.RateLimit(t, "foo"),
+			sample.RateLimit(t, "bar"),
+			sample.RateLimit(t, "baz"),
+		}
+		for _, rateLimit := range rateLimits {
+			k.SetRateLimit(ctx, rateLimit)
+		}
+
+		require.ElementsMatch(t, nullify.Fill(rateLimits), nullify.Fill(k.GetAllRateLimits(ctx)))
+	})
+}
+
+func TestKeeper_RateLimitUsage(t *testing.T) {
+	t.Run("should return the usage recorded in the window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		rateLimit := types.RateLimit{
+			ChainId: 1,
+			Limit:   math.NewUint(1000),
+			Window:  10,
+		}
+		require.True(t, k.GetRateLimitWindowUsage(ctx, rateLimit).IsZero())
+
+		k.AddRateLimitUsage(ctx.WithBlockHeight(100), rateLimit, math.NewUint(10))
+		k.AddRateLimitUsage(ctx.WithBlockHeight(100), rateLimit, math.NewUint(20))
+		k.AddRateLimitUsage(ctx.WithBlockHeight(105), rateLimit, math.NewUint(40))
+
+		require.Equal(t, math.NewUint(70), k.GetRateLimitWindowUsage(ctx.WithBlockHeight(105), rateLimit))
+		require.Equal(t, math.NewUint(70), k.GetRateLimitWindowUsage(ctx.WithBlockHeight(109), rateLimit))
+		require.Equal(t, math.NewUint(40), k.GetRateLimitWindowUsage(ctx.WithBlockHeight(110), rateLimit))
+		require.True(t, k.GetRateLimitWindowUsage(ctx.WithBlockHeight(115), rateLimit).IsZero())
+	})
+
+	t.Run("should prune the usage outside the window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		rateLimit := types.RateLimit{
+			ChainId: 1,
+			Limit:   math.NewUint(1000),
+			Window:  10,
+		}
+
+		k.AddRateLimitUsage(ctx.WithBlockHeight(100), rateLimit, math.NewUint(10))
+		k.AddRateLimitUsage(ctx.WithBlockHeight(120), rateLimit, math.NewUint(20))
+
+		// increasing the window does not bring back the pruned usage
+		rateLimit.Window = 100
+		require.Equal(t, math.NewUint(20), k.GetRateLimitWindowUsage(ctx.WithBlockHeight(120), rateLimit))
+	})
+
+	t.Run("should track the usage of each rate limit separately", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		zetaRateLimit := types.RateLimit{
+			ChainId: 1,
+			Limit:   math.NewUint(1000),
+			Window:  10,
+		}
+		zrc20RateLimit := types.RateLimit{
+			ChainId:      1,
+			Zrc20Address: sample.EthAddress().Hex(),
+			Limit:        math.NewUint(1000),
+			Window:       10,
+		}
+
+		k.AddRateLimitUsage(ctx, zetaRateLimit, math.NewUint(10))
+		k.AddRateLimitUsage(ctx, zrc20RateLimit, math.NewUint(20))
+
+		require.Equal(t, math.NewUint(10), k.GetRateLimitWindowUsage(ctx, zetaRateLimit))
+		require.Equal(t, math.NewUint(20), k.GetRateLimitWindowUsage(ctx, zrc20RateLimit))
+	})
+
+	t.Run("should remove the usage with the rate limit", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+		ctx = ctx.WithBlockHeight(100)
+		rateLimit := sample.RateLimit(t, "foo")
+		k.SetRateLimit(ctx, rateLimit)
+		k.AddRateLimitUsage(ctx, rateLimit, math.NewUint(10))
+
+		k.RemoveRateLimit(ctx, rateLimit.ChainId, rateLimit.Zrc20Address)
+		require.True(t, k.GetRateLimitWindowUsage(ctx, rateLimit).IsZero())
+	})
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// boundedStore is a store whose iterators are restricted to the keys in [start, end)
+// it allows to paginate over a key range of a store, a nil start or end key means no bound
+type boundedStore struct {
+	sdk.KVStore
+	start []byte
+	end   []byte
+}
+
+func newBoundedStore(store sdk.KVStore, start, end []byte) boundedStore {
+	return boundedStore{
+		KVStore: store,
+		start:   start,
+		end:     end,
+	}
+}
+
+// Iterator returns an iterator over the keys in [start, end) restricted to the bounds of the store
+func (s boundedStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.Iterator(s.bound(start, end))
+}
+
+// ReverseIterator returns a reverse iterator over the keys in [start, end) restricted to the bounds of the store
+func (s boundedStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.KVStore.ReverseIterator(s.bound(start, end))
+}
+
+// bound returns the intersection of [start, end) with the bounds of the store
+// an empty range is returned as a start key equal to the end key
+func (s boundedStore) bound(start, end []byte) ([]byte, []byte) {
+	if start == nil || (s.start != nil && bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if end == nil || (s.end != nil && bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
@@ -21,11 +21,9 @@ func (k Keeper) VerifyProof(ctx sdk.Context, proof *common.Proof, chainID int64,
 	if crosschainFlags.BlockHeaderVerificationFlags == nil {
 		return nil, fmt.Errorf("block header verification flags not found")
 	}
-	if common.IsBitcoinChain(chainID) && !crosschainFlags.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled {
-		return nil, fmt.Errorf("proof verification not enabled for bitcoin chain")
-	}
-	if common.IsEVMChain(chainID) && !crosschainFlags.BlockHeaderVerificationFlags.IsEthTypeChainEnabled {
-		return nil, fmt.Errorf("proof verification not enabled for evm chain")
+	if (common.IsBitcoinChain(chainID) || common.IsEVMChain(chainID)) &&
+		!crosschainFlags.BlockHeaderVerificationFlags.IsChainEnabled(chainID) {
+		return nil, fmt.Errorf("proof verification not enabled for chain %d", chainID)
 	}
 
 	// chain must support header-based merkle proof verification
@@ -47,6 +45,26 @@ func (k Keeper) VerifyProof(ctx sdk.Context, proof *common.Proof, chainID int64,
 		return nil, fmt.Errorf("block header not found %s", blockHash)
 	}
 
+	// bitcoin block header must be confirmed on the best header chain
+	if common.IsBitcoinChain(chainID) {
+		confirmations, onBestChain := k.zetaObserverKeeper.GetBlockHeaderConfirmations(ctx, chainID, hashBytes)
+		if !onBestChain {
+			return nil, fmt.Errorf("block header %s is not on the best chain", blockHash)
+		}
+		chainParams, found := k.zetaObserverKeeper.GetChainParamsByChainID(ctx, chainID)
+		if !found {
+			return nil, types.ErrUnsupportedChain.Wrapf("chain params not found for chain %d", chainID)
+		}
+		if uint64(confirmations) < chainParams.ConfirmationCount {
+			return nil, fmt.Errorf(
+				"block header %s has %d confirmations, %d required",
+				blockHash,
+				confirmations,
+				chainParams.ConfirmationCount,
+			)
+		}
+	}
+
 	// verify merkle proof
 	txBytes, err := proof.Verify(res.Header, int(txIndex))
 	if err != nil {
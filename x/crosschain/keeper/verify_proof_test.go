@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/bitcoin"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// bitcoinBlockWithProof returns a bitcoin block header containing a single transaction and the proof of the transaction
+func bitcoinBlockWithProof(t *testing.T, chainID int64) (common.BlockHeader, *common.Proof, []byte) {
+	msgTx := wire.NewMsgTx(wire.TxVersion)
+	msgTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	msgTx.AddTxOut(wire.NewTxOut(1000, sample.Bytes()))
+	var txBuf bytes.Buffer
+	require.NoError(t, msgTx.Serialize(&txBuf))
+
+	tx := btcutil.NewTx(msgTx)
+	path, index, err := bitcoin.NewMerkle([]*btcutil.Tx{tx}).BuildMerkleProof(0)
+	require.NoError(t, err)
+
+	header := wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  chainhash.Hash(sample.Hash()),
+		MerkleRoot: *tx.Hash(),
+		Timestamp:  time.Unix(1700000000, 0),
+		Bits:       0x207fffff,
+	}
+	var headerBuf bytes.Buffer
+	require.NoError(t, header.Serialize(&headerBuf))
+	blockHash := header.BlockHash()
+
+	blockHeader := common.BlockHeader{
+		Header:     common.NewBitcoinHeader(headerBuf.Bytes()),
+		Height:     100,
+		Hash:       blockHash[:],
+		ParentHash: header.PrevBlock[:],
+		ChainId:    chainID,
+	}
+	return blockHeader, common.NewBitcoinProof(txBuf.Bytes(), path, index), txBuf.Bytes()
+}
+
+func TestKeeper_VerifyProof(t *testing.T) {
+	chain := common.BtcRegtestChain()
+	flags := observertypes.CrosschainFlags{
+		BlockHeaderVerificationFlags: &observertypes.BlockHeaderVerificationFlags{
+			IsBtcTypeChainEnabled: true,
+		},
+	}
+	chainParams := sample.ChainParams(chain.ChainId)
+	chainParams.ConfirmationCount = 2
+
+	t.Run("should verify a bitcoin proof with enough confirmations on the best chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		header, proof, txBytes := bitcoinBlockWithProof(t, chain.ChainId)
+		observerMock.On("GetCrosschainFlags", mock.Anything).Return(flags, true)
+		observerMock.On("GetBlockHeader", mock.Anything, header.Hash).Return(header, true)
+		observerMock.On("GetBlockHeaderConfirmations", mock.Anything, chain.ChainId, header.Hash).Return(int64(2), true)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, chain.ChainId).Return(chainParams, true)
+
+		blockHash, err := chainhash.NewHash(header.Hash)
+		require.NoError(t, err)
+		res, err := k.VerifyProof(ctx, proof, chain.ChainId, blockHash.String(), 0)
+		require.NoError(t, err)
+		require.Equal(t, txBytes, res)
+	})
+
+	t.Run("should fail if the bitcoin block header is not on the best chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		header, proof, _ := bitcoinBlockWithProof(t, chain.ChainId)
+		observerMock.On("GetCrosschainFlags", mock.Anything).Return(flags, true)
+		observerMock.On("GetBlockHeader", mock.Anything, header.Hash).Return(header, true)
+		observerMock.On("GetBlockHeaderConfirmations", mock.Anything, chain.ChainId, header.Hash).Return(int64(0), false)
+
+		blockHash, err := chainhash.NewHash(header.Hash)
+		require.NoError(t, err)
+		_, err = k.VerifyProof(ctx, proof, chain.ChainId, blockHash.String(), 0)
+		require.ErrorContains(t, err, "not on the best chain")
+	})
+
+	t.Run("should fail if the bitcoin block header doesn't have enough confirmations", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		header, proof, _ := bitcoinBlockWithProof(t, chain.ChainId)
+		observerMock.On("GetCrosschainFlags", mock.Anything).Return(flags, true)
+		observerMock.On("GetBlockHeader", mock.Anything, header.Hash).Return(header, true)
+		observerMock.On("GetBlockHeaderConfirmations", mock.Anything, chain.ChainId, header.Hash).Return(int64(1), true)
+		observerMock.On("GetChainParamsByChainID", mock.Anything, chain.ChainId).Return(chainParams, true)
+
+		blockHash, err := chainhash.NewHash(header.Hash)
+		require.NoError(t, err)
+		_, err = k.VerifyProof(ctx, proof, chain.ChainId, blockHash.String(), 0)
+		require.ErrorContains(t, err, "1 confirmations, 2 required")
+	})
+
+	t.Run("should fail if the proof verification is disabled for the chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.CrosschainKeeperWithMocks(t, keepertest.CrosschainMockOptions{
+			UseObserverMock: true,
+		})
+		observerMock := keepertest.GetCrosschainObserverMock(t, k)
+		observerMock.On("GetCrosschainFlags", mock.Anything).Return(observertypes.CrosschainFlags{
+			BlockHeaderVerificationFlags: &observertypes.BlockHeaderVerificationFlags{
+				IsEthTypeChainEnabled: true,
+				IsBtcTypeChainEnabled: true,
+			},
+		}, true)
+
+		chainID := common.BscMainnetChain().ChainId
+		_, err := k.VerifyProof(ctx, &common.Proof{}, chainID, sample.Hash().Hex(), 0)
+		require.ErrorContains(t, err, "proof verification not enabled")
+	})
+}
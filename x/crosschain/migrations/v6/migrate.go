@@ -0,0 +1,61 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+// BatchSize is the number of cctxs read from the store at once during the migration
+const BatchSize = 500
+
+// crosschainKeeper is an interface to prevent cyclic dependency
+type crosschainKeeper interface {
+	GetStoreKey() storetypes.StoreKey
+	GetCodec() codec.Codec
+	SetCrossChainTx(ctx sdk.Context, cctx types.CrossChainTx)
+	AddCctxToPruneQueue(ctx sdk.Context, cctxIndex string)
+}
+
+// MigrateStore migrates the x/crosschain module state from the consensus version 5 to 6
+// It builds the secondary indexes of the existing cctxs by saving them again
+// and queues the cctxs in a terminal state for pruning
+// the cctxs are read by batches so they are not all loaded in memory, and saved once the iterator is closed
+func MigrateStore(ctx sdk.Context, crosschainKeeper crosschainKeeper) error {
+	store := prefix.NewStore(ctx.KVStore(crosschainKeeper.GetStoreKey()), types.KeyPrefix(types.SendKey))
+
+	var start []byte
+	for {
+		cctxs, next := readCctxBatch(store, crosschainKeeper.GetCodec(), start)
+		for _, cctx := range cctxs {
+			crosschainKeeper.SetCrossChainTx(ctx, cctx)
+			if cctx.IsPrunable() {
+				crosschainKeeper.AddCctxToPruneQueue(ctx, cctx.Index)
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+// readCctxBatch reads at most BatchSize cctxs from the store starting at the start key
+// it returns the key to start the next batch from, or nil if all the cctxs have been read
+func readCctxBatch(store prefix.Store, cdc codec.Codec, start []byte) ([]types.CrossChainTx, []byte) {
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	cctxs := make([]types.CrossChainTx, 0, BatchSize)
+	for ; iterator.Valid(); iterator.Next() {
+		if len(cctxs) == BatchSize {
+			return cctxs, append([]byte{}, iterator.Key()...)
+		}
+		var cctx types.CrossChainTx
+		cdc.MustUnmarshal(iterator.Value(), &cctx)
+		cctxs = append(cctxs, cctx)
+	}
+	return cctxs, nil
+}
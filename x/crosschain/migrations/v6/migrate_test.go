@@ -0,0 +1,51 @@
+package v6_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	v6 "github.com/zeta-chain/zetacore/x/crosschain/migrations/v6"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	k, ctx, _, _ := keepertest.CrosschainKeeper(t)
+
+	// set the cctxs without indexes as in the previous consensus version
+	// more cctxs than the batch size are set to migrate them in several batches
+	cctxList := make([]types.CrossChainTx, 2*v6.BatchSize+1)
+	store := prefix.NewStore(ctx.KVStore(k.GetStoreKey()), types.KeyPrefix(types.SendKey))
+	for i := range cctxList {
+		cctxList[i] = *sample.CrossChainTx(t, fmt.Sprintf("%d", i))
+		cctxList[i].InboundTxParams.SenderChainId = 1337
+		store.Set(types.KeyPrefix(cctxList[i].Index), k.GetCodec().MustMarshal(&cctxList[i]))
+	}
+
+	pagination := &query.PageRequest{Limit: uint64(len(cctxList))}
+	res, err := k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{SenderChainId: 1337, Pagination: pagination})
+	require.NoError(t, err)
+	require.Empty(t, res.CrossChainTx)
+
+	err = v6.MigrateStore(ctx, k)
+	require.NoError(t, err)
+
+	res, err = k.CctxListFiltered(ctx, &types.QueryListCctxFilteredRequest{SenderChainId: 1337, Pagination: pagination})
+	require.NoError(t, err)
+	require.Len(t, res.CrossChainTx, len(cctxList))
+
+	// the cctxs in a terminal state are queued for pruning
+	prunable := 0
+	for _, cctx := range cctxList {
+		if cctx.IsPrunable() {
+			prunable++
+		}
+	}
+	resPrunable, err := k.CctxListPrunable(ctx, &types.QueryListCctxPrunableRequest{Pagination: pagination})
+	require.NoError(t, err)
+	require.Len(t, resPrunable.CrossChainTx, prunable)
+}
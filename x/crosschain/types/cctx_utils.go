@@ -33,6 +33,22 @@ func (m CrossChainTx) OriginalDestinationChainID() int64 {
 	return m.OutboundTxParams[0].ReceiverChainId
 }
 
+// IsPrunable returns true if the cctx is in a terminal state and can be pruned from the state after the retention period
+// aborted cctxs are only prunable once refunded
+func (m CrossChainTx) IsPrunable() bool {
+	if m.CctxStatus == nil {
+		return false
+	}
+	switch m.CctxStatus.Status {
+	case CctxStatus_OutboundMined, CctxStatus_Reverted:
+		return true
+	case CctxStatus_Aborted:
+		return m.CctxStatus.IsAbortRefunded
+	default:
+		return false
+	}
+}
+
 // GetAllAuthzZetaclientTxTypes returns all the authz types for zetaclient
 func GetAllAuthzZetaclientTxTypes() []string {
 	return []string{
@@ -55,3 +71,17 @@ func (m OutboundTxParams) GetGasPrice() (uint64, error) {
 
 	return gasPrice, nil
 }
+
+// GetGasPriorityFee returns the priority fee of the outbound tx (EIP-1559)
+// zero is returned if the priority fee is not set
+func (m OutboundTxParams) GetGasPriorityFee() (uint64, error) {
+	if m.OutboundTxGasPriorityFee == "" {
+		return 0, nil
+	}
+	priorityFee, err := strconv.ParseUint(m.OutboundTxGasPriorityFee, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse cctx priority fee %s: %s", m.OutboundTxGasPriorityFee, err.Error())
+	}
+
+	return priorityFee, nil
+}
@@ -65,3 +65,28 @@ func TestOutboundTxParams_GetGasPrice(t *testing.T) {
 	_, err = outTxParams.GetGasPrice()
 	require.Error(t, err)
 }
+
+func TestCrossChainTx_IsPrunable(t *testing.T) {
+	cctx := sample.CrossChainTx(t, "foo")
+
+	cctx.CctxStatus = nil
+	require.False(t, cctx.IsPrunable())
+
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingOutbound}
+	require.False(t, cctx.IsPrunable())
+
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_PendingRevert}
+	require.False(t, cctx.IsPrunable())
+
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_OutboundMined}
+	require.True(t, cctx.IsPrunable())
+
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_Reverted}
+	require.True(t, cctx.IsPrunable())
+
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_Aborted, IsAbortRefunded: false}
+	require.False(t, cctx.IsPrunable())
+
+	cctx.CctxStatus = &types.Status{Status: types.CctxStatus_Aborted, IsAbortRefunded: true}
+	require.True(t, cctx.IsPrunable())
+}
@@ -19,6 +19,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgMigrateTssFunds{}, "crosschain/MigrateTssFunds", nil)
 	cdc.RegisterConcrete(&MsgUpdateTssAddress{}, "crosschain/UpdateTssAddress", nil)
 	cdc.RegisterConcrete(&MsgAbortStuckCCTX{}, "crosschain/AbortStuckCCTX", nil)
+	cdc.RegisterConcrete(&MsgExtendCctxDeadline{}, "crosschain/ExtendCctxDeadline", nil)
+	cdc.RegisterConcrete(&MsgClaimAbortedCCTXRefund{}, "crosschain/ClaimAbortedCCTXRefund", nil)
+	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, "crosschain/UpdateRateLimit", nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "crosschain/RemoveRateLimit", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -34,6 +38,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgMigrateTssFunds{},
 		&MsgUpdateTssAddress{},
 		&MsgAbortStuckCCTX{},
+		&MsgExtendCctxDeadline{},
+		&MsgClaimAbortedCCTXRefund{},
+		&MsgUpdateRateLimit{},
+		&MsgRemoveRateLimit{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -29,12 +29,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type CctxStatus int32
 
 const (
-	CctxStatus_PendingInbound  CctxStatus = 0
-	CctxStatus_PendingOutbound CctxStatus = 1
-	CctxStatus_OutboundMined   CctxStatus = 3
-	CctxStatus_PendingRevert   CctxStatus = 4
-	CctxStatus_Reverted        CctxStatus = 5
-	CctxStatus_Aborted         CctxStatus = 6
+	CctxStatus_PendingInbound     CctxStatus = 0
+	CctxStatus_PendingOutbound    CctxStatus = 1
+	CctxStatus_OutboundMined      CctxStatus = 3
+	CctxStatus_PendingRevert      CctxStatus = 4
+	CctxStatus_Reverted           CctxStatus = 5
+	CctxStatus_Aborted            CctxStatus = 6
+	CctxStatus_PendingRateLimited CctxStatus = 7
 )
 
 var CctxStatus_name = map[int32]string{
@@ -44,15 +45,17 @@ var CctxStatus_name = map[int32]string{
 	4: "PendingRevert",
 	5: "Reverted",
 	6: "Aborted",
+	7: "PendingRateLimited",
 }
 
 var CctxStatus_value = map[string]int32{
-	"PendingInbound":  0,
-	"PendingOutbound": 1,
-	"OutboundMined":   3,
-	"PendingRevert":   4,
-	"Reverted":        5,
-	"Aborted":         6,
+	"PendingInbound":     0,
+	"PendingOutbound":    1,
+	"OutboundMined":      3,
+	"PendingRevert":      4,
+	"Reverted":           5,
+	"Aborted":            6,
+	"PendingRateLimited": 7,
 }
 
 func (x CctxStatus) String() string {
@@ -254,6 +257,9 @@ type OutboundTxParams struct {
 	OutboundTxTssNonce uint64                                  `protobuf:"varint,5,opt,name=outbound_tx_tss_nonce,json=outboundTxTssNonce,proto3" json:"outbound_tx_tss_nonce,omitempty"`
 	OutboundTxGasLimit uint64                                  `protobuf:"varint,6,opt,name=outbound_tx_gas_limit,json=outboundTxGasLimit,proto3" json:"outbound_tx_gas_limit,omitempty"`
 	OutboundTxGasPrice string                                  `protobuf:"bytes,7,opt,name=outbound_tx_gas_price,json=outboundTxGasPrice,proto3" json:"outbound_tx_gas_price,omitempty"`
+	// priority fee (tip) per gas of the outbound tx on chains supporting EIP-1559
+	// when set, outbound_tx_gas_price is used as the max fee per gas
+	OutboundTxGasPriorityFee string `protobuf:"bytes,23,opt,name=outbound_tx_gas_priority_fee,json=outboundTxGasPriorityFee,proto3" json:"outbound_tx_gas_priority_fee,omitempty"`
 	// the above are commands for zetaclients
 	// the following fields are used when the outbound tx is mined
 	OutboundTxHash                   string                                 `protobuf:"bytes,8,opt,name=outbound_tx_hash,json=outboundTxHash,proto3" json:"outbound_tx_hash,omitempty"`
@@ -264,6 +270,9 @@ type OutboundTxParams struct {
 	OutboundTxEffectiveGasLimit      uint64                                 `protobuf:"varint,22,opt,name=outbound_tx_effective_gas_limit,json=outboundTxEffectiveGasLimit,proto3" json:"outbound_tx_effective_gas_limit,omitempty"`
 	TssPubkey                        string                                 `protobuf:"bytes,11,opt,name=tss_pubkey,json=tssPubkey,proto3" json:"tss_pubkey,omitempty"`
 	TxFinalizationStatus             TxFinalizationStatus                   `protobuf:"varint,12,opt,name=tx_finalization_status,json=txFinalizationStatus,proto3,enum=zetachain.zetacore.crosschain.TxFinalizationStatus" json:"tx_finalization_status,omitempty"`
+	// the outbound has expired and is cancelled, the observers sign a cancel tx with its nonce instead of the outbound
+	// the cctx is reverted once the cancel tx is observed, or completed if the outbound is observed instead
+	Cancelled bool `protobuf:"varint,24,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 }
 
 func (m *OutboundTxParams) Reset()         { *m = OutboundTxParams{} }
@@ -341,6 +350,13 @@ func (m *OutboundTxParams) GetOutboundTxGasPrice() string {
 	return ""
 }
 
+func (m *OutboundTxParams) GetOutboundTxGasPriorityFee() string {
+	if m != nil {
+		return m.OutboundTxGasPriorityFee
+	}
+	return ""
+}
+
 func (m *OutboundTxParams) GetOutboundTxHash() string {
 	if m != nil {
 		return m.OutboundTxHash
@@ -390,18 +406,107 @@ func (m *OutboundTxParams) GetTxFinalizationStatus() TxFinalizationStatus {
 	return TxFinalizationStatus_NotFinalized
 }
 
+func (m *OutboundTxParams) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// StatusTransition records a single change of status of a CCTX
+type StatusTransition struct {
+	OldStatus  CctxStatus `protobuf:"varint,1,opt,name=old_status,json=oldStatus,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"old_status,omitempty"`
+	NewStatus  CctxStatus `protobuf:"varint,2,opt,name=new_status,json=newStatus,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"new_status,omitempty"`
+	Message    string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ZetaHeight int64      `protobuf:"varint,4,opt,name=zeta_height,json=zetaHeight,proto3" json:"zeta_height,omitempty"`
+	Timestamp  int64      `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *StatusTransition) Reset()         { *m = StatusTransition{} }
+func (m *StatusTransition) String() string { return proto.CompactTextString(m) }
+func (*StatusTransition) ProtoMessage()    {}
+func (*StatusTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af3a0ad055343c21, []int{3}
+}
+func (m *StatusTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StatusTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusTransition.Merge(m, src)
+}
+func (m *StatusTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatusTransition proto.InternalMessageInfo
+
+func (m *StatusTransition) GetOldStatus() CctxStatus {
+	if m != nil {
+		return m.OldStatus
+	}
+	return CctxStatus_PendingInbound
+}
+
+func (m *StatusTransition) GetNewStatus() CctxStatus {
+	if m != nil {
+		return m.NewStatus
+	}
+	return CctxStatus_PendingInbound
+}
+
+func (m *StatusTransition) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *StatusTransition) GetZetaHeight() int64 {
+	if m != nil {
+		return m.ZetaHeight
+	}
+	return 0
+}
+
+func (m *StatusTransition) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
 type Status struct {
 	Status              CctxStatus `protobuf:"varint,1,opt,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
 	StatusMessage       string     `protobuf:"bytes,2,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
 	LastUpdateTimestamp int64      `protobuf:"varint,3,opt,name=lastUpdate_timestamp,json=lastUpdateTimestamp,proto3" json:"lastUpdate_timestamp,omitempty"`
 	IsAbortRefunded     bool       `protobuf:"varint,4,opt,name=isAbortRefunded,proto3" json:"isAbortRefunded,omitempty"`
+	// history contains the latest status transitions of the CCTX, oldest first
+	// the number of entries is bounded by MaxStatusHistoryLength
+	History []*StatusTransition `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	// number of zeta blocks added by the emergency group to the outbound expiry deadline of the CCTX
+	DeadlineExtensionBlocks uint64 `protobuf:"varint,6,opt,name=deadline_extension_blocks,json=deadlineExtensionBlocks,proto3" json:"deadline_extension_blocks,omitempty"`
 }
 
 func (m *Status) Reset()         { *m = Status{} }
 func (m *Status) String() string { return proto.CompactTextString(m) }
 func (*Status) ProtoMessage()    {}
 func (*Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3a0ad055343c21, []int{3}
+	return fileDescriptor_af3a0ad055343c21, []int{4}
 }
 func (m *Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,6 +563,20 @@ func (m *Status) GetIsAbortRefunded() bool {
 	return false
 }
 
+func (m *Status) GetHistory() []*StatusTransition {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *Status) GetDeadlineExtensionBlocks() uint64 {
+	if m != nil {
+		return m.DeadlineExtensionBlocks
+	}
+	return 0
+}
+
 type CrossChainTx struct {
 	Creator          string                                  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Index            string                                  `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
@@ -472,7 +591,7 @@ func (m *CrossChainTx) Reset()         { *m = CrossChainTx{} }
 func (m *CrossChainTx) String() string { return proto.CompactTextString(m) }
 func (*CrossChainTx) ProtoMessage()    {}
 func (*CrossChainTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af3a0ad055343c21, []int{4}
+	return fileDescriptor_af3a0ad055343c21, []int{5}
 }
 func (m *CrossChainTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -549,6 +668,7 @@ func init() {
 	proto.RegisterType((*InboundTxParams)(nil), "zetachain.zetacore.crosschain.InboundTxParams")
 	proto.RegisterType((*ZetaAccounting)(nil), "zetachain.zetacore.crosschain.ZetaAccounting")
 	proto.RegisterType((*OutboundTxParams)(nil), "zetachain.zetacore.crosschain.OutboundTxParams")
+	proto.RegisterType((*StatusTransition)(nil), "zetachain.zetacore.crosschain.StatusTransition")
 	proto.RegisterType((*Status)(nil), "zetachain.zetacore.crosschain.Status")
 	proto.RegisterType((*CrossChainTx)(nil), "zetachain.zetacore.crosschain.CrossChainTx")
 }
@@ -556,77 +676,88 @@ func init() {
 func init() { proto.RegisterFile("crosschain/cross_chain_tx.proto", fileDescriptor_af3a0ad055343c21) }
 
 var fileDescriptor_af3a0ad055343c21 = []byte{
-	// 1120 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
-	0x10, 0xd6, 0x46, 0x8a, 0x2c, 0x8d, 0x6c, 0x6b, 0x4d, 0xcb, 0xe9, 0xc2, 0x69, 0x24, 0x41, 0x6d,
-	0x12, 0x25, 0x80, 0x25, 0xd8, 0x41, 0x11, 0xa0, 0x37, 0xdb, 0xb5, 0x13, 0x23, 0x89, 0x6d, 0x6c,
-	0xed, 0x8b, 0x81, 0x62, 0x4b, 0xed, 0xd2, 0x12, 0x11, 0x69, 0xa9, 0x2e, 0x29, 0x43, 0x0e, 0xfa,
-	0x10, 0x3d, 0xf4, 0x11, 0x7a, 0xe8, 0xa3, 0xe4, 0x50, 0xa0, 0x39, 0x16, 0x3d, 0x18, 0x85, 0x7d,
-	0xee, 0xa5, 0x4f, 0x50, 0xf0, 0x67, 0x57, 0x6b, 0xd5, 0x3f, 0xfd, 0x3b, 0xed, 0x70, 0xc8, 0xef,
-	0x9b, 0x21, 0xe7, 0x1b, 0x72, 0xa1, 0xe6, 0x47, 0x8c, 0x73, 0xbf, 0x87, 0x69, 0xd8, 0x56, 0xa6,
-	0xa7, 0x6c, 0x4f, 0x8c, 0x5b, 0xc3, 0x88, 0x09, 0x86, 0x1e, 0xbc, 0x23, 0x02, 0x2b, 0x5f, 0x4b,
-	0x59, 0x2c, 0x22, 0xad, 0x09, 0x66, 0x79, 0xd1, 0x67, 0x83, 0x01, 0x0b, 0xdb, 0xfa, 0xa3, 0x31,
-	0xcb, 0x95, 0x2e, 0xeb, 0x32, 0x65, 0xb6, 0xa5, 0xa5, 0xbd, 0x8d, 0xdf, 0x73, 0x50, 0xde, 0x09,
-	0x3b, 0x6c, 0x14, 0x06, 0x07, 0xe3, 0x7d, 0x1c, 0xe1, 0x01, 0x47, 0xf7, 0x20, 0xcf, 0x49, 0x18,
-	0x90, 0xc8, 0xb1, 0xea, 0x56, 0xb3, 0xe8, 0x9a, 0x11, 0x7a, 0x04, 0x65, 0x6d, 0x99, 0x74, 0x68,
-	0xe0, 0xdc, 0xa9, 0x5b, 0xcd, 0xac, 0x3b, 0xa7, 0xdd, 0x9b, 0xd2, 0xbb, 0x13, 0xa0, 0xfb, 0x50,
-	0x14, 0x63, 0x8f, 0x45, 0xb4, 0x4b, 0x43, 0x27, 0xab, 0x28, 0x0a, 0x62, 0xbc, 0xa7, 0xc6, 0x68,
-	0x05, 0x8a, 0x3e, 0x93, 0x7b, 0x39, 0x1d, 0x12, 0x27, 0x57, 0xb7, 0x9a, 0xf3, 0x6b, 0x76, 0xcb,
-	0x24, 0xba, 0xc9, 0x68, 0x78, 0x70, 0x3a, 0x24, 0x6e, 0xc1, 0x37, 0x16, 0xaa, 0xc0, 0x5d, 0xcc,
-	0x39, 0x11, 0xce, 0x5d, 0xc5, 0xa3, 0x07, 0xe8, 0x05, 0xe4, 0xf1, 0x80, 0x8d, 0x42, 0xe1, 0xe4,
-	0xa5, 0x7b, 0xa3, 0xfd, 0xfe, 0xac, 0x96, 0xf9, 0xf5, 0xac, 0xf6, 0xb8, 0x4b, 0x45, 0x6f, 0xd4,
-	0x91, 0x7c, 0x6d, 0x9f, 0xf1, 0x01, 0xe3, 0xe6, 0xb3, 0xc2, 0x83, 0xb7, 0x6d, 0x19, 0x92, 0xb7,
-	0x0e, 0x69, 0x28, 0x5c, 0x03, 0x47, 0xcf, 0xc1, 0xa1, 0x7a, 0xf7, 0x9e, 0x4c, 0xb9, 0xc3, 0x49,
-	0x74, 0x42, 0x02, 0xaf, 0x87, 0x79, 0xcf, 0x99, 0x51, 0x11, 0x97, 0x68, 0x7c, 0x3a, 0x7b, 0x66,
-	0xf6, 0x25, 0xe6, 0x3d, 0xf4, 0x1a, 0x3e, 0xb9, 0x0a, 0x48, 0xc6, 0x82, 0x44, 0x21, 0xee, 0x7b,
-	0x3d, 0x42, 0xbb, 0x3d, 0xe1, 0x14, 0xea, 0x56, 0x33, 0xe7, 0xd6, 0xfe, 0xc2, 0xb1, 0x65, 0xd6,
-	0xbd, 0x54, 0xcb, 0xd0, 0x67, 0xf0, 0x51, 0x8a, 0xad, 0x83, 0xfb, 0x7d, 0x26, 0x3c, 0x1a, 0x06,
-	0x64, 0xec, 0x14, 0x55, 0x16, 0x95, 0x84, 0x61, 0x43, 0x4d, 0xee, 0xc8, 0x39, 0xb4, 0x0d, 0xf5,
-	0x14, 0xec, 0x98, 0x86, 0xb8, 0x4f, 0xdf, 0x91, 0xc0, 0x93, 0x9a, 0x88, 0x33, 0x00, 0x95, 0xc1,
-	0xc7, 0x09, 0x7e, 0x3b, 0x5e, 0x75, 0x44, 0x04, 0x36, 0xe1, 0x29, 0xdc, 0x9b, 0xe0, 0xb1, 0xa0,
-	0x2c, 0xf4, 0xb8, 0xc0, 0x62, 0xc4, 0x9d, 0x92, 0x2a, 0xd0, 0xb3, 0xd6, 0x8d, 0x7a, 0x6b, 0x25,
-	0xac, 0x0a, 0xfb, 0xa5, 0x82, 0xba, 0x15, 0x71, 0x85, 0xb7, 0xf1, 0x0d, 0xcc, 0xcb, 0xc0, 0xeb,
-	0xbe, 0x2f, 0xcf, 0x9f, 0x86, 0x5d, 0xe4, 0xc1, 0x22, 0xee, 0xb0, 0x48, 0xc4, 0x79, 0x9b, 0xc2,
-	0x5a, 0xff, 0xae, 0xb0, 0x0b, 0x86, 0x4b, 0x05, 0x51, 0x4c, 0x8d, 0xef, 0x67, 0xc0, 0xde, 0x1b,
-	0x89, 0xcb, 0x1a, 0x5f, 0x86, 0x42, 0x44, 0x7c, 0x42, 0x4f, 0x12, 0x95, 0x27, 0x63, 0xf4, 0x04,
-	0xec, 0xd8, 0xd6, 0x4a, 0xdf, 0x89, 0x85, 0x5e, 0x8e, 0xfd, 0xb1, 0xd4, 0x2f, 0xa9, 0x39, 0x7b,
-	0xab, 0x9a, 0x27, 0xba, 0xcd, 0xfd, 0x37, 0xdd, 0xae, 0xc2, 0x12, 0x33, 0x5b, 0x92, 0xa5, 0x17,
-	0x9c, 0x7b, 0x21, 0x0b, 0x7d, 0xa2, 0xda, 0x24, 0xe7, 0x22, 0x96, 0xec, 0xf7, 0x80, 0xf3, 0x5d,
-	0x39, 0x33, 0x0d, 0xe9, 0x62, 0xee, 0xf5, 0xe9, 0x80, 0xea, 0x16, 0xba, 0x04, 0x79, 0x81, 0xf9,
-	0x6b, 0x39, 0x73, 0x15, 0x64, 0x18, 0x51, 0x9f, 0x98, 0xd6, 0xb8, 0x0c, 0xd9, 0x97, 0x33, 0xa8,
-	0x09, 0x76, 0x1a, 0xa2, 0x1a, 0xa9, 0xa0, 0x56, 0xcf, 0x4f, 0x56, 0xab, 0x0e, 0x7a, 0x0e, 0x4e,
-	0x7a, 0xe5, 0x15, 0xa2, 0x5f, 0x9a, 0x20, 0xd2, 0xaa, 0xdf, 0x85, 0x4f, 0xd3, 0xc0, 0x6b, 0x7b,
-	0x4f, 0x2b, 0xbf, 0x3e, 0x21, 0xb9, 0xa6, 0xf9, 0xda, 0x50, 0x99, 0xde, 0xe5, 0x88, 0x93, 0xc0,
-	0xa9, 0x28, 0xfc, 0xc2, 0xa5, 0x4d, 0x1e, 0x72, 0x12, 0x20, 0x01, 0xb5, 0x34, 0x80, 0x1c, 0x1f,
-	0x13, 0x5f, 0xd0, 0x13, 0x92, 0x3a, 0xa0, 0x25, 0x55, 0xde, 0x96, 0x29, 0xef, 0xa3, 0xbf, 0x51,
-	0xde, 0x9d, 0x50, 0xb8, 0xf7, 0x27, 0xb1, 0xb6, 0x62, 0xd2, 0xe4, 0x64, 0xbf, 0xb8, 0x29, 0xaa,
-	0xae, 0xe4, 0x3d, 0x95, 0xf1, 0x35, 0x2c, 0xba, 0xa4, 0x0f, 0x00, 0xa4, 0x58, 0x86, 0xa3, 0xce,
-	0x5b, 0x72, 0xaa, 0xda, 0xbb, 0xe8, 0x16, 0x05, 0xe7, 0xfb, 0xca, 0x71, 0xc3, 0x4d, 0x30, 0xfb,
-	0x7f, 0xdf, 0x04, 0x3f, 0x5b, 0x90, 0xd7, 0x26, 0x5a, 0x87, 0xbc, 0x89, 0x62, 0xa9, 0x28, 0x4f,
-	0x6e, 0x89, 0xb2, 0xe9, 0x8b, 0xb1, 0xe1, 0x36, 0x40, 0xf4, 0x10, 0xe6, 0xb5, 0xe5, 0x0d, 0x08,
-	0xe7, 0xb8, 0x4b, 0x54, 0xc7, 0x16, 0xdd, 0x39, 0xed, 0x7d, 0xa3, 0x9d, 0x68, 0x15, 0x2a, 0x7d,
-	0xcc, 0xc5, 0xe1, 0x30, 0xc0, 0x82, 0x78, 0x82, 0x0e, 0x08, 0x17, 0x78, 0x30, 0x54, 0xad, 0x9b,
-	0x75, 0x17, 0x27, 0x73, 0x07, 0xf1, 0x14, 0x6a, 0x42, 0x99, 0xf2, 0x75, 0x79, 0xab, 0xb8, 0xe4,
-	0x78, 0x14, 0x06, 0x24, 0x50, 0xcd, 0x5b, 0x70, 0xa7, 0xdd, 0x8d, 0x9f, 0xb2, 0x30, 0xbb, 0x29,
-	0xb3, 0x54, 0xb7, 0xc3, 0xc1, 0x18, 0x39, 0x30, 0xe3, 0x47, 0x04, 0x0b, 0x16, 0xdf, 0x31, 0xf1,
-	0x50, 0x3e, 0x6b, 0x5a, 0xe9, 0x3a, 0x4b, 0x3d, 0x40, 0x5f, 0x43, 0x51, 0x5d, 0x81, 0xc7, 0x84,
-	0x70, 0xfd, 0xe0, 0x6d, 0x6c, 0xfe, 0xc3, 0x1b, 0xe2, 0x8f, 0xb3, 0x9a, 0x7d, 0x8a, 0x07, 0xfd,
-	0xcf, 0x1b, 0x09, 0x53, 0xc3, 0x2d, 0x48, 0x7b, 0x9b, 0x10, 0x8e, 0x1e, 0x43, 0x39, 0x22, 0x7d,
-	0x7c, 0x4a, 0x82, 0xe4, 0x9c, 0xf2, 0xba, 0x3b, 0x8d, 0x3b, 0x3e, 0xa8, 0x6d, 0x28, 0xf9, 0xbe,
-	0x18, 0xc7, 0xd5, 0x97, 0x2d, 0x5c, 0x5a, 0x7b, 0x78, 0x4b, 0x5d, 0x4c, 0x4d, 0xc0, 0x4f, 0xea,
-	0x83, 0x8e, 0x60, 0x21, 0xf5, 0x44, 0x0d, 0xd5, 0xe5, 0xab, 0xda, 0xbb, 0xb4, 0xd6, 0xba, 0x85,
-	0x6d, 0xea, 0xb7, 0xc4, 0x2d, 0xd3, 0xa9, 0xff, 0x94, 0xaf, 0x00, 0xa5, 0x3b, 0xc2, 0x90, 0x43,
-	0x3d, 0xdb, 0x2c, 0xad, 0xb5, 0x6f, 0x21, 0x9f, 0x7e, 0x10, 0x5c, 0x9b, 0x4d, 0x79, 0x9e, 0x7e,
-	0x0b, 0x30, 0x11, 0x1a, 0x42, 0x30, 0xbf, 0x4f, 0xc2, 0x80, 0x86, 0x5d, 0x93, 0x97, 0x9d, 0x41,
-	0x8b, 0x50, 0x36, 0xbe, 0x98, 0xce, 0xb6, 0xd0, 0x02, 0xcc, 0xc5, 0xa3, 0x37, 0x34, 0x24, 0x81,
-	0x9d, 0x95, 0x2e, 0xb3, 0xce, 0x25, 0x27, 0x24, 0x12, 0x76, 0x0e, 0xcd, 0x42, 0x41, 0xdb, 0x24,
-	0xb0, 0xef, 0xa2, 0x12, 0xcc, 0xac, 0xeb, 0x77, 0xcb, 0xce, 0x2f, 0xe7, 0x7e, 0xfc, 0xa1, 0x6a,
-	0x3d, 0x7d, 0x05, 0x95, 0xab, 0x9a, 0x09, 0xd9, 0x30, 0xbb, 0xcb, 0x44, 0xf2, 0x8a, 0xdb, 0x19,
-	0x34, 0x07, 0xc5, 0xc9, 0xd0, 0x92, 0xcc, 0x5b, 0x63, 0xe2, 0x8f, 0x24, 0xd9, 0x1d, 0x4d, 0xb6,
-	0xf1, 0xea, 0xfd, 0x79, 0xd5, 0xfa, 0x70, 0x5e, 0xb5, 0x7e, 0x3b, 0xaf, 0x5a, 0xdf, 0x5d, 0x54,
-	0x33, 0x1f, 0x2e, 0xaa, 0x99, 0x5f, 0x2e, 0xaa, 0x99, 0xa3, 0xd5, 0x94, 0xae, 0xe4, 0x39, 0xad,
-	0xe8, 0xbf, 0xce, 0xf8, 0xc8, 0xda, 0xe3, 0x76, 0xea, 0x5f, 0x54, 0xc9, 0xac, 0x93, 0x57, 0x7f,
-	0x8e, 0xcf, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x13, 0xc7, 0x24, 0x8d, 0xa6, 0x0a, 0x00, 0x00,
+	// 1290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0xc6,
+	0x13, 0x36, 0x2d, 0x59, 0x96, 0x46, 0xb6, 0x45, 0xaf, 0x65, 0x87, 0x3f, 0xc7, 0x91, 0x04, 0xfd,
+	0x9a, 0x44, 0x09, 0x10, 0x09, 0x71, 0x50, 0x04, 0xc8, 0xa1, 0x80, 0xed, 0xda, 0xb1, 0x91, 0x7f,
+	0x06, 0xeb, 0x5c, 0x02, 0x14, 0xec, 0x8a, 0x1c, 0x4b, 0x8b, 0x50, 0x5c, 0x95, 0xbb, 0x72, 0xa4,
+	0x3c, 0x45, 0x0f, 0x7d, 0x84, 0x1e, 0xfa, 0x28, 0x39, 0xf4, 0x90, 0x53, 0x51, 0xf4, 0x10, 0x14,
+	0xc9, 0xb9, 0x97, 0xbc, 0x40, 0x8b, 0x5d, 0x92, 0xa2, 0xac, 0x3a, 0xb1, 0x93, 0xf6, 0xa4, 0xd9,
+	0xd9, 0xfd, 0xbe, 0x99, 0xdd, 0xfd, 0x66, 0xb8, 0x82, 0xaa, 0x1b, 0x72, 0x21, 0xdc, 0x2e, 0x65,
+	0x41, 0x4b, 0x9b, 0x8e, 0xb6, 0x1d, 0x39, 0x6c, 0xf6, 0x43, 0x2e, 0x39, 0xb9, 0xf2, 0x12, 0x25,
+	0xd5, 0xbe, 0xa6, 0xb6, 0x78, 0x88, 0xcd, 0x14, 0xb3, 0xbe, 0xe2, 0xf2, 0x5e, 0x8f, 0x07, 0xad,
+	0xe8, 0x27, 0xc2, 0xac, 0x97, 0x3b, 0xbc, 0xc3, 0xb5, 0xd9, 0x52, 0x56, 0xe4, 0xad, 0xff, 0x99,
+	0x85, 0xd2, 0x41, 0xd0, 0xe6, 0x83, 0xc0, 0x3b, 0x1a, 0x1e, 0xd2, 0x90, 0xf6, 0x04, 0x59, 0x83,
+	0x9c, 0xc0, 0xc0, 0xc3, 0xd0, 0x32, 0x6a, 0x46, 0xa3, 0x60, 0xc7, 0x23, 0x72, 0x0d, 0x4a, 0x91,
+	0x15, 0xa7, 0xc3, 0x3c, 0x6b, 0xb6, 0x66, 0x34, 0x32, 0xf6, 0x62, 0xe4, 0xde, 0x51, 0xde, 0x03,
+	0x8f, 0x5c, 0x86, 0x82, 0x1c, 0x3a, 0x3c, 0x64, 0x1d, 0x16, 0x58, 0x19, 0x4d, 0x91, 0x97, 0xc3,
+	0x27, 0x7a, 0x4c, 0x6e, 0x41, 0xc1, 0xe5, 0x6a, 0x2f, 0xa3, 0x3e, 0x5a, 0xd9, 0x9a, 0xd1, 0x58,
+	0xda, 0x34, 0x9b, 0x71, 0xa2, 0x3b, 0x9c, 0x05, 0x47, 0xa3, 0x3e, 0xda, 0x79, 0x37, 0xb6, 0x48,
+	0x19, 0xe6, 0xa8, 0x10, 0x28, 0xad, 0x39, 0xcd, 0x13, 0x0d, 0xc8, 0x7d, 0xc8, 0xd1, 0x1e, 0x1f,
+	0x04, 0xd2, 0xca, 0x29, 0xf7, 0x76, 0xeb, 0xd5, 0x9b, 0xea, 0xcc, 0xef, 0x6f, 0xaa, 0xd7, 0x3b,
+	0x4c, 0x76, 0x07, 0x6d, 0xc5, 0xd7, 0x72, 0xb9, 0xe8, 0x71, 0x11, 0xff, 0xdc, 0x12, 0xde, 0xf3,
+	0x96, 0x0a, 0x29, 0x9a, 0x4f, 0x59, 0x20, 0xed, 0x18, 0x4e, 0xee, 0x82, 0xc5, 0xa2, 0xdd, 0x3b,
+	0x2a, 0xe5, 0xb6, 0xc0, 0xf0, 0x04, 0x3d, 0xa7, 0x4b, 0x45, 0xd7, 0x9a, 0xd7, 0x11, 0x57, 0x59,
+	0x72, 0x3a, 0x4f, 0xe2, 0xd9, 0x7d, 0x2a, 0xba, 0xe4, 0x21, 0xfc, 0xff, 0x2c, 0x20, 0x0e, 0x25,
+	0x86, 0x01, 0xf5, 0x9d, 0x2e, 0xb2, 0x4e, 0x57, 0x5a, 0xf9, 0x9a, 0xd1, 0xc8, 0xda, 0xd5, 0x7f,
+	0x70, 0xec, 0xc6, 0xeb, 0xf6, 0xf5, 0x32, 0xf2, 0x25, 0x5c, 0x9a, 0x60, 0x6b, 0x53, 0xdf, 0xe7,
+	0xd2, 0x61, 0x81, 0x87, 0x43, 0xab, 0xa0, 0xb3, 0x28, 0x8f, 0x19, 0xb6, 0xf5, 0xe4, 0x81, 0x9a,
+	0x23, 0x7b, 0x50, 0x9b, 0x80, 0x1d, 0xb3, 0x80, 0xfa, 0xec, 0x25, 0x7a, 0x8e, 0xd2, 0x44, 0x92,
+	0x01, 0xe8, 0x0c, 0x36, 0xc6, 0xf8, 0xbd, 0x64, 0xd5, 0x33, 0x94, 0x34, 0x0e, 0xcf, 0x60, 0x2d,
+	0xc5, 0x53, 0xc9, 0x78, 0xe0, 0x08, 0x49, 0xe5, 0x40, 0x58, 0x45, 0x7d, 0x41, 0x77, 0x9a, 0x1f,
+	0xd5, 0x5b, 0x73, 0xcc, 0xaa, 0xb1, 0xdf, 0x68, 0xa8, 0x5d, 0x96, 0x67, 0x78, 0xeb, 0xdf, 0xc3,
+	0x92, 0x0a, 0xbc, 0xe5, 0xba, 0xea, 0xfc, 0x59, 0xd0, 0x21, 0x0e, 0xac, 0xd0, 0x36, 0x0f, 0x65,
+	0x92, 0x77, 0x7c, 0xb1, 0xc6, 0xe7, 0x5d, 0xec, 0x72, 0xcc, 0xa5, 0x83, 0x68, 0xa6, 0xfa, 0xfb,
+	0x79, 0x30, 0x9f, 0x0c, 0xe4, 0x69, 0x8d, 0xaf, 0x43, 0x3e, 0x44, 0x17, 0xd9, 0xc9, 0x58, 0xe5,
+	0xe3, 0x31, 0xb9, 0x01, 0x66, 0x62, 0x47, 0x4a, 0x3f, 0x48, 0x84, 0x5e, 0x4a, 0xfc, 0x89, 0xd4,
+	0x4f, 0xa9, 0x39, 0x73, 0xae, 0x9a, 0x53, 0xdd, 0x66, 0xff, 0x9d, 0x6e, 0x6f, 0xc3, 0x2a, 0x1f,
+	0xc8, 0xf1, 0xd5, 0x4b, 0x21, 0x9c, 0x80, 0x07, 0x2e, 0xea, 0x32, 0xc9, 0xda, 0x84, 0x8f, 0xf7,
+	0x7b, 0x24, 0xc4, 0x63, 0x35, 0x33, 0x0d, 0xe9, 0x50, 0xe1, 0xf8, 0xac, 0xc7, 0xa2, 0x12, 0x3a,
+	0x05, 0xb9, 0x4f, 0xc5, 0x43, 0x35, 0x73, 0x16, 0xa4, 0x1f, 0x32, 0x17, 0xe3, 0xd2, 0x38, 0x0d,
+	0x39, 0x54, 0x33, 0xe4, 0x2b, 0xd8, 0x38, 0x03, 0xc2, 0x43, 0x26, 0x47, 0xce, 0x31, 0xa2, 0x75,
+	0x49, 0x23, 0xad, 0x69, 0xa4, 0x5e, 0xb0, 0x87, 0x48, 0x1a, 0x60, 0x4e, 0xe2, 0x75, 0x21, 0xe6,
+	0x35, 0x66, 0x29, 0xc5, 0xe8, 0x0a, 0xbc, 0x0b, 0xd6, 0xe4, 0xca, 0x33, 0x8a, 0x66, 0x35, 0x45,
+	0x4c, 0x56, 0xcd, 0x63, 0xf8, 0x62, 0x12, 0xf8, 0xc1, 0xda, 0x8d, 0x2a, 0xa7, 0x96, 0x92, 0x7c,
+	0xa0, 0x78, 0x5b, 0x50, 0x9e, 0xde, 0xf2, 0x40, 0xa0, 0x67, 0x95, 0x35, 0x7e, 0xf9, 0xd4, 0x56,
+	0x9f, 0x0a, 0xf4, 0x88, 0x84, 0xea, 0x24, 0x00, 0x8f, 0x8f, 0xd1, 0x95, 0xec, 0x04, 0x27, 0x0e,
+	0x78, 0x55, 0xcb, 0xa3, 0x19, 0xcb, 0xe3, 0xda, 0x05, 0xe4, 0x71, 0x10, 0x48, 0xfb, 0x72, 0x1a,
+	0x6b, 0x37, 0x21, 0x1d, 0xdf, 0xcc, 0xd7, 0x1f, 0x8b, 0x1a, 0x29, 0x61, 0x4d, 0x67, 0xfc, 0x01,
+	0x96, 0x48, 0x12, 0x57, 0x00, 0x94, 0xd8, 0xfa, 0x83, 0xf6, 0x73, 0x1c, 0xe9, 0xf6, 0x50, 0xb0,
+	0x0b, 0x52, 0x88, 0x43, 0xed, 0xf8, 0x48, 0x27, 0x59, 0xf8, 0x8f, 0x3b, 0x09, 0xd9, 0x80, 0x82,
+	0x4b, 0x03, 0x17, 0x7d, 0x1f, 0x3d, 0xcb, 0xaa, 0x19, 0x8d, 0xbc, 0x9d, 0x3a, 0xea, 0x7f, 0x19,
+	0x60, 0x46, 0x0b, 0x8f, 0x42, 0x1a, 0x08, 0xa6, 0x80, 0x64, 0x1f, 0x80, 0xfb, 0x5e, 0x92, 0x91,
+	0xa1, 0x33, 0xba, 0x71, 0x4e, 0x46, 0x3b, 0xae, 0x1c, 0xc6, 0x79, 0x14, 0xb8, 0xef, 0xc5, 0xc1,
+	0xf7, 0x01, 0x02, 0x7c, 0x91, 0x30, 0xcd, 0x7e, 0x32, 0x53, 0x80, 0x2f, 0x62, 0x26, 0x0b, 0xe6,
+	0x7b, 0x28, 0x04, 0xed, 0x60, 0xfc, 0xa9, 0x4c, 0x86, 0xa4, 0x0a, 0xc5, 0xc9, 0x46, 0x9e, 0xd5,
+	0x1d, 0x08, 0x5e, 0xa6, 0x6d, 0x7b, 0x03, 0x0a, 0x92, 0xf5, 0x50, 0x48, 0xda, 0xeb, 0xeb, 0xc2,
+	0xcf, 0xd8, 0xa9, 0xa3, 0xfe, 0xeb, 0x2c, 0xe4, 0xe2, 0x18, 0x5b, 0x90, 0xfb, 0xdc, 0x3d, 0xc7,
+	0x40, 0x72, 0x15, 0x96, 0x22, 0xcb, 0x49, 0xb2, 0x9d, 0xd5, 0xd9, 0x2e, 0x46, 0xde, 0x47, 0x71,
+	0xce, 0xb7, 0xa1, 0xec, 0x53, 0x21, 0x9f, 0xf6, 0x3d, 0x2a, 0xd1, 0x49, 0xb3, 0xcb, 0xe8, 0xec,
+	0x56, 0xd2, 0xb9, 0xa3, 0x64, 0x8a, 0x34, 0xa0, 0xc4, 0xc4, 0x96, 0xea, 0xda, 0x36, 0x1e, 0x0f,
+	0x02, 0x0f, 0x3d, 0xbd, 0xd5, 0xbc, 0x3d, 0xed, 0x26, 0x07, 0x30, 0xdf, 0x65, 0x42, 0xf2, 0x70,
+	0x64, 0xcd, 0xd5, 0x32, 0x8d, 0xe2, 0x66, 0xeb, 0x9c, 0x7d, 0x4c, 0x0b, 0xc0, 0x4e, 0xf0, 0xe4,
+	0x1e, 0xfc, 0xcf, 0x43, 0xea, 0xf9, 0x2c, 0x40, 0x5d, 0xf7, 0x81, 0x50, 0x52, 0x6d, 0xfb, 0xdc,
+	0x7d, 0x2e, 0xe2, 0x86, 0x78, 0x29, 0x59, 0xb0, 0x9b, 0xcc, 0x6f, 0xeb, 0xe9, 0xfa, 0x2f, 0x19,
+	0x58, 0xd8, 0x51, 0x41, 0xf4, 0x47, 0xe0, 0x68, 0xa8, 0xae, 0xd0, 0x0d, 0x91, 0x4a, 0x9e, 0x7c,
+	0x4a, 0x92, 0xa1, 0x7a, 0xbd, 0x44, 0x0d, 0x29, 0x3a, 0xac, 0x68, 0x40, 0xbe, 0x83, 0x82, 0xbe,
+	0xd8, 0x63, 0x44, 0x11, 0xbd, 0x6b, 0xb6, 0x77, 0x3e, 0xf1, 0x43, 0xf0, 0xfe, 0x4d, 0xd5, 0x1c,
+	0xd1, 0x9e, 0x7f, 0xaf, 0x3e, 0x66, 0xaa, 0xdb, 0x79, 0x65, 0xef, 0x21, 0x0a, 0x72, 0x1d, 0x4a,
+	0x21, 0xfa, 0x74, 0x84, 0xde, 0xf8, 0xba, 0x72, 0x51, 0x13, 0x8d, 0xdd, 0xc9, 0x7d, 0xed, 0x41,
+	0xd1, 0x75, 0xe5, 0x30, 0x11, 0xb2, 0xea, 0xb4, 0xc5, 0xcd, 0xab, 0x17, 0x3a, 0x56, 0x1b, 0xdc,
+	0xb1, 0x4c, 0xc8, 0x33, 0x58, 0x9e, 0x78, 0x89, 0xf4, 0xf5, 0x37, 0x56, 0x77, 0xe1, 0xe2, 0x66,
+	0xf3, 0x1c, 0xb6, 0xa9, 0xd7, 0xa7, 0x5d, 0x62, 0xa7, 0x1d, 0xe4, 0x5b, 0x20, 0x93, 0x8d, 0x2b,
+	0x26, 0x87, 0x0b, 0x29, 0x60, 0xfa, 0xbb, 0x6f, 0x9b, 0x7c, 0xca, 0x73, 0xf3, 0x47, 0x03, 0x20,
+	0x15, 0x3c, 0x21, 0xb0, 0x74, 0x88, 0x81, 0xc7, 0x82, 0x4e, 0x9c, 0x98, 0x39, 0x43, 0x56, 0xa0,
+	0x14, 0xfb, 0x12, 0x3e, 0xd3, 0x20, 0xcb, 0xb0, 0x98, 0x8c, 0x1e, 0xb1, 0x00, 0x3d, 0x33, 0xa3,
+	0x5c, 0xf1, 0x3a, 0x1b, 0x4f, 0x30, 0x94, 0x66, 0x96, 0x2c, 0x40, 0x3e, 0xb2, 0xd1, 0x33, 0xe7,
+	0x48, 0x11, 0xe6, 0xb7, 0xa2, 0xf7, 0x89, 0x99, 0x23, 0x6b, 0x40, 0x92, 0xd5, 0x54, 0xa2, 0x6e,
+	0xaf, 0xe8, 0x99, 0xf3, 0xeb, 0xd9, 0x9f, 0x7f, 0xaa, 0x18, 0x37, 0x1f, 0x40, 0xf9, 0xac, 0x66,
+	0x48, 0x4c, 0x58, 0x78, 0xcc, 0xe5, 0xf8, 0x15, 0x67, 0xce, 0x90, 0x45, 0x28, 0xa4, 0x43, 0x43,
+	0x45, 0xdc, 0x1d, 0xa2, 0x3b, 0x50, 0x64, 0xb3, 0x11, 0xd9, 0xf6, 0x83, 0x57, 0x6f, 0x2b, 0xc6,
+	0xeb, 0xb7, 0x15, 0xe3, 0x8f, 0xb7, 0x15, 0xe3, 0x87, 0x77, 0x95, 0x99, 0xd7, 0xef, 0x2a, 0x33,
+	0xbf, 0xbd, 0xab, 0xcc, 0x3c, 0xbb, 0x3d, 0x21, 0x38, 0x75, 0x80, 0xb7, 0xa2, 0x7f, 0x1d, 0xc9,
+	0x59, 0xb6, 0x86, 0xad, 0x89, 0xff, 0x22, 0x5a, 0x7f, 0xed, 0x9c, 0xfe, 0xe7, 0x70, 0xe7, 0xef,
+	0x01, 0x00, 0x50, 0xf5, 0xf9, 0xfe, 0xa6, 0x0c, 0x00, 0x00,
 }
 
 func (m *InboundTxParams) Marshal() (dAtA []byte, err error) {
@@ -775,6 +906,27 @@ func (m *OutboundTxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.OutboundTxGasPriorityFee) > 0 {
+		i -= len(m.OutboundTxGasPriorityFee)
+		copy(dAtA[i:], m.OutboundTxGasPriorityFee)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.OutboundTxGasPriorityFee)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.OutboundTxEffectiveGasLimit))
 		i--
@@ -879,6 +1031,56 @@ func (m *OutboundTxParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StatusTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ZetaHeight != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.ZetaHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewStatus != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.NewStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldStatus != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.OldStatus))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -899,6 +1101,25 @@ func (m *Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeadlineExtensionBlocks != 0 {
+		i = encodeVarintCrossChainTx(dAtA, i, uint64(m.DeadlineExtensionBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrossChainTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.IsAbortRefunded {
 		i--
 		if m.IsAbortRefunded {
@@ -1143,6 +1364,38 @@ func (m *OutboundTxParams) Size() (n int) {
 	if m.OutboundTxEffectiveGasLimit != 0 {
 		n += 2 + sovCrossChainTx(uint64(m.OutboundTxEffectiveGasLimit))
 	}
+	l = len(m.OutboundTxGasPriorityFee)
+	if l > 0 {
+		n += 2 + l + sovCrossChainTx(uint64(l))
+	}
+	if m.Cancelled {
+		n += 3
+	}
+	return n
+}
+
+func (m *StatusTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldStatus != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.OldStatus))
+	}
+	if m.NewStatus != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.NewStatus))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCrossChainTx(uint64(l))
+	}
+	if m.ZetaHeight != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.ZetaHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.Timestamp))
+	}
 	return n
 }
 
@@ -1165,6 +1418,15 @@ func (m *Status) Size() (n int) {
 	if m.IsAbortRefunded {
 		n += 2
 	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovCrossChainTx(uint64(l))
+		}
+	}
+	if m.DeadlineExtensionBlocks != 0 {
+		n += 1 + sovCrossChainTx(uint64(m.DeadlineExtensionBlocks))
+	}
 	return n
 }
 
@@ -2043,6 +2305,216 @@ func (m *OutboundTxParams) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundTxGasPriorityFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundTxGasPriorityFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrossChainTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldStatus", wireType)
+			}
+			m.OldStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldStatus |= CctxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewStatus", wireType)
+			}
+			m.NewStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewStatus |= CctxStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaHeight", wireType)
+			}
+			m.ZetaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZetaHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -2183,6 +2655,59 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsAbortRefunded = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrossChainTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, &StatusTransition{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineExtensionBlocks", wireType)
+			}
+			m.DeadlineExtensionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrossChainTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeadlineExtensionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrossChainTx(dAtA[iNdEx:])
@@ -41,4 +41,9 @@ var (
 	ErrUnableProcessRefund           = errorsmod.Register(ModuleName, 1148, "unable to process refund")
 	ErrUnableToFindZetaAccounting    = errorsmod.Register(ModuleName, 1149, "unable to find zeta accounting")
 	ErrInsufficientZetaAmount        = errorsmod.Register(ModuleName, 1150, "insufficient zeta amount")
+	ErrNotTxOrigin                   = errorsmod.Register(ModuleName, 1151, "creator is not the tx origin of the cctx")
+	ErrRateLimitExceeded             = errorsmod.Register(ModuleName, 1152, "outbound rate limit exceeded")
+	ErrRateLimitNotFound             = errorsmod.Register(ModuleName, 1153, "rate limit not found")
+	ErrInvalidRateLimit              = errorsmod.Register(ModuleName, 1154, "invalid rate limit")
+	ErrOutboundCancelled             = errorsmod.Register(ModuleName, 1155, "the outbound of the cctx is cancelled")
 )
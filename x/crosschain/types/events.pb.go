@@ -509,6 +509,168 @@ func (m *EventOutboundSuccess) GetValueReceived() string {
 	return ""
 }
 
+type EventCCTXExpired struct {
+	CctxIndex string `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	// the status of the cctx is unchanged when the outbound expires, the outbound is cancelled
+	// the cctx is reverted or aborted once the cancel tx is observed
+	Status         string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	StatusMessage  string `protobuf:"bytes,3,opt,name=status_message,json=statusMessage,proto3" json:"status_message,omitempty"`
+	ReceiverChain  string `protobuf:"bytes,4,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
+	DeadlineHeight string `protobuf:"bytes,5,opt,name=deadline_height,json=deadlineHeight,proto3" json:"deadline_height,omitempty"`
+}
+
+func (m *EventCCTXExpired) Reset()         { *m = EventCCTXExpired{} }
+func (m *EventCCTXExpired) String() string { return proto.CompactTextString(m) }
+func (*EventCCTXExpired) ProtoMessage()    {}
+func (*EventCCTXExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{5}
+}
+func (m *EventCCTXExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCCTXExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCCTXExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCCTXExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCCTXExpired.Merge(m, src)
+}
+func (m *EventCCTXExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCCTXExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCCTXExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCCTXExpired proto.InternalMessageInfo
+
+func (m *EventCCTXExpired) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *EventCCTXExpired) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *EventCCTXExpired) GetStatusMessage() string {
+	if m != nil {
+		return m.StatusMessage
+	}
+	return ""
+}
+
+func (m *EventCCTXExpired) GetReceiverChain() string {
+	if m != nil {
+		return m.ReceiverChain
+	}
+	return ""
+}
+
+func (m *EventCCTXExpired) GetDeadlineHeight() string {
+	if m != nil {
+		return m.DeadlineHeight
+	}
+	return ""
+}
+
+type EventCCTXRateLimited struct {
+	CctxIndex     string `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	ReceiverChain string `protobuf:"bytes,2,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
+	Zrc20Address  string `protobuf:"bytes,3,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
+	Amount        string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	WindowUsage   string `protobuf:"bytes,5,opt,name=window_usage,json=windowUsage,proto3" json:"window_usage,omitempty"`
+	Limit         string `protobuf:"bytes,6,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *EventCCTXRateLimited) Reset()         { *m = EventCCTXRateLimited{} }
+func (m *EventCCTXRateLimited) String() string { return proto.CompactTextString(m) }
+func (*EventCCTXRateLimited) ProtoMessage()    {}
+func (*EventCCTXRateLimited) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7398db8b12b87b9e, []int{6}
+}
+func (m *EventCCTXRateLimited) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCCTXRateLimited) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCCTXRateLimited.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCCTXRateLimited) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCCTXRateLimited.Merge(m, src)
+}
+func (m *EventCCTXRateLimited) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCCTXRateLimited) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCCTXRateLimited.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCCTXRateLimited proto.InternalMessageInfo
+
+func (m *EventCCTXRateLimited) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *EventCCTXRateLimited) GetReceiverChain() string {
+	if m != nil {
+		return m.ReceiverChain
+	}
+	return ""
+}
+
+func (m *EventCCTXRateLimited) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
+	}
+	return ""
+}
+
+func (m *EventCCTXRateLimited) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventCCTXRateLimited) GetWindowUsage() string {
+	if m != nil {
+		return m.WindowUsage
+	}
+	return ""
+}
+
+func (m *EventCCTXRateLimited) GetLimit() string {
+	if m != nil {
+		return m.Limit
+	}
+	return ""
+}
+
 type EventCCTXGasPriceIncreased struct {
 	CctxIndex        string `protobuf:"bytes,1,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
 	GasPriceIncrease string `protobuf:"bytes,2,opt,name=gas_price_increase,json=gasPriceIncrease,proto3" json:"gas_price_increase,omitempty"`
@@ -519,7 +681,7 @@ func (m *EventCCTXGasPriceIncreased) Reset()         { *m = EventCCTXGasPriceInc
 func (m *EventCCTXGasPriceIncreased) String() string { return proto.CompactTextString(m) }
 func (*EventCCTXGasPriceIncreased) ProtoMessage()    {}
 func (*EventCCTXGasPriceIncreased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7398db8b12b87b9e, []int{5}
+	return fileDescriptor_7398db8b12b87b9e, []int{7}
 }
 func (m *EventCCTXGasPriceIncreased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,54 +737,64 @@ func init() {
 	proto.RegisterType((*EventZetaWithdrawCreated)(nil), "zetachain.zetacore.crosschain.EventZetaWithdrawCreated")
 	proto.RegisterType((*EventOutboundFailure)(nil), "zetachain.zetacore.crosschain.EventOutboundFailure")
 	proto.RegisterType((*EventOutboundSuccess)(nil), "zetachain.zetacore.crosschain.EventOutboundSuccess")
+	proto.RegisterType((*EventCCTXExpired)(nil), "zetachain.zetacore.crosschain.EventCCTXExpired")
+	proto.RegisterType((*EventCCTXRateLimited)(nil), "zetachain.zetacore.crosschain.EventCCTXRateLimited")
 	proto.RegisterType((*EventCCTXGasPriceIncreased)(nil), "zetachain.zetacore.crosschain.EventCCTXGasPriceIncreased")
 }
 
 func init() { proto.RegisterFile("crosschain/events.proto", fileDescriptor_7398db8b12b87b9e) }
 
 var fileDescriptor_7398db8b12b87b9e = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xcf, 0x6e, 0x13, 0x3b,
-	0x14, 0xc6, 0x3b, 0x6d, 0x92, 0x26, 0x6e, 0x9b, 0x5e, 0xcd, 0xed, 0xbd, 0xf5, 0x8d, 0x6e, 0xa3,
-	0x52, 0x89, 0x3f, 0x0b, 0x48, 0x84, 0x78, 0x83, 0x46, 0x94, 0x56, 0x08, 0x15, 0xb5, 0x45, 0xa0,
-	0x6e, 0x2c, 0xc7, 0x73, 0x98, 0xb1, 0x98, 0xb1, 0x23, 0xdb, 0xd3, 0x4e, 0xfb, 0x14, 0x88, 0xf7,
-	0x40, 0xe2, 0x01, 0x78, 0x00, 0x96, 0x5d, 0xb0, 0x60, 0x89, 0xda, 0x17, 0x41, 0xb6, 0x67, 0x68,
-	0x33, 0x45, 0xb0, 0x40, 0x20, 0xb1, 0x8a, 0xcf, 0x77, 0xec, 0x93, 0x9f, 0xbf, 0xe3, 0xe4, 0xa0,
-	0x55, 0xa6, 0xa4, 0xd6, 0x2c, 0xa1, 0x5c, 0x0c, 0xe1, 0x08, 0x84, 0xd1, 0x83, 0x89, 0x92, 0x46,
-	0x86, 0x6b, 0xa7, 0x60, 0xa8, 0xd3, 0x07, 0x6e, 0x25, 0x15, 0x0c, 0x2e, 0xf7, 0xf6, 0xfe, 0x66,
-	0x32, 0xcb, 0xa4, 0x18, 0xfa, 0x0f, 0x7f, 0xa6, 0xb7, 0x12, 0xcb, 0x58, 0xba, 0xe5, 0xd0, 0xae,
-	0xbc, 0xba, 0xf1, 0x71, 0x0e, 0xfd, 0xf3, 0xd0, 0x96, 0xde, 0x11, 0x63, 0x99, 0x8b, 0x68, 0x8b,
-	0x0b, 0x9a, 0xf2, 0x53, 0x88, 0xc2, 0x75, 0xb4, 0x98, 0xe9, 0x98, 0x98, 0x93, 0x09, 0x90, 0x5c,
-	0xa5, 0x38, 0x58, 0x0f, 0xee, 0x74, 0xf6, 0x50, 0xa6, 0xe3, 0x83, 0x93, 0x09, 0x3c, 0x53, 0x69,
-	0xb8, 0x86, 0x10, 0x63, 0xa6, 0x20, 0x5c, 0x44, 0x50, 0xe0, 0x59, 0x97, 0xef, 0x58, 0x65, 0xc7,
-	0x0a, 0xe1, 0xbf, 0xa8, 0xa5, 0x41, 0x44, 0xa0, 0xf0, 0x9c, 0x4b, 0x95, 0x51, 0xf8, 0x1f, 0x6a,
-	0x9b, 0x82, 0x48, 0x15, 0x73, 0x81, 0x1b, 0x2e, 0x33, 0x6f, 0x8a, 0x5d, 0x1b, 0x86, 0x2b, 0xa8,
-	0x49, 0xb5, 0x06, 0x83, 0x9b, 0x4e, 0xf7, 0x41, 0xf8, 0x3f, 0x42, 0x5c, 0x10, 0x53, 0x90, 0x84,
-	0xea, 0x04, 0xb7, 0x5c, 0xaa, 0xcd, 0xc5, 0x41, 0xb1, 0x4d, 0x75, 0x12, 0xde, 0x42, 0xcb, 0x5c,
-	0x90, 0x71, 0x2a, 0xd9, 0x2b, 0x92, 0x00, 0x8f, 0x13, 0x83, 0xe7, 0xdd, 0x96, 0x25, 0x2e, 0x36,
-	0xad, 0xba, 0xed, 0xc4, 0xb0, 0x87, 0xda, 0x0a, 0x18, 0xf0, 0x23, 0x50, 0xb8, 0xed, 0x6b, 0x54,
-	0x71, 0x78, 0x13, 0x75, 0xab, 0x35, 0x71, 0x16, 0xe2, 0x8e, 0x2f, 0x51, 0xa9, 0x23, 0x2b, 0xda,
-	0x1b, 0xd1, 0x4c, 0xe6, 0xc2, 0x60, 0xe4, 0x6f, 0xe4, 0xa3, 0xf0, 0x36, 0x5a, 0x56, 0x90, 0xd2,
-	0x13, 0x88, 0x48, 0x06, 0x5a, 0xd3, 0x18, 0xf0, 0x82, 0xdb, 0xd0, 0x2d, 0xe5, 0x27, 0x5e, 0xb5,
-	0x8e, 0x09, 0x38, 0x26, 0xda, 0x50, 0x93, 0x6b, 0xbc, 0xe8, 0x1d, 0x13, 0x70, 0xbc, 0xef, 0x04,
-	0x8b, 0xe1, 0x53, 0x5f, 0xcb, 0x2c, 0x79, 0x0c, 0xaf, 0x56, 0x55, 0x6e, 0xa0, 0x45, 0x6f, 0x65,
-	0xc9, 0xda, 0x75, 0x9b, 0x16, 0xbc, 0xe6, 0x48, 0x37, 0xde, 0xce, 0xa2, 0x55, 0xd7, 0xd6, 0x43,
-	0xc5, 0x9e, 0x73, 0x93, 0x44, 0x8a, 0x1e, 0x8f, 0x14, 0x50, 0xf3, 0x2b, 0x1b, 0x5b, 0xe7, 0x6a,
-	0x5c, 0xe3, 0xaa, 0xb5, 0xb2, 0x59, 0x6b, 0xe5, 0xd5, 0x16, 0xb5, 0x7e, 0xd8, 0xa2, 0xf9, 0xef,
-	0xb7, 0xa8, 0x3d, 0xd5, 0xa2, 0x69, 0xe7, 0x3b, 0x35, 0xe7, 0x37, 0xde, 0x05, 0x08, 0x7b, 0xbf,
-	0xc0, 0xd0, 0xdf, 0x66, 0xd8, 0xb4, 0x1b, 0x8d, 0x9a, 0x1b, 0xd3, 0xc8, 0xcd, 0x3a, 0xf2, 0xfb,
-	0x00, 0xad, 0x38, 0xe4, 0xdd, 0xdc, 0xf8, 0x9f, 0x2e, 0xe5, 0x69, 0xae, 0xe0, 0xe7, 0x71, 0xd7,
-	0x10, 0x92, 0x69, 0x54, 0x7d, 0xb1, 0x47, 0xee, 0xc8, 0x34, 0x2a, 0x5f, 0xe9, 0x34, 0x57, 0xe3,
-	0x1b, 0x8f, 0xf8, 0x88, 0xa6, 0x39, 0x90, 0xb2, 0x31, 0x51, 0x89, 0xbe, 0xe4, 0xd4, 0xbd, 0x52,
-	0xbc, 0x8e, 0xbf, 0x9f, 0x33, 0x06, 0x5a, 0xff, 0x21, 0xf8, 0x6f, 0x02, 0xd4, 0x73, 0xf8, 0xa3,
-	0xd1, 0xc1, 0x8b, 0x47, 0x54, 0x3f, 0x55, 0x9c, 0xc1, 0x8e, 0x60, 0x0a, 0xa8, 0x86, 0xa8, 0x86,
-	0x18, 0xd4, 0x11, 0xef, 0xa2, 0x30, 0xa6, 0x9a, 0x4c, 0xec, 0x21, 0xc2, 0xcb, 0x53, 0xe5, 0x4d,
-	0xfe, 0x8a, 0x6b, 0xd5, 0xec, 0xdf, 0x0b, 0x8d, 0x22, 0x6e, 0xb8, 0x14, 0x34, 0x25, 0x2f, 0x01,
-	0xaa, 0x5b, 0x75, 0x2f, 0xe5, 0x2d, 0x00, 0xbd, 0xf9, 0xf8, 0xc3, 0x79, 0x3f, 0x38, 0x3b, 0xef,
-	0x07, 0x9f, 0xcf, 0xfb, 0xc1, 0xeb, 0x8b, 0xfe, 0xcc, 0xd9, 0x45, 0x7f, 0xe6, 0xd3, 0x45, 0x7f,
-	0xe6, 0xf0, 0x7e, 0xcc, 0x4d, 0x92, 0x8f, 0x07, 0x4c, 0x66, 0x43, 0x3b, 0x31, 0xee, 0xf9, 0xa1,
-	0x52, 0x0d, 0x8f, 0x61, 0x31, 0xbc, 0x32, 0x6a, 0xac, 0xf5, 0x7a, 0xdc, 0x72, 0x03, 0xe2, 0xc1,
-	0x97, 0x00, 0x00, 0x00, 0xff, 0xff, 0x45, 0x66, 0xf0, 0x4d, 0x85, 0x06, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcd, 0x6e, 0x2b, 0x35,
+	0x14, 0xee, 0xa4, 0x49, 0x9a, 0xb8, 0x49, 0x7a, 0x35, 0x04, 0xee, 0x10, 0xd1, 0xe8, 0xde, 0x20,
+	0xb8, 0x2c, 0x20, 0xe1, 0xe7, 0x09, 0xb8, 0x51, 0x4b, 0x2b, 0x40, 0x45, 0xfd, 0x11, 0xa8, 0x1b,
+	0xcb, 0x19, 0x1f, 0x66, 0x2c, 0x66, 0xec, 0xc8, 0xf6, 0x34, 0xd3, 0x3e, 0x05, 0xe2, 0x3d, 0x90,
+	0x78, 0x00, 0x16, 0x2c, 0x59, 0x56, 0x88, 0x05, 0x4b, 0xd4, 0xbe, 0x08, 0xb2, 0x3d, 0xd3, 0x36,
+	0x93, 0x40, 0x17, 0x08, 0xa4, 0xbb, 0x8a, 0xcf, 0x67, 0xfb, 0xcc, 0x77, 0xbe, 0xcf, 0xc7, 0x0e,
+	0x7a, 0x1a, 0x4a, 0xa1, 0x54, 0x18, 0x13, 0xc6, 0x27, 0x70, 0x01, 0x5c, 0xab, 0xf1, 0x5c, 0x0a,
+	0x2d, 0xfc, 0xdd, 0x2b, 0xd0, 0xc4, 0xe2, 0x63, 0x3b, 0x12, 0x12, 0xc6, 0xf7, 0x6b, 0x07, 0xaf,
+	0x85, 0x22, 0x4d, 0x05, 0x9f, 0xb8, 0x1f, 0xb7, 0x67, 0xd0, 0x8f, 0x44, 0x24, 0xec, 0x70, 0x62,
+	0x46, 0x0e, 0x1d, 0xfd, 0xbe, 0x89, 0x5e, 0xdf, 0x33, 0xa9, 0x0f, 0xf9, 0x4c, 0x64, 0x9c, 0xee,
+	0x33, 0x4e, 0x12, 0x76, 0x05, 0xd4, 0x7f, 0x86, 0x3a, 0xa9, 0x8a, 0xb0, 0xbe, 0x9c, 0x03, 0xce,
+	0x64, 0x12, 0x78, 0xcf, 0xbc, 0xf7, 0xda, 0xc7, 0x28, 0x55, 0xd1, 0xe9, 0xe5, 0x1c, 0xce, 0x64,
+	0xe2, 0xef, 0x22, 0x14, 0x86, 0x3a, 0xc7, 0x8c, 0x53, 0xc8, 0x83, 0x9a, 0x9d, 0x6f, 0x1b, 0xe4,
+	0xd0, 0x00, 0xfe, 0x1b, 0xa8, 0xa9, 0x80, 0x53, 0x90, 0xc1, 0xa6, 0x9d, 0x2a, 0x22, 0xff, 0x4d,
+	0xd4, 0xd2, 0x39, 0x16, 0x32, 0x62, 0x3c, 0xa8, 0xdb, 0x99, 0x2d, 0x9d, 0x1f, 0x99, 0xd0, 0xef,
+	0xa3, 0x06, 0x51, 0x0a, 0x74, 0xd0, 0xb0, 0xb8, 0x0b, 0xfc, 0xb7, 0x10, 0x62, 0x1c, 0xeb, 0x1c,
+	0xc7, 0x44, 0xc5, 0x41, 0xd3, 0x4e, 0xb5, 0x18, 0x3f, 0xcd, 0x0f, 0x88, 0x8a, 0xfd, 0x77, 0xd1,
+	0x0e, 0xe3, 0x78, 0x96, 0x88, 0xf0, 0x3b, 0x1c, 0x03, 0x8b, 0x62, 0x1d, 0x6c, 0xd9, 0x25, 0x5d,
+	0xc6, 0x5f, 0x1a, 0xf4, 0xc0, 0x82, 0xfe, 0x00, 0xb5, 0x24, 0x84, 0xc0, 0x2e, 0x40, 0x06, 0x2d,
+	0x97, 0xa3, 0x8c, 0xfd, 0x77, 0x50, 0xaf, 0x1c, 0x63, 0x2b, 0x61, 0xd0, 0x76, 0x29, 0x4a, 0x74,
+	0x6a, 0x40, 0x53, 0x11, 0x49, 0x45, 0xc6, 0x75, 0x80, 0x5c, 0x45, 0x2e, 0xf2, 0x5f, 0xa0, 0x1d,
+	0x09, 0x09, 0xb9, 0x04, 0x8a, 0x53, 0x50, 0x8a, 0x44, 0x10, 0x6c, 0xdb, 0x05, 0xbd, 0x02, 0xfe,
+	0xd2, 0xa1, 0x46, 0x31, 0x0e, 0x0b, 0xac, 0x34, 0xd1, 0x99, 0x0a, 0x3a, 0x4e, 0x31, 0x0e, 0x8b,
+	0x13, 0x0b, 0x18, 0x1a, 0x6e, 0xea, 0x2e, 0x4d, 0xd7, 0xd1, 0x70, 0x68, 0x99, 0xe5, 0x39, 0xea,
+	0x38, 0x29, 0x0b, 0xae, 0x3d, 0xbb, 0x68, 0xdb, 0x61, 0x96, 0xe9, 0xe8, 0xc7, 0x1a, 0x7a, 0x6a,
+	0x6d, 0x3d, 0x97, 0xe1, 0xd7, 0x4c, 0xc7, 0x54, 0x92, 0xc5, 0x54, 0x02, 0xd1, 0xff, 0xa5, 0xb1,
+	0x55, 0x5e, 0xf5, 0x15, 0x5e, 0x15, 0x2b, 0x1b, 0x15, 0x2b, 0x1f, 0x5a, 0xd4, 0x7c, 0xd4, 0xa2,
+	0xad, 0x7f, 0xb6, 0xa8, 0xb5, 0x64, 0xd1, 0xb2, 0xf2, 0xed, 0x8a, 0xf2, 0xa3, 0x9f, 0x3c, 0x14,
+	0x38, 0xbd, 0x40, 0x93, 0xff, 0x4d, 0xb0, 0x65, 0x35, 0xea, 0x15, 0x35, 0x96, 0x29, 0x37, 0xaa,
+	0x94, 0x7f, 0xf6, 0x50, 0xdf, 0x52, 0x3e, 0xca, 0xb4, 0x6b, 0x5d, 0xc2, 0x92, 0x4c, 0xc2, 0xbf,
+	0xa7, 0xbb, 0x8b, 0x90, 0x48, 0x68, 0xf9, 0x61, 0x47, 0xb9, 0x2d, 0x12, 0x5a, 0x9c, 0xd2, 0x65,
+	0x5e, 0xf5, 0x35, 0x87, 0xf8, 0x82, 0x24, 0x19, 0xe0, 0xc2, 0x18, 0x5a, 0x50, 0xef, 0x5a, 0xf4,
+	0xb8, 0x00, 0x57, 0xe9, 0x9f, 0x64, 0x61, 0x08, 0x4a, 0xbd, 0x22, 0xf4, 0x7f, 0xf1, 0xd0, 0x13,
+	0x4b, 0x7f, 0x3a, 0x3d, 0xfd, 0x66, 0x2f, 0x9f, 0x33, 0x09, 0xb4, 0x42, 0xcc, 0x5b, 0x77, 0x0c,
+	0xdc, 0x57, 0x6b, 0xc5, 0x31, 0xf8, 0xbb, 0xb6, 0xdf, 0x5c, 0xd7, 0xf6, 0xab, 0x1d, 0x50, 0x5f,
+	0xd7, 0x01, 0x2f, 0xd0, 0x0e, 0x05, 0x42, 0x13, 0xc6, 0xa1, 0xbc, 0x0f, 0x5d, 0x05, 0xbd, 0x12,
+	0x76, 0x17, 0xe2, 0xe8, 0xb7, 0xd2, 0x01, 0x53, 0xc2, 0x31, 0xd1, 0xf0, 0x05, 0x4b, 0x99, 0x7e,
+	0xbc, 0x8c, 0x55, 0x1e, 0xb5, 0x75, 0x3c, 0xde, 0x46, 0xdd, 0x2b, 0x19, 0x7e, 0xfc, 0x21, 0x26,
+	0x94, 0x4a, 0x50, 0xa5, 0x13, 0x1d, 0x0b, 0x7e, 0xea, 0xb0, 0x07, 0xed, 0x5a, 0x5f, 0x6a, 0xd7,
+	0xe7, 0xa8, 0xb3, 0x60, 0x9c, 0x8a, 0x05, 0xce, 0xac, 0x20, 0xae, 0x82, 0x6d, 0x87, 0x9d, 0x59,
+	0x39, 0xfa, 0xa8, 0x91, 0x18, 0xc2, 0xc5, 0x4d, 0xe1, 0x82, 0xd1, 0x0f, 0x1e, 0x1a, 0xdc, 0x15,
+	0xf5, 0x19, 0x51, 0x5f, 0x49, 0x16, 0xc2, 0x21, 0x0f, 0x25, 0x10, 0xf5, 0x78, 0x69, 0xef, 0x23,
+	0x3f, 0x22, 0x0a, 0xcf, 0xcd, 0x26, 0xcc, 0x8a, 0x5d, 0x45, 0x79, 0x4f, 0xa2, 0x4a, 0x36, 0xa3,
+	0x34, 0xa1, 0x94, 0x69, 0x26, 0x38, 0x49, 0xf0, 0xb7, 0x00, 0x65, 0x8d, 0xbd, 0x7b, 0x78, 0x1f,
+	0x40, 0xbd, 0xfc, 0xfc, 0xd7, 0x9b, 0xa1, 0x77, 0x7d, 0x33, 0xf4, 0xfe, 0xbc, 0x19, 0x7a, 0xdf,
+	0xdf, 0x0e, 0x37, 0xae, 0x6f, 0x87, 0x1b, 0x7f, 0xdc, 0x0e, 0x37, 0xce, 0x3f, 0x8a, 0x98, 0x8e,
+	0xb3, 0xd9, 0x38, 0x14, 0xe9, 0xc4, 0xbc, 0xe4, 0x1f, 0xb8, 0xc7, 0xbe, 0x7c, 0xd4, 0x27, 0xf9,
+	0xe4, 0xc1, 0x5f, 0x00, 0xd3, 0x12, 0x6a, 0xd6, 0xb4, 0x0f, 0xf7, 0x27, 0x7f, 0x0d, 0x00, 0xa6,
+	0xdc, 0x35, 0xf7, 0x1d, 0x08, 0x00, 0x00,
 }
 
 func (m *EventInboundFinalized) Marshal() (dAtA []byte, err error) {
@@ -1006,6 +1178,129 @@ func (m *EventOutboundSuccess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCCTXExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCCTXExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCCTXExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeadlineHeight) > 0 {
+		i -= len(m.DeadlineHeight)
+		copy(dAtA[i:], m.DeadlineHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DeadlineHeight)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReceiverChain) > 0 {
+		i -= len(m.ReceiverChain)
+		copy(dAtA[i:], m.ReceiverChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverChain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StatusMessage) > 0 {
+		i -= len(m.StatusMessage)
+		copy(dAtA[i:], m.StatusMessage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StatusMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCCTXRateLimited) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCCTXRateLimited) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCCTXRateLimited) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Limit) > 0 {
+		i -= len(m.Limit)
+		copy(dAtA[i:], m.Limit)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Limit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WindowUsage) > 0 {
+		i -= len(m.WindowUsage)
+		copy(dAtA[i:], m.WindowUsage)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WindowUsage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReceiverChain) > 0 {
+		i -= len(m.ReceiverChain)
+		copy(dAtA[i:], m.ReceiverChain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ReceiverChain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventCCTXGasPriceIncreased) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1258,6 +1553,68 @@ func (m *EventOutboundSuccess) Size() (n int) {
 	return n
 }
 
+func (m *EventCCTXExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.StatusMessage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiverChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DeadlineHeight)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCCTXRateLimited) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ReceiverChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.WindowUsage)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Limit)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventCCTXGasPriceIncreased) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2751,6 +3108,458 @@ func (m *EventOutboundSuccess) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCCTXExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeadlineHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCCTXRateLimited) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCCTXRateLimited: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCCTXRateLimited: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowUsage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WindowUsage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Limit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventCCTXGasPriceIncreased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
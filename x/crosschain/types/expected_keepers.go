@@ -33,6 +33,7 @@ type BankKeeper interface {
 type ObserverKeeper interface {
 	GetObserverSet(ctx sdk.Context) (val observertypes.ObserverSet, found bool)
 	GetBallot(ctx sdk.Context, index string) (val observertypes.Ballot, found bool)
+	RemoveBallot(ctx sdk.Context, index string)
 	GetParams(ctx sdk.Context) (params observertypes.Params)
 	GetChainParamsByChainID(ctx sdk.Context, chainID int64) (params *observertypes.ChainParams, found bool)
 	GetNodeAccount(ctx sdk.Context, address string) (nodeAccount observertypes.NodeAccount, found bool)
@@ -50,6 +51,7 @@ type ObserverKeeper interface {
 	FindBallot(ctx sdk.Context, index string, chain *common.Chain, observationType observertypes.ObservationType) (ballot observertypes.Ballot, isNew bool, err error)
 	AddBallotToList(ctx sdk.Context, ballot observertypes.Ballot)
 	GetBlockHeader(ctx sdk.Context, hash []byte) (val common.BlockHeader, found bool)
+	GetBlockHeaderConfirmations(ctx sdk.Context, chainID int64, hash []byte) (int64, bool)
 	CheckIfTssPubkeyHasBeenGenerated(ctx sdk.Context, tssPubkey string) (observertypes.TSS, bool)
 	GetAllTSS(ctx sdk.Context) (list []observertypes.TSS)
 	GetTSS(ctx sdk.Context) (val observertypes.TSS, found bool)
@@ -66,6 +68,7 @@ type ObserverKeeper interface {
 	GetAllChainNonces(ctx sdk.Context) (list []observertypes.ChainNonces)
 	SetNonceToCctx(ctx sdk.Context, nonceToCctx observertypes.NonceToCctx)
 	GetNonceToCctx(ctx sdk.Context, tss string, chainID int64, nonce int64) (val observertypes.NonceToCctx, found bool)
+	RemoveNonceToCctx(ctx sdk.Context, nonceToCctx observertypes.NonceToCctx)
 	GetAllPendingNonces(ctx sdk.Context) (list []observertypes.PendingNonces, err error)
 	GetPendingNonces(ctx sdk.Context, tss string, chainID int64) (val observertypes.PendingNonces, found bool)
 	SetPendingNonces(ctx sdk.Context, pendingNonces observertypes.PendingNonces)
@@ -165,4 +168,5 @@ type FungibleKeeper interface {
 
 type AuthorityKeeper interface {
 	IsAuthorized(ctx sdk.Context, address string, policyType authoritytypes.PolicyType) bool
+	IsAuthorizedForMsg(ctx sdk.Context, address string, msg sdk.Msg, chainID int64) bool
 }
@@ -56,6 +56,20 @@ func (gs GenesisState) Validate() error {
 		gasPriceIndexMap[elem.Index] = true
 	}
 
+	// Check for duplicated or invalid rate limits
+	rateLimitIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.RateLimits {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
+		index := string(RateLimitKey(elem.ChainId, elem.Zrc20Address))
+		if _, ok := rateLimitIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for rateLimit")
+		}
+		rateLimitIndexMap[index] = true
+	}
+
 	// Check for duplicated index in send
 	//sendIndexMap := make(map[string]bool)
 
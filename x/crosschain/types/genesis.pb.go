@@ -35,6 +35,7 @@ type GenesisState struct {
 	InTxTrackerList     []InTxTracker      `protobuf:"bytes,11,rep,name=in_tx_tracker_list,json=inTxTrackerList,proto3" json:"in_tx_tracker_list"`
 	ZetaAccounting      ZetaAccounting     `protobuf:"bytes,12,opt,name=zeta_accounting,json=zetaAccounting,proto3" json:"zeta_accounting"`
 	FinalizedInbounds   []string           `protobuf:"bytes,16,rep,name=FinalizedInbounds,proto3" json:"FinalizedInbounds,omitempty"`
+	RateLimits          []RateLimit        `protobuf:"bytes,17,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +134,13 @@ func (m *GenesisState) GetFinalizedInbounds() []string {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.crosschain.GenesisState")
 }
@@ -140,39 +148,40 @@ func init() {
 func init() { proto.RegisterFile("crosschain/genesis.proto", fileDescriptor_dd51403692d571f4) }
 
 var fileDescriptor_dd51403692d571f4 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6e, 0xd3, 0x3c,
-	0x14, 0xc7, 0x9b, 0x6f, 0x1f, 0x85, 0xb9, 0x85, 0x0d, 0x83, 0x44, 0x54, 0x89, 0xac, 0x1a, 0x42,
-	0x54, 0xc0, 0x12, 0x31, 0x9e, 0x80, 0x56, 0x62, 0x9b, 0x36, 0x89, 0x11, 0x7a, 0x35, 0x31, 0x19,
-	0xd7, 0xb3, 0x12, 0x6b, 0x5d, 0x5c, 0xc5, 0x8e, 0x14, 0x7a, 0xcd, 0x03, 0xf0, 0x58, 0xbb, 0xdc,
-	0x25, 0x57, 0x08, 0xb5, 0x2f, 0x82, 0x7c, 0x62, 0x86, 0xa3, 0x4e, 0x64, 0x77, 0x47, 0x3e, 0xe7,
-	0xff, 0xfb, 0x1f, 0x9f, 0x63, 0x23, 0x9f, 0xe5, 0x52, 0x29, 0x96, 0x52, 0x91, 0x45, 0x09, 0xcf,
-	0xb8, 0x12, 0x2a, 0x9c, 0xe5, 0x52, 0x4b, 0xfc, 0x74, 0xce, 0x35, 0x85, 0x44, 0x08, 0x91, 0xcc,
-	0x79, 0xf8, 0xb7, 0xb8, 0xb7, 0xe5, 0x08, 0x21, 0x24, 0x10, 0x13, 0x5d, 0x56, 0xfa, 0x5e, 0xcf,
-	0x25, 0x53, 0x45, 0x66, 0xb9, 0x60, 0xdc, 0xe6, 0x9e, 0x39, 0x39, 0xd0, 0x90, 0x94, 0xaa, 0x94,
-	0x68, 0x49, 0x18, 0xbb, 0x06, 0x04, 0x2b, 0x45, 0x3a, 0xa7, 0xec, 0x9c, 0xe7, 0x36, 0xbf, 0xed,
-	0xe4, 0xa7, 0x54, 0x69, 0x32, 0x99, 0x4a, 0x76, 0x4e, 0x52, 0x2e, 0x92, 0x54, 0xdb, 0x1a, 0xb7,
-	0x4b, 0x59, 0xe8, 0x55, 0xc8, 0x13, 0xa7, 0x60, 0x46, 0x73, 0x7a, 0x61, 0xaf, 0xdf, 0x7b, 0x9c,
-	0xc8, 0x44, 0x42, 0x18, 0x99, 0xa8, 0x3a, 0xdd, 0xfe, 0xd6, 0x46, 0xdd, 0xbd, 0x6a, 0x4c, 0x9f,
-	0x34, 0xd5, 0x1c, 0x8f, 0x50, 0xbb, 0x92, 0xf9, 0x5e, 0xdf, 0x1b, 0x74, 0x76, 0x9f, 0x87, 0xff,
-	0x1c, 0x5b, 0x78, 0x0c, 0xc5, 0xc3, 0xff, 0x2f, 0x7f, 0x6e, 0xb5, 0x62, 0x2b, 0xc5, 0xa7, 0x68,
-	0x53, 0x16, 0x7a, 0x5c, 0x8e, 0xab, 0xd6, 0x8e, 0x84, 0xd2, 0xfe, 0x7f, 0xfd, 0xb5, 0x41, 0x67,
-	0xf7, 0x55, 0x03, 0xee, 0x83, 0x23, 0xb3, 0xd0, 0x15, 0x14, 0x3e, 0x44, 0xdd, 0x84, 0xaa, 0x63,
-	0x33, 0x7f, 0x40, 0xdf, 0x01, 0xf4, 0x8b, 0x06, 0xf4, 0x9e, 0x95, 0xc4, 0x35, 0x31, 0xfe, 0x88,
-	0xee, 0x8f, 0x4c, 0xd1, 0xc8, 0x14, 0x8d, 0x4b, 0xe5, 0xdf, 0xbd, 0x55, 0xa3, 0xae, 0x26, 0xae,
-	0x13, 0xf0, 0x17, 0xf4, 0xc8, 0xec, 0x6f, 0x68, 0xd6, 0xb7, 0x0f, 0xdb, 0x83, 0x36, 0xef, 0x01,
-	0x38, 0x6c, 0x00, 0x1f, 0xd5, 0x95, 0xf1, 0x4d, 0x28, 0xcc, 0x10, 0x36, 0x56, 0xfb, 0x54, 0xa5,
-	0x63, 0x39, 0x62, 0xba, 0x04, 0x83, 0x75, 0x30, 0xd8, 0x69, 0x30, 0x38, 0xa8, 0x09, 0xed, 0x90,
-	0x6f, 0xc0, 0xe1, 0x53, 0x63, 0xe2, 0xbc, 0x30, 0x32, 0x35, 0x26, 0x1d, 0x30, 0x79, 0x79, 0x0b,
-	0x93, 0xfa, 0x1a, 0x37, 0x44, 0x56, 0xdf, 0xe2, 0x67, 0xb4, 0x61, 0x94, 0x84, 0x32, 0x26, 0x8b,
-	0x4c, 0x8b, 0x2c, 0xf1, 0xbb, 0xf0, 0xe4, 0x9a, 0x2e, 0x70, 0xc2, 0x35, 0x7d, 0x77, 0x2d, 0xb2,
-	0xf8, 0x07, 0xf3, 0xda, 0x29, 0x7e, 0x8d, 0x1e, 0xbe, 0x17, 0x19, 0x9d, 0x8a, 0x39, 0x3f, 0x3b,
-	0xc8, 0x26, 0xb2, 0xc8, 0xce, 0x94, 0xbf, 0xd9, 0x5f, 0x1b, 0xac, 0xc7, 0xab, 0x89, 0xe1, 0xe1,
-	0xe5, 0x22, 0xf0, 0xae, 0x16, 0x81, 0xf7, 0x6b, 0x11, 0x78, 0xdf, 0x97, 0x41, 0xeb, 0x6a, 0x19,
-	0xb4, 0x7e, 0x2c, 0x83, 0xd6, 0xc9, 0x9b, 0x44, 0xe8, 0xb4, 0x98, 0x84, 0x4c, 0x5e, 0x44, 0xc6,
-	0x62, 0xa7, 0xfa, 0x5a, 0x7f, 0xfa, 0x8a, 0xca, 0xc8, 0xf9, 0x70, 0xfa, 0xeb, 0x8c, 0xab, 0x49,
-	0x1b, 0xbe, 0xd6, 0xdb, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x69, 0x4a, 0x62, 0x7d, 0x8b, 0x04,
-	0x00, 0x00,
+	// 527 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6e, 0xd3, 0x30,
+	0x14, 0x86, 0x1b, 0x06, 0x83, 0xb9, 0x85, 0x6d, 0x06, 0x89, 0xa8, 0x88, 0xac, 0x1a, 0x42, 0x54,
+	0xc0, 0x12, 0x31, 0x9e, 0x80, 0x56, 0x62, 0x9b, 0x56, 0x69, 0x23, 0xf4, 0x6a, 0x62, 0x32, 0xae,
+	0x67, 0x25, 0xd6, 0xd2, 0xb8, 0x8a, 0x1d, 0x29, 0xf4, 0x29, 0x78, 0xac, 0x5d, 0xee, 0x06, 0x89,
+	0x2b, 0x84, 0xda, 0x17, 0x41, 0x76, 0xdc, 0xcd, 0x51, 0x27, 0xd2, 0xbb, 0x23, 0x9f, 0xf3, 0x7f,
+	0xff, 0xb1, 0x8f, 0x0f, 0x70, 0x49, 0xc6, 0x85, 0x20, 0x31, 0x66, 0x69, 0x10, 0xd1, 0x94, 0x0a,
+	0x26, 0xfc, 0x49, 0xc6, 0x25, 0x87, 0x2f, 0xa7, 0x54, 0x62, 0x9d, 0xf0, 0x75, 0xc4, 0x33, 0xea,
+	0xdf, 0x16, 0xb7, 0x77, 0x2c, 0xa1, 0x0e, 0x91, 0x8e, 0x91, 0x2c, 0x4a, 0x7d, 0xbb, 0x6d, 0x93,
+	0xb1, 0x40, 0x93, 0x8c, 0x11, 0x6a, 0x72, 0xaf, 0xac, 0x9c, 0xd6, 0xa0, 0x18, 0x8b, 0x18, 0x49,
+	0x8e, 0x08, 0xb9, 0x01, 0x78, 0x4b, 0x45, 0x32, 0xc3, 0xe4, 0x92, 0x66, 0x26, 0xbf, 0x6b, 0xe5,
+	0x13, 0x2c, 0x24, 0x1a, 0x25, 0x9c, 0x5c, 0xa2, 0x98, 0xb2, 0x28, 0x96, 0xa6, 0xc6, 0xee, 0x92,
+	0xe7, 0x72, 0x19, 0xf2, 0xdc, 0x2a, 0x98, 0xe0, 0x0c, 0x8f, 0xcd, 0xf5, 0xdb, 0x2f, 0xac, 0x44,
+	0x86, 0x25, 0x45, 0x09, 0x1b, 0xb3, 0x05, 0xf6, 0x59, 0xc4, 0x23, 0xae, 0xc3, 0x40, 0x45, 0xe5,
+	0xe9, 0xee, 0xaf, 0x75, 0xd0, 0x3a, 0x28, 0xdf, 0xf0, 0xab, 0xc4, 0x92, 0xc2, 0x3e, 0x58, 0x2f,
+	0x99, 0xae, 0xd3, 0x71, 0xba, 0xcd, 0xfd, 0xd7, 0xfe, 0x7f, 0xdf, 0xd4, 0x3f, 0xd5, 0xc5, 0xbd,
+	0xfb, 0x57, 0x7f, 0x76, 0x1a, 0xa1, 0x91, 0xc2, 0x73, 0xb0, 0xc5, 0x73, 0x39, 0x2c, 0x86, 0x65,
+	0xdf, 0x03, 0x26, 0xa4, 0x7b, 0xaf, 0xb3, 0xd6, 0x6d, 0xee, 0xbf, 0xab, 0xc1, 0x9d, 0x58, 0x32,
+	0x03, 0x5d, 0x42, 0xc1, 0x63, 0xd0, 0x8a, 0xb0, 0x38, 0x55, 0xc3, 0xd1, 0xe8, 0x07, 0x1a, 0xfd,
+	0xa6, 0x06, 0x7d, 0x60, 0x24, 0x61, 0x45, 0x0c, 0xbf, 0x80, 0xc7, 0x7d, 0x55, 0xd4, 0x57, 0x45,
+	0xc3, 0x42, 0xb8, 0x0f, 0x57, 0x6a, 0xd4, 0xd6, 0x84, 0x55, 0x02, 0xfc, 0x0e, 0x9e, 0xaa, 0xe1,
+	0xf6, 0xd4, 0x6c, 0x0f, 0xf5, 0x68, 0x75, 0x9b, 0x8f, 0x34, 0xd8, 0xaf, 0x01, 0x0f, 0xaa, 0xca,
+	0xf0, 0x2e, 0x14, 0x24, 0x00, 0x2a, 0xab, 0x43, 0x2c, 0xe2, 0x21, 0xef, 0x13, 0x59, 0x68, 0x83,
+	0x0d, 0x6d, 0xb0, 0x57, 0x63, 0x70, 0x54, 0x11, 0x9a, 0x47, 0xbe, 0x03, 0x07, 0xcf, 0x95, 0x89,
+	0xf5, 0xfd, 0x50, 0xa2, 0x4c, 0x9a, 0xda, 0xe4, 0xed, 0x0a, 0x26, 0xd5, 0x31, 0x6e, 0xb2, 0xb4,
+	0x3a, 0xc5, 0x6f, 0x60, 0x53, 0x29, 0x11, 0x26, 0x84, 0xe7, 0xa9, 0x64, 0x69, 0xe4, 0xb6, 0x3a,
+	0xce, 0x0a, 0x17, 0x38, 0xa3, 0x12, 0x7f, 0xba, 0x11, 0x19, 0xfc, 0x93, 0x69, 0xe5, 0x14, 0xbe,
+	0x07, 0xdb, 0x9f, 0x59, 0x8a, 0x13, 0x36, 0xa5, 0x17, 0x47, 0xe9, 0x88, 0xe7, 0xe9, 0x85, 0x70,
+	0xb7, 0x3a, 0x6b, 0xdd, 0x8d, 0x70, 0x39, 0x01, 0x4f, 0x40, 0xf3, 0x76, 0x61, 0x84, 0xbb, 0xad,
+	0xef, 0xd8, 0xad, 0xe9, 0x23, 0xc4, 0x92, 0x0e, 0x94, 0xc0, 0xb4, 0x00, 0xb2, 0xc5, 0x81, 0xe8,
+	0x1d, 0x5f, 0xcd, 0x3c, 0xe7, 0x7a, 0xe6, 0x39, 0x7f, 0x67, 0x9e, 0xf3, 0x73, 0xee, 0x35, 0xae,
+	0xe7, 0x5e, 0xe3, 0xf7, 0xdc, 0x6b, 0x9c, 0x7d, 0x88, 0x98, 0x8c, 0xf3, 0x91, 0x4f, 0xf8, 0x38,
+	0x50, 0xd4, 0xbd, 0x72, 0x5f, 0x17, 0x06, 0x41, 0x11, 0x58, 0x5b, 0x2c, 0x7f, 0x4c, 0xa8, 0x18,
+	0xad, 0xeb, 0x5d, 0xfd, 0xf8, 0x6f, 0x00, 0xe5, 0x8c, 0x42, 0x69, 0xf9, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -195,6 +204,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if len(m.FinalizedInbounds) > 0 {
 		for iNdEx := len(m.FinalizedInbounds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.FinalizedInbounds[iNdEx])
@@ -376,6 +401,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -716,6 +747,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.FinalizedInbounds = append(m.FinalizedInbounds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
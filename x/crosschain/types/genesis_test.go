@@ -3,7 +3,9 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 )
 
@@ -78,6 +80,29 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated rateLimit",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					sample.RateLimit(t, "0"),
+					sample.RateLimit(t, "0"),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid rateLimit",
+			genState: &types.GenesisState{
+				RateLimits: []types.RateLimit{
+					{
+						ChainId: 1,
+						Limit:   math.NewUint(100),
+						Window:  0,
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
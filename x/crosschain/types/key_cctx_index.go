@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	// CctxSenderIndexKeyPrefix is the prefix to retrieve CCTX indexes by inbound sender or tx origin
+	CctxSenderIndexKeyPrefix = "CctxSenderIndex/value/"
+
+	// CctxReceiverIndexKeyPrefix is the prefix to retrieve CCTX indexes by receiver of the original outbound
+	CctxReceiverIndexKeyPrefix = "CctxReceiverIndex/value/"
+
+	// CctxStatusIndexKeyPrefix is the prefix to retrieve CCTX indexes by status
+	CctxStatusIndexKeyPrefix = "CctxStatusIndex/value/"
+
+	// CctxSenderChainIndexKeyPrefix is the prefix to retrieve CCTX indexes by sender chain ID
+	CctxSenderChainIndexKeyPrefix = "CctxSenderChainIndex/value/"
+
+	// CctxReceiverChainIndexKeyPrefix is the prefix to retrieve CCTX indexes by receiver chain ID
+	CctxReceiverChainIndexKeyPrefix = "CctxReceiverChainIndex/value/"
+)
+
+// CctxIndexFieldKey returns the store key prefix of all the CCTX index entries for a field value
+func CctxIndexFieldKey(field string) []byte {
+	var key []byte
+
+	key = append(key, []byte(field)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// CctxIndexKey returns the store key of a CCTX index entry
+// the key is composed of the indexed field value, the zeta height at which the CCTX was created and the CCTX index
+// the height is big endian encoded so entries of a field value are ordered by height
+func CctxIndexKey(field string, zetaHeight uint64, cctxIndex string) []byte {
+	key := CctxIndexFieldKey(field)
+
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, zetaHeight)
+	key = append(key, heightBytes...)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(cctxIndex)...)
+
+	return key
+}
+
+// ParseCctxIndexKey returns the zeta height and the CCTX index from a CCTX index entry key
+// the key must be relative to the field value prefix returned by CctxIndexFieldKey
+func ParseCctxIndexKey(key []byte) (uint64, string, error) {
+	if len(key) < 9 || key[8] != '/' {
+		return 0, "", errors.New("invalid cctx index key")
+	}
+	return binary.BigEndian.Uint64(key[:8]), string(key[9:]), nil
+}
+
+// CctxIndexAddress returns the normalized address used as field value in the sender and receiver indexes
+func CctxIndexAddress(address string) string {
+	return strings.ToLower(address)
+}
+
+// CctxIndexChainID returns the chain ID used as field value in the chain indexes
+func CctxIndexChainID(chainID int64) string {
+	return fmt.Sprintf("%d", chainID)
+}
+
+// CctxIndexStatus returns the status used as field value in the status index
+func CctxIndexStatus(status CctxStatus) string {
+	return status.String()
+}
+
+// CctxIndexHeightRange returns the start and end keys bounding the CCTX index entries created in a zeta height range
+// the keys are relative to the field value prefix returned by CctxIndexFieldKey, the end key is exclusive
+// a max height of 0 means no upper bound and a nil end key is returned
+func CctxIndexHeightRange(minHeight, maxHeight uint64) (start, end []byte) {
+	start = make([]byte, 8)
+	binary.BigEndian.PutUint64(start, minHeight)
+	if maxHeight == 0 || maxHeight == math.MaxUint64 {
+		return start, nil
+	}
+	end = make([]byte, 8)
+	binary.BigEndian.PutUint64(end, maxHeight+1)
+	return start, end
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestParseCctxIndexKey(t *testing.T) {
+	t.Run("should parse the key relative to the field prefix", func(t *testing.T) {
+		field := "0xabcdef"
+		key := types.CctxIndexKey(field, 42, "0x1234")
+		prefix := types.CctxIndexFieldKey(field)
+		require.Equal(t, prefix, key[:len(prefix)])
+
+		height, index, err := types.ParseCctxIndexKey(key[len(prefix):])
+		require.NoError(t, err)
+		require.EqualValues(t, 42, height)
+		require.Equal(t, "0x1234", index)
+	})
+
+	t.Run("should order keys by height", func(t *testing.T) {
+		require.Less(t, string(types.CctxIndexKey("foo", 255, "b")), string(types.CctxIndexKey("foo", 256, "a")))
+	})
+
+	t.Run("should fail for invalid key", func(t *testing.T) {
+		_, _, err := types.ParseCctxIndexKey([]byte("foo"))
+		require.Error(t, err)
+	})
+}
+
+func TestCctxIndexHeightRange(t *testing.T) {
+	t.Run("should bound the keys of the height range", func(t *testing.T) {
+		start, end := types.CctxIndexHeightRange(10, 20)
+		prefix := types.CctxIndexFieldKey("foo")
+
+		key := func(height uint64) string {
+			return string(types.CctxIndexKey("foo", height, "0x1234")[len(prefix):])
+		}
+		require.Less(t, key(9), string(start))
+		require.LessOrEqual(t, string(start), key(10))
+		require.Less(t, key(20), string(end))
+		require.LessOrEqual(t, string(end), key(21))
+	})
+
+	t.Run("should return no end key without max height", func(t *testing.T) {
+		_, end := types.CctxIndexHeightRange(10, 0)
+		require.Nil(t, end)
+		_, end = types.CctxIndexHeightRange(10, math.MaxUint64)
+		require.Nil(t, end)
+	})
+}
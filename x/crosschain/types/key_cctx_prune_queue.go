@@ -0,0 +1,38 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+const (
+	// CctxPruneQueueKeyPrefix is the prefix to retrieve the CCTXs in a terminal state, ordered by the height they reached it
+	CctxPruneQueueKeyPrefix = "CctxPruneQueue/value/"
+)
+
+// CctxPruneQueueKey returns the store key of a CCTX in the prune queue
+// the height is big endian encoded so entries are ordered by the zeta height at which the CCTX became prunable
+func CctxPruneQueueKey(zetaHeight uint64, cctxIndex string) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, zetaHeight)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(cctxIndex)...)
+
+	return key
+}
+
+// CctxPruneQueueHeightKey returns the store key prefix of the CCTXs in the prune queue for a zeta height
+func CctxPruneQueueHeightKey(zetaHeight uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, zetaHeight)
+
+	return key
+}
+
+// ParseCctxPruneQueueKey returns the zeta height and the CCTX index from a prune queue entry key
+func ParseCctxPruneQueueKey(key []byte) (uint64, string, error) {
+	if len(key) < 9 || key[8] != '/' {
+		return 0, "", errors.New("invalid cctx prune queue key")
+	}
+	return binary.BigEndian.Uint64(key[:8]), string(key[9:]), nil
+}
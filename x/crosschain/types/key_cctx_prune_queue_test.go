@@ -0,0 +1,28 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestParseCctxPruneQueueKey(t *testing.T) {
+	t.Run("should parse the key", func(t *testing.T) {
+		height, index, err := types.ParseCctxPruneQueueKey(types.CctxPruneQueueKey(42, "0x1234"))
+		require.NoError(t, err)
+		require.EqualValues(t, 42, height)
+		require.Equal(t, "0x1234", index)
+	})
+
+	t.Run("should order keys by height", func(t *testing.T) {
+		require.Less(t, string(types.CctxPruneQueueKey(255, "b")), string(types.CctxPruneQueueKey(256, "a")))
+		require.Less(t, string(types.CctxPruneQueueKey(255, "b")), string(types.CctxPruneQueueHeightKey(256)))
+		require.Less(t, string(types.CctxPruneQueueHeightKey(256)), string(types.CctxPruneQueueKey(256, "a")))
+	})
+
+	t.Run("should fail for invalid key", func(t *testing.T) {
+		_, _, err := types.ParseCctxPruneQueueKey([]byte("foo"))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+const (
+	// RateLimitKeyPrefix is the prefix to retrieve the outbound rate limits
+	RateLimitKeyPrefix = "RateLimit/value/"
+
+	// RateLimitUsageKeyPrefix is the prefix to retrieve the outbound value recorded per zeta height for a rate limit
+	RateLimitUsageKeyPrefix = "RateLimitUsage/value/"
+
+	// RateLimitReleaseCursorKey is the key of the last entry of the rate limited status index scanned for release
+	RateLimitReleaseCursorKey = "RateLimitReleaseCursor/value/"
+
+	// RateLimitReleaseBlockedKeyPrefix is the prefix to retrieve the rate limits blocked in the current release scan
+	RateLimitReleaseBlockedKeyPrefix = "RateLimitReleaseBlocked/value/"
+)
+
+// RateLimitKey returns the store key to retrieve a rate limit from its receiver chain and ZRC20 address
+// the ZRC20 address is lowercased and empty for ZETA
+func RateLimitKey(chainID int64, zrc20Address string) []byte {
+	var key []byte
+
+	key = append(key, []byte(fmt.Sprintf("%d/%s", chainID, strings.ToLower(zrc20Address)))...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// RateLimitUsageHeightKey returns the key of the outbound value recorded at a zeta height
+// the key is relative to the usage prefix of a rate limit returned by RateLimitKey
+// the height is big endian encoded so the usage entries of a rate limit are ordered by height
+func RateLimitUsageHeightKey(zetaHeight uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, zetaHeight)
+	return key
+}
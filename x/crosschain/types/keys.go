@@ -52,6 +52,9 @@ const (
 	// #nosec G101: Potential hardcoded credentials (gosec)
 	// ZetaAccountingKey value is used as prefix for storing ZetaAccountingKey
 	ZetaAccountingKey = "ZetaAccounting-value-"
+
+	// CctxExpiryCursorKey is the key of the last entry of the pending outbound status index scanned for expired cctxs
+	CctxExpiryCursorKey = "CctxExpiryCursor-value-"
 )
 
 // OutTxTrackerKey returns the store key to retrieve a OutTxTracker from the index fields
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const TypeMsgClaimAbortedCCTXRefund = "ClaimAbortedCCTXRefund"
+
+var _ sdk.Msg = &MsgClaimAbortedCCTXRefund{}
+
+func NewMsgClaimAbortedCCTXRefund(creator string, cctxIndex string, refundAddress string) *MsgClaimAbortedCCTXRefund {
+	return &MsgClaimAbortedCCTXRefund{
+		Creator:       creator,
+		CctxIndex:     cctxIndex,
+		RefundAddress: refundAddress,
+	}
+}
+
+func (msg *MsgClaimAbortedCCTXRefund) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaimAbortedCCTXRefund) Type() string {
+	return TypeMsgClaimAbortedCCTXRefund
+}
+
+func (msg *MsgClaimAbortedCCTXRefund) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgClaimAbortedCCTXRefund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgClaimAbortedCCTXRefund) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.CctxIndex) != 66 {
+		return ErrInvalidCCTXIndex
+	}
+	if msg.RefundAddress != "" && !ethcommon.IsHexAddress(msg.RefundAddress) {
+		return ErrInvalidAddress
+	}
+	return nil
+}
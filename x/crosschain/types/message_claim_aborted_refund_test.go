@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestNewMsgClaimAbortedCCTXRefund(t *testing.T) {
+	t.Run("successfully validate message", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "test")
+		msg := types.NewMsgClaimAbortedCCTXRefund(sample.AccAddress(), cctx.Index, "")
+		require.NoError(t, msg.ValidateBasic())
+	})
+	t.Run("successfully validate message with refund address", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "test")
+		msg := types.NewMsgClaimAbortedCCTXRefund(sample.AccAddress(), cctx.Index, sample.EthAddress().String())
+		require.NoError(t, msg.ValidateBasic())
+	})
+	t.Run("invalid creator address", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "test")
+		msg := types.NewMsgClaimAbortedCCTXRefund("invalid", cctx.Index, "")
+		require.ErrorContains(t, msg.ValidateBasic(), "invalid creator address")
+	})
+	t.Run("invalid cctx index", func(t *testing.T) {
+		msg := types.NewMsgClaimAbortedCCTXRefund(sample.AccAddress(), "invalid", "")
+		require.ErrorContains(t, msg.ValidateBasic(), "invalid cctx index")
+	})
+	t.Run("invalid refund address", func(t *testing.T) {
+		cctx := sample.CrossChainTx(t, "test")
+		msg := types.NewMsgClaimAbortedCCTXRefund(sample.AccAddress(), cctx.Index, "invalid")
+		require.ErrorContains(t, msg.ValidateBasic(), "invalid address")
+	})
+}
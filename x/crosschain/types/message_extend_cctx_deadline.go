@@ -0,0 +1,51 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgExtendCctxDeadline = "ExtendCctxDeadline"
+
+var _ sdk.Msg = &MsgExtendCctxDeadline{}
+
+func NewMsgExtendCctxDeadline(creator string, cctxIndex string, extensionBlocks uint64) *MsgExtendCctxDeadline {
+	return &MsgExtendCctxDeadline{
+		Creator:         creator,
+		CctxIndex:       cctxIndex,
+		ExtensionBlocks: extensionBlocks,
+	}
+}
+
+func (msg *MsgExtendCctxDeadline) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgExtendCctxDeadline) Type() string {
+	return TypeMsgExtendCctxDeadline
+}
+
+func (msg *MsgExtendCctxDeadline) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgExtendCctxDeadline) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgExtendCctxDeadline) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ExtensionBlocks == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "extension blocks must be greater than 0")
+	}
+	return nil
+}
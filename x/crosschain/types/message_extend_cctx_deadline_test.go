@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgExtendCctxDeadline_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgExtendCctxDeadline
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgExtendCctxDeadline("invalid_address", "cctx_index", 100),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "no extension",
+			msg:  types.NewMsgExtendCctxDeadline(sample.AccAddress(), "cctx_index", 0),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgExtendCctxDeadline(sample.AccAddress(), "cctx_index", 100),
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+const TypeMsgRemoveRateLimit = "RemoveRateLimit"
+
+var _ sdk.Msg = &MsgRemoveRateLimit{}
+
+func NewMsgRemoveRateLimit(creator string, chainID int64, zrc20Address string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Creator:      creator,
+		ChainId:      chainID,
+		Zrc20Address: zrc20Address,
+	}
+}
+
+func (msg *MsgRemoveRateLimit) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveRateLimit) Type() string {
+	return TypeMsgRemoveRateLimit
+}
+
+func (msg *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRemoveRateLimit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveRateLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.ChainId <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid chain id %d", msg.ChainId)
+	}
+	if msg.Zrc20Address != "" && !ethcommon.IsHexAddress(msg.Zrc20Address) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid zrc20 address %s", msg.Zrc20Address)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgRemoveRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgRemoveRateLimit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgRemoveRateLimit("invalid_address", 1, sample.EthAddress().Hex()),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain id",
+			msg:  types.NewMsgRemoveRateLimit(sample.AccAddress(), 0, sample.EthAddress().Hex()),
+			err:  types.ErrInvalidRateLimit,
+		},
+		{
+			name: "invalid zrc20 address",
+			msg:  types.NewMsgRemoveRateLimit(sample.AccAddress(), 1, "invalid"),
+			err:  types.ErrInvalidRateLimit,
+		},
+		{
+			name: "valid for ZETA",
+			msg:  types.NewMsgRemoveRateLimit(sample.AccAddress(), 1, ""),
+			err:  nil,
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgRemoveRateLimit(sample.AccAddress(), 1, sample.EthAddress().Hex()),
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestMsgUpdateRateLimit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateRateLimit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateRateLimit("invalid_address", sample.RateLimit(t, "foo")),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid rate limit",
+			msg:  types.NewMsgUpdateRateLimit(sample.AccAddress(), types.RateLimit{}),
+			err:  types.ErrInvalidRateLimit,
+		},
+		{
+			name: "valid",
+			msg:  types.NewMsgUpdateRateLimit(sample.AccAddress(), sample.RateLimit(t, "foo")),
+			err:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -10,10 +10,12 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	common "github.com/zeta-chain/zetacore/common"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -1764,21 +1766,34 @@ func (m *QueryListCctxPendingResponse) GetTotalPending() uint64 {
 	return 0
 }
 
-type QueryLastZetaHeightRequest struct {
-}
-
-func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
-func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
-func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
+type QueryListCctxFilteredRequest struct {
+	// sender matches the inbound sender or the inbound tx origin
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// receiver matches the receiver of the original outbound
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// status matches any of the provided statuses
+	Status          []CctxStatus `protobuf:"varint,3,rep,packed,name=status,proto3,enum=zetachain.zetacore.crosschain.CctxStatus" json:"status,omitempty"`
+	SenderChainId   int64        `protobuf:"varint,4,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	ReceiverChainId int64        `protobuf:"varint,5,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	// min_height and max_height define an inclusive range of zeta heights at which the cctx has been created
+	// a zero value means no bound
+	MinHeight  uint64             `protobuf:"varint,6,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight  uint64             `protobuf:"varint,7,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCctxFilteredRequest) Reset()         { *m = QueryListCctxFilteredRequest{} }
+func (m *QueryListCctxFilteredRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListCctxFilteredRequest) ProtoMessage()    {}
+func (*QueryListCctxFilteredRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{37}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListCctxFilteredRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListCctxFilteredRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListCctxFilteredRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1788,34 +1803,91 @@ func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
+func (m *QueryListCctxFilteredRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCctxFilteredRequest.Merge(m, src)
 }
-func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+func (m *QueryListCctxFilteredRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
+func (m *QueryListCctxFilteredRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCctxFilteredRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListCctxFilteredRequest proto.InternalMessageInfo
 
-type QueryLastZetaHeightResponse struct {
-	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
+func (m *QueryListCctxFilteredRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
-func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
-func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
+func (m *QueryListCctxFilteredRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryListCctxFilteredRequest) GetStatus() []CctxStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryListCctxFilteredRequest) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
+	}
+	return 0
+}
+
+func (m *QueryListCctxFilteredRequest) GetReceiverChainId() int64 {
+	if m != nil {
+		return m.ReceiverChainId
+	}
+	return 0
+}
+
+func (m *QueryListCctxFilteredRequest) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *QueryListCctxFilteredRequest) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+func (m *QueryListCctxFilteredRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryListCctxFilteredResponse struct {
+	CrossChainTx []*CrossChainTx     `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCctxFilteredResponse) Reset()         { *m = QueryListCctxFilteredResponse{} }
+func (m *QueryListCctxFilteredResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListCctxFilteredResponse) ProtoMessage()    {}
+func (*QueryListCctxFilteredResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{38}
 }
-func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListCctxFilteredResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListCctxFilteredResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLastZetaHeightResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListCctxFilteredResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1825,42 +1897,52 @@ func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryLastZetaHeightResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLastZetaHeightResponse.Merge(m, src)
+func (m *QueryListCctxFilteredResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCctxFilteredResponse.Merge(m, src)
 }
-func (m *QueryLastZetaHeightResponse) XXX_Size() int {
+func (m *QueryListCctxFilteredResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLastZetaHeightResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLastZetaHeightResponse.DiscardUnknown(m)
+func (m *QueryListCctxFilteredResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCctxFilteredResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLastZetaHeightResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryListCctxFilteredResponse proto.InternalMessageInfo
 
-func (m *QueryLastZetaHeightResponse) GetHeight() int64 {
+func (m *QueryListCctxFilteredResponse) GetCrossChainTx() []*CrossChainTx {
 	if m != nil {
-		return m.Height
+		return m.CrossChainTx
 	}
-	return 0
+	return nil
 }
 
-type QueryConvertGasToZetaRequest struct {
-	ChainId  int64  `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
-	GasLimit string `protobuf:"bytes,2,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+func (m *QueryListCctxFilteredResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZetaRequest{} }
-func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
-func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
+type QueryListCctxPrunableRequest struct {
+	// min_height and max_height define an inclusive range of zeta heights at which the cctx became prunable
+	// a zero value means no bound
+	MinHeight  uint64             `protobuf:"varint,1,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight  uint64             `protobuf:"varint,2,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCctxPrunableRequest) Reset()         { *m = QueryListCctxPrunableRequest{} }
+func (m *QueryListCctxPrunableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListCctxPrunableRequest) ProtoMessage()    {}
+func (*QueryListCctxPrunableRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{39}
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryListCctxPrunableRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListCctxPrunableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConvertGasToZetaRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListCctxPrunableRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1870,50 +1952,56 @@ func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConvertGasToZetaRequest.Merge(m, src)
+func (m *QueryListCctxPrunableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCctxPrunableRequest.Merge(m, src)
 }
-func (m *QueryConvertGasToZetaRequest) XXX_Size() int {
+func (m *QueryListCctxPrunableRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConvertGasToZetaRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConvertGasToZetaRequest.DiscardUnknown(m)
+func (m *QueryListCctxPrunableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCctxPrunableRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConvertGasToZetaRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryListCctxPrunableRequest proto.InternalMessageInfo
 
-func (m *QueryConvertGasToZetaRequest) GetChainId() int64 {
+func (m *QueryListCctxPrunableRequest) GetMinHeight() uint64 {
 	if m != nil {
-		return m.ChainId
+		return m.MinHeight
 	}
 	return 0
 }
 
-func (m *QueryConvertGasToZetaRequest) GetGasLimit() string {
+func (m *QueryListCctxPrunableRequest) GetMaxHeight() uint64 {
 	if m != nil {
-		return m.GasLimit
+		return m.MaxHeight
 	}
-	return ""
+	return 0
 }
 
-type QueryConvertGasToZetaResponse struct {
-	OutboundGasInZeta string `protobuf:"bytes,1,opt,name=outboundGasInZeta,proto3" json:"outboundGasInZeta,omitempty"`
-	ProtocolFeeInZeta string `protobuf:"bytes,2,opt,name=protocolFeeInZeta,proto3" json:"protocolFeeInZeta,omitempty"`
-	ZetaBlockHeight   uint64 `protobuf:"varint,3,opt,name=ZetaBlockHeight,proto3" json:"ZetaBlockHeight,omitempty"`
+func (m *QueryListCctxPrunableRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasToZetaResponse{} }
-func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
-func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
+type QueryListCctxPrunableResponse struct {
+	CrossChainTx []*CrossChainTx     `protobuf:"bytes,1,rep,name=CrossChainTx,proto3" json:"CrossChainTx,omitempty"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListCctxPrunableResponse) Reset()         { *m = QueryListCctxPrunableResponse{} }
+func (m *QueryListCctxPrunableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListCctxPrunableResponse) ProtoMessage()    {}
+func (*QueryListCctxPrunableResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{40}
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryListCctxPrunableResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryListCctxPrunableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryConvertGasToZetaResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryListCctxPrunableResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1923,54 +2011,48 @@ func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryConvertGasToZetaResponse.Merge(m, src)
+func (m *QueryListCctxPrunableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListCctxPrunableResponse.Merge(m, src)
 }
-func (m *QueryConvertGasToZetaResponse) XXX_Size() int {
+func (m *QueryListCctxPrunableResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryConvertGasToZetaResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryConvertGasToZetaResponse.DiscardUnknown(m)
+func (m *QueryListCctxPrunableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListCctxPrunableResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryConvertGasToZetaResponse proto.InternalMessageInfo
-
-func (m *QueryConvertGasToZetaResponse) GetOutboundGasInZeta() string {
-	if m != nil {
-		return m.OutboundGasInZeta
-	}
-	return ""
-}
+var xxx_messageInfo_QueryListCctxPrunableResponse proto.InternalMessageInfo
 
-func (m *QueryConvertGasToZetaResponse) GetProtocolFeeInZeta() string {
+func (m *QueryListCctxPrunableResponse) GetCrossChainTx() []*CrossChainTx {
 	if m != nil {
-		return m.ProtocolFeeInZeta
+		return m.CrossChainTx
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryConvertGasToZetaResponse) GetZetaBlockHeight() uint64 {
+func (m *QueryListCctxPrunableResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.ZetaBlockHeight
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-type QueryMessagePassingProtocolFeeRequest struct {
+type QueryAllRateLimitRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMessagePassingProtocolFeeRequest{} }
-func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
-func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAllRateLimitRequest) Reset()         { *m = QueryAllRateLimitRequest{} }
+func (m *QueryAllRateLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitRequest) ProtoMessage()    {}
+func (*QueryAllRateLimitRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{41}
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllRateLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllRateLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllRateLimitRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1980,36 +2062,42 @@ func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Merge(m, src)
+func (m *QueryAllRateLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitRequest.Merge(m, src)
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_Size() int {
+func (m *QueryAllRateLimitRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMessagePassingProtocolFeeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.DiscardUnknown(m)
+func (m *QueryAllRateLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMessagePassingProtocolFeeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllRateLimitRequest proto.InternalMessageInfo
 
-type QueryMessagePassingProtocolFeeResponse struct {
-	FeeInZeta string `protobuf:"bytes,1,opt,name=feeInZeta,proto3" json:"feeInZeta,omitempty"`
+func (m *QueryAllRateLimitRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
-	*m = QueryMessagePassingProtocolFeeResponse{}
+type QueryAllRateLimitResponse struct {
+	RateLimits []RateLimit         `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
-func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
+
+func (m *QueryAllRateLimitResponse) Reset()         { *m = QueryAllRateLimitResponse{} }
+func (m *QueryAllRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllRateLimitResponse) ProtoMessage()    {}
+func (*QueryAllRateLimitResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_65a992045e92a606, []int{42}
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllRateLimitResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2019,1815 +2107,1834 @@ func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Merge(m, src)
+func (m *QueryAllRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllRateLimitResponse.Merge(m, src)
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_Size() int {
+func (m *QueryAllRateLimitResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryMessagePassingProtocolFeeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.DiscardUnknown(m)
+func (m *QueryAllRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllRateLimitResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryMessagePassingProtocolFeeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllRateLimitResponse proto.InternalMessageInfo
 
-func (m *QueryMessagePassingProtocolFeeResponse) GetFeeInZeta() string {
+func (m *QueryAllRateLimitResponse) GetRateLimits() []RateLimit {
 	if m != nil {
-		return m.FeeInZeta
+		return m.RateLimits
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressRequest")
-	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressResponse")
-	proto.RegisterType((*QueryZetaAccountingRequest)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingRequest")
-	proto.RegisterType((*QueryZetaAccountingResponse)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.crosschain.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.crosschain.QueryParamsResponse")
-	proto.RegisterType((*QueryGetOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerRequest")
-	proto.RegisterType((*QueryGetOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerResponse")
-	proto.RegisterType((*QueryAllOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerRequest")
-	proto.RegisterType((*QueryAllOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerResponse")
-	proto.RegisterType((*QueryAllOutTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainRequest")
-	proto.RegisterType((*QueryAllOutTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainResponse")
-	proto.RegisterType((*QueryAllInTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainRequest")
-	proto.RegisterType((*QueryAllInTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainResponse")
-	proto.RegisterType((*QueryAllInTxTrackersRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersRequest")
-	proto.RegisterType((*QueryAllInTxTrackersResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersResponse")
-	proto.RegisterType((*QueryGetInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxRequest")
-	proto.RegisterType((*QueryGetInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxResponse")
-	proto.RegisterType((*QueryInTxHashToCctxDataRequest)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataRequest")
-	proto.RegisterType((*QueryInTxHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataResponse")
-	proto.RegisterType((*QueryAllInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxRequest")
-	proto.RegisterType((*QueryAllInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxResponse")
-	proto.RegisterType((*QueryGetGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceRequest")
-	proto.RegisterType((*QueryGetGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceResponse")
-	proto.RegisterType((*QueryAllGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceRequest")
-	proto.RegisterType((*QueryAllGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceResponse")
-	proto.RegisterType((*QueryGetLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightRequest")
-	proto.RegisterType((*QueryGetLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightResponse")
-	proto.RegisterType((*QueryAllLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightRequest")
-	proto.RegisterType((*QueryAllLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightResponse")
-	proto.RegisterType((*QueryGetCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxRequest")
-	proto.RegisterType((*QueryGetCctxByNonceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxByNonceRequest")
-	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
-	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
-	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
-	proto.RegisterType((*QueryListCctxPendingRequest)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingRequest")
-	proto.RegisterType((*QueryListCctxPendingResponse)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingResponse")
-	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
-	proto.RegisterType((*QueryLastZetaHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightResponse")
-	proto.RegisterType((*QueryConvertGasToZetaRequest)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaRequest")
-	proto.RegisterType((*QueryConvertGasToZetaResponse)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaResponse")
-	proto.RegisterType((*QueryMessagePassingProtocolFeeRequest)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeRequest")
-	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
+func (m *QueryAllRateLimitResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("crosschain/query.proto", fileDescriptor_65a992045e92a606) }
-
-var fileDescriptor_65a992045e92a606 = []byte{
-	// 1860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0x14, 0xc9,
-	0x15, 0x77, 0x79, 0xb0, 0xd7, 0x94, 0xcd, 0x7a, 0xa9, 0x75, 0x58, 0x6f, 0x63, 0x8f, 0x77, 0xdb,
-	0x31, 0x26, 0x10, 0xcf, 0xac, 0xbd, 0x60, 0xbe, 0x4c, 0xc4, 0xd8, 0x04, 0x83, 0x62, 0xc0, 0x19,
-	0x39, 0x4a, 0x44, 0x14, 0x8d, 0x6a, 0x7a, 0x2a, 0x33, 0x2d, 0xda, 0xdd, 0x66, 0xaa, 0x06, 0xd9,
-	0x58, 0xbe, 0x70, 0xc8, 0x39, 0x12, 0x52, 0x72, 0xc9, 0x35, 0x4a, 0x0e, 0x39, 0xe4, 0x80, 0x92,
-	0x43, 0x24, 0xa2, 0x7c, 0x11, 0x8e, 0x48, 0x91, 0xa2, 0x28, 0x91, 0xa2, 0x08, 0xf2, 0x87, 0xac,
-	0xba, 0xfa, 0xf5, 0x4c, 0x75, 0x4f, 0xf7, 0x4c, 0x79, 0x3c, 0x1c, 0x38, 0x31, 0x5d, 0x55, 0xef,
-	0xbd, 0xdf, 0xef, 0x57, 0x5f, 0xaf, 0x1e, 0xc6, 0xa7, 0xac, 0xba, 0xc7, 0xb9, 0x55, 0xa3, 0xb6,
-	0x9b, 0x7f, 0xd4, 0x60, 0xf5, 0xbd, 0xdc, 0x4e, 0xdd, 0x13, 0x1e, 0x99, 0x7e, 0xc2, 0x04, 0x95,
-	0xcd, 0x39, 0xf9, 0xcb, 0xab, 0xb3, 0x5c, 0x6b, 0xa8, 0x71, 0xce, 0xf2, 0xf8, 0xb6, 0xc7, 0xf3,
-	0x65, 0xca, 0x59, 0x60, 0x97, 0x7f, 0xbc, 0x58, 0x66, 0x82, 0x2e, 0xe6, 0x77, 0x68, 0xd5, 0x76,
-	0xa9, 0xb0, 0x3d, 0x37, 0x70, 0x65, 0xcc, 0x28, 0x21, 0xe4, 0xcf, 0x92, 0xfc, 0x5d, 0x12, 0xbb,
-	0x30, 0xc0, 0x50, 0x06, 0x54, 0x29, 0x2f, 0xed, 0xd4, 0x6d, 0x8b, 0x41, 0xdf, 0xac, 0xd2, 0x27,
-	0x6d, 0x4a, 0x35, 0xca, 0x6b, 0x25, 0xe1, 0x95, 0x2c, 0xab, 0xe9, 0x20, 0xdb, 0x36, 0x48, 0xd4,
-	0xa9, 0xf5, 0x90, 0xd5, 0xa1, 0xdf, 0x54, 0xfa, 0x1d, 0xca, 0x45, 0xa9, 0xec, 0x78, 0xd6, 0xc3,
-	0x52, 0x8d, 0xd9, 0xd5, 0x9a, 0x48, 0x40, 0xe9, 0x35, 0x44, 0xbb, 0x93, 0x4f, 0x94, 0x01, 0x3b,
-	0xb4, 0x4e, 0xb7, 0x39, 0x74, 0x4c, 0x54, 0xbd, 0xaa, 0x27, 0x7f, 0xe6, 0xfd, 0x5f, 0xd0, 0x3a,
-	0x55, 0xf5, 0xbc, 0xaa, 0xc3, 0xf2, 0x74, 0xc7, 0xce, 0x53, 0xd7, 0xf5, 0x84, 0x94, 0x04, 0x6c,
-	0xcc, 0xd3, 0xf8, 0xd3, 0xef, 0xfa, 0xaa, 0xad, 0x33, 0xb1, 0xc5, 0x79, 0xa1, 0x52, 0xa9, 0x33,
-	0xce, 0x8b, 0xec, 0x51, 0x83, 0x71, 0x61, 0xde, 0xc0, 0x46, 0x52, 0x27, 0xdf, 0xf1, 0x5c, 0xce,
-	0xc8, 0x47, 0x38, 0xc3, 0x44, 0x6d, 0x12, 0x7d, 0x86, 0xce, 0x1e, 0x2f, 0xfa, 0x3f, 0xfd, 0x96,
-	0xb2, 0xb0, 0x26, 0x07, 0x83, 0x96, 0xb2, 0xb0, 0xcc, 0x29, 0xf0, 0xf0, 0x80, 0x09, 0x5a, 0xb0,
-	0x2c, 0xaf, 0xe1, 0x0a, 0xdb, 0xad, 0x86, 0xfe, 0xef, 0xe2, 0xd3, 0x89, 0xbd, 0x10, 0x20, 0x87,
-	0x3f, 0xa6, 0x65, 0xaf, 0x2e, 0x58, 0xa5, 0xe4, 0x4f, 0x7d, 0x89, 0x6e, 0xfb, 0x23, 0x20, 0xe0,
-	0x49, 0xe8, 0x92, 0xb6, 0xb2, 0xc3, 0x9c, 0xc0, 0x44, 0xba, 0xdb, 0x94, 0xa2, 0x84, 0x41, 0x1e,
-	0xe0, 0x8f, 0x23, 0xad, 0xe0, 0x7c, 0x0d, 0x0f, 0x07, 0xe2, 0x49, 0x7f, 0xa3, 0x4b, 0x73, 0xb9,
-	0x8e, 0x0b, 0x2d, 0x17, 0x98, 0xaf, 0x1e, 0x7b, 0xf5, 0xdf, 0x99, 0x81, 0x22, 0x98, 0x36, 0x09,
-	0xac, 0x33, 0x71, 0xbf, 0x21, 0xb6, 0x76, 0xb7, 0x82, 0x89, 0x82, 0xd0, 0x64, 0x12, 0x7f, 0x20,
-	0x8d, 0xef, 0xdc, 0x94, 0x41, 0x32, 0xc5, 0xf0, 0x93, 0x4c, 0xe0, 0x21, 0xd7, 0x73, 0x2d, 0x26,
-	0xb5, 0x3a, 0x56, 0x0c, 0x3e, 0xcc, 0x06, 0x9e, 0x4a, 0x76, 0x07, 0x98, 0xbf, 0x87, 0xc7, 0x3c,
-	0xa5, 0x1d, 0x90, 0x9f, 0xef, 0x82, 0x5c, 0x75, 0x05, 0xf8, 0x23, 0x6e, 0x4c, 0x06, 0x2c, 0x0a,
-	0x8e, 0x93, 0xc4, 0xe2, 0x16, 0xc6, 0xad, 0xad, 0x04, 0x31, 0xcf, 0xe4, 0x82, 0x7d, 0x97, 0xf3,
-	0xf7, 0x5d, 0x2e, 0xd8, 0xaf, 0xb0, 0xef, 0x72, 0x9b, 0xb4, 0xca, 0xc0, 0xb6, 0xa8, 0x58, 0x9a,
-	0x2f, 0x10, 0xd0, 0x6b, 0x8b, 0x93, 0x4a, 0x2f, 0xd3, 0x07, 0x7a, 0x64, 0x3d, 0x82, 0x7f, 0x50,
-	0xe2, 0x9f, 0xef, 0x8a, 0x3f, 0xc0, 0x14, 0x21, 0xf0, 0x14, 0x61, 0x33, 0x89, 0xc0, 0xea, 0xde,
-	0x9a, 0x8f, 0x24, 0xd4, 0x6b, 0x02, 0x0f, 0x49, 0x64, 0x30, 0xe7, 0xc1, 0x47, 0x4c, 0xc5, 0xc1,
-	0x9e, 0x55, 0xfc, 0x1b, 0xc2, 0xb3, 0x1d, 0x41, 0xbc, 0x27, 0x62, 0xfe, 0x04, 0xe1, 0xcf, 0x43,
-	0x1e, 0x77, 0xdc, 0x34, 0x2d, 0x3f, 0xc5, 0x23, 0xc1, 0x19, 0x6d, 0x57, 0xa2, 0x5b, 0xa8, 0xd2,
-	0x37, 0x41, 0xff, 0xa4, 0xcc, 0x6a, 0x12, 0x10, 0xd0, 0xb3, 0x88, 0x47, 0x6d, 0x37, 0x2e, 0xe7,
-	0xb9, 0x2e, 0x72, 0xaa, 0xfe, 0x02, 0x35, 0x55, 0x27, 0xfd, 0x13, 0x53, 0xd9, 0xc1, 0x4a, 0x48,
-	0xde, 0xef, 0x1d, 0xfc, 0x07, 0x65, 0x07, 0x47, 0xe3, 0xbc, 0x0f, 0x22, 0x5d, 0xc3, 0xd3, 0xe1,
-	0xe9, 0xea, 0x87, 0xbc, 0x4d, 0x79, 0x6d, 0xcb, 0x5b, 0xb3, 0xc4, 0x6e, 0x28, 0x93, 0x81, 0x47,
-	0x6c, 0xe8, 0x80, 0x4b, 0xa6, 0xf9, 0x6d, 0x1e, 0xe0, 0x6c, 0x9a, 0x31, 0x70, 0xff, 0x21, 0xfe,
-	0xd0, 0x8e, 0xf4, 0x80, 0xd0, 0x0b, 0x1a, 0xf4, 0x5b, 0x46, 0xa0, 0x40, 0xcc, 0x95, 0xb9, 0x02,
-	0xe1, 0xa3, 0x83, 0x6f, 0x52, 0x41, 0x75, 0xc0, 0x3f, 0xc1, 0x33, 0xa9, 0xd6, 0x80, 0xfe, 0xfb,
-	0xf8, 0xc4, 0x9a, 0x8f, 0x49, 0x2e, 0xfa, 0xad, 0x5d, 0xae, 0x79, 0x5e, 0xa8, 0x36, 0x00, 0x3d,
-	0xea, 0xc7, 0xac, 0x82, 0xea, 0xb0, 0x64, 0xda, 0x55, 0xef, 0xd7, 0xe2, 0x7c, 0x89, 0x40, 0xa3,
-	0x84, 0x48, 0x1d, 0xa6, 0x28, 0xd3, 0xa7, 0x29, 0xea, 0xdf, 0x3a, 0xcd, 0xe3, 0x4f, 0xc2, 0xa5,
-	0xb6, 0x4e, 0xf9, 0xa6, 0x9f, 0x83, 0x2a, 0x57, 0x8b, 0xed, 0x56, 0xd8, 0x2e, 0xcc, 0x70, 0xf0,
-	0x61, 0x96, 0xf0, 0x64, 0xbb, 0x41, 0x33, 0xcd, 0x19, 0x09, 0xdb, 0x40, 0xdb, 0xf9, 0x2e, 0x64,
-	0x9b, 0x2e, 0x9a, 0x86, 0x26, 0x05, 0x44, 0x05, 0xc7, 0x89, 0x23, 0xea, 0xd7, 0xec, 0xfd, 0x1a,
-	0x01, 0x89, 0x48, 0x8c, 0x44, 0x12, 0x99, 0x9e, 0x48, 0xf4, 0x6f, 0x7e, 0x96, 0x5b, 0x47, 0xc1,
-	0x06, 0xe5, 0x62, 0xd5, 0x4f, 0xe1, 0x6f, 0xcb, 0x0c, 0xbe, 0xf3, 0x34, 0xed, 0xc3, 0x2e, 0x4c,
-	0xb2, 0x03, 0xa2, 0x3f, 0xc0, 0xe3, 0xb1, 0x2e, 0x90, 0x34, 0xd7, 0x85, 0x6f, 0xdc, 0x61, 0xdc,
-	0x8d, 0x59, 0x6b, 0x6d, 0x8e, 0x14, 0xd0, 0xfd, 0x9a, 0xc9, 0xbf, 0x22, 0xe0, 0x99, 0x14, 0xaa,
-	0x13, 0xcf, 0x4c, 0x1f, 0x78, 0xf6, 0x6f, 0x96, 0xcf, 0xc3, 0xb3, 0x61, 0x9d, 0x09, 0xf5, 0xb4,
-	0x4a, 0x9e, 0xda, 0x8d, 0xd6, 0x43, 0x49, 0x9e, 0x10, 0x7b, 0xf7, 0xfc, 0x7c, 0xbe, 0xd7, 0x67,
-	0x40, 0x15, 0x4f, 0x44, 0x43, 0x83, 0x6a, 0xf7, 0xf1, 0x98, 0x7a, 0xb6, 0x6a, 0xa6, 0xff, 0xaa,
-	0x49, 0x31, 0xe2, 0xc0, 0xfc, 0x11, 0x70, 0x2c, 0x38, 0xce, 0xbb, 0x38, 0x91, 0x7f, 0x8b, 0x80,
-	0x48, 0xd3, 0x7f, 0x2a, 0x91, 0xcc, 0x91, 0x88, 0xf4, 0x6f, 0xd6, 0xef, 0x41, 0x22, 0xb5, 0x61,
-	0x73, 0xa9, 0xfd, 0x26, 0x73, 0x2b, 0xad, 0x07, 0x6b, 0xa7, 0x74, 0x74, 0x02, 0x0f, 0x39, 0xf6,
-	0xb6, 0x2d, 0x64, 0xf4, 0x13, 0xc5, 0xe0, 0xc3, 0x7c, 0x16, 0x66, 0x4c, 0x6d, 0x0e, 0xdf, 0x95,
-	0x14, 0x26, 0x1e, 0x13, 0x9e, 0xa0, 0x0e, 0x04, 0x82, 0x95, 0x15, 0x69, 0x6b, 0xbe, 0xca, 0xfd,
-	0xcd, 0xe3, 0xbf, 0x9f, 0x23, 0x07, 0x81, 0x79, 0x31, 0xd4, 0x20, 0xd6, 0x0b, 0x88, 0x4f, 0xe1,
-	0x61, 0xe5, 0x68, 0xca, 0x14, 0xe1, 0xcb, 0xdc, 0x02, 0xa6, 0x6b, 0x9e, 0xfb, 0x98, 0xd5, 0xfd,
-	0x9b, 0x68, 0xcb, 0xf3, 0xcd, 0xdb, 0x76, 0x41, 0x9b, 0x74, 0x06, 0x1e, 0xa9, 0x52, 0xbe, 0xd1,
-	0x54, 0xef, 0x78, 0xb1, 0xf9, 0x6d, 0xfe, 0x12, 0x41, 0xfe, 0xd0, 0xee, 0x16, 0xf0, 0x7c, 0x13,
-	0x9f, 0xf4, 0x1a, 0xa2, 0xec, 0x35, 0xdc, 0xca, 0x3a, 0xe5, 0x77, 0x5c, 0xbf, 0x33, 0xac, 0x11,
-	0xb4, 0x75, 0xf8, 0xa3, 0x65, 0xe1, 0xc3, 0xf2, 0x9c, 0x5b, 0x8c, 0xc1, 0xe8, 0x20, 0x68, 0x7b,
-	0x07, 0x39, 0x8b, 0xc7, 0xfd, 0x7f, 0xd5, 0x73, 0x2a, 0x23, 0xf5, 0x8c, 0x37, 0x9b, 0xf3, 0x78,
-	0x4e, 0xc2, 0xbc, 0xcb, 0x38, 0xa7, 0x55, 0xb6, 0x49, 0x39, 0xb7, 0xdd, 0xea, 0x66, 0xcb, 0x63,
-	0xa8, 0xee, 0x2d, 0x7c, 0xa6, 0xdb, 0x40, 0x20, 0x36, 0x85, 0x8f, 0xff, 0xb8, 0x09, 0x31, 0x20,
-	0xd4, 0x6a, 0x58, 0xfa, 0xf3, 0x67, 0x78, 0x48, 0x3a, 0x22, 0xcf, 0x11, 0x3e, 0x11, 0xa9, 0xd0,
-	0x90, 0xcb, 0x5d, 0x96, 0x4f, 0x6a, 0xc5, 0xc7, 0xb8, 0xd2, 0x83, 0x65, 0x00, 0xd7, 0xcc, 0x3d,
-	0xfd, 0xc7, 0xff, 0x9f, 0x0d, 0x9e, 0x25, 0x67, 0xf2, 0xbe, 0xe1, 0x82, 0x52, 0x66, 0x83, 0x82,
-	0x1a, 0x13, 0x25, 0xc1, 0x79, 0x89, 0x02, 0xc8, 0x9f, 0x21, 0x3c, 0x1c, 0x14, 0x55, 0xc8, 0xa2,
-	0x4e, 0xd4, 0x48, 0x55, 0xc7, 0x58, 0x3a, 0x8c, 0x09, 0x20, 0x9c, 0x93, 0x08, 0x67, 0xc8, 0x74,
-	0x0a, 0xc2, 0xa0, 0xa8, 0x43, 0x5e, 0x22, 0x3c, 0xa6, 0xbe, 0x83, 0xc9, 0x55, 0x4d, 0x51, 0x12,
-	0x8a, 0x27, 0xc6, 0xb5, 0x9e, 0x6c, 0x01, 0xf0, 0x75, 0x09, 0xf8, 0x12, 0xb9, 0x98, 0x02, 0x58,
-	0x7d, 0x99, 0xe7, 0xf7, 0xe1, 0x52, 0x39, 0xc8, 0xef, 0xcb, 0x6b, 0xe4, 0x80, 0xfc, 0x1e, 0xe1,
-	0x71, 0xd5, 0x6f, 0xc1, 0x71, 0xf4, 0xb8, 0x24, 0x17, 0x82, 0xf4, 0xb8, 0xa4, 0x14, 0x77, 0xcc,
-	0xf3, 0x92, 0xcb, 0x1c, 0x99, 0xd5, 0xe0, 0x42, 0xfe, 0x83, 0xf0, 0xa9, 0x18, 0x72, 0x78, 0x8f,
-	0x93, 0x42, 0x0f, 0x20, 0xa2, 0x45, 0x05, 0x63, 0xf5, 0x28, 0x2e, 0x80, 0xce, 0x55, 0x49, 0xe7,
-	0x02, 0x59, 0xd2, 0xa0, 0x03, 0xb6, 0x30, 0x43, 0x07, 0xe4, 0xdf, 0x08, 0x7f, 0x4d, 0x79, 0xf4,
-	0x2a, 0xe4, 0x6e, 0x68, 0x22, 0x4b, 0x2d, 0x98, 0x18, 0x85, 0x23, 0x78, 0x00, 0x6a, 0x2b, 0x92,
-	0xda, 0x32, 0xb9, 0x90, 0x42, 0xcd, 0x76, 0x53, 0x98, 0x95, 0xec, 0xca, 0x01, 0xf9, 0x1d, 0xc2,
-	0x1f, 0x46, 0xc9, 0x69, 0xaf, 0xb9, 0x84, 0xd2, 0x85, 0xf6, 0x9a, 0x4b, 0x2a, 0x47, 0x74, 0x5d,
-	0x73, 0x0a, 0x13, 0x4e, 0xfe, 0x0e, 0xc0, 0x95, 0x27, 0xdd, 0x8a, 0xe6, 0xe6, 0x4d, 0x7c, 0xd8,
-	0x1a, 0xd7, 0x7b, 0xb4, 0x06, 0xf0, 0x97, 0x25, 0xf8, 0x25, 0xf2, 0x45, 0x07, 0xf0, 0x2d, 0xb3,
-	0xfc, 0x7e, 0xf8, 0x7d, 0x40, 0xfe, 0x89, 0x30, 0x69, 0x7f, 0xea, 0x13, 0x2d, 0x3c, 0xa9, 0x05,
-	0x06, 0xe3, 0x5b, 0xbd, 0x9a, 0x03, 0x9f, 0x82, 0xe4, 0x73, 0x8d, 0x5c, 0x49, 0xe5, 0x13, 0xff,
-	0x4f, 0x95, 0x52, 0x85, 0x0a, 0xaa, 0x12, 0xfb, 0x23, 0xc2, 0x27, 0xa3, 0x11, 0xfc, 0xe5, 0xb5,
-	0x72, 0x88, 0x25, 0xd2, 0xe3, 0x2c, 0xa5, 0x96, 0x14, 0xcc, 0x05, 0xc9, 0x6a, 0x9e, 0xcc, 0x69,
-	0xcd, 0x12, 0xf9, 0x0d, 0x6a, 0x3d, 0x65, 0xc9, 0xb2, 0xe6, 0x02, 0x89, 0xbd, 0xb9, 0x8d, 0x4b,
-	0x87, 0xb6, 0x03, 0xb0, 0x79, 0x09, 0xf6, 0x1b, 0x64, 0x3e, 0xed, 0x8a, 0x06, 0x03, 0x5f, 0xf3,
-	0x0a, 0xdb, 0x3d, 0x20, 0xbf, 0x42, 0x78, 0x34, 0xf4, 0xe2, 0x4b, 0xbd, 0xac, 0x29, 0x56, 0x4f,
-	0x88, 0x13, 0x5e, 0xfe, 0xe6, 0xbc, 0x44, 0xfc, 0x39, 0x99, 0xe9, 0x82, 0x98, 0xbc, 0x40, 0xf8,
-	0xa3, 0x78, 0x8a, 0x48, 0xb4, 0x0e, 0x8f, 0x94, 0x7c, 0xd5, 0x58, 0xe9, 0xcd, 0x58, 0x53, 0x6a,
-	0x2b, 0x8e, 0xf5, 0x25, 0xc2, 0xa3, 0x4a, 0x16, 0x48, 0x6e, 0xea, 0x84, 0xef, 0x96, 0x6d, 0x1a,
-	0xdf, 0x3e, 0xa2, 0x17, 0x60, 0x73, 0x4e, 0xb2, 0xf9, 0x3a, 0x31, 0xd3, 0x32, 0x27, 0x05, 0xf8,
-	0x2b, 0xd4, 0xf6, 0xb8, 0x27, 0xba, 0x47, 0x61, 0x72, 0x69, 0x42, 0xef, 0xe8, 0x49, 0x2f, 0xab,
-	0x98, 0xcb, 0x12, 0xfe, 0x17, 0x24, 0x97, 0x02, 0xdf, 0x89, 0xda, 0x35, 0x97, 0xff, 0x5f, 0x10,
-	0x26, 0x31, 0x9f, 0xfe, 0x2e, 0xd0, 0x3d, 0x32, 0x8e, 0xc2, 0x26, 0xbd, 0x78, 0xd2, 0x35, 0xd1,
-	0x8e, 0xb1, 0x21, 0xbf, 0x40, 0xf8, 0x98, 0x3c, 0x7c, 0x96, 0x34, 0x65, 0x54, 0x8f, 0xc7, 0x2f,
-	0x0f, 0x65, 0xa3, 0x79, 0xef, 0x5a, 0x70, 0x61, 0x49, 0x91, 0x9f, 0x23, 0x3c, 0xaa, 0x14, 0x4d,
-	0xc8, 0x95, 0x43, 0x44, 0x8c, 0x16, 0x5a, 0x7a, 0x03, 0x7b, 0x51, 0x82, 0xcd, 0x93, 0x85, 0x8e,
-	0x60, 0xdb, 0x92, 0xeb, 0x9f, 0x23, 0xfc, 0x41, 0x78, 0x03, 0x2d, 0x69, 0xce, 0xe8, 0xa1, 0x85,
-	0x8d, 0x15, 0x4e, 0xcc, 0x59, 0x89, 0x75, 0x9a, 0x9c, 0xee, 0x80, 0xd5, 0xcf, 0xc0, 0xc6, 0x7d,
-	0xab, 0x0d, 0x9b, 0x0b, 0x78, 0xf1, 0xeb, 0xa5, 0x60, 0xc9, 0x45, 0x0f, 0xbd, 0x14, 0x2c, 0xa5,
-	0xbe, 0xd1, 0xf5, 0xe4, 0xb0, 0x5a, 0x36, 0x32, 0x75, 0x8c, 0xfe, 0x29, 0x80, 0xde, 0x62, 0x48,
-	0xfc, 0xe3, 0x02, 0xe3, 0x6a, 0x2f, 0xa6, 0x9a, 0xb7, 0xfa, 0x93, 0x28, 0x4a, 0x1f, 0x78, 0xb4,
-	0x5a, 0xa2, 0x07, 0x3c, 0xb1, 0xfe, 0xa2, 0x07, 0x3c, 0xb9, 0x38, 0xd3, 0x15, 0xb8, 0x13, 0x31,
-	0x5b, 0xfd, 0xce, 0xab, 0x37, 0x59, 0xf4, 0xfa, 0x4d, 0x16, 0xfd, 0xef, 0x4d, 0x16, 0xfd, 0xf4,
-	0x6d, 0x76, 0xe0, 0xf5, 0xdb, 0xec, 0xc0, 0xbf, 0xde, 0x66, 0x07, 0x1e, 0x2c, 0x56, 0x6d, 0x51,
-	0x6b, 0x94, 0x73, 0x96, 0xb7, 0xad, 0xba, 0x0a, 0xf1, 0xe4, 0x77, 0x55, 0xaf, 0x62, 0x6f, 0x87,
-	0xf1, 0xf2, 0xb0, 0xbc, 0x05, 0xbe, 0xfc, 0x2a, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xad, 0xe9, 0xc2,
-	0xca, 0x23, 0x00, 0x00,
+type QueryRateLimitCapacityRequest struct {
+	ChainId      int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Zrc20Address string `protobuf:"bytes,2,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// GetTssAddress queries the tss address of the module.
-	// Deprecated: Moved to observer
-	// TODO: remove after v12 once upgrade testing is no longer needed with v11
-	// https://github.com/zeta-chain/node/issues/1547
-	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a OutTxTracker by index.
-	OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error)
-	// Queries a list of OutTxTracker items.
-	OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error)
-	OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error)
-	InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error)
-	InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error)
-	// Queries a InTxHashToCctx by index.
-	InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error)
-	// Queries a InTxHashToCctx data by index.
-	InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error)
-	// Queries a list of InTxHashToCctx items.
-	InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error)
-	// Queries a gasPrice by index.
-	GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error)
-	// Queries a list of gasPrice items.
-	GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error)
-	ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error)
-	ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error)
-	// Queries a lastBlockHeight by index.
-	LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error)
-	// Queries a list of lastBlockHeight items.
-	LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error)
-	// Queries a send by index.
-	Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
-	// Queries a send by nonce.
-	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
-	// Queries a list of send items.
-	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
-	// Queries a list of pending cctxs.
-	CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error)
-	ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error)
-	// Queries a list of lastMetaHeight items.
-	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
-}
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRateLimitCapacityRequest) Reset()         { *m = QueryRateLimitCapacityRequest{} }
+func (m *QueryRateLimitCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitCapacityRequest) ProtoMessage()    {}
+func (*QueryRateLimitCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{43}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRateLimitCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
-	out := new(QueryGetTssAddressResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GetTssAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitCapacityRequest.Merge(m, src)
 }
-
-func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error) {
-	out := new(QueryGetOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error) {
-	out := new(QueryAllOutTxTrackerResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitCapacityRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error) {
-	out := new(QueryAllOutTxTrackerByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryRateLimitCapacityRequest proto.InternalMessageInfo
 
-func (c *queryClient) InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error) {
-	out := new(QueryAllInTxTrackerByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitCapacityRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error) {
-	out := new(QueryAllInTxTrackersResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitCapacityRequest) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error) {
-	out := new(QueryGetInTxHashToCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryRateLimitCapacityResponse struct {
+	RateLimit         RateLimit                               `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	WindowUsage       github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=window_usage,json=windowUsage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"window_usage"`
+	RemainingCapacity github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=remaining_capacity,json=remainingCapacity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"remaining_capacity"`
 }
 
-func (c *queryClient) InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error) {
-	out := new(QueryInTxHashToCctxDataResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityResponse) Reset()         { *m = QueryRateLimitCapacityResponse{} }
+func (m *QueryRateLimitCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitCapacityResponse) ProtoMessage()    {}
+func (*QueryRateLimitCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{44}
 }
-
-func (c *queryClient) InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error) {
-	out := new(QueryAllInTxHashToCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error) {
-	out := new(QueryGetGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error) {
-	out := new(QueryAllGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitCapacityResponse.Merge(m, src)
 }
-
-func (c *queryClient) ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error) {
-	out := new(QueryConvertGasToZetaResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error) {
-	out := new(QueryMessagePassingProtocolFeeResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ProtocolFee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryRateLimitCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitCapacityResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error) {
-	out := new(QueryGetLastBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryRateLimitCapacityResponse proto.InternalMessageInfo
 
-func (c *queryClient) LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error) {
-	out := new(QueryAllLastBlockHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitCapacityResponse) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return out, nil
+	return RateLimit{}
 }
 
-func (c *queryClient) Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
-	out := new(QueryGetCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Cctx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryLastZetaHeightRequest struct {
 }
 
-func (c *queryClient) CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
-	out := new(QueryGetCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLastZetaHeightRequest) Reset()         { *m = QueryLastZetaHeightRequest{} }
+func (m *QueryLastZetaHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightRequest) ProtoMessage()    {}
+func (*QueryLastZetaHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{45}
 }
-
-func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
-	out := new(QueryAllCctxResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryLastZetaHeightRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error) {
-	out := new(QueryListCctxPendingResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxListPending", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLastZetaHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastZetaHeightRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLastZetaHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastZetaHeightRequest.Merge(m, src)
+}
+func (m *QueryLastZetaHeightRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastZetaHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastZetaHeightRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error) {
-	out := new(QueryZetaAccountingResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ZetaAccounting", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryLastZetaHeightRequest proto.InternalMessageInfo
+
+type QueryLastZetaHeightResponse struct {
+	Height int64 `protobuf:"varint,1,opt,name=Height,proto3" json:"Height,omitempty"`
 }
 
-func (c *queryClient) LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error) {
-	out := new(QueryLastZetaHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastZetaHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// GetTssAddress queries the tss address of the module.
-	// Deprecated: Moved to observer
-	// TODO: remove after v12 once upgrade testing is no longer needed with v11
-	// https://github.com/zeta-chain/node/issues/1547
-	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a OutTxTracker by index.
-	OutTxTracker(context.Context, *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error)
-	// Queries a list of OutTxTracker items.
-	OutTxTrackerAll(context.Context, *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error)
-	OutTxTrackerAllByChain(context.Context, *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error)
-	InTxTrackerAllByChain(context.Context, *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error)
-	InTxTrackerAll(context.Context, *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error)
-	// Queries a InTxHashToCctx by index.
-	InTxHashToCctx(context.Context, *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error)
-	// Queries a InTxHashToCctx data by index.
-	InTxHashToCctxData(context.Context, *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error)
-	// Queries a list of InTxHashToCctx items.
-	InTxHashToCctxAll(context.Context, *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error)
-	// Queries a gasPrice by index.
-	GasPrice(context.Context, *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error)
-	// Queries a list of gasPrice items.
-	GasPriceAll(context.Context, *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error)
-	ConvertGasToZeta(context.Context, *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error)
-	ProtocolFee(context.Context, *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error)
-	// Queries a lastBlockHeight by index.
-	LastBlockHeight(context.Context, *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error)
-	// Queries a list of lastBlockHeight items.
-	LastBlockHeightAll(context.Context, *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error)
-	// Queries a send by index.
-	Cctx(context.Context, *QueryGetCctxRequest) (*QueryGetCctxResponse, error)
-	// Queries a send by nonce.
-	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
-	// Queries a list of send items.
-	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
-	// Queries a list of pending cctxs.
-	CctxListPending(context.Context, *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error)
-	ZetaAccounting(context.Context, *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error)
-	// Queries a list of lastMetaHeight items.
-	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryLastZetaHeightResponse) Reset()         { *m = QueryLastZetaHeightResponse{} }
+func (m *QueryLastZetaHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastZetaHeightResponse) ProtoMessage()    {}
+func (*QueryLastZetaHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{46}
 }
-
-func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+func (m *QueryLastZetaHeightResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLastZetaHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastZetaHeightResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
+func (m *QueryLastZetaHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastZetaHeightResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OutTxTrackerAll(ctx context.Context, req *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAll not implemented")
+func (m *QueryLastZetaHeightResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) OutTxTrackerAllByChain(ctx context.Context, req *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAllByChain not implemented")
+func (m *QueryLastZetaHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastZetaHeightResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) InTxTrackerAllByChain(ctx context.Context, req *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAllByChain not implemented")
+
+var xxx_messageInfo_QueryLastZetaHeightResponse proto.InternalMessageInfo
+
+func (m *QueryLastZetaHeightResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) InTxTrackerAll(ctx context.Context, req *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAll not implemented")
+
+type QueryConvertGasToZetaRequest struct {
+	ChainId  int64  `protobuf:"varint,1,opt,name=chainId,proto3" json:"chainId,omitempty"`
+	GasLimit string `protobuf:"bytes,2,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
 }
-func (*UnimplementedQueryServer) InTxHashToCctx(ctx context.Context, req *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctx not implemented")
+
+func (m *QueryConvertGasToZetaRequest) Reset()         { *m = QueryConvertGasToZetaRequest{} }
+func (m *QueryConvertGasToZetaRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertGasToZetaRequest) ProtoMessage()    {}
+func (*QueryConvertGasToZetaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{47}
 }
-func (*UnimplementedQueryServer) InTxHashToCctxData(ctx context.Context, req *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxData not implemented")
+func (m *QueryConvertGasToZetaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) InTxHashToCctxAll(ctx context.Context, req *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxAll not implemented")
+func (m *QueryConvertGasToZetaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertGasToZetaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+func (m *QueryConvertGasToZetaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertGasToZetaRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GasPriceAll(ctx context.Context, req *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPriceAll not implemented")
+func (m *QueryConvertGasToZetaRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ConvertGasToZeta(ctx context.Context, req *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertGasToZeta not implemented")
+func (m *QueryConvertGasToZetaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertGasToZetaRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ProtocolFee(ctx context.Context, req *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFee not implemented")
+
+var xxx_messageInfo_QueryConvertGasToZetaRequest proto.InternalMessageInfo
+
+func (m *QueryConvertGasToZetaRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) LastBlockHeight(ctx context.Context, req *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeight not implemented")
+
+func (m *QueryConvertGasToZetaRequest) GetGasLimit() string {
+	if m != nil {
+		return m.GasLimit
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) LastBlockHeightAll(ctx context.Context, req *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeightAll not implemented")
+
+type QueryConvertGasToZetaResponse struct {
+	OutboundGasInZeta string `protobuf:"bytes,1,opt,name=outboundGasInZeta,proto3" json:"outboundGasInZeta,omitempty"`
+	ProtocolFeeInZeta string `protobuf:"bytes,2,opt,name=protocolFeeInZeta,proto3" json:"protocolFeeInZeta,omitempty"`
+	ZetaBlockHeight   uint64 `protobuf:"varint,3,opt,name=ZetaBlockHeight,proto3" json:"ZetaBlockHeight,omitempty"`
 }
-func (*UnimplementedQueryServer) Cctx(ctx context.Context, req *QueryGetCctxRequest) (*QueryGetCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cctx not implemented")
+
+func (m *QueryConvertGasToZetaResponse) Reset()         { *m = QueryConvertGasToZetaResponse{} }
+func (m *QueryConvertGasToZetaResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConvertGasToZetaResponse) ProtoMessage()    {}
+func (*QueryConvertGasToZetaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{48}
 }
-func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxByNonce not implemented")
+func (m *QueryConvertGasToZetaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
+func (m *QueryConvertGasToZetaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConvertGasToZetaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) CctxListPending(ctx context.Context, req *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CctxListPending not implemented")
+func (m *QueryConvertGasToZetaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConvertGasToZetaResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ZetaAccounting(ctx context.Context, req *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ZetaAccounting not implemented")
+func (m *QueryConvertGasToZetaResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastZetaHeight not implemented")
+func (m *QueryConvertGasToZetaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConvertGasToZetaResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryConvertGasToZetaResponse proto.InternalMessageInfo
 
-func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GetTssAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+func (m *QueryConvertGasToZetaResponse) GetOutboundGasInZeta() string {
+	if m != nil {
+		return m.OutboundGasInZeta
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryConvertGasToZetaResponse) GetProtocolFeeInZeta() string {
+	if m != nil {
+		return m.ProtocolFeeInZeta
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOutTxTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTracker(ctx, in)
+func (m *QueryConvertGasToZetaResponse) GetZetaBlockHeight() uint64 {
+	if m != nil {
+		return m.ZetaBlockHeight
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTracker",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTracker(ctx, req.(*QueryGetOutTxTrackerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_OutTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllOutTxTrackerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTrackerAll(ctx, req.(*QueryAllOutTxTrackerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryMessagePassingProtocolFeeRequest struct {
 }
 
-func _Query_OutTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllOutTxTrackerByChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, req.(*QueryAllOutTxTrackerByChainRequest))
+func (m *QueryMessagePassingProtocolFeeRequest) Reset()         { *m = QueryMessagePassingProtocolFeeRequest{} }
+func (m *QueryMessagePassingProtocolFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePassingProtocolFeeRequest) ProtoMessage()    {}
+func (*QueryMessagePassingProtocolFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{49}
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.Merge(m, src)
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessagePassingProtocolFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeRequest.DiscardUnknown(m)
 }
 
-func _Query_InTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInTxTrackerByChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxTrackerAllByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxTrackerAllByChain(ctx, req.(*QueryAllInTxTrackerByChainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryMessagePassingProtocolFeeRequest proto.InternalMessageInfo
+
+type QueryMessagePassingProtocolFeeResponse struct {
+	FeeInZeta string `protobuf:"bytes,1,opt,name=feeInZeta,proto3" json:"feeInZeta,omitempty"`
 }
 
-func _Query_InTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInTxTrackersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxTrackerAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxTrackerAll(ctx, req.(*QueryAllInTxTrackersRequest))
+func (m *QueryMessagePassingProtocolFeeResponse) Reset() {
+	*m = QueryMessagePassingProtocolFeeResponse{}
+}
+func (m *QueryMessagePassingProtocolFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMessagePassingProtocolFeeResponse) ProtoMessage()    {}
+func (*QueryMessagePassingProtocolFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{50}
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.Merge(m, src)
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMessagePassingProtocolFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMessagePassingProtocolFeeResponse.DiscardUnknown(m)
 }
 
-func _Query_InTxHashToCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetInTxHashToCctxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctx(ctx, req.(*QueryGetInTxHashToCctxRequest))
+var xxx_messageInfo_QueryMessagePassingProtocolFeeResponse proto.InternalMessageInfo
+
+func (m *QueryMessagePassingProtocolFeeResponse) GetFeeInZeta() string {
+	if m != nil {
+		return m.FeeInZeta
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_InTxHashToCctxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInTxHashToCctxDataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctxData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctxData(ctx, req.(*QueryInTxHashToCctxDataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryEstimateCctxFeeRequest struct {
+	SenderChainId   int64           `protobuf:"varint,1,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
+	ReceiverChainId int64           `protobuf:"varint,2,opt,name=receiver_chain_id,json=receiverChainId,proto3" json:"receiver_chain_id,omitempty"`
+	CoinType        common.CoinType `protobuf:"varint,3,opt,name=coin_type,json=coinType,proto3,enum=common.CoinType" json:"coin_type,omitempty"`
+	Asset           string          `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount          string          `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	GasLimit        uint64          `protobuf:"varint,6,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func _Query_InTxHashToCctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllInTxHashToCctxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).InTxHashToCctxAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).InTxHashToCctxAll(ctx, req.(*QueryAllInTxHashToCctxRequest))
+func (m *QueryEstimateCctxFeeRequest) Reset()         { *m = QueryEstimateCctxFeeRequest{} }
+func (m *QueryEstimateCctxFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateCctxFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateCctxFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{51}
+}
+func (m *QueryEstimateCctxFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateCctxFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateCctxFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEstimateCctxFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateCctxFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateCctxFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateCctxFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateCctxFeeRequest.DiscardUnknown(m)
 }
 
-func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPrice(ctx, req.(*QueryGetGasPriceRequest))
+var xxx_messageInfo_QueryEstimateCctxFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateCctxFeeRequest) GetSenderChainId() int64 {
+	if m != nil {
+		return m.SenderChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GasPriceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPriceAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPriceAll(ctx, req.(*QueryAllGasPriceRequest))
+func (m *QueryEstimateCctxFeeRequest) GetReceiverChainId() int64 {
+	if m != nil {
+		return m.ReceiverChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_ConvertGasToZeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConvertGasToZetaRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ConvertGasToZeta(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta",
+func (m *QueryEstimateCctxFeeRequest) GetCoinType() common.CoinType {
+	if m != nil {
+		return m.CoinType
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ConvertGasToZeta(ctx, req.(*QueryConvertGasToZetaRequest))
+	return common.CoinType_Zeta
+}
+
+func (m *QueryEstimateCctxFeeRequest) GetAsset() string {
+	if m != nil {
+		return m.Asset
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ProtocolFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMessagePassingProtocolFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEstimateCctxFeeRequest) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProtocolFee(ctx, in)
+	return ""
+}
+
+func (m *QueryEstimateCctxFeeRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ProtocolFee",
+	return 0
+}
+
+// QueryEstimateCctxFeeResponse contains the itemized fees of a prospective cctx
+// the gas fee and protocol flat fee are denominated in the gas token of the receiver chain
+// the total fee is denominated in the sent asset, except for ZRC20 withdrawals where the fees are paid in the gas ZRC20
+type QueryEstimateCctxFeeResponse struct {
+	GasLimit        uint64                                  `protobuf:"varint,1,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice        github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,2,opt,name=gas_price,json=gasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"gas_price"`
+	GasFee          github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=gas_fee,json=gasFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"gas_fee"`
+	ProtocolFlatFee github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,4,opt,name=protocol_flat_fee,json=protocolFlatFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"protocol_flat_fee"`
+	// gas fee converted in ZETA through the system uniswap pool, for ZETA
+	GasFeeInZeta      github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,5,opt,name=gas_fee_in_zeta,json=gasFeeInZeta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"gas_fee_in_zeta"`
+	ProtocolFeeInZeta github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=protocol_fee_in_zeta,json=protocolFeeInZeta,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"protocol_fee_in_zeta"`
+	// amount of the ERC20 swapped through the system uniswap pool to pay the gas fee and protocol flat fee, for ERC20
+	SwapAmountIn github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,7,opt,name=swap_amount_in,json=swapAmountIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"swap_amount_in"`
+	TotalFee     github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,8,opt,name=total_fee,json=totalFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"total_fee"`
+	// zero if the amount doesn't cover the total fee, the cctx would then be aborted
+	ReceiveAmount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,9,opt,name=receive_amount,json=receiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"receive_amount"`
+}
+
+func (m *QueryEstimateCctxFeeResponse) Reset()         { *m = QueryEstimateCctxFeeResponse{} }
+func (m *QueryEstimateCctxFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateCctxFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateCctxFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_65a992045e92a606, []int{52}
+}
+func (m *QueryEstimateCctxFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateCctxFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateCctxFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProtocolFee(ctx, req.(*QueryMessagePassingProtocolFeeRequest))
+}
+func (m *QueryEstimateCctxFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateCctxFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateCctxFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateCctxFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateCctxFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateCctxFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateCctxFeeResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LastBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetLastBlockHeightRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressRequest")
+	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.crosschain.QueryGetTssAddressResponse")
+	proto.RegisterType((*QueryZetaAccountingRequest)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingRequest")
+	proto.RegisterType((*QueryZetaAccountingResponse)(nil), "zetachain.zetacore.crosschain.QueryZetaAccountingResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.crosschain.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.crosschain.QueryParamsResponse")
+	proto.RegisterType((*QueryGetOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerRequest")
+	proto.RegisterType((*QueryGetOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryGetOutTxTrackerResponse")
+	proto.RegisterType((*QueryAllOutTxTrackerRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerRequest")
+	proto.RegisterType((*QueryAllOutTxTrackerResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerResponse")
+	proto.RegisterType((*QueryAllOutTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainRequest")
+	proto.RegisterType((*QueryAllOutTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllOutTxTrackerByChainResponse")
+	proto.RegisterType((*QueryAllInTxTrackerByChainRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainRequest")
+	proto.RegisterType((*QueryAllInTxTrackerByChainResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackerByChainResponse")
+	proto.RegisterType((*QueryAllInTxTrackersRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersRequest")
+	proto.RegisterType((*QueryAllInTxTrackersResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxTrackersResponse")
+	proto.RegisterType((*QueryGetInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxRequest")
+	proto.RegisterType((*QueryGetInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetInTxHashToCctxResponse")
+	proto.RegisterType((*QueryInTxHashToCctxDataRequest)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataRequest")
+	proto.RegisterType((*QueryInTxHashToCctxDataResponse)(nil), "zetachain.zetacore.crosschain.QueryInTxHashToCctxDataResponse")
+	proto.RegisterType((*QueryAllInTxHashToCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxRequest")
+	proto.RegisterType((*QueryAllInTxHashToCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllInTxHashToCctxResponse")
+	proto.RegisterType((*QueryGetGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceRequest")
+	proto.RegisterType((*QueryGetGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryGetGasPriceResponse")
+	proto.RegisterType((*QueryAllGasPriceRequest)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceRequest")
+	proto.RegisterType((*QueryAllGasPriceResponse)(nil), "zetachain.zetacore.crosschain.QueryAllGasPriceResponse")
+	proto.RegisterType((*QueryGetLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightRequest")
+	proto.RegisterType((*QueryGetLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryGetLastBlockHeightResponse")
+	proto.RegisterType((*QueryAllLastBlockHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightRequest")
+	proto.RegisterType((*QueryAllLastBlockHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryAllLastBlockHeightResponse")
+	proto.RegisterType((*QueryGetCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxRequest")
+	proto.RegisterType((*QueryGetCctxByNonceRequest)(nil), "zetachain.zetacore.crosschain.QueryGetCctxByNonceRequest")
+	proto.RegisterType((*QueryGetCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryGetCctxResponse")
+	proto.RegisterType((*QueryAllCctxRequest)(nil), "zetachain.zetacore.crosschain.QueryAllCctxRequest")
+	proto.RegisterType((*QueryAllCctxResponse)(nil), "zetachain.zetacore.crosschain.QueryAllCctxResponse")
+	proto.RegisterType((*QueryListCctxPendingRequest)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingRequest")
+	proto.RegisterType((*QueryListCctxPendingResponse)(nil), "zetachain.zetacore.crosschain.QueryListCctxPendingResponse")
+	proto.RegisterType((*QueryListCctxFilteredRequest)(nil), "zetachain.zetacore.crosschain.QueryListCctxFilteredRequest")
+	proto.RegisterType((*QueryListCctxFilteredResponse)(nil), "zetachain.zetacore.crosschain.QueryListCctxFilteredResponse")
+	proto.RegisterType((*QueryListCctxPrunableRequest)(nil), "zetachain.zetacore.crosschain.QueryListCctxPrunableRequest")
+	proto.RegisterType((*QueryListCctxPrunableResponse)(nil), "zetachain.zetacore.crosschain.QueryListCctxPrunableResponse")
+	proto.RegisterType((*QueryAllRateLimitRequest)(nil), "zetachain.zetacore.crosschain.QueryAllRateLimitRequest")
+	proto.RegisterType((*QueryAllRateLimitResponse)(nil), "zetachain.zetacore.crosschain.QueryAllRateLimitResponse")
+	proto.RegisterType((*QueryRateLimitCapacityRequest)(nil), "zetachain.zetacore.crosschain.QueryRateLimitCapacityRequest")
+	proto.RegisterType((*QueryRateLimitCapacityResponse)(nil), "zetachain.zetacore.crosschain.QueryRateLimitCapacityResponse")
+	proto.RegisterType((*QueryLastZetaHeightRequest)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightRequest")
+	proto.RegisterType((*QueryLastZetaHeightResponse)(nil), "zetachain.zetacore.crosschain.QueryLastZetaHeightResponse")
+	proto.RegisterType((*QueryConvertGasToZetaRequest)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaRequest")
+	proto.RegisterType((*QueryConvertGasToZetaResponse)(nil), "zetachain.zetacore.crosschain.QueryConvertGasToZetaResponse")
+	proto.RegisterType((*QueryMessagePassingProtocolFeeRequest)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeRequest")
+	proto.RegisterType((*QueryMessagePassingProtocolFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryMessagePassingProtocolFeeResponse")
+	proto.RegisterType((*QueryEstimateCctxFeeRequest)(nil), "zetachain.zetacore.crosschain.QueryEstimateCctxFeeRequest")
+	proto.RegisterType((*QueryEstimateCctxFeeResponse)(nil), "zetachain.zetacore.crosschain.QueryEstimateCctxFeeResponse")
+}
+
+func init() { proto.RegisterFile("crosschain/query.proto", fileDescriptor_65a992045e92a606) }
+
+var fileDescriptor_65a992045e92a606 = []byte{
+	// 2574 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xce, 0x78, 0x63, 0xc7, 0x3e, 0xfe, 0x8b, 0x6f, 0x4d, 0xea, 0x8e, 0xff, 0xd2, 0x09, 0x89,
+	0xdd, 0x04, 0xef, 0x26, 0x4e, 0x93, 0x26, 0xb1, 0x53, 0xd5, 0x76, 0x6a, 0xc7, 0xc2, 0x69, 0xcd,
+	0xe2, 0x00, 0x0a, 0x82, 0xe1, 0x7a, 0xf6, 0x66, 0x3d, 0xca, 0x7a, 0xc6, 0xdd, 0xb9, 0x9b, 0xd8,
+	0x89, 0xfc, 0xd2, 0x07, 0x9e, 0x91, 0x2a, 0xc1, 0x0b, 0x6f, 0x80, 0x40, 0x82, 0x07, 0x1e, 0x0a,
+	0x08, 0x90, 0x8a, 0x10, 0x10, 0xf2, 0x82, 0x54, 0x09, 0x09, 0xa1, 0x22, 0x55, 0x28, 0x41, 0xe2,
+	0x81, 0x07, 0x1e, 0x79, 0x45, 0xf7, 0xce, 0x99, 0xd9, 0x99, 0xd9, 0x99, 0xdd, 0xd9, 0xc9, 0x56,
+	0x6a, 0x9f, 0x76, 0xee, 0xcf, 0x39, 0xe7, 0x3b, 0xdf, 0xfd, 0x3b, 0xf7, 0x9e, 0x85, 0x13, 0x46,
+	0xd5, 0x76, 0x1c, 0x63, 0x87, 0x9a, 0x56, 0xe1, 0x9d, 0x1a, 0xab, 0x1e, 0xe4, 0xf7, 0xaa, 0x36,
+	0xb7, 0xc9, 0xe4, 0x43, 0xc6, 0xa9, 0xac, 0xce, 0xcb, 0x2f, 0xbb, 0xca, 0xf2, 0xf5, 0xae, 0xea,
+	0x59, 0xc3, 0x76, 0x76, 0x6d, 0xa7, 0xb0, 0x4d, 0x1d, 0xe6, 0xca, 0x15, 0xee, 0x5f, 0xd8, 0x66,
+	0x9c, 0x5e, 0x28, 0xec, 0xd1, 0xb2, 0x69, 0x51, 0x6e, 0xda, 0x96, 0xab, 0x4a, 0x7d, 0xc1, 0xb0,
+	0x77, 0x77, 0x6d, 0xab, 0xe0, 0xfe, 0x60, 0xe5, 0x74, 0xc0, 0xae, 0xfc, 0xd4, 0xe5, 0xb7, 0xce,
+	0xf7, 0xb1, 0x83, 0x1a, 0xe8, 0x50, 0xa6, 0x8e, 0xbe, 0x57, 0x35, 0x0d, 0x86, 0x6d, 0xa7, 0x02,
+	0x6d, 0x52, 0x46, 0xdf, 0xa1, 0xce, 0x8e, 0xce, 0x6d, 0xdd, 0x30, 0x7c, 0x05, 0x53, 0x0d, 0x9d,
+	0x78, 0x95, 0x1a, 0xf7, 0x58, 0x15, 0xdb, 0xb5, 0x40, 0x7b, 0x85, 0x3a, 0x5c, 0xdf, 0xae, 0xd8,
+	0xc6, 0x3d, 0x7d, 0x87, 0x99, 0xe5, 0x1d, 0x1e, 0x83, 0xd2, 0xae, 0xf1, 0x46, 0x25, 0x2f, 0x06,
+	0x3a, 0xec, 0xd1, 0x2a, 0xdd, 0x75, 0xb0, 0x61, 0x3c, 0xd0, 0x50, 0xa5, 0x9c, 0xe9, 0x15, 0x73,
+	0xd7, 0xf4, 0xd4, 0x8e, 0x96, 0xed, 0xb2, 0x2d, 0x3f, 0x0b, 0xe2, 0x0b, 0x6b, 0x27, 0xca, 0xb6,
+	0x5d, 0xae, 0xb0, 0x02, 0xdd, 0x33, 0x0b, 0xd4, 0xb2, 0x6c, 0x2e, 0x49, 0x44, 0x85, 0xda, 0x38,
+	0xbc, 0xf4, 0x25, 0xc1, 0xf3, 0x1a, 0xe3, 0x5b, 0x8e, 0xb3, 0x54, 0x2a, 0x55, 0x99, 0xe3, 0x14,
+	0xd9, 0x3b, 0x35, 0xe6, 0x70, 0xed, 0x0d, 0x50, 0xe3, 0x1a, 0x9d, 0x3d, 0xdb, 0x72, 0x18, 0x39,
+	0x0e, 0x39, 0xc6, 0x77, 0xc6, 0x94, 0x93, 0xca, 0x6c, 0x5f, 0x51, 0x7c, 0x8a, 0x9a, 0x6d, 0x6e,
+	0x8c, 0x75, 0xb9, 0x35, 0xdb, 0xdc, 0xd0, 0x26, 0x50, 0xc3, 0x1d, 0xc6, 0xe9, 0x92, 0x61, 0xd8,
+	0x35, 0x8b, 0x9b, 0x56, 0xd9, 0xd3, 0x7f, 0x0b, 0xc6, 0x63, 0x5b, 0xd1, 0x40, 0x1e, 0x5e, 0xa0,
+	0xdb, 0x76, 0x95, 0xb3, 0x92, 0x2e, 0x26, 0x8b, 0x4e, 0x77, 0x45, 0x0f, 0x34, 0x38, 0x82, 0x4d,
+	0x52, 0x56, 0x36, 0x68, 0xa3, 0x40, 0xa4, 0xba, 0x4d, 0xc9, 0x98, 0x67, 0xe4, 0x0e, 0xbc, 0x10,
+	0xaa, 0x45, 0xe5, 0x2b, 0xd0, 0xe3, 0x32, 0x2b, 0xf5, 0xf5, 0xcf, 0x9f, 0xce, 0x37, 0x9d, 0x9a,
+	0x79, 0x57, 0x7c, 0xf9, 0xe8, 0x93, 0x8f, 0xa7, 0x8f, 0x14, 0x51, 0xd4, 0x77, 0x60, 0x8d, 0xf1,
+	0xb7, 0x6b, 0x7c, 0x6b, 0x7f, 0xcb, 0x1d, 0x45, 0x34, 0x4d, 0xc6, 0xe0, 0x98, 0x14, 0x5e, 0xbf,
+	0x21, 0x8d, 0xe4, 0x8a, 0x5e, 0x91, 0x8c, 0x42, 0xb7, 0x65, 0x5b, 0x06, 0x93, 0x5c, 0x1d, 0x2d,
+	0xba, 0x05, 0xad, 0x06, 0x13, 0xf1, 0xea, 0x10, 0xf3, 0x6d, 0x18, 0xb0, 0x03, 0xf5, 0x88, 0xfc,
+	0x5c, 0x0b, 0xe4, 0x41, 0x55, 0x88, 0x3f, 0xa4, 0x46, 0x63, 0xe8, 0xc5, 0x52, 0xa5, 0x12, 0xe7,
+	0xc5, 0x2a, 0x40, 0x7d, 0xf1, 0xa1, 0xcd, 0x33, 0x79, 0x77, 0xa5, 0xe6, 0xc5, 0x4a, 0xcd, 0xbb,
+	0x2b, 0x1c, 0x57, 0x6a, 0x7e, 0x93, 0x96, 0x19, 0xca, 0x16, 0x03, 0x92, 0xda, 0x07, 0x0a, 0x4c,
+	0xc4, 0xdb, 0x49, 0x74, 0x2f, 0xd7, 0x01, 0xf7, 0xc8, 0x5a, 0x08, 0x7f, 0x97, 0xc4, 0x3f, 0xd3,
+	0x12, 0xbf, 0x8b, 0x29, 0xe4, 0xc0, 0xbb, 0x0a, 0x68, 0x71, 0x0e, 0x2c, 0x1f, 0xac, 0x08, 0x24,
+	0x1e, 0x5f, 0xa3, 0xd0, 0x2d, 0x91, 0xe1, 0x98, 0xbb, 0x05, 0xb2, 0x1a, 0x83, 0x22, 0x0b, 0x8b,
+	0x7f, 0x52, 0xe0, 0x54, 0x53, 0x10, 0x9f, 0x11, 0x32, 0xbf, 0xad, 0xc0, 0xcb, 0x9e, 0x1f, 0xeb,
+	0x56, 0x12, 0x97, 0x2f, 0x41, 0xaf, 0xbb, 0x81, 0x9b, 0xa5, 0xf0, 0x12, 0x2a, 0x75, 0x8c, 0xd0,
+	0xdf, 0x07, 0x46, 0x35, 0x0e, 0x08, 0xf2, 0x59, 0x84, 0x7e, 0xd3, 0x8a, 0xd2, 0x79, 0xb6, 0x05,
+	0x9d, 0xeb, 0x56, 0x94, 0xcd, 0xa0, 0x92, 0xce, 0x91, 0x19, 0x58, 0xc1, 0x01, 0x93, 0x4e, 0xa7,
+	0x57, 0xf0, 0x6f, 0x03, 0x2b, 0x38, 0x6c, 0xe7, 0xb3, 0x40, 0xd2, 0x02, 0x4c, 0x7a, 0xbb, 0xab,
+	0x30, 0x79, 0x93, 0x3a, 0x3b, 0x5b, 0xf6, 0x8a, 0xc1, 0xf7, 0x3d, 0x9a, 0x54, 0xe8, 0x35, 0xb1,
+	0x01, 0x0f, 0x19, 0xbf, 0xac, 0x1d, 0xc2, 0x54, 0x92, 0x30, 0xfa, 0xfe, 0x75, 0x18, 0x32, 0x43,
+	0x2d, 0x48, 0xf4, 0x5c, 0x0a, 0xf7, 0xeb, 0x42, 0xc8, 0x40, 0x44, 0x95, 0xb6, 0x88, 0xe6, 0xc3,
+	0x9d, 0x6f, 0x50, 0x4e, 0xd3, 0x80, 0x7f, 0x08, 0xd3, 0x89, 0xd2, 0x88, 0xfe, 0xab, 0x30, 0xb8,
+	0x22, 0x30, 0xc9, 0x49, 0xbf, 0xb5, 0xef, 0xa4, 0xdc, 0x2f, 0x82, 0x32, 0x08, 0x3d, 0xac, 0x47,
+	0x2b, 0xc3, 0x64, 0x70, 0xca, 0x34, 0xb2, 0xde, 0xa9, 0xc9, 0xf9, 0x58, 0x81, 0xa9, 0x24, 0x4b,
+	0x4d, 0x86, 0x28, 0xd7, 0xa1, 0x21, 0xea, 0xdc, 0x3c, 0x2d, 0xc0, 0x8b, 0xde, 0x54, 0x5b, 0xa3,
+	0xce, 0x66, 0xd5, 0x34, 0x58, 0xe0, 0x68, 0x31, 0xad, 0x12, 0xdb, 0xc7, 0x11, 0x76, 0x0b, 0x9a,
+	0x0e, 0x63, 0x8d, 0x02, 0x7e, 0x98, 0xd3, 0xeb, 0xd5, 0x21, 0xb7, 0x33, 0x2d, 0x9c, 0xf5, 0x55,
+	0xf8, 0x82, 0x1a, 0x45, 0x44, 0x4b, 0x95, 0x4a, 0x14, 0x51, 0xa7, 0x46, 0xef, 0x27, 0x0a, 0x8c,
+	0x35, 0xda, 0x88, 0x75, 0x22, 0x97, 0xc9, 0x89, 0xce, 0x8d, 0xcf, 0xe5, 0xfa, 0x56, 0xb0, 0x41,
+	0x1d, 0xbe, 0x2c, 0xe2, 0xfb, 0x9b, 0x32, 0xbc, 0x6f, 0x3e, 0x4c, 0x8f, 0x60, 0x3a, 0x51, 0x0e,
+	0x1d, 0xfd, 0x1a, 0x0c, 0x47, 0x9a, 0x90, 0xd2, 0x7c, 0x0b, 0x7f, 0xa3, 0x0a, 0xa3, 0x6a, 0xb4,
+	0x9d, 0xfa, 0xe2, 0x48, 0x00, 0xdd, 0xa9, 0x91, 0xfc, 0xa3, 0x02, 0xd3, 0x89, 0xa6, 0x9a, 0xf9,
+	0x99, 0xeb, 0x80, 0x9f, 0x9d, 0x1b, 0xe5, 0x73, 0x78, 0x6d, 0x58, 0x63, 0x3c, 0xb8, 0x5b, 0xc5,
+	0x0f, 0xed, 0x06, 0xa8, 0xc1, 0xce, 0xcb, 0x07, 0x6f, 0xd9, 0x96, 0xc1, 0xb2, 0x5e, 0x03, 0xca,
+	0x30, 0x1a, 0x36, 0x8d, 0xac, 0xbd, 0x0d, 0x03, 0xc1, 0xbd, 0x35, 0x65, 0xf8, 0x1f, 0x14, 0x29,
+	0x86, 0x14, 0x68, 0xdf, 0x40, 0x1f, 0x97, 0x2a, 0x95, 0x4f, 0x62, 0x47, 0xfe, 0xb9, 0x02, 0xa3,
+	0x61, 0xfd, 0x89, 0x8e, 0xe4, 0x9e, 0xcb, 0x91, 0xce, 0x8d, 0xfa, 0x5b, 0x18, 0x48, 0x6d, 0x98,
+	0x8e, 0xe4, 0x7e, 0x93, 0x59, 0xa5, 0xfa, 0x85, 0xb5, 0x59, 0x38, 0x3a, 0x0a, 0xdd, 0xf2, 0x2e,
+	0x2e, 0xad, 0x0f, 0x16, 0xdd, 0x82, 0xf6, 0x9e, 0x17, 0x31, 0x35, 0x28, 0xfc, 0xa4, 0xa8, 0xd0,
+	0x60, 0x80, 0xdb, 0x9c, 0x56, 0xd0, 0x10, 0xce, 0xac, 0x50, 0x9d, 0xf6, 0xdf, 0xae, 0x08, 0xaa,
+	0x55, 0xb3, 0xc2, 0x59, 0x95, 0x95, 0x3c, 0x3f, 0x4f, 0x40, 0x8f, 0xc3, 0xac, 0x12, 0x5e, 0x31,
+	0xfb, 0x8a, 0x58, 0x12, 0x41, 0x46, 0x95, 0x19, 0xcc, 0xbc, 0xcf, 0xaa, 0x78, 0xcb, 0xf7, 0xcb,
+	0x64, 0x09, 0x7a, 0x1c, 0x4e, 0x79, 0xcd, 0x19, 0xcb, 0x9d, 0xcc, 0xcd, 0x0e, 0xcd, 0xbf, 0xd2,
+	0xca, 0x07, 0x83, 0xef, 0x7f, 0x59, 0x0a, 0x14, 0x51, 0x90, 0x9c, 0x81, 0x61, 0xd7, 0x90, 0xee,
+	0xb3, 0x7c, 0x54, 0xb2, 0x3c, 0xe8, 0x56, 0xaf, 0x20, 0xd7, 0x67, 0x61, 0xc4, 0x33, 0x5b, 0xef,
+	0xd9, 0x2d, 0x7b, 0x0e, 0x7b, 0x0d, 0x5e, 0xdf, 0x49, 0x80, 0x5d, 0xd3, 0xc2, 0xf7, 0x97, 0xb1,
+	0x1e, 0xc9, 0x46, 0xdf, 0xae, 0x69, 0xe1, 0x7e, 0x21, 0x9a, 0xe9, 0xbe, 0xd7, 0x7c, 0x0c, 0x9b,
+	0xe9, 0x3e, 0x36, 0x87, 0x97, 0x42, 0x6f, 0xe6, 0xa5, 0xf0, 0x6b, 0x05, 0x26, 0x13, 0x18, 0xff,
+	0xd4, 0xaf, 0x89, 0x1f, 0x36, 0xcc, 0xe1, 0x6a, 0xcd, 0xa2, 0xdb, 0x15, 0x7f, 0x7f, 0x0b, 0x53,
+	0xac, 0x34, 0xa7, 0xb8, 0xab, 0x39, 0xc5, 0xb9, 0xce, 0x51, 0x5c, 0x87, 0xf9, 0xa9, 0xa7, 0x78,
+	0xbb, 0x1e, 0xfc, 0x14, 0x29, 0x67, 0x1b, 0x62, 0xef, 0xe8, 0xf4, 0x6e, 0xfc, 0x0b, 0x05, 0x5e,
+	0x8a, 0x31, 0xe2, 0x73, 0xd3, 0x5f, 0x7f, 0x4f, 0xf4, 0xa2, 0xff, 0xd9, 0x16, 0xd4, 0xf8, 0x6a,
+	0x30, 0x24, 0x86, 0xaa, 0x57, 0xe1, 0x74, 0x8e, 0x1b, 0x1d, 0x87, 0xd5, 0x37, 0xb6, 0x42, 0xf7,
+	0xa8, 0x61, 0xf2, 0x83, 0x14, 0x9b, 0xf2, 0x29, 0x18, 0x7c, 0x58, 0x35, 0xe6, 0xcf, 0xeb, 0xd4,
+	0x7d, 0xbb, 0xc4, 0x4d, 0x6b, 0x40, 0x56, 0xe2, 0x7b, 0xa6, 0xf6, 0x83, 0x2e, 0x98, 0x4a, 0xb2,
+	0x80, 0xec, 0xdc, 0x02, 0xa8, 0xb3, 0x83, 0x63, 0xd0, 0x2e, 0x39, 0x7d, 0x3e, 0x39, 0xa4, 0x08,
+	0x03, 0x0f, 0x4c, 0xab, 0x64, 0x3f, 0xd0, 0x6b, 0x0e, 0x2d, 0xbb, 0xa7, 0x7f, 0xdf, 0x72, 0x41,
+	0x74, 0xfb, 0xe8, 0xe3, 0xe9, 0x99, 0xb2, 0xc9, 0x77, 0x6a, 0xdb, 0x79, 0xc3, 0xde, 0x2d, 0xe0,
+	0x7b, 0xb8, 0xfb, 0x33, 0xe7, 0x94, 0xee, 0x15, 0xf8, 0xc1, 0x1e, 0x73, 0xf2, 0xb7, 0x4d, 0x8b,
+	0x17, 0xfb, 0x5d, 0x25, 0xb7, 0x85, 0x0e, 0xf2, 0x4d, 0x20, 0x55, 0xb6, 0x4b, 0x4d, 0xcb, 0xb4,
+	0xca, 0xba, 0x81, 0x0e, 0x8c, 0xe5, 0xb2, 0x69, 0x1e, 0xf1, 0x55, 0x79, 0x54, 0xf8, 0x2f, 0xb9,
+	0x22, 0xe0, 0x12, 0x6f, 0xae, 0xa1, 0xe0, 0x51, 0xbb, 0x04, 0xe3, 0xb1, 0xad, 0xc8, 0xdf, 0x09,
+	0xe8, 0x09, 0x84, 0xb3, 0xb9, 0x22, 0x96, 0xb4, 0x2d, 0xdc, 0x59, 0x56, 0x6c, 0xeb, 0x3e, 0xab,
+	0x8a, 0xdb, 0xcb, 0x96, 0x2d, 0xc4, 0x1b, 0x22, 0xa7, 0x86, 0x91, 0x55, 0xa1, 0xb7, 0x4c, 0x9d,
+	0x0d, 0xff, 0xc4, 0xed, 0x2b, 0xfa, 0x65, 0xed, 0x47, 0xde, 0x4e, 0xd0, 0xa8, 0x16, 0xf1, 0x7c,
+	0x01, 0x46, 0xec, 0x1a, 0xdf, 0xb6, 0x6b, 0x56, 0x69, 0x8d, 0x3a, 0xeb, 0x96, 0x68, 0xf4, 0xde,
+	0x95, 0x1b, 0x1a, 0x44, 0x6f, 0xf9, 0x58, 0x6e, 0xd8, 0x95, 0x55, 0xc6, 0xb0, 0xb7, 0x6b, 0xb4,
+	0xb1, 0x81, 0xcc, 0xc2, 0xb0, 0xf8, 0x0d, 0xc6, 0xb6, 0x39, 0xb9, 0xe7, 0x45, 0xab, 0xb5, 0x19,
+	0x38, 0x2d, 0x61, 0xde, 0x62, 0x8e, 0x18, 0xc2, 0x4d, 0xea, 0x38, 0xa6, 0x55, 0xde, 0xac, 0x6b,
+	0xf4, 0xd8, 0x5d, 0x85, 0x33, 0xad, 0x3a, 0xa2, 0x63, 0x13, 0xd0, 0x77, 0x97, 0xb1, 0x90, 0x43,
+	0xf5, 0x0a, 0xed, 0x3f, 0x0a, 0x0e, 0xd3, 0x9b, 0x0e, 0x37, 0x77, 0x29, 0x67, 0xf2, 0x24, 0xf2,
+	0xed, 0xc4, 0x9d, 0xbf, 0x4a, 0xea, 0xf3, 0xb7, 0x2b, 0xfe, 0xfc, 0x9d, 0x83, 0x3e, 0xc3, 0x16,
+	0x89, 0x92, 0x83, 0x3d, 0x26, 0x89, 0x18, 0x9a, 0x3f, 0x9e, 0xc7, 0x9c, 0xcd, 0x8a, 0x6d, 0x5a,
+	0x5b, 0x07, 0x7b, 0xac, 0xd8, 0x6b, 0xe0, 0x97, 0x08, 0xa3, 0xa8, 0xe3, 0x30, 0x2e, 0x0f, 0xfe,
+	0xbe, 0xa2, 0x5b, 0x10, 0xf3, 0x07, 0x1f, 0xff, 0xbb, 0xdd, 0x78, 0xc4, 0x2d, 0x91, 0x71, 0xe8,
+	0x13, 0x49, 0x1c, 0x77, 0x59, 0xba, 0x67, 0x7b, 0x7d, 0x1a, 0xfc, 0xbb, 0x1b, 0x26, 0xe2, 0xbd,
+	0x45, 0xb2, 0x42, 0xd2, 0x4a, 0x58, 0x9a, 0x6c, 0xb8, 0x8d, 0x32, 0x3f, 0x94, 0x75, 0x81, 0xf6,
+	0x96, 0xbd, 0xcb, 0xe7, 0x4d, 0x38, 0x26, 0xb4, 0xdd, 0x65, 0x2c, 0xeb, 0x92, 0xec, 0x29, 0x53,
+	0x67, 0x95, 0x89, 0x37, 0x0c, 0x7f, 0xce, 0xe9, 0x77, 0x2b, 0x94, 0x4b, 0x9d, 0x47, 0xb3, 0xe9,
+	0x1c, 0xf6, 0x67, 0x6f, 0x85, 0x72, 0xa1, 0xfc, 0x2b, 0x30, 0x8c, 0x30, 0x75, 0xd3, 0x92, 0x49,
+	0x97, 0xb1, 0xee, 0x6c, 0xaa, 0x07, 0x5c, 0xb8, 0xb8, 0x26, 0xbe, 0x05, 0xa3, 0x75, 0xd0, 0x01,
+	0xe5, 0x3d, 0x19, 0xb7, 0xa7, 0xc6, 0x55, 0x77, 0x1b, 0x86, 0x9c, 0x07, 0x74, 0x0f, 0x73, 0x44,
+	0xba, 0x69, 0x8d, 0x1d, 0xcb, 0xa6, 0x7b, 0x40, 0xa8, 0x71, 0x13, 0x4a, 0xeb, 0x96, 0x98, 0x05,
+	0x32, 0x72, 0x96, 0x2c, 0xf7, 0x66, 0x9c, 0x05, 0x52, 0x83, 0x4b, 0xef, 0x10, 0x2e, 0x0f, 0x2f,
+	0x97, 0xd5, 0x97, 0x4d, 0xe5, 0x20, 0xaa, 0x71, 0x71, 0xce, 0xff, 0x6f, 0x06, 0xba, 0xe5, 0x4c,
+	0x27, 0xef, 0x2b, 0x30, 0x18, 0xca, 0xd6, 0x91, 0x2b, 0x2d, 0x8e, 0xa9, 0xc4, 0xec, 0x9f, 0x7a,
+	0x35, 0x83, 0xa4, 0xbb, 0xb2, 0xb4, 0xfc, 0xbb, 0x7f, 0xfd, 0xd7, 0x7b, 0x5d, 0xb3, 0xe4, 0x4c,
+	0x41, 0x08, 0xce, 0x05, 0xf2, 0xb1, 0xee, 0x67, 0x99, 0x71, 0x9d, 0x3b, 0x8e, 0x77, 0x2c, 0x93,
+	0xef, 0x2a, 0xd0, 0xe3, 0x26, 0xd8, 0xc8, 0x85, 0x34, 0x56, 0x43, 0x19, 0x3e, 0x75, 0xbe, 0x1d,
+	0x11, 0x44, 0x78, 0x5a, 0x22, 0x9c, 0x26, 0x93, 0x09, 0x08, 0xdd, 0x04, 0x1f, 0x79, 0xac, 0xc0,
+	0x40, 0x30, 0x27, 0x42, 0xae, 0xa5, 0x24, 0x25, 0x26, 0x91, 0xa6, 0x2e, 0x64, 0x92, 0x45, 0xc0,
+	0xd7, 0x25, 0xe0, 0xd7, 0xc8, 0xa5, 0x04, 0xc0, 0xc1, 0x2c, 0x4d, 0xe1, 0x11, 0x3e, 0x30, 0x1c,
+	0x16, 0x1e, 0xc9, 0x27, 0x85, 0x43, 0xf2, 0x2b, 0x05, 0x86, 0x83, 0x7a, 0x97, 0x2a, 0x95, 0x74,
+	0xbe, 0xc4, 0x27, 0x05, 0xd5, 0x85, 0x4c, 0xb2, 0xe8, 0xcb, 0x39, 0xe9, 0xcb, 0x69, 0x72, 0x2a,
+	0x85, 0x2f, 0xe4, 0x1f, 0x0a, 0x9c, 0x88, 0x20, 0xc7, 0xdc, 0x0c, 0x59, 0xca, 0x00, 0x22, 0x9c,
+	0x60, 0x52, 0x97, 0x9f, 0x47, 0x05, 0xba, 0x73, 0x4d, 0xba, 0xf3, 0x2a, 0x99, 0x4f, 0xe1, 0x0e,
+	0xca, 0xe2, 0x08, 0x1d, 0x92, 0x8f, 0x14, 0xf8, 0xdc, 0xba, 0x15, 0xe7, 0xdc, 0x1b, 0x29, 0x91,
+	0x25, 0x26, 0xcf, 0xd4, 0xa5, 0xe7, 0xd0, 0x80, 0xae, 0x2d, 0x4a, 0xd7, 0x2e, 0x93, 0x57, 0x13,
+	0x5c, 0x33, 0xad, 0x04, 0xcf, 0x74, 0xb3, 0x74, 0x48, 0x7e, 0xa9, 0xc0, 0xd0, 0xba, 0x95, 0x69,
+	0xce, 0xc5, 0xa4, 0xb1, 0xd4, 0x85, 0x4c, 0xb2, 0x29, 0xe7, 0x5c, 0xc0, 0x13, 0x87, 0xfc, 0x19,
+	0x81, 0x07, 0x9e, 0xf7, 0x17, 0x53, 0x2e, 0xde, 0xd8, 0x24, 0x87, 0x7a, 0x3d, 0xa3, 0x34, 0x82,
+	0xbf, 0x22, 0xc1, 0xcf, 0x93, 0xf3, 0x4d, 0xc0, 0xd7, 0xc5, 0x0a, 0x8f, 0xbc, 0xf2, 0x21, 0xf9,
+	0x9b, 0x02, 0xa4, 0x31, 0xed, 0x43, 0x52, 0xe1, 0x49, 0x4c, 0x36, 0xa9, 0xaf, 0x67, 0x15, 0x47,
+	0x7f, 0x96, 0xa4, 0x3f, 0x0b, 0xe4, 0x6a, 0xa2, 0x3f, 0xd1, 0x7f, 0xdf, 0xe8, 0x25, 0xca, 0x69,
+	0xd0, 0xb1, 0xdf, 0x29, 0x30, 0x12, 0xb6, 0x20, 0xa6, 0xd7, 0x62, 0x1b, 0x53, 0x24, 0xe3, 0x28,
+	0x25, 0xa6, 0x97, 0xb4, 0x39, 0xe9, 0xd5, 0x0c, 0x39, 0x9d, 0x6a, 0x94, 0xc8, 0xcf, 0x94, 0x7a,
+	0x5a, 0x83, 0x5c, 0x4e, 0x39, 0x41, 0x22, 0xf9, 0x17, 0xf5, 0xb5, 0xb6, 0xe5, 0x10, 0x6c, 0x41,
+	0x82, 0x7d, 0x85, 0xcc, 0x24, 0x1d, 0xd1, 0x28, 0x20, 0x38, 0x2f, 0xb1, 0xfd, 0x43, 0xf2, 0x63,
+	0x05, 0xfa, 0x3d, 0x2d, 0x82, 0xea, 0xcb, 0x29, 0xc9, 0xca, 0x84, 0x38, 0x26, 0x0b, 0xa4, 0xcd,
+	0x48, 0xc4, 0x2f, 0x93, 0xe9, 0x16, 0x88, 0xc9, 0x07, 0x0a, 0x1c, 0x8f, 0x5e, 0xfd, 0x48, 0xaa,
+	0xcd, 0x23, 0xe1, 0x1e, 0xaa, 0x2e, 0x66, 0x13, 0x4e, 0x49, 0xb5, 0x11, 0xc5, 0xfa, 0x58, 0x81,
+	0xfe, 0xc0, 0xed, 0x8e, 0xdc, 0x48, 0x63, 0xbe, 0xd5, 0x2d, 0x52, 0x7d, 0xf3, 0x39, 0xb5, 0xa0,
+	0x37, 0x67, 0xa5, 0x37, 0x9f, 0x27, 0x5a, 0x52, 0xe4, 0x14, 0x00, 0xfe, 0x1b, 0x05, 0x86, 0x23,
+	0xb7, 0xaf, 0x74, 0x27, 0x40, 0xfc, 0x05, 0x55, 0x5d, 0xc8, 0x24, 0x9b, 0x32, 0x28, 0x65, 0x11,
+	0xa0, 0x4f, 0x94, 0x86, 0x2c, 0x15, 0x49, 0xbb, 0x8f, 0xc7, 0xe7, 0xd8, 0xd4, 0xd7, 0xb3, 0x8a,
+	0xa3, 0x0b, 0x97, 0xa5, 0x0b, 0xe7, 0x49, 0x3e, 0xc1, 0x85, 0x4a, 0x58, 0xce, 0x5f, 0xbb, 0x7f,
+	0x50, 0x80, 0x44, 0x74, 0x8a, 0x25, 0x9c, 0x76, 0xbf, 0x7b, 0x1e, 0x6f, 0x92, 0xb3, 0x80, 0x2d,
+	0x07, 0x24, 0xe2, 0x0d, 0xf9, 0xbe, 0x02, 0x47, 0xe5, 0xce, 0x39, 0x9f, 0x92, 0xc6, 0xe0, 0xde,
+	0x7e, 0xb1, 0x2d, 0x99, 0x94, 0x41, 0x83, 0x81, 0xa7, 0xad, 0x24, 0xf9, 0x7d, 0x05, 0xfa, 0x03,
+	0xd9, 0x3f, 0x72, 0xb5, 0x0d, 0x8b, 0xe1, 0x8c, 0x61, 0x36, 0xb0, 0x97, 0x24, 0xd8, 0x02, 0x99,
+	0x6b, 0x0a, 0xb6, 0xe1, 0x66, 0xf0, 0x3d, 0x05, 0x8e, 0x79, 0xc7, 0xe7, 0x7c, 0xca, 0x11, 0x6d,
+	0x9b, 0xd8, 0x48, 0x06, 0x50, 0x3b, 0x25, 0xb1, 0x4e, 0x92, 0xf1, 0x26, 0x58, 0x45, 0xf8, 0x38,
+	0x2c, 0xa4, 0xc4, 0x83, 0x3e, 0xa6, 0xae, 0xd2, 0xed, 0x1e, 0xf1, 0xd9, 0x3b, 0x75, 0x21, 0x93,
+	0x6c, 0xca, 0x6d, 0xcf, 0xa8, 0xcb, 0x88, 0x6d, 0xef, 0xb8, 0x07, 0xdc, 0x4b, 0xf4, 0x90, 0xb6,
+	0xac, 0x47, 0x12, 0x72, 0xea, 0x62, 0x36, 0xe1, 0x36, 0xa6, 0xb1, 0x8f, 0x33, 0x08, 0xde, 0x4b,
+	0xa1, 0xb4, 0x07, 0x3e, 0x92, 0x1f, 0x52, 0x17, 0xb3, 0x09, 0xb7, 0x01, 0xde, 0xc7, 0xf9, 0x53,
+	0x05, 0x06, 0xfc, 0x87, 0x77, 0x31, 0xa3, 0xd3, 0x46, 0x1b, 0xd1, 0xb4, 0x8b, 0x7a, 0xa5, 0x7d,
+	0x41, 0x04, 0x3c, 0x2b, 0x01, 0x6b, 0xe4, 0x64, 0x02, 0xe0, 0x7a, 0x1e, 0xe0, 0x2f, 0x0a, 0x8c,
+	0x34, 0x24, 0x1d, 0xd2, 0xc5, 0xb0, 0x49, 0xd9, 0x10, 0xf5, 0x7a, 0x46, 0x69, 0x04, 0xbf, 0x20,
+	0xc1, 0x5f, 0x22, 0x17, 0x5b, 0x81, 0xf7, 0x24, 0xa3, 0xf7, 0xbd, 0xf0, 0x7f, 0xb9, 0xd3, 0x6d,
+	0x82, 0xb1, 0xff, 0x0e, 0x57, 0xaf, 0x65, 0x11, 0x4d, 0x19, 0x8a, 0x3f, 0x0c, 0xa3, 0x14, 0xc0,
+	0xc3, 0xa9, 0x8b, 0x74, 0xc0, 0x63, 0x93, 0x21, 0xea, 0xb5, 0x2c, 0xa2, 0x29, 0x81, 0x57, 0x42,
+	0x62, 0xcb, 0x5f, 0x7c, 0xf2, 0x74, 0x4a, 0xf9, 0xf0, 0xe9, 0x94, 0xf2, 0xcf, 0xa7, 0x53, 0xca,
+	0x77, 0x9e, 0x4d, 0x1d, 0xf9, 0xf0, 0xd9, 0xd4, 0x91, 0xbf, 0x3f, 0x9b, 0x3a, 0x72, 0xe7, 0x42,
+	0xe0, 0x2d, 0x31, 0xa0, 0xca, 0xc3, 0x53, 0xd8, 0x0f, 0x6a, 0x95, 0x4f, 0x8b, 0xdb, 0x3d, 0x32,
+	0x74, 0xbb, 0xf8, 0xff, 0x01, 0x00, 0xd9, 0x23, 0xbc, 0xd0, 0xbd, 0x31, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// GetTssAddress queries the tss address of the module.
+	// Deprecated: Moved to observer
+	// TODO: remove after v12 once upgrade testing is no longer needed with v11
+	// https://github.com/zeta-chain/node/issues/1547
+	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a OutTxTracker by index.
+	OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error)
+	// Queries a list of OutTxTracker items.
+	OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error)
+	OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error)
+	InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error)
+	InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error)
+	// Queries a InTxHashToCctx by index.
+	InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error)
+	// Queries a InTxHashToCctx data by index.
+	InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error)
+	// Queries a list of InTxHashToCctx items.
+	InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error)
+	// Queries a gasPrice by index.
+	GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error)
+	// Queries a list of gasPrice items.
+	GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error)
+	ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error)
+	ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error)
+	// Estimates the fees paid for a prospective cctx and the amount received on the receiver chain.
+	EstimateCctxFee(ctx context.Context, in *QueryEstimateCctxFeeRequest, opts ...grpc.CallOption) (*QueryEstimateCctxFeeResponse, error)
+	// Queries a lastBlockHeight by index.
+	LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error)
+	// Queries a list of lastBlockHeight items.
+	LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error)
+	// Queries a send by index.
+	Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
+	// Queries a send by nonce.
+	CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error)
+	// Queries a list of send items.
+	CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error)
+	// Queries a list of pending cctxs.
+	CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error)
+	// Queries a list of cctxs filtered by sender, receiver, status, chain and zeta height range.
+	CctxListFiltered(ctx context.Context, in *QueryListCctxFilteredRequest, opts ...grpc.CallOption) (*QueryListCctxFilteredResponse, error)
+	// Queries the cctxs in a terminal state queued for pruning, ordered by the zeta height at which they became prunable
+	CctxListPrunable(ctx context.Context, in *QueryListCctxPrunableRequest, opts ...grpc.CallOption) (*QueryListCctxPrunableResponse, error)
+	// Queries all the outbound rate limits.
+	RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error)
+	// Queries the usage and remaining capacity of the current window of an outbound rate limit.
+	RateLimitCapacity(ctx context.Context, in *QueryRateLimitCapacityRequest, opts ...grpc.CallOption) (*QueryRateLimitCapacityResponse, error)
+	ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error)
+	// Queries a list of lastMetaHeight items.
+	LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
+	out := new(QueryGetTssAddressResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GetTssAddress", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastBlockHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastBlockHeight(ctx, req.(*QueryGetLastBlockHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LastBlockHeightAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllLastBlockHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastBlockHeightAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastBlockHeightAll(ctx, req.(*QueryAllLastBlockHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Cctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCctxRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutTxTracker(ctx context.Context, in *QueryGetOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryGetOutTxTrackerResponse, error) {
+	out := new(QueryGetOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTracker", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Cctx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/Cctx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Cctx(ctx, req.(*QueryGetCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CctxByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCctxByNonceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutTxTrackerAll(ctx context.Context, in *QueryAllOutTxTrackerRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerResponse, error) {
+	out := new(QueryAllOutTxTrackerResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxByNonce(ctx, req.(*QueryGetCctxByNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCctxRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) OutTxTrackerAllByChain(ctx context.Context, in *QueryAllOutTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllOutTxTrackerByChainResponse, error) {
+	out := new(QueryAllOutTxTrackerByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxAll(ctx, req.(*QueryAllCctxRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CctxListPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListCctxPendingRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InTxTrackerAllByChain(ctx context.Context, in *QueryAllInTxTrackerByChainRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackerByChainResponse, error) {
+	out := new(QueryAllInTxTrackerByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CctxListPending(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxListPending",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CctxListPending(ctx, req.(*QueryListCctxPendingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ZetaAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryZetaAccountingRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InTxTrackerAll(ctx context.Context, in *QueryAllInTxTrackersRequest, opts ...grpc.CallOption) (*QueryAllInTxTrackersResponse, error) {
+	out := new(QueryAllInTxTrackersResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxTrackerAll", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ZetaAccounting(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/ZetaAccounting",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ZetaAccounting(ctx, req.(*QueryZetaAccountingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_LastZetaHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastZetaHeightRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) InTxHashToCctx(ctx context.Context, in *QueryGetInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryGetInTxHashToCctxResponse, error) {
+	out := new(QueryGetInTxHashToCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctx", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastZetaHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.crosschain.Query/LastZetaHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastZetaHeight(ctx, req.(*QueryLastZetaHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.crosschain.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetTssAddress",
-			Handler:    _Query_GetTssAddress_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "OutTxTracker",
-			Handler:    _Query_OutTxTracker_Handler,
-		},
-		{
-			MethodName: "OutTxTrackerAll",
-			Handler:    _Query_OutTxTrackerAll_Handler,
-		},
-		{
-			MethodName: "OutTxTrackerAllByChain",
-			Handler:    _Query_OutTxTrackerAllByChain_Handler,
-		},
-		{
-			MethodName: "InTxTrackerAllByChain",
-			Handler:    _Query_InTxTrackerAllByChain_Handler,
-		},
-		{
-			MethodName: "InTxTrackerAll",
-			Handler:    _Query_InTxTrackerAll_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctx",
-			Handler:    _Query_InTxHashToCctx_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctxData",
-			Handler:    _Query_InTxHashToCctxData_Handler,
-		},
-		{
-			MethodName: "InTxHashToCctxAll",
-			Handler:    _Query_InTxHashToCctxAll_Handler,
-		},
-		{
-			MethodName: "GasPrice",
-			Handler:    _Query_GasPrice_Handler,
-		},
-		{
-			MethodName: "GasPriceAll",
-			Handler:    _Query_GasPriceAll_Handler,
-		},
-		{
-			MethodName: "ConvertGasToZeta",
-			Handler:    _Query_ConvertGasToZeta_Handler,
-		},
-		{
-			MethodName: "ProtocolFee",
-			Handler:    _Query_ProtocolFee_Handler,
-		},
-		{
-			MethodName: "LastBlockHeight",
-			Handler:    _Query_LastBlockHeight_Handler,
-		},
-		{
-			MethodName: "LastBlockHeightAll",
-			Handler:    _Query_LastBlockHeightAll_Handler,
-		},
-		{
-			MethodName: "Cctx",
-			Handler:    _Query_Cctx_Handler,
-		},
-		{
-			MethodName: "CctxByNonce",
-			Handler:    _Query_CctxByNonce_Handler,
-		},
-		{
-			MethodName: "CctxAll",
-			Handler:    _Query_CctxAll_Handler,
-		},
-		{
-			MethodName: "CctxListPending",
-			Handler:    _Query_CctxListPending_Handler,
-		},
-		{
-			MethodName: "ZetaAccounting",
-			Handler:    _Query_ZetaAccounting_Handler,
-		},
-		{
-			MethodName: "LastZetaHeight",
-			Handler:    _Query_LastZetaHeight_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "crosschain/query.proto",
+	return out, nil
 }
 
-func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) InTxHashToCctxData(ctx context.Context, in *QueryInTxHashToCctxDataRequest, opts ...grpc.CallOption) (*QueryInTxHashToCctxDataResponse, error) {
+	out := new(QueryInTxHashToCctxDataResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) InTxHashToCctxAll(ctx context.Context, in *QueryAllInTxHashToCctxRequest, opts ...grpc.CallOption) (*QueryAllInTxHashToCctxResponse, error) {
+	out := new(QueryAllInTxHashToCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GasPrice(ctx context.Context, in *QueryGetGasPriceRequest, opts ...grpc.CallOption) (*QueryGetGasPriceResponse, error) {
+	out := new(QueryGetGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPrice", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GasPriceAll(ctx context.Context, in *QueryAllGasPriceRequest, opts ...grpc.CallOption) (*QueryAllGasPriceResponse, error) {
+	out := new(QueryAllGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/GasPriceAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ConvertGasToZeta(ctx context.Context, in *QueryConvertGasToZetaRequest, opts ...grpc.CallOption) (*QueryConvertGasToZetaResponse, error) {
+	out := new(QueryConvertGasToZetaResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryZetaAccountingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProtocolFee(ctx context.Context, in *QueryMessagePassingProtocolFeeRequest, opts ...grpc.CallOption) (*QueryMessagePassingProtocolFeeResponse, error) {
+	out := new(QueryMessagePassingProtocolFeeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ProtocolFee", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryZetaAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateCctxFee(ctx context.Context, in *QueryEstimateCctxFeeRequest, opts ...grpc.CallOption) (*QueryEstimateCctxFeeResponse, error) {
+	out := new(QueryEstimateCctxFeeResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/EstimateCctxFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryZetaAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) LastBlockHeight(ctx context.Context, in *QueryGetLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryGetLastBlockHeightResponse, error) {
+	out := new(QueryGetLastBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryZetaAccountingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastBlockHeightAll(ctx context.Context, in *QueryAllLastBlockHeightRequest, opts ...grpc.CallOption) (*QueryAllLastBlockHeightResponse, error) {
+	out := new(QueryAllLastBlockHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryZetaAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryZetaAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AbortedZetaAmount) > 0 {
-		i -= len(m.AbortedZetaAmount)
-		copy(dAtA[i:], m.AbortedZetaAmount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbortedZetaAmount)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Cctx(ctx context.Context, in *QueryGetCctxRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
+	out := new(QueryGetCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/Cctx", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxByNonce(ctx context.Context, in *QueryGetCctxByNonceRequest, opts ...grpc.CallOption) (*QueryGetCctxResponse, error) {
+	out := new(QueryGetCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxByNonce", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxAll(ctx context.Context, in *QueryAllCctxRequest, opts ...grpc.CallOption) (*QueryAllCctxResponse, error) {
+	out := new(QueryAllCctxResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) CctxListPending(ctx context.Context, in *QueryListCctxPendingRequest, opts ...grpc.CallOption) (*QueryListCctxPendingResponse, error) {
+	out := new(QueryListCctxPendingResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxListPending", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CctxListFiltered(ctx context.Context, in *QueryListCctxFilteredRequest, opts ...grpc.CallOption) (*QueryListCctxFilteredResponse, error) {
+	out := new(QueryListCctxFilteredResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxListFiltered", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) CctxListPrunable(ctx context.Context, in *QueryListCctxPrunableRequest, opts ...grpc.CallOption) (*QueryListCctxPrunableResponse, error) {
+	out := new(QueryListCctxPrunableResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/CctxListPrunable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RateLimitAll(ctx context.Context, in *QueryAllRateLimitRequest, opts ...grpc.CallOption) (*QueryAllRateLimitResponse, error) {
+	out := new(QueryAllRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/RateLimitAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RateLimitCapacity(ctx context.Context, in *QueryRateLimitCapacityRequest, opts ...grpc.CallOption) (*QueryRateLimitCapacityResponse, error) {
+	out := new(QueryRateLimitCapacityResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/RateLimitCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.OutTxTracker.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ZetaAccounting(ctx context.Context, in *QueryZetaAccountingRequest, opts ...grpc.CallOption) (*QueryZetaAccountingResponse, error) {
+	out := new(QueryZetaAccountingResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/ZetaAccounting", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastZetaHeight(ctx context.Context, in *QueryLastZetaHeightRequest, opts ...grpc.CallOption) (*QueryLastZetaHeightResponse, error) {
+	out := new(QueryLastZetaHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Query/LastZetaHeight", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// GetTssAddress queries the tss address of the module.
+	// Deprecated: Moved to observer
+	// TODO: remove after v12 once upgrade testing is no longer needed with v11
+	// https://github.com/zeta-chain/node/issues/1547
+	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a OutTxTracker by index.
+	OutTxTracker(context.Context, *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error)
+	// Queries a list of OutTxTracker items.
+	OutTxTrackerAll(context.Context, *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error)
+	OutTxTrackerAllByChain(context.Context, *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error)
+	InTxTrackerAllByChain(context.Context, *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error)
+	InTxTrackerAll(context.Context, *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error)
+	// Queries a InTxHashToCctx by index.
+	InTxHashToCctx(context.Context, *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error)
+	// Queries a InTxHashToCctx data by index.
+	InTxHashToCctxData(context.Context, *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error)
+	// Queries a list of InTxHashToCctx items.
+	InTxHashToCctxAll(context.Context, *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error)
+	// Queries a gasPrice by index.
+	GasPrice(context.Context, *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error)
+	// Queries a list of gasPrice items.
+	GasPriceAll(context.Context, *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error)
+	ConvertGasToZeta(context.Context, *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error)
+	ProtocolFee(context.Context, *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error)
+	// Estimates the fees paid for a prospective cctx and the amount received on the receiver chain.
+	EstimateCctxFee(context.Context, *QueryEstimateCctxFeeRequest) (*QueryEstimateCctxFeeResponse, error)
+	// Queries a lastBlockHeight by index.
+	LastBlockHeight(context.Context, *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error)
+	// Queries a list of lastBlockHeight items.
+	LastBlockHeightAll(context.Context, *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error)
+	// Queries a send by index.
+	Cctx(context.Context, *QueryGetCctxRequest) (*QueryGetCctxResponse, error)
+	// Queries a send by nonce.
+	CctxByNonce(context.Context, *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error)
+	// Queries a list of send items.
+	CctxAll(context.Context, *QueryAllCctxRequest) (*QueryAllCctxResponse, error)
+	// Queries a list of pending cctxs.
+	CctxListPending(context.Context, *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error)
+	// Queries a list of cctxs filtered by sender, receiver, status, chain and zeta height range.
+	CctxListFiltered(context.Context, *QueryListCctxFilteredRequest) (*QueryListCctxFilteredResponse, error)
+	// Queries the cctxs in a terminal state queued for pruning, ordered by the zeta height at which they became prunable
+	CctxListPrunable(context.Context, *QueryListCctxPrunableRequest) (*QueryListCctxPrunableResponse, error)
+	// Queries all the outbound rate limits.
+	RateLimitAll(context.Context, *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error)
+	// Queries the usage and remaining capacity of the current window of an outbound rate limit.
+	RateLimitCapacity(context.Context, *QueryRateLimitCapacityRequest) (*QueryRateLimitCapacityResponse, error)
+	ZetaAccounting(context.Context, *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error)
+	// Queries a list of lastMetaHeight items.
+	LastZetaHeight(context.Context, *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error)
 }
 
-func (m *QueryAllOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAllOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
 }
-
-func (m *QueryAllOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryAllOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OutTxTracker) > 0 {
-		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) OutTxTracker(ctx context.Context, req *QueryGetOutTxTrackerRequest) (*QueryGetOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTracker not implemented")
 }
-
-func (m *QueryAllOutTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) OutTxTrackerAll(ctx context.Context, req *QueryAllOutTxTrackerRequest) (*QueryAllOutTxTrackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) OutTxTrackerAllByChain(ctx context.Context, req *QueryAllOutTxTrackerByChainRequest) (*QueryAllOutTxTrackerByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutTxTrackerAllByChain not implemented")
+}
+func (*UnimplementedQueryServer) InTxTrackerAllByChain(ctx context.Context, req *QueryAllInTxTrackerByChainRequest) (*QueryAllInTxTrackerByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAllByChain not implemented")
+}
+func (*UnimplementedQueryServer) InTxTrackerAll(ctx context.Context, req *QueryAllInTxTrackersRequest) (*QueryAllInTxTrackersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxTrackerAll not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctx(ctx context.Context, req *QueryGetInTxHashToCctxRequest) (*QueryGetInTxHashToCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctx not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctxData(ctx context.Context, req *QueryInTxHashToCctxDataRequest) (*QueryInTxHashToCctxDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxData not implemented")
+}
+func (*UnimplementedQueryServer) InTxHashToCctxAll(ctx context.Context, req *QueryAllInTxHashToCctxRequest) (*QueryAllInTxHashToCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InTxHashToCctxAll not implemented")
+}
+func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *QueryGetGasPriceRequest) (*QueryGetGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+}
+func (*UnimplementedQueryServer) GasPriceAll(ctx context.Context, req *QueryAllGasPriceRequest) (*QueryAllGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceAll not implemented")
+}
+func (*UnimplementedQueryServer) ConvertGasToZeta(ctx context.Context, req *QueryConvertGasToZetaRequest) (*QueryConvertGasToZetaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertGasToZeta not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFee(ctx context.Context, req *QueryMessagePassingProtocolFeeRequest) (*QueryMessagePassingProtocolFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFee not implemented")
+}
+func (*UnimplementedQueryServer) EstimateCctxFee(ctx context.Context, req *QueryEstimateCctxFeeRequest) (*QueryEstimateCctxFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateCctxFee not implemented")
+}
+func (*UnimplementedQueryServer) LastBlockHeight(ctx context.Context, req *QueryGetLastBlockHeightRequest) (*QueryGetLastBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeight not implemented")
+}
+func (*UnimplementedQueryServer) LastBlockHeightAll(ctx context.Context, req *QueryAllLastBlockHeightRequest) (*QueryAllLastBlockHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlockHeightAll not implemented")
+}
+func (*UnimplementedQueryServer) Cctx(ctx context.Context, req *QueryGetCctxRequest) (*QueryGetCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cctx not implemented")
+}
+func (*UnimplementedQueryServer) CctxByNonce(ctx context.Context, req *QueryGetCctxByNonceRequest) (*QueryGetCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxByNonce not implemented")
+}
+func (*UnimplementedQueryServer) CctxAll(ctx context.Context, req *QueryAllCctxRequest) (*QueryAllCctxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxAll not implemented")
+}
+func (*UnimplementedQueryServer) CctxListPending(ctx context.Context, req *QueryListCctxPendingRequest) (*QueryListCctxPendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxListPending not implemented")
+}
+func (*UnimplementedQueryServer) CctxListFiltered(ctx context.Context, req *QueryListCctxFilteredRequest) (*QueryListCctxFilteredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxListFiltered not implemented")
+}
+func (*UnimplementedQueryServer) CctxListPrunable(ctx context.Context, req *QueryListCctxPrunableRequest) (*QueryListCctxPrunableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CctxListPrunable not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitAll(ctx context.Context, req *QueryAllRateLimitRequest) (*QueryAllRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitAll not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitCapacity(ctx context.Context, req *QueryRateLimitCapacityRequest) (*QueryRateLimitCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitCapacity not implemented")
+}
+func (*UnimplementedQueryServer) ZetaAccounting(ctx context.Context, req *QueryZetaAccountingRequest) (*QueryZetaAccountingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZetaAccounting not implemented")
+}
+func (*UnimplementedQueryServer) LastZetaHeight(ctx context.Context, req *QueryLastZetaHeightRequest) (*QueryLastZetaHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastZetaHeight not implemented")
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Chain != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddress(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GetTssAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllOutTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllOutTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.OutTxTracker) > 0 {
-		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/Params",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutTxTracker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOutTxTrackerRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllInTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTracker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTracker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTracker(ctx, req.(*QueryGetOutTxTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_OutTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutTxTrackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTrackerAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTrackerAll(ctx, req.(*QueryAllOutTxTrackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllOutTxTrackerByChainRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/OutTxTrackerAllByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutTxTrackerAllByChain(ctx, req.(*QueryAllOutTxTrackerByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_InTxTrackerAllByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInTxTrackerByChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InTxTrackerAllByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAllByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxTrackerAllByChain(ctx, req.(*QueryAllInTxTrackerByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_InTxTrackerAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInTxTrackersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InTxTracker) > 0 {
-		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).InTxTrackerAll(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxTrackerAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxTrackerAll(ctx, req.(*QueryAllInTxTrackersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_InTxHashToCctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetInTxHashToCctxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctx(ctx, req.(*QueryGetInTxHashToCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_InTxHashToCctxData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInTxHashToCctxDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctxData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctxData(ctx, req.(*QueryInTxHashToCctxDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_InTxHashToCctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllInTxHashToCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).InTxHashToCctxAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/InTxHashToCctxAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InTxHashToCctxAll(ctx, req.(*QueryAllInTxHashToCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGasPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrice(ctx, req.(*QueryGetGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GasPriceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/GasPriceAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceAll(ctx, req.(*QueryAllGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ConvertGasToZeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConvertGasToZetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InTxTracker) > 0 {
-		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ConvertGasToZeta(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ConvertGasToZeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConvertGasToZeta(ctx, req.(*QueryConvertGasToZetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ProtocolFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMessagePassingProtocolFeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ProtocolFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFee(ctx, req.(*QueryMessagePassingProtocolFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateCctxFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateCctxFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateCctxFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/EstimateCctxFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateCctxFee(ctx, req.(*QueryEstimateCctxFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0xa
+func _Query_LastBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetLastBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockHeight(ctx, req.(*QueryGetLastBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastBlockHeightAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllLastBlockHeightRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastBlockHeightAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastBlockHeightAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastBlockHeightAll(ctx, req.(*QueryAllLastBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Cctx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCctxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Cctx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/Cctx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Cctx(ctx, req.(*QueryGetCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.InTxHashToCctx.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_CctxByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCctxByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxByNonce(ctx, req.(*QueryGetCctxByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCctxRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxAll(ctx, req.(*QueryAllCctxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CctxListPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCctxPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CctxListPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxListPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxListPending(ctx, req.(*QueryListCctxPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
-		i--
-		dAtA[i] = 0xa
+func _Query_CctxListFiltered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCctxFilteredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxListFiltered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxListFiltered",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxListFiltered(ctx, req.(*QueryListCctxFilteredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CctxListPrunable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCctxPrunableRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CctxListPrunable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/CctxListPrunable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CctxListPrunable(ctx, req.(*QueryListCctxPrunableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RateLimitAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllRateLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/RateLimitAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitAll(ctx, req.(*QueryAllRateLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryInTxHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CrossChainTxs) > 0 {
-		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_RateLimitCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/RateLimitCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitCapacity(ctx, req.(*QueryRateLimitCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ZetaAccounting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZetaAccountingRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ZetaAccounting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/ZetaAccounting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZetaAccounting(ctx, req.(*QueryZetaAccountingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LastZetaHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastZetaHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastZetaHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Query/LastZetaHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastZetaHeight(ctx, req.(*QueryLastZetaHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.crosschain.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTssAddress",
+			Handler:    _Query_GetTssAddress_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "OutTxTracker",
+			Handler:    _Query_OutTxTracker_Handler,
+		},
+		{
+			MethodName: "OutTxTrackerAll",
+			Handler:    _Query_OutTxTrackerAll_Handler,
+		},
+		{
+			MethodName: "OutTxTrackerAllByChain",
+			Handler:    _Query_OutTxTrackerAllByChain_Handler,
+		},
+		{
+			MethodName: "InTxTrackerAllByChain",
+			Handler:    _Query_InTxTrackerAllByChain_Handler,
+		},
+		{
+			MethodName: "InTxTrackerAll",
+			Handler:    _Query_InTxTrackerAll_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctx",
+			Handler:    _Query_InTxHashToCctx_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctxData",
+			Handler:    _Query_InTxHashToCctxData_Handler,
+		},
+		{
+			MethodName: "InTxHashToCctxAll",
+			Handler:    _Query_InTxHashToCctxAll_Handler,
+		},
+		{
+			MethodName: "GasPrice",
+			Handler:    _Query_GasPrice_Handler,
+		},
+		{
+			MethodName: "GasPriceAll",
+			Handler:    _Query_GasPriceAll_Handler,
+		},
+		{
+			MethodName: "ConvertGasToZeta",
+			Handler:    _Query_ConvertGasToZeta_Handler,
+		},
+		{
+			MethodName: "ProtocolFee",
+			Handler:    _Query_ProtocolFee_Handler,
+		},
+		{
+			MethodName: "EstimateCctxFee",
+			Handler:    _Query_EstimateCctxFee_Handler,
+		},
+		{
+			MethodName: "LastBlockHeight",
+			Handler:    _Query_LastBlockHeight_Handler,
+		},
+		{
+			MethodName: "LastBlockHeightAll",
+			Handler:    _Query_LastBlockHeightAll_Handler,
+		},
+		{
+			MethodName: "Cctx",
+			Handler:    _Query_Cctx_Handler,
+		},
+		{
+			MethodName: "CctxByNonce",
+			Handler:    _Query_CctxByNonce_Handler,
+		},
+		{
+			MethodName: "CctxAll",
+			Handler:    _Query_CctxAll_Handler,
+		},
+		{
+			MethodName: "CctxListPending",
+			Handler:    _Query_CctxListPending_Handler,
+		},
+		{
+			MethodName: "CctxListFiltered",
+			Handler:    _Query_CctxListFiltered_Handler,
+		},
+		{
+			MethodName: "CctxListPrunable",
+			Handler:    _Query_CctxListPrunable_Handler,
+		},
+		{
+			MethodName: "RateLimitAll",
+			Handler:    _Query_RateLimitAll_Handler,
+		},
+		{
+			MethodName: "RateLimitCapacity",
+			Handler:    _Query_RateLimitCapacity_Handler,
+		},
+		{
+			MethodName: "ZetaAccounting",
+			Handler:    _Query_ZetaAccounting_Handler,
+		},
+		{
+			MethodName: "LastZetaHeight",
+			Handler:    _Query_LastZetaHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "crosschain/query.proto",
 }
 
-func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3837,46 +3944,20 @@ func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.InTxHashToCctx) > 0 {
-		for iNdEx := len(m.InTxHashToCctx) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InTxHashToCctx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3886,27 +3967,34 @@ func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryZetaAccountingRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3916,32 +4004,20 @@ func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasPrice != nil {
-		{
-			size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryZetaAccountingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3951,32 +4027,27 @@ func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryZetaAccountingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.AbortedZetaAmount) > 0 {
+		i -= len(m.AbortedZetaAmount)
+		copy(dAtA[i:], m.AbortedZetaAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbortedZetaAmount)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3986,46 +4057,20 @@ func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GasPrice) > 0 {
-		for iNdEx := len(m.GasPrice) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.GasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4035,27 +4080,30 @@ func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4065,32 +4113,30 @@ func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastBlockHeight != nil {
-		{
-			size, err := m.LastBlockHeight.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.ChainID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4100,32 +4146,30 @@ func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.OutTxTracker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4135,12 +4179,12 @@ func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4155,26 +4199,12 @@ func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LastBlockHeight) > 0 {
-		for iNdEx := len(m.LastBlockHeight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LastBlockHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4184,27 +4214,46 @@ func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.OutTxTracker) > 0 {
+		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4214,30 +4263,37 @@ func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ChainID != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+	if m.Chain != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Chain))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllOutTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4247,19 +4303,19 @@ func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllOutTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CrossChainTx != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.CrossChainTx.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4267,12 +4323,26 @@ func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.OutTxTracker) > 0 {
+		for iNdEx := len(m.OutTxTracker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackerByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4282,12 +4352,12 @@ func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4302,12 +4372,17 @@ func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackerByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4317,12 +4392,12 @@ func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackerByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4339,10 +4414,10 @@ func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.CrossChainTx) > 0 {
-		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InTxTracker) > 0 {
+		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4356,7 +4431,7 @@ func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListCctxPendingRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4366,30 +4441,32 @@ func (m *QueryListCctxPendingRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListCctxPendingRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListCctxPendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListCctxPendingResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxTrackersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4399,25 +4476,32 @@ func (m *QueryListCctxPendingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListCctxPendingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxTrackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TotalPending != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.CrossChainTx) > 0 {
-		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.InTxTracker) > 0 {
+		for iNdEx := len(m.InTxTracker) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.InTxTracker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4431,7 +4515,7 @@ func (m *QueryListCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4441,20 +4525,27 @@ func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4464,25 +4555,30 @@ func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+	{
+		size, err := m.InTxHashToCctx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryInTxHashToCctxDataRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4492,32 +4588,27 @@ func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryConvertGasToZetaRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConvertGasToZetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.GasLimit) > 0 {
-		i -= len(m.GasLimit)
-		copy(dAtA[i:], m.GasLimit)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InTxHash)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryInTxHashToCctxDataResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4527,39 +4618,69 @@ func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryConvertGasToZetaResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConvertGasToZetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryInTxHashToCctxDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ZetaBlockHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ZetaBlockHeight))
-		i--
-		dAtA[i] = 0x18
+	if len(m.CrossChainTxs) > 0 {
+		for iNdEx := len(m.CrossChainTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if len(m.ProtocolFeeInZeta) > 0 {
-		i -= len(m.ProtocolFeeInZeta)
-		copy(dAtA[i:], m.ProtocolFeeInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolFeeInZeta)))
-		i--
-		dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllInTxHashToCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.OutboundGasInZeta) > 0 {
-		i -= len(m.OutboundGasInZeta)
-		copy(dAtA[i:], m.OutboundGasInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundGasInZeta)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllInTxHashToCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllInTxHashToCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllInTxHashToCctxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4569,20 +4690,46 @@ func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllInTxHashToCctxResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllInTxHashToCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InTxHashToCctx) > 0 {
+		for iNdEx := len(m.InTxHashToCctx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InTxHashToCctx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetGasPriceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4592,657 +4739,3940 @@ func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeInZeta) > 0 {
-		i -= len(m.FeeInZeta)
-		copy(dAtA[i:], m.FeeInZeta)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeInZeta)))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryGetTssAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryGetGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.GasPrice != nil {
+		{
+			size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryZetaAccountingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryZetaAccountingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.AbortedZetaAmount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainID != 0 {
-		n += 1 + sovQuery(uint64(m.ChainID))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.GasPrice) > 0 {
+		for iNdEx := len(m.GasPrice) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasPrice[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.OutTxTracker.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllOutTxTrackerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutTxTracker) > 0 {
-		for _, e := range m.OutTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllOutTxTrackerByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Chain != 0 {
-		n += 1 + sovQuery(uint64(m.Chain))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllOutTxTrackerByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.OutTxTracker) > 0 {
-		for _, e := range m.OutTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.LastBlockHeight != nil {
+		{
+			size, err := m.LastBlockHeight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxTrackerByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllLastBlockHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllInTxTrackerByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.InTxTracker) > 0 {
-		for _, e := range m.InTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllLastBlockHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInTxTrackersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllLastBlockHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxTrackersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.InTxTracker) > 0 {
-		for _, e := range m.InTxTracker {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllLastBlockHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetInTxHashToCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllLastBlockHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetInTxHashToCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllLastBlockHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.InTxHashToCctx.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryInTxHashToCctxDataRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.InTxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LastBlockHeight) > 0 {
+		for iNdEx := len(m.LastBlockHeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastBlockHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryInTxHashToCctxDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CrossChainTxs) > 0 {
-		for _, e := range m.CrossChainTxs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryGetCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllInTxHashToCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllInTxHashToCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetCctxByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCctxByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCctxByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.InTxHashToCctx) > 0 {
-		for _, e := range m.InTxHashToCctx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainID != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainID))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.CrossChainTx != nil {
+		{
+			size, err := m.CrossChainTx.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCctxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCctxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCctxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.GasPrice) > 0 {
-		for _, e := range m.GasPrice {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetLastBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllCctxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetLastBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastBlockHeight != nil {
-		l = m.LastBlockHeight.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllCctxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllLastBlockHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCctxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllLastBlockHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LastBlockHeight) > 0 {
-		for _, e := range m.LastBlockHeight {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListCctxPendingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCctxByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainID != 0 {
-		n += 1 + sovQuery(uint64(m.ChainID))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	return n
+func (m *QueryListCctxPendingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListCctxPendingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CrossChainTx != nil {
-		l = m.CrossChainTx.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllCctxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCctxResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CrossChainTx) > 0 {
-		for _, e := range m.CrossChainTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListCctxPendingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListCctxPendingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
-	}
-	return n
+func (m *QueryListCctxPendingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListCctxPendingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListCctxPendingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.TotalPending != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPending))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.CrossChainTx) > 0 {
-		for _, e := range m.CrossChainTx {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.TotalPending != 0 {
-		n += 1 + sovQuery(uint64(m.TotalPending))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastZetaHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListCctxFilteredRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLastZetaHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	return n
+func (m *QueryListCctxFilteredRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryConvertGasToZetaRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListCctxFilteredRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.GasLimit)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *QueryConvertGasToZetaResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x30
 	}
-	var l int
-	_ = l
-	l = len(m.OutboundGasInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ReceiverChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiverChainId))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.ProtocolFeeInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SenderChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Status) > 0 {
+		dAtA25 := make([]byte, len(m.Status)*10)
+		var j24 int
+		for _, num := range m.Status {
+			for num >= 1<<7 {
+				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j24++
+			}
+			dAtA25[j24] = uint8(num)
+			j24++
+		}
+		i -= j24
+		copy(dAtA[i:], dAtA25[:j24])
+		i = encodeVarintQuery(dAtA, i, uint64(j24))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ZetaBlockHeight != 0 {
-		n += 1 + sovQuery(uint64(m.ZetaBlockHeight))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMessagePassingProtocolFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListCctxFilteredResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryMessagePassingProtocolFeeResponse) Size() (n int) {
+func (m *QueryListCctxFilteredResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCctxFilteredResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCctxPrunableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCctxPrunableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCctxPrunableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListCctxPrunableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListCctxPrunableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListCctxPrunableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CrossChainTx) > 0 {
+		for iNdEx := len(m.CrossChainTx) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossChainTx[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingCapacity.Size()
+		i -= size
+		if _, err := m.RemainingCapacity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.WindowUsage.Size()
+		i -= size
+		if _, err := m.WindowUsage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastZetaHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastZetaHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastZetaHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastZetaHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastZetaHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastZetaHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvertGasToZetaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvertGasToZetaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertGasToZetaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasLimit) > 0 {
+		i -= len(m.GasLimit)
+		copy(dAtA[i:], m.GasLimit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GasLimit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConvertGasToZetaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConvertGasToZetaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConvertGasToZetaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ZetaBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ZetaBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProtocolFeeInZeta) > 0 {
+		i -= len(m.ProtocolFeeInZeta)
+		copy(dAtA[i:], m.ProtocolFeeInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProtocolFeeInZeta)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OutboundGasInZeta) > 0 {
+		i -= len(m.OutboundGasInZeta)
+		copy(dAtA[i:], m.OutboundGasInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OutboundGasInZeta)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeInZeta) > 0 {
+		i -= len(m.FeeInZeta)
+		copy(dAtA[i:], m.FeeInZeta)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeInZeta)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateCctxFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateCctxFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCctxFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CoinType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CoinType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReceiverChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReceiverChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SenderChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SenderChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateCctxFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateCctxFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateCctxFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReceiveAmount.Size()
+		i -= size
+		if _, err := m.ReceiveAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TotalFee.Size()
+		i -= size
+		if _, err := m.TotalFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SwapAmountIn.Size()
+		i -= size
+		if _, err := m.SwapAmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ProtocolFeeInZeta.Size()
+		i -= size
+		if _, err := m.ProtocolFeeInZeta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.GasFeeInZeta.Size()
+		i -= size
+		if _, err := m.GasFeeInZeta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ProtocolFlatFee.Size()
+		i -= size
+		if _, err := m.ProtocolFlatFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.GasFee.Size()
+		i -= size
+		if _, err := m.GasFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.GasPrice.Size()
+		i -= size
+		if _, err := m.GasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryGetTssAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryZetaAccountingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryZetaAccountingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AbortedZetaAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OutTxTracker.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllOutTxTrackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutTxTrackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutTxTracker) > 0 {
+		for _, e := range m.OutTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutTxTrackerByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chain != 0 {
+		n += 1 + sovQuery(uint64(m.Chain))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllOutTxTrackerByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OutTxTracker) > 0 {
+		for _, e := range m.OutTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackerByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackerByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InTxTracker) > 0 {
+		for _, e := range m.InTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxTrackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InTxTracker) > 0 {
+		for _, e := range m.InTxTracker {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInTxHashToCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInTxHashToCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InTxHashToCctx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInTxHashToCctxDataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InTxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInTxHashToCctxDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTxs) > 0 {
+		for _, e := range m.CrossChainTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllInTxHashToCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInTxHashToCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InTxHashToCctx) > 0 {
+		for _, e := range m.InTxHashToCctx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GasPrice) > 0 {
+		for _, e := range m.GasPrice {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLastBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetLastBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastBlockHeight != nil {
+		l = m.LastBlockHeight.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLastBlockHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllLastBlockHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LastBlockHeight) > 0 {
+		for _, e := range m.LastBlockHeight {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCctxByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainID != 0 {
+		n += 1 + sovQuery(uint64(m.ChainID))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryGetCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CrossChainTx != nil {
+		l = m.CrossChainTx.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllCctxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCctxPendingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryListCctxPendingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPending != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPending))
+	}
+	return n
+}
+
+func (m *QueryListCctxFilteredRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Status) > 0 {
+		l = 0
+		for _, e := range m.Status {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.SenderChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SenderChainId))
+	}
+	if m.ReceiverChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiverChainId))
+	}
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCctxFilteredResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCctxPrunableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListCctxPrunableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrossChainTx) > 0 {
+		for _, e := range m.CrossChainTx {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.WindowUsage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingCapacity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLastZetaHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastZetaHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryConvertGasToZetaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.GasLimit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConvertGasToZetaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutboundGasInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProtocolFeeInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ZetaBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ZetaBlockHeight))
+	}
+	return n
+}
+
+func (m *QueryMessagePassingProtocolFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMessagePassingProtocolFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeInZeta)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateCctxFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderChainId != 0 {
+		n += 1 + sovQuery(uint64(m.SenderChainId))
+	}
+	if m.ReceiverChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ReceiverChainId))
+	}
+	if m.CoinType != 0 {
+		n += 1 + sovQuery(uint64(m.CoinType))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryEstimateCctxFeeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.FeeInZeta)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	l = m.GasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GasFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolFlatFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GasFeeInZeta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProtocolFeeInZeta.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapAmountIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReceiveAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZetaAccountingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZetaAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryZetaAccountingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryZetaAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbortedZetaAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AbortedZetaAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			m.ChainID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainID |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
+			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
+			}
+			m.Chain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Chain |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
+			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
+			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
+			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InTxHashToCctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
+			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5252,25 +8682,61 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5292,7 +8758,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5315,17 +8781,17 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5335,29 +8801,31 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.InTxHashToCctx = append(m.InTxHashToCctx, InTxHashToCctx{})
+			if err := m.InTxHashToCctx[len(m.InTxHashToCctx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5367,74 +8835,28 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZetaAccountingRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZetaAccountingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5456,7 +8878,7 @@ func (m *QueryZetaAccountingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5479,15 +8901,15 @@ func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryZetaAccountingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryZetaAccountingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbortedZetaAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5515,7 +8937,7 @@ func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AbortedZetaAmount = string(dAtA[iNdEx:postIndex])
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5538,7 +8960,7 @@ func (m *QueryZetaAccountingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5561,12 +8983,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPrice == nil {
+				m.GasPrice = &GasPrice{}
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5588,7 +9046,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5611,15 +9069,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5646,7 +9104,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5671,7 +9132,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5694,36 +9155,17 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			m.ChainID = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainID |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5733,64 +9175,29 @@ func (m *QueryGetOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.GasPrice = append(m.GasPrice, &GasPrice{})
+			if err := m.GasPrice[len(m.GasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5817,7 +9224,10 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OutTxTracker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5842,7 +9252,7 @@ func (m *QueryGetOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5865,17 +9275,17 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5885,27 +9295,23 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5928,7 +9334,7 @@ func (m *QueryAllOutTxTrackerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5951,49 +9357,15 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
-			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6020,10 +9392,10 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.LastBlockHeight == nil {
+				m.LastBlockHeight = &LastBlockHeight{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6048,7 +9420,7 @@ func (m *QueryAllOutTxTrackerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6071,32 +9443,13 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chain", wireType)
-			}
-			m.Chain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Chain |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6153,7 +9506,7 @@ func (m *QueryAllOutTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6176,15 +9529,15 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllOutTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6211,8 +9564,8 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutTxTracker = append(m.OutTxTracker, OutTxTracker{})
-			if err := m.OutTxTracker[len(m.OutTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
+			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6273,7 +9626,7 @@ func (m *QueryAllOutTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6296,36 +9649,17 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6335,27 +9669,23 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Index = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6378,7 +9708,7 @@ func (m *QueryAllInTxTrackerByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6401,17 +9731,17 @@ func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackerByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
 			}
-			var msglen int
+			m.ChainID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6421,29 +9751,83 @@ func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainID |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
-			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetCctxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6470,10 +9854,10 @@ func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.CrossChainTx == nil {
+				m.CrossChainTx = &CrossChainTx{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CrossChainTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6498,7 +9882,7 @@ func (m *QueryAllInTxTrackerByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6521,10 +9905,10 @@ func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6584,7 +9968,7 @@ func (m *QueryAllInTxTrackersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6607,15 +9991,15 @@ func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllCctxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxTrackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxTracker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6642,8 +10026,8 @@ func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxTracker = append(m.InTxTracker, InTxTracker{})
-			if err := m.InTxTracker[len(m.InTxTracker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6704,7 +10088,7 @@ func (m *QueryAllInTxTrackersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6727,17 +10111,17 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxPendingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxPendingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var stringLen uint64
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6747,24 +10131,30 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6786,7 +10176,7 @@ func (m *QueryGetInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6809,15 +10199,15 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxPendingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxPendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6844,10 +10234,30 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InTxHashToCctx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+			}
+			m.TotalPending = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPending |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6869,7 +10279,7 @@ func (m *QueryGetInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxFilteredRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6892,15 +10302,15 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxFilteredRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxFilteredRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6928,63 +10338,13 @@ func (m *QueryInTxHashToCctxDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInTxHashToCctxDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6994,77 +10354,170 @@ func (m *QueryInTxHashToCctxDataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrossChainTxs = append(m.CrossChainTxs, CrossChainTx{})
-			if err := m.CrossChainTxs[len(m.CrossChainTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType == 0 {
+				var v CctxStatus
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= CctxStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Status = append(m.Status, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Status) == 0 {
+					m.Status = make([]CctxStatus, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v CctxStatus
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= CctxStatus(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Status = append(m.Status, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.SenderChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
+			}
+			m.ReceiverChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7121,7 +10574,7 @@ func (m *QueryAllInTxHashToCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxFilteredResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7144,15 +10597,15 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxFilteredResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllInTxHashToCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxFilteredResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHashToCctx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7179,8 +10632,8 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHashToCctx = append(m.InTxHashToCctx, InTxHashToCctx{})
-			if err := m.InTxHashToCctx[len(m.InTxHashToCctx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7241,7 +10694,7 @@ func (m *QueryAllInTxHashToCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxPrunableRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7264,17 +10717,55 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxPrunableRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxPrunableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
+			}
+			m.MaxHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7284,23 +10775,27 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7323,7 +10818,7 @@ func (m *QueryGetGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListCctxPrunableResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7346,15 +10841,15 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListCctxPrunableResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListCctxPrunableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7381,10 +10876,44 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPrice == nil {
-				m.GasPrice = &GasPrice{}
+			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
+			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7409,7 +10938,7 @@ func (m *QueryGetGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRateLimitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7432,10 +10961,10 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRateLimitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRateLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7495,7 +11024,7 @@ func (m *QueryAllGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7518,15 +11047,15 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7553,8 +11082,8 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPrice = append(m.GasPrice, &GasPrice{})
-			if err := m.GasPrice[len(m.GasPrice)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7615,7 +11144,7 @@ func (m *QueryAllGasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7638,15 +11167,34 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7674,7 +11222,7 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7697,7 +11245,7 @@ func (m *QueryGetLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7720,15 +11268,15 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7755,68 +11303,49 @@ func (m *QueryGetLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastBlockHeight == nil {
-				m.LastBlockHeight = &LastBlockHeight{}
-			}
-			if err := m.LastBlockHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowUsage", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WindowUsage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7826,25 +11355,23 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemainingCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7869,7 +11396,7 @@ func (m *QueryAllLastBlockHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastZetaHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7892,82 +11419,12 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastZetaHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllLastBlockHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastZetaHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockHeight", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LastBlockHeight = append(m.LastBlockHeight, &LastBlockHeight{})
-			if err := m.LastBlockHeight[len(m.LastBlockHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7989,7 +11446,7 @@ func (m *QueryAllLastBlockHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastZetaHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8012,17 +11469,17 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastZetaHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastZetaHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8032,24 +11489,11 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8071,7 +11515,7 @@ func (m *QueryGetCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConvertGasToZetaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8094,17 +11538,17 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConvertGasToZetaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConvertGasToZetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.ChainID = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8114,16 +11558,16 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainID |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8133,11 +11577,24 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8159,7 +11616,7 @@ func (m *QueryGetCctxByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConvertGasToZetaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8182,17 +11639,17 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConvertGasToZetaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConvertGasToZetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundGasInZeta", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8202,28 +11659,75 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CrossChainTx == nil {
-				m.CrossChainTx = &CrossChainTx{}
+			m.OutboundGasInZeta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeInZeta", wireType)
 			}
-			if err := m.CrossChainTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ProtocolFeeInZeta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaBlockHeight", wireType)
+			}
+			m.ZetaBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ZetaBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8245,7 +11749,7 @@ func (m *QueryGetCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMessagePassingProtocolFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8268,48 +11772,12 @@ func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8331,7 +11799,7 @@ func (m *QueryAllCctxRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMessagePassingProtocolFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8354,51 +11822,17 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCctxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCctxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
-			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeInZeta", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8408,27 +11842,23 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeeInZeta = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8451,7 +11881,7 @@ func (m *QueryAllCctxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateCctxFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8474,17 +11904,17 @@ func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListCctxPendingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateCctxFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListCctxPendingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateCctxFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderChainId", wireType)
 			}
-			m.ChainId = 0
+			m.SenderChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8494,16 +11924,16 @@ func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.SenderChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChainId", wireType)
 			}
-			m.Limit = 0
+			m.ReceiverChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8513,66 +11943,35 @@ func (m *QueryListCctxPendingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
+				m.ReceiverChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= common.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListCctxPendingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListCctxPendingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8582,31 +11981,29 @@ func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrossChainTx = append(m.CrossChainTx, &CrossChainTx{})
-			if err := m.CrossChainTx[len(m.CrossChainTx)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPending", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.TotalPending = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8616,116 +12013,29 @@ func (m *QueryListCctxPendingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPending |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLastZetaHeightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastZetaHeightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastZetaHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLastZetaHeightResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastZetaHeightResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastZetaHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.Height = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8735,7 +12045,7 @@ func (m *QueryLastZetaHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8761,7 +12071,7 @@ func (m *QueryLastZetaHeightResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConvertGasToZetaRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateCctxFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8784,17 +12094,17 @@ func (m *QueryConvertGasToZetaRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConvertGasToZetaRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateCctxFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConvertGasToZetaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateCctxFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
-			m.ChainId = 0
+			m.GasLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8804,14 +12114,14 @@ func (m *QueryConvertGasToZetaRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.GasLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8839,61 +12149,47 @@ func (m *QueryConvertGasToZetaRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasLimit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFee", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryConvertGasToZetaResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.GasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConvertGasToZetaResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConvertGasToZetaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundGasInZeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFlatFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8921,11 +12217,13 @@ func (m *QueryConvertGasToZetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundGasInZeta = string(dAtA[iNdEx:postIndex])
+			if err := m.ProtocolFlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeInZeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeInZeta", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8953,13 +12251,15 @@ func (m *QueryConvertGasToZetaResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolFeeInZeta = string(dAtA[iNdEx:postIndex])
+			if err := m.GasFeeInZeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaBlockHeight", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeInZeta", wireType)
 			}
-			m.ZetaBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8969,114 +12269,97 @@ func (m *QueryConvertGasToZetaResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ZetaBlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMessagePassingProtocolFeeRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.ProtocolFeeInZeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapAmountIn", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMessagePassingProtocolFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.SwapAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TotalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMessagePassingProtocolFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeInZeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9104,7 +12387,9 @@ func (m *QueryMessagePassingProtocolFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeInZeta = string(dAtA[iNdEx:postIndex])
+			if err := m.ReceiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
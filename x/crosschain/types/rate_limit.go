@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// Validate checks the rate limit is valid
+// the ZRC20 address must be empty for ZETA or a valid hex address, the window must be positive
+func (m RateLimit) Validate() error {
+	if m.ChainId <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid chain id %d", m.ChainId)
+	}
+	if m.Zrc20Address != "" && !ethcommon.IsHexAddress(m.Zrc20Address) {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid zrc20 address %s", m.Zrc20Address)
+	}
+	if m.Limit.IsNil() {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "limit is nil")
+	}
+	if m.Window <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "window must be positive, got %d", m.Window)
+	}
+	if _, ok := RateLimitAction_name[int32(m.Action)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid action %d", m.Action)
+	}
+	return nil
+}
+
+// Target returns a short description of the outbounds limited by the rate limit
+func (m RateLimit) Target() string {
+	if m.Zrc20Address == "" {
+		return fmt.Sprintf("ZETA to chain %d", m.ChainId)
+	}
+	return fmt.Sprintf("ZRC20 %s to chain %d", m.Zrc20Address, m.ChainId)
+}
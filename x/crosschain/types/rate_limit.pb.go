@@ -0,0 +1,508 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crosschain/rate_limit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateLimitAction defines the action taken for an outbound exceeding the rate limit
+type RateLimitAction int32
+
+const (
+	RateLimitAction_Queue  RateLimitAction = 0
+	RateLimitAction_Reject RateLimitAction = 1
+)
+
+var RateLimitAction_name = map[int32]string{
+	0: "Queue",
+	1: "Reject",
+}
+
+var RateLimitAction_value = map[string]int32{
+	"Queue":  0,
+	"Reject": 1,
+}
+
+func (x RateLimitAction) String() string {
+	return proto.EnumName(RateLimitAction_name, int32(x))
+}
+
+func (RateLimitAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7f651aad95a80f70, []int{0}
+}
+
+// RateLimit defines the maximum outbound value to a receiver chain for an asset over a sliding window of zeta blocks
+type RateLimit struct {
+	ChainId      int64                                   `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Zrc20Address string                                  `protobuf:"bytes,2,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
+	Limit        github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,3,opt,name=limit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"limit"`
+	Window       int64                                   `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+	Action       RateLimitAction                         `protobuf:"varint,5,opt,name=action,proto3,enum=zetachain.zetacore.crosschain.RateLimitAction" json:"action,omitempty"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7f651aad95a80f70, []int{0}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *RateLimit) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
+	}
+	return ""
+}
+
+func (m *RateLimit) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *RateLimit) GetAction() RateLimitAction {
+	if m != nil {
+		return m.Action
+	}
+	return RateLimitAction_Queue
+}
+
+func init() {
+	proto.RegisterEnum("zetachain.zetacore.crosschain.RateLimitAction", RateLimitAction_name, RateLimitAction_value)
+	proto.RegisterType((*RateLimit)(nil), "zetachain.zetacore.crosschain.RateLimit")
+}
+
+func init() { proto.RegisterFile("crosschain/rate_limit.proto", fileDescriptor_7f651aad95a80f70) }
+
+var fileDescriptor_7f651aad95a80f70 = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xcd, 0x4e, 0x32, 0x31,
+	0x14, 0x9d, 0x7e, 0xc0, 0x7c, 0xd2, 0xf8, 0x43, 0x1a, 0x63, 0x46, 0x8c, 0x85, 0xe8, 0x42, 0x62,
+	0x42, 0xab, 0xf8, 0x04, 0x90, 0x68, 0x62, 0x74, 0xe3, 0x24, 0x6e, 0xdc, 0x90, 0xa1, 0x6d, 0xa0,
+	0x2a, 0x53, 0xd2, 0x96, 0xa0, 0x3c, 0x85, 0x0f, 0xe1, 0xc2, 0x47, 0x61, 0xc9, 0xd2, 0xb8, 0x20,
+	0x06, 0xde, 0xc0, 0x27, 0x30, 0xd3, 0x19, 0x95, 0xb8, 0x70, 0xd5, 0x7b, 0xef, 0x39, 0xa7, 0x39,
+	0xe7, 0x5e, 0xb8, 0xc3, 0xb4, 0x32, 0x86, 0xf5, 0x22, 0x19, 0x53, 0x1d, 0x59, 0xd1, 0xbe, 0x97,
+	0x7d, 0x69, 0xc9, 0x40, 0x2b, 0xab, 0xd0, 0xee, 0x58, 0xd8, 0xc8, 0x61, 0xc4, 0x55, 0x4a, 0x0b,
+	0xf2, 0xc3, 0x2f, 0x6f, 0x76, 0x55, 0x57, 0x39, 0x26, 0x4d, 0xaa, 0x54, 0xb4, 0xf7, 0x01, 0x60,
+	0x31, 0x8c, 0xac, 0xb8, 0x4c, 0x3e, 0x42, 0xdb, 0x70, 0xc5, 0x91, 0xdb, 0x92, 0x07, 0xa0, 0x0a,
+	0x6a, 0xb9, 0xf0, 0xbf, 0xeb, 0xcf, 0x39, 0xda, 0x87, 0x6b, 0x63, 0xcd, 0x1a, 0x47, 0xed, 0x88,
+	0x73, 0x2d, 0x8c, 0x09, 0xfe, 0x55, 0x41, 0xad, 0x18, 0xae, 0xba, 0x61, 0x33, 0x9d, 0xa1, 0x53,
+	0x58, 0x70, 0x8e, 0x82, 0x5c, 0x02, 0xb6, 0xe8, 0x64, 0x56, 0xf1, 0xde, 0x66, 0x95, 0x83, 0xae,
+	0xb4, 0xbd, 0x61, 0x87, 0x30, 0xd5, 0xa7, 0x4c, 0x99, 0xbe, 0x32, 0xd9, 0x53, 0x37, 0xfc, 0x8e,
+	0xda, 0xc7, 0x81, 0x30, 0xe4, 0x5a, 0xc6, 0x36, 0x4c, 0xd5, 0x68, 0x0b, 0xfa, 0x23, 0x19, 0x73,
+	0x35, 0x0a, 0xf2, 0xce, 0x44, 0xd6, 0xa1, 0x33, 0xe8, 0x47, 0xcc, 0x4a, 0x15, 0x07, 0x85, 0x2a,
+	0xa8, 0xad, 0x37, 0x08, 0xf9, 0x33, 0x32, 0xf9, 0x0e, 0xd6, 0x74, 0xaa, 0x30, 0x53, 0x1f, 0x12,
+	0xb8, 0xf1, 0x0b, 0x42, 0x45, 0x58, 0xb8, 0x1a, 0x8a, 0xa1, 0x28, 0x79, 0x08, 0x42, 0x3f, 0x14,
+	0xb7, 0x82, 0xd9, 0x12, 0x28, 0xe7, 0x5f, 0x9e, 0x31, 0x68, 0x5d, 0x4c, 0xe6, 0x18, 0x4c, 0xe7,
+	0x18, 0xbc, 0xcf, 0x31, 0x78, 0x5a, 0x60, 0x6f, 0xba, 0xc0, 0xde, 0xeb, 0x02, 0x7b, 0x37, 0xc7,
+	0x4b, 0xc9, 0x12, 0x07, 0xf5, 0xf4, 0x36, 0x5f, 0x66, 0xe8, 0x03, 0x5d, 0xba, 0x98, 0x0b, 0xda,
+	0xf1, 0xdd, 0xe2, 0x4f, 0x3e, 0x07, 0x00, 0x52, 0xdc, 0x61, 0x66, 0xcc, 0x01, 0x00, 0x00,
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Window != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovRateLimit(uint64(m.ChainId))
+	}
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = m.Limit.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	if m.Window != 0 {
+		n += 1 + sovRateLimit(uint64(m.Window))
+	}
+	if m.Action != 0 {
+		n += 1 + sovRateLimit(uint64(m.Action))
+	}
+	return n
+}
+
+func sovRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimit(x uint64) (n int) {
+	return sovRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= RateLimitAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRateLimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRateLimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRateLimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRateLimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRateLimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRateLimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRateLimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,90 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/crosschain/types"
+)
+
+func TestRateLimit_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		rateLimit types.RateLimit
+		err       error
+	}{
+		{
+			name:      "valid rate limit",
+			rateLimit: sample.RateLimit(t, "foo"),
+			err:       nil,
+		},
+		{
+			name: "valid rate limit for ZETA",
+			rateLimit: types.RateLimit{
+				ChainId: 1,
+				Limit:   math.NewUint(100),
+				Window:  10,
+				Action:  types.RateLimitAction_Reject,
+			},
+			err: nil,
+		},
+		{
+			name: "invalid chain id",
+			rateLimit: types.RateLimit{
+				ChainId: 0,
+				Limit:   math.NewUint(100),
+				Window:  10,
+			},
+			err: types.ErrInvalidRateLimit,
+		},
+		{
+			name: "invalid zrc20 address",
+			rateLimit: types.RateLimit{
+				ChainId:      1,
+				Zrc20Address: "invalid",
+				Limit:        math.NewUint(100),
+				Window:       10,
+			},
+			err: types.ErrInvalidRateLimit,
+		},
+		{
+			name: "nil limit",
+			rateLimit: types.RateLimit{
+				ChainId: 1,
+				Window:  10,
+			},
+			err: types.ErrInvalidRateLimit,
+		},
+		{
+			name: "invalid window",
+			rateLimit: types.RateLimit{
+				ChainId: 1,
+				Limit:   math.NewUint(100),
+				Window:  -1,
+			},
+			err: types.ErrInvalidRateLimit,
+		},
+		{
+			name: "invalid action",
+			rateLimit: types.RateLimit{
+				ChainId: 1,
+				Limit:   math.NewUint(100),
+				Window:  10,
+				Action:  types.RateLimitAction(42),
+			},
+			err: types.ErrInvalidRateLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rateLimit.Validate()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -2,28 +2,51 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxStatusHistoryLength is the maximum number of status transitions kept in the history of a CCTX
+// when the limit is reached, the oldest transition is dropped
+const MaxStatusHistoryLength = 32
+
 func (m *Status) AbortRefunded(timeStamp int64) {
 	m.IsAbortRefunded = true
 	m.StatusMessage = "CCTX aborted and Refunded"
 	m.LastUpdateTimestamp = timeStamp
 }
 
+// ChangeStatus changes the status of the CCTX and records the transition in the status history
 // empty msg does not overwrite old status message
-func (m *Status) ChangeStatus(newStatus CctxStatus, msg string) {
+func (m *Status) ChangeStatus(ctx sdk.Context, newStatus CctxStatus, msg string) {
+	oldStatus := m.Status
 	if len(msg) > 0 {
 		m.StatusMessage = msg
 	}
 	if !m.ValidateTransition(newStatus) {
 		m.StatusMessage = fmt.Sprintf("Failed to transition : OldStatus %s , NewStatus %s , MSG : %s :", m.Status.String(), newStatus.String(), msg)
 		m.Status = CctxStatus_Aborted
-		return
+	} else {
+		m.Status = newStatus
 	}
-	m.Status = newStatus
-
+	m.AddTransition(StatusTransition{
+		OldStatus:  oldStatus,
+		NewStatus:  m.Status,
+		Message:    m.StatusMessage,
+		ZetaHeight: ctx.BlockHeight(),
+		Timestamp:  ctx.BlockTime().Unix(),
+	})
 } //nolint:typecheck
 
+// AddTransition appends a transition to the status history
+// the oldest transitions are dropped to keep the history bounded by MaxStatusHistoryLength
+func (m *Status) AddTransition(transition StatusTransition) {
+	m.History = append(m.History, &transition)
+	if len(m.History) > MaxStatusHistoryLength {
+		m.History = m.History[len(m.History)-MaxStatusHistoryLength:]
+	}
+}
+
 func (m *Status) ValidateTransition(newStatus CctxStatus) bool {
 	stateTransitionMap := stateTransitionMap()
 	oldStatus := m.Status
@@ -52,6 +75,12 @@ func stateTransitionMap() map[CctxStatus][]CctxStatus {
 		CctxStatus_PendingRevert,
 		CctxStatus_OutboundMined,
 		CctxStatus_Reverted,
+		CctxStatus_PendingRateLimited, // outbound exceeds the rate limit; queued until the window has capacity
+	}
+
+	stateTransitionMap[CctxStatus_PendingRateLimited] = []CctxStatus{
+		CctxStatus_PendingOutbound,
+		CctxStatus_Aborted,
 	}
 
 	stateTransitionMap[CctxStatus_PendingRevert] = []CctxStatus{
@@ -4,7 +4,9 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
 )
 
@@ -23,3 +25,65 @@ func TestStatus_AbortRefunded(t *testing.T) {
 		require.Equal(t, status.LastUpdateTimestamp, timestamp)
 	})
 }
+
+func TestStatus_ChangeStatus(t *testing.T) {
+	blockTime := time.Now()
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Height: 42, Time: blockTime})
+
+	t.Run("should record the transition in the history", func(t *testing.T) {
+		status := types.Status{
+			Status:        types.CctxStatus_PendingInbound,
+			StatusMessage: "pending inbound",
+		}
+		status.ChangeStatus(ctx, types.CctxStatus_PendingOutbound, "inbound finalized")
+		status.ChangeStatus(ctx, types.CctxStatus_PendingRevert, "outbound failed")
+
+		require.Equal(t, types.CctxStatus_PendingRevert, status.Status)
+		require.Equal(t, "outbound failed", status.StatusMessage)
+		require.Len(t, status.History, 2)
+		require.Equal(t, types.StatusTransition{
+			OldStatus:  types.CctxStatus_PendingInbound,
+			NewStatus:  types.CctxStatus_PendingOutbound,
+			Message:    "inbound finalized",
+			ZetaHeight: 42,
+			Timestamp:  blockTime.Unix(),
+		}, *status.History[0])
+		require.Equal(t, types.CctxStatus_PendingOutbound, status.History[1].OldStatus)
+		require.Equal(t, types.CctxStatus_PendingRevert, status.History[1].NewStatus)
+	})
+
+	t.Run("should keep the previous message in the history if the message is empty", func(t *testing.T) {
+		status := types.Status{
+			Status:        types.CctxStatus_PendingOutbound,
+			StatusMessage: "pending outbound",
+		}
+		status.ChangeStatus(ctx, types.CctxStatus_OutboundMined, "")
+
+		require.Len(t, status.History, 1)
+		require.Equal(t, "pending outbound", status.History[0].Message)
+	})
+
+	t.Run("should record the abort if the transition is invalid", func(t *testing.T) {
+		status := types.Status{
+			Status: types.CctxStatus_OutboundMined,
+		}
+		status.ChangeStatus(ctx, types.CctxStatus_PendingOutbound, "invalid")
+
+		require.Equal(t, types.CctxStatus_Aborted, status.Status)
+		require.Len(t, status.History, 1)
+		require.Equal(t, types.CctxStatus_OutboundMined, status.History[0].OldStatus)
+		require.Equal(t, types.CctxStatus_Aborted, status.History[0].NewStatus)
+		require.Equal(t, status.StatusMessage, status.History[0].Message)
+	})
+
+	t.Run("should bound the history length", func(t *testing.T) {
+		status := types.Status{}
+		for i := 0; i < types.MaxStatusHistoryLength+5; i++ {
+			status.AddTransition(types.StatusTransition{ZetaHeight: int64(i)})
+		}
+
+		require.Len(t, status.History, types.MaxStatusHistoryLength)
+		require.EqualValues(t, 5, status.History[0].ZetaHeight)
+		require.EqualValues(t, types.MaxStatusHistoryLength+4, status.History[types.MaxStatusHistoryLength-1].ZetaHeight)
+	})
+}
@@ -822,6 +822,8 @@ type MsgGasPriceVoter struct {
 	Price       uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
 	BlockNumber uint64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	Supply      string `protobuf:"bytes,5,opt,name=supply,proto3" json:"supply,omitempty"`
+	// priority fee (EIP-1559), zero for chains not supporting it
+	PriorityFee uint64 `protobuf:"varint,6,opt,name=priority_fee,json=priorityFee,proto3" json:"priority_fee,omitempty"`
 }
 
 func (m *MsgGasPriceVoter) Reset()         { *m = MsgGasPriceVoter{} }
@@ -892,6 +894,13 @@ func (m *MsgGasPriceVoter) GetSupply() string {
 	return ""
 }
 
+func (m *MsgGasPriceVoter) GetPriorityFee() uint64 {
+	if m != nil {
+		return m.PriorityFee
+	}
+	return 0
+}
+
 type MsgGasPriceVoterResponse struct {
 }
 
@@ -1088,9 +1097,9 @@ type MsgVoteOnObservedInboundTx struct {
 	SenderChainId int64  `protobuf:"varint,3,opt,name=sender_chain_id,json=senderChainId,proto3" json:"sender_chain_id,omitempty"`
 	Receiver      string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	ReceiverChain int64  `protobuf:"varint,5,opt,name=receiver_chain,json=receiverChain,proto3" json:"receiver_chain,omitempty"`
-	// string zeta_burnt = 6;
+	//  string zeta_burnt = 6;
 	Amount github_com_cosmos_cosmos_sdk_types.Uint `protobuf:"bytes,6,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Uint" json:"amount"`
-	// string mMint = 7;
+	//  string mMint = 7;
 	Message       string          `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
 	InTxHash      string          `protobuf:"bytes,9,opt,name=in_tx_hash,json=inTxHash,proto3" json:"in_tx_hash,omitempty"`
 	InBlockHeight uint64          `protobuf:"varint,10,opt,name=in_block_height,json=inBlockHeight,proto3" json:"in_block_height,omitempty"`
@@ -1446,6 +1455,382 @@ func (m *MsgRefundAbortedCCTXResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRefundAbortedCCTXResponse proto.InternalMessageInfo
 
+type MsgClaimAbortedCCTXRefund struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex     string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	RefundAddress string `protobuf:"bytes,3,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
+}
+
+func (m *MsgClaimAbortedCCTXRefund) Reset()         { *m = MsgClaimAbortedCCTXRefund{} }
+func (m *MsgClaimAbortedCCTXRefund) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAbortedCCTXRefund) ProtoMessage()    {}
+func (*MsgClaimAbortedCCTXRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{24}
+}
+func (m *MsgClaimAbortedCCTXRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAbortedCCTXRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAbortedCCTXRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAbortedCCTXRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAbortedCCTXRefund.Merge(m, src)
+}
+func (m *MsgClaimAbortedCCTXRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAbortedCCTXRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAbortedCCTXRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAbortedCCTXRefund proto.InternalMessageInfo
+
+func (m *MsgClaimAbortedCCTXRefund) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgClaimAbortedCCTXRefund) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *MsgClaimAbortedCCTXRefund) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
+type MsgClaimAbortedCCTXRefundResponse struct {
+}
+
+func (m *MsgClaimAbortedCCTXRefundResponse) Reset()         { *m = MsgClaimAbortedCCTXRefundResponse{} }
+func (m *MsgClaimAbortedCCTXRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAbortedCCTXRefundResponse) ProtoMessage()    {}
+func (*MsgClaimAbortedCCTXRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{25}
+}
+func (m *MsgClaimAbortedCCTXRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAbortedCCTXRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAbortedCCTXRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimAbortedCCTXRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAbortedCCTXRefundResponse.Merge(m, src)
+}
+func (m *MsgClaimAbortedCCTXRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAbortedCCTXRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAbortedCCTXRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAbortedCCTXRefundResponse proto.InternalMessageInfo
+
+type MsgExtendCctxDeadline struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CctxIndex       string `protobuf:"bytes,2,opt,name=cctx_index,json=cctxIndex,proto3" json:"cctx_index,omitempty"`
+	ExtensionBlocks uint64 `protobuf:"varint,3,opt,name=extension_blocks,json=extensionBlocks,proto3" json:"extension_blocks,omitempty"`
+}
+
+func (m *MsgExtendCctxDeadline) Reset()         { *m = MsgExtendCctxDeadline{} }
+func (m *MsgExtendCctxDeadline) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendCctxDeadline) ProtoMessage()    {}
+func (*MsgExtendCctxDeadline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{26}
+}
+func (m *MsgExtendCctxDeadline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendCctxDeadline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendCctxDeadline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendCctxDeadline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendCctxDeadline.Merge(m, src)
+}
+func (m *MsgExtendCctxDeadline) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendCctxDeadline) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendCctxDeadline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendCctxDeadline proto.InternalMessageInfo
+
+func (m *MsgExtendCctxDeadline) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgExtendCctxDeadline) GetCctxIndex() string {
+	if m != nil {
+		return m.CctxIndex
+	}
+	return ""
+}
+
+func (m *MsgExtendCctxDeadline) GetExtensionBlocks() uint64 {
+	if m != nil {
+		return m.ExtensionBlocks
+	}
+	return 0
+}
+
+type MsgExtendCctxDeadlineResponse struct {
+}
+
+func (m *MsgExtendCctxDeadlineResponse) Reset()         { *m = MsgExtendCctxDeadlineResponse{} }
+func (m *MsgExtendCctxDeadlineResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExtendCctxDeadlineResponse) ProtoMessage()    {}
+func (*MsgExtendCctxDeadlineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{27}
+}
+func (m *MsgExtendCctxDeadlineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExtendCctxDeadlineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExtendCctxDeadlineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExtendCctxDeadlineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExtendCctxDeadlineResponse.Merge(m, src)
+}
+func (m *MsgExtendCctxDeadlineResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExtendCctxDeadlineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExtendCctxDeadlineResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExtendCctxDeadlineResponse proto.InternalMessageInfo
+
+type MsgUpdateRateLimit struct {
+	Creator   string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	RateLimit RateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+}
+
+func (m *MsgUpdateRateLimit) Reset()         { *m = MsgUpdateRateLimit{} }
+func (m *MsgUpdateRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRateLimit) ProtoMessage()    {}
+func (*MsgUpdateRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{28}
+}
+func (m *MsgUpdateRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRateLimit.Merge(m, src)
+}
+func (m *MsgUpdateRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRateLimit proto.InternalMessageInfo
+
+func (m *MsgUpdateRateLimit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateRateLimit) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+type MsgUpdateRateLimitResponse struct {
+}
+
+func (m *MsgUpdateRateLimitResponse) Reset()         { *m = MsgUpdateRateLimitResponse{} }
+func (m *MsgUpdateRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRateLimitResponse) ProtoMessage()    {}
+func (*MsgUpdateRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{29}
+}
+func (m *MsgUpdateRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRateLimitResponse.Merge(m, src)
+}
+func (m *MsgUpdateRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRateLimitResponse proto.InternalMessageInfo
+
+type MsgRemoveRateLimit struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId      int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Zrc20Address string `protobuf:"bytes,3,opt,name=zrc20_address,json=zrc20Address,proto3" json:"zrc20_address,omitempty"`
+}
+
+func (m *MsgRemoveRateLimit) Reset()         { *m = MsgRemoveRateLimit{} }
+func (m *MsgRemoveRateLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimit) ProtoMessage()    {}
+func (*MsgRemoveRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{30}
+}
+func (m *MsgRemoveRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimit.Merge(m, src)
+}
+func (m *MsgRemoveRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimit proto.InternalMessageInfo
+
+func (m *MsgRemoveRateLimit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRemoveRateLimit) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgRemoveRateLimit) GetZrc20Address() string {
+	if m != nil {
+		return m.Zrc20Address
+	}
+	return ""
+}
+
+type MsgRemoveRateLimitResponse struct {
+}
+
+func (m *MsgRemoveRateLimitResponse) Reset()         { *m = MsgRemoveRateLimitResponse{} }
+func (m *MsgRemoveRateLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitResponse) ProtoMessage()    {}
+func (*MsgRemoveRateLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_81d6d611190b7635, []int{31}
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimitResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateTSSVoter)(nil), "zetachain.zetacore.crosschain.MsgCreateTSSVoter")
 	proto.RegisterType((*MsgCreateTSSVoterResponse)(nil), "zetachain.zetacore.crosschain.MsgCreateTSSVoterResponse")
@@ -1471,106 +1856,128 @@ func init() {
 	proto.RegisterType((*MsgAbortStuckCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgAbortStuckCCTXResponse")
 	proto.RegisterType((*MsgRefundAbortedCCTX)(nil), "zetachain.zetacore.crosschain.MsgRefundAbortedCCTX")
 	proto.RegisterType((*MsgRefundAbortedCCTXResponse)(nil), "zetachain.zetacore.crosschain.MsgRefundAbortedCCTXResponse")
+	proto.RegisterType((*MsgClaimAbortedCCTXRefund)(nil), "zetachain.zetacore.crosschain.MsgClaimAbortedCCTXRefund")
+	proto.RegisterType((*MsgClaimAbortedCCTXRefundResponse)(nil), "zetachain.zetacore.crosschain.MsgClaimAbortedCCTXRefundResponse")
+	proto.RegisterType((*MsgExtendCctxDeadline)(nil), "zetachain.zetacore.crosschain.MsgExtendCctxDeadline")
+	proto.RegisterType((*MsgExtendCctxDeadlineResponse)(nil), "zetachain.zetacore.crosschain.MsgExtendCctxDeadlineResponse")
+	proto.RegisterType((*MsgUpdateRateLimit)(nil), "zetachain.zetacore.crosschain.MsgUpdateRateLimit")
+	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "zetachain.zetacore.crosschain.MsgUpdateRateLimitResponse")
+	proto.RegisterType((*MsgRemoveRateLimit)(nil), "zetachain.zetacore.crosschain.MsgRemoveRateLimit")
+	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "zetachain.zetacore.crosschain.MsgRemoveRateLimitResponse")
 }
 
 func init() { proto.RegisterFile("crosschain/tx.proto", fileDescriptor_81d6d611190b7635) }
 
 var fileDescriptor_81d6d611190b7635 = []byte{
-	// 1502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x6f, 0x4f, 0xdb, 0x56,
-	0x17, 0xc7, 0x0f, 0x10, 0x92, 0x03, 0x01, 0x6a, 0x68, 0x9b, 0x9a, 0x12, 0xa8, 0x79, 0xda, 0x07,
-	0x3d, 0x12, 0x49, 0x4b, 0x35, 0xad, 0xed, 0x36, 0x69, 0x10, 0xb5, 0x94, 0xad, 0x94, 0xca, 0xa4,
-	0xdb, 0xd4, 0x37, 0x96, 0x63, 0x5f, 0x1c, 0x8b, 0xc4, 0x37, 0xf2, 0xbd, 0x8e, 0x12, 0x34, 0x69,
-	0x52, 0xa5, 0xbd, 0x9f, 0xa6, 0x49, 0x9b, 0xf6, 0x05, 0xf6, 0x55, 0xfa, 0xb2, 0xda, 0xab, 0x75,
-	0x2f, 0xaa, 0xa9, 0x7c, 0x82, 0xed, 0x13, 0x4c, 0xf7, 0x8f, 0x4d, 0x9c, 0x90, 0x3f, 0x50, 0xf5,
-	0x55, 0x7c, 0x8e, 0xef, 0xf9, 0xf7, 0x3b, 0xe7, 0xdc, 0x73, 0x62, 0x58, 0xb0, 0x03, 0x4c, 0x88,
-	0x5d, 0xb5, 0x3c, 0xbf, 0x48, 0x5b, 0x85, 0x46, 0x80, 0x29, 0x56, 0x97, 0x8f, 0x11, 0xb5, 0x38,
-	0xaf, 0xc0, 0x9f, 0x70, 0x80, 0x0a, 0xa7, 0xe7, 0xb4, 0x05, 0x1b, 0xd7, 0xeb, 0xd8, 0x2f, 0x8a,
-	0x1f, 0x21, 0xa3, 0x2d, 0xba, 0xd8, 0xc5, 0xfc, 0xb1, 0xc8, 0x9e, 0x04, 0x57, 0xff, 0x4d, 0x81,
-	0x4b, 0x7b, 0xc4, 0x2d, 0x05, 0xc8, 0xa2, 0xa8, 0x7c, 0x70, 0xf0, 0x15, 0xa6, 0x28, 0x50, 0x73,
-	0x30, 0x65, 0x33, 0x0e, 0x0e, 0x72, 0xca, 0xaa, 0xb2, 0x9e, 0x31, 0x22, 0x52, 0x5d, 0x06, 0xa0,
-	0x84, 0x98, 0x8d, 0xb0, 0x72, 0x84, 0xda, 0xb9, 0xff, 0xf0, 0x97, 0x19, 0x4a, 0xc8, 0x33, 0xce,
-	0x50, 0xff, 0x0f, 0xf3, 0x47, 0xa8, 0xbd, 0x83, 0xfc, 0x17, 0x88, 0x5a, 0x8f, 0x91, 0xe7, 0x56,
-	0x69, 0x6e, 0x7c, 0x55, 0x59, 0x1f, 0x37, 0x7a, 0xf8, 0xea, 0x06, 0xa4, 0x08, 0xb5, 0x68, 0x48,
-	0x72, 0x13, 0xab, 0xca, 0xfa, 0xec, 0xe6, 0xe5, 0x82, 0xf4, 0xd7, 0x40, 0x36, 0xf2, 0x9a, 0xe8,
-	0x80, 0xbf, 0x34, 0xe4, 0x21, 0x7d, 0x09, 0xae, 0xf5, 0x38, 0x6a, 0x20, 0xd2, 0xc0, 0x3e, 0x41,
-	0xfa, 0x8f, 0x0a, 0xa8, 0x7b, 0xc4, 0xdd, 0xf3, 0xdc, 0x80, 0xbd, 0x26, 0xe4, 0x51, 0xe8, 0x3b,
-	0x64, 0x40, 0x1c, 0xd7, 0x20, 0xcd, 0xb1, 0x32, 0x3d, 0x87, 0x47, 0x31, 0x6e, 0x4c, 0x71, 0x7a,
-	0xd7, 0x51, 0x77, 0x20, 0x65, 0xd5, 0x71, 0xe8, 0x0b, 0xcf, 0x33, 0xdb, 0xc5, 0x57, 0x6f, 0x57,
-	0xc6, 0xfe, 0x7c, 0xbb, 0xf2, 0x3f, 0xd7, 0xa3, 0xd5, 0xb0, 0xc2, 0xbc, 0x2c, 0xda, 0x98, 0xd4,
-	0x31, 0x91, 0x3f, 0x1b, 0xc4, 0x39, 0x2a, 0xd2, 0x76, 0x03, 0x91, 0xc2, 0x73, 0xcf, 0xa7, 0x86,
-	0x14, 0xd7, 0xaf, 0x83, 0xd6, 0xeb, 0x53, 0xec, 0xf2, 0x53, 0x58, 0xd8, 0x23, 0xee, 0xf3, 0x86,
-	0x23, 0x5e, 0x6e, 0x39, 0x4e, 0x80, 0x08, 0xb9, 0x30, 0xf4, 0xfa, 0x32, 0x2c, 0x9d, 0xa1, 0x2f,
-	0x36, 0xf7, 0xb7, 0xc2, 0xed, 0x6d, 0x39, 0x4e, 0x19, 0xef, 0xfa, 0xe5, 0x56, 0x39, 0xb0, 0xec,
-	0xa3, 0x81, 0xa9, 0x1e, 0x00, 0xd1, 0x55, 0x98, 0xa2, 0x2d, 0xb3, 0x6a, 0x91, 0xaa, 0xc0, 0xc8,
-	0x48, 0xd1, 0xd6, 0x63, 0x8b, 0x54, 0xd5, 0x0d, 0xc8, 0xd8, 0xd8, 0xf3, 0x4d, 0x86, 0x86, 0x4c,
-	0xeb, 0x7c, 0x94, 0xd6, 0x12, 0xf6, 0xfc, 0x72, 0xbb, 0x81, 0x8c, 0xb4, 0x2d, 0x9f, 0xd4, 0x35,
-	0x98, 0x6c, 0x04, 0x18, 0x1f, 0xe6, 0x26, 0x57, 0x95, 0xf5, 0xe9, 0xcd, 0x6c, 0x74, 0xf4, 0x19,
-	0x63, 0x1a, 0xe2, 0x1d, 0x8b, 0xbb, 0x52, 0xc3, 0xf6, 0x91, 0xb0, 0x97, 0x12, 0x71, 0x73, 0x0e,
-	0x37, 0x79, 0x0d, 0xd2, 0xb4, 0x65, 0x7a, 0xbe, 0x83, 0x5a, 0xb9, 0x29, 0xe1, 0x26, 0x6d, 0xed,
-	0x32, 0x52, 0x42, 0xd2, 0x1d, 0x72, 0x0c, 0xc9, 0xef, 0xa2, 0xf6, 0xbf, 0xae, 0x7a, 0x14, 0xd5,
-	0x3c, 0x42, 0x1f, 0x1a, 0xa5, 0xcd, 0xdb, 0x03, 0x00, 0x59, 0x83, 0x2c, 0x0a, 0xec, 0xcd, 0xdb,
-	0xa6, 0x25, 0xb0, 0x95, 0x39, 0x98, 0xe1, 0xcc, 0x28, 0x7f, 0x9d, 0xa8, 0x8d, 0x27, 0x51, 0x53,
-	0x61, 0xc2, 0xb7, 0xea, 0x02, 0x97, 0x8c, 0xc1, 0x9f, 0xd5, 0x2b, 0x90, 0x22, 0xed, 0x7a, 0x05,
-	0xd7, 0x38, 0x04, 0x19, 0x43, 0x52, 0xaa, 0x06, 0x69, 0x07, 0xd9, 0x5e, 0xdd, 0xaa, 0x11, 0x1e,
-	0x72, 0xd6, 0x88, 0x69, 0x75, 0x09, 0x32, 0xae, 0x45, 0xcc, 0x9a, 0x57, 0xf7, 0xa8, 0x0c, 0x39,
-	0xed, 0x5a, 0xe4, 0x09, 0xa3, 0x75, 0x93, 0xb7, 0x49, 0x32, 0xa6, 0x28, 0x62, 0x16, 0xc1, 0x71,
-	0x22, 0x02, 0x11, 0xe1, 0xcc, 0x71, 0x67, 0x04, 0xcb, 0x00, 0xb6, 0x1d, 0x43, 0x2a, 0xeb, 0x8c,
-	0x71, 0x04, 0xa8, 0x6f, 0x14, 0x58, 0x8c, 0x50, 0xdd, 0x0f, 0xe9, 0x7b, 0x56, 0xd2, 0x22, 0x4c,
-	0xfa, 0xd8, 0xb7, 0x11, 0xc7, 0x6a, 0xc2, 0x10, 0x44, 0x67, 0x7d, 0x4d, 0x24, 0xea, 0xeb, 0x03,
-	0x17, 0xcc, 0x67, 0x70, 0xfd, 0xac, 0xd0, 0x62, 0xfc, 0x96, 0x01, 0x3c, 0x62, 0x06, 0xa8, 0x8e,
-	0x9b, 0xc8, 0xe1, 0x51, 0xa6, 0x8d, 0x8c, 0x47, 0x0c, 0xc1, 0xd0, 0x0f, 0x39, 0xf6, 0x82, 0x7a,
-	0x14, 0xe0, 0xfa, 0x07, 0x82, 0x47, 0x5f, 0x83, 0x1b, 0x7d, 0xed, 0xc4, 0xd5, 0xfd, 0x8b, 0x02,
-	0xf3, 0x7b, 0xc4, 0xdd, 0xb1, 0xc8, 0xb3, 0xc0, 0xb3, 0xd1, 0xb0, 0x8b, 0x7d, 0xb0, 0x13, 0x0d,
-	0xa6, 0x22, 0x72, 0x82, 0x13, 0xea, 0x0d, 0x98, 0x11, 0x28, 0xfb, 0x61, 0xbd, 0x82, 0x02, 0x9e,
-	0xa8, 0x09, 0x63, 0x9a, 0xf3, 0x9e, 0x72, 0x16, 0x2f, 0xee, 0xb0, 0xd1, 0xa8, 0xb5, 0xe3, 0xe2,
-	0xe6, 0x94, 0xae, 0x41, 0xae, 0xdb, 0xb3, 0xd8, 0xed, 0x37, 0x93, 0xbc, 0x69, 0x19, 0x73, 0xdf,
-	0xdf, 0xaf, 0x10, 0x14, 0x34, 0x91, 0xb3, 0x1f, 0xd2, 0x0a, 0x0e, 0x7d, 0xa7, 0xdc, 0x1a, 0x10,
-	0xc1, 0x12, 0xf0, 0x2a, 0x15, 0x59, 0x17, 0x65, 0x9b, 0x66, 0x0c, 0x9e, 0xf4, 0x02, 0x2c, 0x60,
-	0xa9, 0xcc, 0xc4, 0x0c, 0xae, 0xce, 0xdb, 0xeb, 0x12, 0x3e, 0xb5, 0x53, 0x16, 0xe7, 0x3f, 0x05,
-	0xad, 0xeb, 0xbc, 0x28, 0x20, 0x31, 0xd2, 0x44, 0xac, 0xb9, 0x84, 0xd8, 0xf6, 0xe9, 0x7b, 0xf5,
-	0x23, 0xb8, 0xda, 0x25, 0xcd, 0x1a, 0x36, 0x24, 0xc8, 0xc9, 0x01, 0x17, 0x5d, 0x4c, 0x88, 0xee,
-	0x58, 0xe4, 0x39, 0x41, 0x8e, 0x7a, 0x0c, 0x7a, 0x97, 0x18, 0x3a, 0x3c, 0x44, 0x36, 0xf5, 0x9a,
-	0x88, 0x2b, 0x10, 0x59, 0x98, 0xe6, 0x53, 0xa9, 0x20, 0xa7, 0xd2, 0xad, 0x11, 0xa6, 0xd2, 0xae,
-	0x4f, 0x8d, 0x7c, 0xc2, 0xe2, 0xc3, 0x48, 0x6f, 0x94, 0x04, 0xf5, 0x8b, 0x21, 0xb6, 0xc5, 0x6d,
-	0x33, 0xc3, 0xbd, 0xef, 0xaf, 0x8b, 0xdf, 0x41, 0x2a, 0x86, 0xd9, 0xa6, 0x55, 0x0b, 0x91, 0x19,
-	0x88, 0x49, 0xee, 0x88, 0xfc, 0x6f, 0x3f, 0x3e, 0xe7, 0x24, 0xfd, 0xe7, 0xed, 0xca, 0xe5, 0xb6,
-	0x55, 0xaf, 0x3d, 0xd0, 0x93, 0xea, 0x74, 0x23, 0xcb, 0x19, 0x72, 0x51, 0x70, 0x3a, 0x56, 0x89,
-	0xd4, 0x08, 0xab, 0x84, 0xba, 0x02, 0xd3, 0x22, 0x44, 0x5e, 0xe1, 0xf2, 0x12, 0x00, 0xce, 0x2a,
-	0x31, 0x8e, 0x7a, 0x0b, 0xe6, 0xc4, 0x01, 0x36, 0x70, 0x45, 0x03, 0xa6, 0x79, 0xe4, 0x59, 0xce,
-	0x2e, 0x13, 0xf2, 0x94, 0xdf, 0x53, 0x89, 0x71, 0x97, 0x19, 0x36, 0xee, 0xf4, 0x9b, 0xb0, 0x36,
-	0xa0, 0xb4, 0xe3, 0x16, 0x78, 0x39, 0xc1, 0x17, 0x87, 0xe4, 0xb9, 0x5d, 0x7f, 0x78, 0x07, 0xb0,
-	0x7e, 0x43, 0xbe, 0x83, 0x02, 0x59, 0xfe, 0x92, 0x62, 0xe1, 0x88, 0x27, 0xb3, 0x6b, 0x34, 0x65,
-	0x05, 0xbb, 0x24, 0x1b, 0x5d, 0x83, 0xb4, 0x84, 0x38, 0x90, 0xf7, 0x6e, 0x4c, 0xab, 0x37, 0x61,
-	0x36, 0x7a, 0x96, 0xb0, 0x4d, 0x0a, 0x15, 0x11, 0x57, 0x20, 0x77, 0xba, 0x3c, 0xa5, 0xde, 0x6b,
-	0x79, 0x62, 0x51, 0xd6, 0x11, 0x21, 0x96, 0x2b, 0xa0, 0xcf, 0x18, 0x11, 0xa9, 0x5e, 0x07, 0x60,
-	0x90, 0xcb, 0x0e, 0xce, 0x08, 0x3f, 0x3d, 0x5f, 0x36, 0xee, 0x2d, 0x98, 0xf3, 0x7c, 0x53, 0xde,
-	0xff, 0xa2, 0x5b, 0x45, 0xcb, 0x65, 0x3d, 0xbf, 0xb3, 0x45, 0x13, 0x43, 0x74, 0x9a, 0x9f, 0x88,
-	0x87, 0x68, 0x32, 0xaf, 0x33, 0x43, 0xd7, 0x98, 0x25, 0xc8, 0xd0, 0x96, 0x89, 0x03, 0xcf, 0xf5,
-	0xfc, 0x5c, 0x56, 0x38, 0x44, 0x5b, 0xfb, 0x9c, 0x66, 0xb7, 0xa7, 0x45, 0x08, 0xa2, 0xb9, 0x59,
-	0xfe, 0x42, 0x10, 0xac, 0x04, 0x51, 0x13, 0xf9, 0x54, 0xce, 0xa1, 0x39, 0xee, 0x00, 0x70, 0x96,
-	0x18, 0x45, 0xff, 0x05, 0xbd, 0x7f, 0x0d, 0xc4, 0xa5, 0xf2, 0x84, 0x6f, 0x30, 0x5b, 0x15, 0x1c,
-	0xd0, 0x03, 0x1a, 0xda, 0x47, 0xa5, 0x52, 0xf9, 0x9b, 0xc1, 0x2b, 0xe4, 0xa0, 0xd1, 0x2e, 0x56,
-	0xec, 0xa4, 0xb6, 0xd8, 0x54, 0x93, 0x8f, 0x7d, 0x03, 0x1d, 0x86, 0xbe, 0xc3, 0x8f, 0x20, 0xe7,
-	0xbd, 0xac, 0x89, 0x8a, 0x62, 0xda, 0xe2, 0x6d, 0x44, 0xdc, 0xc6, 0x59, 0xc1, 0x95, 0xeb, 0x88,
-	0x9e, 0xe7, 0x33, 0xb9, 0xc7, 0x6e, 0xe4, 0xd7, 0xe6, 0xc9, 0x0c, 0x8c, 0xef, 0x11, 0x57, 0xfd,
-	0x5e, 0x81, 0x4b, 0xbd, 0x4b, 0xc9, 0xdd, 0xc2, 0xc0, 0xbf, 0x4a, 0x85, 0xb3, 0xc6, 0xbd, 0xf6,
-	0xc9, 0x05, 0x84, 0xe2, 0x1d, 0xe1, 0xa5, 0x02, 0xf3, 0x3d, 0x5b, 0xf6, 0xe6, 0x88, 0x1a, 0x3b,
-	0x64, 0xb4, 0x07, 0xe7, 0x97, 0x89, 0x9d, 0xf8, 0x49, 0x81, 0x2b, 0x7d, 0xf6, 0x90, 0x7b, 0xc3,
-	0xd5, 0x9e, 0x2d, 0xa9, 0x7d, 0x7e, 0x51, 0xc9, 0xd8, 0xad, 0x36, 0x64, 0x93, 0xfb, 0x48, 0x71,
-	0xb8, 0xca, 0x84, 0x80, 0xf6, 0xf1, 0x39, 0x05, 0x62, 0xd3, 0xbf, 0x2a, 0x90, 0xeb, 0xbb, 0x54,
-	0x8c, 0x00, 0x75, 0x3f, 0x59, 0x6d, 0xfb, 0xe2, 0xb2, 0xb1, 0x73, 0x3f, 0x2b, 0x70, 0xb5, 0xdf,
-	0x75, 0x7f, 0xff, 0xbc, 0xfa, 0x63, 0x51, 0x6d, 0xeb, 0xc2, 0xa2, 0xb1, 0x67, 0xdf, 0xc2, 0x6c,
-	0xd7, 0xff, 0xa3, 0xdb, 0xc3, 0x95, 0x26, 0x25, 0xb4, 0x7b, 0xe7, 0x95, 0x48, 0xf4, 0x52, 0xcf,
-	0x3f, 0xe4, 0x11, 0x7a, 0xa9, 0x5b, 0x66, 0x94, 0x5e, 0xea, 0xf7, 0xcf, 0x59, 0xfd, 0x0e, 0xe6,
-	0xba, 0xbf, 0x2b, 0xdc, 0x19, 0xae, 0xae, 0x4b, 0x44, 0xbb, 0x7f, 0x6e, 0x91, 0xce, 0x1c, 0x74,
-	0x7d, 0x9f, 0x19, 0x21, 0x07, 0x49, 0x89, 0x51, 0x72, 0x70, 0xf6, 0xa7, 0x15, 0x66, 0xbd, 0x6b,
-	0xbe, 0x8c, 0x60, 0x3d, 0x29, 0x31, 0x8a, 0xf5, 0xb3, 0xa7, 0x0e, 0xbf, 0xd5, 0x7b, 0x67, 0xce,
-	0xdd, 0x51, 0x6e, 0xa2, 0x2e, 0xa1, 0x51, 0x6e, 0xf5, 0xbe, 0x53, 0x66, 0xfb, 0xcb, 0x57, 0xef,
-	0xf2, 0xca, 0xeb, 0x77, 0x79, 0xe5, 0xaf, 0x77, 0x79, 0xe5, 0x87, 0x93, 0xfc, 0xd8, 0xeb, 0x93,
-	0xfc, 0xd8, 0x1f, 0x27, 0xf9, 0xb1, 0x17, 0x77, 0x3a, 0x36, 0x1b, 0xa6, 0x76, 0x43, 0x7c, 0xab,
-	0x8b, 0x2c, 0x14, 0x5b, 0xc5, 0xce, 0x2f, 0x78, 0x6c, 0xd1, 0xa9, 0xa4, 0xf8, 0xb7, 0xb7, 0xbb,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x99, 0xcd, 0x4c, 0xdc, 0x13, 0x00, 0x00,
+	// 1719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xcf, 0x11, 0xc7, 0xb1, 0x27, 0x71, 0xfe, 0x5c, 0xd2, 0xd6, 0xb9, 0x24, 0x4e, 0x7a, 0xa1,
+	0x25, 0x20, 0xc5, 0x6e, 0x52, 0x10, 0xe9, 0x1f, 0xa4, 0x26, 0xa6, 0x4d, 0x03, 0x75, 0x53, 0x5d,
+	0x5c, 0x40, 0x7d, 0xb1, 0xce, 0x77, 0x1b, 0xe7, 0x14, 0xfb, 0xd6, 0xba, 0x5d, 0x47, 0x76, 0x00,
+	0x21, 0x55, 0x42, 0xf0, 0x88, 0x10, 0x12, 0x12, 0x5f, 0x80, 0x0f, 0xc1, 0x17, 0xe8, 0x63, 0xc5,
+	0x13, 0xe5, 0xa1, 0x42, 0xed, 0x27, 0x80, 0x4f, 0x80, 0x6e, 0xf7, 0x6e, 0xe3, 0x3b, 0xff, 0x77,
+	0xd5, 0xa7, 0xdc, 0xce, 0xed, 0x6f, 0xe6, 0x37, 0xb3, 0x33, 0x3b, 0x73, 0x31, 0xcc, 0x19, 0x0e,
+	0x26, 0xc4, 0x38, 0xd6, 0x2d, 0x3b, 0x43, 0xeb, 0xe9, 0xaa, 0x83, 0x29, 0x96, 0x97, 0xcf, 0x10,
+	0xd5, 0x99, 0x2c, 0xcd, 0x9e, 0xb0, 0x83, 0xd2, 0xe7, 0xfb, 0x94, 0x39, 0x03, 0x57, 0x2a, 0xd8,
+	0xce, 0xf0, 0x3f, 0x1c, 0xa3, 0x2c, 0x36, 0x29, 0x72, 0x74, 0x8a, 0x0a, 0x65, 0xab, 0x62, 0x51,
+	0xef, 0xe5, 0x7c, 0x09, 0x97, 0x30, 0x7b, 0xcc, 0xb8, 0x4f, 0x5c, 0xaa, 0xfe, 0x2e, 0xc1, 0x6c,
+	0x8e, 0x94, 0xb2, 0x0e, 0xd2, 0x29, 0xca, 0x1f, 0x1e, 0x7e, 0x81, 0x29, 0x72, 0xe4, 0x24, 0x8c,
+	0x1b, 0xae, 0x04, 0x3b, 0x49, 0x69, 0x55, 0x5a, 0x8f, 0x6b, 0xfe, 0x52, 0x5e, 0x06, 0xa0, 0x84,
+	0x14, 0xaa, 0xb5, 0xe2, 0x09, 0x6a, 0x24, 0xdf, 0x61, 0x2f, 0xe3, 0x94, 0x90, 0x47, 0x4c, 0x20,
+	0x7f, 0x00, 0x33, 0x27, 0xa8, 0xb1, 0x87, 0xec, 0x27, 0x88, 0xea, 0xf7, 0x91, 0x55, 0x3a, 0xa6,
+	0xc9, 0xd1, 0x55, 0x69, 0x7d, 0x54, 0x6b, 0x91, 0xcb, 0x1b, 0x10, 0x25, 0x54, 0xa7, 0x35, 0x92,
+	0x8c, 0xac, 0x4a, 0xeb, 0x53, 0x5b, 0x17, 0xd2, 0x9e, 0x33, 0x1a, 0x32, 0x90, 0x75, 0x8a, 0x0e,
+	0xd9, 0x4b, 0xcd, 0xdb, 0xa4, 0x2e, 0xc2, 0x42, 0x0b, 0x51, 0x0d, 0x91, 0x2a, 0xb6, 0x09, 0x52,
+	0x7f, 0x96, 0x40, 0xce, 0x91, 0x52, 0xce, 0x2a, 0xb9, 0x7e, 0xe7, 0x09, 0xb9, 0x57, 0xb3, 0x4d,
+	0xd2, 0xc5, 0x8f, 0x05, 0x88, 0xb1, 0x38, 0x15, 0x2c, 0x93, 0x79, 0x31, 0xaa, 0x8d, 0xb3, 0xf5,
+	0xbe, 0x29, 0xef, 0x41, 0x54, 0xaf, 0xe0, 0x9a, 0xcd, 0x99, 0xc7, 0x77, 0x33, 0xcf, 0x5e, 0xae,
+	0x8c, 0xfc, 0xfd, 0x72, 0xe5, 0xbd, 0x92, 0x45, 0x8f, 0x6b, 0x45, 0x97, 0x65, 0xc6, 0xc0, 0xa4,
+	0x82, 0x89, 0xf7, 0x67, 0x83, 0x98, 0x27, 0x19, 0xda, 0xa8, 0x22, 0x92, 0x7e, 0x6c, 0xd9, 0x54,
+	0xf3, 0xe0, 0xea, 0x12, 0x28, 0xad, 0x9c, 0x04, 0xe5, 0x87, 0x30, 0x97, 0x23, 0xa5, 0xc7, 0x55,
+	0x93, 0xbf, 0xdc, 0x31, 0x4d, 0x07, 0x11, 0x32, 0x74, 0xe8, 0xd5, 0x65, 0x58, 0x6c, 0xa3, 0x4f,
+	0x98, 0xfb, 0x57, 0x62, 0xf6, 0x76, 0x4c, 0x33, 0x8f, 0xf7, 0xed, 0x7c, 0x3d, 0xef, 0xe8, 0xc6,
+	0x09, 0x72, 0x86, 0x0b, 0xd1, 0x25, 0x18, 0xa7, 0xf5, 0xc2, 0xb1, 0x4e, 0x8e, 0x79, 0x8c, 0xb4,
+	0x28, 0xad, 0xdf, 0xd7, 0xc9, 0xb1, 0xbc, 0x01, 0x71, 0x03, 0x5b, 0x76, 0xc1, 0x8d, 0x86, 0x77,
+	0xac, 0x33, 0xfe, 0xb1, 0x66, 0xb1, 0x65, 0xe7, 0x1b, 0x55, 0xa4, 0xc5, 0x0c, 0xef, 0x49, 0x5e,
+	0x83, 0xb1, 0xaa, 0x83, 0xf1, 0x51, 0x72, 0x6c, 0x55, 0x5a, 0x9f, 0xd8, 0x4a, 0xf8, 0x5b, 0x1f,
+	0xb9, 0x42, 0x8d, 0xbf, 0x73, 0xfd, 0x2e, 0x96, 0xb1, 0x71, 0xc2, 0xed, 0x45, 0xb9, 0xdf, 0x4c,
+	0xc2, 0x4c, 0x2e, 0x40, 0x8c, 0xd6, 0x0b, 0x96, 0x6d, 0xa2, 0x7a, 0x72, 0x9c, 0xd3, 0xa4, 0xf5,
+	0x7d, 0x77, 0xe9, 0x85, 0x24, 0xec, 0xb2, 0x08, 0xc9, 0x9f, 0x3c, 0xf7, 0xbf, 0x3c, 0xb6, 0x28,
+	0x2a, 0x5b, 0x84, 0xde, 0xd5, 0xb2, 0x5b, 0xd7, 0xba, 0x04, 0x64, 0x0d, 0x12, 0xc8, 0x31, 0xb6,
+	0xae, 0x15, 0x74, 0x1e, 0x5b, 0xef, 0x0c, 0x26, 0x99, 0xd0, 0x3f, 0xbf, 0xe6, 0xa8, 0x8d, 0x06,
+	0xa3, 0x26, 0x43, 0xc4, 0xd6, 0x2b, 0x3c, 0x2e, 0x71, 0x8d, 0x3d, 0xcb, 0x17, 0x21, 0x4a, 0x1a,
+	0x95, 0x22, 0x2e, 0xb3, 0x10, 0xc4, 0x35, 0x6f, 0x25, 0x2b, 0x10, 0x33, 0x91, 0x61, 0x55, 0xf4,
+	0x32, 0x61, 0x2e, 0x27, 0x34, 0xb1, 0x96, 0x17, 0x21, 0x5e, 0xd2, 0x09, 0x2f, 0x6e, 0xcf, 0xe5,
+	0x58, 0x49, 0x27, 0x0f, 0xdc, 0xb5, 0x5a, 0x80, 0x85, 0x16, 0x9f, 0x7c, 0x8f, 0x5d, 0x0f, 0xce,
+	0x02, 0x1e, 0x70, 0x0f, 0x27, 0xcf, 0x9a, 0x3d, 0x58, 0x06, 0x30, 0x0c, 0x11, 0x52, 0x2f, 0xcf,
+	0x0c, 0xc3, 0x0f, 0xea, 0x0b, 0x09, 0xe6, 0xfd, 0xa8, 0x1e, 0xd4, 0xe8, 0x1b, 0x66, 0xd2, 0x3c,
+	0x8c, 0xd9, 0xd8, 0x36, 0x10, 0x8b, 0x55, 0x44, 0xe3, 0x8b, 0xe6, 0xfc, 0x8a, 0x04, 0xf2, 0xeb,
+	0x2d, 0x27, 0xcc, 0x27, 0xb0, 0xd4, 0xce, 0x35, 0x11, 0xbf, 0x65, 0x00, 0x8b, 0x14, 0x1c, 0x54,
+	0xc1, 0xa7, 0xc8, 0x64, 0x5e, 0xc6, 0xb4, 0xb8, 0x45, 0x34, 0x2e, 0x50, 0x8f, 0x58, 0xec, 0xf9,
+	0xea, 0x9e, 0x83, 0x2b, 0x6f, 0x29, 0x3c, 0xea, 0x1a, 0x5c, 0xee, 0x68, 0x47, 0x64, 0xf7, 0x1f,
+	0x12, 0xcc, 0xe4, 0x48, 0x69, 0x4f, 0x27, 0x8f, 0x1c, 0xcb, 0x40, 0xbd, 0x2e, 0xf6, 0xee, 0x24,
+	0xaa, 0x8e, 0x75, 0x4e, 0x82, 0x2d, 0xe4, 0xcb, 0x30, 0xc9, 0xa3, 0x6c, 0xd7, 0x2a, 0x45, 0xe4,
+	0xb0, 0x83, 0x8a, 0x68, 0x13, 0x4c, 0xf6, 0x90, 0x89, 0x58, 0x72, 0xd7, 0xaa, 0xd5, 0x72, 0x43,
+	0x24, 0x37, 0x5b, 0xb9, 0xd0, 0xaa, 0x63, 0x61, 0xc7, 0xa2, 0x8d, 0xc2, 0x11, 0x42, 0xec, 0x88,
+	0x22, 0xda, 0x84, 0x2f, 0xbb, 0x87, 0x90, 0xaa, 0x40, 0x32, 0x4c, 0x5e, 0x78, 0xf6, 0x62, 0x8c,
+	0xd5, 0xb5, 0x2b, 0x3c, 0xb0, 0x0f, 0x8a, 0x04, 0x39, 0xa7, 0xc8, 0x3c, 0xa8, 0xd1, 0x22, 0xae,
+	0xd9, 0x66, 0xbe, 0xde, 0xc5, 0xc9, 0x45, 0x60, 0x89, 0xcc, 0x13, 0x83, 0x67, 0x76, 0xcc, 0x15,
+	0xb0, 0xbc, 0x48, 0xc3, 0x1c, 0xf6, 0x94, 0x15, 0xb0, 0x1b, 0xd1, 0xe6, 0x0b, 0x6e, 0x16, 0x9f,
+	0xdb, 0xc9, 0xf3, 0xfd, 0xb7, 0x41, 0x09, 0xed, 0xe7, 0x39, 0xc6, 0xbb, 0x1e, 0x0f, 0x47, 0x32,
+	0x00, 0xdb, 0x3d, 0x7f, 0x2f, 0x7f, 0x04, 0x97, 0x42, 0x68, 0xb7, 0xa6, 0x6b, 0x04, 0x99, 0x49,
+	0x60, 0xd0, 0xf9, 0x00, 0x74, 0x4f, 0x27, 0x8f, 0x09, 0x32, 0xe5, 0x33, 0x50, 0x43, 0x30, 0x74,
+	0x74, 0x84, 0x0c, 0x6a, 0x9d, 0x22, 0xa6, 0x80, 0x1f, 0xd4, 0x04, 0x6b, 0x5c, 0x69, 0xaf, 0x71,
+	0x5d, 0xed, 0xa3, 0x71, 0xed, 0xdb, 0x54, 0x4b, 0x05, 0x2c, 0xde, 0xf5, 0xf5, 0xfa, 0x87, 0x20,
+	0x7f, 0xd6, 0xc3, 0x36, 0xbf, 0x90, 0x26, 0x19, 0xfb, 0xce, 0xba, 0xd8, 0x35, 0x25, 0x63, 0x98,
+	0x3a, 0xd5, 0xcb, 0x35, 0x54, 0x70, 0x78, 0xb3, 0x37, 0x79, 0x8a, 0xec, 0xde, 0x1f, 0xb0, 0xd9,
+	0xfe, 0xf7, 0x72, 0xe5, 0x42, 0x43, 0xaf, 0x94, 0x6f, 0xaa, 0x41, 0x75, 0xaa, 0x96, 0x60, 0x02,
+	0x6f, 0x96, 0x30, 0x9b, 0xa6, 0x8d, 0x68, 0x1f, 0xd3, 0x86, 0xbc, 0x02, 0x13, 0xdc, 0x45, 0x56,
+	0x04, 0xde, 0x3d, 0x01, 0x4c, 0x94, 0x75, 0x25, 0xf2, 0x55, 0x98, 0xe6, 0x1b, 0xdc, 0x9e, 0xcc,
+	0x6b, 0x34, 0xc6, 0x3c, 0x4f, 0x30, 0x71, 0x9e, 0x90, 0x87, 0xae, 0x30, 0xd8, 0x11, 0xe3, 0xbd,
+	0x3a, 0xa2, 0x7a, 0x05, 0xd6, 0xba, 0xa4, 0xb6, 0x28, 0x81, 0xa7, 0x11, 0x50, 0x5a, 0xf6, 0xed,
+	0xdb, 0xbd, 0x2b, 0xc0, 0x2d, 0x49, 0x64, 0x9b, 0xc8, 0xf1, 0xd2, 0xdf, 0x5b, 0xb9, 0xee, 0xf0,
+	0xa7, 0x42, 0xa8, 0x7b, 0x25, 0xb8, 0x38, 0xeb, 0xdd, 0x05, 0x0a, 0xc4, 0xbc, 0x10, 0x3b, 0xde,
+	0xd5, 0x2c, 0xd6, 0xf2, 0x15, 0x98, 0xf2, 0x9f, 0xbd, 0xb0, 0x8d, 0x71, 0x15, 0xbe, 0x94, 0x47,
+	0xee, 0x7c, 0xbe, 0x8a, 0xbe, 0xd1, 0x7c, 0xe5, 0x7a, 0x59, 0x41, 0x84, 0xe8, 0x25, 0x1e, 0xfa,
+	0xb8, 0xe6, 0x2f, 0xe5, 0x25, 0x00, 0x37, 0xe4, 0x5e, 0x05, 0xc7, 0x39, 0x4f, 0xcb, 0xf6, 0x0a,
+	0xf7, 0x2a, 0x4c, 0x5b, 0x76, 0xc1, 0x6b, 0x11, 0xbc, 0x5a, 0x79, 0xc9, 0x25, 0x2c, 0xbb, 0xb9,
+	0x44, 0x03, 0x7d, 0x76, 0x82, 0xed, 0x10, 0x7d, 0x36, 0x78, 0xae, 0x93, 0x3d, 0x27, 0x9d, 0x45,
+	0x88, 0xd3, 0x7a, 0x01, 0x3b, 0x56, 0xc9, 0xb2, 0x93, 0x09, 0x4e, 0x88, 0xd6, 0x0f, 0xd8, 0xda,
+	0xbd, 0x60, 0x75, 0x42, 0x10, 0x4d, 0x4e, 0xb1, 0x17, 0x7c, 0xe1, 0xa6, 0x20, 0x3a, 0x45, 0x36,
+	0xf5, 0x5a, 0xd5, 0x34, 0x23, 0x00, 0x4c, 0xc4, 0xbb, 0xd5, 0xbb, 0xa0, 0x76, 0xce, 0x01, 0x91,
+	0x2a, 0x0f, 0xd8, 0x90, 0xb3, 0x53, 0xc4, 0x0e, 0x3d, 0xa4, 0x35, 0xe3, 0x24, 0x9b, 0xcd, 0x7f,
+	0xd5, 0x7d, 0xca, 0xec, 0xd6, 0xfd, 0xf9, 0x14, 0x1e, 0xd4, 0x26, 0x4c, 0x9d, 0xb2, 0xc9, 0x40,
+	0x43, 0x47, 0x35, 0xdb, 0x64, 0x5b, 0x90, 0xf9, 0x46, 0xd6, 0x78, 0x46, 0xb9, 0xda, 0xc4, 0xc0,
+	0xc2, 0x6f, 0xe3, 0x04, 0x97, 0x7a, 0x13, 0x8b, 0x9a, 0x82, 0xa5, 0x76, 0x76, 0x05, 0xaf, 0xaf,
+	0xf9, 0xa7, 0x43, 0x59, 0xb7, 0x2a, 0x81, 0xd7, 0xee, 0xfe, 0xb7, 0x4e, 0x8e, 0x37, 0xeb, 0xf6,
+	0xc6, 0x9b, 0x18, 0x5e, 0xc8, 0x91, 0xd2, 0xdd, 0x3a, 0x45, 0xb6, 0x99, 0x35, 0x68, 0xfd, 0x53,
+	0xa4, 0x9b, 0x65, 0xcb, 0x46, 0xc3, 0xb3, 0x7b, 0x1f, 0x66, 0x90, 0xab, 0x8e, 0x58, 0xd8, 0xcb,
+	0x75, 0xe2, 0xf5, 0xef, 0x69, 0x21, 0x67, 0xc9, 0x4e, 0xd4, 0x15, 0x58, 0x6e, 0x6b, 0x5c, 0xb0,
+	0xfb, 0x16, 0x64, 0xf1, 0x69, 0xa1, 0xe9, 0x14, 0xf1, 0x0a, 0xe8, 0x4c, 0x2d, 0x07, 0x70, 0xfe,
+	0xf9, 0xc9, 0xa8, 0x4d, 0x6c, 0xad, 0xa7, 0xbb, 0x7e, 0xd0, 0xa6, 0x85, 0xde, 0xdd, 0x88, 0x7b,
+	0x1f, 0x68, 0x71, 0xc7, 0x17, 0x78, 0xdf, 0x51, 0x21, 0xf3, 0x82, 0x9c, 0x0d, 0xb2, 0x18, 0x86,
+	0xfa, 0x21, 0xd7, 0x65, 0xd0, 0x69, 0x19, 0x8f, 0x47, 0x5b, 0xc7, 0x63, 0x8f, 0x4d, 0xc8, 0x9e,
+	0xcf, 0x66, 0xeb, 0x87, 0x59, 0x18, 0xcd, 0x91, 0x92, 0xfc, 0xbd, 0x04, 0xb3, 0xad, 0x23, 0xf2,
+	0xf5, 0x1e, 0x41, 0x68, 0x37, 0x7c, 0x2a, 0xb7, 0x86, 0x00, 0x89, 0x89, 0xf5, 0xa9, 0x04, 0x33,
+	0x2d, 0xdf, 0x7c, 0x5b, 0x7d, 0x6a, 0x6c, 0xc2, 0x28, 0x37, 0x07, 0xc7, 0x08, 0x12, 0xbf, 0x48,
+	0x70, 0xb1, 0xc3, 0x54, 0xbc, 0xdd, 0x5b, 0x6d, 0x7b, 0xa4, 0x72, 0x67, 0x58, 0xa4, 0xa0, 0xd5,
+	0x80, 0x44, 0x70, 0x3a, 0xce, 0xf4, 0x56, 0x19, 0x00, 0x28, 0x1f, 0x0f, 0x08, 0x10, 0xa6, 0x7f,
+	0x93, 0x20, 0xd9, 0x71, 0x7e, 0xed, 0x23, 0xd4, 0x9d, 0xb0, 0xca, 0xee, 0xf0, 0x58, 0x41, 0xee,
+	0x57, 0x09, 0x2e, 0x75, 0x9a, 0x2c, 0x6e, 0x0c, 0xaa, 0x5f, 0x40, 0x95, 0x9d, 0xa1, 0xa1, 0x82,
+	0xd9, 0x37, 0x30, 0x15, 0xfa, 0x5a, 0xbf, 0xd6, 0x5b, 0x69, 0x10, 0xa1, 0x6c, 0x0f, 0x8a, 0x08,
+	0xd4, 0x52, 0xcb, 0xff, 0x6b, 0xfa, 0xa8, 0xa5, 0x30, 0x46, 0xb9, 0x39, 0x38, 0x46, 0x90, 0xf8,
+	0x0e, 0xa6, 0xc3, 0xff, 0xe5, 0xda, 0xec, 0xad, 0x2e, 0x04, 0x51, 0x6e, 0x0c, 0x0c, 0x69, 0x3e,
+	0x83, 0xd0, 0x7f, 0x0b, 0xfb, 0x38, 0x83, 0x20, 0x42, 0xd9, 0x1e, 0x14, 0xd1, 0x6c, 0x3d, 0x34,
+	0xca, 0xf4, 0x61, 0x3d, 0x88, 0x50, 0xb6, 0x07, 0x45, 0x08, 0xeb, 0xee, 0xad, 0xde, 0x3a, 0xde,
+	0x5c, 0xef, 0xe7, 0x26, 0x0a, 0x81, 0x94, 0x5b, 0x43, 0x80, 0x04, 0x8f, 0x1f, 0x25, 0x90, 0xdb,
+	0x0c, 0x0b, 0x1f, 0xf6, 0xd6, 0xd9, 0x8a, 0x52, 0x6e, 0x0f, 0x83, 0x0a, 0xdc, 0xed, 0x1d, 0x26,
+	0xab, 0x7e, 0x4e, 0xb9, 0x2d, 0x52, 0xb9, 0x33, 0x2c, 0xb2, 0xb9, 0x4c, 0xc2, 0xf3, 0xca, 0x66,
+	0xbf, 0x55, 0x27, 0x20, 0xca, 0x8d, 0x81, 0x21, 0xcd, 0x04, 0xc2, 0x33, 0xc9, 0x66, 0xbf, 0x1d,
+	0x6b, 0x20, 0x02, 0x1d, 0x26, 0x91, 0xdd, 0xcf, 0x9f, 0xbd, 0x4a, 0x49, 0xcf, 0x5f, 0xa5, 0xa4,
+	0x7f, 0x5e, 0xa5, 0xa4, 0x9f, 0x5e, 0xa7, 0x46, 0x9e, 0xbf, 0x4e, 0x8d, 0xfc, 0xf5, 0x3a, 0x35,
+	0xf2, 0x64, 0xb3, 0xe9, 0x43, 0xcb, 0x55, 0xba, 0xc1, 0x7f, 0x31, 0xf0, 0xf5, 0x67, 0xea, 0x99,
+	0xe6, 0x1f, 0x24, 0xdc, 0xef, 0xae, 0x62, 0x94, 0xfd, 0x5a, 0x70, 0xfd, 0xff, 0x01, 0x00, 0xdf,
+	0x0b, 0xfe, 0x26, 0xab, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1597,6 +2004,10 @@ type MsgClient interface {
 	CreateTSSVoter(ctx context.Context, in *MsgCreateTSSVoter, opts ...grpc.CallOption) (*MsgCreateTSSVoterResponse, error)
 	AbortStuckCCTX(ctx context.Context, in *MsgAbortStuckCCTX, opts ...grpc.CallOption) (*MsgAbortStuckCCTXResponse, error)
 	RefundAbortedCCTX(ctx context.Context, in *MsgRefundAbortedCCTX, opts ...grpc.CallOption) (*MsgRefundAbortedCCTXResponse, error)
+	ExtendCctxDeadline(ctx context.Context, in *MsgExtendCctxDeadline, opts ...grpc.CallOption) (*MsgExtendCctxDeadlineResponse, error)
+	ClaimAbortedCCTXRefund(ctx context.Context, in *MsgClaimAbortedCCTXRefund, opts ...grpc.CallOption) (*MsgClaimAbortedCCTXRefundResponse, error)
+	UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
 }
 
 type msgClient struct {
@@ -1715,22 +2126,62 @@ func (c *msgClient) RefundAbortedCCTX(ctx context.Context, in *MsgRefundAbortedC
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddToOutTxTracker(context.Context, *MsgAddToOutTxTracker) (*MsgAddToOutTxTrackerResponse, error)
-	AddToInTxTracker(context.Context, *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error)
-	RemoveFromOutTxTracker(context.Context, *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error)
-	GasPriceVoter(context.Context, *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error)
-	VoteOnObservedOutboundTx(context.Context, *MsgVoteOnObservedOutboundTx) (*MsgVoteOnObservedOutboundTxResponse, error)
-	VoteOnObservedInboundTx(context.Context, *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error)
-	WhitelistERC20(context.Context, *MsgWhitelistERC20) (*MsgWhitelistERC20Response, error)
-	UpdateTssAddress(context.Context, *MsgUpdateTssAddress) (*MsgUpdateTssAddressResponse, error)
-	MigrateTssFunds(context.Context, *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error)
-	CreateTSSVoter(context.Context, *MsgCreateTSSVoter) (*MsgCreateTSSVoterResponse, error)
-	AbortStuckCCTX(context.Context, *MsgAbortStuckCCTX) (*MsgAbortStuckCCTXResponse, error)
-	RefundAbortedCCTX(context.Context, *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error)
-}
-
+func (c *msgClient) ExtendCctxDeadline(ctx context.Context, in *MsgExtendCctxDeadline, opts ...grpc.CallOption) (*MsgExtendCctxDeadlineResponse, error) {
+	out := new(MsgExtendCctxDeadlineResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/ExtendCctxDeadline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAbortedCCTXRefund(ctx context.Context, in *MsgClaimAbortedCCTXRefund, opts ...grpc.CallOption) (*MsgClaimAbortedCCTXRefundResponse, error) {
+	out := new(MsgClaimAbortedCCTXRefundResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/ClaimAbortedCCTXRefund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
+	out := new(MsgUpdateRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/UpdateRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.crosschain.Msg/RemoveRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddToOutTxTracker(context.Context, *MsgAddToOutTxTracker) (*MsgAddToOutTxTrackerResponse, error)
+	AddToInTxTracker(context.Context, *MsgAddToInTxTracker) (*MsgAddToInTxTrackerResponse, error)
+	RemoveFromOutTxTracker(context.Context, *MsgRemoveFromOutTxTracker) (*MsgRemoveFromOutTxTrackerResponse, error)
+	GasPriceVoter(context.Context, *MsgGasPriceVoter) (*MsgGasPriceVoterResponse, error)
+	VoteOnObservedOutboundTx(context.Context, *MsgVoteOnObservedOutboundTx) (*MsgVoteOnObservedOutboundTxResponse, error)
+	VoteOnObservedInboundTx(context.Context, *MsgVoteOnObservedInboundTx) (*MsgVoteOnObservedInboundTxResponse, error)
+	WhitelistERC20(context.Context, *MsgWhitelistERC20) (*MsgWhitelistERC20Response, error)
+	UpdateTssAddress(context.Context, *MsgUpdateTssAddress) (*MsgUpdateTssAddressResponse, error)
+	MigrateTssFunds(context.Context, *MsgMigrateTssFunds) (*MsgMigrateTssFundsResponse, error)
+	CreateTSSVoter(context.Context, *MsgCreateTSSVoter) (*MsgCreateTSSVoterResponse, error)
+	AbortStuckCCTX(context.Context, *MsgAbortStuckCCTX) (*MsgAbortStuckCCTXResponse, error)
+	RefundAbortedCCTX(context.Context, *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error)
+	ExtendCctxDeadline(context.Context, *MsgExtendCctxDeadline) (*MsgExtendCctxDeadlineResponse, error)
+	ClaimAbortedCCTXRefund(context.Context, *MsgClaimAbortedCCTXRefund) (*MsgClaimAbortedCCTXRefundResponse, error)
+	UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+}
+
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
@@ -1771,6 +2222,18 @@ func (*UnimplementedMsgServer) AbortStuckCCTX(ctx context.Context, req *MsgAbort
 func (*UnimplementedMsgServer) RefundAbortedCCTX(ctx context.Context, req *MsgRefundAbortedCCTX) (*MsgRefundAbortedCCTXResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RefundAbortedCCTX not implemented")
 }
+func (*UnimplementedMsgServer) ExtendCctxDeadline(ctx context.Context, req *MsgExtendCctxDeadline) (*MsgExtendCctxDeadlineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendCctxDeadline not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAbortedCCTXRefund(ctx context.Context, req *MsgClaimAbortedCCTXRefund) (*MsgClaimAbortedCCTXRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAbortedCCTXRefund not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1992,6 +2455,78 @@ func _Msg_RefundAbortedCCTX_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExtendCctxDeadline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExtendCctxDeadline)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExtendCctxDeadline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/ExtendCctxDeadline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExtendCctxDeadline(ctx, req.(*MsgExtendCctxDeadline))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAbortedCCTXRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAbortedCCTXRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAbortedCCTXRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/ClaimAbortedCCTXRefund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAbortedCCTXRefund(ctx, req.(*MsgClaimAbortedCCTXRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/UpdateRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRateLimit(ctx, req.(*MsgUpdateRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.crosschain.Msg/RemoveRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.crosschain.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2044,6 +2579,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RefundAbortedCCTX",
 			Handler:    _Msg_RefundAbortedCCTX_Handler,
 		},
+		{
+			MethodName: "ExtendCctxDeadline",
+			Handler:    _Msg_ExtendCctxDeadline_Handler,
+		},
+		{
+			MethodName: "ClaimAbortedCCTXRefund",
+			Handler:    _Msg_ClaimAbortedCCTXRefund_Handler,
+		},
+		{
+			MethodName: "UpdateRateLimit",
+			Handler:    _Msg_UpdateRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "crosschain/tx.proto",
@@ -2631,6 +3182,11 @@ func (m *MsgGasPriceVoter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PriorityFee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PriorityFee))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Supply) > 0 {
 		i -= len(m.Supply)
 		copy(dAtA[i:], m.Supply)
@@ -3070,104 +3626,364 @@ func (m *MsgRefundAbortedCCTXResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgClaimAbortedCCTXRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateTSSVoter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgClaimAbortedCCTXRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAbortedCCTXRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TssPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.KeyGenZetaHeight != 0 {
-		n += 1 + sovTx(uint64(m.KeyGenZetaHeight))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateTSSVoterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClaimAbortedCCTXRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateTssFunds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgClaimAbortedCCTXRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateTssFundsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgClaimAbortedCCTXRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTssAddress) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExtendCctxDeadline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExtendCctxDeadline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendCctxDeadline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ExtensionBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExtensionBlocks))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.TssPubkey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CctxIndex) > 0 {
+		i -= len(m.CctxIndex)
+		copy(dAtA[i:], m.CctxIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CctxIndex)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExtendCctxDeadlineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddToInTxTracker) Size() (n int) {
-	if m == nil {
+func (m *MsgExtendCctxDeadlineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExtendCctxDeadlineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Zrc20Address) > 0 {
+		i -= len(m.Zrc20Address)
+		copy(dAtA[i:], m.Zrc20Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Zrc20Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateTSSVoter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyGenZetaHeight != 0 {
+		n += 1 + sovTx(uint64(m.KeyGenZetaHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgCreateTSSVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateTssFunds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateTssFundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTssAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TssPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddToInTxTracker) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3357,6 +4173,9 @@ func (m *MsgGasPriceVoter) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.PriorityFee != 0 {
+		n += 1 + sovTx(uint64(m.PriorityFee))
+	}
 	return n
 }
 
@@ -3546,15 +4365,127 @@ func (m *MsgRefundAbortedCCTXResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgClaimAbortedCCTXRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgClaimAbortedCCTXRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgCreateTSSVoter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+
+func (m *MsgExtendCctxDeadline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CctxIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExtensionBlocks != 0 {
+		n += 1 + sovTx(uint64(m.ExtensionBlocks))
+	}
+	return n
+}
+
+func (m *MsgExtendCctxDeadlineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RateLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.Zrc20Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateTSSVoter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -5370,6 +6301,25 @@ func (m *MsgGasPriceVoter) Unmarshal(dAtA []byte) error {
 			}
 			m.Supply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityFee", wireType)
+			}
+			m.PriorityFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriorityFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5986,24 +6936,722 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverChain", wireType)
 			}
-			m.ReceiverChain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReceiverChain |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ReceiverChain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverChain |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBlockHeight", wireType)
+			}
+			m.InBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			}
+			m.CoinType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CoinType |= common.CoinType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxOrigin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxOrigin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventIndex", wireType)
+			}
+			m.EventIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVoteOnObservedInboundTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVoteOnObservedInboundTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVoteOnObservedInboundTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAbortStuckCCTX) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTX: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAbortStuckCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAbortStuckCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTX: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAbortedCCTXRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAbortedCCTXRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAbortedCCTXRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6031,13 +7679,11 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6065,11 +7711,11 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6097,68 +7743,111 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InTxHash = string(dAtA[iNdEx:postIndex])
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InBlockHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.InBlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InBlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.GasLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAbortedCCTXRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinType", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CoinType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CoinType |= common.CoinType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 13:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAbortedCCTXRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAbortedCCTXRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExtendCctxDeadline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExtendCctxDeadline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExtendCctxDeadline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxOrigin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6186,11 +7875,11 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxOrigin = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6218,13 +7907,13 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.CctxIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionBlocks", wireType)
 			}
-			m.EventIndex = 0
+			m.ExtensionBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6234,7 +7923,7 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventIndex |= uint64(b&0x7F) << shift
+				m.ExtensionBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6260,7 +7949,7 @@ func (m *MsgVoteOnObservedInboundTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVoteOnObservedInboundTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExtendCctxDeadlineResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6283,10 +7972,10 @@ func (m *MsgVoteOnObservedInboundTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVoteOnObservedInboundTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExtendCctxDeadlineResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVoteOnObservedInboundTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExtendCctxDeadlineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6310,7 +7999,7 @@ func (m *MsgVoteOnObservedInboundTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAbortStuckCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6333,10 +8022,10 @@ func (m *MsgAbortStuckCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAbortStuckCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAbortStuckCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6373,9 +8062,9 @@ func (m *MsgAbortStuckCCTX) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6385,23 +8074,24 @@ func (m *MsgAbortStuckCCTX) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6424,7 +8114,7 @@ func (m *MsgAbortStuckCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAbortStuckCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6447,10 +8137,10 @@ func (m *MsgAbortStuckCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAbortStuckCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAbortStuckCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6474,7 +8164,7 @@ func (m *MsgAbortStuckCCTXResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6497,10 +8187,10 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundAbortedCCTX: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundAbortedCCTX: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6536,10 +8226,10 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CctxIndex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var stringLen uint64
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6549,27 +8239,14 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CctxIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zrc20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6597,7 +8274,7 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			m.Zrc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6620,7 +8297,7 @@ func (m *MsgRefundAbortedCCTX) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6643,10 +8320,10 @@ func (m *MsgRefundAbortedCCTXResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRefundAbortedCCTXResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -12,23 +12,15 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
+	params := keeper.GetParams(ctx)
 	emissionPoolBalance := keeper.GetReservesFactor(ctx)
-	blockRewards := types.BlockReward
+	blockRewards := params.GetBlockRewards(ctx.BlockHeight())
 	if blockRewards.GT(emissionPoolBalance) {
 		ctx.Logger().Info(fmt.Sprintf("Block rewards %s are greater than emission pool balance %s", blockRewards.String(), emissionPoolBalance.String()))
 		return
 	}
-	validatorRewards := sdk.MustNewDecFromStr(keeper.GetParams(ctx).ValidatorEmissionPercentage).Mul(blockRewards).TruncateInt()
-	observerRewards := sdk.MustNewDecFromStr(keeper.GetParams(ctx).ObserverEmissionPercentage).Mul(blockRewards).TruncateInt()
-	tssSignerRewards := sdk.MustNewDecFromStr(keeper.GetParams(ctx).TssSignerEmissionPercentage).Mul(blockRewards).TruncateInt()
-
-	// TODO : Replace hardcoded slash amount with a parameter
-	// https://github.com/zeta-chain/node/pull/1861
-	slashAmount, ok := sdkmath.NewIntFromString(types.ObserverSlashAmount)
-	if !ok {
-		ctx.Logger().Error(fmt.Sprintf("Error while parsing observer slash amount %s", types.ObserverSlashAmount))
-		return
-	}
+	validatorRewards, observerRewards, tssSignerRewards := params.SplitBlockRewards(blockRewards)
+	slashAmount := params.ObserverSlashAmount
 
 	// Use a tmpCtx, which is a cache-wrapped context to avoid writing to the store
 	// We commit only if all three distributions are successful, if not the funds stay in the emission pool
@@ -50,8 +42,7 @@ func BeginBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	}
 	commit()
 
-	types.EmitValidatorEmissions(ctx, "", "",
-		"",
+	types.EmitValidatorEmissions(ctx, "",
 		validatorRewards.String(),
 		observerRewards.String(),
 		tssSignerRewards.String())
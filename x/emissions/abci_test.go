@@ -88,7 +88,7 @@ func TestBeginBlocker(t *testing.T) {
 		})
 
 		// Total block rewards is the fixed amount of rewards that are distributed
-		totalBlockRewards, err := common.GetAzetaDecFromAmountInZeta(emissionstypes.BlockRewardsInZeta)
+		totalBlockRewards, err := common.GetAzetaDecFromAmountInZeta("210000000")
 		totalRewardCoins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, totalBlockRewards.TruncateInt()))
 		require.NoError(t, err)
 		// Fund the emission pool to start the emission process
@@ -101,7 +101,7 @@ func TestBeginBlocker(t *testing.T) {
 		feeCollecterAddress := sk.AuthKeeper.GetModuleAccount(ctx, types.FeeCollectorName).GetAddress()
 		emissionPool := sk.AuthKeeper.GetModuleAccount(ctx, emissionstypes.ModuleName).GetAddress()
 
-		blockRewards := emissionstypes.BlockReward
+		blockRewards := k.GetParams(ctx).GetBlockRewards(ctx.BlockHeight())
 		observerRewardsForABlock := blockRewards.Mul(sdk.MustNewDecFromStr(k.GetParams(ctx).ObserverEmissionPercentage)).TruncateInt()
 		validatorRewardsForABlock := blockRewards.Mul(sdk.MustNewDecFromStr(k.GetParams(ctx).ValidatorEmissionPercentage)).TruncateInt()
 		tssSignerRewardsForABlock := blockRewards.Mul(sdk.MustNewDecFromStr(k.GetParams(ctx).TssSignerEmissionPercentage)).TruncateInt()
@@ -246,7 +246,7 @@ func TestDistributeObserverRewards(t *testing.T) {
 			zk.ObserverKeeper.SetObserverSet(ctx, observerSet)
 
 			// Total block rewards is the fixed amount of rewards that are distributed
-			totalBlockRewards, err := common.GetAzetaDecFromAmountInZeta(emissionstypes.BlockRewardsInZeta)
+			totalBlockRewards, err := common.GetAzetaDecFromAmountInZeta("210000000")
 			totalRewardCoins := sdk.NewCoins(sdk.NewCoin(config.BaseDenom, totalBlockRewards.TruncateInt()))
 			require.NoError(t, err)
 
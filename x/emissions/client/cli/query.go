@@ -26,7 +26,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams(),
 		CmdListPoolAddresses(),
 		CmdGetEmmisonsFactors(),
-		CmdShowAvailableEmissions())
+		CmdShowAvailableEmissions(),
+		CmdBlockRewards())
 	// this line is used by starport scaffolding # 1
 	return cmd
 }
@@ -0,0 +1,45 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func CmdBlockRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-rewards [height]",
+		Short: "Query the block rewards projected at a height with the current emission schedule",
+		Long: `Query the block rewards projected at a height with the current emission schedule,
+and their distribution among validators, observers and tss signers. The current height is used if not provided.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryBlockRewardsRequest{}
+			if len(args) > 0 {
+				if params.Height, err = strconv.ParseInt(args[0], 10, 64); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BlockRewards(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
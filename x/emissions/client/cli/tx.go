@@ -18,6 +18,9 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(CmdWithdrawEmission())
+	cmd.AddCommand(
+		CmdWithdrawEmission(),
+		CmdUpdateParams(),
+	)
 	return cmd
 }
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func CmdUpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params [params-json-file]",
+		Short: "Update the emissions params, including the emission schedule",
+		Long: `Update the emissions params, including the emission schedule and the observer slash amount.
+The file contains all the params in JSON, as returned by the params query, and replaces the current params.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			paramsBytes, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.Codec.UnmarshalJSON(paramsBytes, &params); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateParams(clientCtx.GetFromAddress().String(), params)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
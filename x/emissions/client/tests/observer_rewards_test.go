@@ -11,7 +11,6 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/zeta-chain/zetacore/cmd/zetacored/config"
 	emissionscli "github.com/zeta-chain/zetacore/x/emissions/client/cli"
-	emissionskeeper "github.com/zeta-chain/zetacore/x/emissions/keeper"
 	emissionstypes "github.com/zeta-chain/zetacore/x/emissions/types"
 	observercli "github.com/zeta-chain/zetacore/x/observer/client/cli"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
@@ -40,10 +39,6 @@ func (s *CliTestSuite) TestObserverRewards() {
 	s.Require().NoError(s.network.WaitForNextBlock())
 
 	// Collect parameter values and build assertion map for the randomised ballot set created
-	emissionFactors := emissionstypes.QueryGetEmissionsFactorsResponse{}
-	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, emissionscli.CmdGetEmmisonsFactors(), []string{"--output", "json"})
-	s.Require().NoError(err)
-	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &emissionFactors))
 	emissionParams := emissionstypes.QueryParamsResponse{}
 	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, emissionscli.CmdQueryParams(), []string{"--output", "json"})
 	s.Require().NoError(err)
@@ -54,14 +49,7 @@ func (s *CliTestSuite) TestObserverRewards() {
 	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &observerParams))
 	_, err = s.network.WaitForHeight(s.ballots[0].BallotCreationHeight + observerParams.Params.BallotMaturityBlocks)
 	s.Require().NoError(err)
-	out, err = clitestutil.ExecTestCLICmd(val.ClientCtx, emissionscli.CmdGetEmmisonsFactors(), []string{"--output", "json"})
-	resFactorsNewBlocks := emissionstypes.QueryGetEmissionsFactorsResponse{}
-	s.Require().NoError(err)
-	s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resFactorsNewBlocks))
-	// Duration factor is calculated in the same block,so we need to query based from the committed state at which the distribution is done
-	// Would be cleaner to use `--height` flag, but it is not supported by the ExecTestCLICmd function yet
-	emissionFactors.DurationFactor = resFactorsNewBlocks.DurationFactor
-	asertValues := CalculateObserverRewards(&s.Suite, s.ballots, emissionParams.Params.ObserverEmissionPercentage, emissionFactors.ReservesFactor, emissionFactors.BondFactor, emissionFactors.DurationFactor)
+	asertValues := CalculateObserverRewards(&s.Suite, s.ballots, emissionParams.Params.ObserverEmissionPercentage)
 
 	// Assert withdrawable rewards for each validator
 	resAvailable := emissionstypes.QueryShowAvailableEmissionsResponse{}
@@ -74,11 +62,9 @@ func (s *CliTestSuite) TestObserverRewards() {
 
 }
 
-func CalculateObserverRewards(s *suite.Suite, ballots []*observertypes.Ballot, observerEmissionPercentage, reservesFactor, bondFactor, durationFactor string) map[string]sdkmath.Int {
+func CalculateObserverRewards(s *suite.Suite, ballots []*observertypes.Ballot, observerEmissionPercentage string) map[string]sdkmath.Int {
 	calculatedDistributer := map[string]sdkmath.Int{}
-	//blockRewards := sdk.MustNewDecFromStr(reservesFactor).Mul(sdk.MustNewDecFromStr(bondFactor)).Mul(sdk.MustNewDecFromStr(durationFactor))
-	blockRewards, err := emissionskeeper.CalculateFixedValidatorRewards(emissionstypes.AvgBlockTime)
-	s.Require().NoError(err)
+	blockRewards := emissionstypes.DefaultParams().BlockRewardAmount
 	observerRewards := sdk.MustNewDecFromStr(observerEmissionPercentage).Mul(blockRewards).TruncateInt()
 	rewardsDistributer := map[string]int64{}
 	totalRewardsUnits := int64(0)
@@ -3,8 +3,6 @@ package emissions_test
 import (
 	"testing"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
-
 	"github.com/stretchr/testify/require"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/nullify"
@@ -14,11 +12,8 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
-	params := types.DefaultParams()
-	params.ObserverSlashAmount = sdk.Int{}
-
 	genesisState := types.GenesisState{
-		Params: params,
+		Params: types.DefaultParams(),
 		WithdrawableEmissions: []types.WithdrawableEmissions{
 			sample.WithdrawableEmissions(t),
 			sample.WithdrawableEmissions(t),
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BlockRewards returns the block rewards projected at a height with the current emission schedule
+// the projection doesn't account for the balance of the emission pool, no rewards are distributed if it is too low
+func (k Keeper) BlockRewards(goCtx context.Context, req *types.QueryBlockRewardsRequest) (*types.QueryBlockRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	height := req.Height
+	if height == 0 {
+		height = ctx.BlockHeight()
+	}
+	params := k.GetParams(ctx)
+	blockRewards := params.GetBlockRewards(height)
+	validatorRewards, observerRewards, tssSignerRewards := params.SplitBlockRewards(blockRewards)
+
+	return &types.QueryBlockRewardsResponse{
+		Height:           height,
+		BlockRewards:     blockRewards,
+		ValidatorRewards: validatorRewards,
+		ObserverRewards:  observerRewards,
+		TssSignerRewards: tssSignerRewards,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func TestKeeper_BlockRewards(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionsKeeper(t)
+
+		_, err := k.BlockRewards(sdk.WrapSDKContext(ctx), nil)
+		require.Error(t, err)
+
+		_, err = k.BlockRewards(sdk.WrapSDKContext(ctx), &types.QueryBlockRewardsRequest{Height: -1})
+		require.Error(t, err)
+	})
+
+	t.Run("can project the block rewards at a future height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionsKeeper(t)
+		params := types.DefaultParams()
+		params.BlockRewardAmount = sdk.NewDec(1000)
+		params.DecayType = types.EmissionDecayType_Step
+		params.DecayRate = sdk.MustNewDecFromStr("0.5")
+		params.DecayIntervalBlocks = 100
+		k.SetParams(ctx, params)
+
+		res, err := k.BlockRewards(sdk.WrapSDKContext(ctx), &types.QueryBlockRewardsRequest{Height: 250})
+		require.NoError(t, err)
+		require.EqualValues(t, 250, res.Height)
+		require.True(t, res.BlockRewards.Equal(sdk.NewDec(250)))
+		require.Equal(t, sdkmath.NewInt(125), res.ValidatorRewards)
+		require.Equal(t, sdkmath.NewInt(62), res.ObserverRewards)
+		require.Equal(t, sdkmath.NewInt(62), res.TssSignerRewards)
+	})
+
+	t.Run("current height is used if not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionsKeeper(t)
+		ctx = ctx.WithBlockHeight(42)
+
+		res, err := k.BlockRewards(sdk.WrapSDKContext(ctx), &types.QueryBlockRewardsRequest{})
+		require.NoError(t, err)
+		require.EqualValues(t, 42, res.Height)
+		require.True(t, res.BlockRewards.Equal(types.DefaultParams().BlockRewardAmount))
+	})
+}
@@ -7,13 +7,11 @@ import (
 	"github.com/zeta-chain/zetacore/x/emissions/types"
 )
 
+// GetEmissionsFactors returns the balance of the emission pool as reserves factor
 func (k Keeper) GetEmissionsFactors(goCtx context.Context, _ *types.QueryGetEmissionsFactorsRequest) (*types.QueryGetEmissionsFactorsResponse, error) {
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	reservesFactor, bondFactor, durationFactor := k.GetBlockRewardComponents(ctx)
 	return &types.QueryGetEmissionsFactorsResponse{
-		ReservesFactor: reservesFactor.String(),
-		BondFactor:     bondFactor.String(),
-		DurationFactor: durationFactor.String(),
+		ReservesFactor: k.GetReservesFactor(ctx).String(),
 	}, nil
 }
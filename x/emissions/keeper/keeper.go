@@ -23,6 +23,7 @@ type (
 		stakingKeeper    types.StakingKeeper
 		observerKeeper   types.ObserverKeeper
 		authKeeper       types.AccountKeeper
+		authorityKeeper  types.AuthorityKeeper
 	}
 )
 
@@ -36,6 +37,7 @@ func NewKeeper(
 	stakingKeeper types.StakingKeeper,
 	observerKeeper types.ObserverKeeper,
 	authKeeper types.AccountKeeper,
+	authorityKeeper types.AuthorityKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -52,6 +54,7 @@ func NewKeeper(
 		stakingKeeper:    stakingKeeper,
 		observerKeeper:   observerKeeper,
 		authKeeper:       authKeeper,
+		authorityKeeper:  authorityKeeper,
 	}
 }
 
@@ -78,3 +81,7 @@ func (k Keeper) GetObserverKeeper() types.ObserverKeeper {
 func (k Keeper) GetAuthKeeper() types.AccountKeeper {
 	return k.authKeeper
 }
+
+func (k Keeper) GetAuthorityKeeper() types.AuthorityKeeper {
+	return k.authorityKeeper
+}
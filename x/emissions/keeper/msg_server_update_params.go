@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+// UpdateParams updates the emissions params, including the emission schedule and the observer slash amount
+// the new emission schedule applies from the next block, the block rewards of the past blocks are not recomputed
+//
+// Authorized: admin policy group 2.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, 0) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "update can only be executed by the correct policy account")
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
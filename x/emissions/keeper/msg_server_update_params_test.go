@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/emissions/keeper"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func TestMsgServer_UpdateParams(t *testing.T) {
+	// halving schedule
+	newParams := func() types.Params {
+		params := types.DefaultParams()
+		params.DecayType = types.EmissionDecayType_Step
+		params.DecayRate = sdk.MustNewDecFromStr("0.5")
+		params.DecayIntervalBlocks = 1000
+		params.DecayStartHeight = 100
+		return params
+	}
+
+	t.Run("can update params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionKeeperWithMockOptions(t, keepertest.EmissionMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetEmissionsAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(admin, newParams()))
+		require.NoError(t, err)
+
+		params := k.GetParams(ctx)
+		require.Equal(t, types.EmissionDecayType_Step, params.DecayType)
+		require.True(t, params.DecayRate.Equal(sdk.MustNewDecFromStr("0.5")))
+		require.EqualValues(t, 1000, params.DecayIntervalBlocks)
+		require.EqualValues(t, 100, params.DecayStartHeight)
+	})
+
+	t.Run("can't update params if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionKeeperWithMockOptions(t, keepertest.EmissionMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetEmissionsAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, false)
+
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(admin, newParams()))
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.Equal(t, types.EmissionDecayType_Constant, k.GetParams(ctx).DecayType)
+	})
+
+	t.Run("can't update with invalid params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionKeeperWithMockOptions(t, keepertest.EmissionMockOptions{
+			UseAuthorityMock: true,
+		})
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetEmissionsAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+		params := newParams()
+		params.DecayIntervalBlocks = 0
+
+		_, err := msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(admin, params))
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+		require.Equal(t, types.EmissionDecayType_Constant, k.GetParams(ctx).DecayType)
+	})
+}
@@ -11,6 +11,12 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// GetParamsIfExists get all parameters as types.Params, the parameters missing from the store are left unset
+func (k Keeper) GetParamsIfExists(ctx sdk.Context) (params types.Params) {
+	k.paramstore.GetParamSetIfExists(ctx, &params)
+	return
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
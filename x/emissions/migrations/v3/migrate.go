@@ -0,0 +1,27 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+// emissionsKeeper prevents circular dependency
+type emissionsKeeper interface {
+	GetParamsIfExists(ctx sdk.Context) (params types.Params)
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// MigrateStore performs in-place store migrations from v2 to v3
+// the emission schedule and the observer slash amount become params, they are set to the previously hardcoded values
+// the bond and duration factor params (max and min bond factor, avg block time, target bond ratio and duration factor
+// constant) are removed, their values left in the params store are no longer read
+func MigrateStore(ctx sdk.Context, emissionsKeeper emissionsKeeper) error {
+	ctx.Logger().Info("Migrating emissions store from v2 to v3")
+	params := emissionsKeeper.GetParamsIfExists(ctx)
+	params.SetDefaultEmissionSchedule()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	emissionsKeeper.SetParams(ctx, params)
+	return nil
+}
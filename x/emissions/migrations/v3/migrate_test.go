@@ -0,0 +1,29 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v3 "github.com/zeta-chain/zetacore/x/emissions/migrations/v3"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("Migrate store from v2 to v3", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.EmissionsKeeper(t)
+		params := types.DefaultParams()
+		params.ValidatorEmissionPercentage = "00.40"
+		params.BlockRewardAmount = params.BlockRewardAmount.MulInt64(2)
+		k.SetParams(ctx, params)
+
+		err := v3.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		params = k.GetParams(ctx)
+		require.Equal(t, "00.40", params.ValidatorEmissionPercentage)
+		require.True(t, types.DefaultParams().BlockRewardAmount.Equal(params.BlockRewardAmount))
+		require.True(t, types.DefaultParams().ObserverSlashAmount.Equal(params.ObserverSlashAmount))
+		require.Equal(t, types.EmissionDecayType_Constant, params.DecayType)
+	})
+}
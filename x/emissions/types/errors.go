@@ -8,4 +8,5 @@ var (
 	ErrInvalidAddress                      = errorsmod.Register(ModuleName, 1003, "invalid address")
 	ErrRewardsPoolDoesNotHaveEnoughBalance = errorsmod.Register(ModuleName, 1004, "rewards pool does not have enough balance")
 	ErrInvalidAmount                       = errorsmod.Register(ModuleName, 1005, "invalid amount")
+	ErrInvalidParams                       = errorsmod.Register(ModuleName, 1006, "invalid params")
 )
@@ -157,9 +157,7 @@ func (m *EventObserverEmissions) GetEmissions() []*ObserverEmission {
 
 type EventBlockEmissions struct {
 	MsgTypeUrl               string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
-	BondFactor               string `protobuf:"bytes,2,opt,name=bond_factor,json=bondFactor,proto3" json:"bond_factor,omitempty"`
 	ReservesFactor           string `protobuf:"bytes,3,opt,name=reserves_factor,json=reservesFactor,proto3" json:"reserves_factor,omitempty"`
-	DurationFactor           string `protobuf:"bytes,4,opt,name=duration_factor,json=durationFactor,proto3" json:"duration_factor,omitempty"`
 	ValidatorRewardsForBlock string `protobuf:"bytes,5,opt,name=validator_rewards_for_block,json=validatorRewardsForBlock,proto3" json:"validator_rewards_for_block,omitempty"`
 	ObserverRewardsForBlock  string `protobuf:"bytes,6,opt,name=observer_rewards_for_block,json=observerRewardsForBlock,proto3" json:"observer_rewards_for_block,omitempty"`
 	TssRewardsForBlock       string `protobuf:"bytes,7,opt,name=tss_rewards_for_block,json=tssRewardsForBlock,proto3" json:"tss_rewards_for_block,omitempty"`
@@ -205,13 +203,6 @@ func (m *EventBlockEmissions) GetMsgTypeUrl() string {
 	return ""
 }
 
-func (m *EventBlockEmissions) GetBondFactor() string {
-	if m != nil {
-		return m.BondFactor
-	}
-	return ""
-}
-
 func (m *EventBlockEmissions) GetReservesFactor() string {
 	if m != nil {
 		return m.ReservesFactor
@@ -219,13 +210,6 @@ func (m *EventBlockEmissions) GetReservesFactor() string {
 	return ""
 }
 
-func (m *EventBlockEmissions) GetDurationFactor() string {
-	if m != nil {
-		return m.DurationFactor
-	}
-	return ""
-}
-
 func (m *EventBlockEmissions) GetValidatorRewardsForBlock() string {
 	if m != nil {
 		return m.ValidatorRewardsForBlock
@@ -258,37 +242,37 @@ func init() { proto.RegisterFile("emissions/events.proto", fileDescriptor_ff5100
 
 var fileDescriptor_ff510015c00ef7ae = []byte{
 	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0xbd, 0x69, 0x9b, 0x2a, 0x93, 0xd0, 0x46, 0x0b, 0x14, 0x2b, 0x20, 0x27, 0xca, 0x01,
-	0x42, 0x45, 0x6d, 0x28, 0x47, 0xc4, 0x81, 0x48, 0x8d, 0x04, 0x42, 0xaa, 0x64, 0xe0, 0xc2, 0xc5,
-	0x5a, 0xdb, 0x5b, 0xc7, 0xaa, 0xed, 0x8d, 0x76, 0x36, 0x81, 0xf2, 0x04, 0x1c, 0x79, 0x08, 0x0e,
-	0x3c, 0x4a, 0x8f, 0x3d, 0x21, 0xe0, 0x50, 0xa1, 0xe4, 0x45, 0x90, 0x37, 0x76, 0x12, 0x05, 0x14,
-	0x89, 0x53, 0x36, 0xbf, 0xbe, 0xdf, 0x33, 0xff, 0xce, 0x2c, 0x1c, 0xf0, 0x34, 0x46, 0x8c, 0x45,
-	0x86, 0x0e, 0x9f, 0xf0, 0x4c, 0xa1, 0x3d, 0x92, 0x42, 0x09, 0x7a, 0xef, 0x13, 0x57, 0x2c, 0x18,
-	0xb2, 0x38, 0xb3, 0xf5, 0x49, 0x48, 0x6e, 0x2f, 0xd0, 0xd6, 0xad, 0x48, 0x44, 0x42, 0x83, 0x4e,
-	0x7e, 0x9a, 0x7b, 0xba, 0xdf, 0x09, 0x34, 0x4f, 0x7d, 0xe4, 0x72, 0xc2, 0xe5, 0x49, 0xc1, 0xd2,
-	0x53, 0xb8, 0x51, 0xfa, 0x3c, 0x75, 0x31, 0xe2, 0x26, 0xe9, 0x90, 0xde, 0xde, 0xf1, 0xa1, 0xbd,
-	0xa9, 0x80, 0x5d, 0xda, 0xdf, 0x5e, 0x8c, 0xb8, 0xdb, 0xe0, 0x2b, 0xff, 0xe8, 0x43, 0x68, 0x8a,
-	0xa2, 0x88, 0xc7, 0xc2, 0x50, 0x72, 0x44, 0xb3, 0xd2, 0x21, 0xbd, 0x9a, 0xbb, 0x5f, 0xea, 0x2f,
-	0xe6, 0x32, 0x1d, 0x40, 0x95, 0xa5, 0x62, 0x9c, 0x29, 0x73, 0x2b, 0x07, 0xfa, 0xf6, 0xe5, 0x75,
-	0xdb, 0xf8, 0x75, 0xdd, 0xbe, 0x1f, 0xc5, 0x6a, 0x38, 0xf6, 0xed, 0x40, 0xa4, 0x4e, 0x20, 0x30,
-	0x15, 0x58, 0xfc, 0x1c, 0x61, 0x78, 0xee, 0xe4, 0x5d, 0xa2, 0xfd, 0x32, 0x53, 0x6e, 0xe1, 0xee,
-	0x7e, 0x26, 0x70, 0x70, 0x92, 0xdf, 0xce, 0x7a, 0x3a, 0xa4, 0x1d, 0x68, 0xa4, 0x18, 0xe9, 0x64,
-	0xde, 0x58, 0x26, 0x3a, 0x5d, 0xcd, 0x85, 0x14, 0xa3, 0xbc, 0xd9, 0x77, 0x32, 0xa1, 0xaf, 0xa1,
-	0xb6, 0xc8, 0x65, 0x56, 0x3a, 0x5b, 0xbd, 0xfa, 0xb1, 0xbd, 0x39, 0xfc, 0x7a, 0x15, 0x77, 0xf9,
-	0x81, 0xee, 0xcf, 0x0a, 0xdc, 0xd4, 0xad, 0xf4, 0x13, 0x11, 0x9c, 0xff, 0x4f, 0x1f, 0x6d, 0xa8,
-	0xfb, 0x22, 0x0b, 0xbd, 0x33, 0x16, 0x28, 0x21, 0x8b, 0x2b, 0x83, 0x5c, 0x1a, 0x68, 0x85, 0x3e,
-	0x80, 0x7d, 0xc9, 0x75, 0x65, 0x2c, 0x21, 0x7d, 0x6d, 0xee, 0x5e, 0x29, 0x2f, 0xc1, 0x70, 0x2c,
-	0x99, 0xca, 0x47, 0x5a, 0x80, 0xdb, 0x73, 0xb0, 0x94, 0x0b, 0xf0, 0x39, 0xdc, 0x9d, 0xb0, 0x24,
-	0x0e, 0x99, 0x12, 0xd2, 0x93, 0xfc, 0x03, 0x93, 0x21, 0x7a, 0x67, 0x42, 0x7a, 0x7e, 0xde, 0xbc,
-	0xb9, 0xa3, 0x4d, 0xe6, 0x02, 0x71, 0xe7, 0xc4, 0x40, 0x48, 0x1d, 0x8e, 0x3e, 0x83, 0xd6, 0x62,
-	0xd2, 0x7f, 0xbb, 0xab, 0xda, 0x7d, 0xa7, 0x24, 0xd6, 0xcd, 0x4f, 0xe0, 0xb6, 0x42, 0xfc, 0x87,
-	0x6f, 0x57, 0xfb, 0xa8, 0x42, 0x5c, 0xb3, 0x1c, 0x3e, 0x82, 0xc6, 0xea, 0xde, 0xd1, 0x1a, 0xec,
-	0xbc, 0x49, 0x18, 0x0e, 0x9b, 0x06, 0xad, 0xc3, 0x6e, 0x41, 0x37, 0x49, 0x6b, 0xfb, 0xdb, 0x57,
-	0x8b, 0xf4, 0x5f, 0x5d, 0x4e, 0x2d, 0x72, 0x35, 0xb5, 0xc8, 0xef, 0xa9, 0x45, 0xbe, 0xcc, 0x2c,
-	0xe3, 0x6a, 0x66, 0x19, 0x3f, 0x66, 0x96, 0xf1, 0xfe, 0xf1, 0xca, 0x7a, 0xe5, 0xe3, 0x3d, 0xd2,
-	0x93, 0x76, 0xca, 0x49, 0x3b, 0x1f, 0x9d, 0xe5, 0xa3, 0xd3, 0xcb, 0xe6, 0x57, 0xf5, 0x03, 0x7a,
-	0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xa4, 0x8d, 0x2d, 0x0d, 0x8e, 0x03, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0xf9, 0xd7, 0x66, 0x13, 0x1a, 0x6b, 0x81, 0x62, 0x05, 0xe4, 0x46, 0x39, 0x40,
+	0xa8, 0xa8, 0x0d, 0xe5, 0x88, 0x38, 0x10, 0xa9, 0x91, 0x88, 0x90, 0x2a, 0x19, 0xb8, 0x70, 0xb1,
+	0xd6, 0xf6, 0xd6, 0xb1, 0x6a, 0x7b, 0xa3, 0x9d, 0x4d, 0xa0, 0x3c, 0x01, 0x47, 0x1e, 0x82, 0x03,
+	0xaf, 0xc0, 0x1b, 0xf4, 0xd8, 0x13, 0x42, 0x1c, 0x2a, 0x94, 0xbc, 0x08, 0xf2, 0xda, 0x4e, 0xa3,
+	0x80, 0x2a, 0xf5, 0xe4, 0xf5, 0xe8, 0xfb, 0xcd, 0xcc, 0xb7, 0x33, 0x8b, 0x77, 0x59, 0x12, 0x01,
+	0x44, 0x3c, 0x05, 0x9b, 0xcd, 0x59, 0x2a, 0xc1, 0x9a, 0x0a, 0x2e, 0x39, 0x79, 0xf0, 0x99, 0x49,
+	0xea, 0x4f, 0x68, 0x94, 0x5a, 0xea, 0xc4, 0x05, 0xb3, 0x56, 0xd2, 0xee, 0x9d, 0x90, 0x87, 0x5c,
+	0x09, 0xed, 0xec, 0x94, 0x33, 0xfd, 0x9f, 0x08, 0xeb, 0xc7, 0x1e, 0x30, 0x31, 0x67, 0xe2, 0xa8,
+	0xd0, 0x92, 0x63, 0x7c, 0xab, 0xe4, 0x5c, 0x79, 0x36, 0x65, 0x06, 0xea, 0xa1, 0xc1, 0xce, 0xe1,
+	0xbe, 0x75, 0x5d, 0x01, 0xab, 0xc4, 0xdf, 0x9d, 0x4d, 0x99, 0xd3, 0x66, 0x6b, 0x7f, 0xe4, 0x31,
+	0xd6, 0x79, 0x51, 0xc4, 0xa5, 0x41, 0x20, 0x18, 0x80, 0x51, 0xe9, 0xa1, 0x41, 0xd3, 0xe9, 0x94,
+	0xf1, 0x57, 0x79, 0x98, 0x8c, 0x70, 0x83, 0x26, 0x7c, 0x96, 0x4a, 0xa3, 0x9a, 0x09, 0x86, 0xd6,
+	0xf9, 0xe5, 0x9e, 0xf6, 0xfb, 0x72, 0xef, 0x61, 0x18, 0xc9, 0xc9, 0xcc, 0xb3, 0x7c, 0x9e, 0xd8,
+	0x3e, 0x87, 0x84, 0x43, 0xf1, 0x39, 0x80, 0xe0, 0xd4, 0xce, 0xba, 0x04, 0xeb, 0x75, 0x2a, 0x9d,
+	0x82, 0xee, 0x7f, 0x41, 0x78, 0xf7, 0x28, 0xbb, 0x9d, 0x4d, 0x77, 0x40, 0x7a, 0xb8, 0x9d, 0x40,
+	0xa8, 0x9c, 0xb9, 0x33, 0x11, 0x2b, 0x77, 0x4d, 0x07, 0x27, 0x10, 0x66, 0xcd, 0xbe, 0x17, 0x31,
+	0x79, 0x83, 0x9b, 0x2b, 0x5f, 0x46, 0xa5, 0x57, 0x1d, 0xb4, 0x0e, 0xad, 0xeb, 0xcd, 0x6f, 0x56,
+	0x71, 0xae, 0x12, 0xf4, 0x7f, 0x54, 0xf0, 0x6d, 0xd5, 0xca, 0x30, 0xe6, 0xfe, 0xe9, 0x4d, 0xfa,
+	0x78, 0x84, 0x3b, 0x82, 0xa9, 0xc4, 0xe0, 0x9e, 0x50, 0x5f, 0x72, 0x91, 0xdf, 0x8a, 0xb3, 0x53,
+	0x86, 0x47, 0x2a, 0x4a, 0x5e, 0xe2, 0xfb, 0x73, 0x1a, 0x47, 0x01, 0x95, 0x5c, 0xb8, 0x82, 0x7d,
+	0xa4, 0x22, 0x00, 0xf7, 0x84, 0x0b, 0xd7, 0xcb, 0x4a, 0x1a, 0x75, 0x05, 0x19, 0x2b, 0x89, 0x93,
+	0x2b, 0x46, 0x5c, 0xa8, 0x96, 0xc8, 0x0b, 0xdc, 0x5d, 0xcd, 0xe7, 0x5f, 0xba, 0xa1, 0xe8, 0x7b,
+	0xa5, 0x62, 0x13, 0x7e, 0x86, 0xef, 0x4a, 0x80, 0xff, 0x70, 0x5b, 0x8a, 0x23, 0x12, 0x60, 0x03,
+	0x19, 0xd7, 0xb6, 0x2b, 0x7a, 0x75, 0x5c, 0xdb, 0xae, 0xe9, 0x75, 0xa7, 0xe5, 0xf1, 0x34, 0x28,
+	0xdc, 0x39, 0x9d, 0x60, 0x26, 0xa8, 0xcc, 0xf6, 0x2e, 0x0f, 0xec, 0x3f, 0xc1, 0xed, 0xf5, 0xbd,
+	0x22, 0x4d, 0x5c, 0x7f, 0x1b, 0x53, 0x98, 0xe8, 0x1a, 0x69, 0xe1, 0xad, 0x22, 0xaf, 0x8e, 0xba,
+	0xb5, 0xef, 0xdf, 0x4c, 0x34, 0x1c, 0x9f, 0x2f, 0x4c, 0x74, 0xb1, 0x30, 0xd1, 0x9f, 0x85, 0x89,
+	0xbe, 0x2e, 0x4d, 0xed, 0x62, 0x69, 0x6a, 0xbf, 0x96, 0xa6, 0xf6, 0xe1, 0xe9, 0xda, 0xfa, 0x64,
+	0xe3, 0x3b, 0x50, 0x93, 0xb4, 0xcb, 0x49, 0xda, 0x9f, 0xec, 0xab, 0x47, 0xa5, 0x96, 0xc9, 0x6b,
+	0xa8, 0x07, 0xf2, 0xfc, 0xef, 0x00, 0x39, 0xcd, 0x0e, 0x6e, 0x6e, 0x03, 0x00, 0x00,
 }
 
 func (m *ObserverEmission) Marshal() (dAtA []byte, err error) {
@@ -421,13 +405,6 @@ func (m *EventBlockEmissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.DurationFactor) > 0 {
-		i -= len(m.DurationFactor)
-		copy(dAtA[i:], m.DurationFactor)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DurationFactor)))
-		i--
-		dAtA[i] = 0x22
-	}
 	if len(m.ReservesFactor) > 0 {
 		i -= len(m.ReservesFactor)
 		copy(dAtA[i:], m.ReservesFactor)
@@ -435,13 +412,6 @@ func (m *EventBlockEmissions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.BondFactor) > 0 {
-		i -= len(m.BondFactor)
-		copy(dAtA[i:], m.BondFactor)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BondFactor)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.MsgTypeUrl) > 0 {
 		i -= len(m.MsgTypeUrl)
 		copy(dAtA[i:], m.MsgTypeUrl)
@@ -510,18 +480,10 @@ func (m *EventBlockEmissions) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.BondFactor)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	l = len(m.ReservesFactor)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.DurationFactor)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	l = len(m.ValidatorRewardsForBlock)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
@@ -855,38 +817,6 @@ func (m *EventBlockEmissions) Unmarshal(dAtA []byte) error {
 			}
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondFactor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BondFactor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ReservesFactor", wireType)
@@ -919,38 +849,6 @@ func (m *EventBlockEmissions) Unmarshal(dAtA []byte) error {
 			}
 			m.ReservesFactor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationFactor", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DurationFactor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewardsForBlock", wireType)
@@ -28,3 +28,7 @@ type BankKeeper interface {
 type StakingKeeper interface {
 	BondedRatio(ctx sdk.Context) sdk.Dec
 }
+
+type AuthorityKeeper interface {
+	IsAuthorizedForMsg(ctx sdk.Context, address string, msg sdk.Msg, chainID int64) bool
+}
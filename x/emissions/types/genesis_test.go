@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/x/emissions/types"
 )
@@ -19,9 +20,19 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc:     "empty params are invalid",
 			genState: &types.GenesisState{},
-			valid:    true,
+			valid:    false,
+		},
+		{
+			desc: "invalid emission schedule",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Params.DecayType = types.EmissionDecayType_Step
+				genState.Params.DecayRate = sdk.MustNewDecFromStr("0.5")
+				return genState
+			}(),
+			valid: false,
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
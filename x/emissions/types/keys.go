@@ -1,7 +1,6 @@
 package types
 
 import (
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -24,17 +23,13 @@ const (
 	MemStoreKey              = "mem_emissions"
 	WithdrawableEmissionsKey = "WithdrawableEmissions-value-"
 
-	SecsInMonth        = 30 * 24 * 60 * 60
-	BlockRewardsInZeta = "210000000"
+	SecsInMonth = 30 * 24 * 60 * 60
 
+	// BlockRewardsInZeta, EmissionScheduledYears and AvgBlockTime define the default emission schedule:
+	// 210M ZETA distributed over 4 years with 5.7 seconds blocks
+	BlockRewardsInZeta     = "210000000"
 	EmissionScheduledYears = 4
 	AvgBlockTime           = "5.7"
-
-	// ObserverSlashAmount is the amount of tokens to be slashed from observer in case of incorrect vote
-	// it is set to 0.1 ZETA
-	// TODO: replace this with a parameter
-	// https://github.com/zeta-chain/node/pull/1861
-	ObserverSlashAmount = "100000000000000000"
 )
 
 func KeyPrefix(p string) []byte {
@@ -43,20 +38,19 @@ func KeyPrefix(p string) []byte {
 
 const (
 	EmissionsTrackerKey              = "EmissionsTracker-value-"
-	ParamMaxBondFactor               = "MaxBondFactor"
-	ParamMinBondFactor               = "MinBondFactor"
-	ParamAvgBlockTime                = "AvgBlockTime"
-	ParamTargetBondRatio             = "TargetBondRation"
 	ParamValidatorEmissionPercentage = "ValidatorEmissionPercentage"
 	ParamObserverEmissionPercentage  = "ObserverEmissionPercentage"
 	ParamTssSignerEmissionPercentage = "SignerEmissionPercentage"
-	ParamDurationFactorConstant      = "DurationFactorConstant"
 	ParamObserverSlashAmount         = "ObserverSlashAmount"
+	ParamBlockRewardAmount           = "BlockRewardAmount"
+	ParamDecayType                   = "DecayType"
+	ParamDecayRate                   = "DecayRate"
+	ParamDecayIntervalBlocks         = "DecayIntervalBlocks"
+	ParamDecayStartHeight            = "DecayStartHeight"
 )
 
 var (
 	EmissionsModuleAddress                  = authtypes.NewModuleAddress(ModuleName)
 	UndistributedObserverRewardsPoolAddress = authtypes.NewModuleAddress(UndistributedObserverRewardsPool)
 	UndistributedTssRewardsPoolAddress      = authtypes.NewModuleAddress(UndistributedTssRewardsPool)
-	BlockReward                             = sdk.MustNewDecFromStr("9620949074074074074.074070733466756687")
 )
@@ -2,8 +2,9 @@ package types
 
 import (
 	"fmt"
-	"strconv"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
@@ -19,21 +20,27 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 
 func NewParams() Params {
-	return Params{
-		MaxBondFactor:               "1.25",
-		MinBondFactor:               "0.75",
-		AvgBlockTime:                "6.00",
-		TargetBondRatio:             "00.67",
+	params := Params{
 		ValidatorEmissionPercentage: "00.50",
 		ObserverEmissionPercentage:  "00.25",
 		TssSignerEmissionPercentage: "00.25",
-		DurationFactorConstant:      "0.001877876953694702",
-
-		// ObserverSlashAmount is currently disabled
-		// TODO: enable this param
-		// https://github.com/zeta-chain/node/issues/1862
-		ObserverSlashAmount: sdk.Int{},
 	}
+	params.SetDefaultEmissionSchedule()
+	return params
+}
+
+// SetDefaultEmissionSchedule sets the emission schedule that was hardcoded before it became a parameter:
+// constant block rewards distributing 210M ZETA over 4 years with 5.7 seconds blocks, and 0.1 ZETA slashed
+// for an incorrect vote of an observer
+func (p *Params) SetDefaultEmissionSchedule() {
+	p.ObserverSlashAmount = sdkmath.NewInt(100000000000000000)
+	// BlockRewardsInZeta in azeta divided by the number of blocks in EmissionScheduledYears with AvgBlockTime seconds blocks:
+	// 210M * 10^18 / (30 * 24 * 60 * 60 / 5.7 * 12 * 4), rounded as computed with sdk.Dec
+	p.BlockRewardAmount = sdk.MustNewDecFromStr("9620949074074074074.074070733466756687")
+	p.DecayType = EmissionDecayType_Constant
+	p.DecayRate = sdk.ZeroDec()
+	p.DecayIntervalBlocks = 0
+	p.DecayStartHeight = 0
 }
 
 // DefaultParams returns a default set of parameters
@@ -44,26 +51,76 @@ func DefaultParams() Params {
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyPrefix(ParamMaxBondFactor), &p.MaxBondFactor, validateMaxBondFactor),
-		paramtypes.NewParamSetPair(KeyPrefix(ParamMinBondFactor), &p.MinBondFactor, validateMinBondFactor),
-		paramtypes.NewParamSetPair(KeyPrefix(ParamAvgBlockTime), &p.AvgBlockTime, validateAvgBlockTime),
-		paramtypes.NewParamSetPair(KeyPrefix(ParamTargetBondRatio), &p.TargetBondRatio, validateTargetBondRatio),
 		paramtypes.NewParamSetPair(KeyPrefix(ParamValidatorEmissionPercentage), &p.ValidatorEmissionPercentage, validateValidatorEmissionPercentage),
 		paramtypes.NewParamSetPair(KeyPrefix(ParamObserverEmissionPercentage), &p.ObserverEmissionPercentage, validateObserverEmissionPercentage),
 		paramtypes.NewParamSetPair(KeyPrefix(ParamTssSignerEmissionPercentage), &p.TssSignerEmissionPercentage, validateTssEmissonPercentage),
-		paramtypes.NewParamSetPair(KeyPrefix(ParamDurationFactorConstant), &p.DurationFactorConstant, validateDurationFactorConstant),
-
-		// TODO: enable this param
-		// https://github.com/zeta-chain/node/pull/1861
-		//paramtypes.NewParamSetPair(KeyPrefix(ParamObserverSlashAmount), &p.ObserverSlashAmount, validateObserverSlashAmount),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamObserverSlashAmount), &p.ObserverSlashAmount, validateObserverSlashAmount),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamBlockRewardAmount), &p.BlockRewardAmount, validateBlockRewardAmount),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamDecayType), &p.DecayType, validateDecayType),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamDecayRate), &p.DecayRate, validateDecayRate),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamDecayIntervalBlocks), &p.DecayIntervalBlocks, validateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyPrefix(ParamDecayStartHeight), &p.DecayStartHeight, validateNonNegativeInt64),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	validations := []struct {
+		validate func(interface{}) error
+		value    interface{}
+	}{
+		{validateValidatorEmissionPercentage, p.ValidatorEmissionPercentage},
+		{validateObserverEmissionPercentage, p.ObserverEmissionPercentage},
+		{validateTssEmissonPercentage, p.TssSignerEmissionPercentage},
+		{validateObserverSlashAmount, p.ObserverSlashAmount},
+		{validateBlockRewardAmount, p.BlockRewardAmount},
+		{validateDecayType, p.DecayType},
+		{validateDecayRate, p.DecayRate},
+		{validateNonNegativeInt64, p.DecayIntervalBlocks},
+		{validateNonNegativeInt64, p.DecayStartHeight},
+	}
+	for _, v := range validations {
+		if err := v.validate(v.value); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, err.Error())
+		}
+	}
+	if p.DecayType == EmissionDecayType_Step && p.DecayIntervalBlocks == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "decay interval must be set for the step decay type")
+	}
 	return nil
 }
 
+// GetBlockRewards returns the block rewards at the height according to the emission schedule
+func (p Params) GetBlockRewards(height int64) sdk.Dec {
+	elapsedBlocks := height - p.DecayStartHeight
+	if elapsedBlocks <= 0 || p.DecayRate.IsNil() || p.DecayRate.IsZero() {
+		return p.BlockRewardAmount
+	}
+
+	var decays int64
+	switch p.DecayType {
+	case EmissionDecayType_Step:
+		if p.DecayIntervalBlocks <= 0 {
+			return p.BlockRewardAmount
+		}
+		decays = elapsedBlocks / p.DecayIntervalBlocks
+	case EmissionDecayType_Exponential:
+		decays = elapsedBlocks
+	default:
+		return p.BlockRewardAmount
+	}
+	return p.BlockRewardAmount.Mul(sdk.OneDec().Sub(p.DecayRate).Power(uint64(decays)))
+}
+
+// SplitBlockRewards returns the part of the block rewards distributed to the validators, the observers and the tss
+// signers according to their emission percentages
+func (p Params) SplitBlockRewards(blockRewards sdk.Dec) (validatorRewards, observerRewards, tssSignerRewards sdkmath.Int) {
+	validatorRewards = sdk.MustNewDecFromStr(p.ValidatorEmissionPercentage).Mul(blockRewards).TruncateInt()
+	observerRewards = sdk.MustNewDecFromStr(p.ObserverEmissionPercentage).Mul(blockRewards).TruncateInt()
+	tssSignerRewards = sdk.MustNewDecFromStr(p.TssSignerEmissionPercentage).Mul(blockRewards).TruncateInt()
+	return
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, err := yaml.Marshal(p)
@@ -73,109 +130,121 @@ func (p Params) String() string {
 	return string(out)
 }
 
-func validateDurationFactorConstant(i interface{}) error {
-	_, ok := i.(string)
+func validateValidatorEmissionPercentage(i interface{}) error {
+	v, ok := i.(string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
+	dec, err := sdk.NewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid validator emission percentage: %s", err)
+	}
+	if dec.GT(sdk.OneDec()) {
+		return fmt.Errorf("validator emission percentage cannot be more than 100 percent")
+	}
+	if dec.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("validator emission percentage cannot be less than 0 percent")
+	}
 	return nil
 }
 
-func validateMaxBondFactor(i interface{}) error {
+func validateObserverEmissionPercentage(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	decMaxBond := sdk.MustNewDecFromStr(v)
-	if decMaxBond.GT(sdk.MustNewDecFromStr("1.25")) {
-		return fmt.Errorf("max bond factor cannot be higher that 0.25")
+	dec, err := sdk.NewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid observer emission percentage: %s", err)
+	}
+	if dec.GT(sdk.OneDec()) {
+		return fmt.Errorf("observer emission percentage cannot be more than 100 percent")
+	}
+	if dec.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("observer emission percentage cannot be less than 0 percent")
 	}
 	return nil
 }
 
-func validateMinBondFactor(i interface{}) error {
+func validateTssEmissonPercentage(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	decMaxBond := sdk.MustNewDecFromStr(v)
-	if decMaxBond.LT(sdk.MustNewDecFromStr("0.75")) {
-		return fmt.Errorf("min bond factor cannot be lower that 0.75")
+	dec, err := sdk.NewDecFromStr(v)
+	if err != nil {
+		return fmt.Errorf("invalid tss emission percentage: %s", err)
+	}
+	if dec.GT(sdk.OneDec()) {
+		return fmt.Errorf("tss emission percentage cannot be more than 100 percent")
+	}
+	if dec.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("tss emission percentage cannot be less than 0 percent")
 	}
 	return nil
 }
 
-func validateAvgBlockTime(i interface{}) error {
-	v, ok := i.(string)
+func validateObserverSlashAmount(i interface{}) error {
+	v, ok := i.(sdkmath.Int)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	blocktime, err := strconv.ParseFloat(v, 64)
-	if err != nil {
-		return fmt.Errorf("invalid block time: %T", i)
+	if v.IsNil() {
+		return fmt.Errorf("slash amount must be set")
 	}
-	if blocktime <= 0 {
-		return fmt.Errorf("block time cannot be less than or equal to 0")
+	if v.IsNegative() {
+		return fmt.Errorf("slash amount cannot be less than 0")
 	}
 	return nil
 }
 
-func validateTargetBondRatio(i interface{}) error {
-	v, ok := i.(string)
+func validateBlockRewardAmount(i interface{}) error {
+	v, ok := i.(sdk.Dec)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	decMaxBond := sdk.MustNewDecFromStr(v)
-	if decMaxBond.GT(sdk.OneDec()) {
-		return fmt.Errorf("target bond ratio cannot be more than 100 percent")
+	if v.IsNil() {
+		return fmt.Errorf("block reward amount must be set")
 	}
-	if decMaxBond.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("target bond ratio cannot be less than 0 percent")
+	if v.IsNegative() {
+		return fmt.Errorf("block reward amount cannot be less than 0")
 	}
 	return nil
 }
 
-func validateValidatorEmissionPercentage(i interface{}) error {
-	v, ok := i.(string)
+func validateDecayType(i interface{}) error {
+	v, ok := i.(EmissionDecayType)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	dec := sdk.MustNewDecFromStr(v)
-	if dec.GT(sdk.OneDec()) {
-		return fmt.Errorf("validator emission percentage cannot be more than 100 percent")
-	}
-	if dec.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("validator emission percentage cannot be less than 0 percent")
+	if _, ok := EmissionDecayType_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid decay type %d", v)
 	}
 	return nil
 }
 
-func validateObserverEmissionPercentage(i interface{}) error {
-	v, ok := i.(string)
+func validateDecayRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	dec := sdk.MustNewDecFromStr(v)
-	if dec.GT(sdk.OneDec()) {
-		return fmt.Errorf("observer emission percentage cannot be more than 100 percent")
+	// a nil decay rate is zero
+	if v.IsNil() {
+		return nil
 	}
-	if dec.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("observer emission percentage cannot be less than 0 percent")
+	if v.IsNegative() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("decay rate must be at least 0 and less than 1: %s", v)
 	}
 	return nil
 }
 
-func validateTssEmissonPercentage(i interface{}) error {
-	v, ok := i.(string)
+func validateNonNegativeInt64(i interface{}) error {
+	v, ok := i.(int64)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	dec := sdk.MustNewDecFromStr(v)
-	if dec.GT(sdk.OneDec()) {
-		return fmt.Errorf("tss emission percentage cannot be more than 100 percent")
-	}
-	if dec.LT(sdk.ZeroDec()) {
-		return fmt.Errorf("tss emission percentage cannot be less than 0 percent")
+	if v < 0 {
+		return fmt.Errorf("negative value %d", v)
 	}
 	return nil
 }
@@ -25,17 +25,55 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// EmissionDecayType defines how the block rewards decrease over time
+type EmissionDecayType int32
+
+const (
+	// the block rewards don't decrease
+	EmissionDecayType_Constant EmissionDecayType = 0
+	// the block rewards are reduced by the decay rate at the end of each decay interval,
+	// a decay rate of 0.5 halves the block rewards at each interval
+	EmissionDecayType_Step EmissionDecayType = 1
+	// the block rewards are reduced by the decay rate at each block
+	EmissionDecayType_Exponential EmissionDecayType = 2
+)
+
+var EmissionDecayType_name = map[int32]string{
+	0: "Constant",
+	1: "Step",
+	2: "Exponential",
+}
+
+var EmissionDecayType_value = map[string]int32{
+	"Constant":    0,
+	"Step":        1,
+	"Exponential": 2,
+}
+
+func (x EmissionDecayType) String() string {
+	return proto.EnumName(EmissionDecayType_name, int32(x))
+}
+
+func (EmissionDecayType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74b1fd2414ebb64a, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
-	MaxBondFactor               string                                 `protobuf:"bytes,1,opt,name=max_bond_factor,json=maxBondFactor,proto3" json:"max_bond_factor,omitempty"`
-	MinBondFactor               string                                 `protobuf:"bytes,2,opt,name=min_bond_factor,json=minBondFactor,proto3" json:"min_bond_factor,omitempty"`
-	AvgBlockTime                string                                 `protobuf:"bytes,3,opt,name=avg_block_time,json=avgBlockTime,proto3" json:"avg_block_time,omitempty"`
-	TargetBondRatio             string                                 `protobuf:"bytes,4,opt,name=target_bond_ratio,json=targetBondRatio,proto3" json:"target_bond_ratio,omitempty"`
-	ValidatorEmissionPercentage string                                 `protobuf:"bytes,5,opt,name=validator_emission_percentage,json=validatorEmissionPercentage,proto3" json:"validator_emission_percentage,omitempty"`
-	ObserverEmissionPercentage  string                                 `protobuf:"bytes,6,opt,name=observer_emission_percentage,json=observerEmissionPercentage,proto3" json:"observer_emission_percentage,omitempty"`
-	TssSignerEmissionPercentage string                                 `protobuf:"bytes,7,opt,name=tss_signer_emission_percentage,json=tssSignerEmissionPercentage,proto3" json:"tss_signer_emission_percentage,omitempty"`
-	DurationFactorConstant      string                                 `protobuf:"bytes,8,opt,name=duration_factor_constant,json=durationFactorConstant,proto3" json:"duration_factor_constant,omitempty"`
-	ObserverSlashAmount         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=observer_slash_amount,json=observerSlashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"observer_slash_amount"`
+	ValidatorEmissionPercentage string `protobuf:"bytes,5,opt,name=validator_emission_percentage,json=validatorEmissionPercentage,proto3" json:"validator_emission_percentage,omitempty"`
+	ObserverEmissionPercentage  string `protobuf:"bytes,6,opt,name=observer_emission_percentage,json=observerEmissionPercentage,proto3" json:"observer_emission_percentage,omitempty"`
+	TssSignerEmissionPercentage string `protobuf:"bytes,7,opt,name=tss_signer_emission_percentage,json=tssSignerEmissionPercentage,proto3" json:"tss_signer_emission_percentage,omitempty"`
+	// amount of azeta slashed from the withdrawable emissions of an observer for an incorrect vote
+	ObserverSlashAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=observer_slash_amount,json=observerSlashAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"observer_slash_amount"`
+	// block rewards in azeta before any decay, distributed among validators, observers and tss signers
+	BlockRewardAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=block_reward_amount,json=blockRewardAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"block_reward_amount"`
+	DecayType         EmissionDecayType                      `protobuf:"varint,11,opt,name=decay_type,json=decayType,proto3,enum=zetachain.zetacore.emissions.EmissionDecayType" json:"decay_type,omitempty"`
+	// fraction of the block rewards removed at each decay, between 0 and 1
+	DecayRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=decay_rate,json=decayRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_rate"`
+	// number of blocks between two decays of the step decay type
+	DecayIntervalBlocks int64 `protobuf:"varint,13,opt,name=decay_interval_blocks,json=decayIntervalBlocks,proto3" json:"decay_interval_blocks,omitempty"`
+	// height from which the block rewards start decreasing
+	DecayStartHeight int64 `protobuf:"varint,14,opt,name=decay_start_height,json=decayStartHeight,proto3" json:"decay_start_height,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -70,97 +108,93 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *Params) GetMaxBondFactor() string {
-	if m != nil {
-		return m.MaxBondFactor
-	}
-	return ""
-}
-
-func (m *Params) GetMinBondFactor() string {
-	if m != nil {
-		return m.MinBondFactor
-	}
-	return ""
-}
-
-func (m *Params) GetAvgBlockTime() string {
+func (m *Params) GetValidatorEmissionPercentage() string {
 	if m != nil {
-		return m.AvgBlockTime
+		return m.ValidatorEmissionPercentage
 	}
 	return ""
 }
 
-func (m *Params) GetTargetBondRatio() string {
+func (m *Params) GetObserverEmissionPercentage() string {
 	if m != nil {
-		return m.TargetBondRatio
+		return m.ObserverEmissionPercentage
 	}
 	return ""
 }
 
-func (m *Params) GetValidatorEmissionPercentage() string {
+func (m *Params) GetTssSignerEmissionPercentage() string {
 	if m != nil {
-		return m.ValidatorEmissionPercentage
+		return m.TssSignerEmissionPercentage
 	}
 	return ""
 }
 
-func (m *Params) GetObserverEmissionPercentage() string {
+func (m *Params) GetDecayType() EmissionDecayType {
 	if m != nil {
-		return m.ObserverEmissionPercentage
+		return m.DecayType
 	}
-	return ""
+	return EmissionDecayType_Constant
 }
 
-func (m *Params) GetTssSignerEmissionPercentage() string {
+func (m *Params) GetDecayIntervalBlocks() int64 {
 	if m != nil {
-		return m.TssSignerEmissionPercentage
+		return m.DecayIntervalBlocks
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetDurationFactorConstant() string {
+func (m *Params) GetDecayStartHeight() int64 {
 	if m != nil {
-		return m.DurationFactorConstant
+		return m.DecayStartHeight
 	}
-	return ""
+	return 0
 }
 
 func init() {
+	proto.RegisterEnum("zetachain.zetacore.emissions.EmissionDecayType", EmissionDecayType_name, EmissionDecayType_value)
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.emissions.Params")
 }
 
 func init() { proto.RegisterFile("emissions/params.proto", fileDescriptor_74b1fd2414ebb64a) }
 
 var fileDescriptor_74b1fd2414ebb64a = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x37, 0xba, 0xae, 0x76, 0x50, 0x8b, 0x51, 0x4b, 0xa8, 0x35, 0x2b, 0x22, 0x45, 0x84,
-	0x26, 0x82, 0x17, 0xf1, 0xa4, 0x29, 0x0a, 0x7a, 0x2a, 0x5b, 0x4f, 0x5e, 0x86, 0x97, 0x64, 0xcc,
-	0x0e, 0xdd, 0x99, 0xb7, 0xcc, 0x7b, 0xbb, 0xac, 0x7e, 0x0a, 0x8f, 0x7a, 0xf3, 0xe3, 0xf4, 0xd8,
-	0xa3, 0x78, 0x28, 0xb2, 0xfb, 0x45, 0x24, 0x93, 0x64, 0xad, 0xb0, 0x3d, 0x65, 0x78, 0xef, 0xf7,
-	0x7e, 0x99, 0x37, 0xfc, 0xc5, 0x8e, 0x32, 0x9a, 0x48, 0xa3, 0xa5, 0x74, 0x0a, 0x0e, 0x0c, 0x25,
-	0x53, 0x87, 0x8c, 0xe1, 0xde, 0x57, 0xc5, 0x50, 0x8c, 0x41, 0xdb, 0xc4, 0x9f, 0xd0, 0xa9, 0x64,
-	0x8d, 0xee, 0xde, 0xab, 0xb0, 0x42, 0x0f, 0xa6, 0xf5, 0xa9, 0x99, 0x79, 0xfc, 0xa3, 0x2f, 0x06,
-	0x47, 0x5e, 0x12, 0xee, 0x8b, 0x6d, 0x03, 0x0b, 0x99, 0xa3, 0x2d, 0xe5, 0x67, 0x28, 0x18, 0x5d,
-	0x14, 0x3c, 0x0a, 0x9e, 0x6e, 0x8d, 0x6e, 0x19, 0x58, 0x64, 0x68, 0xcb, 0x77, 0xbe, 0xe8, 0x39,
-	0x6d, 0xff, 0xe3, 0xae, 0xb4, 0x9c, 0xb6, 0x17, 0xb8, 0x27, 0xe2, 0x36, 0xcc, 0x2b, 0x99, 0x4f,
-	0xb0, 0x38, 0x91, 0xac, 0x8d, 0x8a, 0xae, 0x7a, 0xec, 0x26, 0xcc, 0xab, 0xac, 0x2e, 0x7e, 0xd4,
-	0x46, 0x85, 0xcf, 0xc4, 0x1d, 0x06, 0x57, 0x29, 0x6e, 0x84, 0x0e, 0x58, 0x63, 0xd4, 0xf7, 0xe0,
-	0x76, 0xd3, 0xa8, 0x95, 0xa3, 0xba, 0x1c, 0x66, 0xe2, 0xe1, 0x1c, 0x26, 0xba, 0x04, 0x46, 0x27,
-	0xbb, 0xcd, 0xe4, 0x54, 0xb9, 0x42, 0x59, 0x86, 0x4a, 0x45, 0xd7, 0xfc, 0xdc, 0x83, 0x35, 0xf4,
-	0xb6, 0x65, 0x8e, 0xd6, 0x48, 0xf8, 0x5a, 0xec, 0x61, 0x4e, 0xca, 0xcd, 0xd5, 0x66, 0xc5, 0xc0,
-	0x2b, 0x76, 0x3b, 0x66, 0x83, 0xe1, 0x50, 0xc4, 0x4c, 0x24, 0x49, 0x57, 0xf6, 0x12, 0xc7, 0xf5,
-	0xe6, 0x1a, 0x4c, 0x74, 0xec, 0xa1, 0x0d, 0x92, 0x97, 0x22, 0x2a, 0x67, 0x7e, 0x59, 0xdb, 0x3e,
-	0xa2, 0x2c, 0xd0, 0x12, 0x83, 0xe5, 0xe8, 0x86, 0x1f, 0xdf, 0xe9, 0xfa, 0xcd, 0x73, 0x1e, 0xb6,
-	0xdd, 0x30, 0x17, 0xf7, 0xd7, 0x0b, 0xd0, 0x04, 0x68, 0x2c, 0xc1, 0xe0, 0xcc, 0x72, 0xb4, 0x55,
-	0x8f, 0x65, 0xc9, 0xe9, 0xf9, 0xb0, 0xf7, 0xfb, 0x7c, 0xb8, 0x5f, 0x69, 0x1e, 0xcf, 0xf2, 0xa4,
-	0x40, 0x93, 0x16, 0x48, 0x06, 0xa9, 0xfd, 0x1c, 0x50, 0x79, 0x92, 0xf2, 0x97, 0xa9, 0xa2, 0xe4,
-	0xbd, 0xe5, 0xd1, 0xdd, 0x4e, 0x76, 0x5c, 0xbb, 0xde, 0x78, 0xd5, 0xab, 0xfe, 0xf7, 0x9f, 0xc3,
-	0x5e, 0xf6, 0xe1, 0x74, 0x19, 0x07, 0x67, 0xcb, 0x38, 0xf8, 0xb3, 0x8c, 0x83, 0x6f, 0xab, 0xb8,
-	0x77, 0xb6, 0x8a, 0x7b, 0xbf, 0x56, 0x71, 0xef, 0xd3, 0xf3, 0x0b, 0xf2, 0x3a, 0x6a, 0x07, 0x3e,
-	0x75, 0x69, 0x97, 0xba, 0x74, 0x91, 0xfe, 0x8b, 0xa8, 0xff, 0x55, 0x3e, 0xf0, 0x71, 0x7b, 0xf1,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x51, 0x64, 0x07, 0xbc, 0x02, 0x00, 0x00,
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x4f, 0xd4, 0x40,
+	0x14, 0xc7, 0x5b, 0x28, 0x6b, 0x77, 0x40, 0x28, 0x45, 0x4c, 0x83, 0x58, 0x88, 0x07, 0x43, 0x8c,
+	0xb4, 0x06, 0x6f, 0x9e, 0x74, 0x81, 0x44, 0x9a, 0x68, 0x48, 0xd7, 0x93, 0x07, 0x27, 0xd3, 0x76,
+	0xec, 0x4e, 0xd8, 0xce, 0x34, 0x33, 0x8f, 0x15, 0xfc, 0x14, 0x1e, 0x4d, 0xbc, 0x78, 0xf0, 0xe0,
+	0x47, 0xe1, 0xc8, 0xd1, 0x78, 0x20, 0x66, 0xf7, 0x8b, 0x98, 0x4e, 0xdb, 0x55, 0xe3, 0x6a, 0xa2,
+	0xa7, 0xbe, 0xcc, 0xfb, 0xff, 0x7f, 0xf3, 0xfa, 0xfe, 0x19, 0x74, 0x93, 0x16, 0x4c, 0x29, 0x26,
+	0xb8, 0x0a, 0x4b, 0x22, 0x49, 0xa1, 0x82, 0x52, 0x0a, 0x10, 0xee, 0xe6, 0x5b, 0x0a, 0x24, 0x1d,
+	0x10, 0xc6, 0x03, 0x5d, 0x09, 0x49, 0x83, 0xa9, 0x74, 0xe3, 0x46, 0x2e, 0x72, 0xa1, 0x85, 0x61,
+	0x55, 0xd5, 0x9e, 0x3b, 0x1f, 0x3a, 0xa8, 0x73, 0xac, 0x21, 0x6e, 0x0f, 0xdd, 0x1e, 0x91, 0x21,
+	0xcb, 0x08, 0x08, 0x89, 0x5b, 0x1f, 0x2e, 0xa9, 0x4c, 0x29, 0x07, 0x92, 0x53, 0x6f, 0x61, 0xdb,
+	0xdc, 0xe9, 0xc6, 0xb7, 0xa6, 0xa2, 0xc3, 0x46, 0x73, 0x3c, 0x95, 0xb8, 0x8f, 0xd1, 0xa6, 0x48,
+	0x14, 0x95, 0x23, 0x3a, 0x1b, 0xd1, 0xd1, 0x88, 0x8d, 0x56, 0x33, 0x83, 0xb0, 0x8f, 0x7c, 0x50,
+	0x0a, 0x2b, 0x96, 0xf3, 0x3f, 0x30, 0xae, 0xd5, 0x63, 0x80, 0x52, 0x7d, 0x2d, 0x9a, 0x01, 0x49,
+	0xd0, 0xfa, 0x74, 0x0c, 0x35, 0x24, 0x6a, 0x80, 0x49, 0x21, 0x4e, 0x39, 0x78, 0xdd, 0xca, 0xdb,
+	0x0b, 0x2e, 0xae, 0xb6, 0x8c, 0xaf, 0x57, 0x5b, 0x77, 0x73, 0x06, 0x83, 0xd3, 0x24, 0x48, 0x45,
+	0x11, 0xa6, 0x42, 0x15, 0x42, 0x35, 0x9f, 0x5d, 0x95, 0x9d, 0x84, 0x70, 0x5e, 0x52, 0x15, 0x1c,
+	0x71, 0x88, 0xd7, 0x5a, 0x58, 0xbf, 0x62, 0x3d, 0xd1, 0x28, 0xf7, 0x15, 0x5a, 0x4b, 0x86, 0x22,
+	0x3d, 0xc1, 0x92, 0xbe, 0x21, 0x32, 0x6b, 0x6f, 0x40, 0xff, 0x7c, 0xc3, 0x01, 0x4d, 0xe3, 0x55,
+	0x8d, 0x8a, 0x35, 0xa9, 0xe1, 0x3f, 0x47, 0x28, 0xa3, 0x29, 0x39, 0xc7, 0x95, 0xca, 0x5b, 0xdc,
+	0x36, 0x77, 0x96, 0xf7, 0xc2, 0xe0, 0x6f, 0x11, 0x07, 0xed, 0x26, 0x0e, 0x2a, 0xdf, 0x8b, 0xf3,
+	0x92, 0xc6, 0xdd, 0xac, 0x2d, 0xdd, 0x67, 0x2d, 0x4f, 0x12, 0xa0, 0xde, 0xd2, 0x7f, 0x8d, 0x59,
+	0xe3, 0x62, 0x02, 0xd4, 0xdd, 0x43, 0xeb, 0x35, 0x8e, 0x71, 0xa0, 0x72, 0x44, 0x86, 0x58, 0xff,
+	0x82, 0xf2, 0xae, 0x6f, 0x9b, 0x3b, 0xf3, 0xf1, 0x9a, 0x6e, 0x1e, 0x35, 0xbd, 0x9e, 0x6e, 0xb9,
+	0xf7, 0x91, 0x5b, 0x7b, 0x14, 0x10, 0x09, 0x78, 0x40, 0x59, 0x3e, 0x00, 0x6f, 0x59, 0x1b, 0x1c,
+	0xdd, 0xe9, 0x57, 0x8d, 0xa7, 0xfa, 0xfc, 0x91, 0xf5, 0xfe, 0xe3, 0x96, 0x11, 0x59, 0xb6, 0xe9,
+	0xcc, 0x45, 0x96, 0x3d, 0xe7, 0xcc, 0x47, 0x96, 0x3d, 0xef, 0x58, 0x91, 0x65, 0x5b, 0xce, 0x42,
+	0x64, 0xd9, 0xb6, 0xd3, 0x8d, 0x57, 0x0a, 0x72, 0x86, 0x13, 0xc1, 0x33, 0xfc, 0x9a, 0xa4, 0x20,
+	0x64, 0xbc, 0x52, 0x30, 0xfe, 0xcb, 0xc1, 0x32, 0x19, 0xe5, 0xf5, 0x68, 0x18, 0x58, 0x41, 0xe3,
+	0x55, 0x20, 0x32, 0xa7, 0x50, 0x6b, 0x24, 0x01, 0x26, 0x62, 0x2f, 0x3b, 0xd5, 0x05, 0x6f, 0x3c,
+	0x38, 0x15, 0x5c, 0x01, 0xe1, 0x70, 0xaf, 0x87, 0x56, 0x7f, 0xdb, 0xa9, 0xbb, 0x84, 0xec, 0xfd,
+	0x46, 0xe0, 0x18, 0xae, 0x8d, 0xac, 0x3e, 0xd0, 0xd2, 0x31, 0xdd, 0x15, 0xb4, 0x78, 0x78, 0x56,
+	0x0a, 0x4e, 0x39, 0x30, 0x32, 0x74, 0xe6, 0x36, 0xac, 0xcf, 0x9f, 0x7c, 0xb3, 0x17, 0x5d, 0x8c,
+	0x7d, 0xf3, 0x72, 0xec, 0x9b, 0xdf, 0xc6, 0xbe, 0xf9, 0x6e, 0xe2, 0x1b, 0x97, 0x13, 0xdf, 0xf8,
+	0x32, 0xf1, 0x8d, 0x97, 0x0f, 0x7e, 0xda, 0x7a, 0x95, 0xe6, 0xae, 0x0e, 0x36, 0x6c, 0x83, 0x0d,
+	0xcf, 0xc2, 0x1f, 0x0f, 0x5d, 0x67, 0x90, 0x74, 0xf4, 0xa3, 0x7d, 0xf8, 0x7d, 0x00, 0xea, 0x39,
+	0xeb, 0x4b, 0x02, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -183,6 +217,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DecayStartHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecayStartHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DecayIntervalBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecayIntervalBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.DecayRate.Size()
+		i -= size
+		if _, err := m.DecayRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.DecayType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DecayType))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.BlockRewardAmount.Size()
+		i -= size
+		if _, err := m.BlockRewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.ObserverSlashAmount.Size()
 		i -= size
@@ -193,13 +262,6 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x4a
-	if len(m.DurationFactorConstant) > 0 {
-		i -= len(m.DurationFactorConstant)
-		copy(dAtA[i:], m.DurationFactorConstant)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.DurationFactorConstant)))
-		i--
-		dAtA[i] = 0x42
-	}
 	if len(m.TssSignerEmissionPercentage) > 0 {
 		i -= len(m.TssSignerEmissionPercentage)
 		copy(dAtA[i:], m.TssSignerEmissionPercentage)
@@ -221,34 +283,6 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.TargetBondRatio) > 0 {
-		i -= len(m.TargetBondRatio)
-		copy(dAtA[i:], m.TargetBondRatio)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.TargetBondRatio)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AvgBlockTime) > 0 {
-		i -= len(m.AvgBlockTime)
-		copy(dAtA[i:], m.AvgBlockTime)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.AvgBlockTime)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MinBondFactor) > 0 {
-		i -= len(m.MinBondFactor)
-		copy(dAtA[i:], m.MinBondFactor)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.MinBondFactor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MaxBondFactor) > 0 {
-		i -= len(m.MaxBondFactor)
-		copy(dAtA[i:], m.MaxBondFactor)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.MaxBondFactor)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
@@ -269,22 +303,6 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.MaxBondFactor)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.MinBondFactor)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.AvgBlockTime)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
-	l = len(m.TargetBondRatio)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
 	l = len(m.ValidatorEmissionPercentage)
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
@@ -297,12 +315,21 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
-	l = len(m.DurationFactorConstant)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
-	}
 	l = m.ObserverSlashAmount.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.BlockRewardAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DecayType != 0 {
+		n += 1 + sovParams(uint64(m.DecayType))
+	}
+	l = m.DecayRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DecayIntervalBlocks != 0 {
+		n += 1 + sovParams(uint64(m.DecayIntervalBlocks))
+	}
+	if m.DecayStartHeight != 0 {
+		n += 1 + sovParams(uint64(m.DecayStartHeight))
+	}
 	return n
 }
 
@@ -341,9 +368,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBondFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEmissionPercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -371,11 +398,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxBondFactor = string(dAtA[iNdEx:postIndex])
+			m.ValidatorEmissionPercentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinBondFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverEmissionPercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -403,11 +430,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinBondFactor = string(dAtA[iNdEx:postIndex])
+			m.ObserverEmissionPercentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvgBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TssSignerEmissionPercentage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -435,11 +462,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvgBlockTime = string(dAtA[iNdEx:postIndex])
+			m.TssSignerEmissionPercentage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetBondRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverSlashAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -467,11 +494,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetBondRatio = string(dAtA[iNdEx:postIndex])
+			if err := m.ObserverSlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorEmissionPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRewardAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -499,13 +528,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorEmissionPercentage = string(dAtA[iNdEx:postIndex])
+			if err := m.BlockRewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverEmissionPercentage", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayType", wireType)
 			}
-			var stringLen uint64
+			m.DecayType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -515,27 +546,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DecayType |= EmissionDecayType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObserverEmissionPercentage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssSignerEmissionPercentage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -563,13 +581,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TssSignerEmissionPercentage = string(dAtA[iNdEx:postIndex])
+			if err := m.DecayRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationFactorConstant", wireType)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayIntervalBlocks", wireType)
 			}
-			var stringLen uint64
+			m.DecayIntervalBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -579,29 +599,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DecayIntervalBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DurationFactorConstant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverSlashAmount", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayStartHeight", wireType)
 			}
-			var stringLen uint64
+			m.DecayStartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -611,26 +618,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DecayStartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObserverSlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
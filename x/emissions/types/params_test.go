@@ -0,0 +1,216 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/emissions/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tt := []struct {
+		name    string
+		update  func(*types.Params)
+		wantErr bool
+	}{
+		{
+			name:   "default params are valid",
+			update: func(*types.Params) {},
+		},
+		{
+			name: "step decay is valid",
+			update: func(p *types.Params) {
+				p.DecayType = types.EmissionDecayType_Step
+				p.DecayRate = sdk.MustNewDecFromStr("0.5")
+				p.DecayIntervalBlocks = 1000
+			},
+		},
+		{
+			name: "exponential decay is valid",
+			update: func(p *types.Params) {
+				p.DecayType = types.EmissionDecayType_Exponential
+				p.DecayRate = sdk.MustNewDecFromStr("0.0000001")
+				p.DecayStartHeight = 1000
+			},
+		},
+		{
+			name: "nil decay rate is valid",
+			update: func(p *types.Params) {
+				p.DecayRate = sdk.Dec{}
+			},
+		},
+		{
+			name: "invalid emission percentage",
+			update: func(p *types.Params) {
+				p.ValidatorEmissionPercentage = "invalid"
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil block reward amount",
+			update: func(p *types.Params) {
+				p.BlockRewardAmount = sdk.Dec{}
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative block reward amount",
+			update: func(p *types.Params) {
+				p.BlockRewardAmount = sdk.NewDec(-1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative slash amount",
+			update: func(p *types.Params) {
+				p.ObserverSlashAmount = sdkmath.NewInt(-1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid decay type",
+			update: func(p *types.Params) {
+				p.DecayType = types.EmissionDecayType(42)
+			},
+			wantErr: true,
+		},
+		{
+			name: "decay rate of one",
+			update: func(p *types.Params) {
+				p.DecayRate = sdk.OneDec()
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative decay rate",
+			update: func(p *types.Params) {
+				p.DecayRate = sdk.NewDec(-1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative decay start height",
+			update: func(p *types.Params) {
+				p.DecayStartHeight = -1
+			},
+			wantErr: true,
+		},
+		{
+			name: "step decay without interval",
+			update: func(p *types.Params) {
+				p.DecayType = types.EmissionDecayType_Step
+				p.DecayRate = sdk.MustNewDecFromStr("0.5")
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.update(&params)
+			err := params.Validate()
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrInvalidParams)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// blockRewardsForSchedule returns the constant block rewards distributing the total rewards over the scheduled years
+func blockRewardsForSchedule(t *testing.T, totalRewardsInZeta string, years int64, avgBlockTime string) sdk.Dec {
+	totalRewards, err := common.GetAzetaDecFromAmountInZeta(totalRewardsInZeta)
+	require.NoError(t, err)
+	blocksInAMonth := sdk.NewDec(types.SecsInMonth).Quo(sdk.MustNewDecFromStr(avgBlockTime))
+	return totalRewards.Quo(blocksInAMonth.Mul(sdk.NewDec(12)).Mul(sdk.NewDec(years)))
+}
+
+func TestParams_DefaultBlockRewardAmount(t *testing.T) {
+	t.Run("default block reward amount is derived from the default emission schedule", func(t *testing.T) {
+		expected := blockRewardsForSchedule(t, types.BlockRewardsInZeta, types.EmissionScheduledYears, types.AvgBlockTime)
+		require.Equal(t, expected, types.DefaultParams().BlockRewardAmount)
+	})
+
+	tt := []struct {
+		avgBlockTime         string
+		expectedBlockRewards sdk.Dec
+	}{
+		{"6", sdk.MustNewDecFromStr("10127314814814814814.814814814814814815")},
+		{"3", sdk.MustNewDecFromStr("5063657407407407407.407407407407407407")},
+		{"2", sdk.MustNewDecFromStr("3375771604938271604.938271604938271605")},
+		{"8", sdk.MustNewDecFromStr("13503086419753086419.753086419753086420")},
+	}
+	for _, tc := range tt {
+		t.Run("block time "+tc.avgBlockTime, func(t *testing.T) {
+			blockRewards := blockRewardsForSchedule(t, types.BlockRewardsInZeta, types.EmissionScheduledYears, tc.avgBlockTime)
+			require.Equal(t, tc.expectedBlockRewards, blockRewards)
+		})
+	}
+}
+
+func TestParams_GetBlockRewards(t *testing.T) {
+	base := sdk.NewDec(1000)
+
+	t.Run("constant block rewards", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.BlockRewardAmount = base
+		params.DecayRate = sdk.MustNewDecFromStr("0.5")
+
+		require.Equal(t, base, params.GetBlockRewards(0))
+		require.Equal(t, base, params.GetBlockRewards(1000000))
+	})
+
+	t.Run("step halvings", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.BlockRewardAmount = base
+		params.DecayType = types.EmissionDecayType_Step
+		params.DecayRate = sdk.MustNewDecFromStr("0.5")
+		params.DecayIntervalBlocks = 100
+		params.DecayStartHeight = 50
+
+		require.Equal(t, base, params.GetBlockRewards(0))
+		require.Equal(t, base, params.GetBlockRewards(50))
+		require.Equal(t, base, params.GetBlockRewards(149))
+		require.Equal(t, sdk.NewDec(500), params.GetBlockRewards(150))
+		require.Equal(t, sdk.NewDec(500), params.GetBlockRewards(249))
+		require.Equal(t, sdk.NewDec(250), params.GetBlockRewards(250))
+		require.Equal(t, sdk.MustNewDecFromStr("0.9765625"), params.GetBlockRewards(1050))
+	})
+
+	t.Run("exponential decay", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.BlockRewardAmount = base
+		params.DecayType = types.EmissionDecayType_Exponential
+		params.DecayRate = sdk.MustNewDecFromStr("0.1")
+		params.DecayStartHeight = 10
+
+		require.Equal(t, base, params.GetBlockRewards(10))
+		require.Equal(t, sdk.NewDec(900), params.GetBlockRewards(11))
+		require.Equal(t, sdk.NewDec(810), params.GetBlockRewards(12))
+		require.Equal(t, sdk.MustNewDecFromStr("348.678440100000000000"), params.GetBlockRewards(20))
+	})
+
+	t.Run("no decay if the decay rate is zero", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.BlockRewardAmount = base
+		params.DecayType = types.EmissionDecayType_Exponential
+
+		require.Equal(t, base, params.GetBlockRewards(1000))
+	})
+}
+
+func TestParams_SplitBlockRewards(t *testing.T) {
+	params := types.DefaultParams()
+	params.ValidatorEmissionPercentage = "0.5"
+	params.ObserverEmissionPercentage = "0.3"
+	params.TssSignerEmissionPercentage = "0.2"
+
+	validatorRewards, observerRewards, tssSignerRewards := params.SplitBlockRewards(sdk.MustNewDecFromStr("1001.5"))
+	require.Equal(t, sdkmath.NewInt(500), validatorRewards)
+	require.Equal(t, sdkmath.NewInt(300), observerRewards)
+	require.Equal(t, sdkmath.NewInt(200), tssSignerRewards)
+}
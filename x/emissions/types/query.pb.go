@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -248,8 +249,6 @@ var xxx_messageInfo_QueryGetEmissionsFactorsRequest proto.InternalMessageInfo
 
 type QueryGetEmissionsFactorsResponse struct {
 	ReservesFactor string `protobuf:"bytes,1,opt,name=reservesFactor,proto3" json:"reservesFactor,omitempty"`
-	BondFactor     string `protobuf:"bytes,2,opt,name=bondFactor,proto3" json:"bondFactor,omitempty"`
-	DurationFactor string `protobuf:"bytes,3,opt,name=durationFactor,proto3" json:"durationFactor,omitempty"`
 }
 
 func (m *QueryGetEmissionsFactorsResponse) Reset()         { *m = QueryGetEmissionsFactorsResponse{} }
@@ -292,20 +291,6 @@ func (m *QueryGetEmissionsFactorsResponse) GetReservesFactor() string {
 	return ""
 }
 
-func (m *QueryGetEmissionsFactorsResponse) GetBondFactor() string {
-	if m != nil {
-		return m.BondFactor
-	}
-	return ""
-}
-
-func (m *QueryGetEmissionsFactorsResponse) GetDurationFactor() string {
-	if m != nil {
-		return m.DurationFactor
-	}
-	return ""
-}
-
 type QueryShowAvailableEmissionsRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
@@ -394,6 +379,99 @@ func (m *QueryShowAvailableEmissionsResponse) GetAmount() string {
 	return ""
 }
 
+type QueryBlockRewardsRequest struct {
+	// the current height is used if not set
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryBlockRewardsRequest) Reset()         { *m = QueryBlockRewardsRequest{} }
+func (m *QueryBlockRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockRewardsRequest) ProtoMessage()    {}
+func (*QueryBlockRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e578782beb6ef82, []int{8}
+}
+func (m *QueryBlockRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockRewardsRequest.Merge(m, src)
+}
+func (m *QueryBlockRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockRewardsRequest proto.InternalMessageInfo
+
+func (m *QueryBlockRewardsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type QueryBlockRewardsResponse struct {
+	Height           int64                                  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockRewards     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=block_rewards,json=blockRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"block_rewards"`
+	ValidatorRewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=validator_rewards,json=validatorRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"validator_rewards"`
+	ObserverRewards  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=observer_rewards,json=observerRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"observer_rewards"`
+	TssSignerRewards github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=tss_signer_rewards,json=tssSignerRewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tss_signer_rewards"`
+}
+
+func (m *QueryBlockRewardsResponse) Reset()         { *m = QueryBlockRewardsResponse{} }
+func (m *QueryBlockRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockRewardsResponse) ProtoMessage()    {}
+func (*QueryBlockRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e578782beb6ef82, []int{9}
+}
+func (m *QueryBlockRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockRewardsResponse.Merge(m, src)
+}
+func (m *QueryBlockRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockRewardsResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.emissions.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.emissions.QueryParamsResponse")
@@ -403,53 +481,67 @@ func init() {
 	proto.RegisterType((*QueryGetEmissionsFactorsResponse)(nil), "zetachain.zetacore.emissions.QueryGetEmissionsFactorsResponse")
 	proto.RegisterType((*QueryShowAvailableEmissionsRequest)(nil), "zetachain.zetacore.emissions.QueryShowAvailableEmissionsRequest")
 	proto.RegisterType((*QueryShowAvailableEmissionsResponse)(nil), "zetachain.zetacore.emissions.QueryShowAvailableEmissionsResponse")
+	proto.RegisterType((*QueryBlockRewardsRequest)(nil), "zetachain.zetacore.emissions.QueryBlockRewardsRequest")
+	proto.RegisterType((*QueryBlockRewardsResponse)(nil), "zetachain.zetacore.emissions.QueryBlockRewardsResponse")
 }
 
 func init() { proto.RegisterFile("emissions/query.proto", fileDescriptor_6e578782beb6ef82) }
 
 var fileDescriptor_6e578782beb6ef82 = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6b, 0xd4, 0x40,
-	0x18, 0xde, 0x54, 0x5d, 0x71, 0x04, 0xc1, 0x69, 0xad, 0x25, 0xd4, 0x6c, 0x8d, 0x4b, 0x15, 0xb5,
-	0x49, 0x3f, 0x40, 0x44, 0x6d, 0x69, 0x17, 0x54, 0xf0, 0x03, 0x6b, 0xd5, 0x83, 0x5e, 0xc2, 0x64,
-	0x33, 0x66, 0x07, 0x92, 0x4c, 0x9a, 0x77, 0xd2, 0x5a, 0xc5, 0x8b, 0x47, 0x4f, 0x62, 0xff, 0x8e,
-	0x3f, 0xa0, 0xde, 0x0a, 0x5e, 0x3c, 0xa9, 0x74, 0xfd, 0x19, 0x1e, 0x64, 0x27, 0x93, 0x74, 0xbf,
-	0x59, 0xeb, 0x6d, 0xe6, 0xcd, 0xfb, 0x3c, 0xef, 0xf3, 0xbc, 0x79, 0x42, 0xd0, 0x39, 0x1a, 0x32,
-	0x00, 0xc6, 0x23, 0xb0, 0x37, 0x53, 0x9a, 0xec, 0x58, 0x71, 0xc2, 0x05, 0xc7, 0xd3, 0x6f, 0xa9,
-	0x20, 0xf5, 0x06, 0x61, 0x91, 0x25, 0x4f, 0x3c, 0xa1, 0x56, 0xd1, 0xa9, 0x5f, 0xad, 0x73, 0x08,
-	0x39, 0xd8, 0x2e, 0x01, 0x9a, 0xc1, 0xec, 0xad, 0x05, 0x97, 0x0a, 0xb2, 0x60, 0xc7, 0xc4, 0x67,
-	0x11, 0x11, 0x8c, 0x47, 0x19, 0x93, 0x3e, 0x79, 0x38, 0x20, 0x26, 0x09, 0x09, 0x41, 0xd5, 0x27,
-	0x7c, 0xee, 0x73, 0x79, 0xb4, 0x5b, 0x27, 0x55, 0x9d, 0xf6, 0x39, 0xf7, 0x03, 0x6a, 0x93, 0x98,
-	0xd9, 0x24, 0x8a, 0xb8, 0x90, 0x54, 0x0a, 0x63, 0x4e, 0x20, 0xfc, 0xb4, 0x35, 0x6d, 0x5d, 0x12,
-	0x6d, 0xd0, 0xcd, 0x94, 0x82, 0x30, 0x5f, 0xa2, 0xf1, 0x8e, 0x2a, 0xc4, 0x3c, 0x02, 0x8a, 0x6b,
-	0xa8, 0x9c, 0x0d, 0x9c, 0xd2, 0x66, 0xb4, 0x2b, 0xa7, 0x17, 0xab, 0xd6, 0x30, 0x4f, 0x56, 0x86,
-	0xae, 0x1d, 0xdf, 0xfb, 0x51, 0x29, 0x6d, 0x28, 0xa4, 0x59, 0x41, 0x17, 0x24, 0xf5, 0x23, 0x06,
-	0x62, 0x9d, 0xf3, 0x60, 0xcd, 0xf3, 0x12, 0x0a, 0x40, 0x8b, 0xd9, 0x7f, 0x34, 0x64, 0x0c, 0xea,
-	0x50, 0x3a, 0x5e, 0xa0, 0xcb, 0x69, 0xe4, 0x31, 0x10, 0x09, 0x73, 0x53, 0x41, 0x3d, 0x87, 0xbb,
-	0x40, 0x93, 0x2d, 0x9a, 0x38, 0x2e, 0x09, 0x48, 0x54, 0xa7, 0xe0, 0x90, 0x0c, 0x24, 0x85, 0x9e,
-	0xda, 0xa8, 0x76, 0xb4, 0x3f, 0x51, 0xdd, 0x35, 0xd5, 0xac, 0x06, 0xe0, 0x87, 0xc8, 0xec, 0xa4,
-	0x15, 0x00, 0xbd, 0x8c, 0x63, 0x92, 0xb1, 0xd2, 0xd1, 0xf9, 0x1c, 0xa0, 0x9b, 0xec, 0x06, 0x3a,
-	0x9f, 0x6f, 0xc2, 0x09, 0xb9, 0x97, 0x06, 0xb4, 0x60, 0x38, 0x26, 0x19, 0x8a, 0x98, 0x3c, 0x96,
-	0x4f, 0x15, 0xce, 0xbc, 0x88, 0x2a, 0xd2, 0xfd, 0x7d, 0x2a, 0xee, 0xe6, 0x9b, 0xbc, 0x47, 0xea,
-	0x82, 0x27, 0xc5, 0x86, 0x3e, 0x6b, 0x68, 0x66, 0x70, 0x8f, 0xda, 0xd1, 0x2c, 0x3a, 0x93, 0x50,
-	0xe9, 0x53, 0x3d, 0x52, 0xab, 0xe8, 0xaa, 0x62, 0x03, 0x21, 0x97, 0x47, 0x9e, 0xea, 0xc9, 0xcc,
-	0xb5, 0x55, 0x5a, 0x3c, 0x5e, 0x9a, 0xc8, 0xcc, 0xa8, 0x9e, 0x4c, 0x7e, 0x57, 0xd5, 0x5c, 0x41,
-	0xa6, 0xd4, 0xf4, 0xac, 0xc1, 0xb7, 0xd7, 0xb6, 0x08, 0x0b, 0x88, 0x1b, 0xd0, 0x42, 0x9d, 0x92,
-	0x8e, 0xa7, 0xd0, 0xc9, 0xce, 0x37, 0x93, 0x5f, 0xcd, 0x65, 0x74, 0x69, 0x28, 0x5e, 0xd9, 0x9a,
-	0x44, 0x65, 0x12, 0xf2, 0x34, 0x12, 0x0a, 0xaf, 0x6e, 0x8b, 0x1f, 0xcb, 0xe8, 0x84, 0xc4, 0xe3,
-	0x5d, 0x0d, 0x95, 0xb3, 0xe4, 0xe1, 0xf9, 0xe1, 0xf9, 0xec, 0x0d, 0xbe, 0xbe, 0xf0, 0x0f, 0x88,
-	0x4c, 0x91, 0x59, 0xfd, 0xf0, 0xed, 0xf7, 0xee, 0x98, 0x81, 0xa7, 0xed, 0x16, 0x60, 0x4e, 0x62,
-	0xed, 0xee, 0x2f, 0x14, 0x7f, 0xd1, 0xd0, 0xd9, 0x9e, 0x40, 0xe3, 0xdb, 0x23, 0x8c, 0x1b, 0xf4,
-	0xa1, 0xe8, 0x77, 0x8e, 0x06, 0x56, 0xb2, 0xaf, 0x4b, 0xd9, 0xb3, 0xb8, 0xda, 0x5f, 0x76, 0xc0,
-	0x40, 0xe4, 0x81, 0xa5, 0x80, 0xbf, 0x6a, 0x68, 0xbc, 0x4f, 0xda, 0xf0, 0xf2, 0x08, 0x1a, 0x06,
-	0x27, 0x59, 0x5f, 0x39, 0x2a, 0x5c, 0x99, 0x58, 0x92, 0x26, 0xe6, 0xf0, 0xb5, 0xfe, 0x26, 0x7c,
-	0x2a, 0x9c, 0xe2, 0xe6, 0xbc, 0x56, 0x9a, 0x7f, 0x6a, 0x68, 0xb2, 0x7f, 0xca, 0xf0, 0xea, 0x08,
-	0x7a, 0x86, 0x06, 0x5c, 0x5f, 0xfb, 0x0f, 0x06, 0x65, 0x6a, 0x55, 0x9a, 0xba, 0x85, 0x6f, 0xf6,
-	0x37, 0x05, 0x0d, 0xbe, 0xed, 0x90, 0x1c, 0x7e, 0xe8, 0xcf, 0x7e, 0xa7, 0x5e, 0xd7, 0xfb, 0xda,
-	0x83, 0xbd, 0x03, 0x43, 0xdb, 0x3f, 0x30, 0xb4, 0x5f, 0x07, 0x86, 0xf6, 0xa9, 0x69, 0x94, 0xf6,
-	0x9b, 0x46, 0xe9, 0x7b, 0xd3, 0x28, 0xbd, 0x9a, 0xf7, 0x99, 0x68, 0xa4, 0xae, 0x55, 0xe7, 0x61,
-	0x3b, 0x7b, 0xae, 0xd4, 0x7e, 0xd3, 0x36, 0x48, 0xec, 0xc4, 0x14, 0xdc, 0xb2, 0xfc, 0x4f, 0x2c,
-	0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x6f, 0x3f, 0x0c, 0xd6, 0x06, 0x00, 0x00,
+	// 842 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x4f, 0x1b, 0x47,
+	0x1c, 0xf5, 0x62, 0x63, 0xe8, 0x94, 0x52, 0x33, 0x50, 0x97, 0x5a, 0x74, 0x4d, 0xb7, 0x16, 0xad,
+	0x5a, 0xd8, 0xe5, 0x4f, 0x45, 0xab, 0xb6, 0x20, 0xb0, 0xfa, 0x47, 0xa5, 0xad, 0x42, 0x96, 0xe4,
+	0x40, 0x12, 0x69, 0x35, 0xeb, 0x9d, 0xac, 0x57, 0xac, 0x77, 0xcc, 0xce, 0xac, 0x09, 0x41, 0x5c,
+	0xf2, 0x09, 0x22, 0x71, 0xcf, 0xb7, 0xc8, 0x2d, 0xa7, 0x9c, 0xc8, 0x0d, 0x29, 0x97, 0x28, 0x07,
+	0x12, 0x41, 0x3e, 0x46, 0x0e, 0x91, 0x67, 0x67, 0x17, 0x1b, 0xd6, 0x96, 0x71, 0x4e, 0xde, 0x99,
+	0xfd, 0xbd, 0xf7, 0x7b, 0xef, 0x37, 0xb3, 0x4f, 0x06, 0x5f, 0xe0, 0x9a, 0x43, 0xa9, 0x43, 0x3c,
+	0xaa, 0xed, 0x06, 0xd8, 0xdf, 0x57, 0xeb, 0x3e, 0x61, 0x04, 0x4e, 0x3d, 0xc4, 0x0c, 0x55, 0xaa,
+	0xc8, 0xf1, 0x54, 0xfe, 0x44, 0x7c, 0xac, 0xc6, 0x95, 0x85, 0x1f, 0x2a, 0x84, 0xd6, 0x08, 0xd5,
+	0x4c, 0x44, 0x71, 0x08, 0xd3, 0x1a, 0x0b, 0x26, 0x66, 0x68, 0x41, 0xab, 0x23, 0xdb, 0xf1, 0x10,
+	0x73, 0x88, 0x17, 0x32, 0x15, 0xf2, 0x17, 0x0d, 0xea, 0xc8, 0x47, 0x35, 0x2a, 0xf6, 0x27, 0x6c,
+	0x62, 0x13, 0xfe, 0xa8, 0x35, 0x9f, 0xc4, 0xee, 0x94, 0x4d, 0x88, 0xed, 0x62, 0x0d, 0xd5, 0x1d,
+	0x0d, 0x79, 0x1e, 0x61, 0x9c, 0x4a, 0x60, 0x94, 0x09, 0x00, 0x6f, 0x36, 0xbb, 0x6d, 0x72, 0x22,
+	0x1d, 0xef, 0x06, 0x98, 0x32, 0x65, 0x1b, 0x8c, 0xb7, 0xed, 0xd2, 0x3a, 0xf1, 0x28, 0x86, 0x65,
+	0x90, 0x0d, 0x1b, 0x4e, 0x4a, 0xd3, 0xd2, 0xf7, 0x9f, 0x2e, 0x96, 0xd4, 0x6e, 0x9e, 0xd4, 0x10,
+	0x5d, 0xce, 0x1c, 0x9f, 0x16, 0x53, 0xba, 0x40, 0x2a, 0x45, 0xf0, 0x35, 0xa7, 0xfe, 0xcf, 0xa1,
+	0x6c, 0x93, 0x10, 0x77, 0xdd, 0xb2, 0x7c, 0x4c, 0x29, 0x8e, 0x7b, 0xbf, 0x97, 0x80, 0xdc, 0xa9,
+	0x42, 0xe8, 0xb8, 0x0d, 0xbe, 0x0b, 0x3c, 0xcb, 0xa1, 0xcc, 0x77, 0xcc, 0x80, 0x61, 0xcb, 0x20,
+	0x26, 0xc5, 0x7e, 0x03, 0xfb, 0x86, 0x89, 0x5c, 0xe4, 0x55, 0x30, 0x35, 0x50, 0x08, 0xe2, 0x42,
+	0x3f, 0xd1, 0x4b, 0x6d, 0xe5, 0x37, 0x44, 0x75, 0x59, 0x14, 0x8b, 0x06, 0xf0, 0x5f, 0xa0, 0xb4,
+	0xd3, 0x32, 0x4a, 0xaf, 0x32, 0x0e, 0x70, 0xc6, 0x62, 0x5b, 0xe5, 0x2d, 0x4a, 0x2f, 0x93, 0x2d,
+	0x83, 0x2f, 0xa3, 0x49, 0x18, 0x35, 0x62, 0x05, 0x2e, 0x8e, 0x19, 0xd2, 0x9c, 0x21, 0xbe, 0x26,
+	0xff, 0xf3, 0xb7, 0x02, 0xa7, 0x7c, 0x03, 0x8a, 0xdc, 0xfd, 0xdf, 0x98, 0xfd, 0x29, 0x0a, 0xe8,
+	0x5f, 0xa8, 0xc2, 0x88, 0x1f, 0x4f, 0xc8, 0x07, 0xd3, 0x9d, 0x4b, 0xc4, 0x88, 0x66, 0xc0, 0xa8,
+	0x8f, 0xb9, 0x4d, 0xf1, 0x4a, 0x4c, 0xe2, 0xd2, 0xee, 0x46, 0x66, 0x78, 0x20, 0x97, 0xde, 0xc8,
+	0x0c, 0xa7, 0x73, 0x19, 0x1d, 0x98, 0xc4, 0xb3, 0xc2, 0x7d, 0x7d, 0xd4, 0x0a, 0x7c, 0x7e, 0x51,
+	0xc2, 0xb5, 0xb2, 0x0a, 0x14, 0xde, 0x73, 0xab, 0x4a, 0xf6, 0xd6, 0x1b, 0xc8, 0x71, 0x91, 0xe9,
+	0xe2, 0xb8, 0xbb, 0x50, 0x06, 0x27, 0xc1, 0x50, 0xfb, 0xe0, 0xa3, 0xa5, 0xb2, 0x02, 0xbe, 0xed,
+	0x8a, 0x17, 0xb2, 0xf3, 0x20, 0x8b, 0x6a, 0x24, 0xf0, 0x98, 0xc0, 0x8b, 0x95, 0xb2, 0x08, 0x26,
+	0x39, 0xbc, 0xec, 0x92, 0xca, 0x8e, 0x8e, 0xf7, 0x90, 0x6f, 0xc5, 0x4d, 0xf3, 0x20, 0x5b, 0xc5,
+	0x8e, 0x5d, 0x0d, 0x31, 0x69, 0x5d, 0xac, 0x94, 0x27, 0x69, 0xf0, 0x55, 0x02, 0xe8, 0xa2, 0x53,
+	0x12, 0x0a, 0x6e, 0x81, 0xcf, 0xcc, 0x66, 0xbd, 0xe1, 0x87, 0x80, 0xf0, 0xbc, 0xcb, 0x6a, 0xf3,
+	0x12, 0xbf, 0x3e, 0x2d, 0xce, 0xd8, 0x0e, 0xab, 0x06, 0xa6, 0x5a, 0x21, 0x35, 0x4d, 0x7c, 0xb2,
+	0xe1, 0xcf, 0x1c, 0xb5, 0x76, 0x34, 0xb6, 0x5f, 0xc7, 0x54, 0xfd, 0x03, 0x57, 0xf4, 0x11, 0xb3,
+	0xa5, 0x29, 0xbc, 0x0b, 0xc6, 0x1a, 0xc8, 0x75, 0x2c, 0xc4, 0x88, 0x1f, 0x13, 0xa7, 0xaf, 0x4d,
+	0xfc, 0x8f, 0xc7, 0xf4, 0x5c, 0x4c, 0x14, 0x91, 0x6f, 0x83, 0x5c, 0x7c, 0xff, 0x23, 0xee, 0x4c,
+	0x5f, 0xdc, 0x9f, 0x47, 0x3c, 0x11, 0xf5, 0x3d, 0x00, 0x9b, 0xdf, 0x00, 0x75, 0x6c, 0xaf, 0x85,
+	0x7c, 0xb0, 0x3f, 0xe1, 0x8c, 0xd2, 0x2d, 0x4e, 0x24, 0xd8, 0x17, 0x9f, 0x0f, 0x81, 0x41, 0x7e,
+	0x40, 0xf0, 0x48, 0x02, 0xd9, 0x30, 0x2d, 0xe0, 0x7c, 0xf7, 0x4c, 0xb9, 0x1a, 0x56, 0x85, 0x85,
+	0x6b, 0x20, 0xc2, 0xc3, 0x57, 0x4a, 0x8f, 0x5e, 0xbe, 0x3b, 0x1a, 0x90, 0xe1, 0x94, 0xd6, 0x04,
+	0xcc, 0x71, 0xac, 0x76, 0x39, 0x55, 0xe1, 0x33, 0x09, 0x8c, 0x5d, 0x09, 0x21, 0xf8, 0x5b, 0x0f,
+	0xed, 0x3a, 0x85, 0x5b, 0xe1, 0xf7, 0xfe, 0xc0, 0x42, 0xf6, 0x2c, 0x97, 0x3d, 0x03, 0x4b, 0xc9,
+	0xb2, 0x5d, 0x87, 0xb2, 0x28, 0x64, 0x30, 0x85, 0x2f, 0x24, 0x30, 0x9e, 0x10, 0x11, 0x70, 0xa5,
+	0x07, 0x0d, 0x9d, 0xd3, 0xa7, 0xb0, 0xda, 0x2f, 0x5c, 0x98, 0x58, 0xe2, 0x26, 0xe6, 0xe0, 0x8f,
+	0xc9, 0x26, 0x6c, 0xcc, 0x8c, 0x78, 0x65, 0xdc, 0x17, 0x9a, 0xdf, 0x48, 0x20, 0x9f, 0x1c, 0x1d,
+	0x70, 0xad, 0x07, 0x3d, 0x5d, 0x53, 0xab, 0xb0, 0xfe, 0x11, 0x0c, 0xc2, 0xd4, 0x1a, 0x37, 0xf5,
+	0x2b, 0xfc, 0x25, 0xd9, 0x14, 0xad, 0x92, 0x3d, 0x03, 0x45, 0xf0, 0x0b, 0x7f, 0xda, 0x81, 0x38,
+	0xae, 0x43, 0xf8, 0x54, 0x02, 0x23, 0xad, 0x41, 0x05, 0x97, 0x7b, 0x50, 0x95, 0x10, 0x87, 0x85,
+	0x9f, 0xaf, 0x8d, 0x13, 0x1e, 0x7e, 0xe2, 0x1e, 0x54, 0x38, 0x9b, 0xec, 0xa1, 0x2d, 0x15, 0xb5,
+	0x83, 0x30, 0x2e, 0x0f, 0xcb, 0x1b, 0xc7, 0x67, 0xb2, 0x74, 0x72, 0x26, 0x4b, 0x6f, 0xcf, 0x64,
+	0xe9, 0xf1, 0xb9, 0x9c, 0x3a, 0x39, 0x97, 0x53, 0xaf, 0xce, 0xe5, 0xd4, 0x9d, 0xf9, 0x96, 0x60,
+	0x68, 0x61, 0x8c, 0x34, 0x69, 0x0f, 0x5a, 0xc8, 0x79, 0x4c, 0x98, 0x59, 0xfe, 0x9f, 0x64, 0xe9,
+	0xc3, 0x00, 0x7d, 0x4a, 0xf0, 0x07, 0x42, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -472,6 +564,8 @@ type QueryClient interface {
 	GetEmissionsFactors(ctx context.Context, in *QueryGetEmissionsFactorsRequest, opts ...grpc.CallOption) (*QueryGetEmissionsFactorsResponse, error)
 	// Queries a list of ShowAvailableEmissions items.
 	ShowAvailableEmissions(ctx context.Context, in *QueryShowAvailableEmissionsRequest, opts ...grpc.CallOption) (*QueryShowAvailableEmissionsResponse, error)
+	// Queries the block rewards projected at a height with the current emission schedule
+	BlockRewards(ctx context.Context, in *QueryBlockRewardsRequest, opts ...grpc.CallOption) (*QueryBlockRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -518,6 +612,15 @@ func (c *queryClient) ShowAvailableEmissions(ctx context.Context, in *QueryShowA
 	return out, nil
 }
 
+func (c *queryClient) BlockRewards(ctx context.Context, in *QueryBlockRewardsRequest, opts ...grpc.CallOption) (*QueryBlockRewardsResponse, error) {
+	out := new(QueryBlockRewardsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.emissions.Query/BlockRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -528,6 +631,8 @@ type QueryServer interface {
 	GetEmissionsFactors(context.Context, *QueryGetEmissionsFactorsRequest) (*QueryGetEmissionsFactorsResponse, error)
 	// Queries a list of ShowAvailableEmissions items.
 	ShowAvailableEmissions(context.Context, *QueryShowAvailableEmissionsRequest) (*QueryShowAvailableEmissionsResponse, error)
+	// Queries the block rewards projected at a height with the current emission schedule
+	BlockRewards(context.Context, *QueryBlockRewardsRequest) (*QueryBlockRewardsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -546,6 +651,9 @@ func (*UnimplementedQueryServer) GetEmissionsFactors(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) ShowAvailableEmissions(ctx context.Context, req *QueryShowAvailableEmissionsRequest) (*QueryShowAvailableEmissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowAvailableEmissions not implemented")
 }
+func (*UnimplementedQueryServer) BlockRewards(ctx context.Context, req *QueryBlockRewardsRequest) (*QueryBlockRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRewards not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -623,6 +731,24 @@ func _Query_ShowAvailableEmissions_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlockRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.emissions.Query/BlockRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRewards(ctx, req.(*QueryBlockRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.emissions.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -643,6 +769,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ShowAvailableEmissions",
 			Handler:    _Query_ShowAvailableEmissions_Handler,
 		},
+		{
+			MethodName: "BlockRewards",
+			Handler:    _Query_BlockRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/query.proto",
@@ -814,20 +944,6 @@ func (m *QueryGetEmissionsFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
-	if len(m.DurationFactor) > 0 {
-		i -= len(m.DurationFactor)
-		copy(dAtA[i:], m.DurationFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DurationFactor)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BondFactor) > 0 {
-		i -= len(m.BondFactor)
-		copy(dAtA[i:], m.BondFactor)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BondFactor)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.ReservesFactor) > 0 {
 		i -= len(m.ReservesFactor)
 		copy(dAtA[i:], m.ReservesFactor)
@@ -898,6 +1014,102 @@ func (m *QueryShowAvailableEmissionsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlockRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TssSignerRewards.Size()
+		i -= size
+		if _, err := m.TssSignerRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ObserverRewards.Size()
+		i -= size
+		if _, err := m.ObserverRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ValidatorRewards.Size()
+		i -= size
+		if _, err := m.ValidatorRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BlockRewards.Size()
+		i -= size
+		if _, err := m.BlockRewards.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -978,14 +1190,6 @@ func (m *QueryGetEmissionsFactorsResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.BondFactor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DurationFactor)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	return n
 }
 
@@ -1015,6 +1219,38 @@ func (m *QueryShowAvailableEmissionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBlockRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryBlockRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.BlockRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValidatorRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ObserverRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TssSignerRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1461,9 +1697,59 @@ func (m *QueryGetEmissionsFactorsResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ReservesFactor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowAvailableEmissionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowAvailableEmissionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowAvailableEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1491,11 +1777,61 @@ func (m *QueryGetEmissionsFactorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BondFactor = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryShowAvailableEmissionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryShowAvailableEmissionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryShowAvailableEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DurationFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1523,7 +1859,7 @@ func (m *QueryGetEmissionsFactorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DurationFactor = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1546,7 +1882,7 @@ func (m *QueryGetEmissionsFactorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowAvailableEmissionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1569,17 +1905,17 @@ func (m *QueryShowAvailableEmissionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowAvailableEmissionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowAvailableEmissionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1589,24 +1925,11 @@ func (m *QueryShowAvailableEmissionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1628,7 +1951,7 @@ func (m *QueryShowAvailableEmissionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryShowAvailableEmissionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlockRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1651,15 +1974,34 @@ func (m *QueryShowAvailableEmissionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryShowAvailableEmissionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlockRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryShowAvailableEmissionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlockRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRewards", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1687,7 +2029,111 @@ func (m *QueryShowAvailableEmissionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			if err := m.BlockRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObserverRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssSignerRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TssSignerRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -111,32 +111,127 @@ func (m *MsgWithdrawEmissionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawEmissionResponse proto.InternalMessageInfo
 
+type MsgUpdateParams struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Params  Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_618f91fd090d1520, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_618f91fd090d1520, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgWithdrawEmission)(nil), "zetachain.zetacore.emissions.MsgWithdrawEmission")
 	proto.RegisterType((*MsgWithdrawEmissionResponse)(nil), "zetachain.zetacore.emissions.MsgWithdrawEmissionResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "zetachain.zetacore.emissions.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "zetachain.zetacore.emissions.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("emissions/tx.proto", fileDescriptor_618f91fd090d1520) }
 
 var fileDescriptor_618f91fd090d1520 = []byte{
-	// 272 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4a, 0xcd, 0xcd, 0x2c,
-	0x2e, 0xce, 0xcc, 0xcf, 0x2b, 0xd6, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92,
-	0xa9, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2, 0x8b, 0x52, 0xf5,
-	0xe0, 0xca, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x0a, 0xf5, 0x41, 0x2c, 0x88, 0x1e, 0xa5,
-	0x72, 0x2e, 0x61, 0xdf, 0xe2, 0xf4, 0xf0, 0xcc, 0x92, 0x8c, 0x94, 0xa2, 0xc4, 0x72, 0x57, 0xa8,
-	0x6a, 0x21, 0x09, 0x2e, 0xf6, 0xe4, 0xa2, 0xd4, 0xc4, 0x92, 0xfc, 0x22, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0xce, 0x20, 0x18, 0x57, 0xc8, 0x8d, 0x8b, 0x2d, 0x31, 0x37, 0xbf, 0x34, 0xaf, 0x44, 0x82,
-	0x09, 0x24, 0xe1, 0xa4, 0x77, 0xe2, 0x9e, 0x3c, 0xc3, 0xad, 0x7b, 0xf2, 0x6a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0x50, 0x4a,
-	0xb7, 0x38, 0x25, 0x5b, 0xbf, 0xa4, 0xb2, 0x20, 0xb5, 0x58, 0xcf, 0x33, 0xaf, 0x24, 0x08, 0xaa,
-	0x5b, 0x49, 0x96, 0x4b, 0x1a, 0x8b, 0xc5, 0x41, 0xa9, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x46,
-	0x1d, 0x8c, 0x5c, 0xcc, 0xbe, 0xc5, 0xe9, 0x42, 0x0d, 0x8c, 0x5c, 0x02, 0x18, 0xae, 0x33, 0xd4,
-	0xc3, 0xe7, 0x53, 0x3d, 0x2c, 0xe6, 0x4a, 0x59, 0x92, 0xac, 0x05, 0xe6, 0x14, 0x27, 0xaf, 0x13,
-	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
-	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x40, 0xf2, 0x33, 0xc8, 0x50, 0x5d,
-	0xb0, 0xf9, 0xfa, 0x30, 0xf3, 0xf5, 0x2b, 0xf4, 0x91, 0x62, 0x09, 0x14, 0x02, 0x49, 0x6c, 0xe0,
-	0x50, 0x37, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x84, 0x03, 0xec, 0xd0, 0xbf, 0x01, 0x00, 0x00,
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4a, 0xc3, 0x40,
+	0x10, 0xce, 0xaa, 0x54, 0x5c, 0x05, 0x25, 0x8a, 0xd6, 0xa8, 0xa9, 0x04, 0x11, 0x2f, 0xdd, 0xd5,
+	0x8a, 0x07, 0xaf, 0x01, 0x05, 0x85, 0x82, 0x04, 0x44, 0xf0, 0xb6, 0x4d, 0x97, 0x34, 0x48, 0x32,
+	0x21, 0xb3, 0xa5, 0xd5, 0x93, 0x8f, 0xe0, 0x63, 0xf5, 0xd8, 0xa3, 0x78, 0x28, 0xd2, 0xbe, 0x87,
+	0x48, 0xfe, 0xb4, 0x6a, 0xa9, 0xf4, 0x94, 0xc9, 0xee, 0x7c, 0x3f, 0x33, 0xfb, 0x51, 0x5d, 0x06,
+	0x3e, 0xa2, 0x0f, 0x21, 0x72, 0xd5, 0x65, 0x51, 0x0c, 0x0a, 0xf4, 0xdd, 0x27, 0xa9, 0x84, 0xdb,
+	0x12, 0x7e, 0xc8, 0xd2, 0x0a, 0x62, 0xc9, 0xbe, 0xda, 0x8c, 0xcd, 0x6f, 0x44, 0x24, 0x62, 0x11,
+	0x60, 0x86, 0x32, 0x36, 0x3c, 0xf0, 0x20, 0x2d, 0x79, 0x52, 0x65, 0xa7, 0x56, 0x87, 0xae, 0xd7,
+	0xd1, 0xbb, 0xf3, 0x55, 0xab, 0x19, 0x8b, 0xce, 0x45, 0x0e, 0xd5, 0xcb, 0x74, 0xd1, 0x8d, 0xa5,
+	0x50, 0x10, 0x97, 0xc9, 0x3e, 0x39, 0x5a, 0x72, 0x8a, 0x5f, 0xfd, 0x92, 0x96, 0x44, 0x00, 0xed,
+	0x50, 0x95, 0xe7, 0x92, 0x0b, 0x9b, 0xf5, 0x06, 0x15, 0xed, 0x6d, 0x50, 0x39, 0xf4, 0x7c, 0xd5,
+	0x6a, 0x37, 0x98, 0x0b, 0x01, 0x77, 0x01, 0x03, 0xc0, 0xfc, 0x53, 0xc5, 0xe6, 0x03, 0x57, 0x8f,
+	0x91, 0x44, 0x76, 0x15, 0x2a, 0x27, 0x47, 0x5b, 0x7b, 0x74, 0x67, 0x82, 0xb0, 0x23, 0x31, 0x82,
+	0x10, 0xa5, 0x05, 0x74, 0xb5, 0x8e, 0xde, 0x6d, 0xd4, 0x14, 0x4a, 0xde, 0xa4, 0x63, 0x4c, 0xf1,
+	0x64, 0xd3, 0x52, 0x36, 0x6a, 0xea, 0x69, 0xb9, 0x76, 0xc0, 0xa6, 0x6d, 0x88, 0x65, 0x7c, 0xf6,
+	0x42, 0xe2, 0xdc, 0xc9, 0x91, 0xd6, 0x36, 0xdd, 0xfa, 0x25, 0x58, 0x78, 0xa9, 0x7d, 0x10, 0x3a,
+	0x5f, 0x47, 0x4f, 0x7f, 0x26, 0x74, 0xed, 0xcf, 0xa6, 0x4e, 0xa6, 0x6b, 0x4d, 0x98, 0xd1, 0x38,
+	0x9f, 0x19, 0x52, 0x58, 0xd1, 0x15, 0x5d, 0xf9, 0xb1, 0x93, 0xea, 0xbf, 0x54, 0xe3, 0xed, 0xc6,
+	0xd9, 0x4c, 0xed, 0x85, 0xaa, 0x7d, 0xdd, 0x1b, 0x9a, 0xa4, 0x3f, 0x34, 0xc9, 0xfb, 0xd0, 0x24,
+	0x2f, 0x23, 0x53, 0xeb, 0x8f, 0x4c, 0xed, 0x75, 0x64, 0x6a, 0xf7, 0xc7, 0x63, 0xaf, 0x9e, 0x10,
+	0x56, 0x53, 0x6e, 0x5e, 0x70, 0xf3, 0x2e, 0x1f, 0xcb, 0x6f, 0x92, 0x81, 0x46, 0x29, 0xcd, 0xdd,
+	0xe9, 0xe7, 0x00, 0x22, 0x84, 0x1f, 0xe4, 0xd9, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -152,6 +247,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	WithdrawEmission(ctx context.Context, in *MsgWithdrawEmission, opts ...grpc.CallOption) (*MsgWithdrawEmissionResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -171,9 +267,19 @@ func (c *msgClient) WithdrawEmission(ctx context.Context, in *MsgWithdrawEmissio
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.emissions.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	WithdrawEmission(context.Context, *MsgWithdrawEmission) (*MsgWithdrawEmissionResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -183,6 +289,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) WithdrawEmission(ctx context.Context, req *MsgWithdrawEmission) (*MsgWithdrawEmissionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawEmission not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -206,6 +315,24 @@ func _Msg_WithdrawEmission_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.emissions.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.emissions.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -214,6 +341,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "WithdrawEmission",
 			Handler:    _Msg_WithdrawEmission_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "emissions/tx.proto",
@@ -282,6 +413,69 @@ func (m *MsgWithdrawEmissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -317,6 +511,30 @@ func (m *MsgWithdrawEmissionResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -489,6 +707,171 @@ func (m *MsgWithdrawEmissionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,7 +6,6 @@ import (
 	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/fungible/types"
 )
 
@@ -16,17 +15,17 @@ import (
 func (k msgServer) UpdateZRC20LiquidityCap(goCtx context.Context, msg *types.MsgUpdateZRC20LiquidityCap) (*types.MsgUpdateZRC20LiquidityCapResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check authorization
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
-		return nil, cosmoserrors.Wrap(sdkerrors.ErrUnauthorized, "update can only be executed by group 2 policy group")
-	}
-
 	// fetch the foreign coin
 	coin, found := k.GetForeignCoins(ctx, msg.Zrc20Address)
 	if !found {
 		return nil, types.ErrForeignCoinNotFound
 	}
 
+	// check authorization for the chain of the zrc20
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, coin.ForeignChainId) {
+		return nil, cosmoserrors.Wrap(sdkerrors.ErrUnauthorized, "update can only be executed by group 2 policy group")
+	}
+
 	// update the liquidity cap
 	coin.LiquidityCap = msg.LiquidityCap
 	k.SetForeignCoins(ctx, coin)
@@ -3,8 +3,6 @@ package keeper_test
 import (
 	"testing"
 
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
-
 	"cosmossdk.io/math"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
@@ -29,7 +27,7 @@ func TestMsgServer_UpdateZRC20LiquidityCap(t *testing.T) {
 		k.SetForeignCoins(ctx, foreignCoin)
 
 		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// can update liquidity cap
 		_, err := msgServer.UpdateZRC20LiquidityCap(ctx, types.NewMsgUpdateZRC20LiquidityCap(
@@ -43,7 +41,7 @@ func TestMsgServer_UpdateZRC20LiquidityCap(t *testing.T) {
 		require.True(t, found)
 		require.True(t, coin.LiquidityCap.Equal(math.NewUint(42)), "invalid liquidity cap", coin.LiquidityCap.String())
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// can update liquidity cap again
 		_, err = msgServer.UpdateZRC20LiquidityCap(ctx, types.NewMsgUpdateZRC20LiquidityCap(
@@ -57,7 +55,7 @@ func TestMsgServer_UpdateZRC20LiquidityCap(t *testing.T) {
 		require.True(t, found)
 		require.True(t, coin.LiquidityCap.Equal(math.NewUint(4200000)), "invalid liquidity cap", coin.LiquidityCap.String())
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// can set liquidity cap to 0
 		_, err = msgServer.UpdateZRC20LiquidityCap(ctx, types.NewMsgUpdateZRC20LiquidityCap(
@@ -71,7 +69,7 @@ func TestMsgServer_UpdateZRC20LiquidityCap(t *testing.T) {
 		require.True(t, found)
 		require.True(t, coin.LiquidityCap.Equal(math.ZeroUint()), "invalid liquidity cap", coin.LiquidityCap.String())
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// can set liquidity cap to nil
 		_, err = msgServer.UpdateZRC20LiquidityCap(ctx, types.NewMsgUpdateZRC20LiquidityCap(
@@ -100,7 +98,7 @@ func TestMsgServer_UpdateZRC20LiquidityCap(t *testing.T) {
 		k.SetForeignCoins(ctx, foreignCoin)
 
 		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, false)
 
 		_, err := msgServer.UpdateZRC20LiquidityCap(ctx, types.NewMsgUpdateZRC20LiquidityCap(
 			admin,
@@ -120,9 +118,6 @@ func TestMsgServer_UpdateZRC20LiquidityCap(t *testing.T) {
 		admin := sample.AccAddress()
 		coinAddress := sample.EthAddress().String()
 
-		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
-
 		_, err := msgServer.UpdateZRC20LiquidityCap(ctx, types.NewMsgUpdateZRC20LiquidityCap(
 			admin,
 			coinAddress,
@@ -131,4 +126,27 @@ func TestMsgServer_UpdateZRC20LiquidityCap(t *testing.T) {
 		require.Error(t, err)
 		require.ErrorIs(t, err, types.ErrForeignCoinNotFound)
 	})
+
+	t.Run("should check the authorization for the chain of the zrc20", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.FungibleKeeperWithMocks(t, keepertest.FungibleMockOptions{
+			UseAuthorityMock: true,
+		})
+
+		msgServer := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		coinAddress := sample.EthAddress().String()
+
+		foreignCoin := sample.ForeignCoins(t, coinAddress)
+		k.SetForeignCoins(ctx, foreignCoin)
+
+		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsgForChain(&authorityMock.Mock, admin, foreignCoin.ForeignChainId, true)
+
+		_, err := msgServer.UpdateZRC20LiquidityCap(ctx, types.NewMsgUpdateZRC20LiquidityCap(
+			admin,
+			coinAddress,
+			math.NewUint(42),
+		))
+		require.NoError(t, err)
+	})
 }
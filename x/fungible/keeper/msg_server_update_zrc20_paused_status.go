@@ -33,7 +33,7 @@ func (k msgServer) UpdateZRC20PausedStatus(
 	if msg.Action == types.UpdatePausedStatusAction_UNPAUSE {
 		requiredPolicyAccount = authoritytypes.PolicyType_groupAdmin
 	}
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, requiredPolicyAccount) {
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsgWithPolicy(ctx, msg.Creator, msg, requiredPolicyAccount) {
 		return nil, cosmoserrors.Wrap(sdkerrors.ErrUnauthorized, "Update can only be executed by the correct policy account")
 	}
 
@@ -42,7 +42,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		assertUnpaused(zrc20B)
 		assertUnpaused(zrc20C)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
 
 		// can pause zrc20
 		_, err := msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
@@ -58,7 +58,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		assertPaused(zrc20B)
 		assertUnpaused(zrc20C)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		// can unpause zrc20
 		_, err = msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
@@ -73,7 +73,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		assertPaused(zrc20B)
 		assertUnpaused(zrc20C)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
 
 		// can pause already paused zrc20
 		_, err = msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
@@ -88,7 +88,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		assertPaused(zrc20B)
 		assertUnpaused(zrc20C)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		// can unpause already unpaused zrc20
 		_, err = msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
@@ -103,7 +103,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		assertPaused(zrc20B)
 		assertUnpaused(zrc20C)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
 
 		// can pause all zrc20
 		_, err = msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
@@ -120,7 +120,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		assertPaused(zrc20B)
 		assertPaused(zrc20C)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		// can unpause all zrc20
 		_, err = msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
@@ -163,7 +163,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, false)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, false)
 
 		_, err := msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
 			admin,
@@ -172,7 +172,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 		))
 		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
 
 		_, err = msgServer.UpdateZRC20PausedStatus(ctx, types.NewMsgUpdateZRC20PausedStatus(
 			admin,
@@ -192,7 +192,7 @@ func TestKeeper_UpdateZRC20PausedStatus(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetFungibleAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
 
 		zrc20A, zrc20B := sample.EthAddress().String(), sample.EthAddress().String()
 		k.SetForeignCoins(ctx, sample.ForeignCoins(t, zrc20A))
@@ -56,5 +56,6 @@ type EVMKeeper interface {
 }
 
 type AuthorityKeeper interface {
-	IsAuthorized(ctx sdk.Context, address string, policyType authoritytypes.PolicyType) bool
+	IsAuthorizedForMsgWithPolicy(ctx sdk.Context, address string, msg sdk.Msg, policyType authoritytypes.PolicyType) bool
+	IsAuthorizedForMsg(ctx sdk.Context, address string, msg sdk.Msg, chainID int64) bool
 }
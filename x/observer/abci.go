@@ -9,6 +9,15 @@ import (
 )
 
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// apply the chain params scheduled for the current height
+	k.ApplyPendingChainParams(ctx)
+
+	// update the participation counters of the observers with the matured ballots
+	k.UpdateObserverPerformances(ctx)
+
+	// remove the blame records finalized before the retention period
+	k.PruneBlames(ctx)
+
 	lastBlockObserverCount, found := k.GetLastObserverCount(ctx)
 	if !found {
 		ctx.Logger().Error("LastBlockObserverCount not found at height", ctx.BlockHeight())
@@ -27,10 +27,16 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(
 		CmdQueryParams(),
 		CmdBallotByIdentifier(),
+		CmdListBallotsByHeight(),
+		CmdListBallotsByVoter(),
+		CmdListBallotsByStatus(),
 		CmdObserverSet(),
+		CmdObserverChains(),
 		CmdGetSupportedChains(),
 		CmdGetChainParamsForChain(),
 		CmdGetChainParams(),
+		CmdShowPendingChainParams(),
+		CmdListPendingChainParams(),
 		CmdListNodeAccount(),
 		CmdShowNodeAccount(),
 		CmdShowCrosschainFlags(),
@@ -39,6 +45,7 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdBlameByIdentifier(),
 		CmdGetAllBlameRecords(),
 		CmdGetBlameByChainAndNonce(),
+		CmdShowBlameStatistics(),
 		CmdGetTssAddress(),
 		CmdListTssHistory(),
 		CmdShowTSS(),
@@ -46,6 +53,10 @@ func GetQueryCmd(_ string) *cobra.Command {
 		CmdListChainNonces(),
 		CmdShowChainNonces(),
 		CmdListPendingNonces(),
+		CmdShowObserverPerformance(),
+		CmdShowObserverLiveness(),
+		CmdListJailedObservers(),
+		CmdShowEthereumLightClient(),
 	)
 
 	return cmd
@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
@@ -39,3 +42,140 @@ func CmdBallotByIdentifier() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListBallotsByHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-ballots-by-height [min-height] [max-height]",
+		Short: "lists the ballots created between min-height and max-height, up to the current height if max-height is not provided",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			minHeight, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			var maxHeight int64
+			if len(args) > 1 {
+				maxHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBallotsByHeightRequest{
+				MinHeight:  minHeight,
+				MaxHeight:  maxHeight,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BallotsByHeight(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "ballots-by-height")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListBallotsByVoter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-ballots-by-voter [voter-address] [has-voted]",
+		Short: "lists the ballots the voter has voted on, or has not voted on if has-voted is false",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			hasVoted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBallotsByVoterRequest{
+				VoterAddress: args[0],
+				HasVoted:     hasVoted,
+				Pagination:   pageReq,
+			}
+
+			res, err := queryClient.BallotsByVoter(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "ballots-by-voter")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListBallotsByStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-ballots-by-status [ballot-status] [observation-type]",
+		Short: "lists the ballots with the status (e.g. BallotInProgress), for all observation types if observation-type is not provided",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			ballotStatus, found := types.BallotStatus_value[args[0]]
+			if !found {
+				return fmt.Errorf("invalid ballot status %s", args[0])
+			}
+			var observationType int32
+			if len(args) > 1 {
+				observationType, found = types.ObservationType_value[args[1]]
+				if !found {
+					return fmt.Errorf("invalid observation type %s", args[1])
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBallotsByStatusRequest{
+				BallotStatus:    types.BallotStatus(ballotStatus),
+				ObservationType: types.ObservationType(observationType),
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.BallotsByStatus(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "ballots-by-status")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
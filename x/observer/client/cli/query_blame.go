@@ -114,3 +114,48 @@ func CmdGetBlameByChainAndNonce() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowBlameStatistics() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-blame-statistics [window-blocks] [chain-id]",
+		Short: "shows the number of times the nodes were blamed per chain in the recent blocks",
+		Long: `shows the number of times the nodes were blamed per chain in the last window-blocks blocks.
+All the retained blame records are counted if window-blocks is not provided or 0, the blames of all chains are counted if chain-id is not provided or 0.`,
+		Args: cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			var windowBlocks, chainID int64
+			if len(args) > 0 {
+				windowBlocks, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				chainID, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryBlameStatisticsRequest{
+				ChainId:      chainID,
+				WindowBlocks: windowBlocks,
+			}
+
+			res, err := queryClient.BlameStatistics(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
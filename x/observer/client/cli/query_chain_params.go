@@ -69,3 +69,63 @@ func CmdGetChainParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdShowPendingChainParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-pending-chain-params [chain-id]",
+		Short: "shows the chain params scheduled for a chain and their activation height",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			reqChainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingChainParamsForChainRequest{
+				ChainId: reqChainID,
+			}
+			res, err := queryClient.PendingChainParamsForChain(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListPendingChainParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-pending-chain-params",
+		Short: "lists the chain params scheduled to be applied at an activation height",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryPendingChainParamsRequest{}
+			res, err := queryClient.PendingChainParams(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func CmdShowObserverLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-observer-liveness [observer-address]",
+		Short: "shows the missed ballots window and the jail status of an observer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryObserverLivenessRequest{
+				ObserverAddress: args[0],
+			}
+
+			res, err := queryClient.ObserverLiveness(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListJailedObservers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-jailed-observers",
+		Short: "lists the observers jailed for missing too many ballots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryJailedObserversRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.JailedObservers(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "jailed-observers")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func CmdShowObserverPerformance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-observer-performance [observer-address] [chain-id]",
+		Short: "shows the participation counters of an observer, for all chains if chain-id is not provided",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var chainID int64
+			if len(args) > 1 {
+				chainID, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryObserverPerformanceRequest{
+				ObserverAddress: args[0],
+				ChainId:         chainID,
+				Pagination:      pageReq,
+			}
+
+			res, err := queryClient.ObserverPerformance(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "observer-performance")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
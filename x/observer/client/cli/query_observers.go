@@ -30,3 +30,29 @@ func CmdObserverSet() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdObserverChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show-observer-chains [observer-address]",
+		Short: "Query the chains an observer is assigned to, empty if assigned to all chains",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			params := &types.QueryObserverChainsRequest{
+				ObserverAddress: args[0],
+			}
+			res, err := queryClient.ObserverChains(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -28,6 +28,10 @@ func GetTxCmd() *cobra.Command {
 		CmdUpdateKeygen(),
 		CmdAddBlameVote(),
 		CmdUpdateObserver(),
+		CmdUpdateObserverChains(),
+		CmdUnjailObserver(),
+		CmdBootstrapEthereumLightClient(),
+		CmdUpdateEthereumLightClient(),
 		CmdEncode(),
 	)
 
@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func CmdBootstrapEthereumLightClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bootstrap-ethereum-light-client [chain-id] [trusted-block-root] [bootstrap-file]",
+		Short: "Broadcast message to initialize the beacon chain light client of an Ethereum chain",
+		Long: `Initialize the beacon chain light client of an Ethereum chain from a trusted beacon block root.
+The bootstrap file is the response of the beacon API endpoint /eth/v1/beacon/light_client/bootstrap/{block_root}.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+			bootstrap, err := ethereum.ParseLightClientBootstrapJSON(data)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBootstrapEthereumLightClient(
+				clientCtx.GetFromAddress().String(),
+				chainID,
+				ethcommon.FromHex(args[1]),
+				bootstrap,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateEthereumLightClient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-ethereum-light-client [chain-id] [update-file]",
+		Short: "Broadcast message to apply an update to the beacon chain light client of an Ethereum chain",
+		Long: `Apply a sync committee period update or a finality update to the beacon chain light client of an Ethereum chain.
+The update file is the response of the beacon API endpoint /eth/v1/beacon/light_client/finality_update
+or an element of the response of the endpoint /eth/v1/beacon/light_client/updates.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			chainID, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			update, err := ethereum.ParseLightClientUpdateJSON(data)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateEthereumLightClient(
+				clientCtx.GetFromAddress().String(),
+				chainID,
+				update,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
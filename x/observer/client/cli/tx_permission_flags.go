@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -10,6 +12,13 @@ import (
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
+const (
+	flagRetentionBlocks   = "retention-blocks"
+	flagMaxPrunedPerBlock = "max-pruned-per-block"
+	flagChainFlags        = "chain-flags"
+	flagForeignCoinFlags  = "foreign-coin-flags"
+)
+
 func CmdUpdateCrosschainFlags() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-crosschain-flags [is-inbound-enabled] [is-outbound-enabled]",
@@ -31,11 +40,116 @@ func CmdUpdateCrosschainFlags() *cobra.Command {
 			}
 			msg := types.NewMsgUpdateCrosschainFlags(clientCtx.GetFromAddress().String(), argIsInboundEnabled, arsIsOutboundEnabled)
 
+			// pruning flags are updated if the retention is provided
+			if cmd.Flags().Changed(flagRetentionBlocks) {
+				retentionBlocks, err := cmd.Flags().GetInt64(flagRetentionBlocks)
+				if err != nil {
+					return err
+				}
+				maxPrunedPerBlock, err := cmd.Flags().GetUint32(flagMaxPrunedPerBlock)
+				if err != nil {
+					return err
+				}
+				msg.PruningFlags = &types.PruningFlags{
+					RetentionBlocks:   retentionBlocks,
+					MaxPrunedPerBlock: maxPrunedPerBlock,
+				}
+			}
+
+			chainFlags, err := cmd.Flags().GetStringSlice(flagChainFlags)
+			if err != nil {
+				return err
+			}
+			for _, chainFlag := range chainFlags {
+				parsed, err := parseChainFlags(chainFlag)
+				if err != nil {
+					return err
+				}
+				msg.ChainFlags = append(msg.ChainFlags, parsed)
+			}
+			foreignCoinFlags, err := cmd.Flags().GetStringSlice(flagForeignCoinFlags)
+			if err != nil {
+				return err
+			}
+			for _, foreignCoinFlag := range foreignCoinFlags {
+				parsed, err := parseForeignCoinFlags(foreignCoinFlag)
+				if err != nil {
+					return err
+				}
+				msg.ForeignCoinFlags = append(msg.ForeignCoinFlags, parsed)
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(flagRetentionBlocks, 0, "number of blocks terminal cctxs are kept before being pruned, 0 disables pruning")
+	cmd.Flags().Uint32(flagMaxPrunedPerBlock, types.DefaultPruningFlags.MaxPrunedPerBlock, "maximum number of cctxs pruned per block")
+	cmd.Flags().StringSlice(
+		flagChainFlags,
+		nil,
+		"inbound and outbound toggles of a chain as chain-id:is-inbound-enabled:is-outbound-enabled, e.g. 1:false:true",
+	)
+	cmd.Flags().StringSlice(
+		flagForeignCoinFlags,
+		nil,
+		"inbound and outbound toggles of a foreign coin as chain-id:asset:is-inbound-enabled:is-outbound-enabled, empty asset for the gas coin, e.g. 1:0x...:true:false",
+	)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseChainFlags parses chain flags provided as chain-id:is-inbound-enabled:is-outbound-enabled
+func parseChainFlags(s string) (types.ChainFlags, error) {
+	fields := strings.Split(s, ":")
+	if len(fields) != 3 {
+		return types.ChainFlags{}, fmt.Errorf("invalid chain flags %s, expected chain-id:is-inbound-enabled:is-outbound-enabled", s)
+	}
+	chainID, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return types.ChainFlags{}, err
+	}
+	isInboundEnabled, err := strconv.ParseBool(fields[1])
+	if err != nil {
+		return types.ChainFlags{}, err
+	}
+	isOutboundEnabled, err := strconv.ParseBool(fields[2])
+	if err != nil {
+		return types.ChainFlags{}, err
+	}
+	return types.ChainFlags{
+		ChainId:           chainID,
+		IsInboundEnabled:  isInboundEnabled,
+		IsOutboundEnabled: isOutboundEnabled,
+	}, nil
+}
+
+// parseForeignCoinFlags parses foreign coin flags provided as chain-id:asset:is-inbound-enabled:is-outbound-enabled
+func parseForeignCoinFlags(s string) (types.ForeignCoinFlags, error) {
+	fields := strings.Split(s, ":")
+	if len(fields) != 4 {
+		return types.ForeignCoinFlags{}, fmt.Errorf(
+			"invalid foreign coin flags %s, expected chain-id:asset:is-inbound-enabled:is-outbound-enabled",
+			s,
+		)
+	}
+	chainID, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return types.ForeignCoinFlags{}, err
+	}
+	isInboundEnabled, err := strconv.ParseBool(fields[2])
+	if err != nil {
+		return types.ForeignCoinFlags{}, err
+	}
+	isOutboundEnabled, err := strconv.ParseBool(fields[3])
+	if err != nil {
+		return types.ForeignCoinFlags{}, err
+	}
+	return types.ForeignCoinFlags{
+		ChainId:           chainID,
+		Asset:             fields[1],
+		IsInboundEnabled:  isInboundEnabled,
+		IsOutboundEnabled: isOutboundEnabled,
+	}, nil
+}
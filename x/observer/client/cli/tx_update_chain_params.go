@@ -12,6 +12,8 @@ import (
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
+const flagActivationHeight = "activation-height"
+
 func CmdUpdateChainParams() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-chain-params [chain-id] [client-params.json]",
@@ -40,15 +42,22 @@ func CmdUpdateChainParams() *cobra.Command {
 				return err
 			}
 
+			activationHeight, err := cmd.Flags().GetInt64(flagActivationHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateChainParams(
 				clientCtx.GetFromAddress().String(),
 				&clientParams,
+				activationHeight,
 			)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Int64(flagActivationHeight, 0, "zeta height at which the chain params are applied, applied immediately if not provided")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,48 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func CmdUpdateObserverChains() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-observer-chains [chain-ids]",
+		Short: "set the comma separated list of chains observed by the observer, all the chains are observed if no chain is provided",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var chainIDs []int64
+			if len(args) > 0 && args[0] != "" {
+				for _, arg := range strings.Split(args[0], ",") {
+					chainID, err := strconv.ParseInt(strings.TrimSpace(arg), 10, 64)
+					if err != nil {
+						return err
+					}
+					chainIDs = append(chainIDs, chainID)
+				}
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateObserverChains(
+				clientCtx.GetFromAddress().String(),
+				chainIDs,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -61,6 +61,11 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		if genState.CrosschainFlags.GasPriceIncreaseFlags != nil {
 			crosschainFlags.GasPriceIncreaseFlags = genState.CrosschainFlags.GasPriceIncreaseFlags
 		}
+		if genState.CrosschainFlags.PruningFlags != nil {
+			crosschainFlags.PruningFlags = genState.CrosschainFlags.PruningFlags
+		}
+		crosschainFlags.ChainFlags = genState.CrosschainFlags.ChainFlags
+		crosschainFlags.ForeignCoinFlags = genState.CrosschainFlags.ForeignCoinFlags
 		k.SetCrosschainFlags(ctx, *crosschainFlags)
 	} else {
 		k.SetCrosschainFlags(ctx, *types.DefaultCrosschainFlags())
@@ -128,6 +133,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	for _, elem := range genState.BlameList {
 		k.SetBlame(ctx, elem)
+		k.AddBlameToPruneQueue(ctx, elem)
 	}
 
 	for _, chainNonce := range genState.ChainNonces {
@@ -136,6 +142,15 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.NonceToCctx {
 		k.SetNonceToCctx(ctx, elem)
 	}
+	for _, elem := range genState.ObserverChains {
+		k.SetObserverChains(ctx, elem)
+	}
+	for _, elem := range genState.ObserverLiveness {
+		k.SetObserverLiveness(ctx, elem)
+	}
+	for _, elem := range genState.PendingChainParams {
+		k.SetPendingChainParams(ctx, elem)
+	}
 
 }
 
@@ -195,20 +210,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 
 	return &types.GenesisState{
-		Ballots:           k.GetAllBallots(ctx),
-		ChainParamsList:   chainParams,
-		Observers:         os,
-		Params:            &params,
-		NodeAccountList:   nodeAccounts,
-		CrosschainFlags:   cf,
-		Keygen:            kn,
-		LastObserverCount: oc,
-		Tss:               tss,
-		PendingNonces:     pendingNonces,
-		TssHistory:        k.GetAllTSS(ctx),
-		TssFundMigrators:  k.GetAllTssFundMigrators(ctx),
-		BlameList:         k.GetAllBlame(ctx),
-		ChainNonces:       k.GetAllChainNonces(ctx),
-		NonceToCctx:       k.GetAllNonceToCctx(ctx),
+		Ballots:            k.GetAllBallots(ctx),
+		ChainParamsList:    chainParams,
+		Observers:          os,
+		Params:             &params,
+		NodeAccountList:    nodeAccounts,
+		CrosschainFlags:    cf,
+		Keygen:             kn,
+		LastObserverCount:  oc,
+		Tss:                tss,
+		PendingNonces:      pendingNonces,
+		TssHistory:         k.GetAllTSS(ctx),
+		TssFundMigrators:   k.GetAllTssFundMigrators(ctx),
+		BlameList:          k.GetAllBlame(ctx),
+		ChainNonces:        k.GetAllChainNonces(ctx),
+		NonceToCctx:        k.GetAllNonceToCctx(ctx),
+		ObserverChains:     k.GetAllObserverChains(ctx),
+		ObserverLiveness:   k.GetAllObserverLiveness(ctx),
+		PendingChainParams: k.GetAllPendingChainParams(ctx),
 	}
 }
@@ -1,9 +1,11 @@
 package observer_test
 
 import (
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/nullify"
 	"github.com/zeta-chain/zetacore/testutil/sample"
@@ -43,6 +45,21 @@ func TestGenesis(t *testing.T) {
 		NonceToCctx:   sample.NonceToCctxList(t, "sample", 20),
 	}
 
+	// observer chains are exported in the order of the observer addresses
+	observers := []string{sample.AccAddress(), sample.AccAddress()}
+	sort.Strings(observers)
+	genesisState.ObserverChains = []types.ObserverChains{
+		{ObserverAddress: observers[0], ChainIds: []int64{1, 56}},
+		{ObserverAddress: observers[1], ChainIds: []int64{18332}},
+	}
+	genesisState.PendingChainParams = []types.PendingChainParams{
+		{ChainParams: sample.ChainParams(common.ExternalChainList()[0].ChainId), ActivationHeight: 100},
+	}
+	genesisState.ObserverLiveness = []types.ObserverLiveness{
+		{ObserverAddress: observers[0], WindowBallots: 10, WindowMissedBallots: 2},
+		{ObserverAddress: observers[1], Jailed: true, JailedUntil: 1000, JailCount: 1},
+	}
+
 	// Init and export
 	k, ctx, _, _ := keepertest.ObserverKeeper(t)
 	observer.InitGenesis(ctx, *k, genesisState)
@@ -70,3 +70,31 @@ func (k Keeper) GetMaturedBallotList(ctx sdk.Context) []string {
 	}
 	return list.BallotsIndexList
 }
+
+// RemoveBallot removes a ballot and its reference in the list of ballots for its creation height
+func (k Keeper) RemoveBallot(ctx sdk.Context, index string) {
+	ballot, found := k.GetBallot(ctx, index)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoterKey))
+	store.Delete(types.KeyPrefix(index))
+
+	list, found := k.GetBallotList(ctx, ballot.BallotCreationHeight)
+	if !found {
+		return
+	}
+	indexes := make([]string, 0, len(list.BallotsIndexList))
+	for _, ballotIndex := range list.BallotsIndexList {
+		if ballotIndex != index {
+			indexes = append(indexes, ballotIndex)
+		}
+	}
+	if len(indexes) == 0 {
+		listStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BallotListKey))
+		listStore.Delete(types.BallotListKeyPrefix(list.Height))
+		return
+	}
+	list.BallotsIndexList = indexes
+	k.SetBallotList(ctx, &list)
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
@@ -21,3 +22,39 @@ func TestKeeper_GetBallot(t *testing.T) {
 
 	k.GetBallot(ctx, identifier)
 }
+
+func TestKeeper_RemoveBallot(t *testing.T) {
+	t.Run("remove ballot and its reference in the ballot list", func(t *testing.T) {
+		k, ctx := SetupKeeper(t)
+		for _, identifier := range []string{"foo", "bar"} {
+			ballot := types.Ballot{
+				BallotIdentifier:     identifier,
+				BallotCreationHeight: 42,
+			}
+			k.SetBallot(ctx, &ballot)
+			k.AddBallotToList(ctx, ballot)
+		}
+
+		k.RemoveBallot(ctx, "foo")
+		_, found := k.GetBallot(ctx, "foo")
+		require.False(t, found)
+		_, found = k.GetBallot(ctx, "bar")
+		require.True(t, found)
+		list, found := k.GetBallotList(ctx, 42)
+		require.True(t, found)
+		require.Equal(t, []string{"bar"}, list.BallotsIndexList)
+
+		k.RemoveBallot(ctx, "bar")
+		_, found = k.GetBallot(ctx, "bar")
+		require.False(t, found)
+		_, found = k.GetBallotList(ctx, 42)
+		require.False(t, found)
+	})
+
+	t.Run("no-op if ballot doesn't exist", func(t *testing.T) {
+		k, ctx := SetupKeeper(t)
+		k.RemoveBallot(ctx, "foo")
+		_, found := k.GetBallot(ctx, "foo")
+		require.False(t, found)
+	})
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -62,3 +64,73 @@ func (k Keeper) GetBlamesByChainAndNonce(ctx sdk.Context, chainID int64, nonce i
 	}
 	return
 }
+
+// RemoveBlame removes a blame record from the store
+func (k Keeper) RemoveBlame(ctx sdk.Context, index string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlameKey))
+	store.Delete([]byte(index))
+}
+
+// GetBlameStatistics returns the number of times the nodes were blamed per chain in the blame records finalized since a zeta height
+// the blames of all chains are counted if the chain ID is 0, the nodes are ordered by decreasing number of blames
+func (k Keeper) GetBlameStatistics(ctx sdk.Context, chainID int64, fromHeight int64) []types.NodeBlameStatistics {
+	if fromHeight < 0 {
+		fromHeight = 0
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlamePruneQueueKeyPrefix))
+	// #nosec G701 always positive
+	iterator := store.Iterator(types.BlamePruneQueueHeightKey(uint64(fromHeight)), nil)
+	defer iterator.Close()
+
+	counts := make(map[string]map[int64]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		height, index, err := types.ParseBlamePruneQueueKey(iterator.Key())
+		if err != nil {
+			continue
+		}
+		blame, found := k.GetBlame(ctx, index)
+		// #nosec G701 always in range
+		if !found || blame.FinalizedHeight != int64(height) {
+			continue
+		}
+		if chainID != 0 && blame.ChainId != chainID {
+			continue
+		}
+
+		// a node is counted once per blame record
+		blamed := make(map[string]bool)
+		for _, node := range blame.Nodes {
+			if node == nil || blamed[node.PubKey] {
+				continue
+			}
+			blamed[node.PubKey] = true
+			if counts[node.PubKey] == nil {
+				counts[node.PubKey] = make(map[int64]uint64)
+			}
+			counts[node.PubKey][blame.ChainId]++
+		}
+	}
+
+	statistics := make([]types.NodeBlameStatistics, 0, len(counts))
+	for pubKey, chainCounts := range counts {
+		nodeStatistics := types.NodeBlameStatistics{PubKey: pubKey}
+		for blameChainID, count := range chainCounts {
+			nodeStatistics.BlameCounts = append(nodeStatistics.BlameCounts, types.BlameCount{
+				ChainId: blameChainID,
+				Count:   count,
+			})
+			nodeStatistics.Total += count
+		}
+		sort.Slice(nodeStatistics.BlameCounts, func(i, j int) bool {
+			return nodeStatistics.BlameCounts[i].ChainId < nodeStatistics.BlameCounts[j].ChainId
+		})
+		statistics = append(statistics, nodeStatistics)
+	}
+	sort.Slice(statistics, func(i, j int) bool {
+		if statistics[i].Total != statistics[j].Total {
+			return statistics[i].Total > statistics[j].Total
+		}
+		return statistics[i].PubKey < statistics[j].PubKey
+	})
+	return statistics
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// MaxBlamesPrunedPerBlock is the maximum number of blame records pruned in a block
+const MaxBlamesPrunedPerBlock = 100
+
+// AddBlameToPruneQueue adds a blame record to the prune queue at the zeta height it was finalized at
+// the blame is pruned once the retention period defined in the params has elapsed
+func (k Keeper) AddBlameToPruneQueue(ctx sdk.Context, blame types.Blame) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlamePruneQueueKeyPrefix))
+	// #nosec G701 always positive
+	store.Set(types.BlamePruneQueueKey(uint64(blame.FinalizedHeight), blame.Index), []byte(blame.Index))
+}
+
+// PruneBlames removes from the state the blame records finalized before the retention period
+// The function returns the number of blame records pruned
+func (k Keeper) PruneBlames(ctx sdk.Context) int {
+	params := k.GetParams(ctx)
+	if params.BlameRetentionBlocks <= 0 {
+		return 0
+	}
+	if ctx.BlockHeight() <= params.BlameRetentionBlocks {
+		return 0
+	}
+
+	// collect the blames finalized before the retention period
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlamePruneQueueKeyPrefix))
+	// #nosec G701 always positive
+	end := types.BlamePruneQueueHeightKey(uint64(ctx.BlockHeight() - params.BlameRetentionBlocks))
+	iterator := store.Iterator(nil, end)
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < MaxBlamesPrunedPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	pruned := 0
+	for _, key := range keys {
+		store.Delete(key)
+
+		height, index, err := types.ParseBlamePruneQueueKey(key)
+		if err != nil {
+			ctx.Logger().Error("PruneBlames: invalid blame prune queue key", "err", err.Error())
+			continue
+		}
+
+		// the blame is kept if it was finalized again at a later height
+		blame, found := k.GetBlame(ctx, index)
+		// #nosec G701 always in range
+		if !found || blame.FinalizedHeight != int64(height) {
+			continue
+		}
+		k.RemoveBlame(ctx, index)
+		pruned++
+	}
+
+	return pruned
+}
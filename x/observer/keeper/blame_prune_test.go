@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func finalizedBlame(index string, chainID int64, height int64, pubKeys ...string) types.Blame {
+	nodes := make([]*types.Node, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		nodes[i] = &types.Node{PubKey: pubKey}
+	}
+	return types.Blame{
+		Index:           index,
+		FailureReason:   "failed to join party",
+		Nodes:           nodes,
+		ChainId:         chainID,
+		FinalizedHeight: height,
+	}
+}
+
+func setFinalizedBlames(k *keeper.Keeper, ctx sdk.Context, blames ...types.Blame) {
+	for _, blame := range blames {
+		k.SetBlame(ctx, blame)
+		k.AddBlameToPruneQueue(ctx, blame)
+	}
+}
+
+func TestKeeper_PruneBlames(t *testing.T) {
+	t.Run("prune the blames finalized before the retention period", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := types.DefaultParams()
+		params.BlameRetentionBlocks = 100
+		k.SetParams(ctx, params)
+
+		setFinalizedBlames(k, ctx,
+			finalizedBlame("1-1", 1, 10, "pk1"),
+			finalizedBlame("1-2", 1, 59, "pk1"),
+			finalizedBlame("1-3", 1, 60, "pk1"),
+			finalizedBlame("1-4", 1, 150, "pk1"),
+		)
+
+		ctx = ctx.WithBlockHeight(160)
+		require.Equal(t, 2, k.PruneBlames(ctx))
+
+		_, found := k.GetBlame(ctx, "1-1")
+		require.False(t, found)
+		_, found = k.GetBlame(ctx, "1-2")
+		require.False(t, found)
+		_, found = k.GetBlame(ctx, "1-3")
+		require.True(t, found)
+		_, found = k.GetBlame(ctx, "1-4")
+		require.True(t, found)
+
+		// the pruned blames are removed from the queue
+		require.Equal(t, 0, k.PruneBlames(ctx))
+		ctx = ctx.WithBlockHeight(161)
+		require.Equal(t, 1, k.PruneBlames(ctx))
+		require.Len(t, k.GetAllBlame(ctx), 1)
+	})
+
+	t.Run("prune a limited number of blames per block", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := types.DefaultParams()
+		params.BlameRetentionBlocks = 100
+		k.SetParams(ctx, params)
+
+		for i := 0; i < keeper.MaxBlamesPrunedPerBlock+10; i++ {
+			setFinalizedBlames(k, ctx, finalizedBlame(fmt.Sprintf("1-%d", i), 1, 10, "pk1"))
+		}
+
+		ctx = ctx.WithBlockHeight(200)
+		require.Equal(t, keeper.MaxBlamesPrunedPerBlock, k.PruneBlames(ctx))
+		require.Equal(t, 10, k.PruneBlames(ctx))
+		require.Empty(t, k.GetAllBlame(ctx))
+	})
+
+	t.Run("keep a blame finalized again after being queued", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := types.DefaultParams()
+		params.BlameRetentionBlocks = 100
+		k.SetParams(ctx, params)
+
+		setFinalizedBlames(k, ctx, finalizedBlame("1-1", 1, 10, "pk1"), finalizedBlame("1-1", 1, 150, "pk1"))
+
+		ctx = ctx.WithBlockHeight(200)
+		require.Equal(t, 0, k.PruneBlames(ctx))
+		_, found := k.GetBlame(ctx, "1-1")
+		require.True(t, found)
+	})
+
+	t.Run("no pruning if the retention period is not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := types.DefaultParams()
+		params.BlameRetentionBlocks = 0
+		k.SetParams(ctx, params)
+
+		setFinalizedBlames(k, ctx, finalizedBlame("1-1", 1, 10, "pk1"))
+
+		ctx = ctx.WithBlockHeight(1000)
+		require.Equal(t, 0, k.PruneBlames(ctx))
+		_, found := k.GetBlame(ctx, "1-1")
+		require.True(t, found)
+	})
+}
+
+func TestKeeper_GetBlameStatistics(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	setFinalizedBlames(k, ctx,
+		finalizedBlame("1-1", 1, 10, "pk1", "pk2"),
+		finalizedBlame("1-2", 1, 20, "pk1", "pk1"),
+		finalizedBlame("2-1", 2, 30, "pk1", "pk3"),
+		finalizedBlame("2-2", 2, 40, "pk3"),
+	)
+
+	t.Run("count the blames of all chains", func(t *testing.T) {
+		require.Equal(t, []types.NodeBlameStatistics{
+			{
+				PubKey:      "pk1",
+				BlameCounts: []types.BlameCount{{ChainId: 1, Count: 2}, {ChainId: 2, Count: 1}},
+				Total:       3,
+			},
+			{
+				PubKey:      "pk3",
+				BlameCounts: []types.BlameCount{{ChainId: 2, Count: 2}},
+				Total:       2,
+			},
+			{
+				PubKey:      "pk2",
+				BlameCounts: []types.BlameCount{{ChainId: 1, Count: 1}},
+				Total:       1,
+			},
+		}, k.GetBlameStatistics(ctx, 0, 0))
+	})
+
+	t.Run("count the blames of a chain", func(t *testing.T) {
+		require.Equal(t, []types.NodeBlameStatistics{
+			{
+				PubKey:      "pk3",
+				BlameCounts: []types.BlameCount{{ChainId: 2, Count: 2}},
+				Total:       2,
+			},
+			{
+				PubKey:      "pk1",
+				BlameCounts: []types.BlameCount{{ChainId: 2, Count: 1}},
+				Total:       1,
+			},
+		}, k.GetBlameStatistics(ctx, 2, 0))
+	})
+
+	t.Run("count the blames finalized since a height", func(t *testing.T) {
+		require.Equal(t, []types.NodeBlameStatistics{
+			{
+				PubKey:      "pk1",
+				BlameCounts: []types.BlameCount{{ChainId: 1, Count: 1}, {ChainId: 2, Count: 1}},
+				Total:       2,
+			},
+			{
+				PubKey:      "pk3",
+				BlameCounts: []types.BlameCount{{ChainId: 2, Count: 2}},
+				Total:       2,
+			},
+		}, k.GetBlameStatistics(ctx, 0, 20))
+		require.Empty(t, k.GetBlameStatistics(ctx, 0, 41))
+	})
+}
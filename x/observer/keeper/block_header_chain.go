@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	cosmoserrors "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/bitcoin"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetBlockHeaderWork sets the accumulated proof-of-work of a bitcoin block header
+func (k Keeper) SetBlockHeaderWork(ctx sdk.Context, work types.BlockHeaderWork) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderWorkKeyPrefix))
+	b := k.cdc.MustMarshal(&work)
+	store.Set(work.BlockHash, b)
+}
+
+// GetBlockHeaderWork returns the accumulated proof-of-work of a bitcoin block header from its hash
+func (k Keeper) GetBlockHeaderWork(ctx sdk.Context, hash []byte) (val types.BlockHeaderWork, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BlockHeaderWorkKeyPrefix))
+	b := store.Get(hash)
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetBestChainBlockHash sets the block hash of the best header chain of a chain at a height
+func (k Keeper) SetBestChainBlockHash(ctx sdk.Context, chainID int64, height int64, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BestChainBlockHashKeyPrefix))
+	store.Set(types.BestChainBlockHashKey(chainID, height), hash)
+}
+
+// GetBestChainBlockHash returns the block hash of the best header chain of a chain at a height
+func (k Keeper) GetBestChainBlockHash(ctx sdk.Context, chainID int64, height int64) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BestChainBlockHashKeyPrefix))
+	b := store.Get(types.BestChainBlockHashKey(chainID, height))
+	return b, b != nil
+}
+
+// RemoveBestChainBlockHash removes the block hash of the best header chain of a chain at a height
+func (k Keeper) RemoveBestChainBlockHash(ctx sdk.Context, chainID int64, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BestChainBlockHashKeyPrefix))
+	store.Delete(types.BestChainBlockHashKey(chainID, height))
+}
+
+// GetBlockHeaderConfirmations returns the number of confirmations of a block header on the best header chain
+// false is returned if the block header is not on the best header chain
+func (k Keeper) GetBlockHeaderConfirmations(ctx sdk.Context, chainID int64, hash []byte) (int64, bool) {
+	header, found := k.GetBlockHeader(ctx, hash)
+	if !found || header.ChainId != chainID {
+		return 0, false
+	}
+	bestChainHash, found := k.GetBestChainBlockHash(ctx, chainID, header.Height)
+	if !found || !bytes.Equal(bestChainHash, hash) {
+		return 0, false
+	}
+	bhs, found := k.GetBlockHeaderState(ctx, chainID)
+	if !found || bhs.LatestHeight < header.Height {
+		return 0, false
+	}
+	return bhs.LatestHeight - header.Height + 1, true
+}
+
+// CheckBitcoinHeaderInChain checks a bitcoin block header extends a known block header of the header tree
+// and that its difficulty bits follow the retarget rules of the chain
+func (k Keeper) CheckBitcoinHeaderInChain(ctx sdk.Context, chainID int64, height int64, headerData common.HeaderData) error {
+	header, err := headerData.ParseBitcoinHeader()
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidDifficulty, err.Error())
+	}
+
+	// the header can extend any known header, competing branches are resolved by accumulated work
+	parent, found := k.GetBlockHeader(ctx, header.PrevBlock[:])
+	if !found || parent.ChainId != chainID {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, "parent block header not found")
+	}
+	if height != parent.Height+1 {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, fmt.Sprintf("invalid block height: wanted %d, got %d", parent.Height+1, height))
+	}
+	parentHeader, err := parent.Header.ParseBitcoinHeader()
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
+	}
+
+	chainParams, err := common.GetBTCChainParams(chainID)
+	if err != nil {
+		return cosmoserrors.Wrap(types.ErrSupportedChains, err.Error())
+	}
+	requiredBits, err := bitcoin.CalcNextRequiredDifficulty(
+		chainParams,
+		parentHeader,
+		parent.Height,
+		header.Timestamp,
+		k.bitcoinAncestorFunc(ctx, parent),
+	)
+	if errors.Is(err, bitcoin.ErrAncestorNotFound) {
+		// the retarget interval starts before the earliest known header, only check the difficulty is reachable
+		if err := bitcoin.CheckDifficultyTransition(chainParams, parentHeader.Bits, header.Bits); err != nil {
+			return cosmoserrors.Wrap(types.ErrInvalidDifficulty, err.Error())
+		}
+		return nil
+	} else if err != nil {
+		return cosmoserrors.Wrap(types.ErrInvalidDifficulty, err.Error())
+	}
+	if header.Bits != requiredBits {
+		return cosmoserrors.Wrap(
+			types.ErrInvalidDifficulty,
+			fmt.Sprintf("difficulty bits %08x differ from required difficulty bits %08x", header.Bits, requiredBits),
+		)
+	}
+	return nil
+}
+
+// bitcoinAncestorFunc returns a function looking up the ancestors of a block header by height
+// ancestors are expected to be looked up by decreasing height
+func (k Keeper) bitcoinAncestorFunc(ctx sdk.Context, tip common.BlockHeader) bitcoin.AncestorFunc {
+	cursor := tip
+	return func(height int64) (*wire.BlockHeader, bool) {
+		if height > cursor.Height {
+			cursor = tip
+		}
+		if height > cursor.Height {
+			return nil, false
+		}
+		for cursor.Height > height {
+			parent, found := k.GetBlockHeader(ctx, cursor.ParentHash)
+			if !found {
+				return nil, false
+			}
+			cursor = parent
+		}
+		header, err := cursor.Header.ParseBitcoinHeader()
+		if err != nil {
+			return nil, false
+		}
+		return header, true
+	}
+}
+
+// AddBitcoinHeaderToChain records the accumulated proof-of-work of a bitcoin block header
+// and moves the tip of the best header chain to the header if its branch has the most work
+// on equal work, the tip seen first is kept
+func (k Keeper) AddBitcoinHeaderToChain(
+	ctx sdk.Context,
+	bhs types.BlockHeaderState,
+	header common.BlockHeader,
+) (types.BlockHeaderState, error) {
+	btcHeader, err := header.Header.ParseBitcoinHeader()
+	if err != nil {
+		return bhs, err
+	}
+
+	chainWork := sdk.NewIntFromBigInt(bitcoin.CalcWork(btcHeader.Bits))
+	if parentWork, found := k.GetBlockHeaderWork(ctx, header.ParentHash); found {
+		chainWork = chainWork.Add(parentWork.ChainWork)
+	}
+	k.SetBlockHeaderWork(ctx, types.BlockHeaderWork{
+		BlockHash: header.Hash,
+		Height:    header.Height,
+		ChainWork: chainWork,
+	})
+
+	// the first header initializes the header chain
+	if bhs.EarliestHeight == 0 {
+		bhs.ChainId = header.ChainId
+		bhs.EarliestHeight = header.Height
+		bhs.LatestHeight = header.Height
+		bhs.LatestBlockHash = header.Hash
+		bhs.LatestChainWork = chainWork
+		k.SetBestChainBlockHash(ctx, header.ChainId, header.Height, header.Hash)
+		return bhs, nil
+	}
+
+	latestChainWork := bhs.LatestChainWork
+	if latestChainWork.IsNil() {
+		latestChainWork = sdk.ZeroInt()
+	}
+	if !chainWork.GT(latestChainWork) {
+		return bhs, nil
+	}
+
+	// the header becomes the new tip, the best chain index is rewritten down to the fork point
+	for height := header.Height + 1; height <= bhs.LatestHeight; height++ {
+		k.RemoveBestChainBlockHash(ctx, header.ChainId, height)
+	}
+	cursor := header
+	for {
+		bestChainHash, found := k.GetBestChainBlockHash(ctx, cursor.ChainId, cursor.Height)
+		if found && bytes.Equal(bestChainHash, cursor.Hash) {
+			break
+		}
+		k.SetBestChainBlockHash(ctx, cursor.ChainId, cursor.Height, cursor.Hash)
+		cursor, found = k.GetBlockHeader(ctx, cursor.ParentHash)
+		if !found {
+			break
+		}
+	}
+
+	if !bytes.Equal(bhs.LatestBlockHash, header.ParentHash) {
+		ctx.Logger().Info(fmt.Sprintf(
+			"header chain reorg for chain %d: tip %x at height %d replaced by tip %x at height %d",
+			header.ChainId,
+			bhs.LatestBlockHash,
+			bhs.LatestHeight,
+			header.Hash,
+			header.Height,
+		))
+	}
+	bhs.LatestHeight = header.Height
+	bhs.LatestBlockHash = header.Hash
+	bhs.LatestChainWork = chainWork
+	return bhs, nil
+}
@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// addBitcoinHeader adds a bitcoin block header to the store and to the header chain
+func addBitcoinHeader(
+	t *testing.T,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	bhs types.BlockHeaderState,
+	header common.BlockHeader,
+) types.BlockHeaderState {
+	bhs, err := k.AddBitcoinHeaderToChain(ctx, bhs, header)
+	require.NoError(t, err)
+	k.SetBlockHeaderState(ctx, bhs)
+	k.SetBlockHeader(ctx, header)
+	return bhs
+}
+
+// requireBestChain checks the best chain index of the chain from the earliest height matches the headers
+func requireBestChain(t *testing.T, ctx sdk.Context, k *keeper.Keeper, chainID int64, headers ...common.BlockHeader) {
+	for _, header := range headers {
+		hash, found := k.GetBestChainBlockHash(ctx, chainID, header.Height)
+		require.True(t, found)
+		require.Equal(t, header.Hash, hash)
+	}
+	_, found := k.GetBestChainBlockHash(ctx, chainID, headers[len(headers)-1].Height+1)
+	require.False(t, found)
+}
+
+func TestKeeper_AddBitcoinHeaderToChain(t *testing.T) {
+	chainID := common.BtcRegtestChain().ChainId
+	bits := chaincfg.RegressionNetParams.PowLimitBits
+	headerWork := sdk.NewIntFromBigInt(blockchain.CalcWork(bits))
+	timestamp := time.Unix(1700000000, 0)
+
+	t.Run("should initialize the header chain with the first header", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		header := sample.BitcoinBlockHeader(t, chainID, 100, sample.Hash().Bytes(), timestamp, bits)
+
+		bhs := addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header)
+		require.Equal(t, chainID, bhs.ChainId)
+		require.EqualValues(t, 100, bhs.EarliestHeight)
+		require.EqualValues(t, 100, bhs.LatestHeight)
+		require.Equal(t, header.Hash, bhs.LatestBlockHash)
+		require.True(t, headerWork.Equal(bhs.LatestChainWork))
+		requireBestChain(t, ctx, k, chainID, header)
+
+		work, found := k.GetBlockHeaderWork(ctx, header.Hash)
+		require.True(t, found)
+		require.EqualValues(t, 100, work.Height)
+		require.True(t, headerWork.Equal(work.ChainWork))
+	})
+
+	t.Run("should extend the best chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		header0 := sample.BitcoinBlockHeader(t, chainID, 100, sample.Hash().Bytes(), timestamp, bits)
+		header1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, bits)
+
+		bhs := addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, header1)
+		require.EqualValues(t, 100, bhs.EarliestHeight)
+		require.EqualValues(t, 101, bhs.LatestHeight)
+		require.Equal(t, header1.Hash, bhs.LatestBlockHash)
+		require.True(t, headerWork.MulRaw(2).Equal(bhs.LatestChainWork))
+		requireBestChain(t, ctx, k, chainID, header0, header1)
+	})
+
+	t.Run("should keep the first seen tip on equal work and reorg to the branch with the most work", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		header0 := sample.BitcoinBlockHeader(t, chainID, 100, sample.Hash().Bytes(), timestamp, bits)
+		headerA1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, bits)
+		headerA2 := sample.BitcoinBlockHeader(t, chainID, 102, headerA1.Hash, timestamp, bits)
+		headerB1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, bits)
+		headerB2 := sample.BitcoinBlockHeader(t, chainID, 102, headerB1.Hash, timestamp, bits)
+		headerB3 := sample.BitcoinBlockHeader(t, chainID, 103, headerB2.Hash, timestamp, bits)
+
+		bhs := addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerA1)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerA2)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerB1)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerB2)
+		require.Equal(t, headerA2.Hash, bhs.LatestBlockHash)
+		requireBestChain(t, ctx, k, chainID, header0, headerA1, headerA2)
+
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerB3)
+		require.EqualValues(t, 103, bhs.LatestHeight)
+		require.Equal(t, headerB3.Hash, bhs.LatestBlockHash)
+		require.True(t, headerWork.MulRaw(4).Equal(bhs.LatestChainWork))
+		requireBestChain(t, ctx, k, chainID, header0, headerB1, headerB2, headerB3)
+
+		// confirmations are only counted on the best chain
+		_, onBestChain := k.GetBlockHeaderConfirmations(ctx, chainID, headerA2.Hash)
+		require.False(t, onBestChain)
+		confirmations, onBestChain := k.GetBlockHeaderConfirmations(ctx, chainID, headerB1.Hash)
+		require.True(t, onBestChain)
+		require.EqualValues(t, 3, confirmations)
+		confirmations, onBestChain = k.GetBlockHeaderConfirmations(ctx, chainID, headerB3.Hash)
+		require.True(t, onBestChain)
+		require.EqualValues(t, 1, confirmations)
+		_, onBestChain = k.GetBlockHeaderConfirmations(ctx, common.BtcMainnetChain().ChainId, headerB3.Hash)
+		require.False(t, onBestChain)
+	})
+
+	t.Run("should reorg to a shorter branch with more work", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		harderBits := blockchain.BigToCompact(new(big.Int).Div(blockchain.CompactToBig(bits), big.NewInt(4)))
+		header0 := sample.BitcoinBlockHeader(t, chainID, 100, sample.Hash().Bytes(), timestamp, bits)
+		headerA1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, bits)
+		headerA2 := sample.BitcoinBlockHeader(t, chainID, 102, headerA1.Hash, timestamp, bits)
+		headerB1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, harderBits)
+
+		bhs := addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerA1)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerA2)
+		bhs = addBitcoinHeader(t, ctx, k, bhs, headerB1)
+		require.EqualValues(t, 101, bhs.LatestHeight)
+		require.Equal(t, headerB1.Hash, bhs.LatestBlockHash)
+		requireBestChain(t, ctx, k, chainID, header0, headerB1)
+	})
+}
+
+func TestKeeper_CheckBitcoinHeaderInChain(t *testing.T) {
+	regtestChainID := common.BtcRegtestChain().ChainId
+	regtestBits := chaincfg.RegressionNetParams.PowLimitBits
+	timestamp := time.Unix(1700000000, 0)
+
+	t.Run("should accept a header extending a known header", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		header0 := sample.BitcoinBlockHeader(t, regtestChainID, 100, sample.Hash().Bytes(), timestamp, regtestBits)
+		headerA1 := sample.BitcoinBlockHeader(t, regtestChainID, 101, header0.Hash, timestamp, regtestBits)
+		headerA2 := sample.BitcoinBlockHeader(t, regtestChainID, 102, headerA1.Hash, timestamp, regtestBits)
+		headerB1 := sample.BitcoinBlockHeader(t, regtestChainID, 101, header0.Hash, timestamp, regtestBits)
+		bhs := addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+		addBitcoinHeader(t, ctx, k, bhs, headerA1)
+
+		err := k.CheckBitcoinHeaderInChain(ctx, regtestChainID, headerA2.Height, headerA2.Header)
+		require.NoError(t, err)
+
+		// the header can fork from a header below the tip
+		err = k.CheckBitcoinHeaderInChain(ctx, regtestChainID, headerB1.Height, headerB1.Header)
+		require.NoError(t, err)
+	})
+
+	t.Run("should fail if the parent header is not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		header := sample.BitcoinBlockHeader(t, regtestChainID, 101, sample.Hash().Bytes(), timestamp, regtestBits)
+
+		err := k.CheckBitcoinHeaderInChain(ctx, regtestChainID, header.Height, header.Header)
+		require.ErrorIs(t, err, types.ErrNoParentHash)
+	})
+
+	t.Run("should fail if the height doesn't follow the parent height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		header0 := sample.BitcoinBlockHeader(t, regtestChainID, 100, sample.Hash().Bytes(), timestamp, regtestBits)
+		header1 := sample.BitcoinBlockHeader(t, regtestChainID, 101, header0.Hash, timestamp, regtestBits)
+		addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+
+		err := k.CheckBitcoinHeaderInChain(ctx, regtestChainID, 102, header1.Header)
+		require.ErrorIs(t, err, types.ErrNoParentHash)
+	})
+
+	t.Run("should fail if the difficulty doesn't follow the retarget rules", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		header0 := sample.BitcoinBlockHeader(t, regtestChainID, 100, sample.Hash().Bytes(), timestamp, regtestBits)
+		header1 := sample.BitcoinBlockHeader(t, regtestChainID, 101, header0.Hash, timestamp, 0x1d00ffff)
+		addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+
+		err := k.CheckBitcoinHeaderInChain(ctx, regtestChainID, header1.Height, header1.Header)
+		require.ErrorIs(t, err, types.ErrInvalidDifficulty)
+	})
+
+	t.Run("should check the difficulty is reachable if the retarget interval is not known", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := common.BtcMainnetChain().ChainId
+		bits := uint32(0x1a05db8b)
+		target := blockchain.CompactToBig(bits)
+		harderBits := blockchain.BigToCompact(new(big.Int).Div(target, big.NewInt(2)))
+		tooHardBits := blockchain.BigToCompact(new(big.Int).Div(target, big.NewInt(5)))
+
+		header0 := sample.BitcoinBlockHeader(t, chainID, 2015, sample.Hash().Bytes(), timestamp, bits)
+		headerA1 := sample.BitcoinBlockHeader(t, chainID, 2016, header0.Hash, timestamp, harderBits)
+		headerB1 := sample.BitcoinBlockHeader(t, chainID, 2016, header0.Hash, timestamp, tooHardBits)
+		addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+
+		err := k.CheckBitcoinHeaderInChain(ctx, chainID, headerA1.Height, headerA1.Header)
+		require.NoError(t, err)
+		err = k.CheckBitcoinHeaderInChain(ctx, chainID, headerB1.Height, headerB1.Header)
+		require.ErrorIs(t, err, types.ErrInvalidDifficulty)
+	})
+
+	t.Run("should compute the required difficulty from the known ancestors", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := common.BtcMainnetChain().ChainId
+		bits := uint32(0x1a05db8b)
+
+		header0 := sample.BitcoinBlockHeader(t, chainID, 2017, sample.Hash().Bytes(), timestamp, bits)
+		header1 := sample.BitcoinBlockHeader(t, chainID, 2018, header0.Hash, timestamp, bits)
+		header2 := sample.BitcoinBlockHeader(t, chainID, 2019, header1.Hash, timestamp, bits-1)
+		bhs := addBitcoinHeader(t, ctx, k, types.BlockHeaderState{}, header0)
+
+		err := k.CheckBitcoinHeaderInChain(ctx, chainID, header1.Height, header1.Header)
+		require.NoError(t, err)
+		addBitcoinHeader(t, ctx, k, bhs, header1)
+
+		err = k.CheckBitcoinHeaderInChain(ctx, chainID, header2.Height, header2.Header)
+		require.ErrorIs(t, err, types.ErrInvalidDifficulty)
+	})
+}
@@ -27,6 +27,28 @@ func (k Keeper) GetChainParamsList(ctx sdk.Context) (val types.ChainParamsList,
 	return
 }
 
+// SetChainParamsForChain updates the chain params of a chain, or adds them if the chain has no chain params
+func (k Keeper) SetChainParamsForChain(ctx sdk.Context, chainParams *types.ChainParams) {
+	// find current chain params list or initialize a new one
+	chainParamsList, found := k.GetChainParamsList(ctx)
+	if !found {
+		chainParamsList = types.ChainParamsList{}
+	}
+
+	// find chain params for the chain
+	for i, cp := range chainParamsList.ChainParams {
+		if cp.ChainId == chainParams.ChainId {
+			chainParamsList.ChainParams[i] = chainParams
+			k.SetChainParamsList(ctx, chainParamsList)
+			return
+		}
+	}
+
+	// add new chain params
+	chainParamsList.ChainParams = append(chainParamsList.ChainParams, chainParams)
+	k.SetChainParamsList(ctx, chainParamsList)
+}
+
 func (k Keeper) GetChainParamsByChainID(ctx sdk.Context, chainID int64) (*types.ChainParams, bool) {
 	allChainParams, found := k.GetChainParamsList(ctx)
 	if !found {
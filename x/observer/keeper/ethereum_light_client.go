@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetEthereumLightClient sets the beacon chain light client of a chain
+func (k Keeper) SetEthereumLightClient(ctx sdk.Context, lightClient types.EthereumLightClient) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EthereumLightClientKeyPrefix))
+	b := k.cdc.MustMarshal(&lightClient)
+	store.Set(types.EthereumLightClientKey(lightClient.ChainId), b)
+}
+
+// GetEthereumLightClient returns the beacon chain light client of a chain
+func (k Keeper) GetEthereumLightClient(ctx sdk.Context, chainID int64) (val types.EthereumLightClient, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.EthereumLightClientKeyPrefix))
+	b := store.Get(types.EthereumLightClientKey(chainID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetFinalizedBlockHash sets the execution block hash finalized by the light client of a chain at a height
+func (k Keeper) SetFinalizedBlockHash(ctx sdk.Context, chainID int64, height int64, hash []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedBlockHashKeyPrefix))
+	store.Set(types.FinalizedBlockHashKey(chainID, height), hash)
+}
+
+// GetFinalizedBlockHash returns the execution block hash finalized by the light client of a chain at a height
+func (k Keeper) GetFinalizedBlockHash(ctx sdk.Context, chainID int64, height int64) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.FinalizedBlockHashKeyPrefix))
+	b := store.Get(types.FinalizedBlockHashKey(chainID, height))
+	return b, b != nil
+}
+
+// SetFinalizedExecutionBlock sets the hash of an execution block finalized by the light client of a chain
+func (k Keeper) SetFinalizedExecutionBlock(ctx sdk.Context, chainID int64, header ethereum.ExecutionPayloadHeader) {
+	k.SetFinalizedBlockHash(ctx, chainID, int64(header.BlockNumber), header.BlockHash)
+}
+
+// CheckBlockHeaderFinalized checks an execution block header is finalized by the light client of a chain
+// the hash finalized at a height is either the execution block of a finalized beacon header
+// or the parent of a finalized block header added to the store
+func (k Keeper) CheckBlockHeaderFinalized(ctx sdk.Context, chainID int64, height int64, hash []byte) error {
+	finalizedHash, found := k.GetFinalizedBlockHash(ctx, chainID, height)
+	if !found {
+		return fmt.Errorf("no block finalized at height %d", height)
+	}
+	if !bytes.Equal(finalizedHash, hash) {
+		return fmt.Errorf("block hash %x differs from the finalized block hash %x at height %d", hash, finalizedHash, height)
+	}
+	return nil
+}
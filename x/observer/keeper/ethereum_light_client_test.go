@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_CheckBlockHeaderFinalized(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	chainID := common.SepoliaChain().ChainId
+	hash := sample.Hash().Bytes()
+	k.SetFinalizedBlockHash(ctx, chainID, 100, hash)
+
+	require.NoError(t, k.CheckBlockHeaderFinalized(ctx, chainID, 100, hash))
+	require.Error(t, k.CheckBlockHeaderFinalized(ctx, chainID, 100, sample.Hash().Bytes()))
+	require.Error(t, k.CheckBlockHeaderFinalized(ctx, chainID, 101, hash))
+	require.Error(t, k.CheckBlockHeaderFinalized(ctx, common.EthChain().ChainId, 100, hash))
+
+	_, found := k.GetEthereumLightClient(ctx, chainID)
+	require.False(t, found)
+	k.SetEthereumLightClient(ctx, types.EthereumLightClient{ChainId: chainID})
+	_, found = k.GetEthereumLightClient(ctx, chainID)
+	require.True(t, found)
+}
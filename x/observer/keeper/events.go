@@ -3,6 +3,7 @@ package keeper
 import (
 	"strconv"
 
+	"cosmossdk.io/math"
 	types2 "github.com/coinbase/rosetta-sdk-go/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -44,3 +45,47 @@ func EmitEventAddObserver(ctx sdk.Context, observerCount uint64, operatorAddress
 		ctx.Logger().Error("Error emitting EmitEventAddObserver :", err)
 	}
 }
+
+func EmitEventObserverJailed(ctx sdk.Context, liveness types.ObserverLiveness, jailedUntil int64, slashedAmount math.Int) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverJailed{
+		ObserverAddress:     liveness.ObserverAddress,
+		WindowBallots:       liveness.WindowBallots,
+		WindowMissedBallots: liveness.WindowMissedBallots,
+		JailedUntil:         jailedUntil,
+		SlashedAmount:       slashedAmount.String(),
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverJailed :", err)
+	}
+}
+
+func EmitEventObserverUnjailed(ctx sdk.Context, observerAddress string) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventObserverUnjailed{
+		MsgTypeUrl:      sdk.MsgTypeURL(&types.MsgUnjailObserver{}),
+		ObserverAddress: observerAddress,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventObserverUnjailed :", err)
+	}
+}
+
+func EmitEventChainParamsScheduled(ctx sdk.Context, pending types.PendingChainParams) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventChainParamsScheduled{
+		MsgTypeUrl:       sdk.MsgTypeURL(&types.MsgUpdateChainParams{}),
+		ChainId:          pending.ChainParams.ChainId,
+		ActivationHeight: pending.ActivationHeight,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainParamsScheduled :", err)
+	}
+}
+
+func EmitEventChainParamsActivated(ctx sdk.Context, pending types.PendingChainParams) {
+	err := ctx.EventManager().EmitTypedEvents(&types.EventChainParamsActivated{
+		ChainId:          pending.ChainParams.ChainId,
+		ActivationHeight: pending.ActivationHeight,
+	})
+	if err != nil {
+		ctx.Logger().Error("Error emitting EventChainParamsActivated :", err)
+	}
+}
@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -53,3 +56,177 @@ func (k Keeper) BallotByIdentifier(goCtx context.Context, req *types.QueryBallot
 		BallotStatus:     ballot.BallotStatus,
 	}, nil
 }
+
+// BallotsByHeight returns the ballots created between the min and max heights in the order of their creation,
+// up to the current height if max height is zero
+// the ballots are read from the ballot lists of the heights of the range instead of iterating all the ballots
+func (k Keeper) BallotsByHeight(
+	goCtx context.Context,
+	req *types.QueryBallotsByHeightRequest,
+) (*types.QueryBallotsByHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.MaxHeight != 0 && req.MaxHeight < req.MinHeight {
+		return nil, status.Error(codes.InvalidArgument, "max height must be greater than min height")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	maxHeight := req.MaxHeight
+	if maxHeight == 0 {
+		maxHeight = ctx.BlockHeight()
+	}
+	ballots, pageRes, err := k.paginateBallotsByHeight(ctx, req.Pagination, req.MinHeight, maxHeight)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryBallotsByHeightResponse{Ballots: ballots, Pagination: pageRes}, nil
+}
+
+// BallotsByVoter returns the ballots the voter is part of and has voted or has not voted on
+func (k Keeper) BallotsByVoter(
+	goCtx context.Context,
+	req *types.QueryBallotsByVoterRequest,
+) (*types.QueryBallotsByVoterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.VoterAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid voter address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ballots, pageRes, err := k.paginateBallots(ctx, req.Pagination, func(ballot types.Ballot) bool {
+		index := ballot.GetVoterIndex(req.VoterAddress)
+		if index == -1 || index >= len(ballot.Votes) {
+			return false
+		}
+		return (ballot.Votes[index] != types.VoteType_NotYetVoted) == req.HasVoted
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBallotsByVoterResponse{Ballots: ballots, Pagination: pageRes}, nil
+}
+
+// BallotsByStatus returns the ballots with the status, for all observation types if the observation type is empty
+func (k Keeper) BallotsByStatus(
+	goCtx context.Context,
+	req *types.QueryBallotsByStatusRequest,
+) (*types.QueryBallotsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ballots, pageRes, err := k.paginateBallots(ctx, req.Pagination, func(ballot types.Ballot) bool {
+		if ballot.BallotStatus != req.BallotStatus {
+			return false
+		}
+		return req.ObservationType == types.ObservationType_EmptyObserverType ||
+			ballot.ObservationType == req.ObservationType
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBallotsByStatusResponse{Ballots: ballots, Pagination: pageRes}, nil
+}
+
+// paginateBallots paginates over the ballots of the store matching the filter
+func (k Keeper) paginateBallots(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+	filter func(ballot types.Ballot) bool,
+) ([]types.Ballot, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.VoterKey))
+
+	var ballots []types.Ballot
+	pageRes, err := query.FilteredPaginate(store, pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var ballot types.Ballot
+		if err := k.cdc.Unmarshal(value, &ballot); err != nil {
+			return false, err
+		}
+		if !filter(ballot) {
+			return false, nil
+		}
+		if accumulate {
+			ballots = append(ballots, ballot)
+		}
+		return true, nil
+	})
+	return ballots, pageRes, err
+}
+
+// paginateBallotsByHeight paginates over the ballot lists of the heights between the min and max heights
+// the pagination key is the height and the position of the ballot in the ballot list of the height
+func (k Keeper) paginateBallotsByHeight(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+	minHeight int64,
+	maxHeight int64,
+) ([]types.Ballot, *query.PageResponse, error) {
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	}
+	if pagination.Offset > 0 && pagination.Key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	limit := pagination.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pagination.CountTotal && pagination.Key == nil
+
+	startHeight, startPosition := minHeight, uint64(0)
+	if pagination.Key != nil {
+		if len(pagination.Key) != 16 {
+			return nil, nil, fmt.Errorf("invalid pagination key")
+		}
+		// #nosec G701 the key is a height set from a previous page
+		startHeight = int64(sdk.BigEndianToUint64(pagination.Key[:8]))
+		startPosition = sdk.BigEndianToUint64(pagination.Key[8:])
+		if startHeight < minHeight {
+			return nil, nil, fmt.Errorf("invalid pagination key")
+		}
+	}
+
+	var (
+		ballots []types.Ballot
+		nextKey []byte
+		count   uint64
+	)
+	for height := startHeight; height <= maxHeight; height++ {
+		list, found := k.GetBallotList(ctx, height)
+		if !found {
+			continue
+		}
+		position := uint64(0)
+		if height == startHeight {
+			position = startPosition
+		}
+		for ; position < uint64(len(list.BallotsIndexList)); position++ {
+			ballot, found := k.GetBallot(ctx, list.BallotsIndexList[position])
+			if !found {
+				continue
+			}
+			count++
+			switch {
+			case count <= pagination.Offset:
+			case count <= pagination.Offset+limit:
+				ballots = append(ballots, ballot)
+			case nextKey == nil:
+				// #nosec G701 always positive
+				nextKey = append(sdk.Uint64ToBigEndian(uint64(height)), sdk.Uint64ToBigEndian(position)...)
+			}
+			if nextKey != nil && !countTotal {
+				return ballots, &query.PageResponse{NextKey: nextKey}, nil
+			}
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+	return ballots, pageRes, nil
+}
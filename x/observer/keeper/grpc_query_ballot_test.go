@@ -0,0 +1,277 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// setBallots sets the ballots in the store
+func setBallots(ctx sdk.Context, k *keeper.Keeper, ballots []types.Ballot) {
+	for _, ballot := range ballots {
+		ballot := ballot
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+	}
+}
+
+// ballotIdentifiers returns the identifiers of the ballots
+func ballotIdentifiers(ballots []types.Ballot) []string {
+	identifiers := make([]string, len(ballots))
+	for i, ballot := range ballots {
+		identifiers[i] = ballot.BallotIdentifier
+	}
+	return identifiers
+}
+
+func TestKeeper_BallotsByHeight(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.BallotsByHeight(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if max height is lower than min height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.BallotsByHeight(sdk.WrapSDKContext(ctx), &types.QueryBallotsByHeightRequest{
+			MinHeight: 10,
+			MaxHeight: 5,
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the ballots created in the height range", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(20)
+		wctx := sdk.WrapSDKContext(ctx)
+		setBallots(ctx, k, []types.Ballot{
+			{BallotIdentifier: "a", BallotCreationHeight: 1},
+			{BallotIdentifier: "b", BallotCreationHeight: 5},
+			{BallotIdentifier: "c", BallotCreationHeight: 10},
+			{BallotIdentifier: "d", BallotCreationHeight: 15},
+		})
+
+		res, err := k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{
+			MinHeight: 5,
+			MaxHeight: 10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c"}, ballotIdentifiers(res.Ballots))
+
+		// up to the current height if max height is zero
+		res, err = k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{
+			MinHeight: 5,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c", "d"}, ballotIdentifiers(res.Ballots))
+	})
+
+	t.Run("should return the ballots in the order of their creation", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(20)
+		wctx := sdk.WrapSDKContext(ctx)
+		setBallots(ctx, k, []types.Ballot{
+			{BallotIdentifier: "d", BallotCreationHeight: 1},
+			{BallotIdentifier: "c", BallotCreationHeight: 2},
+			{BallotIdentifier: "a", BallotCreationHeight: 2},
+			{BallotIdentifier: "b", BallotCreationHeight: 10},
+		})
+		k.RemoveBallot(ctx, "c")
+
+		res, err := k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []string{"d", "a", "b"}, ballotIdentifiers(res.Ballots))
+	})
+
+	t.Run("should paginate the ballots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(20)
+		wctx := sdk.WrapSDKContext(ctx)
+		setBallots(ctx, k, []types.Ballot{
+			{BallotIdentifier: "a", BallotCreationHeight: 1},
+			{BallotIdentifier: "b", BallotCreationHeight: 5},
+			{BallotIdentifier: "c", BallotCreationHeight: 10},
+			{BallotIdentifier: "d", BallotCreationHeight: 15},
+		})
+
+		res, err := k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{
+			MinHeight:  5,
+			Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b", "c"}, ballotIdentifiers(res.Ballots))
+		require.EqualValues(t, 3, res.Pagination.Total)
+
+		res, err = k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{
+			MinHeight:  5,
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"d"}, ballotIdentifiers(res.Ballots))
+		require.Nil(t, res.Pagination.NextKey)
+
+		res, err = k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{
+			MinHeight:  5,
+			Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"c"}, ballotIdentifiers(res.Ballots))
+		require.NotNil(t, res.Pagination.NextKey)
+	})
+
+	t.Run("should paginate the ballots of a height across pages", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		ctx = ctx.WithBlockHeight(20)
+		wctx := sdk.WrapSDKContext(ctx)
+		setBallots(ctx, k, []types.Ballot{
+			{BallotIdentifier: "a", BallotCreationHeight: 5},
+			{BallotIdentifier: "b", BallotCreationHeight: 5},
+			{BallotIdentifier: "c", BallotCreationHeight: 5},
+		})
+
+		res, err := k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{
+			Pagination: &query.PageRequest{Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, ballotIdentifiers(res.Ballots))
+
+		res, err = k.BallotsByHeight(wctx, &types.QueryBallotsByHeightRequest{
+			Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"c"}, ballotIdentifiers(res.Ballots))
+	})
+
+	t.Run("should error if the pagination key is invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		_, err := k.BallotsByHeight(sdk.WrapSDKContext(ctx), &types.QueryBallotsByHeightRequest{
+			Pagination: &query.PageRequest{Key: []byte("invalid")},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestKeeper_BallotsByVoter(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.BallotsByVoter(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if voter address is invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.BallotsByVoter(sdk.WrapSDKContext(ctx), &types.QueryBallotsByVoterRequest{
+			VoterAddress: "invalid",
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the ballots the voter has voted or has not voted on", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		voter := sample.AccAddress()
+		other := sample.AccAddress()
+		setBallots(ctx, k, []types.Ballot{
+			{
+				BallotIdentifier: "a",
+				VoterList:        []string{voter, other},
+				Votes:            []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted},
+			},
+			{
+				BallotIdentifier: "b",
+				VoterList:        []string{voter, other},
+				Votes:            []types.VoteType{types.VoteType_NotYetVoted, types.VoteType_SuccessObservation},
+			},
+			{
+				BallotIdentifier: "c",
+				VoterList:        []string{other},
+				Votes:            []types.VoteType{types.VoteType_NotYetVoted},
+			},
+			{
+				BallotIdentifier: "d",
+				VoterList:        []string{other, voter},
+				Votes:            []types.VoteType{types.VoteType_NotYetVoted, types.VoteType_FailureObservation},
+			},
+		})
+
+		res, err := k.BallotsByVoter(wctx, &types.QueryBallotsByVoterRequest{
+			VoterAddress: voter,
+			HasVoted:     true,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "d"}, ballotIdentifiers(res.Ballots))
+
+		res, err = k.BallotsByVoter(wctx, &types.QueryBallotsByVoterRequest{
+			VoterAddress: voter,
+			HasVoted:     false,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b"}, ballotIdentifiers(res.Ballots))
+	})
+}
+
+func TestKeeper_BallotsByStatus(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.BallotsByStatus(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the ballots with the status and observation type", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		setBallots(ctx, k, []types.Ballot{
+			{
+				BallotIdentifier: "a",
+				ObservationType:  types.ObservationType_InBoundTx,
+				BallotStatus:     types.BallotStatus_BallotInProgress,
+			},
+			{
+				BallotIdentifier: "b",
+				ObservationType:  types.ObservationType_OutBoundTx,
+				BallotStatus:     types.BallotStatus_BallotInProgress,
+			},
+			{
+				BallotIdentifier: "c",
+				ObservationType:  types.ObservationType_InBoundTx,
+				BallotStatus:     types.BallotStatus_BallotFinalized_SuccessObservation,
+			},
+		})
+
+		res, err := k.BallotsByStatus(wctx, &types.QueryBallotsByStatusRequest{
+			BallotStatus: types.BallotStatus_BallotInProgress,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, ballotIdentifiers(res.Ballots))
+
+		res, err = k.BallotsByStatus(wctx, &types.QueryBallotsByStatusRequest{
+			BallotStatus:    types.BallotStatus_BallotInProgress,
+			ObservationType: types.ObservationType_OutBoundTx,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"b"}, ballotIdentifiers(res.Ballots))
+
+		res, err = k.BallotsByStatus(wctx, &types.QueryBallotsByStatusRequest{
+			BallotStatus: types.BallotStatus_BallotFinalized_FailureObservation,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.Ballots)
+	})
+}
@@ -53,3 +53,25 @@ func (k Keeper) BlamesByChainAndNonce(goCtx context.Context, request *types.Quer
 		BlameInfo: blameRecords,
 	}, nil
 }
+
+// BlameStatistics returns the number of times the nodes were blamed per chain in the recent blocks
+func (k Keeper) BlameStatistics(goCtx context.Context, request *types.QueryBlameStatisticsRequest) (*types.QueryBlameStatisticsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if request.WindowBlocks < 0 {
+		return nil, status.Error(codes.InvalidArgument, "negative window")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the window includes the current block, all the retained blames are counted if the window is not set
+	fromHeight := int64(0)
+	if request.WindowBlocks > 0 && ctx.BlockHeight() >= request.WindowBlocks {
+		fromHeight = ctx.BlockHeight() - request.WindowBlocks + 1
+	}
+
+	return &types.QueryBlameStatisticsResponse{
+		NodeStatistics: k.GetBlameStatistics(ctx, request.ChainId, fromHeight),
+		FromHeight:     fromHeight,
+	}, nil
+}
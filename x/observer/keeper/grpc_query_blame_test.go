@@ -108,3 +108,55 @@ func TestKeeper_BlameAll(t *testing.T) {
 		require.Len(t, rst, 0)
 	})
 }
+
+func TestKeeper_BlameStatistics(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		_, err := k.BlameStatistics(ctx, nil)
+		require.Error(t, err)
+		_, err = k.BlameStatistics(ctx, &types.QueryBlameStatisticsRequest{WindowBlocks: -1})
+		require.Error(t, err)
+	})
+
+	t.Run("count the blames in the window", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		setFinalizedBlames(k, ctx,
+			finalizedBlame("1-1", 1, 10, "pk1"),
+			finalizedBlame("1-2", 1, 91, "pk1", "pk2"),
+			finalizedBlame("2-1", 2, 100, "pk2"),
+		)
+		ctx = ctx.WithBlockHeight(100)
+
+		res, err := k.BlameStatistics(ctx, &types.QueryBlameStatisticsRequest{WindowBlocks: 10})
+		require.NoError(t, err)
+		require.Equal(t, int64(91), res.FromHeight)
+		require.Equal(t, []types.NodeBlameStatistics{
+			{
+				PubKey:      "pk2",
+				BlameCounts: []types.BlameCount{{ChainId: 1, Count: 1}, {ChainId: 2, Count: 1}},
+				Total:       2,
+			},
+			{
+				PubKey:      "pk1",
+				BlameCounts: []types.BlameCount{{ChainId: 1, Count: 1}},
+				Total:       1,
+			},
+		}, res.NodeStatistics)
+
+		res, err = k.BlameStatistics(ctx, &types.QueryBlameStatisticsRequest{ChainId: 1})
+		require.NoError(t, err)
+		require.Equal(t, int64(0), res.FromHeight)
+		require.Equal(t, []types.NodeBlameStatistics{
+			{
+				PubKey:      "pk1",
+				BlameCounts: []types.BlameCount{{ChainId: 1, Count: 2}},
+				Total:       2,
+			},
+			{
+				PubKey:      "pk2",
+				BlameCounts: []types.BlameCount{{ChainId: 1, Count: 1}},
+				Total:       1,
+			},
+		}, res.NodeStatistics)
+	})
+}
@@ -44,3 +44,37 @@ func (k Keeper) GetChainParams(
 		ChainParams: &chainParams,
 	}, nil
 }
+
+// PendingChainParams returns the chain params scheduled to be applied at an activation height
+func (k Keeper) PendingChainParams(
+	goCtx context.Context,
+	req *types.QueryPendingChainParamsRequest,
+) (*types.QueryPendingChainParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPendingChainParamsResponse{
+		PendingChainParams: k.GetAllPendingChainParams(ctx),
+	}, nil
+}
+
+// PendingChainParamsForChain returns the chain params scheduled to be applied at an activation height for a chain
+func (k Keeper) PendingChainParamsForChain(
+	goCtx context.Context,
+	req *types.QueryPendingChainParamsForChainRequest,
+) (*types.QueryPendingChainParamsForChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pending, found := k.GetPendingChainParams(ctx, req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "pending chain params not found")
+	}
+	return &types.QueryPendingChainParamsForChainResponse{
+		PendingChainParams: pending,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EthereumLightClient queries the beacon chain light client of an Ethereum chain
+func (k Keeper) EthereumLightClient(
+	c context.Context,
+	req *types.QueryEthereumLightClientRequest,
+) (*types.QueryEthereumLightClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	lightClient, found := k.GetEthereumLightClient(sdk.UnwrapSDKContext(c), req.ChainId)
+	if !found {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("not found: chain id %d", req.ChainId))
+	}
+
+	return &types.QueryEthereumLightClientResponse{LightClient: lightClient}, nil
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_EthereumLightClient(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.EthereumLightClient(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.EthereumLightClient(sdk.WrapSDKContext(ctx), &types.QueryEthereumLightClientRequest{
+			ChainId: chainID,
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the light client of the chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		lightClient := types.EthereumLightClient{ChainId: chainID}
+		lightClient.Store.FinalizedHeader.Beacon.Slot = 42
+		k.SetEthereumLightClient(ctx, lightClient)
+
+		res, err := k.EthereumLightClient(sdk.WrapSDKContext(ctx), &types.QueryEthereumLightClientRequest{
+			ChainId: chainID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, lightClient, res.LightClient)
+	})
+}
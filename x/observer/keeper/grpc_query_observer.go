@@ -41,3 +41,16 @@ func (k Keeper) ObserverSet(goCtx context.Context, req *types.QueryObserverSet)
 	}, nil
 
 }
+
+// ObserverChains returns the chains an observer is assigned to, an empty list is returned if the observer is assigned to all the chains
+func (k Keeper) ObserverChains(goCtx context.Context, req *types.QueryObserverChainsRequest) (*types.QueryObserverChainsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	observerChains, _ := k.GetObserverChains(ctx, req.ObserverAddress)
+	return &types.QueryObserverChainsResponse{
+		ChainIds: observerChains.ChainIds,
+	}, nil
+}
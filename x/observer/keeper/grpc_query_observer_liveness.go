@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ObserverLiveness returns the liveness state of an observer
+func (k Keeper) ObserverLiveness(
+	c context.Context,
+	req *types.QueryObserverLivenessRequest,
+) (*types.QueryObserverLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	liveness, found := k.GetObserverLiveness(ctx, req.ObserverAddress)
+	if !found {
+		return nil, status.Error(codes.NotFound, "observer liveness not found")
+	}
+	return &types.QueryObserverLivenessResponse{ObserverLiveness: liveness}, nil
+}
+
+// JailedObservers returns the liveness state of the jailed observers
+func (k Keeper) JailedObservers(
+	c context.Context,
+	req *types.QueryJailedObserversRequest,
+) (*types.QueryJailedObserversResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKeyPrefix))
+
+	var jailed []types.ObserverLiveness
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var liveness types.ObserverLiveness
+		if err := k.cdc.Unmarshal(value, &liveness); err != nil {
+			return false, err
+		}
+		if !liveness.Jailed {
+			return false, nil
+		}
+		if accumulate {
+			jailed = append(jailed, liveness)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryJailedObserversResponse{ObserverLiveness: jailed, Pagination: pageRes}, nil
+}
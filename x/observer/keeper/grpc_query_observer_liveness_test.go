@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_ObserverLiveness(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLiveness(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if not found", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.ObserverLiveness(sdk.WrapSDKContext(ctx), &types.QueryObserverLivenessRequest{
+			ObserverAddress: sample.AccAddress(),
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the liveness of the observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		liveness := types.ObserverLiveness{
+			ObserverAddress:     sample.AccAddress(),
+			WindowBallots:       10,
+			WindowMissedBallots: 2,
+		}
+		k.SetObserverLiveness(ctx, liveness)
+
+		res, err := k.ObserverLiveness(sdk.WrapSDKContext(ctx), &types.QueryObserverLivenessRequest{
+			ObserverAddress: liveness.ObserverAddress,
+		})
+		require.NoError(t, err)
+		require.Equal(t, liveness, res.ObserverLiveness)
+	})
+}
+
+func TestKeeper_JailedObservers(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.JailedObservers(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the jailed observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		jailed := types.ObserverLiveness{
+			ObserverAddress: sample.AccAddress(),
+			Jailed:          true,
+			JailedUntil:     100,
+		}
+		k.SetObserverLiveness(ctx, jailed)
+		k.SetObserverLiveness(ctx, types.ObserverLiveness{ObserverAddress: sample.AccAddress()})
+
+		res, err := k.JailedObservers(sdk.WrapSDKContext(ctx), &types.QueryJailedObserversRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []types.ObserverLiveness{jailed}, res.ObserverLiveness)
+	})
+}
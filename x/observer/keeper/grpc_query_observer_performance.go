@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ObserverPerformance returns the participation counters of an observer, for all chains or for a given chain
+func (k Keeper) ObserverPerformance(
+	c context.Context,
+	req *types.QueryObserverPerformanceRequest,
+) (*types.QueryObserverPerformanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.ObserverAddress); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid observer address")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverPerformanceKeyPrefix))
+	performanceStore := prefix.NewStore(store, types.ObserverPerformancePrefix(req.ObserverAddress, req.ChainId))
+
+	var performances []types.ObserverPerformance
+	pageRes, err := query.Paginate(performanceStore, req.Pagination, func(_ []byte, value []byte) error {
+		var performance types.ObserverPerformance
+		if err := k.cdc.Unmarshal(value, &performance); err != nil {
+			return err
+		}
+		performances = append(performances, performance)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryObserverPerformanceResponse{ObserverPerformances: performances, Pagination: pageRes}, nil
+}
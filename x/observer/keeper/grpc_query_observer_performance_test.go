@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_ObserverPerformance(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ObserverPerformance(wctx, nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should error if observer address is invalid", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+
+		res, err := k.ObserverPerformance(wctx, &types.QueryObserverPerformanceRequest{
+			ObserverAddress: "invalid",
+		})
+		require.Nil(t, res)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the counters of the observer for all chains or for a chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		wctx := sdk.WrapSDKContext(ctx)
+		observer := sample.AccAddress()
+		performances := []types.ObserverPerformance{
+			{ObserverAddress: observer, ChainId: 1, ObservationType: types.ObservationType_InBoundTx, BallotsEligible: 1},
+			{ObserverAddress: observer, ChainId: 1, ObservationType: types.ObservationType_OutBoundTx, BallotsEligible: 2},
+			{ObserverAddress: observer, ChainId: 10, ObservationType: types.ObservationType_InBoundTx, BallotsEligible: 3},
+			{ObserverAddress: sample.AccAddress(), ChainId: 1, ObservationType: types.ObservationType_InBoundTx, BallotsEligible: 4},
+		}
+		for _, performance := range performances {
+			k.SetObserverPerformance(ctx, performance)
+		}
+
+		res, err := k.ObserverPerformance(wctx, &types.QueryObserverPerformanceRequest{
+			ObserverAddress: observer,
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, performances[:3], res.ObserverPerformances)
+
+		res, err = k.ObserverPerformance(wctx, &types.QueryObserverPerformanceRequest{
+			ObserverAddress: observer,
+			ChainId:         1,
+		})
+		require.NoError(t, err)
+		require.ElementsMatch(t, performances[:2], res.ObserverPerformances)
+
+		res, err = k.ObserverPerformance(wctx, &types.QueryObserverPerformanceRequest{
+			ObserverAddress: observer,
+			ChainId:         5,
+		})
+		require.NoError(t, err)
+		require.Empty(t, res.ObserverPerformances)
+	})
+}
@@ -2,12 +2,16 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	v10 "github.com/zeta-chain/zetacore/x/observer/migrations/v10"
+	v11 "github.com/zeta-chain/zetacore/x/observer/migrations/v11"
 	v2 "github.com/zeta-chain/zetacore/x/observer/migrations/v2"
 	v3 "github.com/zeta-chain/zetacore/x/observer/migrations/v3"
 	v4 "github.com/zeta-chain/zetacore/x/observer/migrations/v4"
 	v5 "github.com/zeta-chain/zetacore/x/observer/migrations/v5"
 	v6 "github.com/zeta-chain/zetacore/x/observer/migrations/v6"
 	v7 "github.com/zeta-chain/zetacore/x/observer/migrations/v7"
+	v8 "github.com/zeta-chain/zetacore/x/observer/migrations/v8"
+	v9 "github.com/zeta-chain/zetacore/x/observer/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -48,3 +52,23 @@ func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 func (m Migrator) Migrate6to7(ctx sdk.Context) error {
 	return v7.MigrateStore(ctx, m.observerKeeper)
 }
+
+// Migrate7to8 migrates the store from consensus version 7 to 8
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.observerKeeper)
+}
+
+// Migrate8to9 migrates the store from consensus version 8 to 9
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.observerKeeper)
+}
+
+// Migrate9to10 migrates the store from consensus version 9 to 10
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateStore(ctx, m.observerKeeper)
+}
+
+// Migrate10to11 migrates the store from consensus version 10 to 11
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	return v11.MigrateStore(ctx, m.observerKeeper)
+}
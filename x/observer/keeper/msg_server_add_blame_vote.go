@@ -22,6 +22,9 @@ func (k msgServer) AddBlameVote(goCtx context.Context, vote *types.MsgAddBlameVo
 	if ok := k.IsAuthorized(ctx, vote.Creator); !ok {
 		return nil, types.ErrNotAuthorizedPolicy
 	}
+	if !k.IsObserverAssignedToChain(ctx, vote.Creator, vote.ChainId) {
+		return nil, types.ErrObserverNotAssignedToChain
+	}
 
 	index := vote.Digest()
 	// Add votes and Set Ballot
@@ -51,6 +54,10 @@ func (k msgServer) AddBlameVote(goCtx context.Context, vote *types.MsgAddBlameVo
 	// below only happens when ballot is finalized: exactly when threshold vote is in
 	// ******************************************************************************
 
-	k.SetBlame(ctx, vote.BlameInfo)
+	blame := vote.BlameInfo
+	blame.ChainId = vote.ChainId
+	blame.FinalizedHeight = ctx.BlockHeight()
+	k.SetBlame(ctx, blame)
+	k.AddBlameToPruneQueue(ctx, blame)
 	return &types.MsgAddBlameVoteResponse{}, nil
 }
@@ -11,6 +11,9 @@ import (
 )
 
 // AddBlockHeader handles adding a block header to the store, through majority voting of observers
+// Bitcoin block headers form a header tree: a header can extend any known header if its difficulty follows the retarget rules,
+// the tip of the header chain is the header with the most accumulated work.
+// Ethereum block headers of a chain tracked by a beacon chain light client are only accepted if finalized by the light client.
 func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockHeader) (*types.MsgAddBlockHeaderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -23,6 +26,9 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	if ok := k.IsAuthorized(ctx, msg.Creator); !ok {
 		return nil, types.ErrNotAuthorizedPolicy
 	}
+	if !k.IsObserverAssignedToChain(ctx, msg.Creator, msg.ChainId) {
+		return nil, types.ErrObserverNotAssignedToChain
+	}
 
 	crosschainFlags, found := k.GetCrosschainFlags(ctx)
 	if !found {
@@ -31,11 +37,9 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	if crosschainFlags.BlockHeaderVerificationFlags == nil {
 		return nil, fmt.Errorf("block header verification flags not found")
 	}
-	if common.IsBitcoinChain(msg.ChainId) && !crosschainFlags.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled {
-		return nil, cosmoserrors.Wrapf(types.ErrBlockHeaderVerificationDisabled, "proof verification not enabled for bitcoin ,chain id: %d", msg.ChainId)
-	}
-	if common.IsEVMChain(msg.ChainId) && !crosschainFlags.BlockHeaderVerificationFlags.IsEthTypeChainEnabled {
-		return nil, cosmoserrors.Wrapf(types.ErrBlockHeaderVerificationDisabled, "proof verification not enabled for evm ,chain id: %d", msg.ChainId)
+	if (common.IsBitcoinChain(msg.ChainId) || common.IsEVMChain(msg.ChainId)) &&
+		!crosschainFlags.BlockHeaderVerificationFlags.IsChainEnabled(msg.ChainId) {
+		return nil, cosmoserrors.Wrapf(types.ErrBlockHeaderVerificationDisabled, "proof verification not enabled for chain id: %d", msg.ChainId)
 	}
 
 	_, found = k.GetBlockHeader(ctx, msg.BlockHash)
@@ -48,8 +52,20 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	// the Earliest/Latest height with this block header (after voting, not here)
 	// if BlockHeaderState is found, check if the block height is valid
 	// validate block height as it's not part of the header itself
+	// bitcoin block headers form a tree, their parent and difficulty are checked against the known headers
+	// ethereum block headers of a chain tracked by a beacon chain light client must be finalized by the light client
+	// they don't need to extend the latest block header, the parent of a finalized header can be added
+	_, hasLightClient := k.GetEthereumLightClient(ctx, msg.ChainId)
 	bhs, found := k.Keeper.GetBlockHeaderState(ctx, msg.ChainId)
-	if found && bhs.EarliestHeight > 0 && bhs.EarliestHeight < msg.Height {
+	if hasLightClient {
+		if err := k.CheckBlockHeaderFinalized(ctx, msg.ChainId, msg.Height, msg.BlockHash); err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrBlockNotFinalized, err.Error())
+		}
+	} else if found && bhs.EarliestHeight > 0 && common.IsBitcoinChain(msg.ChainId) {
+		if err := k.CheckBitcoinHeaderInChain(ctx, msg.ChainId, msg.Height, msg.Header); err != nil {
+			return nil, err
+		}
+	} else if found && bhs.EarliestHeight > 0 && bhs.EarliestHeight < msg.Height {
 		pHash, err := msg.Header.ParentHash()
 		if err != nil {
 			return nil, cosmoserrors.Wrap(types.ErrNoParentHash, err.Error())
@@ -92,8 +108,35 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 	/**
 	 * Vote finalized, add block header to store
 	 */
+	bh := common.BlockHeader{
+		Header:     msg.Header,
+		Height:     msg.Height,
+		Hash:       msg.BlockHash,
+		ParentHash: pHash,
+		ChainId:    msg.ChainId,
+	}
+
 	bhs, found = k.Keeper.GetBlockHeaderState(ctx, msg.ChainId)
-	if !found {
+	if common.IsBitcoinChain(msg.ChainId) {
+		// the tip of the bitcoin header chain is the header with the most accumulated work
+		bhs, err = k.AddBitcoinHeaderToChain(ctx, bhs, bh)
+		if err != nil {
+			return nil, cosmoserrors.Wrap(types.ErrInvalidDifficulty, err.Error())
+		}
+	} else if hasLightClient {
+		// the parent of a finalized block header is finalized
+		if _, parentFinalized := k.GetFinalizedBlockHash(ctx, msg.ChainId, msg.Height-1); !parentFinalized {
+			k.SetFinalizedBlockHash(ctx, msg.ChainId, msg.Height-1, pHash)
+		}
+		if !found || msg.Height > bhs.LatestHeight {
+			bhs.ChainId = msg.ChainId
+			bhs.LatestHeight = msg.Height
+			bhs.LatestBlockHash = msg.BlockHash
+		}
+		if !found || bhs.EarliestHeight == 0 || msg.Height < bhs.EarliestHeight {
+			bhs.EarliestHeight = msg.Height
+		}
+	} else if !found {
 		bhs = types.BlockHeaderState{
 			ChainId:         msg.ChainId,
 			LatestHeight:    msg.Height,
@@ -110,14 +153,6 @@ func (k msgServer) AddBlockHeader(goCtx context.Context, msg *types.MsgAddBlockH
 		}
 	}
 	k.Keeper.SetBlockHeaderState(ctx, bhs)
-
-	bh := common.BlockHeader{
-		Header:     msg.Header,
-		Height:     msg.Height,
-		Hash:       msg.BlockHash,
-		ParentHash: pHash,
-		ChainId:    msg.ChainId,
-	}
 	k.SetBlockHeader(ctx, bh)
 
 	return &types.MsgAddBlockHeaderResponse{}, nil
@@ -5,9 +5,11 @@ import (
 	"math/rand"
 	"os"
 	"testing"
+	"time"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/require"
@@ -38,6 +40,7 @@ func TestMsgServer_AddBlockHeader(t *testing.T) {
 		msg                   *types.MsgAddBlockHeader
 		IsEthTypeChainEnabled bool
 		IsBtcTypeChainEnabled bool
+		IsBscTypeChainEnabled bool
 		validator             stakingtypes.Validator
 		wantErr               require.ErrorAssertionFunc
 	}{
@@ -71,6 +74,23 @@ func TestMsgServer_AddBlockHeader(t *testing.T) {
 				require.ErrorIs(t, err, types.ErrBlockHeaderVerificationDisabled)
 			},
 		},
+		{
+			name: "failure submit bsc header bsc disabled",
+			msg: &types.MsgAddBlockHeader{
+				Creator:   observerAddress.String(),
+				ChainId:   common.BscTestnetChain().ChainId,
+				BlockHash: header.Hash().Bytes(),
+				Height:    1,
+				Header:    common.NewEthereumHeader(header1RLP),
+			},
+			IsEthTypeChainEnabled: true,
+			IsBtcTypeChainEnabled: true,
+			IsBscTypeChainEnabled: false,
+			validator:             validator,
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				require.ErrorIs(t, err, types.ErrBlockHeaderVerificationDisabled)
+			},
+		},
 		{
 			name: "failure submit eth header eth disabled",
 			msg: &types.MsgAddBlockHeader{
@@ -169,10 +189,11 @@ func TestMsgServer_AddBlockHeader(t *testing.T) {
 				BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
 					IsEthTypeChainEnabled: tc.IsEthTypeChainEnabled,
 					IsBtcTypeChainEnabled: tc.IsBtcTypeChainEnabled,
+					IsBscTypeChainEnabled: tc.IsBscTypeChainEnabled,
 				},
 			})
 
-			setSupportedChain(ctx, *k, common.GoerliLocalnetChain().ChainId)
+			setSupportedChain(ctx, *k, common.GoerliLocalnetChain().ChainId, common.BscTestnetChain().ChainId)
 
 			_, err := srv.AddBlockHeader(ctx, tc.msg)
 			tc.wantErr(t, err)
@@ -185,6 +206,162 @@ func TestMsgServer_AddBlockHeader(t *testing.T) {
 	}
 }
 
+func TestMsgServer_AddBlockHeaderBitcoin(t *testing.T) {
+	chainID := common.BtcRegtestChain().ChainId
+	bits := chaincfg.RegressionNetParams.PowLimitBits
+	timestamp := time.Unix(1700000000, 0)
+
+	r := rand.New(rand.NewSource(9))
+	validator := sample.Validator(t, r)
+	observerAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+	require.NoError(t, err)
+
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	ctx = ctx.WithBlockTime(timestamp)
+	srv := keeper.NewMsgServerImpl(*k)
+	k.SetObserverSet(ctx, types.ObserverSet{
+		ObserverList: []string{observerAddress.String()},
+	})
+	k.GetStakingKeeper().SetValidator(ctx, validator)
+	k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+		IsInboundEnabled:  true,
+		IsOutboundEnabled: true,
+		BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+			IsBtcTypeChainEnabled: true,
+		},
+	})
+	setSupportedChain(ctx, *k, chainID)
+
+	addBlockHeader := func(header common.BlockHeader) error {
+		_, err := srv.AddBlockHeader(ctx, &types.MsgAddBlockHeader{
+			Creator:   observerAddress.String(),
+			ChainId:   header.ChainId,
+			BlockHash: header.Hash,
+			Height:    header.Height,
+			Header:    header.Header,
+		})
+		return err
+	}
+
+	header0 := sample.BitcoinBlockHeader(t, chainID, 100, sample.Hash().Bytes(), timestamp, bits)
+	headerA1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, bits)
+	headerB1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, bits)
+	headerB2 := sample.BitcoinBlockHeader(t, chainID, 102, headerB1.Hash, timestamp, bits)
+	require.NoError(t, addBlockHeader(header0))
+	require.NoError(t, addBlockHeader(headerA1))
+
+	t.Run("should fail if the parent header is unknown", func(t *testing.T) {
+		header := sample.BitcoinBlockHeader(t, chainID, 101, sample.Hash().Bytes(), timestamp, bits)
+		err := addBlockHeader(header)
+		require.ErrorIs(t, err, types.ErrNoParentHash)
+	})
+
+	t.Run("should fail if the difficulty is invalid", func(t *testing.T) {
+		header := sample.BitcoinBlockHeader(t, chainID, 102, headerA1.Hash, timestamp, 0x1d00ffff)
+		err := addBlockHeader(header)
+		require.ErrorIs(t, err, types.ErrInvalidDifficulty)
+	})
+
+	t.Run("should add competing headers and follow the branch with the most work", func(t *testing.T) {
+		require.NoError(t, addBlockHeader(headerB1))
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, headerA1.Hash, bhs.LatestBlockHash)
+
+		require.NoError(t, addBlockHeader(headerB2))
+		bhs, found = k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.EqualValues(t, 102, bhs.LatestHeight)
+		require.Equal(t, headerB2.Hash, bhs.LatestBlockHash)
+
+		_, found = k.GetBlockHeader(ctx, headerA1.Hash)
+		require.True(t, found)
+		_, onBestChain := k.GetBlockHeaderConfirmations(ctx, chainID, headerA1.Hash)
+		require.False(t, onBestChain)
+		confirmations, onBestChain := k.GetBlockHeaderConfirmations(ctx, chainID, headerB1.Hash)
+		require.True(t, onBestChain)
+		require.EqualValues(t, 2, confirmations)
+	})
+}
+
+func TestMsgServer_AddBlockHeaderEthereumLightClient(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+	header1, header2, _, err := ethHeaders()
+	require.NoError(t, err)
+	header1RLP, err := rlp.EncodeToBytes(header1)
+	require.NoError(t, err)
+	header2RLP, err := rlp.EncodeToBytes(header2)
+	require.NoError(t, err)
+
+	r := rand.New(rand.NewSource(9))
+	validator := sample.Validator(t, r)
+	observerAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+	require.NoError(t, err)
+
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	k.SetObserverSet(ctx, types.ObserverSet{
+		ObserverList: []string{observerAddress.String()},
+	})
+	k.GetStakingKeeper().SetValidator(ctx, validator)
+	k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+		IsInboundEnabled:  true,
+		IsOutboundEnabled: true,
+		BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+			IsEthTypeChainEnabled: true,
+		},
+	})
+	setSupportedChain(ctx, *k, chainID)
+
+	// the light client finalized the second header
+	k.SetEthereumLightClient(ctx, types.EthereumLightClient{ChainId: chainID})
+	k.SetFinalizedBlockHash(ctx, chainID, header2.Number.Int64(), header2.Hash().Bytes())
+
+	addBlockHeader := func(header *ethtypes.Header, headerRLP []byte) error {
+		_, err := srv.AddBlockHeader(ctx, &types.MsgAddBlockHeader{
+			Creator:   observerAddress.String(),
+			ChainId:   chainID,
+			BlockHash: header.Hash().Bytes(),
+			Height:    header.Number.Int64(),
+			Header:    common.NewEthereumHeader(headerRLP),
+		})
+		return err
+	}
+
+	t.Run("should fail if the header is not finalized by the light client", func(t *testing.T) {
+		err := addBlockHeader(header1, header1RLP)
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+	})
+
+	t.Run("should fail if the header differs from the finalized header", func(t *testing.T) {
+		k.SetFinalizedBlockHash(ctx, chainID, header1.Number.Int64(), sample.Hash().Bytes())
+		err := addBlockHeader(header1, header1RLP)
+		require.ErrorIs(t, err, types.ErrBlockNotFinalized)
+	})
+
+	t.Run("should add the finalized header and its parent", func(t *testing.T) {
+		k.SetFinalizedBlockHash(ctx, chainID, header1.Number.Int64(), header1.Hash().Bytes())
+		require.NoError(t, addBlockHeader(header2, header2RLP))
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, header2.Number.Int64(), bhs.LatestHeight)
+		require.Equal(t, header2.Number.Int64(), bhs.EarliestHeight)
+
+		// the parent doesn't need to extend the latest header
+		require.NoError(t, addBlockHeader(header1, header1RLP))
+		bhs, found = k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, header2.Number.Int64(), bhs.LatestHeight)
+		require.Equal(t, header2.Hash().Bytes(), bhs.LatestBlockHash)
+		require.Equal(t, header1.Number.Int64(), bhs.EarliestHeight)
+
+		// the parent of the added header is finalized
+		hash, found := k.GetFinalizedBlockHash(ctx, chainID, header1.Number.Int64()-1)
+		require.True(t, found)
+		require.Equal(t, header1.ParentHash.Bytes(), hash)
+	})
+}
+
 func ethHeaders() (*ethtypes.Header, *ethtypes.Header, *ethtypes.Header, error) {
 	header1, err := readHeader("./testdata/header_sepolia_5000000.json")
 	if err != nil {
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// BootstrapEthereumLightClient initializes the beacon chain light client of an Ethereum chain from the bootstrap
+// of a trusted beacon block root. An existing light client of the chain is replaced.
+// Once the light client is set, only the execution block headers finalized by the light client are accepted for the chain.
+//
+// Authorized: admin policy group 1.
+func (k msgServer) BootstrapEthereumLightClient(
+	goCtx context.Context,
+	msg *types.MsgBootstrapEthereumLightClient,
+) (*types.MsgBootstrapEthereumLightClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, msg.ChainId) {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+
+	if chain := k.GetSupportedChainFromChainID(ctx, msg.ChainId); chain == nil {
+		return nil, cosmoserrors.Wrapf(types.ErrSupportedChains, "chain id: %d", msg.ChainId)
+	}
+	config, found := ethereum.GetNetworkConfig(msg.ChainId)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrLightClientNotSupported, "chain id: %d", msg.ChainId)
+	}
+
+	store, err := config.InitializeLightClientStore(msg.TrustedBlockRoot, msg.Bootstrap)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+	}
+
+	k.SetEthereumLightClient(ctx, types.EthereumLightClient{
+		ChainId: msg.ChainId,
+		Store:   store,
+	})
+	k.SetFinalizedExecutionBlock(ctx, msg.ChainId, store.FinalizedHeader.Execution)
+
+	return &types.MsgBootstrapEthereumLightClientResponse{}, nil
+}
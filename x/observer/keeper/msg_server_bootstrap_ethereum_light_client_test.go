@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// the Sepolia light client fixtures are synthetic, their sync committees are made of test keys
+const (
+	lightClientTestdata    = "../../../common/testdata/"
+	lightClientTrustedRoot = "0x45b51db36d8b00a679dd21a3063d3c516bb8d6faed95aa3dd356f5eb8821f971"
+)
+
+func lightClientBootstrap(t *testing.T) ethereum.LightClientBootstrap {
+	data, err := os.ReadFile(lightClientTestdata + "sepolia_light_client_bootstrap.json")
+	require.NoError(t, err)
+	bootstrap, err := ethereum.ParseLightClientBootstrapJSON(data)
+	require.NoError(t, err)
+	return bootstrap
+}
+
+func lightClientUpdate(t *testing.T, file string) ethereum.LightClientUpdate {
+	data, err := os.ReadFile(lightClientTestdata + file)
+	require.NoError(t, err)
+	update, err := ethereum.ParseLightClientUpdateJSON(data)
+	require.NoError(t, err)
+	return update
+}
+
+func TestMsgServer_BootstrapEthereumLightClient(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+	trustedRoot := ethcommon.HexToHash(lightClientTrustedRoot).Bytes()
+
+	t.Run("can bootstrap the light client", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+		setSupportedChain(ctx, *k, chainID)
+
+		bootstrap := lightClientBootstrap(t)
+		_, err := srv.BootstrapEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgBootstrapEthereumLightClient{
+			Creator:          admin,
+			ChainId:          chainID,
+			TrustedBlockRoot: trustedRoot,
+			Bootstrap:        bootstrap,
+		})
+		require.NoError(t, err)
+
+		lightClient, found := k.GetEthereumLightClient(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, bootstrap.Header, lightClient.Store.FinalizedHeader)
+		require.Nil(t, lightClient.Store.NextSyncCommittee)
+
+		hash, found := k.GetFinalizedBlockHash(ctx, chainID, int64(bootstrap.Header.Execution.BlockNumber))
+		require.True(t, found)
+		require.Equal(t, bootstrap.Header.Execution.BlockHash, hash)
+	})
+
+	t.Run("cannot bootstrap the light client if not authorized", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, false)
+		setSupportedChain(ctx, *k, chainID)
+
+		_, err := srv.BootstrapEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgBootstrapEthereumLightClient{
+			Creator:          admin,
+			ChainId:          chainID,
+			TrustedBlockRoot: trustedRoot,
+			Bootstrap:        lightClientBootstrap(t),
+		})
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+
+	t.Run("cannot bootstrap the light client of a chain without beacon chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+		bscChainID := common.BscTestnetChain().ChainId
+		setSupportedChain(ctx, *k, bscChainID)
+
+		_, err := srv.BootstrapEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgBootstrapEthereumLightClient{
+			Creator:          admin,
+			ChainId:          bscChainID,
+			TrustedBlockRoot: trustedRoot,
+			Bootstrap:        lightClientBootstrap(t),
+		})
+		require.ErrorIs(t, err, types.ErrLightClientNotSupported)
+	})
+
+	t.Run("cannot bootstrap the light client from an untrusted block root", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+		setSupportedChain(ctx, *k, chainID)
+
+		_, err := srv.BootstrapEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgBootstrapEthereumLightClient{
+			Creator:          admin,
+			ChainId:          chainID,
+			TrustedBlockRoot: sample.Hash().Bytes(),
+			Bootstrap:        lightClientBootstrap(t),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+		_, found := k.GetEthereumLightClient(ctx, chainID)
+		require.False(t, found)
+	})
+}
@@ -4,22 +4,31 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 // RemoveChainParams removes chain parameters for a specific chain.
+// The chain parameters scheduled for the chain are also removed.
 func (k msgServer) RemoveChainParams(goCtx context.Context, msg *types.MsgRemoveChainParams) (*types.MsgRemoveChainParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check permission
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, msg.ChainId) {
 		return &types.MsgRemoveChainParamsResponse{}, types.ErrNotAuthorizedPolicy
 	}
 
+	// cancel the chain params scheduled for the chain
+	_, pendingFound := k.GetPendingChainParams(ctx, msg.ChainId)
+	if pendingFound {
+		k.RemovePendingChainParams(ctx, msg.ChainId)
+	}
+
 	// find current core params list or initialize a new one
 	chainParamsList, found := k.GetChainParamsList(ctx)
 	if !found {
+		if pendingFound {
+			return &types.MsgRemoveChainParamsResponse{}, nil
+		}
 		return &types.MsgRemoveChainParamsResponse{}, types.ErrChainParamsNotFound
 	}
 
@@ -34,6 +43,9 @@ func (k msgServer) RemoveChainParams(goCtx context.Context, msg *types.MsgRemove
 		}
 	}
 	if !found {
+		if pendingFound {
+			return &types.MsgRemoveChainParamsResponse{}, nil
+		}
 		return &types.MsgRemoveChainParamsResponse{}, types.ErrChainParamsNotFound
 	}
 
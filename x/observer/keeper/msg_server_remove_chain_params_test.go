@@ -8,7 +8,6 @@ import (
 	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/observer/keeper"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -39,7 +38,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 			},
 		})
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// remove chain params
 		_, err := srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
@@ -55,7 +54,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 		require.Equal(t, chain1, chainParamsList.ChainParams[0].ChainId)
 		require.Equal(t, chain3, chainParamsList.ChainParams[1].ChainId)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// remove chain params
 		_, err = srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
@@ -70,7 +69,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 		require.Len(t, chainParamsList.ChainParams, 1)
 		require.Equal(t, chain3, chainParamsList.ChainParams[0].ChainId)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// remove chain params
 		_, err = srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
@@ -93,7 +92,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, false)
 
 		_, err := srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
 			Creator: admin,
@@ -111,7 +110,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 		// set admin
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// not found if no chain params
 		_, found := k.GetChainParamsList(ctx)
@@ -132,7 +131,7 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 			},
 		})
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// not found if chain ID not in list
 		_, err = srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
@@ -141,4 +140,45 @@ func TestMsgServer_RemoveChainParams(t *testing.T) {
 		})
 		require.ErrorIs(t, err, types.ErrChainParamsNotFound)
 	})
+	t.Run("removes the chain params scheduled for the chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		admin := sample.AccAddress()
+		chain1 := common.ExternalChainList()[0].ChainId
+		chain2 := common.ExternalChainList()[1].ChainId
+
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{sample.ChainParams(chain1)},
+		})
+		k.SetPendingChainParams(ctx, types.PendingChainParams{ChainParams: sample.ChainParams(chain1), ActivationHeight: 100})
+		k.SetPendingChainParams(ctx, types.PendingChainParams{ChainParams: sample.ChainParams(chain2), ActivationHeight: 100})
+
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		// chain params and scheduled chain params are removed
+		_, err := srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
+			Creator: admin,
+			ChainId: chain1,
+		})
+		require.NoError(t, err)
+		_, found := k.GetChainParamsByChainID(ctx, chain1)
+		require.False(t, found)
+		_, found = k.GetPendingChainParams(ctx, chain1)
+		require.False(t, found)
+
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		// scheduled chain params can be removed for a chain without chain params
+		_, err = srv.RemoveChainParams(sdk.WrapSDKContext(ctx), &types.MsgRemoveChainParams{
+			Creator: admin,
+			ChainId: chain2,
+		})
+		require.NoError(t, err)
+		_, found = k.GetPendingChainParams(ctx, chain2)
+		require.False(t, found)
+	})
 }
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UnjailObserver adds back a jailed observer to the voters of the new ballots once its jail duration has elapsed.
+// The liveness window of the observer is restarted.
+//
+// Authorized: observer.
+func (k msgServer) UnjailObserver(
+	goCtx context.Context,
+	msg *types.MsgUnjailObserver,
+) (*types.MsgUnjailObserverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAddressPartOfObserverSet(ctx, msg.Creator) {
+		return nil, types.ErrNotObserver
+	}
+
+	liveness, found := k.GetObserverLiveness(ctx, msg.Creator)
+	if !found || !liveness.Jailed {
+		return nil, types.ErrObserverNotJailed
+	}
+	if ctx.BlockHeight() < liveness.JailedUntil {
+		return nil, errorsmod.Wrap(
+			types.ErrObserverJailPeriodNotElapsed,
+			fmt.Sprintf("jailed until height %d", liveness.JailedUntil),
+		)
+	}
+
+	liveness.Jailed = false
+	liveness.WindowBallots = 0
+	liveness.WindowMissedBallots = 0
+	k.SetObserverLiveness(ctx, liveness)
+
+	EmitEventObserverUnjailed(ctx, msg.Creator)
+	return &types.MsgUnjailObserverResponse{}, nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_UnjailObserver(t *testing.T) {
+	t.Run("should unjail the observer once the jail duration has elapsed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer}})
+		k.SetObserverLiveness(ctx, types.ObserverLiveness{
+			ObserverAddress: observer,
+			Jailed:          true,
+			JailedUntil:     100,
+			JailCount:       1,
+		})
+
+		ctx = ctx.WithBlockHeight(100)
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer))
+		require.NoError(t, err)
+
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.False(t, liveness.Jailed)
+		require.EqualValues(t, 1, liveness.JailCount)
+		require.False(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("should fail if the jail duration has not elapsed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer}})
+		k.SetObserverLiveness(ctx, types.ObserverLiveness{
+			ObserverAddress: observer,
+			Jailed:          true,
+			JailedUntil:     100,
+		})
+
+		ctx = ctx.WithBlockHeight(99)
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer))
+		require.ErrorIs(t, err, types.ErrObserverJailPeriodNotElapsed)
+		require.True(t, k.IsObserverJailed(ctx, observer))
+	})
+
+	t.Run("should fail if the observer is not jailed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer}})
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(observer))
+		require.ErrorIs(t, err, types.ErrObserverNotJailed)
+	})
+
+	t.Run("should fail if the creator is not an observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+
+		_, err := srv.UnjailObserver(sdk.WrapSDKContext(ctx), types.NewMsgUnjailObserver(sample.AccAddress()))
+		require.ErrorIs(t, err, types.ErrNotObserver)
+	})
+}
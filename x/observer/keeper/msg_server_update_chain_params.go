@@ -3,41 +3,46 @@ package keeper
 import (
 	"context"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 // UpdateChainParams updates chain parameters for a specific chain, or add a new one.
 // Chain parameters include: confirmation count, outbound transaction schedule interval, ZETA token,
 // connector and ERC20 custody contract addresses, etc.
+// If an activation height is provided, the chain parameters are stored as pending and applied at the beginning of
+// the block at this height, replacing any chain parameters previously scheduled for the chain.
 // Only the admin policy account is authorized to broadcast this message.
 func (k msgServer) UpdateChainParams(goCtx context.Context, msg *types.MsgUpdateChainParams) (*types.MsgUpdateChainParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check permission
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupAdmin) {
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, msg.ChainParams.GetChainId()) {
 		return &types.MsgUpdateChainParamsResponse{}, types.ErrNotAuthorizedPolicy
 	}
 
-	// find current chain params list or initialize a new one
-	chainParamsList, found := k.GetChainParamsList(ctx)
-	if !found {
-		chainParamsList = types.ChainParamsList{}
+	// apply the chain params immediately if no activation height is provided
+	if msg.ActivationHeight == 0 {
+		k.SetChainParamsForChain(ctx, msg.ChainParams)
+		return &types.MsgUpdateChainParamsResponse{}, nil
 	}
 
-	// find chain params for the chain
-	for i, cp := range chainParamsList.ChainParams {
-		if cp.ChainId == msg.ChainParams.ChainId {
-			chainParamsList.ChainParams[i] = msg.ChainParams
-			k.SetChainParamsList(ctx, chainParamsList)
-			return &types.MsgUpdateChainParamsResponse{}, nil
-		}
+	// schedule the chain params
+	if msg.ActivationHeight <= ctx.BlockHeight() {
+		return &types.MsgUpdateChainParamsResponse{}, errorsmod.Wrapf(
+			types.ErrInvalidActivationHeight,
+			"activation height %d must be greater than the current height %d",
+			msg.ActivationHeight,
+			ctx.BlockHeight(),
+		)
 	}
-
-	// add new chain params
-	chainParamsList.ChainParams = append(chainParamsList.ChainParams, msg.ChainParams)
-	k.SetChainParamsList(ctx, chainParamsList)
+	pending := types.PendingChainParams{
+		ChainParams:      msg.ChainParams,
+		ActivationHeight: msg.ActivationHeight,
+	}
+	k.SetPendingChainParams(ctx, pending)
+	EmitEventChainParamsScheduled(ctx, pending)
 
 	return &types.MsgUpdateChainParamsResponse{}, nil
 }
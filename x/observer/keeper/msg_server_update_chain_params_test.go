@@ -3,8 +3,6 @@ package keeper_test
 import (
 	"testing"
 
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/common"
@@ -33,7 +31,7 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 		_, found := k.GetChainParamsList(ctx)
 		require.False(t, found)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// a new chain params can be added
 		chainParams1 := sample.ChainParams(chain1)
@@ -49,7 +47,7 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 		require.Len(t, chainParamsList.ChainParams, 1)
 		require.Equal(t, chainParams1, chainParamsList.ChainParams[0])
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// a new chian params can be added
 		chainParams2 := sample.ChainParams(chain2)
@@ -66,7 +64,7 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 		require.Equal(t, chainParams1, chainParamsList.ChainParams[0])
 		require.Equal(t, chainParams2, chainParamsList.ChainParams[1])
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// a new chain params can be added
 		chainParams3 := sample.ChainParams(chain3)
@@ -84,7 +82,7 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 		require.Equal(t, chainParams2, chainParamsList.ChainParams[1])
 		require.Equal(t, chainParams3, chainParamsList.ChainParams[2])
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
 
 		// chain params can be updated
 		chainParams2.ConfirmationCount = chainParams2.ConfirmationCount + 1
@@ -111,7 +109,7 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, false)
 
 		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainParams{
 			Creator:     admin,
@@ -119,4 +117,53 @@ func TestMsgServer_UpdateChainParams(t *testing.T) {
 		})
 		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
 	})
+	t.Run("can schedule chain params at an activation height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(100)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		chainParams := sample.ChainParams(common.ExternalChainList()[0].ChainId)
+		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainParams{
+			Creator:          admin,
+			ChainParams:      chainParams,
+			ActivationHeight: 110,
+		})
+		require.NoError(t, err)
+
+		// chain params are not applied before the activation height
+		_, found := k.GetChainParamsByChainID(ctx, chainParams.ChainId)
+		require.False(t, found)
+		pending, found := k.GetPendingChainParams(ctx, chainParams.ChainId)
+		require.True(t, found)
+		require.Equal(t, types.PendingChainParams{ChainParams: chainParams, ActivationHeight: 110}, pending)
+	})
+
+	t.Run("cannot schedule chain params at a past height", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		ctx = ctx.WithBlockHeight(100)
+
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsg(&authorityMock.Mock, admin, true)
+
+		chainParams := sample.ChainParams(common.ExternalChainList()[0].ChainId)
+		_, err := srv.UpdateChainParams(sdk.WrapSDKContext(ctx), &types.MsgUpdateChainParams{
+			Creator:          admin,
+			ChainParams:      chainParams,
+			ActivationHeight: 100,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidActivationHeight)
+
+		_, found := k.GetPendingChainParams(ctx, chainParams.ChainId)
+		require.False(t, found)
+	})
 }
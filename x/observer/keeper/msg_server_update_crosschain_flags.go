@@ -3,28 +3,29 @@ package keeper
 import (
 	"context"
 
+	cosmoserrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
 
 // UpdateCrosschainFlags updates the crosschain related flags.
 //
-// Aurthorized: admin policy group 1 (except enabling/disabled
-// inbounds/outbounds and gas price increase), admin policy group 2 (all).
+// Aurthorized: admin policy group 1 (disabling inbounds/outbounds globally, for a chain or a
+// foreign coin, and block header verification), admin policy group 2 (all).
 func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUpdateCrosschainFlags) (*types.MsgUpdateCrosschainFlagsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// check permission
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, msg.GetRequiredPolicyType()) {
-		return &types.MsgUpdateCrosschainFlagsResponse{}, types.ErrNotAuthorizedPolicy
-	}
-
 	// check if the value exists
 	flags, isFound := k.GetCrosschainFlags(ctx)
 	if !isFound {
 		flags = *types.DefaultCrosschainFlags()
 	}
 
+	// check permission
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsgWithPolicy(ctx, msg.Creator, msg, msg.GetRequiredPolicyType(flags)) {
+		return &types.MsgUpdateCrosschainFlagsResponse{}, types.ErrNotAuthorizedPolicy
+	}
+
 	// update values
 	flags.IsInboundEnabled = msg.IsInboundEnabled
 	flags.IsOutboundEnabled = msg.IsOutboundEnabled
@@ -37,6 +38,27 @@ func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUp
 		flags.BlockHeaderVerificationFlags = msg.BlockHeaderVerificationFlags
 	}
 
+	if msg.PruningFlags != nil {
+		// ballots must remain in the state until they are matured to distribute the observer rewards
+		ballotMaturityBlocks := k.GetParamsIfExists(ctx).BallotMaturityBlocks
+		if msg.PruningFlags.RetentionBlocks > 0 && msg.PruningFlags.RetentionBlocks <= ballotMaturityBlocks {
+			return &types.MsgUpdateCrosschainFlagsResponse{}, cosmoserrors.Wrapf(
+				types.ErrInvalidPruningFlags,
+				"retention blocks must be greater than the ballot maturity blocks %d",
+				ballotMaturityBlocks,
+			)
+		}
+		flags.PruningFlags = msg.PruningFlags
+	}
+
+	for _, chainFlags := range msg.ChainFlags {
+		flags.SetChainFlags(chainFlags)
+	}
+
+	for _, foreignCoinFlags := range msg.ForeignCoinFlags {
+		flags.SetForeignCoinFlags(foreignCoinFlags)
+	}
+
 	k.SetCrosschainFlags(ctx, flags)
 
 	err := ctx.EventManager().EmitTypedEvents(&types.EventCrosschainFlagsUpdated{
@@ -45,6 +67,9 @@ func (k msgServer) UpdateCrosschainFlags(goCtx context.Context, msg *types.MsgUp
 		IsOutboundEnabled:            msg.IsOutboundEnabled,
 		GasPriceIncreaseFlags:        msg.GasPriceIncreaseFlags,
 		BlockHeaderVerificationFlags: msg.BlockHeaderVerificationFlags,
+		PruningFlags:                 msg.PruningFlags,
+		ChainFlags:                   msg.ChainFlags,
+		ForeignCoinFlags:             msg.ForeignCoinFlags,
 		Signer:                       msg.Creator,
 	})
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
 	"github.com/zeta-chain/zetacore/testutil/sample"
 	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
@@ -23,7 +24,7 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 
 		// mock the authority keeper for authorization
 		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
 			Creator:           admin,
@@ -51,7 +52,7 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		require.True(t, flags.BlockHeaderVerificationFlags.IsEthTypeChainEnabled)
 		require.False(t, flags.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
 
 		// can update flags again
 		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
@@ -81,7 +82,7 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		require.True(t, flags.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled)
 
 		// group 1 should be able to disable inbound and outbound
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
 
 		// if gas price increase flags is nil, it should not be updated
 		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
@@ -102,7 +103,7 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		require.True(t, flags.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled)
 
 		// group 1 should be able to disable header verification
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
 
 		// if gas price increase flags is nil, it should not be updated
 		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
@@ -131,7 +132,8 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		_, found = k.GetCrosschainFlags(ctx)
 		require.False(t, found)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		// group 1 should be able to disable inbound only
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
 
 		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
 			Creator:           admin,
@@ -149,6 +151,119 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		require.Equal(t, types.DefaultGasPriceIncreaseFlags.GasPriceIncreasePercent, flags.GasPriceIncreaseFlags.GasPriceIncreasePercent)
 	})
 
+	t.Run("can update pruning flags", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		k.SetParams(ctx, types.DefaultParams())
+
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			PruningFlags: &types.PruningFlags{
+				RetentionBlocks:   types.DefaultParams().BallotMaturityBlocks + 1,
+				MaxPrunedPerBlock: 42,
+			},
+		})
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.Equal(t, types.DefaultParams().BallotMaturityBlocks+1, flags.PruningFlags.RetentionBlocks)
+		require.Equal(t, uint32(42), flags.PruningFlags.MaxPrunedPerBlock)
+	})
+
+	t.Run("cannot update pruning flags if retention is not greater than ballot maturity", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		k.SetParams(ctx, types.DefaultParams())
+
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			PruningFlags: &types.PruningFlags{
+				RetentionBlocks:   types.DefaultParams().BallotMaturityBlocks,
+				MaxPrunedPerBlock: 42,
+			},
+		})
+		require.ErrorIs(t, err, types.ErrInvalidPruningFlags)
+	})
+
+	t.Run("can disable and re-enable a chain and a foreign coin", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
+			UseAuthorityMock: true,
+		})
+		srv := keeper.NewMsgServerImpl(*k)
+		admin := sample.AccAddress()
+		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
+
+		// group 1 can disable a chain and a foreign coin
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, true)
+		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			ChainFlags: []types.ChainFlags{
+				{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true},
+			},
+			ForeignCoinFlags: []types.ForeignCoinFlags{
+				{ChainId: 2, Asset: "0xabcd", IsInboundEnabled: true, IsOutboundEnabled: false},
+			},
+		})
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.False(t, flags.IsChainInboundEnabled(1))
+		require.True(t, flags.IsChainOutboundEnabled(1))
+		require.False(t, flags.IsForeignCoinOutboundEnabled(2, common.CoinType_ERC20, "0xabcd"))
+
+		// group 1 can't re-enable the chain
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			ChainFlags: []types.ChainFlags{
+				{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: true},
+			},
+		})
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+
+		// group 2 can re-enable the chain and the foreign coin
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, true)
+		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
+			Creator:           admin,
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			ChainFlags: []types.ChainFlags{
+				{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: true},
+			},
+			ForeignCoinFlags: []types.ForeignCoinFlags{
+				{ChainId: 2, Asset: "0xabcd", IsInboundEnabled: true, IsOutboundEnabled: true},
+			},
+		})
+		require.NoError(t, err)
+
+		flags, found = k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.Empty(t, flags.ChainFlags)
+		require.Empty(t, flags.ForeignCoinFlags)
+	})
+
 	t.Run("cannot update crosschain flags if not authorized", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMockOptions{
 			UseAuthorityMock: true,
@@ -157,7 +272,7 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 
 		admin := sample.AccAddress()
 		authorityMock := keepertest.GetObserverAuthorityMock(t, k)
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, false)
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupEmergency, false)
 
 		_, err := srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
 			Creator:           admin,
@@ -167,7 +282,9 @@ func TestMsgServer_UpdateCrosschainFlags(t *testing.T) {
 		require.Error(t, err)
 		require.Equal(t, types.ErrNotAuthorizedPolicy, err)
 
-		keepertest.MockIsAuthorized(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
+		// re-enabling the outbounds requires group 2
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{IsInboundEnabled: false, IsOutboundEnabled: false})
+		keepertest.MockIsAuthorizedForMsgWithPolicy(&authorityMock.Mock, admin, authoritytypes.PolicyType_groupAdmin, false)
 
 		_, err = srv.UpdateCrosschainFlags(sdk.WrapSDKContext(ctx), &types.MsgUpdateCrosschainFlags{
 			Creator:           admin,
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UpdateEthereumLightClient applies a sync committee period update or a finality update to the beacon chain light client
+// of an Ethereum chain. The update is verified against the sync committee of the light client, no vote is required.
+// The execution block of the new finalized beacon header is finalized for the chain.
+//
+// Authorized: observer assigned to the chain.
+func (k msgServer) UpdateEthereumLightClient(
+	goCtx context.Context,
+	msg *types.MsgUpdateEthereumLightClient,
+) (*types.MsgUpdateEthereumLightClientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if ok := k.IsAuthorized(ctx, msg.Creator); !ok {
+		return nil, types.ErrNotAuthorizedPolicy
+	}
+	if !k.IsObserverAssignedToChain(ctx, msg.Creator, msg.ChainId) {
+		return nil, types.ErrObserverNotAssignedToChain
+	}
+
+	config, found := ethereum.GetNetworkConfig(msg.ChainId)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrLightClientNotSupported, "chain id: %d", msg.ChainId)
+	}
+	lightClient, found := k.GetEthereumLightClient(ctx, msg.ChainId)
+	if !found {
+		return nil, cosmoserrors.Wrapf(types.ErrLightClientNotFound, "chain id: %d", msg.ChainId)
+	}
+
+	store, finalizedChanged, err := config.ProcessLightClientUpdate(lightClient.Store, msg.Update)
+	if err != nil {
+		return nil, cosmoserrors.Wrap(types.ErrInvalidLightClientUpdate, err.Error())
+	}
+
+	lightClient.Store = store
+	k.SetEthereumLightClient(ctx, lightClient)
+	if finalizedChanged {
+		k.SetFinalizedExecutionBlock(ctx, msg.ChainId, store.FinalizedHeader.Execution)
+	}
+
+	return &types.MsgUpdateEthereumLightClientResponse{}, nil
+}
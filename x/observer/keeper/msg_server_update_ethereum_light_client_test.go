@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_UpdateEthereumLightClient(t *testing.T) {
+	chainID := common.SepoliaChain().ChainId
+	r := rand.New(rand.NewSource(9))
+	validator := sample.Validator(t, r)
+	observerAddress, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+	require.NoError(t, err)
+
+	setup := func(t *testing.T, bootstrap bool) (*keeper.Keeper, sdk.Context, types.MsgServer) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		k.SetObserverSet(ctx, types.ObserverSet{
+			ObserverList: []string{observerAddress.String()},
+		})
+		k.GetStakingKeeper().SetValidator(ctx, validator)
+		setSupportedChain(ctx, *k, chainID)
+		if bootstrap {
+			store, err := ethereum.SepoliaNetworkConfig().InitializeLightClientStore(
+				ethcommon.HexToHash(lightClientTrustedRoot).Bytes(),
+				lightClientBootstrap(t),
+			)
+			require.NoError(t, err)
+			k.SetEthereumLightClient(ctx, types.EthereumLightClient{
+				ChainId: chainID,
+				Store:   store,
+			})
+		}
+		return k, ctx, keeper.NewMsgServerImpl(*k)
+	}
+
+	t.Run("can follow the finalized headers with updates", func(t *testing.T) {
+		k, ctx, srv := setup(t, true)
+
+		update := lightClientUpdate(t, "sepolia_light_client_update_1069.json")
+		_, err := srv.UpdateEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgUpdateEthereumLightClient{
+			Creator: observerAddress.String(),
+			ChainId: chainID,
+			Update:  update,
+		})
+		require.NoError(t, err)
+		lightClient, found := k.GetEthereumLightClient(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, update.FinalizedHeader, lightClient.Store.FinalizedHeader)
+		require.Equal(t, update.NextSyncCommittee, lightClient.Store.NextSyncCommittee)
+		hash, found := k.GetFinalizedBlockHash(ctx, chainID, int64(update.FinalizedHeader.Execution.BlockNumber))
+		require.True(t, found)
+		require.Equal(t, update.FinalizedHeader.Execution.BlockHash, hash)
+
+		finalityUpdate := lightClientUpdate(t, "sepolia_light_client_finality_update_1070.json")
+		_, err = srv.UpdateEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgUpdateEthereumLightClient{
+			Creator: observerAddress.String(),
+			ChainId: chainID,
+			Update:  finalityUpdate,
+		})
+		require.NoError(t, err)
+		lightClient, found = k.GetEthereumLightClient(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, finalityUpdate.FinalizedHeader, lightClient.Store.FinalizedHeader)
+		require.Equal(t, *update.NextSyncCommittee, lightClient.Store.CurrentSyncCommittee)
+		hash, found = k.GetFinalizedBlockHash(ctx, chainID, int64(finalityUpdate.FinalizedHeader.Execution.BlockNumber))
+		require.True(t, found)
+		require.Equal(t, finalityUpdate.FinalizedHeader.Execution.BlockHash, hash)
+	})
+
+	t.Run("cannot update the light client if not an observer", func(t *testing.T) {
+		_, ctx, srv := setup(t, true)
+
+		_, err := srv.UpdateEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgUpdateEthereumLightClient{
+			Creator: sample.AccAddress(),
+			ChainId: chainID,
+			Update:  lightClientUpdate(t, "sepolia_light_client_update_1069.json"),
+		})
+		require.ErrorIs(t, err, types.ErrNotAuthorizedPolicy)
+	})
+
+	t.Run("cannot update the light client if not bootstrapped", func(t *testing.T) {
+		_, ctx, srv := setup(t, false)
+
+		_, err := srv.UpdateEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgUpdateEthereumLightClient{
+			Creator: observerAddress.String(),
+			ChainId: chainID,
+			Update:  lightClientUpdate(t, "sepolia_light_client_update_1069.json"),
+		})
+		require.ErrorIs(t, err, types.ErrLightClientNotFound)
+	})
+
+	t.Run("cannot update the light client with an update below the supermajority", func(t *testing.T) {
+		k, ctx, srv := setup(t, true)
+		lightClient, found := k.GetEthereumLightClient(ctx, chainID)
+		require.True(t, found)
+
+		_, err := srv.UpdateEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgUpdateEthereumLightClient{
+			Creator: observerAddress.String(),
+			ChainId: chainID,
+			Update:  lightClientUpdate(t, "sepolia_light_client_finality_update_low_participation.json"),
+		})
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+		unchanged, found := k.GetEthereumLightClient(ctx, chainID)
+		require.True(t, found)
+		require.Equal(t, lightClient, unchanged)
+	})
+
+	t.Run("cannot update the light client with an invalid signature", func(t *testing.T) {
+		_, ctx, srv := setup(t, true)
+		update := lightClientUpdate(t, "sepolia_light_client_update_1069.json")
+		update.SyncAggregate.SyncCommitteeSignature[10] ^= 0x01
+
+		_, err := srv.UpdateEthereumLightClient(sdk.WrapSDKContext(ctx), &types.MsgUpdateEthereumLightClient{
+			Creator: observerAddress.String(),
+			ChainId: chainID,
+			Update:  update,
+		})
+		require.ErrorIs(t, err, types.ErrInvalidLightClientUpdate)
+	})
+}
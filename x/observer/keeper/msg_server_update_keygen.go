@@ -3,8 +3,6 @@ package keeper
 import (
 	"context"
 
-	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/x/observer/types"
 )
@@ -17,7 +15,7 @@ func (k msgServer) UpdateKeygen(goCtx context.Context, msg *types.MsgUpdateKeyge
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// check permission
-	if !k.GetAuthorityKeeper().IsAuthorized(ctx, msg.Creator, authoritytypes.PolicyType_groupEmergency) {
+	if !k.GetAuthorityKeeper().IsAuthorizedForMsg(ctx, msg.Creator, msg, 0) {
 		return &types.MsgUpdateKeygenResponse{}, types.ErrNotAuthorizedPolicy
 	}
 
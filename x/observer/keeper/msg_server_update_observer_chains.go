@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// UpdateObserverChains sets the chains the observer votes on, the ballots of the other chains are created without the observer.
+// An empty list of chains assigns the observer to all the supported chains.
+// The update fails if it leaves a supported chain without assigned observers.
+//
+// Authorized: observer.
+func (k msgServer) UpdateObserverChains(
+	goCtx context.Context,
+	msg *types.MsgUpdateObserverChains,
+) (*types.MsgUpdateObserverChainsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsAddressPartOfObserverSet(ctx, msg.Creator) {
+		return nil, types.ErrNotObserver
+	}
+
+	if len(msg.ChainIds) == 0 {
+		k.RemoveObserverChains(ctx, msg.Creator)
+		return &types.MsgUpdateObserverChainsResponse{}, nil
+	}
+
+	for _, chainID := range msg.ChainIds {
+		if k.GetSupportedChainFromChainID(ctx, chainID) == nil {
+			return nil, errorsmod.Wrap(types.ErrSupportedChains, fmt.Sprintf("chain id %d", chainID))
+		}
+	}
+
+	// the ballots of a chain without assigned observers would have no voters and never be finalized
+	observerSet, _ := k.GetObserverSet(ctx)
+	for _, chain := range k.GetSupportedChains(ctx) {
+		if common.IsZetaChain(chain.ChainId) || chainIDInList(chain.ChainId, msg.ChainIds) {
+			continue
+		}
+		assigned := false
+		for _, observer := range observerSet.ObserverList {
+			if observer != msg.Creator && k.IsObserverAssignedToChain(ctx, observer, chain.ChainId) {
+				assigned = true
+				break
+			}
+		}
+		if !assigned {
+			return nil, errorsmod.Wrap(types.ErrChainWithoutObservers, fmt.Sprintf("chain id %d", chain.ChainId))
+		}
+	}
+
+	k.SetObserverChains(ctx, types.ObserverChains{
+		ObserverAddress: msg.Creator,
+		ChainIds:        msg.ChainIds,
+	})
+	return &types.MsgUpdateObserverChainsResponse{}, nil
+}
+
+// chainIDInList returns true if the chain id is in the list
+func chainIDInList(chainID int64, chainIDs []int64) bool {
+	for _, id := range chainIDs {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/keeper"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgServer_UpdateObserverChains(t *testing.T) {
+	t.Run("should assign the observer to the chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		chain1 := common.GoerliLocalnetChain().ChainId
+		chain2 := common.GoerliChain().ChainId
+		setSupportedChain(ctx, *k, chain1, chain2)
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer, sample.AccAddress()}})
+
+		_, err := srv.UpdateObserverChains(sdk.WrapSDKContext(ctx), types.NewMsgUpdateObserverChains(observer, []int64{chain2}))
+		require.NoError(t, err)
+		require.False(t, k.IsObserverAssignedToChain(ctx, observer, chain1))
+		require.True(t, k.IsObserverAssignedToChain(ctx, observer, chain2))
+
+		// an empty list assigns the observer to all the chains
+		_, err = srv.UpdateObserverChains(sdk.WrapSDKContext(ctx), types.NewMsgUpdateObserverChains(observer, nil))
+		require.NoError(t, err)
+		_, found := k.GetObserverChains(ctx, observer)
+		require.False(t, found)
+		require.True(t, k.IsObserverAssignedToChain(ctx, observer, chain1))
+	})
+
+	t.Run("should fail if the creator is not an observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		chain := common.GoerliLocalnetChain().ChainId
+		setSupportedChain(ctx, *k, chain)
+
+		_, err := srv.UpdateObserverChains(sdk.WrapSDKContext(ctx), types.NewMsgUpdateObserverChains(sample.AccAddress(), []int64{chain}))
+		require.ErrorIs(t, err, types.ErrNotObserver)
+	})
+
+	t.Run("should fail if a chain is not supported", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		chain := common.GoerliLocalnetChain().ChainId
+		setSupportedChain(ctx, *k, chain)
+		observer := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: []string{observer}})
+
+		_, err := srv.UpdateObserverChains(sdk.WrapSDKContext(ctx), types.NewMsgUpdateObserverChains(observer, []int64{chain, common.GoerliChain().ChainId}))
+		require.ErrorIs(t, err, types.ErrSupportedChains)
+		_, found := k.GetObserverChains(ctx, observer)
+		require.False(t, found)
+	})
+	t.Run("should fail if a supported chain is left without assigned observers", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		srv := keeper.NewMsgServerImpl(*k)
+		chain1 := common.GoerliLocalnetChain().ChainId
+		chain2 := common.GoerliChain().ChainId
+		setSupportedChain(ctx, *k, chain1, chain2, common.ZetaPrivnetChain().ChainId)
+		observers := []string{sample.AccAddress(), sample.AccAddress()}
+		k.SetObserverSet(ctx, types.ObserverSet{ObserverList: observers})
+		k.SetObserverChains(ctx, types.ObserverChains{
+			ObserverAddress: observers[1],
+			ChainIds:        []int64{chain2},
+		})
+
+		_, err := srv.UpdateObserverChains(sdk.WrapSDKContext(ctx), types.NewMsgUpdateObserverChains(observers[0], []int64{chain2}))
+		require.ErrorIs(t, err, types.ErrChainWithoutObservers)
+		_, found := k.GetObserverChains(ctx, observers[0])
+		require.False(t, found)
+
+		// zeta chain is observed by all the observers and doesn't need to be assigned
+		_, err = srv.UpdateObserverChains(sdk.WrapSDKContext(ctx), types.NewMsgUpdateObserverChains(observers[0], []int64{chain1}))
+		require.NoError(t, err)
+	})
+}
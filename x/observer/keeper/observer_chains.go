@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetObserverChains sets the chains an observer is assigned to
+func (k Keeper) SetObserverChains(ctx sdk.Context, observerChains types.ObserverChains) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverChainsKeyPrefix))
+	b := k.cdc.MustMarshal(&observerChains)
+	store.Set([]byte(observerChains.ObserverAddress), b)
+}
+
+// GetObserverChains returns the chains an observer is assigned to
+func (k Keeper) GetObserverChains(ctx sdk.Context, observerAddress string) (val types.ObserverChains, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverChainsKeyPrefix))
+	b := store.Get([]byte(observerAddress))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveObserverChains removes the chains assigned to an observer, the observer is then assigned to all the chains
+func (k Keeper) RemoveObserverChains(ctx sdk.Context, observerAddress string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverChainsKeyPrefix))
+	store.Delete([]byte(observerAddress))
+}
+
+// GetAllObserverChains returns the chains assigned to all the observers with assigned chains
+func (k Keeper) GetAllObserverChains(ctx sdk.Context) (list []types.ObserverChains) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverChainsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObserverChains
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// IsObserverAssignedToChain returns true if the observer observes the chain
+// an observer without assigned chains observes all the chains
+// all the observers are assigned to ZetaChain as they all vote on the ZetaChain ballots like the keygen blames
+func (k Keeper) IsObserverAssignedToChain(ctx sdk.Context, observerAddress string, chainID int64) bool {
+	if common.IsZetaChain(chainID) {
+		return true
+	}
+	observerChains, found := k.GetObserverChains(ctx, observerAddress)
+	if !found {
+		return true
+	}
+	for _, assignedChainID := range observerChains.ChainIds {
+		if assignedChainID == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetObserversForChain returns the observers of the list that are assigned to the chain
+func (k Keeper) GetObserversForChain(ctx sdk.Context, observers []string, chainID int64) []string {
+	assigned := make([]string, 0, len(observers))
+	for _, observer := range observers {
+		if k.IsObserverAssignedToChain(ctx, observer, chainID) {
+			assigned = append(assigned, observer)
+		}
+	}
+	return assigned
+}
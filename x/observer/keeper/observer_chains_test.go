@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_GetObserverChains(t *testing.T) {
+	t.Run("should set, get and remove observer chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observer := sample.AccAddress()
+
+		_, found := k.GetObserverChains(ctx, observer)
+		require.False(t, found)
+
+		observerChains := types.ObserverChains{
+			ObserverAddress: observer,
+			ChainIds:        []int64{1, 56},
+		}
+		k.SetObserverChains(ctx, observerChains)
+		res, found := k.GetObserverChains(ctx, observer)
+		require.True(t, found)
+		require.Equal(t, observerChains, res)
+
+		k.RemoveObserverChains(ctx, observer)
+		_, found = k.GetObserverChains(ctx, observer)
+		require.False(t, found)
+	})
+
+	t.Run("should get all observer chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observers := []string{sample.AccAddress(), sample.AccAddress()}
+		sort.Strings(observers)
+		list := []types.ObserverChains{
+			{ObserverAddress: observers[0], ChainIds: []int64{1}},
+			{ObserverAddress: observers[1], ChainIds: []int64{56, 137}},
+		}
+		for _, observerChains := range list {
+			k.SetObserverChains(ctx, observerChains)
+		}
+		require.Equal(t, list, k.GetAllObserverChains(ctx))
+	})
+}
+
+func TestKeeper_IsObserverAssignedToChain(t *testing.T) {
+	t.Run("should return true if the observer has no assigned chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		require.True(t, k.IsObserverAssignedToChain(ctx, sample.AccAddress(), 1))
+	})
+
+	t.Run("should return true only for the assigned chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observer := sample.AccAddress()
+		k.SetObserverChains(ctx, types.ObserverChains{
+			ObserverAddress: observer,
+			ChainIds:        []int64{1, 56},
+		})
+		require.True(t, k.IsObserverAssignedToChain(ctx, observer, 1))
+		require.True(t, k.IsObserverAssignedToChain(ctx, observer, 56))
+		require.False(t, k.IsObserverAssignedToChain(ctx, observer, 137))
+	})
+
+	t.Run("should return true for zeta chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		observer := sample.AccAddress()
+		k.SetObserverChains(ctx, types.ObserverChains{
+			ObserverAddress: observer,
+			ChainIds:        []int64{1},
+		})
+		require.True(t, k.IsObserverAssignedToChain(ctx, observer, common.ZetaPrivnetChain().ChainId))
+	})
+}
+
+func TestKeeper_GetObserversForChain(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	k.SetObserverChains(ctx, types.ObserverChains{
+		ObserverAddress: observers[0],
+		ChainIds:        []int64{1},
+	})
+	k.SetObserverChains(ctx, types.ObserverChains{
+		ObserverAddress: observers[1],
+		ChainIds:        []int64{56},
+	})
+
+	require.Equal(t, []string{observers[0], observers[2]}, k.GetObserversForChain(ctx, observers, 1))
+	require.Equal(t, []string{observers[1], observers[2]}, k.GetObserversForChain(ctx, observers, 56))
+	require.Equal(t, []string{observers[2]}, k.GetObserversForChain(ctx, observers, 137))
+}
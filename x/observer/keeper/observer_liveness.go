@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetObserverLiveness sets the liveness state of an observer
+func (k Keeper) SetObserverLiveness(ctx sdk.Context, liveness types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKeyPrefix))
+	b := k.cdc.MustMarshal(&liveness)
+	store.Set([]byte(liveness.ObserverAddress), b)
+}
+
+// GetObserverLiveness returns the liveness state of an observer
+func (k Keeper) GetObserverLiveness(ctx sdk.Context, observerAddress string) (val types.ObserverLiveness, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKeyPrefix))
+	b := store.Get([]byte(observerAddress))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllObserverLiveness returns the liveness state of all the observers
+func (k Keeper) GetAllObserverLiveness(ctx sdk.Context) (list []types.ObserverLiveness) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverLivenessKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ObserverLiveness
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// IsObserverJailed returns true if the observer is jailed for missing ballots
+func (k Keeper) IsObserverJailed(ctx sdk.Context, observerAddress string) bool {
+	liveness, found := k.GetObserverLiveness(ctx, observerAddress)
+	return found && liveness.Jailed
+}
+
+// GetUnjailedObservers returns the observers of the list that are not jailed
+func (k Keeper) GetUnjailedObservers(ctx sdk.Context, observers []string) []string {
+	unjailed := make([]string, 0, len(observers))
+	for _, observer := range observers {
+		if !k.IsObserverJailed(ctx, observer) {
+			unjailed = append(unjailed, observer)
+		}
+	}
+	return unjailed
+}
+
+// UpdateObserverLivenessForBallot accounts a matured ballot in the liveness window of its voters
+func (k Keeper) UpdateObserverLivenessForBallot(ctx sdk.Context, params types.Params, ballot types.Ballot) {
+	if !params.IsLivenessEnabled() {
+		return
+	}
+	for i, voter := range ballot.VoterList {
+		if i >= len(ballot.Votes) {
+			break
+		}
+		k.UpdateObserverLiveness(ctx, params, voter, ballot.Votes[i] == types.VoteType_NotYetVoted)
+	}
+}
+
+// UpdateObserverLiveness accounts a matured ballot in the liveness window of a voter
+// the observer is jailed and slashed at the end of the window if it missed more ballots than allowed
+func (k Keeper) UpdateObserverLiveness(ctx sdk.Context, params types.Params, observerAddress string, missed bool) {
+	if !params.IsLivenessEnabled() {
+		return
+	}
+	liveness, found := k.GetObserverLiveness(ctx, observerAddress)
+	if !found {
+		liveness = types.ObserverLiveness{ObserverAddress: observerAddress}
+	}
+
+	// the ballots maturing while the observer is jailed are not accounted
+	if liveness.Jailed {
+		return
+	}
+
+	liveness.WindowBallots++
+	if missed {
+		liveness.WindowMissedBallots++
+	}
+
+	if liveness.WindowBallots >= params.MissedBallotsWindow {
+		missedRatio := sdk.NewDec(liveness.WindowMissedBallots).QuoInt64(liveness.WindowBallots)
+		if missedRatio.GT(params.MaxMissedBallotsRatio) {
+			k.jailObserver(ctx, params, liveness)
+			return
+		}
+		liveness.WindowBallots = 0
+		liveness.WindowMissedBallots = 0
+	}
+	k.SetObserverLiveness(ctx, liveness)
+}
+
+// jailObserver removes the observer from the voters of the new ballots for the jail duration and slashes its validator
+func (k Keeper) jailObserver(ctx sdk.Context, params types.Params, liveness types.ObserverLiveness) {
+	slashedAmount := k.slashObserver(ctx, liveness.ObserverAddress, params.ObserverSlashFraction)
+
+	EmitEventObserverJailed(ctx, liveness, ctx.BlockHeight()+params.ObserverJailDuration, slashedAmount)
+
+	liveness.Jailed = true
+	liveness.JailedUntil = ctx.BlockHeight() + params.ObserverJailDuration
+	liveness.JailCount++
+	liveness.WindowBallots = 0
+	liveness.WindowMissedBallots = 0
+	k.SetObserverLiveness(ctx, liveness)
+}
+
+// slashObserver slashes the validator of the observer by the slash fraction, it returns the slashed amount
+func (k Keeper) slashObserver(ctx sdk.Context, observerAddress string, slashFraction sdk.Dec) math.Int {
+	if slashFraction.IsNil() || !slashFraction.IsPositive() {
+		return math.ZeroInt()
+	}
+	valAddress, err := types.GetOperatorAddressFromAccAddress(observerAddress)
+	if err != nil {
+		return math.ZeroInt()
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddress)
+	if !found {
+		return math.ZeroInt()
+	}
+	consAddress, err := validator.GetConsAddr()
+	if err != nil {
+		return math.ZeroInt()
+	}
+	power := validator.GetConsensusPower(sdk.DefaultPowerReduction)
+	if power == 0 {
+		return math.ZeroInt()
+	}
+	return k.stakingKeeper.Slash(ctx, consAddress, ctx.BlockHeight(), power, slashFraction)
+}
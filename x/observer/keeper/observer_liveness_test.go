@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	zetacommon "github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// livenessParams returns params with a liveness window of 4 ballots
+func livenessParams() types.Params {
+	params := types.DefaultParams()
+	params.MissedBallotsWindow = 4
+	params.MaxMissedBallotsRatio = sdk.MustNewDecFromStr("0.5")
+	params.ObserverJailDuration = 100
+	params.ObserverSlashFraction = sdk.MustNewDecFromStr("0.01")
+	return params
+}
+
+func TestKeeper_UpdateObserverLiveness(t *testing.T) {
+	t.Run("should not update the liveness if the liveness policy is disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := livenessParams()
+		params.MissedBallotsWindow = 0
+		observer := sample.AccAddress()
+
+		k.UpdateObserverLiveness(ctx, params, observer, true)
+		_, found := k.GetObserverLiveness(ctx, observer)
+		require.False(t, found)
+	})
+
+	t.Run("should restart the window if the observer missed less ballots than allowed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := livenessParams()
+		observer := sample.AccAddress()
+
+		k.UpdateObserverLiveness(ctx, params, observer, true)
+		k.UpdateObserverLiveness(ctx, params, observer, false)
+		k.UpdateObserverLiveness(ctx, params, observer, true)
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.EqualValues(t, 3, liveness.WindowBallots)
+		require.EqualValues(t, 2, liveness.WindowMissedBallots)
+
+		k.UpdateObserverLiveness(ctx, params, observer, false)
+		liveness, found = k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.False(t, liveness.Jailed)
+		require.EqualValues(t, 0, liveness.WindowBallots)
+		require.EqualValues(t, 0, liveness.WindowMissedBallots)
+	})
+
+	t.Run("should jail and slash the observer if it missed more ballots than allowed", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMocksAll)
+		ctx = ctx.WithBlockHeight(42)
+		params := livenessParams()
+
+		validator := sample.Validator(t, sample.Rand())
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
+		observer, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+		require.NoError(t, err)
+
+		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		stakingMock.MockGetValidator(validator)
+		stakingMock.MockSlash(sdk.NewInt(1000))
+
+		for i := 0; i < 4; i++ {
+			k.UpdateObserverLiveness(ctx, params, observer.String(), i != 0)
+		}
+
+		liveness, found := k.GetObserverLiveness(ctx, observer.String())
+		require.True(t, found)
+		require.True(t, liveness.Jailed)
+		require.EqualValues(t, 142, liveness.JailedUntil)
+		require.EqualValues(t, 1, liveness.JailCount)
+		require.EqualValues(t, 0, liveness.WindowBallots)
+		require.True(t, k.IsObserverJailed(ctx, observer.String()))
+		consAddress, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		stakingMock.AssertCalled(t, "Slash", mock.Anything, consAddress, int64(42), int64(10), params.ObserverSlashFraction)
+	})
+
+	t.Run("should not update the window of a jailed observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := livenessParams()
+		observer := sample.AccAddress()
+		k.SetObserverLiveness(ctx, types.ObserverLiveness{
+			ObserverAddress: observer,
+			Jailed:          true,
+			JailedUntil:     100,
+		})
+
+		k.UpdateObserverLiveness(ctx, params, observer, true)
+		liveness, found := k.GetObserverLiveness(ctx, observer)
+		require.True(t, found)
+		require.EqualValues(t, 0, liveness.WindowBallots)
+	})
+}
+
+func TestKeeper_UpdateObserverLivenessForBallot(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	params := livenessParams()
+	voters := []string{sample.AccAddress(), sample.AccAddress()}
+
+	k.UpdateObserverLivenessForBallot(ctx, params, types.Ballot{
+		VoterList: voters,
+		Votes:     []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted},
+	})
+
+	liveness, found := k.GetObserverLiveness(ctx, voters[0])
+	require.True(t, found)
+	require.EqualValues(t, 1, liveness.WindowBallots)
+	require.EqualValues(t, 0, liveness.WindowMissedBallots)
+
+	liveness, found = k.GetObserverLiveness(ctx, voters[1])
+	require.True(t, found)
+	require.EqualValues(t, 1, liveness.WindowBallots)
+	require.EqualValues(t, 1, liveness.WindowMissedBallots)
+}
+
+func TestKeeper_GetUnjailedObservers(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	observers := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	k.SetObserverLiveness(ctx, types.ObserverLiveness{ObserverAddress: observers[0], Jailed: true})
+	k.SetObserverLiveness(ctx, types.ObserverLiveness{ObserverAddress: observers[1], Jailed: false})
+
+	require.Equal(t, []string{observers[1], observers[2]}, k.GetUnjailedObservers(ctx, observers))
+}
+
+func TestKeeper_FindBallotWithJailedObserver(t *testing.T) {
+	k, ctx, _, _ := keepertest.ObserverKeeper(t)
+	chain := zetacommon.GoerliLocalnetChain()
+	observerSet := sample.ObserverSet(3)
+	k.SetObserverSet(ctx, observerSet)
+	setSupportedChain(ctx, *k, chain.ChainId)
+	k.SetObserverLiveness(ctx, types.ObserverLiveness{ObserverAddress: observerSet.ObserverList[0], Jailed: true})
+
+	ballot, _, err := k.FindBallot(ctx, "foo", &chain, types.ObservationType_InBoundTx)
+	require.NoError(t, err)
+	require.Equal(t, observerSet.ObserverList[1:], ballot.VoterList)
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetObserverPerformance sets the participation counters of an observer for a chain and an observation type
+func (k Keeper) SetObserverPerformance(ctx sdk.Context, performance types.ObserverPerformance) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverPerformanceKeyPrefix))
+	b := k.cdc.MustMarshal(&performance)
+	store.Set(types.ObserverPerformanceKey(performance.ObserverAddress, performance.ChainId, performance.ObservationType), b)
+}
+
+// GetObserverPerformance returns the participation counters of an observer for a chain and an observation type
+func (k Keeper) GetObserverPerformance(
+	ctx sdk.Context,
+	observerAddress string,
+	chainID int64,
+	observationType types.ObservationType,
+) (val types.ObserverPerformance, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ObserverPerformanceKeyPrefix))
+	b := store.Get(types.ObserverPerformanceKey(observerAddress, chainID, observationType))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// getOrInitObserverPerformance returns the participation counters of an observer, empty counters are returned if not found
+func (k Keeper) getOrInitObserverPerformance(
+	ctx sdk.Context,
+	observerAddress string,
+	chainID int64,
+	observationType types.ObservationType,
+) types.ObserverPerformance {
+	performance, found := k.GetObserverPerformance(ctx, observerAddress, chainID, observationType)
+	if !found {
+		performance = types.ObserverPerformance{
+			ObserverAddress: observerAddress,
+			ChainId:         chainID,
+			ObservationType: observationType,
+		}
+	}
+	return performance
+}
+
+// UpdateObserverPerformances updates the participation counters and the liveness of the observers with the ballots maturing at the current height
+func (k Keeper) UpdateObserverPerformances(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, ballotIdentifier := range k.GetMaturedBallotList(ctx) {
+		ballot, found := k.GetBallot(ctx, ballotIdentifier)
+		if !found {
+			continue
+		}
+		k.UpdateObserverPerformancesForBallot(ctx, ballot)
+		k.UpdateObserverLivenessForBallot(ctx, params, ballot)
+	}
+}
+
+// UpdateObserverPerformancesForBallot updates the participation counters of the voters of a matured ballot
+func (k Keeper) UpdateObserverPerformancesForBallot(ctx sdk.Context, ballot types.Ballot) {
+	for i, voter := range ballot.VoterList {
+		if i >= len(ballot.Votes) {
+			break
+		}
+		vote := ballot.Votes[i]
+
+		performance := k.getOrInitObserverPerformance(ctx, voter, ballot.ChainId, ballot.ObservationType)
+		performance.BallotsEligible++
+		if vote == types.VoteType_NotYetVoted {
+			performance.BallotsMissed++
+		} else {
+			performance.BallotsVoted++
+			if ballot.IsVoteWithMajority(vote) {
+				performance.BallotsVotedWithMajority++
+			}
+		}
+		performance.LastUpdatedHeight = ctx.BlockHeight()
+		k.SetObserverPerformance(ctx, performance)
+	}
+}
+
+// recordLateVote increments the late votes counter of an observer that voted on an already finalized ballot
+func (k Keeper) recordLateVote(ctx sdk.Context, ballot types.Ballot, voter string) {
+	performance := k.getOrInitObserverPerformance(ctx, voter, ballot.ChainId, ballot.ObservationType)
+	performance.BallotsVotedLate++
+	performance.LastUpdatedHeight = ctx.BlockHeight()
+	k.SetObserverPerformance(ctx, performance)
+}
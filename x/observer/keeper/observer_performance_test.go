@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_UpdateObserverPerformances(t *testing.T) {
+	t.Run("should update the counters of the voters of the matured ballots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := k.GetParams(ctx)
+		params.BallotMaturityBlocks = 10
+		k.SetParams(ctx, params)
+
+		voters := []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+		ballots := []types.Ballot{
+			{
+				BallotIdentifier:     "foo",
+				VoterList:            voters,
+				Votes:                []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_FailureObservation, types.VoteType_NotYetVoted},
+				ObservationType:      types.ObservationType_InBoundTx,
+				BallotStatus:         types.BallotStatus_BallotFinalized_SuccessObservation,
+				BallotCreationHeight: 42,
+				ChainId:              1,
+			},
+			{
+				BallotIdentifier:     "bar",
+				VoterList:            voters,
+				Votes:                []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted, types.VoteType_NotYetVoted},
+				ObservationType:      types.ObservationType_InBoundTx,
+				BallotStatus:         types.BallotStatus_BallotInProgress,
+				BallotCreationHeight: 42,
+				ChainId:              1,
+			},
+		}
+		for _, ballot := range ballots {
+			ballot := ballot
+			k.SetBallot(ctx, &ballot)
+			k.AddBallotToList(ctx, ballot)
+		}
+
+		ctx = ctx.WithBlockHeight(52)
+		k.UpdateObserverPerformances(ctx)
+
+		performance, found := k.GetObserverPerformance(ctx, voters[0], 1, types.ObservationType_InBoundTx)
+		require.True(t, found)
+		require.EqualValues(t, 2, performance.BallotsEligible)
+		require.EqualValues(t, 2, performance.BallotsVoted)
+		require.EqualValues(t, 1, performance.BallotsVotedWithMajority)
+		require.EqualValues(t, 0, performance.BallotsMissed)
+		require.EqualValues(t, 52, performance.LastUpdatedHeight)
+
+		performance, found = k.GetObserverPerformance(ctx, voters[1], 1, types.ObservationType_InBoundTx)
+		require.True(t, found)
+		require.EqualValues(t, 2, performance.BallotsEligible)
+		require.EqualValues(t, 1, performance.BallotsVoted)
+		require.EqualValues(t, 0, performance.BallotsVotedWithMajority)
+		require.EqualValues(t, 1, performance.BallotsMissed)
+
+		performance, found = k.GetObserverPerformance(ctx, voters[2], 1, types.ObservationType_InBoundTx)
+		require.True(t, found)
+		require.EqualValues(t, 2, performance.BallotsEligible)
+		require.EqualValues(t, 0, performance.BallotsVoted)
+		require.EqualValues(t, 2, performance.BallotsMissed)
+
+		_, found = k.GetObserverPerformance(ctx, voters[0], 1, types.ObservationType_OutBoundTx)
+		require.False(t, found)
+	})
+
+	t.Run("should not update the counters if no ballot is matured", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := k.GetParams(ctx)
+		params.BallotMaturityBlocks = 10
+		k.SetParams(ctx, params)
+
+		voter := sample.AccAddress()
+		ballot := types.Ballot{
+			BallotIdentifier:     "foo",
+			VoterList:            []string{voter},
+			Votes:                []types.VoteType{types.VoteType_SuccessObservation},
+			BallotCreationHeight: 42,
+		}
+		k.SetBallot(ctx, &ballot)
+		k.AddBallotToList(ctx, ballot)
+
+		ctx = ctx.WithBlockHeight(51)
+		k.UpdateObserverPerformances(ctx)
+
+		_, found := k.GetObserverPerformance(ctx, voter, 0, types.ObservationType_EmptyObserverType)
+		require.False(t, found)
+	})
+}
+
+func TestKeeper_AddVoteToBallot(t *testing.T) {
+	t.Run("should count a vote on a finalized ballot as late", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		voters := []string{sample.AccAddress(), sample.AccAddress()}
+		ballot := types.Ballot{
+			BallotIdentifier: "foo",
+			VoterList:        voters,
+			Votes:            []types.VoteType{types.VoteType_SuccessObservation, types.VoteType_NotYetVoted},
+			ObservationType:  types.ObservationType_OutBoundTx,
+			BallotStatus:     types.BallotStatus_BallotFinalized_SuccessObservation,
+			ChainId:          1,
+		}
+
+		_, err := k.AddVoteToBallot(ctx, ballot, voters[1], types.VoteType_SuccessObservation)
+		require.NoError(t, err)
+
+		performance, found := k.GetObserverPerformance(ctx, voters[1], 1, types.ObservationType_OutBoundTx)
+		require.True(t, found)
+		require.EqualValues(t, 1, performance.BallotsVotedLate)
+	})
+
+	t.Run("should not count a vote on a ballot in progress as late", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		voters := []string{sample.AccAddress(), sample.AccAddress()}
+		ballot := types.Ballot{
+			BallotIdentifier: "foo",
+			VoterList:        voters,
+			Votes:            []types.VoteType{types.VoteType_NotYetVoted, types.VoteType_NotYetVoted},
+			ObservationType:  types.ObservationType_OutBoundTx,
+			BallotStatus:     types.BallotStatus_BallotInProgress,
+			ChainId:          1,
+		}
+
+		_, err := k.AddVoteToBallot(ctx, ballot, voters[1], types.VoteType_SuccessObservation)
+		require.NoError(t, err)
+
+		_, found := k.GetObserverPerformance(ctx, voters[1], 1, types.ObservationType_OutBoundTx)
+		require.False(t, found)
+	})
+}
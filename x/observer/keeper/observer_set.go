@@ -76,6 +76,13 @@ func (k Keeper) UpdateObserverAddress(ctx sdk.Context, oldObserverAddress, newOb
 		if addr == oldObserverAddress {
 			observerSet.ObserverList[i] = newObserverAddress
 			k.SetObserverSet(ctx, observerSet)
+
+			// the new observer address keeps the chains assigned to the old one
+			if observerChains, found := k.GetObserverChains(ctx, oldObserverAddress); found {
+				k.RemoveObserverChains(ctx, oldObserverAddress)
+				observerChains.ObserverAddress = newObserverAddress
+				k.SetObserverChains(ctx, observerChains)
+			}
 			return nil
 		}
 	}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// SetPendingChainParams schedules chain params to be applied at the activation height
+// a previously scheduled chain params for the same chain is replaced
+func (k Keeper) SetPendingChainParams(ctx sdk.Context, pending types.PendingChainParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingChainParamsKeyPrefix))
+	b := k.cdc.MustMarshal(&pending)
+	store.Set(types.PendingChainParamsKey(pending.ChainParams.ChainId), b)
+}
+
+// GetPendingChainParams returns the chain params scheduled for a chain
+func (k Keeper) GetPendingChainParams(ctx sdk.Context, chainID int64) (val types.PendingChainParams, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingChainParamsKeyPrefix))
+	b := store.Get(types.PendingChainParamsKey(chainID))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemovePendingChainParams removes the chain params scheduled for a chain
+func (k Keeper) RemovePendingChainParams(ctx sdk.Context, chainID int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingChainParamsKeyPrefix))
+	store.Delete(types.PendingChainParamsKey(chainID))
+}
+
+// GetAllPendingChainParams returns the chain params scheduled for all chains
+func (k Keeper) GetAllPendingChainParams(ctx sdk.Context) (list []types.PendingChainParams) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingChainParamsKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PendingChainParams
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// ApplyPendingChainParams applies the scheduled chain params whose activation height is reached
+// it is called in BeginBlock so all the zetaclients observe the new chain params from the same block
+func (k Keeper) ApplyPendingChainParams(ctx sdk.Context) {
+	for _, pending := range k.GetAllPendingChainParams(ctx) {
+		if pending.ActivationHeight > ctx.BlockHeight() {
+			continue
+		}
+		k.SetChainParamsForChain(ctx, pending.ChainParams)
+		k.RemovePendingChainParams(ctx, pending.ChainParams.ChainId)
+		EmitEventChainParamsActivated(ctx, pending)
+	}
+}
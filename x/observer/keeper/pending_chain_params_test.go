@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestKeeper_ApplyPendingChainParams(t *testing.T) {
+	t.Run("should apply the chain params once the activation height is reached", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chain1 := common.ExternalChainList()[0].ChainId
+		chain2 := common.ExternalChainList()[1].ChainId
+
+		current := sample.ChainParams(chain1)
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{current}})
+
+		updated := sample.ChainParams(chain1)
+		updated.ConfirmationCount = current.ConfirmationCount + 1
+		added := sample.ChainParams(chain2)
+		k.SetPendingChainParams(ctx, types.PendingChainParams{ChainParams: updated, ActivationHeight: 100})
+		k.SetPendingChainParams(ctx, types.PendingChainParams{ChainParams: added, ActivationHeight: 110})
+
+		// nothing is applied before the activation height
+		k.ApplyPendingChainParams(ctx.WithBlockHeight(99))
+		chainParams, found := k.GetChainParamsByChainID(ctx, chain1)
+		require.True(t, found)
+		require.Equal(t, current, chainParams)
+		require.Len(t, k.GetAllPendingChainParams(ctx), 2)
+
+		// the chain params are updated at the activation height
+		k.ApplyPendingChainParams(ctx.WithBlockHeight(100))
+		chainParams, found = k.GetChainParamsByChainID(ctx, chain1)
+		require.True(t, found)
+		require.Equal(t, updated, chainParams)
+		_, found = k.GetPendingChainParams(ctx, chain1)
+		require.False(t, found)
+		_, found = k.GetChainParamsByChainID(ctx, chain2)
+		require.False(t, found)
+
+		// the chain params are added if the chain has no chain params
+		k.ApplyPendingChainParams(ctx.WithBlockHeight(110))
+		chainParams, found = k.GetChainParamsByChainID(ctx, chain2)
+		require.True(t, found)
+		require.Equal(t, added, chainParams)
+		require.Empty(t, k.GetAllPendingChainParams(ctx))
+	})
+}
+
+func TestKeeper_PendingChainParams(t *testing.T) {
+	t.Run("should error if req is nil", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		res, err := k.PendingChainParams(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, res)
+		require.Error(t, err)
+
+		resForChain, err := k.PendingChainParamsForChain(sdk.WrapSDKContext(ctx), nil)
+		require.Nil(t, resForChain)
+		require.Error(t, err)
+	})
+
+	t.Run("should return the scheduled chain params", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := common.ExternalChainList()[0].ChainId
+		pending := types.PendingChainParams{ChainParams: sample.ChainParams(chainID), ActivationHeight: 100}
+		k.SetPendingChainParams(ctx, pending)
+
+		res, err := k.PendingChainParams(sdk.WrapSDKContext(ctx), &types.QueryPendingChainParamsRequest{})
+		require.NoError(t, err)
+		require.Equal(t, []types.PendingChainParams{pending}, res.PendingChainParams)
+
+		resForChain, err := k.PendingChainParamsForChain(sdk.WrapSDKContext(ctx), &types.QueryPendingChainParamsForChainRequest{
+			ChainId: chainID,
+		})
+		require.NoError(t, err)
+		require.Equal(t, pending, resForChain.PendingChainParams)
+
+		_, err = k.PendingChainParamsForChain(sdk.WrapSDKContext(ctx), &types.QueryPendingChainParamsForChainRequest{
+			ChainId: common.ExternalChainList()[1].ChainId,
+		})
+		require.Error(t, err)
+	})
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/observer/types"
@@ -15,6 +16,11 @@ func (k Keeper) AddVoteToBallot(ctx sdk.Context, ballot types.Ballot, address st
 	}
 	ctx.Logger().Info(fmt.Sprintf("Vote Added | Voter :%s, ballot idetifier %s", address, ballot.BallotIdentifier))
 	k.SetBallot(ctx, &ballot)
+
+	// a vote on a finalized ballot is not accounted in the outcome of the ballot
+	if ballot.BallotStatus != types.BallotStatus_BallotInProgress {
+		k.recordLateVote(ctx, ballot, address)
+	}
 	return ballot, err
 }
 
@@ -31,13 +37,16 @@ func (k Keeper) CheckIfFinalizingVote(ctx sdk.Context, ballot types.Ballot) (typ
 }
 
 // IsAuthorized checks whether a signer is authorized to sign
-// This function checks if the signer is present in the observer set
+// This function checks if the signer is present in the observer set, is not jailed for missing ballots
 // and also checks if the signer is not tombstoned
 func (k Keeper) IsAuthorized(ctx sdk.Context, address string) bool {
 	isPresentInMapper := k.IsAddressPartOfObserverSet(ctx, address)
 	if !isPresentInMapper {
 		return false
 	}
+	if k.IsObserverJailed(ctx, address) {
+		return false
+	}
 	isTombstoned, err := k.IsOperatorTombstoned(ctx, address)
 	if err != nil || isTombstoned {
 		return false
@@ -55,6 +64,10 @@ func (k Keeper) FindBallot(
 	ballot, found := k.GetBallot(ctx, index)
 	if !found {
 		observerSet, _ := k.GetObserverSet(ctx)
+		// only the observers assigned to the chain vote on the ballot
+		voters := k.GetObserversForChain(ctx, observerSet.ObserverList, chain.ChainId)
+		// the jailed observers are removed from the voters until they are unjailed
+		voters = k.GetUnjailedObservers(ctx, voters)
 
 		cp, found := k.GetChainParamsByChainID(ctx, chain.ChainId)
 		if !found || cp == nil || !cp.IsSupported {
@@ -65,12 +78,16 @@ func (k Keeper) FindBallot(
 		ballot = types.Ballot{
 			Index:                "",
 			BallotIdentifier:     index,
-			VoterList:            observerSet.ObserverList,
-			Votes:                types.CreateVotes(len(observerSet.ObserverList)),
+			VoterList:            voters,
+			Votes:                types.CreateVotes(len(voters)),
 			ObservationType:      observationType,
 			BallotThreshold:      cp.BallotThreshold,
 			BallotStatus:         types.BallotStatus_BallotInProgress,
 			BallotCreationHeight: ctx.BlockHeight(),
+			ChainId:              chain.ChainId,
+		}
+		if cp.StakeWeightedBallots {
+			ballot.VoterWeights = k.GetVoterWeights(ctx, voters)
 		}
 		isNew = true
 		k.AddBallotToList(ctx, ballot)
@@ -78,6 +95,25 @@ func (k Keeper) FindBallot(
 	return
 }
 
+// GetVoterWeights returns the bonded stake of the validators of the observers
+// the weight of an observer is zero if its validator is not found or not bonded
+func (k Keeper) GetVoterWeights(ctx sdk.Context, observers []string) []sdkmath.Int {
+	weights := make([]sdkmath.Int, len(observers))
+	for i, observer := range observers {
+		weights[i] = sdkmath.ZeroInt()
+		valAddress, err := types.GetOperatorAddressFromAccAddress(observer)
+		if err != nil {
+			continue
+		}
+		validator, found := k.stakingKeeper.GetValidator(ctx, valAddress)
+		if !found {
+			continue
+		}
+		weights[i] = validator.BondedTokens()
+	}
+	return weights
+}
+
 func (k Keeper) IsValidator(ctx sdk.Context, creator string) error {
 	valAddress, err := types.GetOperatorAddressFromAccAddress(creator)
 	if err != nil {
@@ -5,8 +5,10 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 	zetacommon "github.com/zeta-chain/zetacore/common"
 	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
@@ -93,6 +95,36 @@ func TestKeeper_IsAuthorized(t *testing.T) {
 
 		require.False(t, k.IsAuthorized(ctx, accAddressOfValidator.String()))
 
+	})
+	t.Run("not authorized for jailed observer", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		r := rand.New(rand.NewSource(9))
+
+		// Set validator in the store
+		validator := sample.Validator(t, r)
+		k.GetStakingKeeper().SetValidator(ctx, validator)
+		consAddress, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		k.GetSlashingKeeper().SetValidatorSigningInfo(ctx, consAddress, slashingtypes.ValidatorSigningInfo{
+			Address:             consAddress.String(),
+			StartHeight:         0,
+			JailedUntil:         ctx.BlockHeader().Time.Add(1000000 * time.Second),
+			Tombstoned:          false,
+			MissedBlocksCounter: 1,
+		})
+
+		accAddressOfValidator, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+		k.SetObserverSet(ctx, types.ObserverSet{
+			ObserverList: []string{accAddressOfValidator.String()},
+		})
+		k.SetObserverLiveness(ctx, types.ObserverLiveness{
+			ObserverAddress: accAddressOfValidator.String(),
+			Jailed:          true,
+		})
+
+		require.False(t, k.IsAuthorized(ctx, accAddressOfValidator.String()))
+
 	})
 	t.Run("not authorized for non-validator observer", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeper(t)
@@ -121,3 +153,84 @@ func TestKeeper_IsAuthorized(t *testing.T) {
 
 	})
 }
+
+func TestKeeper_FindBallot(t *testing.T) {
+	t.Run("should snapshot the bonded stake of the voters if the chain has stake weighted ballots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		r := rand.New(rand.NewSource(9))
+		chain := zetacommon.GoerliLocalnetChain()
+
+		validator := sample.Validator(t, r)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.NewInt(100)
+		k.GetStakingKeeper().SetValidator(ctx, validator)
+		observer, err := types.GetAccAddressFromOperatorAddress(validator.OperatorAddress)
+		require.NoError(t, err)
+		unknownObserver := sample.AccAddress()
+		k.SetObserverSet(ctx, types.ObserverSet{
+			ObserverList: []string{observer.String(), unknownObserver},
+		})
+
+		chainParams := sample.ChainParamsSupported(chain.ChainId)
+		chainParams.StakeWeightedBallots = true
+		k.SetChainParamsList(ctx, types.ChainParamsList{ChainParams: []*types.ChainParams{chainParams}})
+
+		ballot, isNew, err := k.FindBallot(ctx, "foo", &chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.True(t, ballot.IsStakeWeighted())
+		require.Equal(t, []sdkmath.Int{sdk.NewInt(100), sdk.ZeroInt()}, ballot.VoterWeights)
+	})
+
+	t.Run("should not set voter weights if the chain doesn't have stake weighted ballots", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chain := zetacommon.GoerliLocalnetChain()
+		k.SetObserverSet(ctx, sample.ObserverSet(3))
+		setSupportedChain(ctx, *k, chain.ChainId)
+
+		ballot, isNew, err := k.FindBallot(ctx, "foo", &chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.False(t, ballot.IsStakeWeighted())
+		require.Empty(t, ballot.VoterWeights)
+	})
+
+	t.Run("should only add the observers assigned to the chain to the voters", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chain := zetacommon.GoerliLocalnetChain()
+		otherChain := zetacommon.GoerliChain()
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		setSupportedChain(ctx, *k, chain.ChainId, otherChain.ChainId)
+		k.SetObserverChains(ctx, types.ObserverChains{
+			ObserverAddress: observerSet.ObserverList[1],
+			ChainIds:        []int64{otherChain.ChainId},
+		})
+
+		ballot, isNew, err := k.FindBallot(ctx, "foo", &chain, types.ObservationType_InBoundTx)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, []string{observerSet.ObserverList[0], observerSet.ObserverList[2]}, ballot.VoterList)
+		require.Len(t, ballot.Votes, 2)
+	})
+
+	t.Run("should add all the observers to the voters of a zeta chain ballot", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chain := zetacommon.ZetaPrivnetChain()
+		otherChain := zetacommon.GoerliChain()
+		observerSet := sample.ObserverSet(3)
+		k.SetObserverSet(ctx, observerSet)
+		setSupportedChain(ctx, *k, chain.ChainId, otherChain.ChainId)
+		for _, observer := range observerSet.ObserverList {
+			k.SetObserverChains(ctx, types.ObserverChains{
+				ObserverAddress: observer,
+				ChainIds:        []int64{otherChain.ChainId},
+			})
+		}
+
+		ballot, isNew, err := k.FindBallot(ctx, "foo", &chain, types.ObservationType_TSSKeySign)
+		require.NoError(t, err)
+		require.True(t, isNew)
+		require.Equal(t, observerSet.ObserverList, ballot.VoterList)
+	})
+}
@@ -41,6 +41,9 @@ func (k Keeper) VoteOnInboundBallot(
 	if ok := k.IsAuthorized(ctx, voter); !ok {
 		return false, false, types.ErrNotObserver
 	}
+	if !k.IsObserverAssignedToChain(ctx, voter, senderChainID) {
+		return false, false, types.ErrObserverNotAssignedToChain
+	}
 
 	// makes sure we are getting only supported chains
 	receiverChain := k.GetSupportedChainFromChainID(ctx, receiverChainID)
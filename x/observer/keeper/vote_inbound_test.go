@@ -106,6 +106,51 @@ func TestKeeper_VoteOnInboundBallot(t *testing.T) {
 		require.ErrorIs(t, err, types.ErrNotObserver)
 	})
 
+	t.Run("fail if observer not assigned to sender chain", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMocksAll)
+
+		observer := sample.AccAddress()
+		stakingMock := keepertest.GetObserverStakingMock(t, k)
+		slashingMock := keepertest.GetObserverSlashingMock(t, k)
+
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			IsInboundEnabled: true,
+		})
+		k.SetChainParamsList(ctx, types.ChainParamsList{
+			ChainParams: []*types.ChainParams{
+				{
+					ChainId:     getValidEthChainIDWithIndex(t, 0),
+					IsSupported: true,
+				},
+				{
+					ChainId:     getValidEthChainIDWithIndex(t, 1),
+					IsSupported: true,
+				},
+			},
+		})
+		k.SetObserverSet(ctx, types.ObserverSet{
+			ObserverList: []string{observer},
+		})
+		k.SetObserverChains(ctx, types.ObserverChains{
+			ObserverAddress: observer,
+			ChainIds:        []int64{getValidEthChainIDWithIndex(t, 1)},
+		})
+		stakingMock.MockGetValidator(sample.Validator(t, sample.Rand()))
+		slashingMock.MockIsTombstoned(false)
+
+		_, _, err := k.VoteOnInboundBallot(
+			ctx,
+			getValidEthChainIDWithIndex(t, 0),
+			getValidEthChainIDWithIndex(t, 1),
+			common.CoinType_ERC20,
+			observer,
+			"index",
+			"inTxHash",
+		)
+		require.Error(t, err)
+		require.ErrorIs(t, err, types.ErrObserverNotAssignedToChain)
+	})
+
 	t.Run("fail if receiver chain not supported", func(t *testing.T) {
 		k, ctx, _, _ := keepertest.ObserverKeeperWithMocks(t, keepertest.ObserverMocksAll)
 
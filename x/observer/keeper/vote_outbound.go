@@ -34,6 +34,9 @@ func (k Keeper) VoteOnOutboundBallot(
 	if ok := k.IsAuthorized(ctx, voter); !ok {
 		return false, false, ballot, "", observertypes.ErrNotObserver
 	}
+	if !k.IsObserverAssignedToChain(ctx, voter, outTxChainID) {
+		return false, false, ballot, "", observertypes.ErrObserverNotAssignedToChain
+	}
 
 	// fetch or create ballot
 	ballot, isNew, err = k.FindBallot(ctx, ballotIndex, observationChain, observertypes.ObservationType_OutBoundTx)
@@ -0,0 +1,31 @@
+package v10
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// observerKeeper prevents circular dependency
+type observerKeeper interface {
+	GetCrosschainFlags(ctx sdk.Context) (val types.CrosschainFlags, found bool)
+	SetCrosschainFlags(ctx sdk.Context, crosschainFlags types.CrosschainFlags)
+}
+
+// MigrateStore performs in-place store migrations from v9 to v10
+func MigrateStore(ctx sdk.Context, observerKeeper observerKeeper) error {
+	ctx.Logger().Info("Migrating observer store from v9 to v10")
+	return MigrateBlockHeaderVerificationFlags(ctx, observerKeeper)
+}
+
+// MigrateBlockHeaderVerificationFlags sets the block header verification flags of BSC and Polygon chains
+// BSC headers were verified under the Ethereum flag and keep its value, Polygon headers were not verified and remain disabled
+func MigrateBlockHeaderVerificationFlags(ctx sdk.Context, observerKeeper observerKeeper) error {
+	crosschainFlags, found := observerKeeper.GetCrosschainFlags(ctx)
+	if !found || crosschainFlags.BlockHeaderVerificationFlags == nil {
+		return nil
+	}
+	crosschainFlags.BlockHeaderVerificationFlags.IsBscTypeChainEnabled = crosschainFlags.BlockHeaderVerificationFlags.IsEthTypeChainEnabled
+	crosschainFlags.BlockHeaderVerificationFlags.IsPolygonTypeChainEnabled = false
+	observerKeeper.SetCrosschainFlags(ctx, crosschainFlags)
+	return nil
+}
@@ -0,0 +1,66 @@
+package v10_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v10 "github.com/zeta-chain/zetacore/x/observer/migrations/v10"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("Migrate store from v9 to v10", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			IsInboundEnabled:  true,
+			IsOutboundEnabled: true,
+			BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+				IsEthTypeChainEnabled: true,
+				IsBtcTypeChainEnabled: false,
+			},
+		})
+
+		err := v10.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.True(t, flags.IsInboundEnabled)
+		require.True(t, flags.IsOutboundEnabled)
+		require.Equal(t, types.BlockHeaderVerificationFlags{
+			IsEthTypeChainEnabled:     true,
+			IsBtcTypeChainEnabled:     false,
+			IsBscTypeChainEnabled:     true,
+			IsPolygonTypeChainEnabled: false,
+		}, *flags.BlockHeaderVerificationFlags)
+	})
+
+	t.Run("Keep BSC verification disabled if Ethereum verification is disabled", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		k.SetCrosschainFlags(ctx, types.CrosschainFlags{
+			BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+				IsEthTypeChainEnabled: false,
+				IsBtcTypeChainEnabled: true,
+			},
+		})
+
+		err := v10.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		flags, found := k.GetCrosschainFlags(ctx)
+		require.True(t, found)
+		require.False(t, flags.BlockHeaderVerificationFlags.IsBscTypeChainEnabled)
+		require.False(t, flags.BlockHeaderVerificationFlags.IsPolygonTypeChainEnabled)
+	})
+
+	t.Run("Do nothing if crosschain flags are not set", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		err := v10.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		_, found := k.GetCrosschainFlags(ctx)
+		require.False(t, found)
+	})
+}
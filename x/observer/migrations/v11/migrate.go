@@ -0,0 +1,53 @@
+package v11
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// observerKeeper prevents circular dependency
+type observerKeeper interface {
+	GetParamsIfExists(ctx sdk.Context) (params types.Params)
+	SetParams(ctx sdk.Context, params types.Params)
+	GetAllBlame(ctx sdk.Context) []types.Blame
+	SetBlame(ctx sdk.Context, blame types.Blame)
+	AddBlameToPruneQueue(ctx sdk.Context, blame types.Blame)
+}
+
+// MigrateStore performs in-place store migrations from v10 to v11
+func MigrateStore(ctx sdk.Context, observerKeeper observerKeeper) error {
+	ctx.Logger().Info("Migrating observer store from v10 to v11")
+	if err := MigrateParams(ctx, observerKeeper); err != nil {
+		return err
+	}
+	MigrateBlames(ctx, observerKeeper)
+	return nil
+}
+
+// MigrateParams sets the default retention period of the blame records in the params
+func MigrateParams(ctx sdk.Context, observerKeeper observerKeeper) error {
+	params := observerKeeper.GetParamsIfExists(ctx)
+	params.SetDefaultBlameRetentionBlocks()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	observerKeeper.SetParams(ctx, params)
+	return nil
+}
+
+// MigrateBlames sets the chain and the finalization height of the existing blame records and adds them to the prune queue
+// the finalization height is unknown, the migration height is used so the blames are kept for the retention period
+// the chain is parsed from the blame index, it is left unset if the index has an unexpected format
+func MigrateBlames(ctx sdk.Context, observerKeeper observerKeeper) {
+	for _, blame := range observerKeeper.GetAllBlame(ctx) {
+		if chainID, err := strconv.ParseInt(strings.SplitN(blame.Index, "-", 2)[0], 10, 64); err == nil {
+			blame.ChainId = chainID
+		}
+		blame.FinalizedHeight = ctx.BlockHeight()
+		observerKeeper.SetBlame(ctx, blame)
+		observerKeeper.AddBlameToPruneQueue(ctx, blame)
+	}
+}
@@ -0,0 +1,45 @@
+package v11_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v11 "github.com/zeta-chain/zetacore/x/observer/migrations/v11"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("Migrate store from v10 to v11", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		params := types.DefaultParams()
+		params.BlameRetentionBlocks = 0
+		k.SetParams(ctx, params)
+
+		index := types.GetBlameIndex(97, 101, "85f5e10431f69bc2a14046a13aabaefc660103b6de7a84f75c4b96181d03f0b5", 123)
+		k.SetBlame(ctx, types.Blame{Index: index, Nodes: []*types.Node{{PubKey: "pk1"}}})
+		k.SetBlame(ctx, types.Blame{Index: "invalid", Nodes: []*types.Node{{PubKey: "pk1"}}})
+
+		ctx = ctx.WithBlockHeight(1000)
+		err := v11.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		params = k.GetParams(ctx)
+		require.Equal(t, types.DefaultParams().BlameRetentionBlocks, params.BlameRetentionBlocks)
+
+		blame, found := k.GetBlame(ctx, index)
+		require.True(t, found)
+		require.Equal(t, int64(97), blame.ChainId)
+		require.Equal(t, int64(1000), blame.FinalizedHeight)
+
+		blame, found = k.GetBlame(ctx, "invalid")
+		require.True(t, found)
+		require.Equal(t, int64(0), blame.ChainId)
+		require.Equal(t, int64(1000), blame.FinalizedHeight)
+
+		// the blames are pruned after the retention period
+		require.Equal(t, 0, k.PruneBlames(ctx.WithBlockHeight(1000+params.BlameRetentionBlocks)))
+		require.Equal(t, 2, k.PruneBlames(ctx.WithBlockHeight(1001+params.BlameRetentionBlocks)))
+		require.Empty(t, k.GetAllBlame(ctx))
+	})
+}
@@ -0,0 +1,29 @@
+package v8
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// observerKeeper prevents circular dependency
+type observerKeeper interface {
+	GetParamsIfExists(ctx sdk.Context) (params types.Params)
+	SetParams(ctx sdk.Context, params types.Params)
+}
+
+// MigrateStore performs in-place store migrations from v7 to v8
+func MigrateStore(ctx sdk.Context, observerKeeper observerKeeper) error {
+	ctx.Logger().Info("Migrating observer store from v7 to v8")
+	return MigrateParams(ctx, observerKeeper)
+}
+
+// MigrateParams sets the default liveness policy of the observers in the params
+func MigrateParams(ctx sdk.Context, observerKeeper observerKeeper) error {
+	params := observerKeeper.GetParamsIfExists(ctx)
+	params.SetDefaultLivenessParams()
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	observerKeeper.SetParams(ctx, params)
+	return nil
+}
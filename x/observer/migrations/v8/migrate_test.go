@@ -0,0 +1,32 @@
+package v8_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	v8 "github.com/zeta-chain/zetacore/x/observer/migrations/v8"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("Migrate store from v7 to v8", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		k.SetParams(ctx, types.Params{
+			BallotMaturityBlocks: 42,
+		})
+
+		err := v8.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		params := k.GetParams(ctx)
+		require.EqualValues(t, 42, params.BallotMaturityBlocks)
+		require.True(t, params.IsLivenessEnabled())
+
+		expected := types.DefaultParams()
+		require.Equal(t, expected.MissedBallotsWindow, params.MissedBallotsWindow)
+		require.True(t, expected.MaxMissedBallotsRatio.Equal(params.MaxMissedBallotsRatio))
+		require.Equal(t, expected.ObserverJailDuration, params.ObserverJailDuration)
+		require.True(t, expected.ObserverSlashFraction.Equal(params.ObserverSlashFraction))
+	})
+}
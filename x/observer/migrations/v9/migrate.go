@@ -0,0 +1,63 @@
+package v9
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/bitcoin"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+// observerKeeper prevents circular dependency
+type observerKeeper interface {
+	GetBlockHeader(ctx sdk.Context, hash []byte) (val common.BlockHeader, found bool)
+	GetBlockHeaderState(ctx sdk.Context, chainID int64) (val types.BlockHeaderState, found bool)
+	SetBlockHeaderState(ctx sdk.Context, blockHeaderState types.BlockHeaderState)
+	SetBlockHeaderWork(ctx sdk.Context, work types.BlockHeaderWork)
+	SetBestChainBlockHash(ctx sdk.Context, chainID int64, height int64, hash []byte)
+}
+
+// MigrateStore performs in-place store migrations from v8 to v9
+func MigrateStore(ctx sdk.Context, observerKeeper observerKeeper) error {
+	ctx.Logger().Info("Migrating observer store from v8 to v9")
+	return MigrateBitcoinHeaderChains(ctx, observerKeeper)
+}
+
+// MigrateBitcoinHeaderChains indexes the existing bitcoin block headers as the best header chain
+// and records their accumulated proof-of-work from the earliest block header
+func MigrateBitcoinHeaderChains(ctx sdk.Context, observerKeeper observerKeeper) error {
+	for _, chain := range common.DefaultChainsList() {
+		if !common.IsBitcoinChain(chain.ChainId) {
+			continue
+		}
+		bhs, found := observerKeeper.GetBlockHeaderState(ctx, chain.ChainId)
+		if !found || bhs.EarliestHeight == 0 {
+			continue
+		}
+
+		// headers were only added on top of the latest header, the chain is linear from the latest header
+		var headers []common.BlockHeader
+		header, found := observerKeeper.GetBlockHeader(ctx, bhs.LatestBlockHash)
+		for found {
+			headers = append(headers, header)
+			header, found = observerKeeper.GetBlockHeader(ctx, header.ParentHash)
+		}
+
+		chainWork := sdk.ZeroInt()
+		for i := len(headers) - 1; i >= 0; i-- {
+			btcHeader, err := headers[i].Header.ParseBitcoinHeader()
+			if err != nil {
+				return err
+			}
+			chainWork = chainWork.Add(sdk.NewIntFromBigInt(bitcoin.CalcWork(btcHeader.Bits)))
+			observerKeeper.SetBlockHeaderWork(ctx, types.BlockHeaderWork{
+				BlockHash: headers[i].Hash,
+				Height:    headers[i].Height,
+				ChainWork: chainWork,
+			})
+			observerKeeper.SetBestChainBlockHash(ctx, chain.ChainId, headers[i].Height, headers[i].Hash)
+		}
+		bhs.LatestChainWork = chainWork
+		observerKeeper.SetBlockHeaderState(ctx, bhs)
+	}
+	return nil
+}
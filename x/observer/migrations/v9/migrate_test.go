@@ -0,0 +1,67 @@
+package v9_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	keepertest "github.com/zeta-chain/zetacore/testutil/keeper"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	v9 "github.com/zeta-chain/zetacore/x/observer/migrations/v9"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	t.Run("Migrate store from v8 to v9", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+		chainID := common.BtcRegtestChain().ChainId
+		bits := chaincfg.RegressionNetParams.PowLimitBits
+		headerWork := sdk.NewIntFromBigInt(blockchain.CalcWork(bits))
+		timestamp := time.Unix(1700000000, 0)
+
+		header0 := sample.BitcoinBlockHeader(t, chainID, 100, sample.Hash().Bytes(), timestamp, bits)
+		header1 := sample.BitcoinBlockHeader(t, chainID, 101, header0.Hash, timestamp, bits)
+		header2 := sample.BitcoinBlockHeader(t, chainID, 102, header1.Hash, timestamp, bits)
+		headers := []common.BlockHeader{header0, header1, header2}
+		for _, header := range headers {
+			k.SetBlockHeader(ctx, header)
+		}
+		k.SetBlockHeaderState(ctx, types.BlockHeaderState{
+			ChainId:         chainID,
+			LatestHeight:    102,
+			EarliestHeight:  100,
+			LatestBlockHash: header2.Hash,
+		})
+
+		err := v9.MigrateStore(ctx, k)
+		require.NoError(t, err)
+
+		bhs, found := k.GetBlockHeaderState(ctx, chainID)
+		require.True(t, found)
+		require.True(t, headerWork.MulRaw(3).Equal(bhs.LatestChainWork))
+		for i, header := range headers {
+			hash, found := k.GetBestChainBlockHash(ctx, chainID, header.Height)
+			require.True(t, found)
+			require.Equal(t, header.Hash, hash)
+
+			work, found := k.GetBlockHeaderWork(ctx, header.Hash)
+			require.True(t, found)
+			require.Equal(t, header.Height, work.Height)
+			require.True(t, headerWork.MulRaw(int64(i+1)).Equal(work.ChainWork))
+		}
+		confirmations, onBestChain := k.GetBlockHeaderConfirmations(ctx, chainID, header0.Hash)
+		require.True(t, onBestChain)
+		require.EqualValues(t, 3, confirmations)
+	})
+
+	t.Run("Migrate store without bitcoin header chains", func(t *testing.T) {
+		k, ctx, _, _ := keepertest.ObserverKeeper(t)
+
+		err := v9.MigrateStore(ctx, k)
+		require.NoError(t, err)
+	})
+}
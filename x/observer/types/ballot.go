@@ -8,6 +8,9 @@ import (
 )
 
 func (m Ballot) AddVote(address string, vote VoteType) (Ballot, error) {
+	if m.GetVoterIndex(address) == -1 {
+		return m, errors.Wrap(ErrUnableToAddVote, fmt.Sprintf(" Voter : %s | Ballot :%s | Not a voter of the ballot", address, m.String()))
+	}
 	if m.HasVoted(address) {
 		return m, errors.Wrap(ErrUnableToAddVote, fmt.Sprintf(" Voter : %s | Ballot :%s | Already Voted", address, m.String()))
 	}
@@ -37,21 +40,22 @@ func (m Ballot) GetVoterIndex(address string) int {
 // IsFinalizingVote checks sets the ballot to a final status if enough votes have been added
 // If it has already been finalized it returns false
 // It enough votes have not been added it returns false
+// If the ballot has voter weights, the votes are weighted by the bonded stake of the voters
 func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 	if m.BallotStatus != BallotStatus_BallotInProgress {
 		return m, false
 	}
 	success, failure := sdk.ZeroDec(), sdk.ZeroDec()
-	total := sdk.NewDec(int64(len(m.VoterList)))
+	total := m.GetTotalWeight()
 	if total.IsZero() {
 		return m, false
 	}
-	for _, vote := range m.Votes {
+	for i, vote := range m.Votes {
 		if vote == VoteType_SuccessObservation {
-			success = success.Add(sdk.OneDec())
+			success = success.Add(m.GetVoteWeight(i))
 		}
 		if vote == VoteType_FailureObservation {
-			failure = failure.Add(sdk.OneDec())
+			failure = failure.Add(m.GetVoteWeight(i))
 		}
 
 	}
@@ -71,6 +75,48 @@ func (m Ballot) IsFinalizingVote() (Ballot, bool) {
 	return m, false
 }
 
+// IsStakeWeighted returns true if the votes of the ballot are weighted by the bonded stake of the voters
+func (m Ballot) IsStakeWeighted() bool {
+	return len(m.VoterWeights) > 0 && len(m.VoterWeights) == len(m.VoterList)
+}
+
+// GetTotalWeight returns the sum of the weights of all the voters of the ballot
+// It is the number of voters if the ballot is not stake weighted
+func (m Ballot) GetTotalWeight() sdk.Dec {
+	if !m.IsStakeWeighted() {
+		return sdk.NewDec(int64(len(m.VoterList)))
+	}
+	total := sdk.ZeroDec()
+	for _, weight := range m.VoterWeights {
+		total = total.Add(sdk.NewDecFromInt(weight))
+	}
+	return total
+}
+
+// GetVoteWeight returns the weight of the vote of the voter at the given index of the `VoterList`
+// Every vote has a weight of one if the ballot is not stake weighted
+func (m Ballot) GetVoteWeight(index int) sdk.Dec {
+	if !m.IsStakeWeighted() {
+		return sdk.OneDec()
+	}
+	if index >= len(m.VoterWeights) {
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(m.VoterWeights[index])
+}
+
+// IsVoteWithMajority returns true if the ballot is finalized and the vote matches the finalized outcome
+func (m Ballot) IsVoteWithMajority(vote VoteType) bool {
+	switch m.BallotStatus {
+	case BallotStatus_BallotFinalized_SuccessObservation:
+		return vote == VoteType_SuccessObservation
+	case BallotStatus_BallotFinalized_FailureObservation:
+		return vote == VoteType_FailureObservation
+	default:
+		return false
+	}
+}
+
 func CreateVotes(len int) []VoteType {
 	voterList := make([]VoteType, len)
 	for i := range voterList {
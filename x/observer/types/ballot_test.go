@@ -1,8 +1,10 @@
 package types
 
 import (
+	"fmt"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
@@ -396,3 +398,85 @@ func Test_BuildRewardsDistribution(t *testing.T) {
 	}
 
 }
+
+func TestBallot_IsVoteWithMajority(t *testing.T) {
+	tt := []struct {
+		name         string
+		ballotStatus BallotStatus
+		vote         VoteType
+		expected     bool
+	}{
+		{"success vote on success ballot", BallotStatus_BallotFinalized_SuccessObservation, VoteType_SuccessObservation, true},
+		{"failure vote on success ballot", BallotStatus_BallotFinalized_SuccessObservation, VoteType_FailureObservation, false},
+		{"failure vote on failure ballot", BallotStatus_BallotFinalized_FailureObservation, VoteType_FailureObservation, true},
+		{"success vote on failure ballot", BallotStatus_BallotFinalized_FailureObservation, VoteType_SuccessObservation, false},
+		{"success vote on ballot in progress", BallotStatus_BallotInProgress, VoteType_SuccessObservation, false},
+		{"no vote on success ballot", BallotStatus_BallotFinalized_SuccessObservation, VoteType_NotYetVoted, false},
+	}
+	for _, test := range tt {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ballot := Ballot{BallotStatus: test.ballotStatus}
+			require.Equal(t, test.expected, ballot.IsVoteWithMajority(test.vote))
+		})
+	}
+}
+
+func TestBallot_IsFinalizingVoteStakeWeighted(t *testing.T) {
+	tt := []struct {
+		name        string
+		weights     []sdkmath.Int
+		votes       []VoteType
+		isFinalized bool
+		finalStatus BallotStatus
+	}{
+		{
+			name:        "finalized to success with the majority of the stake",
+			weights:     []sdkmath.Int{sdkmath.NewInt(70), sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(10)},
+			votes:       []VoteType{VoteType_SuccessObservation, VoteType_NotYetVoted, VoteType_NotYetVoted, VoteType_NotYetVoted},
+			isFinalized: true,
+			finalStatus: BallotStatus_BallotFinalized_SuccessObservation,
+		},
+		{
+			name:        "finalized to failure with the majority of the stake",
+			weights:     []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(70), sdkmath.NewInt(10)},
+			votes:       []VoteType{VoteType_SuccessObservation, VoteType_SuccessObservation, VoteType_FailureObservation, VoteType_NotYetVoted},
+			isFinalized: true,
+			finalStatus: BallotStatus_BallotFinalized_FailureObservation,
+		},
+		{
+			name:        "not finalized with the majority of the voters but not of the stake",
+			weights:     []sdkmath.Int{sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(10), sdkmath.NewInt(70)},
+			votes:       []VoteType{VoteType_SuccessObservation, VoteType_SuccessObservation, VoteType_SuccessObservation, VoteType_NotYetVoted},
+			isFinalized: false,
+			finalStatus: BallotStatus_BallotInProgress,
+		},
+		{
+			name:        "not finalized if the total stake is zero",
+			weights:     []sdkmath.Int{sdkmath.ZeroInt(), sdkmath.ZeroInt()},
+			votes:       []VoteType{VoteType_SuccessObservation, VoteType_SuccessObservation},
+			isFinalized: false,
+			finalStatus: BallotStatus_BallotInProgress,
+		},
+	}
+	for _, test := range tt {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			voters := make([]string, len(test.votes))
+			for i := range voters {
+				voters[i] = fmt.Sprintf("Observer%d", i)
+			}
+			ballot := Ballot{
+				VoterList:       voters,
+				Votes:           test.votes,
+				VoterWeights:    test.weights,
+				BallotThreshold: sdk.MustNewDecFromStr("0.66"),
+				BallotStatus:    BallotStatus_BallotInProgress,
+			}
+			require.True(t, ballot.IsStakeWeighted())
+			ballot, isFinalized := ballot.IsFinalizingVote()
+			require.Equal(t, test.isFinalized, isFinalized)
+			require.Equal(t, test.finalStatus, ballot.BallotStatus)
+		})
+	}
+}
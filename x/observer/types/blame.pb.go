@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
 
@@ -87,6 +88,10 @@ type Blame struct {
 	Index         string  `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 	FailureReason string  `protobuf:"bytes,2,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
 	Nodes         []*Node `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// chain of the blame vote, set when the blame is finalized
+	ChainId int64 `protobuf:"varint,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// zeta height at which the blame was finalized, the blame is pruned once the retention period is elapsed
+	FinalizedHeight int64 `protobuf:"varint,5,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
 }
 
 func (m *Blame) Reset()         { *m = Blame{} }
@@ -143,34 +148,173 @@ func (m *Blame) GetNodes() []*Node {
 	return nil
 }
 
+func (m *Blame) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *Blame) GetFinalizedHeight() int64 {
+	if m != nil {
+		return m.FinalizedHeight
+	}
+	return 0
+}
+
+// BlameCount is the number of blame records of a chain a node was blamed in
+type BlameCount struct {
+	ChainId int64  `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Count   uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *BlameCount) Reset()         { *m = BlameCount{} }
+func (m *BlameCount) String() string { return proto.CompactTextString(m) }
+func (*BlameCount) ProtoMessage()    {}
+func (*BlameCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eda3a934f0dc78, []int{2}
+}
+func (m *BlameCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlameCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlameCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlameCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlameCount.Merge(m, src)
+}
+func (m *BlameCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlameCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlameCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlameCount proto.InternalMessageInfo
+
+func (m *BlameCount) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *BlameCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// NodeBlameStatistics contains the number of times a node was blamed per chain
+type NodeBlameStatistics struct {
+	PubKey      string       `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	BlameCounts []BlameCount `protobuf:"bytes,2,rep,name=blame_counts,json=blameCounts,proto3" json:"blame_counts"`
+	// number of times the node was blamed on all the chains
+	Total uint64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *NodeBlameStatistics) Reset()         { *m = NodeBlameStatistics{} }
+func (m *NodeBlameStatistics) String() string { return proto.CompactTextString(m) }
+func (*NodeBlameStatistics) ProtoMessage()    {}
+func (*NodeBlameStatistics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9eda3a934f0dc78, []int{3}
+}
+func (m *NodeBlameStatistics) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeBlameStatistics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeBlameStatistics.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeBlameStatistics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeBlameStatistics.Merge(m, src)
+}
+func (m *NodeBlameStatistics) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeBlameStatistics) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeBlameStatistics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeBlameStatistics proto.InternalMessageInfo
+
+func (m *NodeBlameStatistics) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *NodeBlameStatistics) GetBlameCounts() []BlameCount {
+	if m != nil {
+		return m.BlameCounts
+	}
+	return nil
+}
+
+func (m *NodeBlameStatistics) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Node)(nil), "zetachain.zetacore.observer.Node")
 	proto.RegisterType((*Blame)(nil), "zetachain.zetacore.observer.Blame")
+	proto.RegisterType((*BlameCount)(nil), "zetachain.zetacore.observer.BlameCount")
+	proto.RegisterType((*NodeBlameStatistics)(nil), "zetachain.zetacore.observer.NodeBlameStatistics")
 }
 
 func init() { proto.RegisterFile("observer/blame.proto", fileDescriptor_e9eda3a934f0dc78) }
 
 var fileDescriptor_e9eda3a934f0dc78 = []byte{
-	// 292 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4b, 0xc3, 0x30,
-	0x14, 0xc6, 0x17, 0xe7, 0x26, 0x8d, 0x3a, 0x21, 0x0c, 0x56, 0x14, 0x43, 0x1d, 0x88, 0xbd, 0x98,
-	0x80, 0x1e, 0xbc, 0x0f, 0x2f, 0x22, 0x78, 0xa8, 0x37, 0x2f, 0x25, 0x59, 0x9f, 0x5d, 0x71, 0x6b,
-	0x4a, 0x9a, 0xca, 0x2a, 0xf8, 0x3f, 0xf8, 0x67, 0x79, 0xdc, 0xd1, 0xa3, 0xb4, 0xff, 0x88, 0x34,
-	0x5d, 0x77, 0xf4, 0xf6, 0xf2, 0xcb, 0xf7, 0xbe, 0x8f, 0xf7, 0xe1, 0xb1, 0x92, 0x39, 0xe8, 0x77,
-	0xd0, 0x5c, 0x2e, 0xc5, 0x0a, 0x58, 0xa6, 0x95, 0x51, 0xe4, 0xec, 0x03, 0x8c, 0x98, 0x2f, 0x44,
-	0x92, 0x32, 0x3b, 0x29, 0x0d, 0xac, 0x13, 0x9e, 0x4e, 0x76, 0x2b, 0xdd, 0xd0, 0x6e, 0x4d, 0x63,
-	0xbc, 0xff, 0xa4, 0x22, 0x20, 0x13, 0x7c, 0x90, 0x15, 0x32, 0x7c, 0x83, 0xd2, 0x45, 0x1e, 0xf2,
-	0x9d, 0x60, 0x98, 0x15, 0xf2, 0x11, 0x4a, 0x72, 0x8e, 0xb1, 0x4d, 0x09, 0x23, 0x61, 0x84, 0xbb,
-	0xe7, 0x21, 0xff, 0x28, 0x70, 0x2c, 0xb9, 0x17, 0x46, 0x90, 0x2b, 0x7c, 0xd2, 0x7e, 0xe7, 0x49,
-	0x9c, 0x0a, 0x53, 0x68, 0x70, 0xfb, 0x56, 0x33, 0xb2, 0xf8, 0xb9, 0xa3, 0xd3, 0x4f, 0x3c, 0x98,
-	0x35, 0x84, 0x8c, 0xf1, 0x20, 0x49, 0x23, 0x58, 0x6f, 0x73, 0xda, 0x07, 0xb9, 0xc4, 0xa3, 0x57,
-	0x91, 0x2c, 0x0b, 0x0d, 0xa1, 0x06, 0x91, 0xab, 0xd4, 0x46, 0x39, 0xc1, 0xf1, 0x96, 0x06, 0x16,
-	0x92, 0x3b, 0x3c, 0x48, 0x55, 0x04, 0xb9, 0xdb, 0xf7, 0xfa, 0xfe, 0xe1, 0xcd, 0x05, 0xfb, 0xe7,
-	0x68, 0xd6, 0x1c, 0x16, 0xb4, 0xfa, 0xd9, 0xc3, 0x77, 0x45, 0xd1, 0xa6, 0xa2, 0xe8, 0xb7, 0xa2,
-	0xe8, 0xab, 0xa6, 0xbd, 0x4d, 0x4d, 0x7b, 0x3f, 0x35, 0xed, 0xbd, 0xf0, 0x38, 0x31, 0x8b, 0x42,
-	0xb2, 0xb9, 0x5a, 0xf1, 0xc6, 0xe3, 0xda, 0xda, 0xf1, 0xce, 0x8e, 0xaf, 0x77, 0x95, 0x71, 0x53,
-	0x66, 0x90, 0xcb, 0xa1, 0x6d, 0xee, 0xf6, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x5e, 0x43, 0xc9,
-	0x87, 0x01, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xb1, 0x6e, 0xd4, 0x40,
+	0x10, 0x86, 0x6f, 0x73, 0x76, 0xc2, 0x4d, 0x42, 0x82, 0x96, 0x93, 0x62, 0x82, 0x30, 0xc7, 0x49,
+	0x28, 0x47, 0x81, 0x2d, 0x41, 0x41, 0x45, 0x73, 0x50, 0x10, 0x21, 0x21, 0xb4, 0xe9, 0x68, 0xac,
+	0xf5, 0x79, 0xe2, 0x5b, 0xe1, 0x78, 0x2d, 0xef, 0x1a, 0xe5, 0xf2, 0x14, 0x54, 0xbc, 0x10, 0x4d,
+	0xca, 0x94, 0x54, 0x08, 0xdd, 0xbd, 0x08, 0xf2, 0x6c, 0x6c, 0x94, 0x82, 0x74, 0x33, 0xdf, 0xfe,
+	0x3b, 0xff, 0xcc, 0xec, 0xc2, 0x58, 0xa7, 0x06, 0xeb, 0x6f, 0x58, 0xc7, 0x69, 0x21, 0xcf, 0x31,
+	0xaa, 0x6a, 0x6d, 0x35, 0x7f, 0x7c, 0x89, 0x56, 0x2e, 0x96, 0x52, 0x95, 0x11, 0x45, 0xba, 0xc6,
+	0xa8, 0x13, 0x1e, 0x8d, 0x73, 0x9d, 0x6b, 0xd2, 0xc5, 0x6d, 0xe4, 0xae, 0x1c, 0x1d, 0xf6, 0x85,
+	0xba, 0xc0, 0x1d, 0x4c, 0x73, 0xf0, 0x3e, 0xe9, 0x0c, 0xf9, 0x21, 0xec, 0x54, 0x4d, 0x9a, 0x7c,
+	0xc5, 0x55, 0xc0, 0x26, 0x6c, 0x36, 0x12, 0xdb, 0x55, 0x93, 0x7e, 0xc4, 0x15, 0x7f, 0x02, 0x40,
+	0xde, 0x49, 0x26, 0xad, 0x0c, 0xb6, 0x26, 0x6c, 0xb6, 0x27, 0x46, 0x44, 0xde, 0x4b, 0x2b, 0xf9,
+	0x31, 0x1c, 0xb8, 0x63, 0xa3, 0xf2, 0x52, 0xda, 0xa6, 0xc6, 0x60, 0x48, 0x9a, 0x7d, 0xc2, 0xa7,
+	0x1d, 0x9d, 0xfe, 0x64, 0xe0, 0xcf, 0x5b, 0xc4, 0xc7, 0xe0, 0xab, 0x32, 0xc3, 0x8b, 0x1b, 0x23,
+	0x97, 0xf0, 0xe7, 0xb0, 0x7f, 0x26, 0x55, 0xd1, 0xd4, 0x98, 0xd4, 0x28, 0x8d, 0x2e, 0xc9, 0x6b,
+	0x24, 0xee, 0xdf, 0x50, 0x41, 0x90, 0xbf, 0x01, 0xbf, 0xd4, 0x19, 0x9a, 0x60, 0x38, 0x19, 0xce,
+	0x76, 0x5f, 0x3d, 0x8b, 0xee, 0xd8, 0x45, 0xd4, 0x4e, 0x26, 0x9c, 0x9e, 0x3f, 0x82, 0x7b, 0x24,
+	0x4b, 0x54, 0x16, 0x78, 0x13, 0x36, 0x1b, 0x8a, 0x1d, 0xca, 0x4f, 0x32, 0xfe, 0x02, 0x1e, 0x9c,
+	0xa9, 0x52, 0x16, 0xea, 0x12, 0xb3, 0x64, 0x89, 0x2a, 0x5f, 0xda, 0xc0, 0x27, 0xc9, 0x41, 0xcf,
+	0x3f, 0x10, 0x9e, 0xbe, 0x05, 0xa0, 0x21, 0xde, 0xe9, 0xa6, 0xb4, 0xb7, 0x6a, 0xb2, 0xdb, 0x35,
+	0xc7, 0xe0, 0x2f, 0x5a, 0x0d, 0x4d, 0xe1, 0x09, 0x97, 0x4c, 0x7f, 0x30, 0x78, 0xd8, 0x36, 0x45,
+	0x35, 0x4e, 0xad, 0xb4, 0xca, 0x58, 0xb5, 0x30, 0xff, 0xdf, 0xfe, 0x67, 0xd8, 0x73, 0xeb, 0xa5,
+	0xfb, 0x26, 0xd8, 0xa2, 0xa9, 0x8f, 0xef, 0x9c, 0xfa, 0x5f, 0x83, 0x73, 0xef, 0xea, 0xf7, 0xd3,
+	0x81, 0xd8, 0x4d, 0x7b, 0x62, 0xda, 0xc6, 0xac, 0xb6, 0xb2, 0xa0, 0x67, 0xf2, 0x84, 0x4b, 0xe6,
+	0x27, 0x57, 0xeb, 0x90, 0x5d, 0xaf, 0x43, 0xf6, 0x67, 0x1d, 0xb2, 0xef, 0x9b, 0x70, 0x70, 0xbd,
+	0x09, 0x07, 0xbf, 0x36, 0xe1, 0xe0, 0x4b, 0x9c, 0x2b, 0xbb, 0x6c, 0xd2, 0x68, 0xa1, 0xcf, 0xe3,
+	0xd6, 0xeb, 0x25, 0xd9, 0xc6, 0x9d, 0x6d, 0x7c, 0xd1, 0xff, 0xa8, 0xd8, 0xae, 0x2a, 0x34, 0xe9,
+	0x36, 0x7d, 0xac, 0xd7, 0x7f, 0x07, 0x00, 0xb0, 0x9a, 0xb1, 0x85, 0xbc, 0x02, 0x00, 0x00,
 }
 
 func (m *Node) Marshal() (dAtA []byte, err error) {
@@ -237,6 +381,16 @@ func (m *Blame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FinalizedHeight != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.FinalizedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Nodes) > 0 {
 		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -268,6 +422,88 @@ func (m *Blame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlameCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlameCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlameCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeBlameStatistics) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeBlameStatistics) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeBlameStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintBlame(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlameCounts) > 0 {
+		for iNdEx := len(m.BlameCounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameCounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBlame(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintBlame(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlame(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlame(v)
 	base := offset
@@ -320,6 +556,49 @@ func (m *Blame) Size() (n int) {
 			n += 1 + l + sovBlame(uint64(l))
 		}
 	}
+	if m.ChainId != 0 {
+		n += 1 + sovBlame(uint64(m.ChainId))
+	}
+	if m.FinalizedHeight != 0 {
+		n += 1 + sovBlame(uint64(m.FinalizedHeight))
+	}
+	return n
+}
+
+func (m *BlameCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovBlame(uint64(m.ChainId))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBlame(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *NodeBlameStatistics) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovBlame(uint64(l))
+	}
+	if len(m.BlameCounts) > 0 {
+		for _, e := range m.BlameCounts {
+			l = e.Size()
+			n += 1 + l + sovBlame(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovBlame(uint64(m.Total))
+	}
 	return n
 }
 
@@ -606,6 +885,267 @@ func (m *Blame) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+			}
+			m.FinalizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlame(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlameCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlame
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlameCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlameCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlame(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeBlameStatistics) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlame
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeBlameStatistics: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeBlameStatistics: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameCounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlameCounts = append(m.BlameCounts, BlameCount{})
+			if err := m.BlameCounts[len(m.BlameCounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlame(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 )
@@ -29,6 +30,8 @@ type BlockHeaderState struct {
 	LatestHeight    int64  `protobuf:"varint,2,opt,name=latest_height,json=latestHeight,proto3" json:"latest_height,omitempty"`
 	EarliestHeight  int64  `protobuf:"varint,3,opt,name=earliest_height,json=earliestHeight,proto3" json:"earliest_height,omitempty"`
 	LatestBlockHash []byte `protobuf:"bytes,4,opt,name=latest_block_hash,json=latestBlockHash,proto3" json:"latest_block_hash,omitempty"`
+	// accumulated proof-of-work of the latest block header, only tracked for bitcoin chains
+	LatestChainWork github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=latest_chain_work,json=latestChainWork,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"latest_chain_work"`
 }
 
 func (m *BlockHeaderState) Reset()         { *m = BlockHeaderState{} }
@@ -92,31 +95,92 @@ func (m *BlockHeaderState) GetLatestBlockHash() []byte {
 	return nil
 }
 
+// BlockHeaderWork is the accumulated proof-of-work of a bitcoin block header and its ancestors
+type BlockHeaderWork struct {
+	BlockHash []byte                                 `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height    int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	ChainWork github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=chain_work,json=chainWork,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"chain_work"`
+}
+
+func (m *BlockHeaderWork) Reset()         { *m = BlockHeaderWork{} }
+func (m *BlockHeaderWork) String() string { return proto.CompactTextString(m) }
+func (*BlockHeaderWork) ProtoMessage()    {}
+func (*BlockHeaderWork) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fad6da3aeeeaa45, []int{1}
+}
+func (m *BlockHeaderWork) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockHeaderWork) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockHeaderWork.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockHeaderWork) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockHeaderWork.Merge(m, src)
+}
+func (m *BlockHeaderWork) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockHeaderWork) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockHeaderWork.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockHeaderWork proto.InternalMessageInfo
+
+func (m *BlockHeaderWork) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *BlockHeaderWork) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*BlockHeaderState)(nil), "zetachain.zetacore.observer.BlockHeaderState")
+	proto.RegisterType((*BlockHeaderWork)(nil), "zetachain.zetacore.observer.BlockHeaderWork")
 }
 
 func init() { proto.RegisterFile("observer/block_header.proto", fileDescriptor_9fad6da3aeeeaa45) }
 
 var fileDescriptor_9fad6da3aeeeaa45 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4f, 0x2a, 0x4e,
-	0x2d, 0x2a, 0x4b, 0x2d, 0xd2, 0x4f, 0xca, 0xc9, 0x4f, 0xce, 0x8e, 0xcf, 0x48, 0x4d, 0x4c, 0x49,
-	0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xae, 0x4a, 0x2d, 0x49, 0x4c, 0xce, 0x48,
-	0xcc, 0xcc, 0xd3, 0x03, 0xb3, 0xf2, 0x8b, 0x52, 0xf5, 0x60, 0xea, 0xa5, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xea, 0xf4, 0x41, 0x2c, 0x88, 0x16, 0x29, 0x71, 0xb8, 0x79, 0x30, 0x06, 0x44, 0x42,
-	0x69, 0x39, 0x23, 0x97, 0x80, 0x13, 0xc8, 0x0a, 0x0f, 0xb0, 0x0d, 0xc1, 0x25, 0x89, 0x25, 0xa9,
-	0x42, 0x92, 0x5c, 0x1c, 0x60, 0xe3, 0xe3, 0x33, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x98, 0x83,
-	0xd8, 0xc1, 0x7c, 0xcf, 0x14, 0x21, 0x65, 0x2e, 0xde, 0x9c, 0xc4, 0x92, 0xd4, 0xe2, 0x92, 0xf8,
-	0x8c, 0xd4, 0xcc, 0xf4, 0x8c, 0x12, 0x09, 0x26, 0xb0, 0x3c, 0x0f, 0x44, 0xd0, 0x03, 0x2c, 0x26,
-	0xa4, 0xce, 0xc5, 0x9f, 0x9a, 0x58, 0x94, 0x93, 0x89, 0xa4, 0x8c, 0x19, 0xac, 0x8c, 0x0f, 0x26,
-	0x0c, 0x55, 0xa8, 0xc5, 0x25, 0x08, 0x35, 0x0d, 0xea, 0xcd, 0xc4, 0xe2, 0x0c, 0x09, 0x16, 0x05,
-	0x46, 0x0d, 0x9e, 0x20, 0x7e, 0x88, 0x04, 0xc4, 0x6d, 0x89, 0xc5, 0x19, 0x4e, 0x9e, 0x27, 0x1e,
-	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
-	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9f, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4,
-	0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x0a, 0x10, 0x5d, 0xb0, 0x63, 0xf5, 0x61, 0x61, 0xa3, 0x5f, 0x01,
-	0xf7, 0xb4, 0x7e, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6, 0x80, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xa7, 0xf5, 0x45, 0x4d, 0x66, 0x01, 0x00, 0x00,
+	// 360 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x4f, 0xfa, 0x30,
+	0x18, 0xc7, 0x57, 0xf8, 0xfd, 0x50, 0x1a, 0x14, 0x6d, 0x8c, 0x4e, 0x88, 0x83, 0x60, 0xa2, 0xc4,
+	0x84, 0xf5, 0xe0, 0x3b, 0xc0, 0x0b, 0x1c, 0xbc, 0xe0, 0xc1, 0x84, 0x0b, 0xe9, 0xb6, 0x66, 0x5d,
+	0x06, 0x94, 0xb4, 0xf5, 0xef, 0xd5, 0x37, 0xe0, 0xcd, 0xb7, 0xc4, 0x91, 0xa3, 0xf1, 0x40, 0x0c,
+	0xbc, 0x11, 0xb3, 0x76, 0x5b, 0xe6, 0xd5, 0xd3, 0x9e, 0x7e, 0x9f, 0x6f, 0xbf, 0xfd, 0xac, 0x7d,
+	0x60, 0x93, 0x7b, 0x92, 0x8a, 0x47, 0x2a, 0xb0, 0x37, 0xe5, 0x7e, 0x3c, 0x61, 0x94, 0x04, 0x54,
+	0xb8, 0x0b, 0xc1, 0x15, 0x47, 0xcd, 0x57, 0xaa, 0x88, 0xcf, 0x48, 0x34, 0x77, 0x75, 0xc5, 0x05,
+	0x75, 0x33, 0x7f, 0xe3, 0x28, 0xe4, 0x21, 0xd7, 0x3e, 0x9c, 0x54, 0x66, 0x4b, 0xe3, 0x24, 0xcf,
+	0xcb, 0x0a, 0xd3, 0xe8, 0xbc, 0x95, 0xe0, 0x41, 0x3f, 0x39, 0x62, 0xa0, 0x4f, 0xb8, 0x53, 0x44,
+	0x51, 0x74, 0x0a, 0x77, 0x75, 0xfc, 0x24, 0x0a, 0x6c, 0xd0, 0x06, 0xdd, 0xf2, 0x68, 0x47, 0xaf,
+	0x87, 0x01, 0x3a, 0x87, 0x7b, 0x53, 0xa2, 0xa8, 0x54, 0x13, 0x46, 0xa3, 0x90, 0x29, 0xbb, 0xa4,
+	0xfb, 0x35, 0x23, 0x0e, 0xb4, 0x86, 0x2e, 0x61, 0x9d, 0x12, 0x31, 0x8d, 0x0a, 0xb6, 0xb2, 0xb6,
+	0xed, 0x67, 0x72, 0x6a, 0xbc, 0x82, 0x87, 0x69, 0x5a, 0xfa, 0x9b, 0x44, 0x32, 0xfb, 0x5f, 0x1b,
+	0x74, 0x6b, 0xa3, 0xba, 0x69, 0x18, 0x36, 0x22, 0x19, 0x1a, 0xe7, 0x5e, 0xc3, 0xf6, 0xc4, 0x45,
+	0x6c, 0xff, 0x6f, 0x83, 0x6e, 0xb5, 0xef, 0x2e, 0xd7, 0x2d, 0xeb, 0x6b, 0xdd, 0xba, 0x08, 0x23,
+	0xc5, 0x1e, 0x3c, 0xd7, 0xe7, 0x33, 0xec, 0x73, 0x39, 0xe3, 0x32, 0xfd, 0xf4, 0x64, 0x10, 0x63,
+	0xf5, 0xb2, 0xa0, 0xd2, 0x1d, 0xce, 0x55, 0x96, 0x7d, 0x93, 0xe4, 0xdc, 0x73, 0x11, 0x77, 0x3e,
+	0x00, 0xac, 0x17, 0x6e, 0x21, 0xd1, 0xd0, 0x19, 0x84, 0x05, 0x28, 0xa0, 0xa1, 0xaa, 0x5e, 0x8e,
+	0x73, 0x0c, 0x2b, 0xbf, 0x6e, 0x20, 0x5d, 0xa1, 0x5b, 0x08, 0x0b, 0x7c, 0xe5, 0x3f, 0xf1, 0x55,
+	0xfd, 0x8c, 0xac, 0x3f, 0x5c, 0x6e, 0x1c, 0xb0, 0xda, 0x38, 0xe0, 0x7b, 0xe3, 0x80, 0xf7, 0xad,
+	0x63, 0xad, 0xb6, 0x8e, 0xf5, 0xb9, 0x75, 0xac, 0x31, 0x2e, 0x84, 0x25, 0x63, 0xd0, 0xd3, 0x9b,
+	0x70, 0x36, 0x11, 0xf8, 0x39, 0x7f, 0x6a, 0x93, 0xec, 0x55, 0xf4, 0x8b, 0x5f, 0xff, 0x0c, 0x00,
+	0xd5, 0x4f, 0x67, 0xae, 0x5c, 0x02, 0x00, 0x00,
 }
 
 func (m *BlockHeaderState) Marshal() (dAtA []byte, err error) {
@@ -139,6 +203,16 @@ func (m *BlockHeaderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LatestChainWork.Size()
+		i -= size
+		if _, err := m.LatestChainWork.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBlockHeader(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	if len(m.LatestBlockHash) > 0 {
 		i -= len(m.LatestBlockHash)
 		copy(dAtA[i:], m.LatestBlockHash)
@@ -164,6 +238,51 @@ func (m *BlockHeaderState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockHeaderWork) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockHeaderWork) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockHeaderWork) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ChainWork.Size()
+		i -= size
+		if _, err := m.ChainWork.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBlockHeader(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintBlockHeader(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintBlockHeader(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlockHeader(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlockHeader(v)
 	base := offset
@@ -194,6 +313,26 @@ func (m *BlockHeaderState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBlockHeader(uint64(l))
 	}
+	l = m.LatestChainWork.Size()
+	n += 1 + l + sovBlockHeader(uint64(l))
+	return n
+}
+
+func (m *BlockHeaderWork) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovBlockHeader(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovBlockHeader(uint64(m.Height))
+	}
+	l = m.ChainWork.Size()
+	n += 1 + l + sovBlockHeader(uint64(l))
 	return n
 }
 
@@ -323,6 +462,177 @@ func (m *BlockHeaderState) Unmarshal(dAtA []byte) error {
 				m.LatestBlockHash = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestChainWork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestChainWork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockHeader(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeaderWork) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockHeader
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeaderWork: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeaderWork: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainWork", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockHeader
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockHeader
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainWork.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBlockHeader(dAtA[iNdEx:])
@@ -335,5 +335,7 @@ func ChainParamsEqual(params1, params2 ChainParams) bool {
 		params1.OutboundTxScheduleLookahead == params2.OutboundTxScheduleLookahead &&
 		params1.BallotThreshold.Equal(params2.BallotThreshold) &&
 		params1.MinObserverDelegation.Equal(params2.MinObserverDelegation) &&
-		params1.IsSupported == params2.IsSupported
+		params1.IsSupported == params2.IsSupported &&
+		params1.MaxOutboundPendingBlocks == params2.MaxOutboundPendingBlocks &&
+		params1.StakeWeightedBallots == params2.StakeWeightedBallots
 }
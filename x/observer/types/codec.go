@@ -16,6 +16,10 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateKeygen{}, "crosschain/UpdateKeygen", nil)
 	cdc.RegisterConcrete(&MsgAddBlockHeader{}, "crosschain/AddBlockHeader", nil)
 	cdc.RegisterConcrete(&MsgUpdateObserver{}, "observer/UpdateObserver", nil)
+	cdc.RegisterConcrete(&MsgUpdateObserverChains{}, "observer/UpdateObserverChains", nil)
+	cdc.RegisterConcrete(&MsgUnjailObserver{}, "observer/UnjailObserver", nil)
+	cdc.RegisterConcrete(&MsgBootstrapEthereumLightClient{}, "observer/BootstrapEthereumLightClient", nil)
+	cdc.RegisterConcrete(&MsgUpdateEthereumLightClient{}, "observer/UpdateEthereumLightClient", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -28,6 +32,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateKeygen{},
 		&MsgAddBlockHeader{},
 		&MsgUpdateObserver{},
+		&MsgUpdateObserverChains{},
+		&MsgUnjailObserver{},
+		&MsgBootstrapEthereumLightClient{},
+		&MsgUpdateEthereumLightClient{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -1,6 +1,11 @@
 package types
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	"github.com/zeta-chain/zetacore/common"
+)
 
 var DefaultGasPriceIncreaseFlags = GasPriceIncreaseFlags{
 	// EpochLength is the number of blocks in an epoch before triggering a gas price increase
@@ -22,8 +27,19 @@ var DefaultGasPriceIncreaseFlags = GasPriceIncreaseFlags{
 }
 
 var DefaultBlockHeaderVerificationFlags = BlockHeaderVerificationFlags{
-	IsEthTypeChainEnabled: true,
-	IsBtcTypeChainEnabled: true,
+	IsEthTypeChainEnabled:     true,
+	IsBtcTypeChainEnabled:     true,
+	IsBscTypeChainEnabled:     true,
+	IsPolygonTypeChainEnabled: true,
+}
+
+var DefaultPruningFlags = PruningFlags{
+	// RetentionBlocks is the number of blocks a terminal CCTX is kept before being pruned
+	// 0 disables pruning
+	RetentionBlocks: 0,
+
+	// Maximum CCTXs to prune per block
+	MaxPrunedPerBlock: 100,
 }
 
 // DefaultCrosschainFlags returns the default crosschain flags used when not defined
@@ -33,5 +49,121 @@ func DefaultCrosschainFlags() *CrosschainFlags {
 		IsOutboundEnabled:            true,
 		GasPriceIncreaseFlags:        &DefaultGasPriceIncreaseFlags,
 		BlockHeaderVerificationFlags: &DefaultBlockHeaderVerificationFlags,
+		PruningFlags:                 &DefaultPruningFlags,
+	}
+}
+
+// IsChainEnabled returns true if block header verification is enabled for the chain
+// BSC and Polygon chains have their own flag, other EVM chains use the Ethereum flag
+func (m BlockHeaderVerificationFlags) IsChainEnabled(chainID int64) bool {
+	switch {
+	case common.IsBscChain(chainID):
+		return m.IsBscTypeChainEnabled
+	case common.IsPolygonChain(chainID):
+		return m.IsPolygonTypeChainEnabled
+	case common.IsEVMChain(chainID):
+		return m.IsEthTypeChainEnabled
+	case common.IsBitcoinChain(chainID):
+		return m.IsBtcTypeChainEnabled
+	default:
+		return false
+	}
+}
+
+// IsChainInboundEnabled returns true if the inbounds are enabled globally and for the chain
+func (m CrosschainFlags) IsChainInboundEnabled(chainID int64) bool {
+	if !m.IsInboundEnabled {
+		return false
+	}
+	flags, found := m.FindChainFlags(chainID)
+	return !found || flags.IsInboundEnabled
+}
+
+// IsChainOutboundEnabled returns true if the outbounds are enabled globally and for the chain
+func (m CrosschainFlags) IsChainOutboundEnabled(chainID int64) bool {
+	if !m.IsOutboundEnabled {
+		return false
+	}
+	flags, found := m.FindChainFlags(chainID)
+	return !found || flags.IsOutboundEnabled
+}
+
+// IsForeignCoinInboundEnabled returns true if the inbounds of a coin are enabled globally, for the chain and for the foreign coin
+// the foreign coin flags only apply to the gas and ERC20 coin types, the asset of the gas coin is empty
+func (m CrosschainFlags) IsForeignCoinInboundEnabled(chainID int64, coinType common.CoinType, asset string) bool {
+	if !m.IsChainInboundEnabled(chainID) {
+		return false
+	}
+	flags, found := m.findForeignCoinFlagsForCoinType(chainID, coinType, asset)
+	return !found || flags.IsInboundEnabled
+}
+
+// IsForeignCoinOutboundEnabled returns true if the outbounds of a coin are enabled globally, for the chain and for the foreign coin
+// the foreign coin flags only apply to the gas and ERC20 coin types, the asset of the gas coin is empty
+func (m CrosschainFlags) IsForeignCoinOutboundEnabled(chainID int64, coinType common.CoinType, asset string) bool {
+	if !m.IsChainOutboundEnabled(chainID) {
+		return false
+	}
+	flags, found := m.findForeignCoinFlagsForCoinType(chainID, coinType, asset)
+	return !found || flags.IsOutboundEnabled
+}
+
+// FindChainFlags returns the flags of a chain
+func (m CrosschainFlags) FindChainFlags(chainID int64) (ChainFlags, bool) {
+	for _, flags := range m.ChainFlags {
+		if flags.ChainId == chainID {
+			return flags, true
+		}
+	}
+	return ChainFlags{}, false
+}
+
+// FindForeignCoinFlags returns the flags of a foreign coin, the asset is case-insensitive
+func (m CrosschainFlags) FindForeignCoinFlags(chainID int64, asset string) (ForeignCoinFlags, bool) {
+	for _, flags := range m.ForeignCoinFlags {
+		if flags.ChainId == chainID && strings.EqualFold(flags.Asset, asset) {
+			return flags, true
+		}
+	}
+	return ForeignCoinFlags{}, false
+}
+
+// findForeignCoinFlagsForCoinType returns the flags of the foreign coin of a coin type
+func (m CrosschainFlags) findForeignCoinFlagsForCoinType(chainID int64, coinType common.CoinType, asset string) (ForeignCoinFlags, bool) {
+	switch coinType {
+	case common.CoinType_Gas:
+		return m.FindForeignCoinFlags(chainID, "")
+	case common.CoinType_ERC20:
+		return m.FindForeignCoinFlags(chainID, asset)
+	default:
+		return ForeignCoinFlags{}, false
+	}
+}
+
+// SetChainFlags sets the flags of a chain, the chain flags are removed if inbounds and outbounds are enabled
+func (m *CrosschainFlags) SetChainFlags(chainFlags ChainFlags) {
+	list := make([]ChainFlags, 0, len(m.ChainFlags)+1)
+	for _, flags := range m.ChainFlags {
+		if flags.ChainId != chainFlags.ChainId {
+			list = append(list, flags)
+		}
+	}
+	if !chainFlags.IsInboundEnabled || !chainFlags.IsOutboundEnabled {
+		list = append(list, chainFlags)
+	}
+	m.ChainFlags = list
+}
+
+// SetForeignCoinFlags sets the flags of a foreign coin, the foreign coin flags are removed if inbounds and outbounds are enabled
+func (m *CrosschainFlags) SetForeignCoinFlags(foreignCoinFlags ForeignCoinFlags) {
+	list := make([]ForeignCoinFlags, 0, len(m.ForeignCoinFlags)+1)
+	for _, flags := range m.ForeignCoinFlags {
+		if flags.ChainId != foreignCoinFlags.ChainId || !strings.EqualFold(flags.Asset, foreignCoinFlags.Asset) {
+			list = append(list, flags)
+		}
+	}
+	if !foreignCoinFlags.IsInboundEnabled || !foreignCoinFlags.IsOutboundEnabled {
+		list = append(list, foreignCoinFlags)
 	}
+	m.ForeignCoinFlags = list
 }
@@ -108,8 +108,10 @@ func (m *GasPriceIncreaseFlags) GetMaxPendingCctxs() uint32 {
 }
 
 type BlockHeaderVerificationFlags struct {
-	IsEthTypeChainEnabled bool `protobuf:"varint,1,opt,name=isEthTypeChainEnabled,proto3" json:"isEthTypeChainEnabled,omitempty"`
-	IsBtcTypeChainEnabled bool `protobuf:"varint,2,opt,name=isBtcTypeChainEnabled,proto3" json:"isBtcTypeChainEnabled,omitempty"`
+	IsEthTypeChainEnabled     bool `protobuf:"varint,1,opt,name=isEthTypeChainEnabled,proto3" json:"isEthTypeChainEnabled,omitempty"`
+	IsBtcTypeChainEnabled     bool `protobuf:"varint,2,opt,name=isBtcTypeChainEnabled,proto3" json:"isBtcTypeChainEnabled,omitempty"`
+	IsBscTypeChainEnabled     bool `protobuf:"varint,3,opt,name=isBscTypeChainEnabled,proto3" json:"isBscTypeChainEnabled,omitempty"`
+	IsPolygonTypeChainEnabled bool `protobuf:"varint,4,opt,name=isPolygonTypeChainEnabled,proto3" json:"isPolygonTypeChainEnabled,omitempty"`
 }
 
 func (m *BlockHeaderVerificationFlags) Reset()         { *m = BlockHeaderVerificationFlags{} }
@@ -159,18 +161,222 @@ func (m *BlockHeaderVerificationFlags) GetIsBtcTypeChainEnabled() bool {
 	return false
 }
 
+func (m *BlockHeaderVerificationFlags) GetIsBscTypeChainEnabled() bool {
+	if m != nil {
+		return m.IsBscTypeChainEnabled
+	}
+	return false
+}
+
+func (m *BlockHeaderVerificationFlags) GetIsPolygonTypeChainEnabled() bool {
+	if m != nil {
+		return m.IsPolygonTypeChainEnabled
+	}
+	return false
+}
+
+type PruningFlags struct {
+	// Number of blocks a terminal cctx is kept in the state before being pruned
+	// Pruning is disabled if 0
+	RetentionBlocks int64 `protobuf:"varint,1,opt,name=retentionBlocks,proto3" json:"retentionBlocks,omitempty"`
+	// Maximum number of cctxs pruned per block
+	MaxPrunedPerBlock uint32 `protobuf:"varint,2,opt,name=maxPrunedPerBlock,proto3" json:"maxPrunedPerBlock,omitempty"`
+}
+
+func (m *PruningFlags) Reset()         { *m = PruningFlags{} }
+func (m *PruningFlags) String() string { return proto.CompactTextString(m) }
+func (*PruningFlags) ProtoMessage()    {}
+func (*PruningFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b948b59e4d986f49, []int{2}
+}
+func (m *PruningFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PruningFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PruningFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PruningFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PruningFlags.Merge(m, src)
+}
+func (m *PruningFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *PruningFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_PruningFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PruningFlags proto.InternalMessageInfo
+
+func (m *PruningFlags) GetRetentionBlocks() int64 {
+	if m != nil {
+		return m.RetentionBlocks
+	}
+	return 0
+}
+
+func (m *PruningFlags) GetMaxPrunedPerBlock() uint32 {
+	if m != nil {
+		return m.MaxPrunedPerBlock
+	}
+	return 0
+}
+
+// ChainFlags enables or disables the inbounds and outbounds of a chain
+type ChainFlags struct {
+	ChainId           int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	IsInboundEnabled  bool  `protobuf:"varint,2,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
+	IsOutboundEnabled bool  `protobuf:"varint,3,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
+}
+
+func (m *ChainFlags) Reset()         { *m = ChainFlags{} }
+func (m *ChainFlags) String() string { return proto.CompactTextString(m) }
+func (*ChainFlags) ProtoMessage()    {}
+func (*ChainFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b948b59e4d986f49, []int{3}
+}
+func (m *ChainFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChainFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainFlags.Merge(m, src)
+}
+func (m *ChainFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainFlags proto.InternalMessageInfo
+
+func (m *ChainFlags) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ChainFlags) GetIsInboundEnabled() bool {
+	if m != nil {
+		return m.IsInboundEnabled
+	}
+	return false
+}
+
+func (m *ChainFlags) GetIsOutboundEnabled() bool {
+	if m != nil {
+		return m.IsOutboundEnabled
+	}
+	return false
+}
+
+// ForeignCoinFlags enables or disables the inbounds and outbounds of a foreign coin
+type ForeignCoinFlags struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// asset of the foreign coin on its chain, empty for the gas coin
+	Asset             string `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	IsInboundEnabled  bool   `protobuf:"varint,3,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
+	IsOutboundEnabled bool   `protobuf:"varint,4,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
+}
+
+func (m *ForeignCoinFlags) Reset()         { *m = ForeignCoinFlags{} }
+func (m *ForeignCoinFlags) String() string { return proto.CompactTextString(m) }
+func (*ForeignCoinFlags) ProtoMessage()    {}
+func (*ForeignCoinFlags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b948b59e4d986f49, []int{4}
+}
+func (m *ForeignCoinFlags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForeignCoinFlags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForeignCoinFlags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForeignCoinFlags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForeignCoinFlags.Merge(m, src)
+}
+func (m *ForeignCoinFlags) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForeignCoinFlags) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForeignCoinFlags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForeignCoinFlags proto.InternalMessageInfo
+
+func (m *ForeignCoinFlags) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ForeignCoinFlags) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *ForeignCoinFlags) GetIsInboundEnabled() bool {
+	if m != nil {
+		return m.IsInboundEnabled
+	}
+	return false
+}
+
+func (m *ForeignCoinFlags) GetIsOutboundEnabled() bool {
+	if m != nil {
+		return m.IsOutboundEnabled
+	}
+	return false
+}
+
 type CrosschainFlags struct {
 	IsInboundEnabled             bool                          `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled            bool                          `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags        *GasPriceIncreaseFlags        `protobuf:"bytes,3,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,4,opt,name=blockHeaderVerificationFlags,proto3" json:"blockHeaderVerificationFlags,omitempty"`
+	PruningFlags                 *PruningFlags                 `protobuf:"bytes,5,opt,name=pruningFlags,proto3" json:"pruningFlags,omitempty"`
+	// chainFlags and foreignCoinFlags list the chains and foreign coins with inbounds or outbounds disabled
+	ChainFlags       []ChainFlags       `protobuf:"bytes,6,rep,name=chainFlags,proto3" json:"chainFlags"`
+	ForeignCoinFlags []ForeignCoinFlags `protobuf:"bytes,7,rep,name=foreignCoinFlags,proto3" json:"foreignCoinFlags"`
 }
 
 func (m *CrosschainFlags) Reset()         { *m = CrosschainFlags{} }
 func (m *CrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*CrosschainFlags) ProtoMessage()    {}
 func (*CrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b948b59e4d986f49, []int{2}
+	return fileDescriptor_b948b59e4d986f49, []int{5}
 }
 func (m *CrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,6 +433,27 @@ func (m *CrosschainFlags) GetBlockHeaderVerificationFlags() *BlockHeaderVerifica
 	return nil
 }
 
+func (m *CrosschainFlags) GetPruningFlags() *PruningFlags {
+	if m != nil {
+		return m.PruningFlags
+	}
+	return nil
+}
+
+func (m *CrosschainFlags) GetChainFlags() []ChainFlags {
+	if m != nil {
+		return m.ChainFlags
+	}
+	return nil
+}
+
+func (m *CrosschainFlags) GetForeignCoinFlags() []ForeignCoinFlags {
+	if m != nil {
+		return m.ForeignCoinFlags
+	}
+	return nil
+}
+
 type LegacyCrosschainFlags struct {
 	IsInboundEnabled      bool                   `protobuf:"varint,1,opt,name=isInboundEnabled,proto3" json:"isInboundEnabled,omitempty"`
 	IsOutboundEnabled     bool                   `protobuf:"varint,2,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
@@ -237,7 +464,7 @@ func (m *LegacyCrosschainFlags) Reset()         { *m = LegacyCrosschainFlags{} }
 func (m *LegacyCrosschainFlags) String() string { return proto.CompactTextString(m) }
 func (*LegacyCrosschainFlags) ProtoMessage()    {}
 func (*LegacyCrosschainFlags) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b948b59e4d986f49, []int{3}
+	return fileDescriptor_b948b59e4d986f49, []int{6}
 }
 func (m *LegacyCrosschainFlags) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +517,9 @@ func (m *LegacyCrosschainFlags) GetGasPriceIncreaseFlags() *GasPriceIncreaseFlag
 func init() {
 	proto.RegisterType((*GasPriceIncreaseFlags)(nil), "zetachain.zetacore.observer.GasPriceIncreaseFlags")
 	proto.RegisterType((*BlockHeaderVerificationFlags)(nil), "zetachain.zetacore.observer.BlockHeaderVerificationFlags")
+	proto.RegisterType((*PruningFlags)(nil), "zetachain.zetacore.observer.PruningFlags")
+	proto.RegisterType((*ChainFlags)(nil), "zetachain.zetacore.observer.ChainFlags")
+	proto.RegisterType((*ForeignCoinFlags)(nil), "zetachain.zetacore.observer.ForeignCoinFlags")
 	proto.RegisterType((*CrosschainFlags)(nil), "zetachain.zetacore.observer.CrosschainFlags")
 	proto.RegisterType((*LegacyCrosschainFlags)(nil), "zetachain.zetacore.observer.LegacyCrosschainFlags")
 }
@@ -297,38 +527,50 @@ func init() {
 func init() { proto.RegisterFile("observer/crosschain_flags.proto", fileDescriptor_b948b59e4d986f49) }
 
 var fileDescriptor_b948b59e4d986f49 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0x5d, 0x95, 0x65, 0xca, 0xb2, 0x1a, 0x2d, 0xd6, 0x75, 0x49, 0x4b, 0x4f, 0x45,
-	0x34, 0x23, 0xd5, 0x83, 0x5e, 0x5b, 0x57, 0x0d, 0xac, 0x58, 0x82, 0x78, 0xf0, 0x22, 0x93, 0xc9,
-	0xeb, 0x64, 0x30, 0x3b, 0x53, 0x66, 0x26, 0x4b, 0x2b, 0xf8, 0x05, 0x3c, 0x79, 0x14, 0x3f, 0xd1,
-	0x1e, 0xf7, 0xe0, 0x41, 0x10, 0x54, 0xda, 0x2f, 0x22, 0x9d, 0xd8, 0xc5, 0xb6, 0x31, 0x1f, 0xc0,
-	0xdb, 0xe4, 0xfd, 0xdf, 0xff, 0xfd, 0x92, 0xf7, 0x5e, 0x06, 0xb7, 0x55, 0x6c, 0x40, 0x9f, 0x82,
-	0x26, 0x4c, 0x2b, 0x63, 0x58, 0x4a, 0x85, 0x7c, 0x3b, 0xce, 0x28, 0x37, 0xc1, 0x44, 0x2b, 0xab,
-	0xbc, 0xdb, 0xef, 0xc1, 0x52, 0x17, 0x0e, 0xdc, 0x49, 0x69, 0x08, 0x56, 0x9e, 0x83, 0x1b, 0x5c,
-	0x71, 0xe5, 0xf2, 0xc8, 0xf2, 0x54, 0x58, 0x0e, 0x7c, 0xae, 0x14, 0xcf, 0x80, 0xb8, 0xa7, 0x38,
-	0x1f, 0x93, 0x24, 0xd7, 0xd4, 0x0a, 0x25, 0x0b, 0xbd, 0xfb, 0xa5, 0x8e, 0x9b, 0xcf, 0xa8, 0x19,
-	0x69, 0xc1, 0x20, 0x94, 0x4c, 0x03, 0x35, 0xf0, 0x74, 0x89, 0xf4, 0x3a, 0xb8, 0x01, 0x13, 0xc5,
-	0xd2, 0x63, 0x90, 0xdc, 0xa6, 0x2d, 0xd4, 0x41, 0xbd, 0x9d, 0xe8, 0xef, 0x90, 0x17, 0xe2, 0x3d,
-	0x0d, 0x56, 0xcf, 0x42, 0x69, 0x41, 0x9f, 0xd2, 0xac, 0x55, 0xef, 0xa0, 0x5e, 0xa3, 0x7f, 0x2b,
-	0x28, 0x98, 0xc1, 0x8a, 0x19, 0x3c, 0xf9, 0xc3, 0x1c, 0xec, 0x9e, 0xfd, 0x68, 0xd7, 0x3e, 0xff,
-	0x6c, 0xa3, 0x68, 0xdd, 0xe9, 0x3d, 0xc2, 0x37, 0xf9, 0xc6, 0x5b, 0x8c, 0x40, 0x33, 0x90, 0xb6,
-	0xb5, 0xd3, 0x41, 0xbd, 0xbd, 0xe8, 0x5f, 0xb2, 0x77, 0x1f, 0x5f, 0xdf, 0x94, 0x5e, 0xd0, 0x69,
-	0xeb, 0x92, 0x73, 0x95, 0x49, 0x5e, 0x0f, 0xef, 0x9f, 0xd0, 0xe9, 0x08, 0x64, 0x22, 0x24, 0x1f,
-	0x32, 0x3b, 0x35, 0xad, 0xcb, 0x2e, 0x7b, 0x33, 0xdc, 0xfd, 0x88, 0xf0, 0xe1, 0x20, 0x53, 0xec,
-	0xdd, 0x73, 0xa0, 0x09, 0xe8, 0xd7, 0xa0, 0xc5, 0x58, 0x30, 0xf7, 0x29, 0x45, 0x8f, 0x1e, 0xe2,
-	0xa6, 0x30, 0x47, 0x36, 0x7d, 0x35, 0x9b, 0xc0, 0x70, 0x39, 0x97, 0x23, 0x49, 0xe3, 0x0c, 0x12,
-	0xd7, 0xad, 0xdd, 0xa8, 0x5c, 0x2c, 0x5c, 0x03, 0xcb, 0xb6, 0x5c, 0xf5, 0x95, 0xab, 0x44, 0xec,
-	0x7e, 0xad, 0xe3, 0xfd, 0xe1, 0xc5, 0x5e, 0x14, 0xfc, 0x3b, 0xf8, 0xaa, 0x30, 0xa1, 0x8c, 0x55,
-	0x2e, 0x93, 0x75, 0xf4, 0x56, 0xdc, 0xbb, 0x8b, 0xaf, 0x09, 0xf3, 0x32, 0xb7, 0x6b, 0xc9, 0x05,
-	0x71, 0x5b, 0xf0, 0x52, 0xdc, 0xe4, 0x65, 0x6b, 0xe1, 0xc6, 0xd1, 0xe8, 0xf7, 0x83, 0x8a, 0x55,
-	0x0c, 0x4a, 0x17, 0x2a, 0x2a, 0x2f, 0xe8, 0x7d, 0xc0, 0x87, 0x71, 0x45, 0x8f, 0xdd, 0x24, 0x1b,
-	0xfd, 0xc7, 0x95, 0xc0, 0xaa, 0x21, 0x45, 0x95, 0xe5, 0xbb, 0xdf, 0x11, 0x6e, 0x1e, 0x03, 0xa7,
-	0x6c, 0xf6, 0x1f, 0x36, 0x77, 0x10, 0x9e, 0xcd, 0x7d, 0x74, 0x3e, 0xf7, 0xd1, 0xaf, 0xb9, 0x8f,
-	0x3e, 0x2d, 0xfc, 0xda, 0xf9, 0xc2, 0xaf, 0x7d, 0x5b, 0xf8, 0xb5, 0x37, 0x84, 0x0b, 0x9b, 0xe6,
-	0x71, 0xc0, 0xd4, 0x09, 0x59, 0x42, 0xee, 0x39, 0x1e, 0x59, 0xf1, 0xc8, 0x94, 0x5c, 0xdc, 0x46,
-	0x76, 0x36, 0x01, 0x13, 0x5f, 0x71, 0xbf, 0xf3, 0x83, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xde,
-	0xd8, 0x41, 0xc9, 0xa6, 0x04, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0x26, 0xe9, 0x0f, 0x27, 0x2d, 0xad, 0x63, 0x83, 0x69, 0x2d, 0x69, 0xc8, 0xc5, 0x28,
+	0x76, 0x57, 0xa2, 0x07, 0x05, 0x4f, 0x89, 0xad, 0x06, 0x5a, 0x0c, 0x8b, 0x78, 0xf0, 0x52, 0xf6,
+	0xc7, 0xcb, 0x64, 0x30, 0x9d, 0x09, 0x33, 0xb3, 0x25, 0x11, 0x3c, 0xf8, 0x1f, 0x78, 0x14, 0xc1,
+	0xff, 0xa7, 0xc7, 0x82, 0x17, 0x41, 0x50, 0x69, 0xff, 0x0f, 0x91, 0x9d, 0x4d, 0xda, 0x24, 0xbb,
+	0x59, 0xec, 0xd5, 0xdb, 0xce, 0xfb, 0xe6, 0xfb, 0xbe, 0x37, 0x6f, 0xde, 0xbc, 0x45, 0x3b, 0xdc,
+	0x95, 0x20, 0x4e, 0x40, 0x58, 0x9e, 0xe0, 0x52, 0x7a, 0x5d, 0x87, 0xb2, 0xa3, 0x4e, 0xcf, 0x21,
+	0xd2, 0xec, 0x0b, 0xae, 0x38, 0xbe, 0xf3, 0x1e, 0x94, 0xa3, 0xc3, 0xa6, 0xfe, 0xe2, 0x02, 0xcc,
+	0x31, 0x67, 0x6b, 0x83, 0x70, 0xc2, 0xf5, 0x3e, 0x2b, 0xfc, 0x8a, 0x28, 0x5b, 0x65, 0xc2, 0x39,
+	0xe9, 0x81, 0xa5, 0x57, 0x6e, 0xd0, 0xb1, 0xfc, 0x40, 0x38, 0x8a, 0x72, 0x16, 0xe1, 0xd5, 0x2f,
+	0x59, 0x54, 0x7c, 0xe1, 0xc8, 0xb6, 0xa0, 0x1e, 0xb4, 0x98, 0x27, 0xc0, 0x91, 0xb0, 0x1f, 0x5a,
+	0xe2, 0x0a, 0x2a, 0x40, 0x9f, 0x7b, 0xdd, 0x03, 0x60, 0x44, 0x75, 0x4b, 0x46, 0xc5, 0xa8, 0xe5,
+	0xec, 0xc9, 0x10, 0x6e, 0xa1, 0x55, 0x01, 0x4a, 0x0c, 0x5b, 0x4c, 0x81, 0x38, 0x71, 0x7a, 0xa5,
+	0x6c, 0xc5, 0xa8, 0x15, 0xea, 0x9b, 0x66, 0xe4, 0x69, 0x8e, 0x3d, 0xcd, 0xe7, 0x23, 0xcf, 0xc6,
+	0xf2, 0xe9, 0xcf, 0x9d, 0xcc, 0xe7, 0x5f, 0x3b, 0x86, 0x3d, 0xcd, 0xc4, 0x4f, 0xd0, 0x6d, 0x32,
+	0x93, 0x45, 0x1b, 0x84, 0x07, 0x4c, 0x95, 0x72, 0x15, 0xa3, 0xb6, 0x6a, 0xcf, 0x83, 0xf1, 0x43,
+	0x74, 0x6b, 0x16, 0x3a, 0x74, 0x06, 0xa5, 0xbc, 0x66, 0x25, 0x41, 0xb8, 0x86, 0xd6, 0x8e, 0x9d,
+	0x41, 0x1b, 0x98, 0x4f, 0x19, 0x69, 0x7a, 0x6a, 0x20, 0x4b, 0x0b, 0x7a, 0xf7, 0x6c, 0xb8, 0xfa,
+	0xc7, 0x40, 0xdb, 0x8d, 0x1e, 0xf7, 0xde, 0xbd, 0x04, 0xc7, 0x07, 0xf1, 0x06, 0x04, 0xed, 0x50,
+	0x4f, 0x1f, 0x25, 0xaa, 0xd1, 0x63, 0x54, 0xa4, 0x72, 0x4f, 0x75, 0x5f, 0x0f, 0xfb, 0xd0, 0x0c,
+	0xef, 0x65, 0x8f, 0x39, 0x6e, 0x0f, 0x7c, 0x5d, 0xad, 0x65, 0x3b, 0x19, 0x8c, 0x58, 0x0d, 0xe5,
+	0xc5, 0x58, 0xd9, 0x31, 0x2b, 0x01, 0x1c, 0xb1, 0x64, 0x9c, 0x95, 0xbb, 0x64, 0xc5, 0x41, 0xfc,
+	0x0c, 0x6d, 0x52, 0xd9, 0xe6, 0xbd, 0x21, 0xe1, 0x2c, 0xc6, 0xcc, 0x6b, 0xe6, 0xfc, 0x0d, 0xd5,
+	0x0e, 0x5a, 0x69, 0x8b, 0x80, 0x51, 0x46, 0xa2, 0xf3, 0xd6, 0xd0, 0x9a, 0x00, 0x05, 0x4c, 0x5f,
+	0x66, 0x58, 0x18, 0x39, 0xea, 0x8b, 0xd9, 0x30, 0x7e, 0x80, 0x6e, 0x86, 0xd5, 0x14, 0x01, 0x03,
+	0xbf, 0x0d, 0x42, 0x47, 0xf5, 0xf9, 0x56, 0xed, 0x38, 0x50, 0xfd, 0x68, 0x20, 0xa4, 0x8d, 0x23,
+	0x9b, 0x4d, 0xb4, 0x1c, 0x35, 0x3f, 0xf5, 0x47, 0xfa, 0x4b, 0x7a, 0xdd, 0xf2, 0xf1, 0x7d, 0xb4,
+	0x4e, 0x65, 0x8b, 0xb9, 0x3c, 0x60, 0xfe, 0x74, 0xd9, 0x62, 0xf1, 0x30, 0x07, 0x2a, 0x5f, 0x05,
+	0x6a, 0x6a, 0x73, 0x54, 0xad, 0x38, 0x50, 0xfd, 0x6a, 0xa0, 0xf5, 0x7d, 0x2e, 0x80, 0x12, 0xd6,
+	0xe4, 0xff, 0x90, 0xc9, 0x06, 0x5a, 0x70, 0xa4, 0x04, 0xa5, 0xed, 0x6f, 0xd8, 0xd1, 0x22, 0x31,
+	0xbf, 0xdc, 0x75, 0xf2, 0xcb, 0xcf, 0xcb, 0xef, 0x5b, 0x1e, 0xad, 0x35, 0x2f, 0xe7, 0x42, 0x94,
+	0x5e, 0x92, 0x9b, 0x71, 0x1d, 0xb7, 0xec, 0x1c, 0x37, 0xdc, 0x45, 0x45, 0x92, 0x34, 0x16, 0xf4,
+	0x61, 0x0a, 0xf5, 0xba, 0x99, 0x32, 0x8a, 0xcc, 0xc4, 0x81, 0x62, 0x27, 0x0b, 0xe2, 0x0f, 0x68,
+	0xdb, 0x4d, 0x79, 0x63, 0xba, 0x20, 0x85, 0xfa, 0xd3, 0x54, 0xc3, 0xb4, 0x47, 0x6a, 0xa7, 0xca,
+	0xe3, 0x43, 0xb4, 0xd2, 0x9f, 0x68, 0x71, 0x3d, 0x0a, 0x0a, 0xf5, 0x7b, 0xa9, 0x76, 0x93, 0x6f,
+	0xc2, 0x9e, 0xa2, 0xe3, 0x43, 0x84, 0xae, 0xee, 0xa7, 0xb4, 0x58, 0xc9, 0xd5, 0x0a, 0xf5, 0xbb,
+	0xa9, 0x62, 0x57, 0x7d, 0xdf, 0xc8, 0x87, 0xe3, 0xd1, 0x9e, 0x10, 0xc0, 0x47, 0x68, 0xbd, 0x33,
+	0xd3, 0x93, 0xa5, 0x25, 0x2d, 0xba, 0x9b, 0x2a, 0x3a, 0xdb, 0xc8, 0x23, 0xe9, 0x98, 0x58, 0xf5,
+	0x87, 0x81, 0x8a, 0x07, 0x40, 0x1c, 0x6f, 0xf8, 0x1f, 0xf6, 0x56, 0xa3, 0x75, 0x7a, 0x5e, 0x36,
+	0xce, 0xce, 0xcb, 0xc6, 0xef, 0xf3, 0xb2, 0xf1, 0xe9, 0xa2, 0x9c, 0x39, 0xbb, 0x28, 0x67, 0xbe,
+	0x5f, 0x94, 0x33, 0x6f, 0x2d, 0x42, 0x55, 0x37, 0x70, 0x4d, 0x8f, 0x1f, 0x5b, 0xa1, 0xc9, 0xae,
+	0xf6, 0xb3, 0xc6, 0x7e, 0xd6, 0xc0, 0xba, 0xfc, 0x19, 0xab, 0x61, 0x1f, 0xa4, 0xbb, 0xa8, 0xff,
+	0x66, 0x8f, 0xfe, 0x0e, 0x00, 0x6c, 0x2e, 0xb7, 0xe5, 0xa5, 0x07, 0x00, 0x00,
 }
 
 func (m *GasPriceIncreaseFlags) Marshal() (dAtA []byte, err error) {
@@ -402,6 +644,26 @@ func (m *BlockHeaderVerificationFlags) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.IsPolygonTypeChainEnabled {
+		i--
+		if m.IsPolygonTypeChainEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsBscTypeChainEnabled {
+		i--
+		if m.IsBscTypeChainEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.IsBtcTypeChainEnabled {
 		i--
 		if m.IsBtcTypeChainEnabled {
@@ -425,7 +687,7 @@ func (m *BlockHeaderVerificationFlags) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *CrosschainFlags) Marshal() (dAtA []byte, err error) {
+func (m *PruningFlags) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -435,40 +697,49 @@ func (m *CrosschainFlags) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
+func (m *PruningFlags) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PruningFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeaderVerificationFlags != nil {
-		{
-			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
-		}
+	if m.MaxPrunedPerBlock != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.MaxPrunedPerBlock))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x10
 	}
-	if m.GasPriceIncreaseFlags != nil {
-		{
-			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
-		}
+	if m.RetentionBlocks != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.RetentionBlocks))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.IsOutboundEnabled {
 		i--
 		if m.IsOutboundEnabled {
@@ -477,7 +748,7 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if m.IsInboundEnabled {
 		i--
@@ -487,12 +758,17 @@ func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ChainId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *LegacyCrosschainFlags) Marshal() (dAtA []byte, err error) {
+func (m *ForeignCoinFlags) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -502,28 +778,16 @@ func (m *LegacyCrosschainFlags) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LegacyCrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
+func (m *ForeignCoinFlags) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LegacyCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ForeignCoinFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GasPriceIncreaseFlags != nil {
-		{
-			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
 	if m.IsOutboundEnabled {
 		i--
 		if m.IsOutboundEnabled {
@@ -532,7 +796,7 @@ func (m *LegacyCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
 	if m.IsInboundEnabled {
 		i--
@@ -542,34 +806,208 @@ func (m *LegacyCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintCrosschainFlags(dAtA, i, uint64(m.ChainId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCrosschainFlags(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCrosschainFlags(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CrosschainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GasPriceIncreaseFlags) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochLength != 0 {
-		n += 1 + sovCrosschainFlags(uint64(m.EpochLength))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RetryInterval)
-	n += 1 + l + sovCrosschainFlags(uint64(l))
-	if m.GasPriceIncreasePercent != 0 {
+
+func (m *CrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForeignCoinFlags) > 0 {
+		for iNdEx := len(m.ForeignCoinFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForeignCoinFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChainFlags) > 0 {
+		for iNdEx := len(m.ChainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.PruningFlags != nil {
+		{
+			size, err := m.PruningFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BlockHeaderVerificationFlags != nil {
+		{
+			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasPriceIncreaseFlags != nil {
+		{
+			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LegacyCrosschainFlags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LegacyCrosschainFlags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LegacyCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasPriceIncreaseFlags != nil {
+		{
+			size, err := m.GasPriceIncreaseFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrosschainFlags(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.IsOutboundEnabled {
+		i--
+		if m.IsOutboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.IsInboundEnabled {
+		i--
+		if m.IsInboundEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrosschainFlags(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrosschainFlags(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasPriceIncreaseFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.EpochLength))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RetryInterval)
+	n += 1 + l + sovCrosschainFlags(uint64(l))
+	if m.GasPriceIncreasePercent != 0 {
 		n += 1 + sovCrosschainFlags(uint64(m.GasPriceIncreasePercent))
 	}
 	if m.GasPriceIncreaseMax != 0 {
@@ -593,6 +1031,67 @@ func (m *BlockHeaderVerificationFlags) Size() (n int) {
 	if m.IsBtcTypeChainEnabled {
 		n += 2
 	}
+	if m.IsBscTypeChainEnabled {
+		n += 2
+	}
+	if m.IsPolygonTypeChainEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *PruningFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetentionBlocks != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.RetentionBlocks))
+	}
+	if m.MaxPrunedPerBlock != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.MaxPrunedPerBlock))
+	}
+	return n
+}
+
+func (m *ChainFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ChainId))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *ForeignCoinFlags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovCrosschainFlags(uint64(m.ChainId))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovCrosschainFlags(uint64(l))
+	}
+	if m.IsInboundEnabled {
+		n += 2
+	}
+	if m.IsOutboundEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -616,6 +1115,22 @@ func (m *CrosschainFlags) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
+	if m.PruningFlags != nil {
+		l = m.PruningFlags.Size()
+		n += 1 + l + sovCrosschainFlags(uint64(l))
+	}
+	if len(m.ChainFlags) > 0 {
+		for _, e := range m.ChainFlags {
+			l = e.Size()
+			n += 1 + l + sovCrosschainFlags(uint64(l))
+		}
+	}
+	if len(m.ForeignCoinFlags) > 0 {
+		for _, e := range m.ForeignCoinFlags {
+			l = e.Size()
+			n += 1 + l + sovCrosschainFlags(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -635,16 +1150,305 @@ func (m *LegacyCrosschainFlags) Size() (n int) {
 		l = m.GasPriceIncreaseFlags.Size()
 		n += 1 + l + sovCrosschainFlags(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func sovCrosschainFlags(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrosschainFlags(x uint64) (n int) {
+	return sovCrosschainFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceIncreaseFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RetryInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreasePercent", wireType)
+			}
+			m.GasPriceIncreasePercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceIncreasePercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseMax", wireType)
+			}
+			m.GasPriceIncreaseMax = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPriceIncreaseMax |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingCctxs", wireType)
+			}
+			m.MaxPendingCctxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingCctxs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeaderVerificationFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeaderVerificationFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeaderVerificationFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEthTypeChainEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEthTypeChainEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBtcTypeChainEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBtcTypeChainEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsBscTypeChainEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsBscTypeChainEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsPolygonTypeChainEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsPolygonTypeChainEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovCrosschainFlags(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCrosschainFlags(x uint64) (n int) {
-	return sovCrosschainFlags(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
+func (m *PruningFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -667,17 +1471,17 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceIncreaseFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: PruningFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceIncreaseFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PruningFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetentionBlocks", wireType)
 			}
-			m.EpochLength = 0
+			m.RetentionBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrosschainFlags
@@ -687,16 +1491,16 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochLength |= int64(b&0x7F) << shift
+				m.RetentionBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedPerBlock", wireType)
 			}
-			var msglen int
+			m.MaxPrunedPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrosschainFlags
@@ -706,30 +1510,66 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPrunedPerBlock |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCrosschainFlags
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCrosschainFlags
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RetryInterval, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainFlags) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrosschainFlags
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainFlags: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreasePercent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.GasPriceIncreasePercent = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrosschainFlags
@@ -739,16 +1579,16 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasPriceIncreasePercent |= uint32(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
-			m.GasPriceIncreaseMax = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrosschainFlags
@@ -758,16 +1598,17 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasPriceIncreaseMax |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingCctxs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
 			}
-			m.MaxPendingCctxs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrosschainFlags
@@ -777,11 +1618,12 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPendingCctxs |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -803,7 +1645,7 @@ func (m *GasPriceIncreaseFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeaderVerificationFlags) Unmarshal(dAtA []byte) error {
+func (m *ForeignCoinFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -826,15 +1668,66 @@ func (m *BlockHeaderVerificationFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeaderVerificationFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForeignCoinFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeaderVerificationFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForeignCoinFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEthTypeChainEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -851,10 +1744,10 @@ func (m *BlockHeaderVerificationFlags) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsEthTypeChainEnabled = bool(v != 0)
-		case 2:
+			m.IsInboundEnabled = bool(v != 0)
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsBtcTypeChainEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -871,7 +1764,7 @@ func (m *BlockHeaderVerificationFlags) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsBtcTypeChainEnabled = bool(v != 0)
+			m.IsOutboundEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
@@ -1034,6 +1927,110 @@ func (m *CrosschainFlags) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PruningFlags == nil {
+				m.PruningFlags = &PruningFlags{}
+			}
+			if err := m.PruningFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainFlags = append(m.ChainFlags, ChainFlags{})
+			if err := m.ChainFlags[len(m.ChainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignCoinFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrosschainFlags
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrosschainFlags
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignCoinFlags = append(m.ForeignCoinFlags, ForeignCoinFlags{})
+			if err := m.ForeignCoinFlags[len(m.ForeignCoinFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrosschainFlags(dAtA[iNdEx:])
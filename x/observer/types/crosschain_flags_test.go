@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestCrosschainFlags_IsChainEnabled(t *testing.T) {
+	flags := types.CrosschainFlags{
+		IsInboundEnabled:  true,
+		IsOutboundEnabled: true,
+		ChainFlags: []types.ChainFlags{
+			{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true},
+			{ChainId: 2, IsInboundEnabled: true, IsOutboundEnabled: false},
+		},
+	}
+
+	require.False(t, flags.IsChainInboundEnabled(1))
+	require.True(t, flags.IsChainOutboundEnabled(1))
+	require.True(t, flags.IsChainInboundEnabled(2))
+	require.False(t, flags.IsChainOutboundEnabled(2))
+	require.True(t, flags.IsChainInboundEnabled(3))
+	require.True(t, flags.IsChainOutboundEnabled(3))
+
+	// global flags take precedence
+	flags.IsInboundEnabled = false
+	flags.IsOutboundEnabled = false
+	require.False(t, flags.IsChainInboundEnabled(3))
+	require.False(t, flags.IsChainOutboundEnabled(3))
+}
+
+func TestBlockHeaderVerificationFlags_IsChainEnabled(t *testing.T) {
+	flags := types.BlockHeaderVerificationFlags{
+		IsEthTypeChainEnabled:     true,
+		IsBtcTypeChainEnabled:     false,
+		IsBscTypeChainEnabled:     false,
+		IsPolygonTypeChainEnabled: true,
+	}
+
+	require.True(t, flags.IsChainEnabled(common.EthChain().ChainId))
+	require.True(t, flags.IsChainEnabled(common.GoerliChain().ChainId))
+	require.False(t, flags.IsChainEnabled(common.BtcMainnetChain().ChainId))
+	require.False(t, flags.IsChainEnabled(common.BscMainnetChain().ChainId))
+	require.False(t, flags.IsChainEnabled(common.BscTestnetChain().ChainId))
+	require.True(t, flags.IsChainEnabled(common.PolygonChain().ChainId))
+	require.True(t, flags.IsChainEnabled(common.MumbaiChain().ChainId))
+	require.False(t, flags.IsChainEnabled(common.ZetaTestnetChain().ChainId))
+
+	// bsc and polygon chains don't use the ethereum flag
+	flags = types.BlockHeaderVerificationFlags{IsEthTypeChainEnabled: true}
+	require.False(t, flags.IsChainEnabled(common.BscMainnetChain().ChainId))
+	require.False(t, flags.IsChainEnabled(common.PolygonChain().ChainId))
+}
+
+func TestCrosschainFlags_IsForeignCoinEnabled(t *testing.T) {
+	flags := types.CrosschainFlags{
+		IsInboundEnabled:  true,
+		IsOutboundEnabled: true,
+		ChainFlags: []types.ChainFlags{
+			{ChainId: 2, IsInboundEnabled: false, IsOutboundEnabled: false},
+		},
+		ForeignCoinFlags: []types.ForeignCoinFlags{
+			{ChainId: 1, Asset: "", IsInboundEnabled: false, IsOutboundEnabled: true},
+			{ChainId: 1, Asset: "0xabcd", IsInboundEnabled: true, IsOutboundEnabled: false},
+		},
+	}
+
+	// gas coin
+	require.False(t, flags.IsForeignCoinInboundEnabled(1, common.CoinType_Gas, ""))
+	require.True(t, flags.IsForeignCoinOutboundEnabled(1, common.CoinType_Gas, ""))
+
+	// erc20, the asset is case-insensitive
+	require.True(t, flags.IsForeignCoinInboundEnabled(1, common.CoinType_ERC20, "0xABCD"))
+	require.False(t, flags.IsForeignCoinOutboundEnabled(1, common.CoinType_ERC20, "0xABCD"))
+	require.True(t, flags.IsForeignCoinOutboundEnabled(1, common.CoinType_ERC20, "0x1234"))
+
+	// the foreign coin flags don't apply to zeta
+	require.True(t, flags.IsForeignCoinInboundEnabled(1, common.CoinType_Zeta, ""))
+	require.True(t, flags.IsForeignCoinOutboundEnabled(1, common.CoinType_Zeta, ""))
+
+	// the chain flags apply to all coins
+	require.False(t, flags.IsForeignCoinInboundEnabled(2, common.CoinType_Zeta, ""))
+	require.False(t, flags.IsForeignCoinOutboundEnabled(2, common.CoinType_Gas, ""))
+}
+
+func TestCrosschainFlags_SetChainFlags(t *testing.T) {
+	var flags types.CrosschainFlags
+
+	flags.SetChainFlags(types.ChainFlags{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true})
+	flags.SetChainFlags(types.ChainFlags{ChainId: 2, IsInboundEnabled: true, IsOutboundEnabled: false})
+	require.Len(t, flags.ChainFlags, 2)
+
+	// update
+	flags.SetChainFlags(types.ChainFlags{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: false})
+	chainFlags, found := flags.FindChainFlags(1)
+	require.True(t, found)
+	require.False(t, chainFlags.IsOutboundEnabled)
+	require.Len(t, flags.ChainFlags, 2)
+
+	// the flags are removed when everything is enabled
+	flags.SetChainFlags(types.ChainFlags{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: true})
+	_, found = flags.FindChainFlags(1)
+	require.False(t, found)
+	require.Len(t, flags.ChainFlags, 1)
+}
+
+func TestCrosschainFlags_SetForeignCoinFlags(t *testing.T) {
+	var flags types.CrosschainFlags
+
+	flags.SetForeignCoinFlags(types.ForeignCoinFlags{ChainId: 1, Asset: "0xabcd", IsInboundEnabled: false, IsOutboundEnabled: true})
+	flags.SetForeignCoinFlags(types.ForeignCoinFlags{ChainId: 1, Asset: "", IsInboundEnabled: true, IsOutboundEnabled: false})
+	require.Len(t, flags.ForeignCoinFlags, 2)
+
+	// update, the asset is case-insensitive
+	flags.SetForeignCoinFlags(types.ForeignCoinFlags{ChainId: 1, Asset: "0xABCD", IsInboundEnabled: false, IsOutboundEnabled: false})
+	coinFlags, found := flags.FindForeignCoinFlags(1, "0xabcd")
+	require.True(t, found)
+	require.False(t, coinFlags.IsOutboundEnabled)
+	require.Len(t, flags.ForeignCoinFlags, 2)
+
+	// the flags are removed when everything is enabled
+	flags.SetForeignCoinFlags(types.ForeignCoinFlags{ChainId: 1, Asset: "0xabcd", IsInboundEnabled: true, IsOutboundEnabled: true})
+	_, found = flags.FindForeignCoinFlags(1, "0xabcd")
+	require.False(t, found)
+	require.Len(t, flags.ForeignCoinFlags, 1)
+}
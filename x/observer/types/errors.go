@@ -39,4 +39,19 @@ var (
 	ErrInboundDisabled      = errorsmod.Register(ModuleName, 1132, "inbound tx processing is disabled")
 	ErrInvalidZetaCoinTypes = errorsmod.Register(ModuleName, 1133, "invalid zeta coin types")
 	ErrNotObserver          = errorsmod.Register(ModuleName, 1134, "sender is not an observer")
+	ErrInvalidPruningFlags  = errorsmod.Register(ModuleName, 1135, "invalid pruning flags")
+	ErrOutboundDisabled     = errorsmod.Register(ModuleName, 1136, "outbound tx processing is disabled")
+
+	ErrObserverNotAssignedToChain   = errorsmod.Register(ModuleName, 1137, "observer is not assigned to the chain")
+	ErrObserverNotJailed            = errorsmod.Register(ModuleName, 1138, "observer is not jailed")
+	ErrObserverJailPeriodNotElapsed = errorsmod.Register(ModuleName, 1139, "observer jail period has not elapsed")
+	ErrParamsLiveness               = errorsmod.Register(ModuleName, 1140, "invalid liveness params")
+	ErrInvalidActivationHeight      = errorsmod.Register(ModuleName, 1141, "invalid activation height")
+	ErrInvalidDifficulty            = errorsmod.Register(ModuleName, 1142, "invalid block header difficulty")
+	ErrLightClientNotSupported      = errorsmod.Register(ModuleName, 1143, "light client not supported for the chain")
+	ErrLightClientNotFound          = errorsmod.Register(ModuleName, 1144, "light client not found")
+	ErrInvalidLightClientUpdate     = errorsmod.Register(ModuleName, 1145, "invalid light client update")
+	ErrBlockNotFinalized            = errorsmod.Register(ModuleName, 1146, "block is not finalized by the light client")
+	ErrParamsBlameRetention         = errorsmod.Register(ModuleName, 1147, "invalid blame retention params")
+	ErrChainWithoutObservers        = errorsmod.Register(ModuleName, 1148, "supported chain without assigned observers")
 )
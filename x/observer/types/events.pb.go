@@ -243,6 +243,9 @@ type EventCrosschainFlagsUpdated struct {
 	GasPriceIncreaseFlags        *GasPriceIncreaseFlags        `protobuf:"bytes,4,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	Signer                       string                        `protobuf:"bytes,5,opt,name=signer,proto3" json:"signer,omitempty"`
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,6,opt,name=blockHeaderVerificationFlags,proto3" json:"blockHeaderVerificationFlags,omitempty"`
+	PruningFlags                 *PruningFlags                 `protobuf:"bytes,7,opt,name=pruningFlags,proto3" json:"pruningFlags,omitempty"`
+	ChainFlags                   []ChainFlags                  `protobuf:"bytes,8,rep,name=chainFlags,proto3" json:"chainFlags"`
+	ForeignCoinFlags             []ForeignCoinFlags            `protobuf:"bytes,9,rep,name=foreignCoinFlags,proto3" json:"foreignCoinFlags"`
 }
 
 func (m *EventCrosschainFlagsUpdated) Reset()         { *m = EventCrosschainFlagsUpdated{} }
@@ -320,55 +323,336 @@ func (m *EventCrosschainFlagsUpdated) GetBlockHeaderVerificationFlags() *BlockHe
 	return nil
 }
 
+func (m *EventCrosschainFlagsUpdated) GetPruningFlags() *PruningFlags {
+	if m != nil {
+		return m.PruningFlags
+	}
+	return nil
+}
+
+func (m *EventCrosschainFlagsUpdated) GetChainFlags() []ChainFlags {
+	if m != nil {
+		return m.ChainFlags
+	}
+	return nil
+}
+
+func (m *EventCrosschainFlagsUpdated) GetForeignCoinFlags() []ForeignCoinFlags {
+	if m != nil {
+		return m.ForeignCoinFlags
+	}
+	return nil
+}
+
+type EventObserverJailed struct {
+	ObserverAddress     string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	WindowBallots       int64  `protobuf:"varint,2,opt,name=window_ballots,json=windowBallots,proto3" json:"window_ballots,omitempty"`
+	WindowMissedBallots int64  `protobuf:"varint,3,opt,name=window_missed_ballots,json=windowMissedBallots,proto3" json:"window_missed_ballots,omitempty"`
+	JailedUntil         int64  `protobuf:"varint,4,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	SlashedAmount       string `protobuf:"bytes,5,opt,name=slashed_amount,json=slashedAmount,proto3" json:"slashed_amount,omitempty"`
+}
+
+func (m *EventObserverJailed) Reset()         { *m = EventObserverJailed{} }
+func (m *EventObserverJailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverJailed) ProtoMessage()    {}
+func (*EventObserverJailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{4}
+}
+func (m *EventObserverJailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverJailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverJailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverJailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverJailed.Merge(m, src)
+}
+func (m *EventObserverJailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverJailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverJailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverJailed proto.InternalMessageInfo
+
+func (m *EventObserverJailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *EventObserverJailed) GetWindowBallots() int64 {
+	if m != nil {
+		return m.WindowBallots
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetWindowMissedBallots() int64 {
+	if m != nil {
+		return m.WindowMissedBallots
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *EventObserverJailed) GetSlashedAmount() string {
+	if m != nil {
+		return m.SlashedAmount
+	}
+	return ""
+}
+
+type EventObserverUnjailed struct {
+	MsgTypeUrl      string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ObserverAddress string `protobuf:"bytes,2,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+}
+
+func (m *EventObserverUnjailed) Reset()         { *m = EventObserverUnjailed{} }
+func (m *EventObserverUnjailed) String() string { return proto.CompactTextString(m) }
+func (*EventObserverUnjailed) ProtoMessage()    {}
+func (*EventObserverUnjailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{5}
+}
+func (m *EventObserverUnjailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObserverUnjailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObserverUnjailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObserverUnjailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObserverUnjailed.Merge(m, src)
+}
+func (m *EventObserverUnjailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObserverUnjailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObserverUnjailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObserverUnjailed proto.InternalMessageInfo
+
+func (m *EventObserverUnjailed) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventObserverUnjailed) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+type EventChainParamsScheduled struct {
+	MsgTypeUrl       string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	ChainId          int64  `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ActivationHeight int64  `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *EventChainParamsScheduled) Reset()         { *m = EventChainParamsScheduled{} }
+func (m *EventChainParamsScheduled) String() string { return proto.CompactTextString(m) }
+func (*EventChainParamsScheduled) ProtoMessage()    {}
+func (*EventChainParamsScheduled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{6}
+}
+func (m *EventChainParamsScheduled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainParamsScheduled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainParamsScheduled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainParamsScheduled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainParamsScheduled.Merge(m, src)
+}
+func (m *EventChainParamsScheduled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainParamsScheduled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainParamsScheduled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainParamsScheduled proto.InternalMessageInfo
+
+func (m *EventChainParamsScheduled) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventChainParamsScheduled) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventChainParamsScheduled) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+type EventChainParamsActivated struct {
+	ChainId          int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *EventChainParamsActivated) Reset()         { *m = EventChainParamsActivated{} }
+func (m *EventChainParamsActivated) String() string { return proto.CompactTextString(m) }
+func (*EventChainParamsActivated) ProtoMessage()    {}
+func (*EventChainParamsActivated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1ca57368474456, []int{7}
+}
+func (m *EventChainParamsActivated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChainParamsActivated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChainParamsActivated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChainParamsActivated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChainParamsActivated.Merge(m, src)
+}
+func (m *EventChainParamsActivated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChainParamsActivated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChainParamsActivated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChainParamsActivated proto.InternalMessageInfo
+
+func (m *EventChainParamsActivated) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *EventChainParamsActivated) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventBallotCreated)(nil), "zetachain.zetacore.observer.EventBallotCreated")
 	proto.RegisterType((*EventKeygenBlockUpdated)(nil), "zetachain.zetacore.observer.EventKeygenBlockUpdated")
 	proto.RegisterType((*EventNewObserverAdded)(nil), "zetachain.zetacore.observer.EventNewObserverAdded")
 	proto.RegisterType((*EventCrosschainFlagsUpdated)(nil), "zetachain.zetacore.observer.EventCrosschainFlagsUpdated")
+	proto.RegisterType((*EventObserverJailed)(nil), "zetachain.zetacore.observer.EventObserverJailed")
+	proto.RegisterType((*EventObserverUnjailed)(nil), "zetachain.zetacore.observer.EventObserverUnjailed")
+	proto.RegisterType((*EventChainParamsScheduled)(nil), "zetachain.zetacore.observer.EventChainParamsScheduled")
+	proto.RegisterType((*EventChainParamsActivated)(nil), "zetachain.zetacore.observer.EventChainParamsActivated")
 }
 
 func init() { proto.RegisterFile("observer/events.proto", fileDescriptor_1f1ca57368474456) }
 
 var fileDescriptor_1f1ca57368474456 = []byte{
-	// 599 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x14, 0x5e, 0xb6, 0x31, 0x81, 0x37, 0xd8, 0x66, 0xb1, 0x2d, 0xeb, 0x50, 0x36, 0x2a, 0x21, 0xf1,
-	0x33, 0x91, 0xc6, 0x69, 0x88, 0x0b, 0xad, 0xc6, 0x56, 0x81, 0xd8, 0x54, 0x31, 0x0e, 0x5c, 0x22,
-	0x27, 0x79, 0x4b, 0xac, 0x66, 0x76, 0x65, 0x3b, 0x83, 0x22, 0x71, 0xe4, 0xce, 0x15, 0xfe, 0x22,
-	0x8e, 0x3b, 0x72, 0xe0, 0x80, 0xd6, 0x7f, 0x04, 0xd9, 0x4e, 0xd3, 0xa2, 0x56, 0x55, 0x6f, 0xce,
-	0x7b, 0xdf, 0xf7, 0xbd, 0xef, 0xbd, 0xe7, 0x18, 0x6d, 0xf0, 0x48, 0x82, 0xb8, 0x04, 0x11, 0xc0,
-	0x25, 0x30, 0x25, 0xfd, 0xae, 0xe0, 0x8a, 0xe3, 0x9d, 0x2f, 0xa0, 0x48, 0x9c, 0x11, 0xca, 0x7c,
-	0x73, 0xe2, 0x02, 0xfc, 0x01, 0xb2, 0x76, 0x37, 0xe5, 0x29, 0x37, 0xb8, 0x40, 0x9f, 0x2c, 0xa5,
-	0xb6, 0x5b, 0x29, 0xc5, 0x82, 0x4b, 0x69, 0xc8, 0xe1, 0x79, 0x4e, 0xd2, 0x52, 0xb3, 0xb6, 0x55,
-	0x01, 0x06, 0x07, 0x9b, 0xa8, 0xff, 0x71, 0x10, 0x3e, 0xd4, 0xd5, 0x1b, 0x24, 0xcf, 0xb9, 0x6a,
-	0x0a, 0x20, 0x0a, 0x12, 0xbc, 0x87, 0x56, 0x2e, 0x64, 0x1a, 0xaa, 0x5e, 0x17, 0xc2, 0x42, 0xe4,
-	0xae, 0xb3, 0xe7, 0x3c, 0xbc, 0xd5, 0x46, 0x17, 0x32, 0x7d, 0xdf, 0xeb, 0xc2, 0x99, 0xc8, 0xf1,
-	0x13, 0xb4, 0x1e, 0x19, 0x4a, 0x48, 0x13, 0x60, 0x8a, 0x9e, 0x53, 0x10, 0xee, 0xbc, 0x81, 0xad,
-	0xd9, 0x44, 0xab, 0x8a, 0xe3, 0x47, 0x68, 0xcd, 0xd6, 0x25, 0x8a, 0x72, 0x16, 0x66, 0x44, 0x66,
-	0xee, 0x82, 0xc1, 0xae, 0x8e, 0xc4, 0x8f, 0x89, 0xcc, 0xb4, 0xee, 0x28, 0xd4, 0xb4, 0xe2, 0x2e,
-	0x5a, 0xdd, 0x91, 0x44, 0x53, 0xc7, 0xf1, 0x2e, 0x5a, 0x2e, 0x4d, 0x68, 0xa7, 0xee, 0x0d, 0xeb,
-	0xd2, 0x86, 0xb4, 0xd1, 0xfa, 0x37, 0x07, 0x6d, 0x99, 0xf6, 0xde, 0x40, 0x2f, 0x05, 0xd6, 0xc8,
-	0x79, 0xdc, 0x39, 0xeb, 0x26, 0x33, 0xf6, 0x78, 0x1f, 0xad, 0x74, 0x0c, 0x2f, 0x8c, 0x34, 0xb1,
-	0x6c, 0x6f, 0xb9, 0x33, 0xd4, 0xc2, 0x0f, 0xd0, 0x9d, 0x12, 0xd2, 0x2d, 0xa2, 0x0e, 0xf4, 0x64,
-	0xd9, 0xd7, 0x6d, 0x1b, 0x3d, 0xb5, 0xc1, 0xfa, 0x8f, 0x79, 0xb4, 0x61, 0x7c, 0xbc, 0x83, 0x4f,
-	0x27, 0xe5, 0x06, 0x5e, 0x25, 0xc9, 0x4c, 0x2e, 0xaa, 0xe1, 0x81, 0x08, 0x49, 0x92, 0x08, 0x90,
-	0xb2, 0x74, 0xb2, 0xca, 0x87, 0x52, 0x3a, 0x8c, 0x5f, 0xa2, 0x9a, 0xb9, 0x32, 0x39, 0x05, 0xa6,
-	0xc2, 0x54, 0x10, 0xa6, 0x00, 0x2a, 0x92, 0x75, 0xe6, 0x0e, 0x11, 0x47, 0x16, 0x30, 0x60, 0xbf,
-	0x40, 0xdb, 0x13, 0xd8, 0xb6, 0xaf, 0x72, 0x05, 0x5b, 0x63, 0x64, 0xdb, 0x21, 0x3e, 0x40, 0xdb,
-	0x95, 0xc9, 0x9c, 0x48, 0x65, 0x27, 0x16, 0xc6, 0xbc, 0x60, 0xca, 0xec, 0x65, 0xb1, 0xbd, 0x39,
-	0x00, 0xbc, 0x25, 0x52, 0x99, 0xe9, 0x35, 0x75, 0xb6, 0xfe, 0x73, 0x01, 0xed, 0x98, 0xd9, 0x34,
-	0xab, 0xbb, 0xfb, 0x5a, 0x5f, 0xdd, 0xd9, 0xf7, 0xf4, 0x18, 0xad, 0x51, 0xd9, 0x62, 0x11, 0x2f,
-	0x58, 0x72, 0xc8, 0x48, 0x94, 0x43, 0x62, 0x26, 0x74, 0xb3, 0x3d, 0x16, 0xc7, 0x4f, 0xd1, 0x3a,
-	0x95, 0x27, 0x85, 0xfa, 0x0f, 0xbc, 0x60, 0xc0, 0xe3, 0x09, 0x9c, 0xa1, 0x8d, 0x94, 0xc8, 0x53,
-	0x41, 0x63, 0x68, 0xb1, 0x58, 0x00, 0x91, 0x60, 0xbc, 0x99, 0x71, 0x2c, 0xef, 0xef, 0xfb, 0x53,
-	0xfe, 0x55, 0xff, 0x68, 0x12, 0xb3, 0x3d, 0x59, 0x10, 0x6f, 0xa2, 0x25, 0x49, 0x53, 0x06, 0xa2,
-	0xbc, 0xc5, 0xe5, 0x17, 0xfe, 0x8a, 0xee, 0x99, 0x51, 0x1e, 0x03, 0x49, 0x40, 0x7c, 0x00, 0x41,
-	0xcf, 0x69, 0x6c, 0x7e, 0x01, 0x6b, 0x64, 0xc9, 0x18, 0x39, 0x98, 0x6a, 0xa4, 0x31, 0x45, 0xa0,
-	0x3d, 0x55, 0xbe, 0xd1, 0xfa, 0x75, 0xed, 0x39, 0x57, 0xd7, 0x9e, 0xf3, 0xf7, 0xda, 0x73, 0xbe,
-	0xf7, 0xbd, 0xb9, 0xab, 0xbe, 0x37, 0xf7, 0xbb, 0xef, 0xcd, 0x7d, 0x0c, 0x52, 0xaa, 0xb2, 0x22,
-	0xf2, 0x63, 0x7e, 0x11, 0xe8, 0x92, 0xcf, 0x4c, 0xf5, 0x60, 0x50, 0x3d, 0xf8, 0x5c, 0x3d, 0x35,
-	0x81, 0x5e, 0x9d, 0x8c, 0x96, 0xcc, 0x8b, 0xf3, 0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xea,
-	0x9e, 0x8b, 0x9e, 0xf7, 0x04, 0x00, 0x00,
+	// 854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xc6, 0x69, 0x9a, 0x8c, 0x93, 0xe2, 0x4e, 0x71, 0xe3, 0xa4, 0xc8, 0x49, 0x2d, 0x55,
+	0xb4, 0x40, 0x6d, 0x29, 0x9c, 0x8a, 0xb8, 0xc4, 0x56, 0xdb, 0x18, 0x08, 0x8d, 0x16, 0xc2, 0x81,
+	0xcb, 0x6a, 0x76, 0xe7, 0x65, 0x77, 0xc8, 0x7a, 0xd6, 0x9a, 0x99, 0x6d, 0x08, 0x12, 0x47, 0xee,
+	0x5c, 0xfb, 0x8f, 0x7a, 0xcc, 0x91, 0x03, 0x42, 0x28, 0x11, 0xff, 0x03, 0xcd, 0x9b, 0xdd, 0x8d,
+	0x83, 0x2d, 0xcb, 0x07, 0x6e, 0xbb, 0xef, 0x7d, 0xef, 0x7b, 0xdf, 0x7b, 0xf3, 0xde, 0x0c, 0x69,
+	0x66, 0xa1, 0x06, 0xf5, 0x16, 0x54, 0x0f, 0xde, 0x82, 0x34, 0xba, 0x3b, 0x56, 0x99, 0xc9, 0xe8,
+	0xa3, 0x5f, 0xc0, 0xb0, 0x28, 0x61, 0x42, 0x76, 0xf1, 0x2b, 0x53, 0xd0, 0x2d, 0x91, 0x3b, 0x1f,
+	0xc6, 0x59, 0x9c, 0x21, 0xae, 0x67, 0xbf, 0x5c, 0xc8, 0xce, 0x6e, 0xc5, 0x14, 0xa9, 0x4c, 0x6b,
+	0x0c, 0x0e, 0x4e, 0x53, 0x16, 0x17, 0x9c, 0x3b, 0x5b, 0x15, 0xa0, 0xfc, 0x70, 0x8e, 0xce, 0x9f,
+	0x1e, 0xa1, 0x2f, 0x6d, 0xf6, 0x3e, 0x4b, 0xd3, 0xcc, 0x0c, 0x14, 0x30, 0x03, 0x9c, 0xee, 0x91,
+	0x8d, 0x91, 0x8e, 0x03, 0x73, 0x31, 0x86, 0x20, 0x57, 0x69, 0xcb, 0xdb, 0xf3, 0x9e, 0xae, 0xfb,
+	0x64, 0xa4, 0xe3, 0xef, 0x2f, 0xc6, 0x70, 0xa2, 0x52, 0xfa, 0x29, 0xb9, 0x1f, 0x62, 0x48, 0x20,
+	0x38, 0x48, 0x23, 0x4e, 0x05, 0xa8, 0xd6, 0x32, 0xc2, 0x1a, 0xce, 0x31, 0xac, 0xec, 0xf4, 0x19,
+	0x69, 0xb8, 0xbc, 0xcc, 0x88, 0x4c, 0x06, 0x09, 0xd3, 0x49, 0xab, 0x86, 0xd8, 0x0f, 0x26, 0xec,
+	0x87, 0x4c, 0x27, 0x96, 0x77, 0x12, 0x8a, 0xa5, 0xb4, 0x56, 0x1c, 0xef, 0x84, 0x63, 0x60, 0xed,
+	0x74, 0x97, 0xd4, 0x0b, 0x11, 0x56, 0x69, 0xeb, 0x8e, 0x53, 0xe9, 0x4c, 0x56, 0x68, 0xe7, 0x37,
+	0x8f, 0x6c, 0x61, 0x79, 0x5f, 0xc3, 0x45, 0x0c, 0xb2, 0x9f, 0x66, 0xd1, 0xd9, 0xc9, 0x98, 0x2f,
+	0x58, 0xe3, 0x63, 0xb2, 0x71, 0x86, 0x71, 0x41, 0x68, 0x03, 0x8b, 0xf2, 0xea, 0x67, 0x37, 0x5c,
+	0xf4, 0x09, 0xb9, 0x57, 0x40, 0xc6, 0x79, 0x78, 0x06, 0x17, 0xba, 0xa8, 0x6b, 0xd3, 0x59, 0x8f,
+	0x9d, 0xb1, 0xf3, 0x6e, 0x99, 0x34, 0x51, 0xc7, 0xb7, 0x70, 0xfe, 0xa6, 0x38, 0x81, 0x03, 0xce,
+	0x17, 0x52, 0x51, 0x35, 0x0f, 0x54, 0xc0, 0x38, 0x57, 0xa0, 0x75, 0x6b, 0x79, 0xb2, 0x79, 0x48,
+	0x65, 0xcd, 0xf4, 0x4b, 0xb2, 0x83, 0x23, 0x93, 0x0a, 0x90, 0x26, 0x88, 0x15, 0x93, 0x06, 0xa0,
+	0x0a, 0x72, 0xca, 0x5a, 0x37, 0x88, 0xd7, 0x0e, 0x50, 0x46, 0x7f, 0x41, 0xb6, 0x67, 0x44, 0xbb,
+	0xba, 0x8a, 0x23, 0xd8, 0x9a, 0x0a, 0x76, 0x15, 0xd2, 0x17, 0x64, 0xbb, 0x12, 0x99, 0x32, 0x6d,
+	0x5c, 0xc7, 0x82, 0x28, 0xcb, 0xa5, 0xc1, 0x73, 0x59, 0xf1, 0x1f, 0x96, 0x80, 0x6f, 0x98, 0x36,
+	0xd8, 0xbd, 0x81, 0xf5, 0x76, 0xde, 0xdd, 0x21, 0x8f, 0xb0, 0x37, 0x83, 0x6a, 0x76, 0x5f, 0xd9,
+	0xd1, 0x5d, 0xfc, 0x9c, 0x3e, 0x21, 0x0d, 0xa1, 0x87, 0x32, 0xcc, 0x72, 0xc9, 0x5f, 0x4a, 0x16,
+	0xa6, 0xc0, 0xb1, 0x43, 0x6b, 0xfe, 0x94, 0x9d, 0x7e, 0x46, 0xee, 0x0b, 0xfd, 0x26, 0x37, 0xb7,
+	0xc0, 0x35, 0x04, 0x4f, 0x3b, 0x68, 0x42, 0x9a, 0x31, 0xd3, 0xc7, 0x4a, 0x44, 0x30, 0x94, 0x91,
+	0x02, 0xa6, 0x01, 0xb5, 0x61, 0x3b, 0xea, 0xfb, 0xfb, 0xdd, 0x39, 0xbb, 0xda, 0x7d, 0x3d, 0x2b,
+	0xd2, 0x9f, 0x4d, 0x48, 0x1f, 0x92, 0x55, 0x2d, 0x62, 0x09, 0xaa, 0x98, 0xe2, 0xe2, 0x8f, 0xfe,
+	0x4a, 0x3e, 0xc2, 0x56, 0x1e, 0x02, 0xe3, 0xa0, 0x7e, 0x00, 0x25, 0x4e, 0x45, 0x84, 0x2b, 0xe0,
+	0x84, 0xac, 0xa2, 0x90, 0x17, 0x73, 0x85, 0xf4, 0xe7, 0x10, 0xf8, 0x73, 0xe9, 0xe9, 0x11, 0xd9,
+	0x18, 0xab, 0x5c, 0x0a, 0x19, 0xbb, 0x74, 0x77, 0x31, 0xdd, 0xb3, 0xb9, 0xe9, 0x8e, 0x27, 0x02,
+	0xfc, 0x5b, 0xe1, 0xf4, 0x88, 0x90, 0x9b, 0x03, 0x6e, 0xad, 0xed, 0xd5, 0x9e, 0xd6, 0xf7, 0x3f,
+	0x9e, 0x4b, 0x36, 0xa8, 0xe0, 0xfd, 0x95, 0xf7, 0x7f, 0xed, 0x2e, 0xf9, 0x13, 0x04, 0x34, 0x20,
+	0x8d, 0xd3, 0x4c, 0x81, 0x88, 0xe5, 0x20, 0x2b, 0x49, 0xd7, 0x91, 0xf4, 0xf9, 0x5c, 0xd2, 0x57,
+	0xff, 0x09, 0x2a, 0xa8, 0xa7, 0xc8, 0x3a, 0xff, 0x78, 0xe4, 0x01, 0xce, 0x66, 0xb9, 0xb4, 0x5f,
+	0x31, 0x61, 0xe7, 0x62, 0xd6, 0x4e, 0x7a, 0xb3, 0x77, 0xf2, 0x09, 0xb9, 0x77, 0x2e, 0x24, 0xcf,
+	0xce, 0x03, 0x77, 0x2f, 0xb9, 0xe5, 0xad, 0xf9, 0x9b, 0xce, 0xea, 0xee, 0x5d, 0x4d, 0xf7, 0x49,
+	0xb3, 0x80, 0x8d, 0x84, 0xd6, 0xc0, 0x2b, 0x74, 0x0d, 0xd1, 0x0f, 0x9c, 0xf3, 0x08, 0x7d, 0x65,
+	0xcc, 0x63, 0xb2, 0xf1, 0x13, 0xea, 0x09, 0x72, 0x69, 0x44, 0x8a, 0x43, 0x59, 0xf3, 0xeb, 0xce,
+	0x76, 0x62, 0x4d, 0x36, 0xbb, 0x4e, 0x99, 0x4e, 0x80, 0x07, 0x6c, 0x54, 0x2d, 0xe3, 0xba, 0xbf,
+	0x59, 0x58, 0x0f, 0xd0, 0xd8, 0xe1, 0xa4, 0x79, 0xab, 0xcc, 0x13, 0xe9, 0x48, 0xfe, 0xd7, 0xeb,
+	0xc9, 0xde, 0xc6, 0xdb, 0x6e, 0xd3, 0xed, 0xb9, 0x1c, 0x33, 0xc5, 0x46, 0xfa, 0xbb, 0x28, 0x01,
+	0x9e, 0x2f, 0x96, 0x6a, 0x9b, 0xac, 0xb9, 0xa7, 0x4d, 0xf0, 0xa2, 0x89, 0x77, 0xf1, 0x7f, 0xc8,
+	0xed, 0xb3, 0xc1, 0x22, 0x23, 0xca, 0x07, 0x06, 0x44, 0x9c, 0x98, 0xa2, 0x75, 0x8d, 0x1b, 0xc7,
+	0x21, 0xda, 0x3b, 0xd1, 0xb4, 0x8c, 0x03, 0x87, 0x01, 0x7e, 0x2b, 0x89, 0xb7, 0x40, 0x92, 0xe5,
+	0xd9, 0x49, 0xfa, 0xc3, 0xf7, 0x57, 0x6d, 0xef, 0xf2, 0xaa, 0xed, 0xfd, 0x7d, 0xd5, 0xf6, 0x7e,
+	0xbf, 0x6e, 0x2f, 0x5d, 0x5e, 0xb7, 0x97, 0xfe, 0xb8, 0x6e, 0x2f, 0xfd, 0xd8, 0x8b, 0x85, 0x49,
+	0xf2, 0xb0, 0x1b, 0x65, 0xa3, 0x9e, 0x9d, 0xcd, 0xe7, 0xc8, 0xdf, 0x2b, 0xc7, 0xb4, 0xf7, 0x73,
+	0xf5, 0x48, 0xf7, 0x6c, 0x33, 0x74, 0xb8, 0x8a, 0x6f, 0xf5, 0xe7, 0xff, 0x0e, 0x00, 0x1d, 0x88,
+	0xfc, 0x33, 0x31, 0x08, 0x00, 0x00,
 }
 
 func (m *EventBallotCreated) Marshal() (dAtA []byte, err error) {
@@ -549,6 +833,46 @@ func (m *EventCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForeignCoinFlags) > 0 {
+		for iNdEx := len(m.ForeignCoinFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForeignCoinFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChainFlags) > 0 {
+		for iNdEx := len(m.ChainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PruningFlags != nil {
+		{
+			size, err := m.PruningFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.BlockHeaderVerificationFlags != nil {
 		{
 			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -610,54 +934,216 @@ func (m *EventCrosschainFlagsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventObserverJailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventBallotCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventObserverJailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.SlashedAmount) > 0 {
+		i -= len(m.SlashedAmount)
+		copy(dAtA[i:], m.SlashedAmount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SlashedAmount)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.JailedUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.ObservationHash)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.WindowMissedBallots != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowMissedBallots))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ObservationChain)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.WindowBallots != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowBallots))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BallotType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventKeygenBlockUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventObserverUnjailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObserverUnjailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObserverUnjailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainParamsScheduled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainParamsScheduled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainParamsScheduled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChainParamsActivated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChainParamsActivated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChainParamsActivated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventBallotCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObservationChain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BallotType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventKeygenBlockUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	l = len(m.KeygenBlock)
@@ -727,16 +1213,465 @@ func (m *EventCrosschainFlagsUpdated) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	if m.PruningFlags != nil {
+		l = m.PruningFlags.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ChainFlags) > 0 {
+		for _, e := range m.ChainFlags {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.ForeignCoinFlags) > 0 {
+		for _, e := range m.ForeignCoinFlags {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventObserverJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.WindowBallots != 0 {
+		n += 1 + sovEvents(uint64(m.WindowBallots))
+	}
+	if m.WindowMissedBallots != 0 {
+		n += 1 + sovEvents(uint64(m.WindowMissedBallots))
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovEvents(uint64(m.JailedUntil))
+	}
+	l = len(m.SlashedAmount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventObserverUnjailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventChainParamsScheduled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventChainParamsActivated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovEvents(uint64(m.ChainId))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
+func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -759,10 +1694,10 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBallotCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBallotCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -799,7 +1734,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -827,11 +1762,11 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -859,11 +1794,11 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationHash = string(dAtA[iNdEx:postIndex])
+			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -891,13 +1826,13 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ObservationChain = string(dAtA[iNdEx:postIndex])
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotType", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
 			}
-			var stringLen uint64
+			m.ObserverLastBlockCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -907,24 +1842,11 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BallotType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -946,7 +1868,7 @@ func (m *EventBallotCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -969,10 +1891,10 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKeygenBlockUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1008,10 +1930,50 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsInboundEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1021,27 +1983,31 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeygenBlock = string(dAtA[iNdEx:postIndex])
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeygenPubkeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1069,7 +2035,147 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeygenPubkeys = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHeaderVerificationFlags == nil {
+				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
+			}
+			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PruningFlags == nil {
+				m.PruningFlags = &PruningFlags{}
+			}
+			if err := m.PruningFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainFlags = append(m.ChainFlags, ChainFlags{})
+			if err := m.ChainFlags[len(m.ChainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignCoinFlags", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignCoinFlags = append(m.ForeignCoinFlags, ForeignCoinFlags{})
+			if err := m.ForeignCoinFlags[len(m.ForeignCoinFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1092,7 +2198,7 @@ func (m *EventKeygenBlockUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
+func (m *EventObserverJailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1115,15 +2221,15 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewObserverAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventObserverJailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewObserverAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventObserverJailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1151,13 +2257,13 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBallots", wireType)
 			}
-			var stringLen uint64
+			m.WindowBallots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1167,27 +2273,52 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowBallots |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMissedBallots", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.WindowMissedBallots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowMissedBallots |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteeAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1215,11 +2346,61 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteeAddress = string(dAtA[iNdEx:postIndex])
+			m.SlashedAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObserverUnjailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventObserverUnjailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventObserverUnjailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1247,13 +2428,13 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLastBlockCount", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			m.ObserverLastBlockCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1263,11 +2444,24 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObserverLastBlockCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -1289,7 +2483,7 @@ func (m *EventNewObserverAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventChainParamsScheduled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1312,10 +2506,10 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventChainParamsScheduled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCrosschainFlagsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventChainParamsScheduled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1352,9 +2546,9 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1364,37 +2558,16 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsInboundEnabled = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsOutboundEnabled = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			var msglen int
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1404,33 +2577,66 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChainParamsActivated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChainParamsActivated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChainParamsActivated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1440,29 +2646,16 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			var msglen int
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1472,28 +2665,11 @@ func (m *EventCrosschainFlagsUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.BlockHeaderVerificationFlags == nil {
-				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
-			}
-			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
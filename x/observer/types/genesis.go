@@ -55,6 +55,42 @@ func (gs GenesisState) Validate() error {
 		chainNoncesIndexMap[elem.Index] = true
 	}
 
+	// Check for duplicated observer in observerChains
+	observerChainsIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.ObserverChains {
+		if _, ok := observerChainsIndexMap[elem.ObserverAddress]; ok {
+			return fmt.Errorf("duplicated observer for observerChains")
+		}
+		observerChainsIndexMap[elem.ObserverAddress] = true
+	}
+
+	// Check for duplicated observer in observerLiveness
+	observerLivenessIndexMap := make(map[string]bool)
+
+	for _, elem := range gs.ObserverLiveness {
+		if _, ok := observerLivenessIndexMap[elem.ObserverAddress]; ok {
+			return fmt.Errorf("duplicated observer for observerLiveness")
+		}
+		observerLivenessIndexMap[elem.ObserverAddress] = true
+	}
+
+	// Check for invalid or duplicated chain in pendingChainParams
+	pendingChainParamsIndexMap := make(map[int64]bool)
+
+	for _, elem := range gs.PendingChainParams {
+		if err := ValidateChainParams(elem.ChainParams); err != nil {
+			return err
+		}
+		if elem.ActivationHeight <= 0 {
+			return fmt.Errorf("invalid activation height for pendingChainParams")
+		}
+		if _, ok := pendingChainParamsIndexMap[elem.ChainParams.ChainId]; ok {
+			return fmt.Errorf("duplicated chain for pendingChainParams")
+		}
+		pendingChainParamsIndexMap[elem.ChainParams.ChainId] = true
+	}
+
 	return gs.Observers.Validate()
 }
 
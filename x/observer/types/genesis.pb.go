@@ -25,21 +25,24 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Ballots           []*Ballot             `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
-	Observers         ObserverSet           `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers"`
-	NodeAccountList   []*NodeAccount        `protobuf:"bytes,3,rep,name=nodeAccountList,proto3" json:"nodeAccountList,omitempty"`
-	CrosschainFlags   *CrosschainFlags      `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
-	Params            *Params               `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
-	Keygen            *Keygen               `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
-	LastObserverCount *LastObserverCount    `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
-	ChainParamsList   ChainParamsList       `protobuf:"bytes,8,opt,name=chain_params_list,json=chainParamsList,proto3" json:"chain_params_list"`
-	Tss               *TSS                  `protobuf:"bytes,9,opt,name=tss,proto3" json:"tss,omitempty"`
-	TssHistory        []TSS                 `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
-	TssFundMigrators  []TssFundMigratorInfo `protobuf:"bytes,11,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
-	BlameList         []Blame               `protobuf:"bytes,12,rep,name=blame_list,json=blameList,proto3" json:"blame_list"`
-	PendingNonces     []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
-	ChainNonces       []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
-	NonceToCctx       []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
+	Ballots            []*Ballot             `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots,omitempty"`
+	Observers          ObserverSet           `protobuf:"bytes,2,opt,name=observers,proto3" json:"observers"`
+	NodeAccountList    []*NodeAccount        `protobuf:"bytes,3,rep,name=nodeAccountList,proto3" json:"nodeAccountList,omitempty"`
+	CrosschainFlags    *CrosschainFlags      `protobuf:"bytes,4,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags,omitempty"`
+	Params             *Params               `protobuf:"bytes,5,opt,name=params,proto3" json:"params,omitempty"`
+	Keygen             *Keygen               `protobuf:"bytes,6,opt,name=keygen,proto3" json:"keygen,omitempty"`
+	LastObserverCount  *LastObserverCount    `protobuf:"bytes,7,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
+	ChainParamsList    ChainParamsList       `protobuf:"bytes,8,opt,name=chain_params_list,json=chainParamsList,proto3" json:"chain_params_list"`
+	Tss                *TSS                  `protobuf:"bytes,9,opt,name=tss,proto3" json:"tss,omitempty"`
+	TssHistory         []TSS                 `protobuf:"bytes,10,rep,name=tss_history,json=tssHistory,proto3" json:"tss_history"`
+	TssFundMigrators   []TssFundMigratorInfo `protobuf:"bytes,11,rep,name=tss_fund_migrators,json=tssFundMigrators,proto3" json:"tss_fund_migrators"`
+	BlameList          []Blame               `protobuf:"bytes,12,rep,name=blame_list,json=blameList,proto3" json:"blame_list"`
+	PendingNonces      []PendingNonces       `protobuf:"bytes,13,rep,name=pending_nonces,json=pendingNonces,proto3" json:"pending_nonces"`
+	ChainNonces        []ChainNonces         `protobuf:"bytes,14,rep,name=chain_nonces,json=chainNonces,proto3" json:"chain_nonces"`
+	NonceToCctx        []NonceToCctx         `protobuf:"bytes,15,rep,name=nonce_to_cctx,json=nonceToCctx,proto3" json:"nonce_to_cctx"`
+	ObserverChains     []ObserverChains      `protobuf:"bytes,16,rep,name=observer_chains,json=observerChains,proto3" json:"observer_chains"`
+	ObserverLiveness   []ObserverLiveness    `protobuf:"bytes,17,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
+	PendingChainParams []PendingChainParams  `protobuf:"bytes,18,rep,name=pending_chain_params,json=pendingChainParams,proto3" json:"pending_chain_params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -180,6 +183,27 @@ func (m *GenesisState) GetNonceToCctx() []NonceToCctx {
 	return nil
 }
 
+func (m *GenesisState) GetObserverChains() []ObserverChains {
+	if m != nil {
+		return m.ObserverChains
+	}
+	return nil
+}
+
+func (m *GenesisState) GetObserverLiveness() []ObserverLiveness {
+	if m != nil {
+		return m.ObserverLiveness
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingChainParams() []PendingChainParams {
+	if m != nil {
+		return m.PendingChainParams
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "zetachain.zetacore.observer.GenesisState")
 }
@@ -187,47 +211,52 @@ func init() {
 func init() { proto.RegisterFile("observer/genesis.proto", fileDescriptor_15ea8c9d44da7399) }
 
 var fileDescriptor_15ea8c9d44da7399 = []byte{
-	// 637 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdf, 0x4e, 0x13, 0x4f,
-	0x14, 0x6e, 0x7f, 0xf0, 0x03, 0x99, 0x02, 0x85, 0x11, 0x75, 0x02, 0xba, 0x20, 0xde, 0x10, 0xa3,
-	0xbb, 0x06, 0x2f, 0x8d, 0x17, 0x42, 0x02, 0x12, 0x11, 0x75, 0x4b, 0x62, 0xe2, 0x05, 0x9b, 0xe9,
-	0x74, 0x58, 0x36, 0x6e, 0x67, 0x9a, 0x9d, 0xa9, 0xa1, 0x3e, 0x85, 0x4f, 0xe2, 0x73, 0x70, 0xc9,
-	0xa5, 0x57, 0xc6, 0xb4, 0x2f, 0x62, 0xe6, 0xdf, 0x6e, 0xb7, 0x4d, 0xd6, 0xde, 0x4d, 0xbe, 0x73,
-	0xbe, 0xef, 0x9c, 0x39, 0xff, 0xc0, 0x7d, 0xde, 0x16, 0x34, 0xfb, 0x46, 0xb3, 0x20, 0xa6, 0x8c,
-	0x8a, 0x44, 0xf8, 0xbd, 0x8c, 0x4b, 0x0e, 0xb7, 0xbe, 0x53, 0x89, 0xc9, 0x15, 0x4e, 0x98, 0xaf,
-	0x5f, 0x3c, 0xa3, 0xbe, 0x73, 0xdd, 0xdc, 0x88, 0x79, 0xcc, 0xb5, 0x5f, 0xa0, 0x5e, 0x86, 0xb2,
-	0x79, 0x2f, 0x97, 0x6a, 0xe3, 0x34, 0xe5, 0xd2, 0xc2, 0x1b, 0x05, 0x9c, 0xe2, 0x2e, 0xb5, 0xe8,
-	0x56, 0x8e, 0xea, 0x20, 0x11, 0xe3, 0x8c, 0x50, 0x1b, 0x7c, 0x73, 0xbb, 0x30, 0x66, 0x5c, 0x08,
-	0xe3, 0x71, 0x99, 0xe2, 0x58, 0x4c, 0x85, 0xfa, 0x4a, 0x07, 0x31, 0x65, 0x53, 0xa2, 0x8c, 0x77,
-	0x68, 0x84, 0x09, 0xe1, 0x7d, 0xe6, 0xf2, 0x78, 0x38, 0x66, 0x64, 0x84, 0x46, 0x92, 0x47, 0x84,
-	0xc8, 0x6b, 0x6b, 0x7d, 0x90, 0x5b, 0xdd, 0x63, 0x2a, 0x54, 0x0f, 0x67, 0xb8, 0xeb, 0x32, 0x78,
-	0x54, 0xc0, 0x94, 0x75, 0x12, 0x16, 0x97, 0x7f, 0x00, 0x73, 0xb3, 0x14, 0x0e, 0x7b, 0x3c, 0x8e,
-	0x45, 0x97, 0x7d, 0xd6, 0x11, 0x51, 0x37, 0x89, 0x33, 0x2c, 0xb9, 0x0d, 0xb6, 0xfb, 0x73, 0x09,
-	0x2c, 0x1f, 0x9b, 0x3e, 0xb4, 0x24, 0x96, 0x14, 0xbe, 0x06, 0x8b, 0xa6, 0x98, 0x02, 0xd5, 0x77,
-	0xe6, 0xf6, 0x1a, 0xfb, 0x4f, 0xfc, 0x8a, 0xc6, 0xf8, 0x07, 0xda, 0x37, 0x74, 0x1c, 0x78, 0x0a,
-	0x96, 0x9c, 0x4d, 0xa0, 0xff, 0x76, 0xea, 0x7b, 0x8d, 0xfd, 0xbd, 0x4a, 0x81, 0x0f, 0xf6, 0xd1,
-	0xa2, 0xf2, 0x60, 0xfe, 0xe6, 0xf7, 0x76, 0x2d, 0x2c, 0x04, 0x60, 0x08, 0x9a, 0xaa, 0xae, 0x6f,
-	0x4c, 0x59, 0x4f, 0x13, 0x21, 0xd1, 0x9c, 0x4e, 0xaa, 0x5a, 0xf3, 0xac, 0xe0, 0x84, 0x93, 0x02,
-	0xf0, 0x33, 0x58, 0x9b, 0xec, 0x31, 0x9a, 0xd7, 0x89, 0x3e, 0xab, 0x14, 0x3d, 0xcc, 0x49, 0x47,
-	0x8a, 0x13, 0x36, 0x49, 0x19, 0x80, 0xaf, 0xc0, 0x82, 0x69, 0x18, 0xfa, 0x5f, 0xcb, 0x55, 0x17,
-	0xee, 0xa3, 0x76, 0x0d, 0x2d, 0x45, 0x91, 0xcd, 0x60, 0xa1, 0x85, 0x19, 0xc8, 0xef, 0xb4, 0x6b,
-	0x68, 0x29, 0xf0, 0x02, 0xdc, 0x4d, 0xb1, 0x90, 0x91, 0xb3, 0x47, 0xfa, 0xb7, 0x68, 0x51, 0x2b,
-	0xf9, 0x95, 0x4a, 0xa7, 0x58, 0x48, 0xd7, 0x82, 0x43, 0x5d, 0xb0, 0xf5, 0x74, 0x12, 0x82, 0x17,
-	0x60, 0xdd, 0x54, 0xcb, 0x24, 0x1b, 0xa5, 0xaa, 0x11, 0x77, 0x66, 0xa9, 0x99, 0xc2, 0xcd, 0x4f,
-	0x55, 0xed, 0x6d, 0x83, 0x9b, 0xa4, 0x0c, 0xc3, 0x7d, 0x30, 0x27, 0x85, 0x40, 0x4b, 0x5a, 0x71,
-	0xa7, 0x52, 0xf1, 0xbc, 0xd5, 0x0a, 0x95, 0x33, 0x3c, 0x06, 0x0d, 0x35, 0xd4, 0x57, 0x89, 0x90,
-	0x3c, 0x1b, 0x20, 0xa0, 0xc7, 0xe2, 0x9f, 0x5c, 0x9b, 0x01, 0x90, 0x42, 0xbc, 0x35, 0x4c, 0xd8,
-	0x01, 0xd0, 0x6d, 0x47, 0xbe, 0x1c, 0x02, 0x35, 0xb4, 0xde, 0x8b, 0x6a, 0x3d, 0x21, 0x8e, 0xfa,
-	0xac, 0xf3, 0xde, 0x92, 0x4e, 0xd8, 0x25, 0xb7, 0xfa, 0x6b, 0xb2, 0x6c, 0x52, 0xe9, 0x02, 0x7d,
-	0x8c, 0x4c, 0xed, 0x96, 0xb5, 0xfa, 0x6e, 0xf5, 0x66, 0x29, 0x77, 0xb7, 0x12, 0x9a, 0x6b, 0xc7,
-	0x77, 0xb5, 0xbc, 0xff, 0x68, 0x45, 0x8b, 0x3d, 0xad, 0x9e, 0x36, 0x43, 0x39, 0xd3, 0x0c, 0x2b,
-	0xba, 0xd2, 0x1b, 0x07, 0xe1, 0x27, 0xb0, 0x3c, 0x7e, 0x18, 0xd1, 0xea, 0x0c, 0x8b, 0xa6, 0xfb,
-	0x5b, 0x12, 0x6d, 0x90, 0x02, 0x82, 0x21, 0x58, 0x29, 0x5d, 0x3e, 0xd4, 0x9c, 0x69, 0x79, 0x19,
-	0xa1, 0xe7, 0xfc, 0x90, 0xc8, 0x6b, 0xa7, 0xc9, 0xc6, 0xa0, 0x93, 0x9b, 0xa1, 0x57, 0xbf, 0x1d,
-	0x7a, 0xf5, 0x3f, 0x43, 0xaf, 0xfe, 0x63, 0xe4, 0xd5, 0x6e, 0x47, 0x5e, 0xed, 0xd7, 0xc8, 0xab,
-	0x7d, 0x09, 0xe2, 0x44, 0x5e, 0xf5, 0xdb, 0x3e, 0xe1, 0xdd, 0x40, 0xc9, 0x3e, 0xd7, 0x11, 0x02,
-	0x17, 0x21, 0xb8, 0x0e, 0x8a, 0x73, 0x38, 0xe8, 0x51, 0xd1, 0x5e, 0xd0, 0x27, 0xf0, 0xe5, 0xdf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x5e, 0x89, 0xea, 0x92, 0x06, 0x00, 0x00,
+	// 706 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x4f, 0x13, 0x4d,
+	0x14, 0x6e, 0xdf, 0xf2, 0x82, 0x4c, 0x81, 0xd2, 0x11, 0x75, 0x02, 0x5a, 0x2a, 0xde, 0x10, 0x95,
+	0x5d, 0x83, 0x97, 0xc6, 0x0b, 0x21, 0x01, 0x89, 0x15, 0x75, 0x4b, 0x62, 0xc2, 0x05, 0xeb, 0x76,
+	0x3a, 0x2c, 0x1b, 0xb7, 0x33, 0xcd, 0xce, 0x94, 0x80, 0xbf, 0xc2, 0x9f, 0xc5, 0x25, 0x37, 0x26,
+	0x5e, 0x19, 0x03, 0x7f, 0xc4, 0xcc, 0xd7, 0x6e, 0xb7, 0x4d, 0x96, 0xbd, 0x9b, 0x3c, 0xe7, 0x3c,
+	0xcf, 0x39, 0x7b, 0xbe, 0x16, 0x3c, 0x64, 0x3d, 0x4e, 0x92, 0x73, 0x92, 0xb8, 0x21, 0xa1, 0x84,
+	0x47, 0xdc, 0x19, 0x26, 0x4c, 0x30, 0xb8, 0xf6, 0x83, 0x88, 0x00, 0x9f, 0x05, 0x11, 0x75, 0xd4,
+	0x8b, 0x25, 0xc4, 0xb1, 0xae, 0xab, 0x2b, 0x21, 0x0b, 0x99, 0xf2, 0x73, 0xe5, 0x4b, 0x53, 0x56,
+	0x1f, 0xa4, 0x52, 0xbd, 0x20, 0x8e, 0x99, 0x30, 0xf0, 0x4a, 0x06, 0xc7, 0xc1, 0x80, 0x18, 0x74,
+	0x2d, 0x45, 0x55, 0x10, 0x9f, 0x32, 0x8a, 0x89, 0x09, 0xbe, 0xba, 0x9e, 0x19, 0x13, 0xc6, 0xb9,
+	0xf6, 0x38, 0x8d, 0x83, 0x90, 0x4f, 0x85, 0xfa, 0x4e, 0x2e, 0x43, 0x42, 0xa7, 0x44, 0x29, 0xeb,
+	0x13, 0x3f, 0xc0, 0x98, 0x8d, 0xa8, 0xcd, 0xe3, 0xf1, 0x98, 0x91, 0x62, 0xe2, 0x0b, 0xe6, 0x63,
+	0x2c, 0x2e, 0x8c, 0xf5, 0x51, 0x6a, 0xb5, 0x0f, 0x63, 0x68, 0x4f, 0x19, 0xfc, 0x38, 0x3a, 0x27,
+	0x94, 0xf0, 0xe9, 0x64, 0x86, 0x41, 0x12, 0x0c, 0x2c, 0xfc, 0x24, 0x83, 0x09, 0xed, 0x47, 0x34,
+	0xcc, 0x7f, 0x23, 0x4c, 0xcd, 0x22, 0x55, 0x7a, 0x3a, 0x8e, 0xf9, 0xa7, 0x23, 0xda, 0xe7, 0xfe,
+	0x20, 0x0a, 0x93, 0x40, 0x30, 0x93, 0xce, 0xc6, 0xaf, 0x3a, 0x58, 0xd8, 0xd7, 0x9d, 0xea, 0x8a,
+	0x40, 0x10, 0xf8, 0x16, 0xcc, 0xe9, 0x72, 0x73, 0x54, 0x6d, 0xd7, 0x36, 0xeb, 0xdb, 0xcf, 0x9c,
+	0x82, 0xd6, 0x39, 0x3b, 0xca, 0xd7, 0xb3, 0x1c, 0xd8, 0x01, 0xf3, 0xd6, 0xc6, 0xd1, 0x7f, 0xed,
+	0xea, 0x66, 0x7d, 0x7b, 0xb3, 0x50, 0xe0, 0x93, 0x79, 0x74, 0x89, 0xd8, 0x99, 0xb9, 0xfa, 0xb3,
+	0x5e, 0xf1, 0x32, 0x01, 0xe8, 0x81, 0x86, 0xac, 0xfc, 0x3b, 0x5d, 0xf8, 0x4e, 0xc4, 0x05, 0xaa,
+	0xb5, 0x6b, 0x77, 0x6a, 0x1e, 0x66, 0x1c, 0x6f, 0x52, 0x00, 0x7e, 0x05, 0xcb, 0x93, 0x53, 0x80,
+	0x66, 0x54, 0xa2, 0x2f, 0x0b, 0x45, 0x77, 0x53, 0xd2, 0x9e, 0xe4, 0x78, 0x0d, 0x9c, 0x07, 0xe0,
+	0x1b, 0x30, 0xab, 0x1b, 0x86, 0xfe, 0x6f, 0x57, 0xef, 0x2c, 0xdc, 0x67, 0xe5, 0xea, 0x19, 0x8a,
+	0x24, 0xeb, 0xd1, 0x43, 0xb3, 0x25, 0xc8, 0x1f, 0x94, 0xab, 0x67, 0x28, 0xf0, 0x04, 0xdc, 0x8f,
+	0x03, 0x2e, 0xfc, 0x74, 0xa2, 0xd4, 0xd7, 0xa2, 0x39, 0xa5, 0xe4, 0x14, 0x2a, 0x75, 0x02, 0x2e,
+	0x6c, 0x0b, 0x76, 0x55, 0xc1, 0x9a, 0xf1, 0x24, 0x04, 0x4f, 0x40, 0x53, 0x57, 0x4b, 0x27, 0xeb,
+	0xc7, 0xb2, 0x11, 0xf7, 0xca, 0xd4, 0x4c, 0xe2, 0xfa, 0x4b, 0x65, 0xed, 0x4d, 0x83, 0x1b, 0x38,
+	0x0f, 0xc3, 0x6d, 0x50, 0x13, 0x9c, 0xa3, 0x79, 0xa5, 0xd8, 0x2e, 0x54, 0x3c, 0xea, 0x76, 0x3d,
+	0xe9, 0x0c, 0xf7, 0x41, 0x5d, 0x0e, 0xf5, 0x59, 0xc4, 0x05, 0x4b, 0x2e, 0x11, 0x68, 0xd7, 0xca,
+	0x70, 0x4d, 0x06, 0x40, 0x70, 0xfe, 0x5e, 0x33, 0x61, 0x1f, 0x40, 0xbb, 0x1d, 0xe9, 0x72, 0x70,
+	0x54, 0x57, 0x7a, 0xaf, 0x8a, 0xf5, 0x38, 0xdf, 0x1b, 0xd1, 0xfe, 0x47, 0x43, 0x3a, 0xa0, 0xa7,
+	0xcc, 0xe8, 0x2f, 0x8b, 0xbc, 0x49, 0xa6, 0x0b, 0xd4, 0xb9, 0xd2, 0xb5, 0x5b, 0x50, 0xea, 0x1b,
+	0xc5, 0x9b, 0x25, 0xdd, 0xed, 0x4a, 0x28, 0xae, 0x19, 0xdf, 0xa5, 0xfc, 0xfe, 0xa3, 0x45, 0x25,
+	0xf6, 0xbc, 0x78, 0xda, 0x34, 0xe5, 0x50, 0x31, 0x8c, 0xe8, 0xe2, 0x70, 0x1c, 0x84, 0x5f, 0xc0,
+	0xc2, 0xf8, 0xe9, 0x44, 0x4b, 0x25, 0x16, 0x4d, 0xf5, 0x37, 0x27, 0x5a, 0xc7, 0x19, 0x04, 0x3d,
+	0xb0, 0x98, 0xbb, 0x8d, 0xa8, 0x51, 0x6a, 0x79, 0x29, 0x26, 0x47, 0x6c, 0x17, 0x8b, 0x0b, 0xab,
+	0x49, 0x33, 0x08, 0x1e, 0x83, 0x46, 0x36, 0xe6, 0x52, 0x82, 0xa3, 0x65, 0xa5, 0xfa, 0xa2, 0xd4,
+	0x99, 0x51, 0x19, 0xdb, 0x64, 0x97, 0x58, 0x0e, 0x85, 0xdf, 0x40, 0x73, 0xea, 0x28, 0xa3, 0xa6,
+	0x52, 0xdf, 0x2a, 0xa5, 0xde, 0x31, 0x24, 0x3b, 0x06, 0x6c, 0x02, 0x87, 0x21, 0x58, 0xb1, 0xdd,
+	0x1b, 0xdf, 0x28, 0x04, 0x55, 0x10, 0xb7, 0x4c, 0x0f, 0xc7, 0x76, 0xca, 0x84, 0x81, 0xc3, 0x69,
+	0xcb, 0xc1, 0xd5, 0x4d, 0xab, 0x7a, 0x7d, 0xd3, 0xaa, 0xfe, 0xbd, 0x69, 0x55, 0x7f, 0xde, 0xb6,
+	0x2a, 0xd7, 0xb7, 0xad, 0xca, 0xef, 0xdb, 0x56, 0xe5, 0xd8, 0x0d, 0x23, 0x71, 0x36, 0xea, 0x39,
+	0x98, 0x0d, 0x5c, 0x19, 0x64, 0x4b, 0xc5, 0x73, 0x6d, 0x3c, 0xf7, 0xc2, 0xcd, 0xfe, 0x1a, 0x97,
+	0x43, 0xc2, 0x7b, 0xb3, 0xea, 0x4f, 0xf1, 0xfa, 0xdf, 0x00, 0x9d, 0xc0, 0x85, 0xc9, 0xdb, 0x07,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +279,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingChainParams) > 0 {
+		for iNdEx := len(m.PendingChainParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChainParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ObserverChains) > 0 {
+		for iNdEx := len(m.ObserverChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.NonceToCctx) > 0 {
 		for iNdEx := len(m.NonceToCctx) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -534,6 +611,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ObserverChains) > 0 {
+		for _, e := range m.ObserverChains {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingChainParams) > 0 {
+		for _, e := range m.PendingChainParams {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1090,6 +1185,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverChains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverChains = append(m.ObserverChains, ObserverChains{})
+			if err := m.ObserverChains[len(m.ObserverChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLiveness{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChainParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChainParams = append(m.PendingChainParams, PendingChainParams{})
+			if err := m.PendingChainParams[len(m.PendingChainParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -12,6 +12,17 @@ func TestGenesisState_Validate(t *testing.T) {
 	chainParams := types.GetDefaultChainParams().ChainParams
 	invalidChainParamsGen.ChainParamsList.ChainParams = append(chainParams, chainParams[0])
 
+	duplicatedPendingChainParamsGen := types.DefaultGenesis()
+	duplicatedPendingChainParamsGen.PendingChainParams = []types.PendingChainParams{
+		{ChainParams: chainParams[0], ActivationHeight: 100},
+		{ChainParams: chainParams[0], ActivationHeight: 200},
+	}
+
+	invalidActivationHeightGen := types.DefaultGenesis()
+	invalidActivationHeightGen.PendingChainParams = []types.PendingChainParams{
+		{ChainParams: chainParams[0]},
+	}
+
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -32,6 +43,16 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: invalidChainParamsGen,
 			valid:    false,
 		},
+		{
+			desc:     "duplicated pending chain params",
+			genState: duplicatedPendingChainParamsGen,
+			valid:    false,
+		},
+		{
+			desc:     "invalid pending chain params activation height",
+			genState: invalidActivationHeightGen,
+			valid:    false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/binary"
+	"errors"
 	"fmt"
 
 	sdkmath "cosmossdk.io/math"
@@ -60,6 +62,13 @@ const (
 	AdminPolicyParamsKey          = "AdminParams"
 	BallotMaturityBlocksParamsKey = "BallotMaturityBlocksParams"
 
+	MissedBallotsWindowParamsKey   = "MissedBallotsWindowParams"
+	MaxMissedBallotsRatioParamsKey = "MaxMissedBallotsRatioParams"
+	ObserverJailDurationParamsKey  = "ObserverJailDurationParams"
+	ObserverSlashFractionParamsKey = "ObserverSlashFractionParams"
+
+	BlameRetentionBlocksParamsKey = "BlameRetentionBlocksParams"
+
 	// CrosschainFlagsKey is the key for the crosschain flags
 	// NOTE: PermissionFlags is old name for CrosschainFlags we keep it as key value for backward compatibility
 	CrosschainFlagsKey = "PermissionFlags-value-"
@@ -78,6 +87,33 @@ const (
 	PendingNoncesKeyPrefix = "PendingNonces-value-"
 	ChainNoncesKey         = "ChainNonces-value-"
 	NonceToCctxKeyPrefix   = "NonceToCctx-value-"
+
+	// ObserverPerformanceKeyPrefix is the key prefix for the participation counters of the observers
+	ObserverPerformanceKeyPrefix = "ObserverPerformance-value-"
+
+	// ObserverChainsKeyPrefix is the key prefix for the chains assigned to the observers
+	ObserverChainsKeyPrefix = "ObserverChains-value-"
+
+	// ObserverLivenessKeyPrefix is the key prefix for the liveness state of the observers
+	ObserverLivenessKeyPrefix = "ObserverLiveness-value-"
+
+	// PendingChainParamsKeyPrefix is the key prefix for the chain params scheduled to be applied at an activation height
+	PendingChainParamsKeyPrefix = "PendingChainParams-value-"
+
+	// BlockHeaderWorkKeyPrefix is the key prefix for the accumulated proof-of-work of the bitcoin block headers
+	BlockHeaderWorkKeyPrefix = "BlockHeaderWork-value-"
+
+	// BestChainBlockHashKeyPrefix is the key prefix for the block hashes of the best bitcoin header chain indexed by height
+	BestChainBlockHashKeyPrefix = "BestChainBlockHash-value-"
+
+	// EthereumLightClientKeyPrefix is the key prefix for the beacon chain light clients of the Ethereum chains
+	EthereumLightClientKeyPrefix = "EthereumLightClient-value-"
+
+	// FinalizedBlockHashKeyPrefix is the key prefix for the execution block hashes finalized by the light clients indexed by height
+	FinalizedBlockHashKeyPrefix = "FinalizedBlockHash-value-"
+
+	// BlamePruneQueueKeyPrefix is the key prefix for the blame records ordered by the zeta height they were finalized at
+	BlamePruneQueueKeyPrefix = "BlamePruneQueue-value-"
 )
 
 func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) string {
@@ -87,3 +123,63 @@ func GetBlameIndex(chainID int64, nonce uint64, digest string, height uint64) st
 func GetBlamePrefix(chainID int64, nonce int64) string {
 	return fmt.Sprintf("%d-%d", chainID, nonce)
 }
+
+// BlamePruneQueueKey returns the store key of a blame record in the prune queue
+// the height is big endian encoded so entries are ordered by the zeta height at which the blame was finalized
+func BlamePruneQueueKey(zetaHeight uint64, blameIndex string) []byte {
+	key := BlamePruneQueueHeightKey(zetaHeight)
+	key = append(key, []byte("/")...)
+	key = append(key, []byte(blameIndex)...)
+
+	return key
+}
+
+// BlamePruneQueueHeightKey returns the store key prefix of the blame records in the prune queue for a zeta height
+func BlamePruneQueueHeightKey(zetaHeight uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, zetaHeight)
+
+	return key
+}
+
+// ParseBlamePruneQueueKey returns the zeta height and the blame index from a prune queue entry key
+func ParseBlamePruneQueueKey(key []byte) (uint64, string, error) {
+	if len(key) < 9 || key[8] != '/' {
+		return 0, "", errors.New("invalid blame prune queue key")
+	}
+	return binary.BigEndian.Uint64(key[:8]), string(key[9:]), nil
+}
+
+// ObserverPerformanceKey returns the key of the participation counters of an observer for a chain and an observation type
+func ObserverPerformanceKey(observerAddress string, chainID int64, observationType ObservationType) []byte {
+	return []byte(fmt.Sprintf("%s/%d/%d", observerAddress, chainID, observationType))
+}
+
+// ObserverPerformancePrefix returns the key prefix of the participation counters of an observer
+// the counters of all chains are returned if the chain ID is 0
+func ObserverPerformancePrefix(observerAddress string, chainID int64) []byte {
+	if chainID == 0 {
+		return []byte(fmt.Sprintf("%s/", observerAddress))
+	}
+	return []byte(fmt.Sprintf("%s/%d/", observerAddress, chainID))
+}
+
+// PendingChainParamsKey returns the key of the chain params scheduled for a chain
+func PendingChainParamsKey(chainID int64) []byte {
+	return []byte(fmt.Sprintf("%d", chainID))
+}
+
+// BestChainBlockHashKey returns the key of the block hash of the best header chain of a chain at a height
+func BestChainBlockHashKey(chainID int64, height int64) []byte {
+	return []byte(fmt.Sprintf("%d/%d", chainID, height))
+}
+
+// EthereumLightClientKey returns the key of the beacon chain light client of a chain
+func EthereumLightClientKey(chainID int64) []byte {
+	return []byte(fmt.Sprintf("%d", chainID))
+}
+
+// FinalizedBlockHashKey returns the key of the execution block hash finalized by the light client of a chain at a height
+func FinalizedBlockHashKey(chainID int64, height int64) []byte {
+	return []byte(fmt.Sprintf("%d/%d", chainID, height))
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+)
+
+const TypeMsgBootstrapEthereumLightClient = "bootstrap_ethereum_light_client"
+
+var _ sdk.Msg = &MsgBootstrapEthereumLightClient{}
+
+func NewMsgBootstrapEthereumLightClient(
+	creator string,
+	chainID int64,
+	trustedBlockRoot []byte,
+	bootstrap ethereum.LightClientBootstrap,
+) *MsgBootstrapEthereumLightClient {
+	return &MsgBootstrapEthereumLightClient{
+		Creator:          creator,
+		ChainId:          chainID,
+		TrustedBlockRoot: trustedBlockRoot,
+		Bootstrap:        bootstrap,
+	}
+}
+
+func (msg *MsgBootstrapEthereumLightClient) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBootstrapEthereumLightClient) Type() string {
+	return TypeMsgBootstrapEthereumLightClient
+}
+
+func (msg *MsgBootstrapEthereumLightClient) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBootstrapEthereumLightClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBootstrapEthereumLightClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, found := ethereum.GetNetworkConfig(msg.ChainId); !found {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "light client not supported for chain id (%d)", msg.ChainId)
+	}
+	if len(msg.TrustedBlockRoot) != 32 {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid trusted block root length (%d)", len(msg.TrustedBlockRoot))
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 
 	authoritytypes "github.com/zeta-chain/zetacore/x/authority/types"
 
@@ -57,6 +59,34 @@ func (msg *MsgUpdateCrosschainFlags) ValidateBasic() error {
 		}
 	}
 
+	if msg.PruningFlags != nil {
+		if err := msg.PruningFlags.Validate(); err != nil {
+			return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	chainIDs := make(map[int64]bool)
+	for _, flags := range msg.ChainFlags {
+		if chainIDs[flags.ChainId] {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated chain flags for chain %d", flags.ChainId)
+		}
+		chainIDs[flags.ChainId] = true
+	}
+
+	foreignCoins := make(map[string]bool)
+	for _, flags := range msg.ForeignCoinFlags {
+		key := fmt.Sprintf("%d-%s", flags.ChainId, strings.ToLower(flags.Asset))
+		if foreignCoins[key] {
+			return cosmoserrors.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"duplicated foreign coin flags for chain %d asset %s",
+				flags.ChainId,
+				flags.Asset,
+			)
+		}
+		foreignCoins[key] = true
+	}
+
 	return nil
 }
 
@@ -70,22 +100,59 @@ func (gpf GasPriceIncreaseFlags) Validate() error {
 	return nil
 }
 
+func (pf PruningFlags) Validate() error {
+	if pf.RetentionBlocks < 0 {
+		return errors.New("retention blocks must not be negative")
+	}
+	if pf.RetentionBlocks > 0 && pf.MaxPrunedPerBlock == 0 {
+		return errors.New("max pruned per block must be positive if pruning is enabled")
+	}
+	return nil
+}
+
 // GetRequiredPolicyType returns the required policy type for the message to execute the message
+// the current crosschain flags are used to determine if the message enables functionalities currently disabled
 // Group emergency should only be able to stop or disable functionalities in case of emergency
-// this concerns disabling inbound and outbound txs or block header verification
-// every other action requires group admin
+// this concerns disabling inbound and outbound txs, globally or for a chain or foreign coin, or block header verification
+// every other action, including re-enabling a disabled functionality, requires group admin
 // TODO: add separate message for each group
 // https://github.com/zeta-chain/node/issues/1562
-func (msg *MsgUpdateCrosschainFlags) GetRequiredPolicyType() authoritytypes.PolicyType {
-	if msg.IsInboundEnabled || msg.IsOutboundEnabled {
+func (msg *MsgUpdateCrosschainFlags) GetRequiredPolicyType(current CrosschainFlags) authoritytypes.PolicyType {
+	if (msg.IsInboundEnabled && !current.IsInboundEnabled) || (msg.IsOutboundEnabled && !current.IsOutboundEnabled) {
 		return authoritytypes.PolicyType_groupAdmin
 	}
 	if msg.GasPriceIncreaseFlags != nil {
 		return authoritytypes.PolicyType_groupAdmin
 	}
-	if msg.BlockHeaderVerificationFlags != nil && (msg.BlockHeaderVerificationFlags.IsEthTypeChainEnabled || msg.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled) {
+	if msg.PruningFlags != nil {
 		return authoritytypes.PolicyType_groupAdmin
-
+	}
+	if msg.BlockHeaderVerificationFlags != nil {
+		currentVerificationFlags := current.GetBlockHeaderVerificationFlags()
+		if (msg.BlockHeaderVerificationFlags.IsEthTypeChainEnabled && !currentVerificationFlags.GetIsEthTypeChainEnabled()) ||
+			(msg.BlockHeaderVerificationFlags.IsBtcTypeChainEnabled && !currentVerificationFlags.GetIsBtcTypeChainEnabled()) ||
+			(msg.BlockHeaderVerificationFlags.IsBscTypeChainEnabled && !currentVerificationFlags.GetIsBscTypeChainEnabled()) ||
+			(msg.BlockHeaderVerificationFlags.IsPolygonTypeChainEnabled && !currentVerificationFlags.GetIsPolygonTypeChainEnabled()) {
+			return authoritytypes.PolicyType_groupAdmin
+		}
+	}
+	for _, flags := range msg.ChainFlags {
+		currentFlags, found := current.FindChainFlags(flags.ChainId)
+		if !found {
+			continue
+		}
+		if (flags.IsInboundEnabled && !currentFlags.IsInboundEnabled) || (flags.IsOutboundEnabled && !currentFlags.IsOutboundEnabled) {
+			return authoritytypes.PolicyType_groupAdmin
+		}
+	}
+	for _, flags := range msg.ForeignCoinFlags {
+		currentFlags, found := current.FindForeignCoinFlags(flags.ChainId, flags.Asset)
+		if !found {
+			continue
+		}
+		if (flags.IsInboundEnabled && !currentFlags.IsInboundEnabled) || (flags.IsOutboundEnabled && !currentFlags.IsOutboundEnabled) {
+			return authoritytypes.PolicyType_groupAdmin
+		}
 	}
 	return authoritytypes.PolicyType_groupEmergency
 }
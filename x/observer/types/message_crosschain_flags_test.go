@@ -35,6 +35,38 @@ func TestMsgUpdateCrosschainFlags_ValidateBasic(t *testing.T) {
 			},
 			err: sdkerrors.ErrInvalidRequest,
 		},
+		{
+			name: "invalid pruning flags",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator: sample.AccAddress(),
+				PruningFlags: &types.PruningFlags{
+					RetentionBlocks: -1,
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "duplicated chain flags",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator: sample.AccAddress(),
+				ChainFlags: []types.ChainFlags{
+					{ChainId: 1},
+					{ChainId: 1, IsInboundEnabled: true},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "duplicated foreign coin flags",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator: sample.AccAddress(),
+				ForeignCoinFlags: []types.ForeignCoinFlags{
+					{ChainId: 1, Asset: "0xabcd"},
+					{ChainId: 1, Asset: "0xABCD", IsInboundEnabled: true},
+				},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
 		{
 			name: "valid address",
 			msg: types.MsgUpdateCrosschainFlags{
@@ -118,11 +150,61 @@ func TestGasPriceIncreaseFlags_Validate(t *testing.T) {
 	}
 }
 
+func TestPruningFlags_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		pf          types.PruningFlags
+		errContains string
+	}{
+		{
+			name: "invalid retention blocks",
+			pf: types.PruningFlags{
+				RetentionBlocks:   -1,
+				MaxPrunedPerBlock: 1,
+			},
+			errContains: "retention blocks must not be negative",
+		},
+		{
+			name: "invalid max pruned per block",
+			pf: types.PruningFlags{
+				RetentionBlocks:   1,
+				MaxPrunedPerBlock: 0,
+			},
+			errContains: "max pruned per block must be positive",
+		},
+		{
+			name: "valid",
+			pf: types.PruningFlags{
+				RetentionBlocks:   1,
+				MaxPrunedPerBlock: 1,
+			},
+		},
+		{
+			name: "max pruned per block can be 0 if pruning is disabled",
+			pf: types.PruningFlags{
+				RetentionBlocks:   0,
+				MaxPrunedPerBlock: 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.pf.Validate()
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestMsgUpdateCrosschainFlags_GetRequiredPolicyType(t *testing.T) {
 	tests := []struct {
-		name string
-		msg  types.MsgUpdateCrosschainFlags
-		want authoritytypes.PolicyType
+		name    string
+		msg     types.MsgUpdateCrosschainFlags
+		current types.CrosschainFlags
+		want    authoritytypes.PolicyType
 	}{
 		{
 			name: "disabling outbound and inbound allows group 1",
@@ -168,6 +250,19 @@ func TestMsgUpdateCrosschainFlags_GetRequiredPolicyType(t *testing.T) {
 			},
 			want: authoritytypes.PolicyType_groupAdmin,
 		},
+		{
+			name: "updating pruning flags asserts group 2",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  false,
+				IsOutboundEnabled: false,
+				PruningFlags: &types.PruningFlags{
+					RetentionBlocks:   0,
+					MaxPrunedPerBlock: 0,
+				},
+			},
+			want: authoritytypes.PolicyType_groupAdmin,
+		},
 		{
 			name: "enabling inbound asserts group 2",
 			msg: types.MsgUpdateCrosschainFlags{
@@ -210,6 +305,117 @@ func TestMsgUpdateCrosschainFlags_GetRequiredPolicyType(t *testing.T) {
 			},
 			want: authoritytypes.PolicyType_groupAdmin,
 		},
+		{
+			name: "enabling bsc header verification asserts group 2",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  false,
+				IsOutboundEnabled: false,
+				BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+					IsBscTypeChainEnabled: true,
+				},
+			},
+			want: authoritytypes.PolicyType_groupAdmin,
+		},
+		{
+			name: "enabling polygon header verification asserts group 2",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  false,
+				IsOutboundEnabled: false,
+				BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+					IsPolygonTypeChainEnabled: true,
+				},
+			},
+			want: authoritytypes.PolicyType_groupAdmin,
+		},
+		{
+			name: "keeping polygon header verification enabled allows group 1",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  false,
+				IsOutboundEnabled: false,
+				BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+					IsPolygonTypeChainEnabled: true,
+				},
+			},
+			current: types.CrosschainFlags{
+				BlockHeaderVerificationFlags: &types.BlockHeaderVerificationFlags{
+					IsPolygonTypeChainEnabled: true,
+				},
+			},
+			want: authoritytypes.PolicyType_groupEmergency,
+		},
+		{
+			name: "keeping inbound and outbound enabled allows group 1",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+			},
+			current: types.CrosschainFlags{
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+			},
+			want: authoritytypes.PolicyType_groupEmergency,
+		},
+		{
+			name: "disabling a chain and a foreign coin allows group 1",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+				ChainFlags: []types.ChainFlags{
+					{ChainId: 1, IsInboundEnabled: false, IsOutboundEnabled: true},
+				},
+				ForeignCoinFlags: []types.ForeignCoinFlags{
+					{ChainId: 1, Asset: "0x1234", IsInboundEnabled: true, IsOutboundEnabled: false},
+				},
+			},
+			current: types.CrosschainFlags{
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+			},
+			want: authoritytypes.PolicyType_groupEmergency,
+		},
+		{
+			name: "re-enabling a chain asserts group 2",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+				ChainFlags: []types.ChainFlags{
+					{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: true},
+				},
+			},
+			current: types.CrosschainFlags{
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+				ChainFlags: []types.ChainFlags{
+					{ChainId: 1, IsInboundEnabled: true, IsOutboundEnabled: false},
+				},
+			},
+			want: authoritytypes.PolicyType_groupAdmin,
+		},
+		{
+			name: "re-enabling a foreign coin asserts group 2",
+			msg: types.MsgUpdateCrosschainFlags{
+				Creator:           sample.AccAddress(),
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+				ForeignCoinFlags: []types.ForeignCoinFlags{
+					{ChainId: 1, Asset: "0xABCD", IsInboundEnabled: true, IsOutboundEnabled: true},
+				},
+			},
+			current: types.CrosschainFlags{
+				IsInboundEnabled:  true,
+				IsOutboundEnabled: true,
+				ForeignCoinFlags: []types.ForeignCoinFlags{
+					{ChainId: 1, Asset: "0xabcd", IsInboundEnabled: false, IsOutboundEnabled: true},
+				},
+			},
+			want: authoritytypes.PolicyType_groupAdmin,
+		},
 		{
 			name: "enabling btc header verification asserts group 2",
 			msg: types.MsgUpdateCrosschainFlags{
@@ -228,7 +434,7 @@ func TestMsgUpdateCrosschainFlags_GetRequiredPolicyType(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			require.EqualValues(t, tt.want, tt.msg.GetRequiredPolicyType())
+			require.EqualValues(t, tt.want, tt.msg.GetRequiredPolicyType(tt.current))
 		})
 	}
 }
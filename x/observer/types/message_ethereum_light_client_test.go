@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgBootstrapEthereumLightClient_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgBootstrapEthereumLightClient
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.NewMsgBootstrapEthereumLightClient(
+				sample.AccAddress(),
+				common.SepoliaChain().ChainId,
+				sample.Hash().Bytes(),
+				ethereum.LightClientBootstrap{},
+			),
+		},
+		{
+			name: "invalid address",
+			msg: types.NewMsgBootstrapEthereumLightClient(
+				"invalid_address",
+				common.SepoliaChain().ChainId,
+				sample.Hash().Bytes(),
+				ethereum.LightClientBootstrap{},
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "chain not supported",
+			msg: types.NewMsgBootstrapEthereumLightClient(
+				sample.AccAddress(),
+				common.BscMainnetChain().ChainId,
+				sample.Hash().Bytes(),
+				ethereum.LightClientBootstrap{},
+			),
+			err: sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "invalid trusted block root",
+			msg: types.NewMsgBootstrapEthereumLightClient(
+				sample.AccAddress(),
+				common.SepoliaChain().ChainId,
+				[]byte{1, 2, 3},
+				ethereum.LightClientBootstrap{},
+			),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateEthereumLightClient_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateEthereumLightClient
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.NewMsgUpdateEthereumLightClient(
+				sample.AccAddress(),
+				common.EthChain().ChainId,
+				ethereum.LightClientUpdate{SignatureSlot: 42},
+			),
+		},
+		{
+			name: "invalid address",
+			msg: types.NewMsgUpdateEthereumLightClient(
+				"invalid_address",
+				common.EthChain().ChainId,
+				ethereum.LightClientUpdate{SignatureSlot: 42},
+			),
+			err: sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "chain not supported",
+			msg: types.NewMsgUpdateEthereumLightClient(
+				sample.AccAddress(),
+				common.GoerliChain().ChainId,
+				ethereum.LightClientUpdate{SignatureSlot: 42},
+			),
+			err: sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "signature slot not set",
+			msg: types.NewMsgUpdateEthereumLightClient(
+				sample.AccAddress(),
+				common.EthChain().ChainId,
+				ethereum.LightClientUpdate{},
+			),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
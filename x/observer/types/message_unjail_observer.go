@@ -0,0 +1,46 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgUnjailObserver = "unjail_observer"
+
+var _ sdk.Msg = &MsgUnjailObserver{}
+
+func NewMsgUnjailObserver(creator string) *MsgUnjailObserver {
+	return &MsgUnjailObserver{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgUnjailObserver) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnjailObserver) Type() string {
+	return TypeMsgUnjailObserver
+}
+
+func (msg *MsgUnjailObserver) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnjailObserver) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUnjailObserver) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -11,10 +11,13 @@ const TypeMsgUpdateChainParams = "update_chain_params"
 
 var _ sdk.Msg = &MsgUpdateChainParams{}
 
-func NewMsgUpdateChainParams(creator string, chainParams *ChainParams) *MsgUpdateChainParams {
+// NewMsgUpdateChainParams creates a message updating the chain params of a chain
+// the chain params are applied immediately if the activation height is zero
+func NewMsgUpdateChainParams(creator string, chainParams *ChainParams, activationHeight int64) *MsgUpdateChainParams {
 	return &MsgUpdateChainParams{
-		Creator:     creator,
-		ChainParams: chainParams,
+		Creator:          creator,
+		ChainParams:      chainParams,
+		ActivationHeight: activationHeight,
 	}
 }
 
@@ -49,5 +52,9 @@ func (msg *MsgUpdateChainParams) ValidateBasic() error {
 		return cosmoserrors.Wrapf(ErrInvalidChainParams, err.Error())
 	}
 
+	if msg.ActivationHeight < 0 {
+		return cosmoserrors.Wrapf(ErrInvalidActivationHeight, "activation height cannot be negative (%d)", msg.ActivationHeight)
+	}
+
 	return nil
 }
@@ -39,6 +39,23 @@ func TestMsgUpdateChainParams_ValidateBasic(t *testing.T) {
 			},
 			err: types.ErrInvalidChainParams,
 		},
+		{
+			name: "valid message with activation height",
+			msg: types.MsgUpdateChainParams{
+				Creator:          sample.AccAddress(),
+				ChainParams:      sample.ChainParams(common.ExternalChainList()[0].ChainId),
+				ActivationHeight: 100,
+			},
+		},
+		{
+			name: "invalid activation height",
+			msg: types.MsgUpdateChainParams{
+				Creator:          sample.AccAddress(),
+				ChainParams:      sample.ChainParams(common.ExternalChainList()[0].ChainId),
+				ActivationHeight: -1,
+			},
+			err: types.ErrInvalidActivationHeight,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
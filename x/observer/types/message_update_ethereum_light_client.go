@@ -0,0 +1,59 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common/ethereum"
+)
+
+const TypeMsgUpdateEthereumLightClient = "update_ethereum_light_client"
+
+var _ sdk.Msg = &MsgUpdateEthereumLightClient{}
+
+func NewMsgUpdateEthereumLightClient(
+	creator string,
+	chainID int64,
+	update ethereum.LightClientUpdate,
+) *MsgUpdateEthereumLightClient {
+	return &MsgUpdateEthereumLightClient{
+		Creator: creator,
+		ChainId: chainID,
+		Update:  update,
+	}
+}
+
+func (msg *MsgUpdateEthereumLightClient) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateEthereumLightClient) Type() string {
+	return TypeMsgUpdateEthereumLightClient
+}
+
+func (msg *MsgUpdateEthereumLightClient) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateEthereumLightClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateEthereumLightClient) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, found := ethereum.GetNetworkConfig(msg.ChainId); !found {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "light client not supported for chain id (%d)", msg.ChainId)
+	}
+	if msg.Update.SignatureSlot == 0 {
+		return cosmoserrors.Wrap(sdkerrors.ErrInvalidRequest, "signature slot is not set")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	cosmoserrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/zeta-chain/zetacore/common"
+)
+
+const TypeMsgUpdateObserverChains = "update_observer_chains"
+
+var _ sdk.Msg = &MsgUpdateObserverChains{}
+
+func NewMsgUpdateObserverChains(creator string, chainIDs []int64) *MsgUpdateObserverChains {
+	return &MsgUpdateObserverChains{
+		Creator:  creator,
+		ChainIds: chainIDs,
+	}
+}
+
+func (msg *MsgUpdateObserverChains) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateObserverChains) Type() string {
+	return TypeMsgUpdateObserverChains
+}
+
+func (msg *MsgUpdateObserverChains) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateObserverChains) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateObserverChains) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return cosmoserrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	chainIDs := make(map[int64]bool, len(msg.ChainIds))
+	for _, chainID := range msg.ChainIds {
+		if common.GetChainFromChainID(chainID) == nil {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidChainID, "chain id (%d)", chainID)
+		}
+		if chainIDs[chainID] {
+			return cosmoserrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicated chain id (%d)", chainID)
+		}
+		chainIDs[chainID] = true
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestMsgUpdateObserverChains_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *types.MsgUpdateObserverChains
+		err  error
+	}{
+		{
+			name: "valid message",
+			msg: types.NewMsgUpdateObserverChains(sample.AccAddress(), []int64{
+				common.ExternalChainList()[0].ChainId,
+				common.ExternalChainList()[1].ChainId,
+			}),
+		},
+		{
+			name: "valid message with empty list",
+			msg:  types.NewMsgUpdateObserverChains(sample.AccAddress(), nil),
+		},
+		{
+			name: "invalid address",
+			msg:  types.NewMsgUpdateObserverChains("invalid_address", []int64{common.ExternalChainList()[0].ChainId}),
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid chain ID",
+			msg:  types.NewMsgUpdateObserverChains(sample.AccAddress(), []int64{999}),
+			err:  sdkerrors.ErrInvalidChainID,
+		},
+		{
+			name: "duplicated chain ID",
+			msg: types.NewMsgUpdateObserverChains(sample.AccAddress(), []int64{
+				common.ExternalChainList()[0].ChainId,
+				common.ExternalChainList()[0].ChainId,
+			}),
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
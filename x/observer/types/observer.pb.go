@@ -191,6 +191,60 @@ func (m *ObserverSet) GetObserverList() []string {
 	return nil
 }
 
+// ObserverChains contains the chains an observer is assigned to
+// an observer without assigned chains observes all the supported chains
+type ObserverChains struct {
+	ObserverAddress string  `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	ChainIds        []int64 `protobuf:"varint,2,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+}
+
+func (m *ObserverChains) Reset()         { *m = ObserverChains{} }
+func (m *ObserverChains) String() string { return proto.CompactTextString(m) }
+func (*ObserverChains) ProtoMessage()    {}
+func (*ObserverChains) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3004233a4a5969ce, []int{2}
+}
+func (m *ObserverChains) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverChains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverChains.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverChains) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverChains.Merge(m, src)
+}
+func (m *ObserverChains) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverChains) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverChains.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverChains proto.InternalMessageInfo
+
+func (m *ObserverChains) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverChains) GetChainIds() []int64 {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
 type LastObserverCount struct {
 	Count            uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	LastChangeHeight int64  `protobuf:"varint,2,opt,name=last_change_height,json=lastChangeHeight,proto3" json:"last_change_height,omitempty"`
@@ -200,7 +254,7 @@ func (m *LastObserverCount) Reset()         { *m = LastObserverCount{} }
 func (m *LastObserverCount) String() string { return proto.CompactTextString(m) }
 func (*LastObserverCount) ProtoMessage()    {}
 func (*LastObserverCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3004233a4a5969ce, []int{2}
+	return fileDescriptor_3004233a4a5969ce, []int{3}
 }
 func (m *LastObserverCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,40 +302,44 @@ func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.ObserverUpdateReason", ObserverUpdateReason_name, ObserverUpdateReason_value)
 	proto.RegisterType((*ObserverMapper)(nil), "zetachain.zetacore.observer.ObserverMapper")
 	proto.RegisterType((*ObserverSet)(nil), "zetachain.zetacore.observer.ObserverSet")
+	proto.RegisterType((*ObserverChains)(nil), "zetachain.zetacore.observer.ObserverChains")
 	proto.RegisterType((*LastObserverCount)(nil), "zetachain.zetacore.observer.LastObserverCount")
 }
 
 func init() { proto.RegisterFile("observer/observer.proto", fileDescriptor_3004233a4a5969ce) }
 
 var fileDescriptor_3004233a4a5969ce = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xc1, 0x8a, 0x13, 0x41,
-	0x10, 0x9d, 0x4e, 0xa2, 0x90, 0x8e, 0xc9, 0xce, 0xb6, 0x11, 0x43, 0x84, 0x21, 0xac, 0x97, 0xb0,
-	0x68, 0x1a, 0x56, 0x7f, 0xc0, 0x0d, 0xa2, 0x8b, 0x91, 0x85, 0x49, 0x16, 0xc1, 0x4b, 0xe8, 0x64,
-	0xca, 0x49, 0x43, 0xa6, 0x7b, 0x98, 0xae, 0x48, 0xe2, 0xcd, 0x3f, 0xf0, 0x23, 0x3c, 0xf8, 0x29,
-	0x1e, 0xf7, 0xe8, 0x51, 0x92, 0x1f, 0x91, 0xee, 0xde, 0xce, 0xc5, 0x3d, 0xf5, 0x7b, 0xaf, 0xfa,
-	0x55, 0x3d, 0xa8, 0xa2, 0x4f, 0xf5, 0xc2, 0x40, 0xf5, 0x15, 0x2a, 0x1e, 0xc0, 0xa8, 0xac, 0x34,
-	0x6a, 0xf6, 0xec, 0x1b, 0xa0, 0x58, 0xae, 0x84, 0x54, 0x23, 0x87, 0x74, 0x05, 0xa3, 0xf0, 0xa5,
-	0xff, 0x78, 0xa9, 0x8b, 0x42, 0x2b, 0xee, 0x1f, 0xef, 0xe8, 0x77, 0x73, 0x9d, 0x6b, 0x07, 0xb9,
-	0x45, 0x5e, 0x3d, 0xfb, 0x4e, 0x68, 0xe7, 0xfa, 0xce, 0xf7, 0x51, 0x94, 0x25, 0x54, 0xac, 0x4b,
-	0x1f, 0x48, 0x95, 0xc1, 0xb6, 0x47, 0x06, 0x64, 0xd8, 0x4c, 0x3d, 0x61, 0xaf, 0x69, 0x27, 0xf4,
-	0x9f, 0xbb, 0xb9, 0xbd, 0xda, 0x80, 0x0c, 0x5b, 0x17, 0xed, 0xd1, 0xdd, 0x94, 0xb1, 0x15, 0xd3,
-	0x76, 0xf8, 0xe4, 0x28, 0x7b, 0x4e, 0x8f, 0xc2, 0x7c, 0x2d, 0x0d, 0xf6, 0x1a, 0x83, 0xfa, 0xb0,
-	0x99, 0x3e, 0x0a, 0xe2, 0x44, 0x1a, 0x3c, 0xbb, 0xa0, 0xad, 0x10, 0x61, 0x0a, 0xf8, 0xbf, 0x87,
-	0xdc, 0xe3, 0xf9, 0x44, 0x4f, 0x27, 0xc2, 0x60, 0xf0, 0x8d, 0xf5, 0x46, 0xa1, 0x4d, 0xbe, 0xb4,
-	0xc0, 0x25, 0x6f, 0xa4, 0x9e, 0xb0, 0x17, 0x94, 0xad, 0x85, 0x41, 0x9b, 0x5a, 0xe5, 0x30, 0x5f,
-	0x81, 0xcc, 0x57, 0xe8, 0xd2, 0xd7, 0xd3, 0xd8, 0x56, 0xc6, 0xae, 0xf0, 0xde, 0xe9, 0xe7, 0x6b,
-	0x7a, 0xe2, 0x9b, 0x0a, 0x94, 0x5a, 0xcd, 0x76, 0x25, 0xb0, 0x27, 0xf4, 0xf4, 0x6d, 0x51, 0xe2,
-	0x2e, 0x0c, 0xb3, 0x62, 0x1c, 0xb1, 0x36, 0x6d, 0x5e, 0xa9, 0x4b, 0xbd, 0x51, 0xd9, 0x6c, 0x1b,
-	0x13, 0xd6, 0xa1, 0xf4, 0x7a, 0x83, 0x81, 0xd7, 0x6c, 0x79, 0x36, 0x9d, 0x7e, 0x80, 0xdd, 0x3b,
-	0x50, 0x71, 0xdd, 0x96, 0x3d, 0x9d, 0xca, 0x5c, 0xc5, 0x8d, 0x7e, 0xe3, 0xd7, 0xcf, 0x84, 0x9c,
-	0x4f, 0x68, 0x37, 0x74, 0xbd, 0x29, 0x33, 0x81, 0x90, 0x82, 0x30, 0x5a, 0x59, 0xf3, 0x8d, 0xca,
-	0xe0, 0x8b, 0x54, 0x90, 0xc5, 0x91, 0x33, 0xeb, 0x62, 0x61, 0x50, 0x5b, 0x4e, 0xd8, 0x09, 0x6d,
-	0xbd, 0xc9, 0x0a, 0xa9, 0xbc, 0x27, 0xae, 0xf9, 0x6e, 0x97, 0x57, 0xbf, 0xf7, 0x09, 0xb9, 0xdd,
-	0x27, 0xe4, 0xef, 0x3e, 0x21, 0x3f, 0x0e, 0x49, 0x74, 0x7b, 0x48, 0xa2, 0x3f, 0x87, 0x24, 0xfa,
-	0xcc, 0x73, 0x89, 0xab, 0xcd, 0xc2, 0xee, 0x8a, 0xdb, 0x7b, 0x79, 0xe9, 0x56, 0xc8, 0xc3, 0xe9,
-	0xf0, 0xed, 0xf1, 0xbe, 0x38, 0xee, 0x4a, 0x30, 0x8b, 0x87, 0xee, 0x3c, 0x5e, 0xfd, 0x0b, 0x00,
-	0x00, 0xff, 0xff, 0x3a, 0x82, 0x4c, 0x46, 0x81, 0x02, 0x00, 0x00,
+	// 466 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xd1, 0x8e, 0x12, 0x31,
+	0x14, 0x9d, 0x32, 0x68, 0xa4, 0x08, 0xcc, 0x56, 0x8c, 0x84, 0x4d, 0x26, 0x04, 0x5f, 0x70, 0xa3,
+	0x4c, 0xb2, 0xfa, 0x03, 0xbb, 0x1b, 0xa3, 0x44, 0xcc, 0x26, 0x03, 0x1b, 0x8d, 0x2f, 0xa4, 0xd0,
+	0xeb, 0xd0, 0x84, 0x69, 0x27, 0xd3, 0x62, 0xc0, 0x37, 0xff, 0xc0, 0x8f, 0xf0, 0xc1, 0x4f, 0xf1,
+	0x71, 0x1f, 0x7d, 0x34, 0xf0, 0x23, 0xa6, 0x2d, 0xc5, 0x07, 0xf7, 0xa9, 0xf7, 0x9c, 0xdb, 0x73,
+	0x6e, 0x7b, 0x73, 0xf0, 0x13, 0x39, 0x57, 0x50, 0x7e, 0x81, 0x32, 0xf1, 0xc5, 0xb0, 0x28, 0xa5,
+	0x96, 0xe4, 0xf4, 0x2b, 0x68, 0xba, 0x58, 0x52, 0x2e, 0x86, 0xb6, 0x92, 0x25, 0x0c, 0xfd, 0x95,
+	0xee, 0xa3, 0x85, 0xcc, 0x73, 0x29, 0x12, 0x77, 0x38, 0x45, 0xb7, 0x9d, 0xc9, 0x4c, 0xda, 0x32,
+	0x31, 0x95, 0x63, 0xfb, 0xdf, 0x10, 0x6e, 0x5e, 0x1f, 0x74, 0xef, 0x69, 0x51, 0x40, 0x49, 0xda,
+	0xf8, 0x1e, 0x17, 0x0c, 0x36, 0x1d, 0xd4, 0x43, 0x83, 0x5a, 0xea, 0x00, 0x79, 0x85, 0x9b, 0xde,
+	0x7f, 0x66, 0xe7, 0x76, 0x2a, 0x3d, 0x34, 0xa8, 0x9f, 0x37, 0x86, 0x87, 0x29, 0x57, 0x86, 0x4c,
+	0x1b, 0xfe, 0x92, 0x85, 0xe4, 0x29, 0x3e, 0x12, 0xb3, 0x15, 0x57, 0xba, 0x53, 0xed, 0x85, 0x83,
+	0x5a, 0xfa, 0xd0, 0x93, 0x63, 0xae, 0x74, 0xff, 0x1c, 0xd7, 0xfd, 0x13, 0x26, 0xa0, 0xff, 0xd7,
+	0xa0, 0x3b, 0x34, 0x1f, 0xff, 0x3d, 0xdb, 0x4e, 0x52, 0xe4, 0x19, 0x8e, 0x8e, 0x32, 0xca, 0x58,
+	0x09, 0x4a, 0x1d, 0x7e, 0xd0, 0xf2, 0xfc, 0x85, 0xa3, 0xc9, 0x29, 0xae, 0xd9, 0x2f, 0xcc, 0x38,
+	0x53, 0x9d, 0x4a, 0x2f, 0x1c, 0x84, 0xe9, 0x03, 0x4b, 0x8c, 0x98, 0xea, 0x7f, 0xc0, 0x27, 0x63,
+	0xaa, 0xf4, 0xd1, 0x5d, 0xae, 0x85, 0x36, 0x3b, 0x59, 0x98, 0xc2, 0x3a, 0x56, 0x53, 0x07, 0xc8,
+	0x73, 0x4c, 0x56, 0x54, 0x69, 0xb3, 0x0f, 0x91, 0xc1, 0x6c, 0x09, 0x3c, 0x5b, 0x6a, 0xbb, 0x97,
+	0x30, 0x8d, 0x4c, 0xe7, 0xca, 0x36, 0xde, 0x5a, 0xfe, 0x6c, 0x85, 0x5b, 0xce, 0x94, 0x6a, 0x2e,
+	0xc5, 0x74, 0x5b, 0x00, 0x79, 0x8c, 0x4f, 0x5e, 0xe7, 0x85, 0xde, 0xfa, 0x61, 0x86, 0x8c, 0x02,
+	0xd2, 0xc0, 0xb5, 0x91, 0xb8, 0x94, 0x6b, 0xc1, 0xa6, 0x9b, 0x08, 0x91, 0x26, 0xc6, 0xd7, 0x6b,
+	0xed, 0x71, 0xc5, 0xb4, 0xa7, 0x93, 0xc9, 0x3b, 0xd8, 0xbe, 0x01, 0x11, 0x85, 0xa6, 0xed, 0xe0,
+	0x84, 0x67, 0x22, 0xaa, 0x76, 0xab, 0x3f, 0x7f, 0xc4, 0xe8, 0x6c, 0x8c, 0xdb, 0xde, 0xf5, 0xa6,
+	0x60, 0x54, 0x43, 0x0a, 0x54, 0x49, 0x61, 0xc4, 0x37, 0x82, 0xc1, 0x67, 0x2e, 0x80, 0x45, 0x81,
+	0x15, 0xcb, 0x7c, 0xae, 0xb4, 0x34, 0x18, 0x91, 0x16, 0xae, 0x5f, 0xb0, 0x9c, 0x0b, 0xa7, 0x89,
+	0x2a, 0xce, 0xed, 0x72, 0xf4, 0x6b, 0x17, 0xa3, 0xdb, 0x5d, 0x8c, 0xfe, 0xec, 0x62, 0xf4, 0x7d,
+	0x1f, 0x07, 0xb7, 0xfb, 0x38, 0xf8, 0xbd, 0x8f, 0x83, 0x4f, 0x49, 0xc6, 0xf5, 0x72, 0x3d, 0x37,
+	0x29, 0x48, 0x4c, 0x12, 0x5f, 0xd8, 0x45, 0x26, 0x3e, 0x94, 0xc9, 0xe6, 0x98, 0xdc, 0x44, 0x6f,
+	0x0b, 0x50, 0xf3, 0xfb, 0x36, 0x78, 0x2f, 0xff, 0x0e, 0x00, 0x0f, 0x55, 0xcc, 0xc7, 0xdb, 0x02,
+	0x00, 0x00,
 }
 
 func (m *ObserverMapper) Marshal() (dAtA []byte, err error) {
@@ -367,6 +425,55 @@ func (m *ObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ObserverChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChainIds) > 0 {
+		dAtA3 := make([]byte, len(m.ChainIds)*10)
+		var j2 int
+		for _, num1 := range m.ChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintObserver(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintObserver(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LastObserverCount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -449,6 +556,26 @@ func (m *ObserverSet) Size() (n int) {
 	return n
 }
 
+func (m *ObserverChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovObserver(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		l = 0
+		for _, e := range m.ChainIds {
+			l += sovObserver(uint64(e))
+		}
+		n += 1 + sovObserver(uint64(l)) + l
+	}
+	return n
+}
+
 func (m *LastObserverCount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -702,6 +829,164 @@ func (m *ObserverSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ObserverChains) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserver
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverChains: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverChains: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserver
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserver
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChainIds = append(m.ChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowObserver
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthObserver
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthObserver
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChainIds) == 0 {
+					m.ChainIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowObserver
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChainIds = append(m.ChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserver(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObserver
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LastObserverCount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,512 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: observer/observer_liveness.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ObserverLiveness contains the liveness state of an observer
+// the observer is jailed if it misses too many ballots in a window of matured ballots
+type ObserverLiveness struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	// number of matured ballots the observer was eligible to vote on in the current window
+	WindowBallots int64 `protobuf:"varint,2,opt,name=window_ballots,json=windowBallots,proto3" json:"window_ballots,omitempty"`
+	// number of matured ballots the observer missed in the current window
+	WindowMissedBallots int64 `protobuf:"varint,3,opt,name=window_missed_ballots,json=windowMissedBallots,proto3" json:"window_missed_ballots,omitempty"`
+	// a jailed observer is not a voter of the new ballots
+	Jailed bool `protobuf:"varint,4,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// zeta height from which a jailed observer can be unjailed
+	JailedUntil int64 `protobuf:"varint,5,opt,name=jailed_until,json=jailedUntil,proto3" json:"jailed_until,omitempty"`
+	// number of times the observer has been jailed
+	JailCount uint64 `protobuf:"varint,6,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+}
+
+func (m *ObserverLiveness) Reset()         { *m = ObserverLiveness{} }
+func (m *ObserverLiveness) String() string { return proto.CompactTextString(m) }
+func (*ObserverLiveness) ProtoMessage()    {}
+func (*ObserverLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6a224e8f5c3634a, []int{0}
+}
+func (m *ObserverLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverLiveness.Merge(m, src)
+}
+func (m *ObserverLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverLiveness proto.InternalMessageInfo
+
+func (m *ObserverLiveness) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverLiveness) GetWindowBallots() int64 {
+	if m != nil {
+		return m.WindowBallots
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetWindowMissedBallots() int64 {
+	if m != nil {
+		return m.WindowMissedBallots
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *ObserverLiveness) GetJailedUntil() int64 {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return 0
+}
+
+func (m *ObserverLiveness) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ObserverLiveness)(nil), "zetachain.zetacore.observer.ObserverLiveness")
+}
+
+func init() { proto.RegisterFile("observer/observer_liveness.proto", fileDescriptor_d6a224e8f5c3634a) }
+
+var fileDescriptor_d6a224e8f5c3634a = []byte{
+	// 289 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xcd, 0x4a, 0xf4, 0x30,
+	0x14, 0x86, 0x9b, 0x6f, 0xe6, 0x2b, 0x4e, 0xfc, 0x1b, 0x22, 0x4a, 0x41, 0x0c, 0x55, 0x10, 0xea,
+	0xc2, 0x06, 0xf4, 0x0a, 0x1c, 0x57, 0x82, 0x22, 0x14, 0xdc, 0xb8, 0x29, 0xfd, 0x39, 0x38, 0x91,
+	0x4e, 0x33, 0x34, 0xe9, 0x8c, 0x7a, 0x15, 0x5e, 0x96, 0xcb, 0x59, 0xba, 0x94, 0xf6, 0x0e, 0xbc,
+	0x02, 0x69, 0xd2, 0x74, 0x77, 0xde, 0xe7, 0xbc, 0xe7, 0x2c, 0x1e, 0xec, 0x8b, 0x54, 0x42, 0xb5,
+	0x82, 0x8a, 0xd9, 0x21, 0x2e, 0xf8, 0x0a, 0x4a, 0x90, 0x32, 0x5c, 0x56, 0x42, 0x09, 0x72, 0xfc,
+	0x01, 0x2a, 0xc9, 0xe6, 0x09, 0x2f, 0x43, 0x3d, 0x89, 0x0a, 0x42, 0xdb, 0x3d, 0xfb, 0x45, 0x78,
+	0xfa, 0xd8, 0x87, 0xfb, 0xfe, 0x8e, 0x5c, 0xe0, 0xe9, 0xf0, 0x2c, 0xc9, 0xf3, 0x0a, 0xa4, 0xf4,
+	0x90, 0x8f, 0x82, 0x49, 0xb4, 0x6f, 0xf9, 0x8d, 0xc1, 0xe4, 0x1c, 0xef, 0xad, 0x79, 0x99, 0x8b,
+	0x75, 0x9c, 0x26, 0x45, 0x21, 0x94, 0xf4, 0xfe, 0xf9, 0x28, 0x18, 0x45, 0xbb, 0x86, 0xce, 0x0c,
+	0x24, 0x57, 0xf8, 0xb0, 0xaf, 0x2d, 0xb8, 0x94, 0x90, 0x0f, 0xed, 0x91, 0x6e, 0x1f, 0x98, 0xe5,
+	0x83, 0xde, 0xd9, 0x9b, 0x23, 0xec, 0xbe, 0x26, 0xbc, 0x80, 0xdc, 0x1b, 0xfb, 0x28, 0xd8, 0x8a,
+	0xfa, 0x44, 0x4e, 0xf1, 0x8e, 0x99, 0xe2, 0xba, 0x54, 0xbc, 0xf0, 0xfe, 0xeb, 0x17, 0xdb, 0x86,
+	0x3d, 0x75, 0x88, 0x9c, 0x60, 0xdc, 0xc5, 0x38, 0x13, 0x75, 0xa9, 0x3c, 0xd7, 0x47, 0xc1, 0x38,
+	0x9a, 0x74, 0xe4, 0xb6, 0x03, 0xb3, 0xbb, 0xaf, 0x86, 0xa2, 0x4d, 0x43, 0xd1, 0x4f, 0x43, 0xd1,
+	0x67, 0x4b, 0x9d, 0x4d, 0x4b, 0x9d, 0xef, 0x96, 0x3a, 0xcf, 0xec, 0x85, 0xab, 0x79, 0x9d, 0x86,
+	0x99, 0x58, 0xb0, 0x4e, 0xd6, 0xa5, 0xf6, 0xc6, 0xac, 0x37, 0xf6, 0x36, 0x58, 0x66, 0xea, 0x7d,
+	0x09, 0x32, 0x75, 0xb5, 0xe3, 0xeb, 0xbf, 0x01, 0x00, 0x79, 0x67, 0x8c, 0x85, 0x87, 0x01, 0x00,
+	0x00,
+}
+
+func (m *ObserverLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.JailedUntil != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.JailedUntil))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowMissedBallots != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.WindowMissedBallots))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowBallots != 0 {
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(m.WindowBallots))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintObserverLiveness(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObserverLiveness(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObserverLiveness(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ObserverLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovObserverLiveness(uint64(l))
+	}
+	if m.WindowBallots != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.WindowBallots))
+	}
+	if m.WindowMissedBallots != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.WindowMissedBallots))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.JailedUntil != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.JailedUntil))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovObserverLiveness(uint64(m.JailCount))
+	}
+	return n
+}
+
+func sovObserverLiveness(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObserverLiveness(x uint64) (n int) {
+	return sovObserverLiveness(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ObserverLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserverLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBallots", wireType)
+			}
+			m.WindowBallots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBallots |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMissedBallots", wireType)
+			}
+			m.WindowMissedBallots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowMissedBallots |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			m.JailedUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailedUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserverLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObserverLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObserverLiveness(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObserverLiveness
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverLiveness
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObserverLiveness
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObserverLiveness
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObserverLiveness
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObserverLiveness        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObserverLiveness          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObserverLiveness = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,621 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: observer/observer_performance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ObserverPerformance contains the participation counters of an observer for a chain and an observation type
+// the counters are updated when the ballots mature
+// store key is observer_address/chain_id/observation_type
+type ObserverPerformance struct {
+	ObserverAddress string          `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	ChainId         int64           `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ObservationType ObservationType `protobuf:"varint,3,opt,name=observation_type,json=observationType,proto3,enum=zetachain.zetacore.observer.ObservationType" json:"observation_type,omitempty"`
+	// number of matured ballots the observer was eligible to vote on
+	BallotsEligible uint64 `protobuf:"varint,4,opt,name=ballots_eligible,json=ballotsEligible,proto3" json:"ballots_eligible,omitempty"`
+	// number of matured ballots the observer voted on
+	BallotsVoted uint64 `protobuf:"varint,5,opt,name=ballots_voted,json=ballotsVoted,proto3" json:"ballots_voted,omitempty"`
+	// number of matured finalized ballots the observer voted on with the finalized outcome
+	BallotsVotedWithMajority uint64 `protobuf:"varint,6,opt,name=ballots_voted_with_majority,json=ballotsVotedWithMajority,proto3" json:"ballots_voted_with_majority,omitempty"`
+	// number of votes cast after the ballot was finalized
+	BallotsVotedLate uint64 `protobuf:"varint,7,opt,name=ballots_voted_late,json=ballotsVotedLate,proto3" json:"ballots_voted_late,omitempty"`
+	// number of matured ballots the observer didn't vote on
+	BallotsMissed uint64 `protobuf:"varint,8,opt,name=ballots_missed,json=ballotsMissed,proto3" json:"ballots_missed,omitempty"`
+	// zeta height of the last update of the counters
+	LastUpdatedHeight int64 `protobuf:"varint,9,opt,name=last_updated_height,json=lastUpdatedHeight,proto3" json:"last_updated_height,omitempty"`
+}
+
+func (m *ObserverPerformance) Reset()         { *m = ObserverPerformance{} }
+func (m *ObserverPerformance) String() string { return proto.CompactTextString(m) }
+func (*ObserverPerformance) ProtoMessage()    {}
+func (*ObserverPerformance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3a7e18fbaddec28, []int{0}
+}
+func (m *ObserverPerformance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverPerformance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverPerformance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverPerformance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverPerformance.Merge(m, src)
+}
+func (m *ObserverPerformance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverPerformance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverPerformance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverPerformance proto.InternalMessageInfo
+
+func (m *ObserverPerformance) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *ObserverPerformance) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *ObserverPerformance) GetObservationType() ObservationType {
+	if m != nil {
+		return m.ObservationType
+	}
+	return ObservationType_EmptyObserverType
+}
+
+func (m *ObserverPerformance) GetBallotsEligible() uint64 {
+	if m != nil {
+		return m.BallotsEligible
+	}
+	return 0
+}
+
+func (m *ObserverPerformance) GetBallotsVoted() uint64 {
+	if m != nil {
+		return m.BallotsVoted
+	}
+	return 0
+}
+
+func (m *ObserverPerformance) GetBallotsVotedWithMajority() uint64 {
+	if m != nil {
+		return m.BallotsVotedWithMajority
+	}
+	return 0
+}
+
+func (m *ObserverPerformance) GetBallotsVotedLate() uint64 {
+	if m != nil {
+		return m.BallotsVotedLate
+	}
+	return 0
+}
+
+func (m *ObserverPerformance) GetBallotsMissed() uint64 {
+	if m != nil {
+		return m.BallotsMissed
+	}
+	return 0
+}
+
+func (m *ObserverPerformance) GetLastUpdatedHeight() int64 {
+	if m != nil {
+		return m.LastUpdatedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ObserverPerformance)(nil), "zetachain.zetacore.observer.ObserverPerformance")
+}
+
+func init() {
+	proto.RegisterFile("observer/observer_performance.proto", fileDescriptor_f3a7e18fbaddec28)
+}
+
+var fileDescriptor_f3a7e18fbaddec28 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x3b, 0xb6, 0xf6, 0xcf, 0xa0, 0x6d, 0x9d, 0x2e, 0x1c, 0x2d, 0x84, 0x60, 0x11, 0x22,
+	0xd4, 0x04, 0x74, 0xed, 0x42, 0x41, 0xb0, 0x60, 0xa9, 0x14, 0xb5, 0xe0, 0x26, 0x4c, 0x9a, 0x63,
+	0x33, 0x92, 0x74, 0xc2, 0xcc, 0xb4, 0x5a, 0x9f, 0xc2, 0xc7, 0xea, 0xb2, 0x4b, 0x97, 0x97, 0xf6,
+	0x45, 0x2e, 0x99, 0x66, 0xfa, 0x87, 0x0b, 0x77, 0x37, 0xf9, 0x9d, 0xef, 0x3b, 0xe7, 0xf0, 0xe5,
+	0xe0, 0x81, 0x88, 0x14, 0xc8, 0x35, 0xc8, 0xc0, 0x3e, 0xc2, 0x1c, 0xe4, 0x4f, 0x21, 0x33, 0xb6,
+	0x9c, 0x83, 0x9f, 0x4b, 0xa1, 0x05, 0xe9, 0xff, 0x05, 0xcd, 0xe6, 0x09, 0xe3, 0x4b, 0xdf, 0xbc,
+	0x84, 0x04, 0xdf, 0xca, 0x9f, 0x3f, 0xbd, 0xd3, 0xe1, 0xe8, 0x7a, 0xb1, 0xad, 0xe2, 0xde, 0xa4,
+	0x44, 0x5f, 0xce, 0x3d, 0xc9, 0x2b, 0xdc, 0x3d, 0xcd, 0x62, 0x71, 0x2c, 0x41, 0x29, 0x8a, 0x5c,
+	0xe4, 0xb5, 0xa6, 0x1d, 0xcb, 0xdf, 0x1f, 0x31, 0x79, 0x86, 0x9b, 0x66, 0x6c, 0xc8, 0x63, 0xfa,
+	0xc0, 0x45, 0x5e, 0x75, 0xda, 0x30, 0xdf, 0xa3, 0x98, 0xcc, 0x6c, 0x17, 0xa6, 0xb9, 0x58, 0x86,
+	0x7a, 0x93, 0x03, 0xad, 0xba, 0xc8, 0x6b, 0xbf, 0x19, 0xfa, 0xf7, 0xac, 0xeb, 0x4f, 0xce, 0xa6,
+	0xaf, 0x9b, 0x1c, 0xec, 0xcc, 0x13, 0x28, 0xd6, 0x8b, 0x58, 0x9a, 0x0a, 0xad, 0x42, 0x48, 0xf9,
+	0x82, 0x47, 0x29, 0xd0, 0x9a, 0x8b, 0xbc, 0xda, 0xb4, 0x53, 0xf2, 0x8f, 0x25, 0x26, 0x03, 0xfc,
+	0xd8, 0x4a, 0xd7, 0x42, 0x43, 0x4c, 0x1f, 0x1a, 0xdd, 0xa3, 0x12, 0x7e, 0x2f, 0x18, 0x79, 0x87,
+	0xfb, 0x57, 0xa2, 0xf0, 0x37, 0xd7, 0x49, 0x98, 0xb1, 0x5f, 0x42, 0x72, 0xbd, 0xa1, 0x75, 0x63,
+	0xa1, 0x97, 0x96, 0x19, 0xd7, 0xc9, 0xb8, 0xac, 0x93, 0x21, 0x26, 0xd7, 0xf6, 0x94, 0x69, 0xa0,
+	0x0d, 0xe3, 0xea, 0x5e, 0xba, 0x3e, 0x33, 0x0d, 0xe4, 0x25, 0x6e, 0x5b, 0x75, 0xc6, 0x95, 0x82,
+	0x98, 0x36, 0x8d, 0xd2, 0xee, 0x39, 0x36, 0x90, 0xf8, 0xb8, 0x97, 0x32, 0xa5, 0xc3, 0x55, 0x1e,
+	0xb3, 0xa2, 0x67, 0x02, 0x7c, 0x91, 0x68, 0xda, 0x32, 0x11, 0x3f, 0x29, 0x4a, 0xdf, 0x8e, 0x95,
+	0x4f, 0xa6, 0xf0, 0x61, 0xb4, 0xdd, 0x3b, 0x68, 0xb7, 0x77, 0xd0, 0xcd, 0xde, 0x41, 0xff, 0x0e,
+	0x4e, 0x65, 0x77, 0x70, 0x2a, 0xff, 0x0f, 0x4e, 0xe5, 0x47, 0xb0, 0xe0, 0x3a, 0x59, 0x45, 0xfe,
+	0x5c, 0x64, 0x41, 0x11, 0xf6, 0x6b, 0x93, 0x7b, 0x60, 0x73, 0x0f, 0xfe, 0x9c, 0xae, 0x22, 0x28,
+	0x7e, 0x91, 0x8a, 0xea, 0xe6, 0x38, 0xde, 0xde, 0x0e, 0x00, 0x12, 0xb0, 0xa7, 0x5b, 0x79, 0x02,
+	0x00, 0x00,
+}
+
+func (m *ObserverPerformance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverPerformance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverPerformance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdatedHeight != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.LastUpdatedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.BallotsMissed != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.BallotsMissed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BallotsVotedLate != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.BallotsVotedLate))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BallotsVotedWithMajority != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.BallotsVotedWithMajority))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BallotsVoted != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.BallotsVoted))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BallotsEligible != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.BallotsEligible))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ObservationType != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintObserverPerformance(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObserverPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObserverPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ObserverPerformance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovObserverPerformance(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.ChainId))
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.ObservationType))
+	}
+	if m.BallotsEligible != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.BallotsEligible))
+	}
+	if m.BallotsVoted != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.BallotsVoted))
+	}
+	if m.BallotsVotedWithMajority != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.BallotsVotedWithMajority))
+	}
+	if m.BallotsVotedLate != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.BallotsVotedLate))
+	}
+	if m.BallotsMissed != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.BallotsMissed))
+	}
+	if m.LastUpdatedHeight != 0 {
+		n += 1 + sovObserverPerformance(uint64(m.LastUpdatedHeight))
+	}
+	return n
+}
+
+func sovObserverPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObserverPerformance(x uint64) (n int) {
+	return sovObserverPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ObserverPerformance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObserverPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverPerformance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverPerformance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObserverPerformance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObserverPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
+			}
+			m.ObservationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotsEligible", wireType)
+			}
+			m.BallotsEligible = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotsEligible |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotsVoted", wireType)
+			}
+			m.BallotsVoted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotsVoted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotsVotedWithMajority", wireType)
+			}
+			m.BallotsVotedWithMajority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotsVotedWithMajority |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotsVotedLate", wireType)
+			}
+			m.BallotsVotedLate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotsVotedLate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotsMissed", wireType)
+			}
+			m.BallotsMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotsMissed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedHeight", wireType)
+			}
+			m.LastUpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObserverPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObserverPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObserverPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObserverPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObserverPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObserverPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObserverPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObserverPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObserverPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObserverPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObserverPerformance = fmt.Errorf("proto: unexpected end of group")
+)
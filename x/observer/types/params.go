@@ -38,7 +38,28 @@ func DefaultParams() Params {
 			MinObserverDelegation: sdk.MustNewDecFromStr("1000000000000000000000"), // 1000 ZETA
 		}
 	}
-	return NewParams(observerParams, DefaultAdminPolicy(), 100)
+	params := NewParams(observerParams, DefaultAdminPolicy(), 100)
+	params.SetDefaultLivenessParams()
+	params.SetDefaultBlameRetentionBlocks()
+	return params
+}
+
+// SetDefaultLivenessParams sets the default liveness policy of the observers
+func (p *Params) SetDefaultLivenessParams() {
+	p.MissedBallotsWindow = 1000
+	p.MaxMissedBallotsRatio = sdk.MustNewDecFromStr("0.5")
+	p.ObserverJailDuration = 14400
+	p.ObserverSlashFraction = sdk.MustNewDecFromStr("0.0001")
+}
+
+// SetDefaultBlameRetentionBlocks sets the default retention period of the blame records, about a week
+func (p *Params) SetDefaultBlameRetentionBlocks() {
+	p.BlameRetentionBlocks = 100800
+}
+
+// IsLivenessEnabled returns true if the observers are jailed when missing ballots
+func (p Params) IsLivenessEnabled() bool {
+	return p.MissedBallotsWindow > 0
 }
 
 func DefaultAdminPolicy() []*Admin_Policy {
@@ -60,11 +81,34 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPrefix(ObserverParamsKey), &p.ObserverParams, validateVotingThresholds),
 		paramtypes.NewParamSetPair(KeyPrefix(AdminPolicyParamsKey), &p.AdminPolicy, validateAdminPolicy),
 		paramtypes.NewParamSetPair(KeyPrefix(BallotMaturityBlocksParamsKey), &p.BallotMaturityBlocks, validateBallotMaturityBlocks),
+		paramtypes.NewParamSetPair(KeyPrefix(MissedBallotsWindowParamsKey), &p.MissedBallotsWindow, validateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyPrefix(MaxMissedBallotsRatioParamsKey), &p.MaxMissedBallotsRatio, validateRatio),
+		paramtypes.NewParamSetPair(KeyPrefix(ObserverJailDurationParamsKey), &p.ObserverJailDuration, validateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyPrefix(ObserverSlashFractionParamsKey), &p.ObserverSlashFraction, validateRatio),
+		paramtypes.NewParamSetPair(KeyPrefix(BlameRetentionBlocksParamsKey), &p.BlameRetentionBlocks, validateBlameRetentionBlocks),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateNonNegativeInt64(p.MissedBallotsWindow); err != nil {
+		return err
+	}
+	if err := validateNonNegativeInt64(p.ObserverJailDuration); err != nil {
+		return err
+	}
+	if err := validateRatio(p.MaxMissedBallotsRatio); err != nil {
+		return err
+	}
+	if err := validateRatio(p.ObserverSlashFraction); err != nil {
+		return err
+	}
+	if err := validateBlameRetentionBlocks(p.BlameRetentionBlocks); err != nil {
+		return err
+	}
+	if p.IsLivenessEnabled() && (p.MaxMissedBallotsRatio.IsNil() || p.ObserverSlashFraction.IsNil()) {
+		return ErrParamsLiveness.Wrap("max missed ballots ratio and slash fraction must be set")
+	}
 	return nil
 }
 
@@ -109,6 +153,43 @@ func validateBallotMaturityBlocks(i interface{}) error {
 	return nil
 }
 
+func validateNonNegativeInt64(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return ErrParamsLiveness.Wrapf("negative value %d", v)
+	}
+	return nil
+}
+
+func validateBlameRetentionBlocks(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return ErrParamsBlameRetention.Wrapf("negative retention period %d", v)
+	}
+	return nil
+}
+
+func validateRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// a nil ratio is zero
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return ErrParamsLiveness.Wrapf("ratio must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
 func (p Params) GetAdminPolicyAccount(policyType Policy_Type) string {
 	for _, admin := range p.AdminPolicy {
 		if admin.PolicyType == policyType {
@@ -111,6 +111,11 @@ type ChainParams struct {
 	BallotThreshold             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=ballot_threshold,json=ballotThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ballot_threshold"`
 	MinObserverDelegation       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=min_observer_delegation,json=minObserverDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_observer_delegation"`
 	IsSupported                 bool                                   `protobuf:"varint,16,opt,name=is_supported,json=isSupported,proto3" json:"is_supported,omitempty"`
+	// maximum number of zeta blocks a CCTX can stay in PendingOutbound before it expires
+	// 0 disables the expiry
+	MaxOutboundPendingBlocks uint64 `protobuf:"varint,17,opt,name=max_outbound_pending_blocks,json=maxOutboundPendingBlocks,proto3" json:"max_outbound_pending_blocks,omitempty"`
+	// if true, the ballots of the chain are finalized on the bonded stake of the voters instead of the number of votes
+	StakeWeightedBallots bool `protobuf:"varint,18,opt,name=stake_weighted_ballots,json=stakeWeightedBallots,proto3" json:"stake_weighted_ballots,omitempty"`
 }
 
 func (m *ChainParams) Reset()         { *m = ChainParams{} }
@@ -230,6 +235,74 @@ func (m *ChainParams) GetIsSupported() bool {
 	return false
 }
 
+func (m *ChainParams) GetMaxOutboundPendingBlocks() uint64 {
+	if m != nil {
+		return m.MaxOutboundPendingBlocks
+	}
+	return 0
+}
+
+func (m *ChainParams) GetStakeWeightedBallots() bool {
+	if m != nil {
+		return m.StakeWeightedBallots
+	}
+	return false
+}
+
+// PendingChainParams contains chain params scheduled to replace the chain params of a chain at the activation height
+type PendingChainParams struct {
+	ChainParams *ChainParams `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
+	// zeta height at which the chain params are applied
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *PendingChainParams) Reset()         { *m = PendingChainParams{} }
+func (m *PendingChainParams) String() string { return proto.CompactTextString(m) }
+func (*PendingChainParams) ProtoMessage()    {}
+func (*PendingChainParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4542fa62877488a1, []int{2}
+}
+func (m *PendingChainParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingChainParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingChainParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingChainParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChainParams.Merge(m, src)
+}
+func (m *PendingChainParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingChainParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChainParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChainParams proto.InternalMessageInfo
+
+func (m *PendingChainParams) GetChainParams() *ChainParams {
+	if m != nil {
+		return m.ChainParams
+	}
+	return nil
+}
+
+func (m *PendingChainParams) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 // Deprecated(v13): Use ChainParamsList
 type ObserverParams struct {
 	Chain                 *common.Chain                          `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
@@ -242,7 +315,7 @@ func (m *ObserverParams) Reset()         { *m = ObserverParams{} }
 func (m *ObserverParams) String() string { return proto.CompactTextString(m) }
 func (*ObserverParams) ProtoMessage()    {}
 func (*ObserverParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4542fa62877488a1, []int{2}
+	return fileDescriptor_4542fa62877488a1, []int{3}
 }
 func (m *ObserverParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,7 +368,7 @@ func (m *Admin_Policy) Reset()         { *m = Admin_Policy{} }
 func (m *Admin_Policy) String() string { return proto.CompactTextString(m) }
 func (*Admin_Policy) ProtoMessage()    {}
 func (*Admin_Policy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4542fa62877488a1, []int{3}
+	return fileDescriptor_4542fa62877488a1, []int{4}
 }
 func (m *Admin_Policy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -345,12 +418,23 @@ type Params struct {
 	// Deprecated(v14):Moved into the authority module
 	AdminPolicy          []*Admin_Policy `protobuf:"bytes,2,rep,name=admin_policy,json=adminPolicy,proto3" json:"admin_policy,omitempty"`
 	BallotMaturityBlocks int64           `protobuf:"varint,3,opt,name=ballot_maturity_blocks,json=ballotMaturityBlocks,proto3" json:"ballot_maturity_blocks,omitempty"`
+	// liveness policy of the observers, the policy is disabled if missed_ballots_window is zero
+	// number of matured ballots an observer must be eligible to vote on to complete a liveness window
+	MissedBallotsWindow int64 `protobuf:"varint,4,opt,name=missed_ballots_window,json=missedBallotsWindow,proto3" json:"missed_ballots_window,omitempty"`
+	// maximum ratio of missed ballots in a liveness window, the observer is jailed above this ratio
+	MaxMissedBallotsRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_missed_ballots_ratio,json=maxMissedBallotsRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_missed_ballots_ratio"`
+	// number of blocks a jailed observer is removed from the ballots before it can be unjailed
+	ObserverJailDuration int64 `protobuf:"varint,6,opt,name=observer_jail_duration,json=observerJailDuration,proto3" json:"observer_jail_duration,omitempty"`
+	// fraction of the stake of the validator slashed when the observer is jailed
+	ObserverSlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=observer_slash_fraction,json=observerSlashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"observer_slash_fraction"`
+	// number of blocks a blame record is kept after its finalization before it is pruned, 0 disables the pruning
+	BlameRetentionBlocks int64 `protobuf:"varint,8,opt,name=blame_retention_blocks,json=blameRetentionBlocks,proto3" json:"blame_retention_blocks,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4542fa62877488a1, []int{4}
+	return fileDescriptor_4542fa62877488a1, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -400,10 +484,32 @@ func (m *Params) GetBallotMaturityBlocks() int64 {
 	return 0
 }
 
+func (m *Params) GetMissedBallotsWindow() int64 {
+	if m != nil {
+		return m.MissedBallotsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetObserverJailDuration() int64 {
+	if m != nil {
+		return m.ObserverJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetBlameRetentionBlocks() int64 {
+	if m != nil {
+		return m.BlameRetentionBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("zetachain.zetacore.observer.Policy_Type", Policy_Type_name, Policy_Type_value)
 	proto.RegisterType((*ChainParamsList)(nil), "zetachain.zetacore.observer.ChainParamsList")
 	proto.RegisterType((*ChainParams)(nil), "zetachain.zetacore.observer.ChainParams")
+	proto.RegisterType((*PendingChainParams)(nil), "zetachain.zetacore.observer.PendingChainParams")
 	proto.RegisterType((*ObserverParams)(nil), "zetachain.zetacore.observer.ObserverParams")
 	proto.RegisterType((*Admin_Policy)(nil), "zetachain.zetacore.observer.Admin_Policy")
 	proto.RegisterType((*Params)(nil), "zetachain.zetacore.observer.Params")
@@ -412,58 +518,71 @@ func init() {
 func init() { proto.RegisterFile("observer/params.proto", fileDescriptor_4542fa62877488a1) }
 
 var fileDescriptor_4542fa62877488a1 = []byte{
-	// 808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0xc7, 0xe3, 0x4d, 0x76, 0xbb, 0x7d, 0xce, 0x26, 0xa9, 0x69, 0xa9, 0xc9, 0x0a, 0x6f, 0x08,
-	0x12, 0x0a, 0xad, 0xd6, 0x86, 0x85, 0x13, 0x82, 0xc3, 0x6e, 0x7a, 0x59, 0xb1, 0x88, 0x95, 0x1b,
-	0x0e, 0x70, 0x60, 0x34, 0x19, 0x4f, 0x93, 0x51, 0x6c, 0x3f, 0x6b, 0x66, 0x5c, 0x12, 0x3e, 0x05,
-	0x47, 0x24, 0x2e, 0x48, 0x70, 0xe0, 0xa3, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1, 0xdd, 0x0b, 0x1f,
-	0x03, 0x79, 0x6c, 0x87, 0x6c, 0xb7, 0x5a, 0xa4, 0x4a, 0x3d, 0xf9, 0x79, 0xde, 0xef, 0xfd, 0xe7,
-	0xcd, 0x9b, 0xf7, 0x6c, 0xb8, 0x87, 0x53, 0xc5, 0xe5, 0x53, 0x2e, 0x83, 0x8c, 0x4a, 0x9a, 0x28,
-	0x3f, 0x93, 0xa8, 0xd1, 0xd9, 0xff, 0x91, 0x6b, 0xca, 0xe6, 0x54, 0xa4, 0xbe, 0xb1, 0x50, 0x72,
-	0xbf, 0x26, 0xfb, 0x6f, 0x31, 0x4c, 0x12, 0x4c, 0x83, 0xf2, 0x51, 0x46, 0xf4, 0xef, 0xce, 0x70,
-	0x86, 0xc6, 0x0c, 0x0a, 0xab, 0x5a, 0xbd, 0xbf, 0x96, 0xaf, 0x8d, 0xd2, 0x31, 0xfc, 0x1e, 0xba,
-	0xe3, 0x42, 0xfe, 0xdc, 0xec, 0x7a, 0x26, 0x94, 0x76, 0xbe, 0x84, 0xb6, 0xd9, 0x91, 0x94, 0x99,
-	0xb8, 0xd6, 0xa0, 0x39, 0xb2, 0x8f, 0x46, 0xfe, 0x0d, 0xa9, 0xf8, 0x1b, 0x1a, 0xa1, 0xcd, 0xfe,
-	0x7b, 0x19, 0xfe, 0xb6, 0x03, 0xf6, 0x86, 0xd3, 0x79, 0x07, 0x76, 0x4b, 0x71, 0x11, 0xb9, 0xf6,
-	0xc0, 0x1a, 0x35, 0xc3, 0x5b, 0xe6, 0xfd, 0x34, 0x72, 0x0e, 0xc1, 0x61, 0x98, 0x3e, 0x11, 0x32,
-	0xa1, 0x5a, 0x60, 0x4a, 0x18, 0xe6, 0xa9, 0x76, 0xad, 0x81, 0x35, 0x6a, 0x85, 0x77, 0x36, 0x3d,
-	0xe3, 0xc2, 0xe1, 0x8c, 0xa0, 0x37, 0xa3, 0x8a, 0x64, 0x52, 0x30, 0x4e, 0xb4, 0x60, 0x0b, 0x2e,
-	0xdd, 0x2d, 0x03, 0x77, 0x66, 0x54, 0x9d, 0x17, 0xcb, 0x13, 0xb3, 0xea, 0x0c, 0xa0, 0x2d, 0x52,
-	0xa2, 0x97, 0x35, 0xd5, 0x34, 0x14, 0x88, 0x74, 0xb2, 0xac, 0x88, 0x21, 0xec, 0x61, 0xae, 0x37,
-	0x90, 0x96, 0x41, 0x6c, 0xcc, 0xf5, 0x9a, 0x79, 0x00, 0x77, 0x7e, 0xa0, 0x9a, 0xcd, 0x49, 0xae,
-	0x97, 0x58, 0x73, 0xdb, 0x86, 0xeb, 0x1a, 0xc7, 0x37, 0x7a, 0x89, 0x15, 0xfb, 0x05, 0x98, 0x8b,
-	0x23, 0x1a, 0x17, 0xbc, 0x38, 0x48, 0xaa, 0x25, 0x65, 0x9a, 0xd0, 0x28, 0x92, 0x5c, 0x29, 0x77,
-	0x77, 0x60, 0x8d, 0x6e, 0x87, 0x6e, 0x81, 0x4c, 0x0a, 0x62, 0x5c, 0x01, 0xc7, 0xa5, 0xdf, 0xf9,
-	0x1c, 0xfa, 0x0c, 0xd3, 0x94, 0x33, 0x8d, 0xf2, 0x7a, 0xf4, 0xed, 0x32, 0x7a, 0x4d, 0xbc, 0x1c,
-	0x3d, 0x06, 0x8f, 0x4b, 0x76, 0xf4, 0x11, 0x61, 0xb9, 0xd2, 0x18, 0xad, 0xae, 0x2b, 0x80, 0x51,
-	0xd8, 0x37, 0xd4, 0xb8, 0x84, 0x5e, 0x16, 0x39, 0x86, 0x77, 0x31, 0xd7, 0x53, 0xcc, 0xd3, 0xa8,
-	0x28, 0x8b, 0x62, 0x73, 0x1e, 0xe5, 0x31, 0x27, 0x22, 0xd5, 0x5c, 0x3e, 0xa5, 0xb1, 0xdb, 0x36,
-	0x97, 0xd7, 0xaf, 0xa1, 0xc9, 0xf2, 0x71, 0x85, 0x9c, 0x56, 0x44, 0x91, 0xc7, 0x2b, 0x25, 0x62,
-	0xc4, 0x05, 0x9d, 0x73, 0x1a, 0xb9, 0x7b, 0x46, 0x63, 0xff, 0xba, 0xc6, 0x59, 0x8d, 0x38, 0xdf,
-	0x42, 0x6f, 0x4a, 0xe3, 0x18, 0x35, 0xd1, 0x73, 0xc9, 0xd5, 0x1c, 0xe3, 0xc8, 0xed, 0x14, 0xe9,
-	0x9f, 0xf8, 0xcf, 0x5e, 0x1c, 0x34, 0xfe, 0x7a, 0x71, 0xf0, 0xc1, 0x4c, 0xe8, 0x79, 0x3e, 0xf5,
-	0x19, 0x26, 0x01, 0x43, 0x95, 0xa0, 0xaa, 0x1e, 0x87, 0x2a, 0x5a, 0x04, 0x7a, 0x95, 0x71, 0xe5,
-	0x3f, 0xe2, 0x2c, 0xec, 0x96, 0x3a, 0x93, 0x5a, 0xc6, 0x79, 0x02, 0xf7, 0x13, 0x91, 0x92, 0xba,
-	0x87, 0x49, 0xc4, 0x63, 0x3e, 0x33, 0x0d, 0xe6, 0x76, 0x5f, 0x6b, 0x87, 0x7b, 0x89, 0x48, 0xbf,
-	0xae, 0xd4, 0x1e, 0xad, 0xc5, 0x9c, 0xf7, 0xa0, 0x2d, 0x14, 0x51, 0x79, 0x96, 0xa1, 0xd4, 0x3c,
-	0x72, 0x7b, 0x03, 0x6b, 0xb4, 0x1b, 0xda, 0x42, 0x3d, 0xae, 0x97, 0x86, 0xbf, 0x6c, 0x41, 0xa7,
-	0x8e, 0xac, 0x06, 0xe5, 0x7d, 0xd8, 0x36, 0x83, 0x61, 0x06, 0xc0, 0x3e, 0xda, 0xf3, 0xab, 0x29,
-	0x37, 0xc3, 0x14, 0x96, 0xbe, 0x57, 0x56, 0xa7, 0xf9, 0xc6, 0xab, 0xd3, 0x7a, 0x93, 0xd5, 0xd9,
-	0xbe, 0x5e, 0x1d, 0x05, 0xed, 0xe3, 0xa8, 0x48, 0xe6, 0x1c, 0x63, 0xc1, 0x56, 0xce, 0x29, 0xd8,
-	0x99, 0xb1, 0x48, 0xa1, 0x6e, 0x0a, 0xd4, 0xf9, 0x9f, 0xef, 0x53, 0x19, 0x49, 0x26, 0xab, 0x8c,
-	0x87, 0x50, 0x06, 0x17, 0xb6, 0xe3, 0xc2, 0xad, 0x7a, 0x28, 0xb6, 0xcc, 0x50, 0xd4, 0xaf, 0xc3,
-	0x7f, 0x2c, 0xd8, 0xa9, 0xae, 0x62, 0x02, 0xdd, 0x75, 0x19, 0xae, 0x7c, 0x13, 0x1f, 0xde, 0xb8,
-	0xe7, 0xd5, 0x0b, 0x0d, 0x3b, 0x78, 0xf5, 0x82, 0xcf, 0xa0, 0x4d, 0xcd, 0xa9, 0xca, 0x74, 0xdc,
-	0x2d, 0x23, 0xf9, 0xe1, 0x8d, 0x92, 0x9b, 0x65, 0x08, 0x6d, 0x13, 0x5e, 0xd5, 0xe4, 0x53, 0x78,
-	0xbb, 0xea, 0x84, 0x84, 0xea, 0x5c, 0x0a, 0xbd, 0x22, 0xd3, 0x18, 0xd9, 0x42, 0x99, 0x7e, 0x68,
-	0x86, 0x77, 0x4b, 0xef, 0x57, 0x95, 0xf3, 0xc4, 0xf8, 0x3e, 0x6b, 0xfd, 0xfc, 0xeb, 0x41, 0xe3,
-	0xc1, 0x43, 0xb0, 0x37, 0xea, 0xe3, 0x00, 0xec, 0xcc, 0x24, 0xe6, 0xd9, 0xc7, 0xbd, 0xc6, 0xda,
-	0x3e, 0xea, 0x59, 0xfd, 0xd6, 0x1f, 0xbf, 0x7b, 0xd6, 0xc9, 0xe9, 0xb3, 0x0b, 0xcf, 0x7a, 0x7e,
-	0xe1, 0x59, 0x7f, 0x5f, 0x78, 0xd6, 0x4f, 0x97, 0x5e, 0xe3, 0xf9, 0xa5, 0xd7, 0xf8, 0xf3, 0xd2,
-	0x6b, 0x7c, 0x17, 0x6c, 0x34, 0x42, 0x91, 0xfa, 0xa1, 0x39, 0x45, 0x50, 0x9f, 0x22, 0x58, 0xae,
-	0xff, 0x3d, 0x65, 0x57, 0x4c, 0x77, 0xcc, 0x2f, 0xe8, 0x93, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0xaf, 0x0f, 0xcf, 0xef, 0xfc, 0x06, 0x00, 0x00,
+	// 1010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x9b, 0xf4, 0x63, 0x27, 0x69, 0x9b, 0x7a, 0xdb, 0x5d, 0xd3, 0x8a, 0x34, 0x04, 0x09,
+	0x85, 0xad, 0x9a, 0x40, 0xd9, 0x13, 0x82, 0x43, 0x3f, 0x84, 0x28, 0x74, 0xb5, 0x95, 0x1b, 0xb4,
+	0x82, 0x03, 0xa3, 0xc9, 0x78, 0x6a, 0x0f, 0xb1, 0x3d, 0xd6, 0xcc, 0xb8, 0x4d, 0xf9, 0x11, 0x88,
+	0xe3, 0x4a, 0x5c, 0x38, 0x70, 0xe0, 0xa7, 0xec, 0x71, 0x8f, 0xc0, 0x61, 0x85, 0xda, 0x3f, 0x82,
+	0xe6, 0xc3, 0x6e, 0xda, 0xac, 0x8a, 0x54, 0x69, 0x4f, 0x19, 0xcf, 0xf3, 0xbc, 0xcf, 0xbc, 0xf3,
+	0xbe, 0xcf, 0xeb, 0x18, 0xac, 0xb1, 0xa1, 0x20, 0xfc, 0x8c, 0xf0, 0x7e, 0x86, 0x38, 0x4a, 0x44,
+	0x2f, 0xe3, 0x4c, 0x32, 0x77, 0xe3, 0x67, 0x22, 0x11, 0x8e, 0x10, 0x4d, 0x7b, 0x7a, 0xc5, 0x38,
+	0xe9, 0x15, 0xcc, 0xf5, 0x87, 0x98, 0x25, 0x09, 0x4b, 0xfb, 0xe6, 0xc7, 0x44, 0xac, 0xaf, 0x86,
+	0x2c, 0x64, 0x7a, 0xd9, 0x57, 0x2b, 0xbb, 0xfb, 0xb8, 0x94, 0x2f, 0x16, 0x06, 0xe8, 0xfc, 0x08,
+	0x96, 0xf7, 0x95, 0xfc, 0xb1, 0x3e, 0xf5, 0x88, 0x0a, 0xe9, 0x7e, 0x0b, 0x1a, 0xfa, 0x44, 0x68,
+	0x32, 0xf1, 0x9c, 0x76, 0xb5, 0x5b, 0xdf, 0xe9, 0xf6, 0xee, 0x48, 0xa5, 0x37, 0xa1, 0xe1, 0xd7,
+	0xf1, 0xf5, 0x43, 0xe7, 0xe5, 0x3c, 0xa8, 0x4f, 0x80, 0xee, 0x7b, 0x60, 0xc1, 0x88, 0xd3, 0xc0,
+	0xab, 0xb7, 0x9d, 0x6e, 0xd5, 0x9f, 0xd7, 0xcf, 0x87, 0x81, 0xbb, 0x0d, 0x5c, 0xcc, 0xd2, 0x53,
+	0xca, 0x13, 0x24, 0x29, 0x4b, 0x21, 0x66, 0x79, 0x2a, 0x3d, 0xa7, 0xed, 0x74, 0x6b, 0xfe, 0xca,
+	0x24, 0xb2, 0xaf, 0x00, 0xb7, 0x0b, 0x9a, 0x21, 0x12, 0x30, 0xe3, 0x14, 0x13, 0x28, 0x29, 0x1e,
+	0x11, 0xee, 0xcd, 0x68, 0xf2, 0x52, 0x88, 0xc4, 0xb1, 0xda, 0x1e, 0xe8, 0x5d, 0xb7, 0x0d, 0x1a,
+	0x34, 0x85, 0x72, 0x5c, 0xb0, 0xaa, 0x9a, 0x05, 0x68, 0x3a, 0x18, 0x5b, 0x46, 0x07, 0x2c, 0xb2,
+	0x5c, 0x4e, 0x50, 0x6a, 0x9a, 0x52, 0x67, 0xb9, 0x2c, 0x39, 0x4f, 0xc0, 0xca, 0x39, 0x92, 0x38,
+	0x82, 0xb9, 0x1c, 0xb3, 0x82, 0x37, 0xab, 0x79, 0xcb, 0x1a, 0xf8, 0x4e, 0x8e, 0x99, 0xe5, 0x7e,
+	0x09, 0x74, 0xe3, 0xa0, 0x64, 0x23, 0xa2, 0x2e, 0x92, 0x4a, 0x8e, 0xb0, 0x84, 0x28, 0x08, 0x38,
+	0x11, 0xc2, 0x5b, 0x68, 0x3b, 0xdd, 0x07, 0xbe, 0xa7, 0x28, 0x03, 0xc5, 0xd8, 0xb7, 0x84, 0x5d,
+	0x83, 0xbb, 0x5f, 0x80, 0x75, 0xcc, 0xd2, 0x94, 0x60, 0xc9, 0xf8, 0x74, 0xf4, 0x03, 0x13, 0x5d,
+	0x32, 0x6e, 0x47, 0xef, 0x83, 0x16, 0xe1, 0x78, 0xe7, 0x13, 0x88, 0x73, 0x21, 0x59, 0x70, 0x31,
+	0xad, 0x00, 0xb4, 0xc2, 0x86, 0x66, 0xed, 0x1b, 0xd2, 0x6d, 0x91, 0x5d, 0xf0, 0x3e, 0xcb, 0xe5,
+	0x90, 0xe5, 0x69, 0xa0, 0xca, 0x22, 0x70, 0x44, 0x82, 0x3c, 0x26, 0x90, 0xa6, 0x92, 0xf0, 0x33,
+	0x14, 0x7b, 0x0d, 0xdd, 0xbc, 0xf5, 0x82, 0x34, 0x18, 0x9f, 0x58, 0xca, 0xa1, 0x65, 0xa8, 0x3c,
+	0xde, 0x2a, 0x11, 0x33, 0x36, 0x42, 0x11, 0x41, 0x81, 0xb7, 0xa8, 0x35, 0x36, 0xa6, 0x35, 0x8e,
+	0x0a, 0x8a, 0xfb, 0x3d, 0x68, 0x0e, 0x51, 0x1c, 0x33, 0x09, 0x65, 0xc4, 0x89, 0x88, 0x58, 0x1c,
+	0x78, 0x4b, 0x2a, 0xfd, 0xbd, 0xde, 0xab, 0x37, 0x9b, 0x95, 0x7f, 0xde, 0x6c, 0x7e, 0x14, 0x52,
+	0x19, 0xe5, 0xc3, 0x1e, 0x66, 0x49, 0x1f, 0x33, 0x91, 0x30, 0x61, 0x7f, 0xb6, 0x45, 0x30, 0xea,
+	0xcb, 0x8b, 0x8c, 0x88, 0xde, 0x01, 0xc1, 0xfe, 0xb2, 0xd1, 0x19, 0x14, 0x32, 0xee, 0x29, 0x78,
+	0x9c, 0xd0, 0x14, 0x16, 0x1e, 0x86, 0x01, 0x89, 0x49, 0xa8, 0x0d, 0xe6, 0x2d, 0xdf, 0xeb, 0x84,
+	0xb5, 0x84, 0xa6, 0xcf, 0xad, 0xda, 0x41, 0x29, 0xe6, 0x7e, 0x00, 0x1a, 0x54, 0x40, 0x91, 0x67,
+	0x19, 0xe3, 0x92, 0x04, 0x5e, 0xb3, 0xed, 0x74, 0x17, 0xfc, 0x3a, 0x15, 0x27, 0xc5, 0x96, 0xf2,
+	0x4b, 0x82, 0xc6, 0xb0, 0x2c, 0x57, 0x46, 0xd2, 0x80, 0xa6, 0x21, 0x1c, 0xc6, 0x0c, 0x8f, 0x84,
+	0xb7, 0xa2, 0x5d, 0xe6, 0x25, 0x68, 0xfc, 0xdc, 0x32, 0x8e, 0x0d, 0x61, 0x4f, 0xe3, 0xee, 0x53,
+	0xf0, 0x48, 0x48, 0x34, 0x22, 0xf0, 0x9c, 0xd0, 0x30, 0x92, 0x24, 0x80, 0xe6, 0xae, 0xc2, 0x73,
+	0xf5, 0x59, 0xab, 0x1a, 0x7d, 0x61, 0xc1, 0x3d, 0x83, 0x75, 0x7e, 0x71, 0x80, 0x6b, 0x75, 0x26,
+	0x27, 0x74, 0x7a, 0xfc, 0x9d, 0x7b, 0x8f, 0xbf, 0xbb, 0x05, 0x56, 0x10, 0x96, 0xf4, 0xcc, 0x4c,
+	0x74, 0xa4, 0x33, 0xd0, 0x53, 0x5a, 0xf5, 0x9b, 0xd7, 0xc0, 0xd7, 0x7a, 0xbf, 0xf3, 0xdb, 0x0c,
+	0x58, 0x2a, 0xea, 0x67, 0xe3, 0x3f, 0x04, 0xb3, 0x5a, 0xce, 0x66, 0xb1, 0xd8, 0xb3, 0xef, 0x3a,
+	0x7d, 0xa0, 0x6f, 0xb0, 0xb7, 0x7a, 0xa4, 0xfa, 0xce, 0x3d, 0x52, 0x7b, 0x97, 0x1e, 0x99, 0x9d,
+	0xf2, 0x48, 0x47, 0x80, 0xc6, 0x6e, 0xa0, 0x92, 0x39, 0x66, 0x31, 0xc5, 0x17, 0xee, 0x21, 0xa8,
+	0x67, 0x7a, 0x05, 0x95, 0xba, 0x2e, 0xd0, 0xd2, 0xff, 0xb4, 0xc9, 0x44, 0xc2, 0xc1, 0x45, 0x46,
+	0x7c, 0x60, 0x82, 0xd5, 0xda, 0xf5, 0xc0, 0x7c, 0xf1, 0x6a, 0x98, 0xd1, 0xaf, 0x86, 0xe2, 0xb1,
+	0xf3, 0x77, 0x0d, 0xcc, 0xd9, 0x56, 0x0c, 0xc0, 0x72, 0x59, 0x86, 0x1b, 0xff, 0x0c, 0x5b, 0x77,
+	0x9e, 0x79, 0xb3, 0xa1, 0xfe, 0x12, 0xbb, 0xd9, 0xe0, 0x23, 0xd0, 0x40, 0xfa, 0x56, 0x26, 0x1d,
+	0x6f, 0x46, 0x4b, 0x7e, 0x7c, 0xa7, 0xe4, 0x64, 0x19, 0xfc, 0xba, 0x0e, 0xb7, 0x35, 0x79, 0x0a,
+	0x1e, 0x59, 0x27, 0x24, 0x48, 0xe6, 0x9c, 0xca, 0x8b, 0x62, 0x84, 0xaa, 0xda, 0x73, 0xab, 0x06,
+	0x7d, 0x66, 0x41, 0x3b, 0x3e, 0x3b, 0x60, 0x2d, 0xa1, 0x42, 0x5c, 0x8f, 0x0d, 0x3c, 0xa7, 0x69,
+	0xc0, 0xce, 0x75, 0x8b, 0xab, 0xfe, 0x43, 0x03, 0xda, 0xb1, 0x79, 0xa1, 0x21, 0x37, 0x04, 0x6a,
+	0x1c, 0xe1, 0xad, 0x38, 0xae, 0xba, 0xe9, 0xcd, 0xde, 0xd3, 0x19, 0x68, 0xfc, 0x6c, 0xf2, 0x24,
+	0x5f, 0x89, 0xa9, 0x2b, 0x95, 0x65, 0xff, 0x09, 0xd1, 0x18, 0x06, 0x39, 0x37, 0x06, 0x9c, 0x33,
+	0x57, 0x2a, 0xd0, 0x6f, 0x10, 0x8d, 0x0f, 0x2c, 0xa6, 0x7c, 0x5b, 0x46, 0x89, 0x18, 0x89, 0x08,
+	0x9e, 0xaa, 0xb7, 0xbb, 0x0a, 0x9b, 0xbf, 0x5f, 0x76, 0x85, 0xdc, 0x89, 0x52, 0xfb, 0xca, 0x8a,
+	0xe9, 0x82, 0xc7, 0x28, 0x21, 0x90, 0x13, 0x49, 0x52, 0xb5, 0x55, 0x14, 0x7c, 0xc1, 0x16, 0x5c,
+	0xa1, 0x7e, 0x01, 0x9a, 0x82, 0x7f, 0x5e, 0x7b, 0xf9, 0xfb, 0x66, 0xe5, 0xc9, 0x16, 0xa8, 0x4f,
+	0x18, 0xd2, 0x05, 0x60, 0x2e, 0xe4, 0x2c, 0xcf, 0x3e, 0x6d, 0x56, 0xca, 0xf5, 0x4e, 0xd3, 0x59,
+	0xaf, 0xfd, 0xf9, 0x47, 0xcb, 0xd9, 0x3b, 0x7c, 0x75, 0xd9, 0x72, 0x5e, 0x5f, 0xb6, 0x9c, 0x7f,
+	0x2f, 0x5b, 0xce, 0xaf, 0x57, 0xad, 0xca, 0xeb, 0xab, 0x56, 0xe5, 0xaf, 0xab, 0x56, 0xe5, 0x87,
+	0xfe, 0xc4, 0x0d, 0x94, 0x57, 0xb6, 0xb5, 0x6d, 0xfa, 0x85, 0x6d, 0xfa, 0xe3, 0xf2, 0x93, 0xc7,
+	0x5c, 0x67, 0x38, 0xa7, 0xbf, 0x7c, 0x3e, 0xfb, 0x6f, 0x00, 0x4a, 0xae, 0x4d, 0x53, 0x73, 0x09,
+	0x00, 0x00,
 }
 
 func (m *ChainParamsList) Marshal() (dAtA []byte, err error) {
@@ -523,6 +642,25 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.StakeWeightedBallots {
+		i--
+		if m.StakeWeightedBallots {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxOutboundPendingBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOutboundPendingBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.IsSupported {
 		i--
 		if m.IsSupported {
@@ -619,6 +757,46 @@ func (m *ChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingChainParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingChainParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainParams != nil {
+		{
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObserverParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -739,6 +917,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlameRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlameRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.ObserverSlashFraction.Size()
+		i -= size
+		if _, err := m.ObserverSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.ObserverJailDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ObserverJailDuration))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxMissedBallotsRatio.Size()
+		i -= size
+		if _, err := m.MaxMissedBallotsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MissedBallotsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MissedBallotsWindow))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.BallotMaturityBlocks != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BallotMaturityBlocks))
 		i--
@@ -850,6 +1063,28 @@ func (m *ChainParams) Size() (n int) {
 	if m.IsSupported {
 		n += 3
 	}
+	if m.MaxOutboundPendingBlocks != 0 {
+		n += 2 + sovParams(uint64(m.MaxOutboundPendingBlocks))
+	}
+	if m.StakeWeightedBallots {
+		n += 3
+	}
+	return n
+}
+
+func (m *PendingChainParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParams(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -910,6 +1145,19 @@ func (m *Params) Size() (n int) {
 	if m.BallotMaturityBlocks != 0 {
 		n += 1 + sovParams(uint64(m.BallotMaturityBlocks))
 	}
+	if m.MissedBallotsWindow != 0 {
+		n += 1 + sovParams(uint64(m.MissedBallotsWindow))
+	}
+	l = m.MaxMissedBallotsRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ObserverJailDuration != 0 {
+		n += 1 + sovParams(uint64(m.ObserverJailDuration))
+	}
+	l = m.ObserverSlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BlameRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.BlameRetentionBlocks))
+	}
 	return n
 }
 
@@ -1368,6 +1616,150 @@ func (m *ChainParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsSupported = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutboundPendingBlocks", wireType)
+			}
+			m.MaxOutboundPendingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutboundPendingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeWeightedBallots", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StakeWeightedBallots = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1780,6 +2172,131 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBallotsWindow", wireType)
+			}
+			m.MissedBallotsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBallotsWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissedBallotsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMissedBallotsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverJailDuration", wireType)
+			}
+			m.ObserverJailDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObserverJailDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObserverSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameRetentionBlocks", wireType)
+			}
+			m.BlameRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlameRetentionBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
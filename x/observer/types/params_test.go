@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zeta-chain/zetacore/x/observer/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	t.Run("default params are valid", func(t *testing.T) {
+		params := types.DefaultParams()
+		require.NoError(t, params.Validate())
+		require.True(t, params.IsLivenessEnabled())
+	})
+
+	t.Run("params without liveness policy are valid", func(t *testing.T) {
+		params := types.Params{BallotMaturityBlocks: 100}
+		require.NoError(t, params.Validate())
+		require.False(t, params.IsLivenessEnabled())
+	})
+
+	t.Run("invalid liveness params", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.MissedBallotsWindow = -1
+		require.ErrorIs(t, params.Validate(), types.ErrParamsLiveness)
+
+		params = types.DefaultParams()
+		params.ObserverJailDuration = -1
+		require.ErrorIs(t, params.Validate(), types.ErrParamsLiveness)
+
+		params = types.DefaultParams()
+		params.MaxMissedBallotsRatio = sdk.MustNewDecFromStr("1.1")
+		require.ErrorIs(t, params.Validate(), types.ErrParamsLiveness)
+
+		params = types.DefaultParams()
+		params.ObserverSlashFraction = sdk.MustNewDecFromStr("-0.1")
+		require.ErrorIs(t, params.Validate(), types.ErrParamsLiveness)
+
+		params = types.DefaultParams()
+		params.ObserverSlashFraction = sdk.Dec{}
+		require.ErrorIs(t, params.Validate(), types.ErrParamsLiveness)
+	})
+
+	t.Run("invalid blame retention period", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.BlameRetentionBlocks = -1
+		require.ErrorIs(t, params.Validate(), types.ErrParamsBlameRetention)
+	})
+}
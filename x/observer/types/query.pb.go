@@ -32,6 +32,119 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryObserverPerformanceRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
+	// chain to get the counters for, 0 for all chains
+	ChainId    int64              `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryObserverPerformanceRequest) Reset()         { *m = QueryObserverPerformanceRequest{} }
+func (m *QueryObserverPerformanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverPerformanceRequest) ProtoMessage()    {}
+func (*QueryObserverPerformanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{0}
+}
+func (m *QueryObserverPerformanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverPerformanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverPerformanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserverPerformanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverPerformanceRequest.Merge(m, src)
+}
+func (m *QueryObserverPerformanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverPerformanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverPerformanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserverPerformanceRequest proto.InternalMessageInfo
+
+func (m *QueryObserverPerformanceRequest) GetObserverAddress() string {
+	if m != nil {
+		return m.ObserverAddress
+	}
+	return ""
+}
+
+func (m *QueryObserverPerformanceRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryObserverPerformanceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryObserverPerformanceResponse struct {
+	ObserverPerformances []ObserverPerformance `protobuf:"bytes,1,rep,name=observer_performances,json=observerPerformances,proto3" json:"observer_performances"`
+	Pagination           *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryObserverPerformanceResponse) Reset()         { *m = QueryObserverPerformanceResponse{} }
+func (m *QueryObserverPerformanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverPerformanceResponse) ProtoMessage()    {}
+func (*QueryObserverPerformanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{1}
+}
+func (m *QueryObserverPerformanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserverPerformanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserverPerformanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserverPerformanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverPerformanceResponse.Merge(m, src)
+}
+func (m *QueryObserverPerformanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserverPerformanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverPerformanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserverPerformanceResponse proto.InternalMessageInfo
+
+func (m *QueryObserverPerformanceResponse) GetObserverPerformances() []ObserverPerformance {
+	if m != nil {
+		return m.ObserverPerformances
+	}
+	return nil
+}
+
+func (m *QueryObserverPerformanceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryGetChainNoncesRequest struct {
 	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
@@ -40,7 +153,7 @@ func (m *QueryGetChainNoncesRequest) Reset()         { *m = QueryGetChainNoncesR
 func (m *QueryGetChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesRequest) ProtoMessage()    {}
 func (*QueryGetChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{0}
+	return fileDescriptor_dcb801e455adaee4, []int{2}
 }
 func (m *QueryGetChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -84,7 +197,7 @@ func (m *QueryGetChainNoncesResponse) Reset()         { *m = QueryGetChainNonces
 func (m *QueryGetChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetChainNoncesResponse) ProtoMessage()    {}
 func (*QueryGetChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{1}
+	return fileDescriptor_dcb801e455adaee4, []int{3}
 }
 func (m *QueryGetChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -128,7 +241,7 @@ func (m *QueryAllChainNoncesRequest) Reset()         { *m = QueryAllChainNoncesR
 func (m *QueryAllChainNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesRequest) ProtoMessage()    {}
 func (*QueryAllChainNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{2}
+	return fileDescriptor_dcb801e455adaee4, []int{4}
 }
 func (m *QueryAllChainNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -173,7 +286,7 @@ func (m *QueryAllChainNoncesResponse) Reset()         { *m = QueryAllChainNonces
 func (m *QueryAllChainNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllChainNoncesResponse) ProtoMessage()    {}
 func (*QueryAllChainNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{3}
+	return fileDescriptor_dcb801e455adaee4, []int{5}
 }
 func (m *QueryAllChainNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +337,7 @@ func (m *QueryAllPendingNoncesRequest) Reset()         { *m = QueryAllPendingNon
 func (m *QueryAllPendingNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesRequest) ProtoMessage()    {}
 func (*QueryAllPendingNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{4}
+	return fileDescriptor_dcb801e455adaee4, []int{6}
 }
 func (m *QueryAllPendingNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,7 +382,7 @@ func (m *QueryAllPendingNoncesResponse) Reset()         { *m = QueryAllPendingNo
 func (m *QueryAllPendingNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPendingNoncesResponse) ProtoMessage()    {}
 func (*QueryAllPendingNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{5}
+	return fileDescriptor_dcb801e455adaee4, []int{7}
 }
 func (m *QueryAllPendingNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +433,7 @@ func (m *QueryPendingNoncesByChainRequest) Reset()         { *m = QueryPendingNo
 func (m *QueryPendingNoncesByChainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainRequest) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{6}
+	return fileDescriptor_dcb801e455adaee4, []int{8}
 }
 func (m *QueryPendingNoncesByChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,7 +477,7 @@ func (m *QueryPendingNoncesByChainResponse) Reset()         { *m = QueryPendingN
 func (m *QueryPendingNoncesByChainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPendingNoncesByChainResponse) ProtoMessage()    {}
 func (*QueryPendingNoncesByChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{7}
+	return fileDescriptor_dcb801e455adaee4, []int{9}
 }
 func (m *QueryPendingNoncesByChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -407,7 +520,7 @@ func (m *QueryGetTSSRequest) Reset()         { *m = QueryGetTSSRequest{} }
 func (m *QueryGetTSSRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSRequest) ProtoMessage()    {}
 func (*QueryGetTSSRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{8}
+	return fileDescriptor_dcb801e455adaee4, []int{10}
 }
 func (m *QueryGetTSSRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +557,7 @@ func (m *QueryGetTSSResponse) Reset()         { *m = QueryGetTSSResponse{} }
 func (m *QueryGetTSSResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTSSResponse) ProtoMessage()    {}
 func (*QueryGetTSSResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{9}
+	return fileDescriptor_dcb801e455adaee4, []int{11}
 }
 func (m *QueryGetTSSResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -488,7 +601,7 @@ func (m *QueryGetTssAddressRequest) Reset()         { *m = QueryGetTssAddressReq
 func (m *QueryGetTssAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressRequest) ProtoMessage()    {}
 func (*QueryGetTssAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{10}
+	return fileDescriptor_dcb801e455adaee4, []int{12}
 }
 func (m *QueryGetTssAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,7 +646,7 @@ func (m *QueryGetTssAddressResponse) Reset()         { *m = QueryGetTssAddressRe
 func (m *QueryGetTssAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTssAddressResponse) ProtoMessage()    {}
 func (*QueryGetTssAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{11}
+	return fileDescriptor_dcb801e455adaee4, []int{13}
 }
 func (m *QueryGetTssAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -589,7 +702,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightRequest) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{12}
+	return fileDescriptor_dcb801e455adaee4, []int{14}
 }
 func (m *QueryGetTssAddressByFinalizedHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -645,7 +758,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) String() string {
 }
 func (*QueryGetTssAddressByFinalizedHeightResponse) ProtoMessage() {}
 func (*QueryGetTssAddressByFinalizedHeightResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{13}
+	return fileDescriptor_dcb801e455adaee4, []int{15}
 }
 func (m *QueryGetTssAddressByFinalizedHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +809,7 @@ func (m *QueryTssHistoryRequest) Reset()         { *m = QueryTssHistoryRequest{}
 func (m *QueryTssHistoryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryRequest) ProtoMessage()    {}
 func (*QueryTssHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{14}
+	return fileDescriptor_dcb801e455adaee4, []int{16}
 }
 func (m *QueryTssHistoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +854,7 @@ func (m *QueryTssHistoryResponse) Reset()         { *m = QueryTssHistoryResponse
 func (m *QueryTssHistoryResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTssHistoryResponse) ProtoMessage()    {}
 func (*QueryTssHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{15}
+	return fileDescriptor_dcb801e455adaee4, []int{17}
 }
 func (m *QueryTssHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -796,7 +909,7 @@ func (m *QueryProveRequest) Reset()         { *m = QueryProveRequest{} }
 func (m *QueryProveRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryProveRequest) ProtoMessage()    {}
 func (*QueryProveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{16}
+	return fileDescriptor_dcb801e455adaee4, []int{18}
 }
 func (m *QueryProveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +981,7 @@ func (m *QueryProveResponse) Reset()         { *m = QueryProveResponse{} }
 func (m *QueryProveResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProveResponse) ProtoMessage()    {}
 func (*QueryProveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{17}
+	return fileDescriptor_dcb801e455adaee4, []int{19}
 }
 func (m *QueryProveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -911,7 +1024,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{18}
+	return fileDescriptor_dcb801e455adaee4, []int{20}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1063,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{19}
+	return fileDescriptor_dcb801e455adaee4, []int{21}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,7 +1108,7 @@ func (m *QueryHasVotedRequest) Reset()         { *m = QueryHasVotedRequest{} }
 func (m *QueryHasVotedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedRequest) ProtoMessage()    {}
 func (*QueryHasVotedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{20}
+	return fileDescriptor_dcb801e455adaee4, []int{22}
 }
 func (m *QueryHasVotedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1046,7 +1159,7 @@ func (m *QueryHasVotedResponse) Reset()         { *m = QueryHasVotedResponse{} }
 func (m *QueryHasVotedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryHasVotedResponse) ProtoMessage()    {}
 func (*QueryHasVotedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{21}
+	return fileDescriptor_dcb801e455adaee4, []int{23}
 }
 func (m *QueryHasVotedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1090,7 +1203,7 @@ func (m *QueryBallotByIdentifierRequest) Reset()         { *m = QueryBallotByIde
 func (m *QueryBallotByIdentifierRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierRequest) ProtoMessage()    {}
 func (*QueryBallotByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{22}
+	return fileDescriptor_dcb801e455adaee4, []int{24}
 }
 func (m *QueryBallotByIdentifierRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1135,7 +1248,7 @@ func (m *VoterList) Reset()         { *m = VoterList{} }
 func (m *VoterList) String() string { return proto.CompactTextString(m) }
 func (*VoterList) ProtoMessage()    {}
 func (*VoterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{23}
+	return fileDescriptor_dcb801e455adaee4, []int{25}
 }
 func (m *VoterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1189,7 +1302,7 @@ func (m *QueryBallotByIdentifierResponse) Reset()         { *m = QueryBallotById
 func (m *QueryBallotByIdentifierResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBallotByIdentifierResponse) ProtoMessage()    {}
 func (*QueryBallotByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{24}
+	return fileDescriptor_dcb801e455adaee4, []int{26}
 }
 func (m *QueryBallotByIdentifierResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,21 +1359,25 @@ func (m *QueryBallotByIdentifierResponse) GetBallotStatus() BallotStatus {
 	return BallotStatus_BallotFinalized_SuccessObservation
 }
 
-type QueryObserverSet struct {
+// max_height is the current height if zero, the ballots are returned in the order of their creation
+type QueryBallotsByHeightRequest struct {
+	MinHeight  int64              `protobuf:"varint,1,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	MaxHeight  int64              `protobuf:"varint,2,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryObserverSet) Reset()         { *m = QueryObserverSet{} }
-func (m *QueryObserverSet) String() string { return proto.CompactTextString(m) }
-func (*QueryObserverSet) ProtoMessage()    {}
-func (*QueryObserverSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{25}
+func (m *QueryBallotsByHeightRequest) Reset()         { *m = QueryBallotsByHeightRequest{} }
+func (m *QueryBallotsByHeightRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotsByHeightRequest) ProtoMessage()    {}
+func (*QueryBallotsByHeightRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{27}
 }
-func (m *QueryObserverSet) XXX_Unmarshal(b []byte) error {
+func (m *QueryBallotsByHeightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryObserverSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBallotsByHeightRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryObserverSet.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBallotsByHeightRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1270,34 +1387,56 @@ func (m *QueryObserverSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *QueryObserverSet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryObserverSet.Merge(m, src)
+func (m *QueryBallotsByHeightRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotsByHeightRequest.Merge(m, src)
 }
-func (m *QueryObserverSet) XXX_Size() int {
+func (m *QueryBallotsByHeightRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryObserverSet) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryObserverSet.DiscardUnknown(m)
+func (m *QueryBallotsByHeightRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotsByHeightRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryObserverSet proto.InternalMessageInfo
+var xxx_messageInfo_QueryBallotsByHeightRequest proto.InternalMessageInfo
 
-type QueryObserverSetResponse struct {
-	Observers []string `protobuf:"bytes,1,rep,name=observers,proto3" json:"observers,omitempty"`
+func (m *QueryBallotsByHeightRequest) GetMinHeight() int64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
 }
 
-func (m *QueryObserverSetResponse) Reset()         { *m = QueryObserverSetResponse{} }
-func (m *QueryObserverSetResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryObserverSetResponse) ProtoMessage()    {}
-func (*QueryObserverSetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{26}
+func (m *QueryBallotsByHeightRequest) GetMaxHeight() int64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
 }
-func (m *QueryObserverSetResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBallotsByHeightRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBallotsByHeightResponse struct {
+	Ballots    []Ballot            `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBallotsByHeightResponse) Reset()         { *m = QueryBallotsByHeightResponse{} }
+func (m *QueryBallotsByHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotsByHeightResponse) ProtoMessage()    {}
+func (*QueryBallotsByHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{28}
+}
+func (m *QueryBallotsByHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryObserverSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBallotsByHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryObserverSetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBallotsByHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1307,40 +1446,50 @@ func (m *QueryObserverSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryObserverSetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryObserverSetResponse.Merge(m, src)
+func (m *QueryBallotsByHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotsByHeightResponse.Merge(m, src)
 }
-func (m *QueryObserverSetResponse) XXX_Size() int {
+func (m *QueryBallotsByHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryObserverSetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryObserverSetResponse.DiscardUnknown(m)
+func (m *QueryBallotsByHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotsByHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryObserverSetResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBallotsByHeightResponse proto.InternalMessageInfo
 
-func (m *QueryObserverSetResponse) GetObservers() []string {
+func (m *QueryBallotsByHeightResponse) GetBallots() []Ballot {
 	if m != nil {
-		return m.Observers
+		return m.Ballots
 	}
 	return nil
 }
 
-type QuerySupportedChains struct {
+func (m *QueryBallotsByHeightResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QuerySupportedChains) Reset()         { *m = QuerySupportedChains{} }
-func (m *QuerySupportedChains) String() string { return proto.CompactTextString(m) }
-func (*QuerySupportedChains) ProtoMessage()    {}
-func (*QuerySupportedChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{27}
+type QueryBallotsByVoterRequest struct {
+	VoterAddress string             `protobuf:"bytes,1,opt,name=voter_address,json=voterAddress,proto3" json:"voter_address,omitempty"`
+	HasVoted     bool               `protobuf:"varint,2,opt,name=has_voted,json=hasVoted,proto3" json:"has_voted,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QuerySupportedChains) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBallotsByVoterRequest) Reset()         { *m = QueryBallotsByVoterRequest{} }
+func (m *QueryBallotsByVoterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotsByVoterRequest) ProtoMessage()    {}
+func (*QueryBallotsByVoterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{29}
+}
+func (m *QueryBallotsByVoterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySupportedChains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBallotsByVoterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySupportedChains.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBallotsByVoterRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1350,34 +1499,56 @@ func (m *QuerySupportedChains) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QuerySupportedChains) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySupportedChains.Merge(m, src)
+func (m *QueryBallotsByVoterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotsByVoterRequest.Merge(m, src)
 }
-func (m *QuerySupportedChains) XXX_Size() int {
+func (m *QueryBallotsByVoterRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySupportedChains) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySupportedChains.DiscardUnknown(m)
+func (m *QueryBallotsByVoterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotsByVoterRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySupportedChains proto.InternalMessageInfo
+var xxx_messageInfo_QueryBallotsByVoterRequest proto.InternalMessageInfo
 
-type QuerySupportedChainsResponse struct {
-	Chains []*common.Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+func (m *QueryBallotsByVoterRequest) GetVoterAddress() string {
+	if m != nil {
+		return m.VoterAddress
+	}
+	return ""
 }
 
-func (m *QuerySupportedChainsResponse) Reset()         { *m = QuerySupportedChainsResponse{} }
-func (m *QuerySupportedChainsResponse) String() string { return proto.CompactTextString(m) }
-func (*QuerySupportedChainsResponse) ProtoMessage()    {}
-func (*QuerySupportedChainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{28}
+func (m *QueryBallotsByVoterRequest) GetHasVoted() bool {
+	if m != nil {
+		return m.HasVoted
+	}
+	return false
 }
-func (m *QuerySupportedChainsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBallotsByVoterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBallotsByVoterResponse struct {
+	Ballots    []Ballot            `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBallotsByVoterResponse) Reset()         { *m = QueryBallotsByVoterResponse{} }
+func (m *QueryBallotsByVoterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotsByVoterResponse) ProtoMessage()    {}
+func (*QueryBallotsByVoterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{30}
+}
+func (m *QueryBallotsByVoterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QuerySupportedChainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBallotsByVoterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QuerySupportedChainsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBallotsByVoterResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1387,41 +1558,51 @@ func (m *QuerySupportedChainsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QuerySupportedChainsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySupportedChainsResponse.Merge(m, src)
+func (m *QueryBallotsByVoterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotsByVoterResponse.Merge(m, src)
 }
-func (m *QuerySupportedChainsResponse) XXX_Size() int {
+func (m *QueryBallotsByVoterResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QuerySupportedChainsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySupportedChainsResponse.DiscardUnknown(m)
+func (m *QueryBallotsByVoterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotsByVoterResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QuerySupportedChainsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBallotsByVoterResponse proto.InternalMessageInfo
 
-func (m *QuerySupportedChainsResponse) GetChains() []*common.Chain {
+func (m *QueryBallotsByVoterResponse) GetBallots() []Ballot {
 	if m != nil {
-		return m.Chains
+		return m.Ballots
 	}
 	return nil
 }
 
-type QueryGetChainParamsForChainRequest struct {
-	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (m *QueryBallotsByVoterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetChainParamsForChainRequest) Reset()         { *m = QueryGetChainParamsForChainRequest{} }
-func (m *QueryGetChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsForChainRequest) ProtoMessage()    {}
-func (*QueryGetChainParamsForChainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{29}
+// all the observation types are returned if observation_type is EmptyObserverType
+type QueryBallotsByStatusRequest struct {
+	BallotStatus    BallotStatus       `protobuf:"varint,1,opt,name=ballot_status,json=ballotStatus,proto3,enum=zetachain.zetacore.observer.BallotStatus" json:"ballot_status,omitempty"`
+	ObservationType ObservationType    `protobuf:"varint,2,opt,name=observation_type,json=observationType,proto3,enum=zetachain.zetacore.observer.ObservationType" json:"observation_type,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBallotsByStatusRequest) Reset()         { *m = QueryBallotsByStatusRequest{} }
+func (m *QueryBallotsByStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotsByStatusRequest) ProtoMessage()    {}
+func (*QueryBallotsByStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{31}
+}
+func (m *QueryBallotsByStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBallotsByStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsForChainRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBallotsByStatusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1431,41 +1612,56 @@ func (m *QueryGetChainParamsForChainRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsForChainRequest.Merge(m, src)
+func (m *QueryBallotsByStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotsByStatusRequest.Merge(m, src)
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_Size() int {
+func (m *QueryBallotsByStatusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsForChainRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsForChainRequest.DiscardUnknown(m)
+func (m *QueryBallotsByStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotsByStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsForChainRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBallotsByStatusRequest proto.InternalMessageInfo
 
-func (m *QueryGetChainParamsForChainRequest) GetChainId() int64 {
+func (m *QueryBallotsByStatusRequest) GetBallotStatus() BallotStatus {
 	if m != nil {
-		return m.ChainId
+		return m.BallotStatus
 	}
-	return 0
+	return BallotStatus_BallotFinalized_SuccessObservation
 }
 
-type QueryGetChainParamsForChainResponse struct {
-	ChainParams *ChainParams `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
+func (m *QueryBallotsByStatusRequest) GetObservationType() ObservationType {
+	if m != nil {
+		return m.ObservationType
+	}
+	return ObservationType_EmptyObserverType
 }
 
-func (m *QueryGetChainParamsForChainResponse) Reset()         { *m = QueryGetChainParamsForChainResponse{} }
-func (m *QueryGetChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsForChainResponse) ProtoMessage()    {}
-func (*QueryGetChainParamsForChainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{30}
+func (m *QueryBallotsByStatusRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
+
+type QueryBallotsByStatusResponse struct {
+	Ballots    []Ballot            `protobuf:"bytes,1,rep,name=ballots,proto3" json:"ballots"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBallotsByStatusResponse) Reset()         { *m = QueryBallotsByStatusResponse{} }
+func (m *QueryBallotsByStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotsByStatusResponse) ProtoMessage()    {}
+func (*QueryBallotsByStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{32}
+}
+func (m *QueryBallotsByStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBallotsByStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsForChainResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBallotsByStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1475,40 +1671,47 @@ func (m *QueryGetChainParamsForChainResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsForChainResponse.Merge(m, src)
+func (m *QueryBallotsByStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotsByStatusResponse.Merge(m, src)
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_Size() int {
+func (m *QueryBallotsByStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsForChainResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsForChainResponse.DiscardUnknown(m)
+func (m *QueryBallotsByStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotsByStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsForChainResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBallotsByStatusResponse proto.InternalMessageInfo
 
-func (m *QueryGetChainParamsForChainResponse) GetChainParams() *ChainParams {
+func (m *QueryBallotsByStatusResponse) GetBallots() []Ballot {
 	if m != nil {
-		return m.ChainParams
+		return m.Ballots
 	}
 	return nil
 }
 
-type QueryGetChainParamsRequest struct {
+func (m *QueryBallotsByStatusResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetChainParamsRequest) Reset()         { *m = QueryGetChainParamsRequest{} }
-func (m *QueryGetChainParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsRequest) ProtoMessage()    {}
-func (*QueryGetChainParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{31}
+type QueryObserverSet struct {
 }
-func (m *QueryGetChainParamsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryObserverSet) Reset()         { *m = QueryObserverSet{} }
+func (m *QueryObserverSet) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSet) ProtoMessage()    {}
+func (*QueryObserverSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{33}
+}
+func (m *QueryObserverSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryObserverSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryObserverSet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1518,34 +1721,34 @@ func (m *QueryGetChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsRequest.Merge(m, src)
+func (m *QueryObserverSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSet.Merge(m, src)
 }
-func (m *QueryGetChainParamsRequest) XXX_Size() int {
+func (m *QueryObserverSet) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsRequest.DiscardUnknown(m)
+func (m *QueryObserverSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryObserverSet proto.InternalMessageInfo
 
-type QueryGetChainParamsResponse struct {
-	ChainParams *ChainParamsList `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
+type QueryObserverSetResponse struct {
+	Observers []string `protobuf:"bytes,1,rep,name=observers,proto3" json:"observers,omitempty"`
 }
 
-func (m *QueryGetChainParamsResponse) Reset()         { *m = QueryGetChainParamsResponse{} }
-func (m *QueryGetChainParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetChainParamsResponse) ProtoMessage()    {}
-func (*QueryGetChainParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{32}
+func (m *QueryObserverSetResponse) Reset()         { *m = QueryObserverSetResponse{} }
+func (m *QueryObserverSetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverSetResponse) ProtoMessage()    {}
+func (*QueryObserverSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{34}
 }
-func (m *QueryGetChainParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryObserverSetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetChainParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryObserverSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetChainParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryObserverSetResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1555,41 +1758,41 @@ func (m *QueryGetChainParamsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetChainParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetChainParamsResponse.Merge(m, src)
+func (m *QueryObserverSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverSetResponse.Merge(m, src)
 }
-func (m *QueryGetChainParamsResponse) XXX_Size() int {
+func (m *QueryObserverSetResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetChainParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetChainParamsResponse.DiscardUnknown(m)
+func (m *QueryObserverSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverSetResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetChainParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryObserverSetResponse proto.InternalMessageInfo
 
-func (m *QueryGetChainParamsResponse) GetChainParams() *ChainParamsList {
+func (m *QueryObserverSetResponse) GetObservers() []string {
 	if m != nil {
-		return m.ChainParams
+		return m.Observers
 	}
 	return nil
 }
 
-type QueryGetNodeAccountRequest struct {
-	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+type QueryObserverChainsRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
 }
 
-func (m *QueryGetNodeAccountRequest) Reset()         { *m = QueryGetNodeAccountRequest{} }
-func (m *QueryGetNodeAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetNodeAccountRequest) ProtoMessage()    {}
-func (*QueryGetNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{33}
+func (m *QueryObserverChainsRequest) Reset()         { *m = QueryObserverChainsRequest{} }
+func (m *QueryObserverChainsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverChainsRequest) ProtoMessage()    {}
+func (*QueryObserverChainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{35}
 }
-func (m *QueryGetNodeAccountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryObserverChainsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryObserverChainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetNodeAccountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryObserverChainsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1599,41 +1802,42 @@ func (m *QueryGetNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetNodeAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetNodeAccountRequest.Merge(m, src)
+func (m *QueryObserverChainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverChainsRequest.Merge(m, src)
 }
-func (m *QueryGetNodeAccountRequest) XXX_Size() int {
+func (m *QueryObserverChainsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetNodeAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetNodeAccountRequest.DiscardUnknown(m)
+func (m *QueryObserverChainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverChainsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetNodeAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryObserverChainsRequest proto.InternalMessageInfo
 
-func (m *QueryGetNodeAccountRequest) GetIndex() string {
+func (m *QueryObserverChainsRequest) GetObserverAddress() string {
 	if m != nil {
-		return m.Index
+		return m.ObserverAddress
 	}
 	return ""
 }
 
-type QueryGetNodeAccountResponse struct {
-	NodeAccount *NodeAccount `protobuf:"bytes,1,opt,name=node_account,json=nodeAccount,proto3" json:"node_account,omitempty"`
+// chain_ids is empty if the observer is assigned to all the supported chains
+type QueryObserverChainsResponse struct {
+	ChainIds []int64 `protobuf:"varint,1,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
 }
 
-func (m *QueryGetNodeAccountResponse) Reset()         { *m = QueryGetNodeAccountResponse{} }
-func (m *QueryGetNodeAccountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetNodeAccountResponse) ProtoMessage()    {}
-func (*QueryGetNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{34}
+func (m *QueryObserverChainsResponse) Reset()         { *m = QueryObserverChainsResponse{} }
+func (m *QueryObserverChainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverChainsResponse) ProtoMessage()    {}
+func (*QueryObserverChainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{36}
 }
-func (m *QueryGetNodeAccountResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryObserverChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryObserverChainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetNodeAccountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryObserverChainsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1643,41 +1847,41 @@ func (m *QueryGetNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetNodeAccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetNodeAccountResponse.Merge(m, src)
+func (m *QueryObserverChainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverChainsResponse.Merge(m, src)
 }
-func (m *QueryGetNodeAccountResponse) XXX_Size() int {
+func (m *QueryObserverChainsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetNodeAccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetNodeAccountResponse.DiscardUnknown(m)
+func (m *QueryObserverChainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverChainsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetNodeAccountResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryObserverChainsResponse proto.InternalMessageInfo
 
-func (m *QueryGetNodeAccountResponse) GetNodeAccount() *NodeAccount {
+func (m *QueryObserverChainsResponse) GetChainIds() []int64 {
 	if m != nil {
-		return m.NodeAccount
+		return m.ChainIds
 	}
 	return nil
 }
 
-type QueryAllNodeAccountRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryObserverLivenessRequest struct {
+	ObserverAddress string `protobuf:"bytes,1,opt,name=observer_address,json=observerAddress,proto3" json:"observer_address,omitempty"`
 }
 
-func (m *QueryAllNodeAccountRequest) Reset()         { *m = QueryAllNodeAccountRequest{} }
-func (m *QueryAllNodeAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllNodeAccountRequest) ProtoMessage()    {}
-func (*QueryAllNodeAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{35}
+func (m *QueryObserverLivenessRequest) Reset()         { *m = QueryObserverLivenessRequest{} }
+func (m *QueryObserverLivenessRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverLivenessRequest) ProtoMessage()    {}
+func (*QueryObserverLivenessRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{37}
 }
-func (m *QueryAllNodeAccountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryObserverLivenessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryObserverLivenessRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllNodeAccountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryObserverLivenessRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1687,42 +1891,41 @@ func (m *QueryAllNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAllNodeAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllNodeAccountRequest.Merge(m, src)
+func (m *QueryObserverLivenessRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverLivenessRequest.Merge(m, src)
 }
-func (m *QueryAllNodeAccountRequest) XXX_Size() int {
+func (m *QueryObserverLivenessRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllNodeAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllNodeAccountRequest.DiscardUnknown(m)
+func (m *QueryObserverLivenessRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverLivenessRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllNodeAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryObserverLivenessRequest proto.InternalMessageInfo
 
-func (m *QueryAllNodeAccountRequest) GetPagination() *query.PageRequest {
+func (m *QueryObserverLivenessRequest) GetObserverAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.ObserverAddress
 	}
-	return nil
+	return ""
 }
 
-type QueryAllNodeAccountResponse struct {
-	NodeAccount []*NodeAccount      `protobuf:"bytes,1,rep,name=NodeAccount,proto3" json:"NodeAccount,omitempty"`
-	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryObserverLivenessResponse struct {
+	ObserverLiveness ObserverLiveness `protobuf:"bytes,1,opt,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
 }
 
-func (m *QueryAllNodeAccountResponse) Reset()         { *m = QueryAllNodeAccountResponse{} }
-func (m *QueryAllNodeAccountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllNodeAccountResponse) ProtoMessage()    {}
-func (*QueryAllNodeAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{36}
+func (m *QueryObserverLivenessResponse) Reset()         { *m = QueryObserverLivenessResponse{} }
+func (m *QueryObserverLivenessResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserverLivenessResponse) ProtoMessage()    {}
+func (*QueryObserverLivenessResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{38}
 }
-func (m *QueryAllNodeAccountResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryObserverLivenessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryObserverLivenessResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllNodeAccountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryObserverLivenessResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1732,47 +1935,41 @@ func (m *QueryAllNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllNodeAccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllNodeAccountResponse.Merge(m, src)
+func (m *QueryObserverLivenessResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserverLivenessResponse.Merge(m, src)
 }
-func (m *QueryAllNodeAccountResponse) XXX_Size() int {
+func (m *QueryObserverLivenessResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllNodeAccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllNodeAccountResponse.DiscardUnknown(m)
+func (m *QueryObserverLivenessResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserverLivenessResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllNodeAccountResponse proto.InternalMessageInfo
-
-func (m *QueryAllNodeAccountResponse) GetNodeAccount() []*NodeAccount {
-	if m != nil {
-		return m.NodeAccount
-	}
-	return nil
-}
+var xxx_messageInfo_QueryObserverLivenessResponse proto.InternalMessageInfo
 
-func (m *QueryAllNodeAccountResponse) GetPagination() *query.PageResponse {
+func (m *QueryObserverLivenessResponse) GetObserverLiveness() ObserverLiveness {
 	if m != nil {
-		return m.Pagination
+		return m.ObserverLiveness
 	}
-	return nil
+	return ObserverLiveness{}
 }
 
-type QueryGetCrosschainFlagsRequest struct {
+type QueryJailedObserversRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Reset()         { *m = QueryGetCrosschainFlagsRequest{} }
-func (m *QueryGetCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCrosschainFlagsRequest) ProtoMessage()    {}
-func (*QueryGetCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{37}
+func (m *QueryJailedObserversRequest) Reset()         { *m = QueryJailedObserversRequest{} }
+func (m *QueryJailedObserversRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryJailedObserversRequest) ProtoMessage()    {}
+func (*QueryJailedObserversRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{39}
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryJailedObserversRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryJailedObserversRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCrosschainFlagsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryJailedObserversRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1782,34 +1979,42 @@ func (m *QueryGetCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCrosschainFlagsRequest.Merge(m, src)
+func (m *QueryJailedObserversRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailedObserversRequest.Merge(m, src)
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_Size() int {
+func (m *QueryJailedObserversRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCrosschainFlagsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCrosschainFlagsRequest.DiscardUnknown(m)
+func (m *QueryJailedObserversRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailedObserversRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCrosschainFlagsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryJailedObserversRequest proto.InternalMessageInfo
 
-type QueryGetCrosschainFlagsResponse struct {
-	CrosschainFlags CrosschainFlags `protobuf:"bytes,1,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags"`
+func (m *QueryJailedObserversRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Reset()         { *m = QueryGetCrosschainFlagsResponse{} }
-func (m *QueryGetCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCrosschainFlagsResponse) ProtoMessage()    {}
-func (*QueryGetCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{38}
+type QueryJailedObserversResponse struct {
+	ObserverLiveness []ObserverLiveness  `protobuf:"bytes,1,rep,name=observer_liveness,json=observerLiveness,proto3" json:"observer_liveness"`
+	Pagination       *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryGetCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryJailedObserversResponse) Reset()         { *m = QueryJailedObserversResponse{} }
+func (m *QueryJailedObserversResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryJailedObserversResponse) ProtoMessage()    {}
+func (*QueryJailedObserversResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{40}
+}
+func (m *QueryJailedObserversResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryJailedObserversResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCrosschainFlagsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryJailedObserversResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1819,40 +2024,47 @@ func (m *QueryGetCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCrosschainFlagsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCrosschainFlagsResponse.Merge(m, src)
+func (m *QueryJailedObserversResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryJailedObserversResponse.Merge(m, src)
 }
-func (m *QueryGetCrosschainFlagsResponse) XXX_Size() int {
+func (m *QueryJailedObserversResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCrosschainFlagsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCrosschainFlagsResponse.DiscardUnknown(m)
+func (m *QueryJailedObserversResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryJailedObserversResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCrosschainFlagsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryJailedObserversResponse proto.InternalMessageInfo
 
-func (m *QueryGetCrosschainFlagsResponse) GetCrosschainFlags() CrosschainFlags {
+func (m *QueryJailedObserversResponse) GetObserverLiveness() []ObserverLiveness {
 	if m != nil {
-		return m.CrosschainFlags
+		return m.ObserverLiveness
 	}
-	return CrosschainFlags{}
+	return nil
 }
 
-type QueryGetKeygenRequest struct {
+func (m *QueryJailedObserversResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
-func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetKeygenRequest) ProtoMessage()    {}
-func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{39}
-}
-func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type QuerySupportedChains struct {
 }
-func (m *QueryGetKeygenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+func (m *QuerySupportedChains) Reset()         { *m = QuerySupportedChains{} }
+func (m *QuerySupportedChains) String() string { return proto.CompactTextString(m) }
+func (*QuerySupportedChains) ProtoMessage()    {}
+func (*QuerySupportedChains) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{41}
+}
+func (m *QuerySupportedChains) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupportedChains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetKeygenRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySupportedChains.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1862,34 +2074,34 @@ func (m *QueryGetKeygenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryGetKeygenRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetKeygenRequest.Merge(m, src)
+func (m *QuerySupportedChains) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupportedChains.Merge(m, src)
 }
-func (m *QueryGetKeygenRequest) XXX_Size() int {
+func (m *QuerySupportedChains) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetKeygenRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetKeygenRequest.DiscardUnknown(m)
+func (m *QuerySupportedChains) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupportedChains.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetKeygenRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySupportedChains proto.InternalMessageInfo
 
-type QueryGetKeygenResponse struct {
-	Keygen *Keygen `protobuf:"bytes,1,opt,name=keygen,proto3" json:"keygen,omitempty"`
+type QuerySupportedChainsResponse struct {
+	Chains []*common.Chain `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
 }
 
-func (m *QueryGetKeygenResponse) Reset()         { *m = QueryGetKeygenResponse{} }
-func (m *QueryGetKeygenResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetKeygenResponse) ProtoMessage()    {}
-func (*QueryGetKeygenResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{40}
+func (m *QuerySupportedChainsResponse) Reset()         { *m = QuerySupportedChainsResponse{} }
+func (m *QuerySupportedChainsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupportedChainsResponse) ProtoMessage()    {}
+func (*QuerySupportedChainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{42}
 }
-func (m *QueryGetKeygenResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySupportedChainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetKeygenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySupportedChainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetKeygenResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySupportedChainsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1899,40 +2111,41 @@ func (m *QueryGetKeygenResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetKeygenResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetKeygenResponse.Merge(m, src)
+func (m *QuerySupportedChainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupportedChainsResponse.Merge(m, src)
 }
-func (m *QueryGetKeygenResponse) XXX_Size() int {
+func (m *QuerySupportedChainsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetKeygenResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetKeygenResponse.DiscardUnknown(m)
+func (m *QuerySupportedChainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupportedChainsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetKeygenResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySupportedChainsResponse proto.InternalMessageInfo
 
-func (m *QueryGetKeygenResponse) GetKeygen() *Keygen {
+func (m *QuerySupportedChainsResponse) GetChains() []*common.Chain {
 	if m != nil {
-		return m.Keygen
+		return m.Chains
 	}
 	return nil
 }
 
-type QueryShowObserverCountRequest struct {
+type QueryGetChainParamsForChainRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserverCountRequest{} }
-func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryShowObserverCountRequest) ProtoMessage()    {}
-func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{41}
+func (m *QueryGetChainParamsForChainRequest) Reset()         { *m = QueryGetChainParamsForChainRequest{} }
+func (m *QueryGetChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsForChainRequest) ProtoMessage()    {}
+func (*QueryGetChainParamsForChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{43}
 }
-func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsForChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryShowObserverCountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsForChainRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1942,34 +2155,41 @@ func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryShowObserverCountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryShowObserverCountRequest.Merge(m, src)
+func (m *QueryGetChainParamsForChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsForChainRequest.Merge(m, src)
 }
-func (m *QueryShowObserverCountRequest) XXX_Size() int {
+func (m *QueryGetChainParamsForChainRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryShowObserverCountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryShowObserverCountRequest.DiscardUnknown(m)
+func (m *QueryGetChainParamsForChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsForChainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryShowObserverCountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetChainParamsForChainRequest proto.InternalMessageInfo
 
-type QueryShowObserverCountResponse struct {
-	LastObserverCount *LastObserverCount `protobuf:"bytes,1,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
+func (m *QueryGetChainParamsForChainRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
 
-func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserverCountResponse{} }
-func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryShowObserverCountResponse) ProtoMessage()    {}
-func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{42}
+type QueryGetChainParamsForChainResponse struct {
+	ChainParams *ChainParams `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
 }
-func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetChainParamsForChainResponse) Reset()         { *m = QueryGetChainParamsForChainResponse{} }
+func (m *QueryGetChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsForChainResponse) ProtoMessage()    {}
+func (*QueryGetChainParamsForChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{44}
+}
+func (m *QueryGetChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsForChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryShowObserverCountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsForChainResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1979,41 +2199,40 @@ func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryShowObserverCountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryShowObserverCountResponse.Merge(m, src)
+func (m *QueryGetChainParamsForChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsForChainResponse.Merge(m, src)
 }
-func (m *QueryShowObserverCountResponse) XXX_Size() int {
+func (m *QueryGetChainParamsForChainResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryShowObserverCountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryShowObserverCountResponse.DiscardUnknown(m)
+func (m *QueryGetChainParamsForChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsForChainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryShowObserverCountResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetChainParamsForChainResponse proto.InternalMessageInfo
 
-func (m *QueryShowObserverCountResponse) GetLastObserverCount() *LastObserverCount {
+func (m *QueryGetChainParamsForChainResponse) GetChainParams() *ChainParams {
 	if m != nil {
-		return m.LastObserverCount
+		return m.ChainParams
 	}
 	return nil
 }
 
-type QueryBlameByIdentifierRequest struct {
-	BlameIdentifier string `protobuf:"bytes,1,opt,name=blame_identifier,json=blameIdentifier,proto3" json:"blame_identifier,omitempty"`
+type QueryGetChainParamsRequest struct {
 }
 
-func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdentifierRequest{} }
-func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
-func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{43}
+func (m *QueryGetChainParamsRequest) Reset()         { *m = QueryGetChainParamsRequest{} }
+func (m *QueryGetChainParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsRequest) ProtoMessage()    {}
+func (*QueryGetChainParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{45}
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetChainParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByIdentifierRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2023,41 +2242,34 @@ func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByIdentifierRequest.Merge(m, src)
+func (m *QueryGetChainParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsRequest.Merge(m, src)
 }
-func (m *QueryBlameByIdentifierRequest) XXX_Size() int {
+func (m *QueryGetChainParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByIdentifierRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByIdentifierRequest.DiscardUnknown(m)
+func (m *QueryGetChainParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByIdentifierRequest proto.InternalMessageInfo
-
-func (m *QueryBlameByIdentifierRequest) GetBlameIdentifier() string {
-	if m != nil {
-		return m.BlameIdentifier
-	}
-	return ""
-}
+var xxx_messageInfo_QueryGetChainParamsRequest proto.InternalMessageInfo
 
-type QueryBlameByIdentifierResponse struct {
-	BlameInfo *Blame `protobuf:"bytes,1,opt,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
+type QueryGetChainParamsResponse struct {
+	ChainParams *ChainParamsList `protobuf:"bytes,1,opt,name=chain_params,json=chainParams,proto3" json:"chain_params,omitempty"`
 }
 
-func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIdentifierResponse{} }
-func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
-func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{44}
+func (m *QueryGetChainParamsResponse) Reset()         { *m = QueryGetChainParamsResponse{} }
+func (m *QueryGetChainParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetChainParamsResponse) ProtoMessage()    {}
+func (*QueryGetChainParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{46}
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetChainParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetChainParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByIdentifierResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetChainParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2067,41 +2279,40 @@ func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByIdentifierResponse.Merge(m, src)
+func (m *QueryGetChainParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetChainParamsResponse.Merge(m, src)
 }
-func (m *QueryBlameByIdentifierResponse) XXX_Size() int {
+func (m *QueryGetChainParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByIdentifierResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByIdentifierResponse.DiscardUnknown(m)
+func (m *QueryGetChainParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetChainParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByIdentifierResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetChainParamsResponse proto.InternalMessageInfo
 
-func (m *QueryBlameByIdentifierResponse) GetBlameInfo() *Blame {
+func (m *QueryGetChainParamsResponse) GetChainParams() *ChainParamsList {
 	if m != nil {
-		return m.BlameInfo
+		return m.ChainParams
 	}
 	return nil
 }
 
-type QueryAllBlameRecordsRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryPendingChainParamsRequest struct {
 }
 
-func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecordsRequest{} }
-func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
-func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{45}
+func (m *QueryPendingChainParamsRequest) Reset()         { *m = QueryPendingChainParamsRequest{} }
+func (m *QueryPendingChainParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChainParamsRequest) ProtoMessage()    {}
+func (*QueryPendingChainParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{47}
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingChainParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingChainParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlameRecordsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingChainParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2111,42 +2322,34 @@ func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlameRecordsRequest.Merge(m, src)
+func (m *QueryPendingChainParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChainParamsRequest.Merge(m, src)
 }
-func (m *QueryAllBlameRecordsRequest) XXX_Size() int {
+func (m *QueryPendingChainParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlameRecordsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlameRecordsRequest.DiscardUnknown(m)
+func (m *QueryPendingChainParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChainParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlameRecordsRequest proto.InternalMessageInfo
-
-func (m *QueryAllBlameRecordsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryPendingChainParamsRequest proto.InternalMessageInfo
 
-type QueryAllBlameRecordsResponse struct {
-	BlameInfo  []Blame             `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info"`
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryPendingChainParamsResponse struct {
+	PendingChainParams []PendingChainParams `protobuf:"bytes,1,rep,name=pending_chain_params,json=pendingChainParams,proto3" json:"pending_chain_params"`
 }
 
-func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecordsResponse{} }
-func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
-func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{46}
+func (m *QueryPendingChainParamsResponse) Reset()         { *m = QueryPendingChainParamsResponse{} }
+func (m *QueryPendingChainParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChainParamsResponse) ProtoMessage()    {}
+func (*QueryPendingChainParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{48}
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingChainParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingChainParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlameRecordsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingChainParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2156,49 +2359,43 @@ func (m *QueryAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlameRecordsResponse.Merge(m, src)
+func (m *QueryPendingChainParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChainParamsResponse.Merge(m, src)
 }
-func (m *QueryAllBlameRecordsResponse) XXX_Size() int {
+func (m *QueryPendingChainParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlameRecordsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlameRecordsResponse.DiscardUnknown(m)
+func (m *QueryPendingChainParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChainParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlameRecordsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingChainParamsResponse proto.InternalMessageInfo
 
-func (m *QueryAllBlameRecordsResponse) GetBlameInfo() []Blame {
+func (m *QueryPendingChainParamsResponse) GetPendingChainParams() []PendingChainParams {
 	if m != nil {
-		return m.BlameInfo
+		return m.PendingChainParams
 	}
 	return nil
 }
 
-func (m *QueryAllBlameRecordsResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-type QueryBlameByChainAndNonceRequest struct {
+type QueryPendingChainParamsForChainRequest struct {
 	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Nonce   int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByChainAndNonceRequest{} }
-func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
-func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{47}
+func (m *QueryPendingChainParamsForChainRequest) Reset() {
+	*m = QueryPendingChainParamsForChainRequest{}
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingChainParamsForChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChainParamsForChainRequest) ProtoMessage()    {}
+func (*QueryPendingChainParamsForChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{49}
+}
+func (m *QueryPendingChainParamsForChainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingChainParamsForChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByChainAndNonceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingChainParamsForChainRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2208,48 +2405,43 @@ func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByChainAndNonceRequest.Merge(m, src)
+func (m *QueryPendingChainParamsForChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChainParamsForChainRequest.Merge(m, src)
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_Size() int {
+func (m *QueryPendingChainParamsForChainRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByChainAndNonceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByChainAndNonceRequest.DiscardUnknown(m)
+func (m *QueryPendingChainParamsForChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChainParamsForChainRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByChainAndNonceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingChainParamsForChainRequest proto.InternalMessageInfo
 
-func (m *QueryBlameByChainAndNonceRequest) GetChainId() int64 {
+func (m *QueryPendingChainParamsForChainRequest) GetChainId() int64 {
 	if m != nil {
 		return m.ChainId
 	}
 	return 0
 }
 
-func (m *QueryBlameByChainAndNonceRequest) GetNonce() int64 {
-	if m != nil {
-		return m.Nonce
-	}
-	return 0
+type QueryPendingChainParamsForChainResponse struct {
+	PendingChainParams PendingChainParams `protobuf:"bytes,1,opt,name=pending_chain_params,json=pendingChainParams,proto3" json:"pending_chain_params"`
 }
 
-type QueryBlameByChainAndNonceResponse struct {
-	BlameInfo []*Blame `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
+func (m *QueryPendingChainParamsForChainResponse) Reset() {
+	*m = QueryPendingChainParamsForChainResponse{}
 }
-
-func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByChainAndNonceResponse{} }
-func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
-func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{48}
+func (m *QueryPendingChainParamsForChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingChainParamsForChainResponse) ProtoMessage()    {}
+func (*QueryPendingChainParamsForChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{50}
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingChainParamsForChainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingChainParamsForChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBlameByChainAndNonceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingChainParamsForChainResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2259,41 +2451,41 @@ func (m *QueryBlameByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBlameByChainAndNonceResponse.Merge(m, src)
+func (m *QueryPendingChainParamsForChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingChainParamsForChainResponse.Merge(m, src)
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_Size() int {
+func (m *QueryPendingChainParamsForChainResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBlameByChainAndNonceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBlameByChainAndNonceResponse.DiscardUnknown(m)
+func (m *QueryPendingChainParamsForChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingChainParamsForChainResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBlameByChainAndNonceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingChainParamsForChainResponse proto.InternalMessageInfo
 
-func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
+func (m *QueryPendingChainParamsForChainResponse) GetPendingChainParams() PendingChainParams {
 	if m != nil {
-		return m.BlameInfo
+		return m.PendingChainParams
 	}
-	return nil
+	return PendingChainParams{}
 }
 
-type QueryAllBlockHeaderRequest struct {
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetNodeAccountRequest struct {
+	Index string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryAllBlockHeaderRequest) Reset()         { *m = QueryAllBlockHeaderRequest{} }
-func (m *QueryAllBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlockHeaderRequest) ProtoMessage()    {}
-func (*QueryAllBlockHeaderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{49}
+func (m *QueryGetNodeAccountRequest) Reset()         { *m = QueryGetNodeAccountRequest{} }
+func (m *QueryGetNodeAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNodeAccountRequest) ProtoMessage()    {}
+func (*QueryGetNodeAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{51}
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlockHeaderRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetNodeAccountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2303,42 +2495,41 @@ func (m *QueryAllBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlockHeaderRequest.Merge(m, src)
+func (m *QueryGetNodeAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNodeAccountRequest.Merge(m, src)
 }
-func (m *QueryAllBlockHeaderRequest) XXX_Size() int {
+func (m *QueryGetNodeAccountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlockHeaderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlockHeaderRequest.DiscardUnknown(m)
+func (m *QueryGetNodeAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNodeAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlockHeaderRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetNodeAccountRequest proto.InternalMessageInfo
 
-func (m *QueryAllBlockHeaderRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetNodeAccountRequest) GetIndex() string {
 	if m != nil {
-		return m.Pagination
+		return m.Index
 	}
-	return nil
+	return ""
 }
 
-type QueryAllBlockHeaderResponse struct {
-	BlockHeaders []*common.BlockHeader `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
-	Pagination   *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryGetNodeAccountResponse struct {
+	NodeAccount *NodeAccount `protobuf:"bytes,1,opt,name=node_account,json=nodeAccount,proto3" json:"node_account,omitempty"`
 }
 
-func (m *QueryAllBlockHeaderResponse) Reset()         { *m = QueryAllBlockHeaderResponse{} }
-func (m *QueryAllBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAllBlockHeaderResponse) ProtoMessage()    {}
-func (*QueryAllBlockHeaderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{50}
+func (m *QueryGetNodeAccountResponse) Reset()         { *m = QueryGetNodeAccountResponse{} }
+func (m *QueryGetNodeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetNodeAccountResponse) ProtoMessage()    {}
+func (*QueryGetNodeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{52}
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAllBlockHeaderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetNodeAccountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2348,48 +2539,41 @@ func (m *QueryAllBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAllBlockHeaderResponse.Merge(m, src)
+func (m *QueryGetNodeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetNodeAccountResponse.Merge(m, src)
 }
-func (m *QueryAllBlockHeaderResponse) XXX_Size() int {
+func (m *QueryGetNodeAccountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAllBlockHeaderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAllBlockHeaderResponse.DiscardUnknown(m)
+func (m *QueryGetNodeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetNodeAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAllBlockHeaderResponse proto.InternalMessageInfo
-
-func (m *QueryAllBlockHeaderResponse) GetBlockHeaders() []*common.BlockHeader {
-	if m != nil {
-		return m.BlockHeaders
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetNodeAccountResponse proto.InternalMessageInfo
 
-func (m *QueryAllBlockHeaderResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetNodeAccountResponse) GetNodeAccount() *NodeAccount {
 	if m != nil {
-		return m.Pagination
+		return m.NodeAccount
 	}
 	return nil
 }
 
-type QueryGetBlockHeaderByHashRequest struct {
-	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+type QueryAllNodeAccountRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) Reset()         { *m = QueryGetBlockHeaderByHashRequest{} }
-func (m *QueryGetBlockHeaderByHashRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderByHashRequest) ProtoMessage()    {}
-func (*QueryGetBlockHeaderByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{51}
+func (m *QueryAllNodeAccountRequest) Reset()         { *m = QueryAllNodeAccountRequest{} }
+func (m *QueryAllNodeAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNodeAccountRequest) ProtoMessage()    {}
+func (*QueryAllNodeAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{53}
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllNodeAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllNodeAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllNodeAccountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2399,41 +2583,42 @@ func (m *QueryGetBlockHeaderByHashRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Merge(m, src)
+func (m *QueryAllNodeAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNodeAccountRequest.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_Size() int {
+func (m *QueryAllNodeAccountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderByHashRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.DiscardUnknown(m)
+func (m *QueryAllNodeAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNodeAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderByHashRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllNodeAccountRequest proto.InternalMessageInfo
 
-func (m *QueryGetBlockHeaderByHashRequest) GetBlockHash() []byte {
+func (m *QueryAllNodeAccountRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.BlockHash
+		return m.Pagination
 	}
 	return nil
 }
 
-type QueryGetBlockHeaderByHashResponse struct {
-	BlockHeader *common.BlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+type QueryAllNodeAccountResponse struct {
+	NodeAccount []*NodeAccount      `protobuf:"bytes,1,rep,name=NodeAccount,proto3" json:"NodeAccount,omitempty"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) Reset()         { *m = QueryGetBlockHeaderByHashResponse{} }
-func (m *QueryGetBlockHeaderByHashResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderByHashResponse) ProtoMessage()    {}
-func (*QueryGetBlockHeaderByHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{52}
+func (m *QueryAllNodeAccountResponse) Reset()         { *m = QueryAllNodeAccountResponse{} }
+func (m *QueryAllNodeAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllNodeAccountResponse) ProtoMessage()    {}
+func (*QueryAllNodeAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{54}
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAllNodeAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAllNodeAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAllNodeAccountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2443,41 +2628,47 @@ func (m *QueryGetBlockHeaderByHashResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Merge(m, src)
+func (m *QueryAllNodeAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllNodeAccountResponse.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_Size() int {
+func (m *QueryAllNodeAccountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderByHashResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.DiscardUnknown(m)
+func (m *QueryAllNodeAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllNodeAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderByHashResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAllNodeAccountResponse proto.InternalMessageInfo
 
-func (m *QueryGetBlockHeaderByHashResponse) GetBlockHeader() *common.BlockHeader {
+func (m *QueryAllNodeAccountResponse) GetNodeAccount() []*NodeAccount {
 	if m != nil {
-		return m.BlockHeader
+		return m.NodeAccount
 	}
 	return nil
 }
 
-type QueryGetBlockHeaderStateRequest struct {
-	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+func (m *QueryAllNodeAccountResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetBlockHeaderStateRequest) Reset()         { *m = QueryGetBlockHeaderStateRequest{} }
-func (m *QueryGetBlockHeaderStateRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderStateRequest) ProtoMessage()    {}
-func (*QueryGetBlockHeaderStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{53}
+type QueryGetCrosschainFlagsRequest struct {
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryGetCrosschainFlagsRequest) Reset()         { *m = QueryGetCrosschainFlagsRequest{} }
+func (m *QueryGetCrosschainFlagsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCrosschainFlagsRequest) ProtoMessage()    {}
+func (*QueryGetCrosschainFlagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{55}
+}
+func (m *QueryGetCrosschainFlagsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCrosschainFlagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderStateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCrosschainFlagsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2487,41 +2678,34 @@ func (m *QueryGetBlockHeaderStateRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderStateRequest.Merge(m, src)
+func (m *QueryGetCrosschainFlagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCrosschainFlagsRequest.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_Size() int {
+func (m *QueryGetCrosschainFlagsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderStateRequest.DiscardUnknown(m)
+func (m *QueryGetCrosschainFlagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCrosschainFlagsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCrosschainFlagsRequest proto.InternalMessageInfo
 
-func (m *QueryGetBlockHeaderStateRequest) GetChainId() int64 {
-	if m != nil {
-		return m.ChainId
-	}
-	return 0
+type QueryGetCrosschainFlagsResponse struct {
+	CrosschainFlags CrosschainFlags `protobuf:"bytes,1,opt,name=crosschain_flags,json=crosschainFlags,proto3" json:"crosschain_flags"`
 }
 
-type QueryGetBlockHeaderStateResponse struct {
-	BlockHeaderState *BlockHeaderState `protobuf:"bytes,1,opt,name=block_header_state,json=blockHeaderState,proto3" json:"block_header_state,omitempty"`
+func (m *QueryGetCrosschainFlagsResponse) Reset()         { *m = QueryGetCrosschainFlagsResponse{} }
+func (m *QueryGetCrosschainFlagsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCrosschainFlagsResponse) ProtoMessage()    {}
+func (*QueryGetCrosschainFlagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{56}
 }
-
-func (m *QueryGetBlockHeaderStateResponse) Reset()         { *m = QueryGetBlockHeaderStateResponse{} }
-func (m *QueryGetBlockHeaderStateResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetBlockHeaderStateResponse) ProtoMessage()    {}
-func (*QueryGetBlockHeaderStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dcb801e455adaee4, []int{54}
-}
-func (m *QueryGetBlockHeaderStateResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetCrosschainFlagsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCrosschainFlagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetBlockHeaderStateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCrosschainFlagsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2531,2643 +2715,2696 @@ func (m *QueryGetBlockHeaderStateResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetBlockHeaderStateResponse.Merge(m, src)
+func (m *QueryGetCrosschainFlagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCrosschainFlagsResponse.Merge(m, src)
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_Size() int {
+func (m *QueryGetCrosschainFlagsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetBlockHeaderStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetBlockHeaderStateResponse.DiscardUnknown(m)
+func (m *QueryGetCrosschainFlagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCrosschainFlagsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetBlockHeaderStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCrosschainFlagsResponse proto.InternalMessageInfo
 
-func (m *QueryGetBlockHeaderStateResponse) GetBlockHeaderState() *BlockHeaderState {
+func (m *QueryGetCrosschainFlagsResponse) GetCrosschainFlags() CrosschainFlags {
 	if m != nil {
-		return m.BlockHeaderState
+		return m.CrosschainFlags
 	}
-	return nil
+	return CrosschainFlags{}
 }
 
-func init() {
-	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesRequest")
-	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesResponse")
-	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesRequest")
-	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesResponse")
-	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesRequest")
-	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesResponse")
-	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainRequest")
-	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainResponse")
-	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.observer.QueryGetTSSRequest")
-	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.observer.QueryGetTSSResponse")
-	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressRequest")
-	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressResponse")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightRequest")
-	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightResponse")
-	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.observer.QueryTssHistoryRequest")
-	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.observer.QueryTssHistoryResponse")
-	proto.RegisterType((*QueryProveRequest)(nil), "zetachain.zetacore.observer.QueryProveRequest")
-	proto.RegisterType((*QueryProveResponse)(nil), "zetachain.zetacore.observer.QueryProveResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.observer.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.observer.QueryParamsResponse")
-	proto.RegisterType((*QueryHasVotedRequest)(nil), "zetachain.zetacore.observer.QueryHasVotedRequest")
-	proto.RegisterType((*QueryHasVotedResponse)(nil), "zetachain.zetacore.observer.QueryHasVotedResponse")
-	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
-	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
-	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
-	proto.RegisterType((*QueryObserverSet)(nil), "zetachain.zetacore.observer.QueryObserverSet")
-	proto.RegisterType((*QueryObserverSetResponse)(nil), "zetachain.zetacore.observer.QueryObserverSetResponse")
-	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
-	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
-	proto.RegisterType((*QueryGetChainParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainRequest")
-	proto.RegisterType((*QueryGetChainParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainResponse")
-	proto.RegisterType((*QueryGetChainParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsRequest")
-	proto.RegisterType((*QueryGetChainParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsResponse")
-	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
-	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
-	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
-	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
-	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
-	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
-	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
-	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
-	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
-	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
-	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
-	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
-	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
-	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
-	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
-	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
-	proto.RegisterType((*QueryAllBlockHeaderRequest)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderRequest")
-	proto.RegisterType((*QueryAllBlockHeaderResponse)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderResponse")
-	proto.RegisterType((*QueryGetBlockHeaderByHashRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashRequest")
-	proto.RegisterType((*QueryGetBlockHeaderByHashResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashResponse")
-	proto.RegisterType((*QueryGetBlockHeaderStateRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateRequest")
-	proto.RegisterType((*QueryGetBlockHeaderStateResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateResponse")
+type QueryGetKeygenRequest struct {
 }
 
-func init() { proto.RegisterFile("observer/query.proto", fileDescriptor_dcb801e455adaee4) }
-
-var fileDescriptor_dcb801e455adaee4 = []byte{
-	// 2495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
-	0x15, 0x37, 0xad, 0x48, 0x96, 0x9e, 0x3e, 0x2c, 0x8f, 0xe5, 0x2f, 0xda, 0x96, 0x65, 0x2a, 0x8e,
-	0x65, 0xc5, 0x5e, 0xc6, 0x72, 0x12, 0xcb, 0x1f, 0x8a, 0xad, 0x75, 0x6d, 0xc9, 0x4e, 0x6a, 0x2b,
-	0xbb, 0x6a, 0x53, 0x38, 0x6d, 0xb7, 0xdc, 0xdd, 0xd1, 0x2e, 0x9b, 0x15, 0xb9, 0x21, 0x47, 0x8a,
-	0x36, 0xaa, 0xd0, 0xa2, 0xc7, 0xa0, 0x87, 0x00, 0x05, 0xda, 0x5b, 0x11, 0xa0, 0x68, 0x6f, 0x05,
-	0x8a, 0x00, 0x45, 0x0b, 0x14, 0x3d, 0xe4, 0xd4, 0x1c, 0x7a, 0x48, 0x51, 0xa0, 0x68, 0x2f, 0x6d,
-	0x60, 0xb7, 0xff, 0x47, 0xc1, 0xe1, 0x23, 0x39, 0xe4, 0x72, 0xb9, 0xb3, 0xca, 0xf6, 0xa4, 0xe5,
-	0xcc, 0xbc, 0x37, 0xbf, 0xdf, 0x9b, 0x37, 0x33, 0xef, 0x27, 0x12, 0xa6, 0xec, 0xb2, 0x4b, 0x9d,
-	0x6d, 0xea, 0xe8, 0xef, 0x6f, 0x51, 0xa7, 0x95, 0x6b, 0x3a, 0x36, 0xb3, 0xc9, 0xe9, 0x0f, 0x29,
-	0x33, 0x2a, 0x75, 0xc3, 0xb4, 0x72, 0xfc, 0x97, 0xed, 0xd0, 0x5c, 0x30, 0x50, 0x3d, 0x5a, 0xb1,
-	0x37, 0x37, 0x6d, 0x4b, 0xf7, 0xff, 0xf8, 0x16, 0xea, 0x7c, 0xc5, 0x76, 0x37, 0x6d, 0x57, 0x2f,
-	0x1b, 0x2e, 0xf5, 0x5d, 0xe9, 0xdb, 0x57, 0xcb, 0x94, 0x19, 0x57, 0xf5, 0xa6, 0x51, 0x33, 0x2d,
-	0x83, 0x99, 0xe1, 0xd8, 0xa9, 0x9a, 0x5d, 0xb3, 0xf9, 0x4f, 0xdd, 0xfb, 0x85, 0xad, 0x67, 0x6a,
-	0xb6, 0x5d, 0x6b, 0x50, 0xdd, 0x68, 0x9a, 0xba, 0x61, 0x59, 0x36, 0xe3, 0x26, 0x2e, 0xf6, 0x1e,
-	0x0b, 0x71, 0x96, 0x8d, 0x46, 0xc3, 0x66, 0x81, 0xab, 0xa8, 0xb9, 0x61, 0x6c, 0x52, 0x6c, 0x3d,
-	0x2d, 0xb4, 0xda, 0x95, 0xf7, 0x4a, 0x75, 0x6a, 0x54, 0xa9, 0xd3, 0xd6, 0xc9, 0x09, 0x96, 0x2c,
-	0xdb, 0xaa, 0xd0, 0x60, 0x9a, 0x73, 0x51, 0xa7, 0x63, 0xbb, 0xae, 0x3f, 0x62, 0xa3, 0x61, 0xd4,
-	0xda, 0x71, 0xbc, 0x47, 0x5b, 0x35, 0x6a, 0xb5, 0x39, 0xb5, 0xec, 0x2a, 0x2d, 0x19, 0x95, 0x8a,
-	0xbd, 0x65, 0x05, 0x20, 0x4f, 0x84, 0x9d, 0xc1, 0x8f, 0x36, 0x67, 0x4d, 0xc3, 0x31, 0x36, 0x83,
-	0x39, 0xce, 0x46, 0xcd, 0xd4, 0xaa, 0x9a, 0x56, 0x2d, 0x8e, 0x91, 0x84, 0xdd, 0xcc, 0xc5, 0x36,
-	0x6d, 0x01, 0xd4, 0xb7, 0xbd, 0xa0, 0xaf, 0x50, 0x76, 0xcf, 0xc3, 0xfc, 0x98, 0x1b, 0x14, 0xe8,
-	0xfb, 0x5b, 0xd4, 0x65, 0x64, 0x0a, 0x06, 0x4d, 0xab, 0x4a, 0x77, 0x4e, 0x2a, 0x33, 0xca, 0xdc,
-	0x48, 0xc1, 0x7f, 0xd0, 0x6c, 0x38, 0x9d, 0x6a, 0xe3, 0x36, 0x6d, 0xcb, 0xa5, 0x64, 0x0d, 0x46,
-	0x85, 0x66, 0x6e, 0x3a, 0xba, 0x30, 0x97, 0xcb, 0xc8, 0x8c, 0x9c, 0x30, 0x3e, 0xff, 0xc2, 0xe7,
-	0xff, 0x3a, 0x77, 0xa0, 0x20, 0xba, 0xd0, 0xaa, 0x08, 0x72, 0xb9, 0xd1, 0x48, 0x01, 0xf9, 0x00,
-	0x20, 0xca, 0x14, 0x9c, 0xee, 0xa5, 0x9c, 0x9f, 0x56, 0x39, 0x2f, 0xad, 0x72, 0x7e, 0x86, 0x62,
-	0x5a, 0xe5, 0xd6, 0x8c, 0x1a, 0x45, 0xdb, 0x82, 0x60, 0xa9, 0xfd, 0x41, 0x41, 0x5e, 0xc9, 0x69,
-	0x3a, 0xf1, 0x1a, 0xf8, 0x8a, 0xbc, 0xc8, 0x4a, 0x0c, 0xf9, 0x41, 0x8e, 0xfc, 0x62, 0x57, 0xe4,
-	0x3e, 0x9c, 0x18, 0xf4, 0x0d, 0x38, 0x13, 0x20, 0x5f, 0xf3, 0x57, 0xfe, 0xff, 0x13, 0xa2, 0xcf,
-	0x14, 0x38, 0xdb, 0x61, 0x22, 0x0c, 0xd2, 0x3b, 0x30, 0x11, 0xcf, 0x3d, 0x8c, 0xd3, 0x7c, 0x66,
-	0x9c, 0x62, 0xbe, 0x30, 0x52, 0xe3, 0x4d, 0xb1, 0xb1, 0x7f, 0xb1, 0x5a, 0x82, 0x19, 0x4e, 0x21,
-	0x3e, 0x67, 0x8b, 0xaf, 0x4b, 0x10, 0xaf, 0x53, 0x30, 0xec, 0xef, 0x60, 0xb3, 0xca, 0xa3, 0x35,
-	0x50, 0x38, 0xc4, 0x9f, 0x1f, 0x56, 0xb5, 0x1f, 0xc0, 0xf9, 0x0c, 0xf3, 0x8c, 0x28, 0x28, 0x7d,
-	0x88, 0x82, 0x36, 0x05, 0x24, 0xd8, 0x7a, 0xeb, 0xc5, 0x22, 0xc2, 0xd5, 0x9e, 0xc0, 0xd1, 0x58,
-	0x2b, 0xa2, 0x58, 0x84, 0x81, 0xf5, 0x62, 0x11, 0xa7, 0x9e, 0xc9, 0x9c, 0x7a, 0xbd, 0x58, 0xc4,
-	0x09, 0x3d, 0x13, 0xed, 0x3e, 0x9c, 0x0a, 0x1d, 0xba, 0xee, 0x72, 0xb5, 0xea, 0x50, 0x37, 0x4c,
-	0xa6, 0x39, 0x98, 0x2c, 0x9b, 0xac, 0x62, 0x9b, 0x56, 0x29, 0x0c, 0xd2, 0x41, 0x1e, 0xa4, 0x09,
-	0x6c, 0xbf, 0x87, 0xb1, 0xba, 0x1b, 0x1d, 0x2e, 0xa2, 0x1b, 0x84, 0x37, 0x09, 0x03, 0x94, 0xd5,
-	0xf1, 0x68, 0xf1, 0x7e, 0x7a, 0x2d, 0x65, 0x56, 0xe1, 0xce, 0x46, 0x0a, 0xde, 0x4f, 0xed, 0x23,
-	0x05, 0xe6, 0xdb, 0x5d, 0xe4, 0x5b, 0x0f, 0x4c, 0xcb, 0x68, 0x98, 0x1f, 0xd2, 0xea, 0x2a, 0x35,
-	0x6b, 0x75, 0x16, 0x40, 0x5b, 0x80, 0x63, 0x1b, 0x41, 0x4f, 0xc9, 0x63, 0x59, 0xaa, 0xf3, 0x7e,
-	0x5c, 0xc4, 0xa3, 0x61, 0xe7, 0x53, 0xca, 0x0c, 0xdf, 0xb4, 0x07, 0x3a, 0x6f, 0xc3, 0xcb, 0x52,
-	0x58, 0x7a, 0xe0, 0xf7, 0x3d, 0x38, 0xce, 0x5d, 0xae, 0xbb, 0xee, 0xaa, 0xe9, 0x32, 0xdb, 0x69,
-	0xf5, 0x7b, 0xcb, 0xfe, 0x4a, 0x81, 0x13, 0x6d, 0x53, 0x20, 0xc2, 0x65, 0x18, 0x66, 0xae, 0x5b,
-	0x6a, 0x98, 0x2e, 0xc3, 0x6d, 0x2a, 0x9b, 0x25, 0x87, 0x98, 0xeb, 0xbe, 0x65, 0xba, 0xac, 0x7f,
-	0xdb, 0xf2, 0xd7, 0x0a, 0x1c, 0xf1, 0x37, 0x96, 0x63, 0x6f, 0xd3, 0xee, 0x1b, 0x91, 0x9c, 0x80,
-	0x43, 0x6c, 0xa7, 0x54, 0x37, 0xdc, 0x3a, 0x06, 0x74, 0x88, 0xed, 0xac, 0x1a, 0x6e, 0x9d, 0xcc,
-	0xc2, 0x60, 0xd3, 0xb1, 0xed, 0x8d, 0x93, 0x03, 0x1c, 0xcd, 0x78, 0x0e, 0xeb, 0x8d, 0x35, 0xaf,
-	0xb1, 0xe0, 0xf7, 0x91, 0xb3, 0x00, 0x78, 0xc5, 0x7b, 0x0e, 0x5e, 0xe0, 0x0e, 0x46, 0x78, 0x0b,
-	0xf7, 0x71, 0x0a, 0x86, 0xd9, 0x4e, 0xc9, 0xbf, 0xfb, 0x06, 0xfd, 0x79, 0xd9, 0xce, 0x43, 0x7e,
-	0xfb, 0xcd, 0xe3, 0x16, 0x44, 0x9c, 0x18, 0xca, 0x29, 0x18, 0xdc, 0x36, 0x1a, 0x88, 0x72, 0xb8,
-	0xe0, 0x3f, 0x84, 0xdb, 0x75, 0x8d, 0xdf, 0xd2, 0xc1, 0x76, 0xfd, 0x16, 0x6e, 0xd7, 0xa0, 0x35,
-	0x5c, 0x8d, 0x21, 0xff, 0x36, 0xc7, 0xd5, 0x9e, 0xcd, 0x3e, 0x2c, 0xf8, 0x50, 0x5c, 0x0e, 0x34,
-	0xd4, 0xea, 0x30, 0xc5, 0x3d, 0xaf, 0x1a, 0xee, 0x37, 0x6d, 0x46, 0xab, 0x41, 0x18, 0x5f, 0x86,
-	0x23, 0x7e, 0xf5, 0x53, 0x32, 0xab, 0xd4, 0x62, 0xe6, 0x86, 0x49, 0x1d, 0x4c, 0xcc, 0x49, 0xbf,
-	0xe3, 0x61, 0xd8, 0x4e, 0x66, 0x61, 0x7c, 0xdb, 0x66, 0xd4, 0x29, 0x19, 0x7e, 0x86, 0x63, 0x78,
-	0xc7, 0x78, 0x23, 0x66, 0xbd, 0xf6, 0x2a, 0x1c, 0x4b, 0xcc, 0x84, 0x2c, 0x4e, 0xc3, 0x48, 0xdd,
-	0x70, 0x4b, 0xde, 0xe0, 0x20, 0x18, 0xc3, 0x75, 0x1c, 0xa4, 0x7d, 0x1d, 0xa6, 0xb9, 0x55, 0x9e,
-	0xcf, 0x99, 0x6f, 0x45, 0xb3, 0xee, 0x07, 0xa9, 0xc6, 0x60, 0xc4, 0xf3, 0xeb, 0xf0, 0x4c, 0x6c,
-	0x83, 0xad, 0xb4, 0xc3, 0x26, 0x79, 0x18, 0xf1, 0x9e, 0x4b, 0xac, 0xd5, 0xa4, 0x9c, 0xd7, 0xc4,
-	0xc2, 0x85, 0xcc, 0x30, 0x7b, 0xfe, 0xd7, 0x5b, 0x4d, 0x5a, 0x18, 0xde, 0xc6, 0x5f, 0xda, 0xef,
-	0x0f, 0xc2, 0xb9, 0x8e, 0x2c, 0x30, 0x0a, 0x3d, 0x05, 0xfc, 0x0d, 0x18, 0xe2, 0x20, 0xbd, 0x48,
-	0x0f, 0xf0, 0x6d, 0xde, 0x0d, 0x11, 0x67, 0x5c, 0x40, 0x2b, 0xf2, 0x0e, 0x4c, 0xfa, 0xbd, 0x7c,
-	0x27, 0xf9, 0xdc, 0x06, 0x38, 0xb7, 0xcb, 0x99, 0x9e, 0x9e, 0x44, 0x46, 0x9c, 0xe2, 0x61, 0x3b,
-	0xde, 0x40, 0x1e, 0xc3, 0x38, 0xb2, 0x70, 0x99, 0xc1, 0xb6, 0x5c, 0xbe, 0x4f, 0x26, 0x16, 0x2e,
-	0x65, 0x7a, 0xf5, 0xa3, 0x52, 0xe4, 0x06, 0x85, 0xb1, 0xb2, 0xf0, 0xa4, 0x11, 0x98, 0xe4, 0x81,
-	0x7b, 0x82, 0x63, 0x8b, 0x94, 0x69, 0x8b, 0x70, 0x32, 0xd9, 0x16, 0x46, 0xf1, 0x0c, 0x8c, 0x04,
-	0x6e, 0xfd, 0x3a, 0x62, 0xa4, 0x10, 0x35, 0x68, 0xc7, 0x31, 0xd9, 0x8b, 0x5b, 0xcd, 0xa6, 0xed,
-	0x30, 0x5a, 0xe5, 0xe7, 0xb4, 0xab, 0xdd, 0xc7, 0x62, 0x28, 0xd1, 0x1e, 0x7a, 0xbd, 0x00, 0x43,
-	0x1c, 0x7b, 0x50, 0x9a, 0x84, 0x07, 0x84, 0x7f, 0x87, 0x63, 0xa7, 0x76, 0x07, 0xb4, 0x58, 0x95,
-	0xeb, 0x6f, 0xb8, 0x07, 0xb6, 0x23, 0x5b, 0x29, 0x38, 0x30, 0x9b, 0xe9, 0x00, 0xe1, 0xbc, 0x09,
-	0x63, 0xbe, 0x87, 0xd8, 0xe6, 0x97, 0xa8, 0x2b, 0xf1, 0xf8, 0x18, 0xad, 0x44, 0x0f, 0xda, 0x99,
-	0x44, 0x39, 0x1f, 0x3f, 0x78, 0xac, 0x44, 0xe1, 0x9e, 0x38, 0x80, 0x9e, 0xa4, 0x22, 0xb9, 0x2c,
-	0x8b, 0x84, 0xe7, 0x64, 0x0c, 0x8d, 0x20, 0x2e, 0x1e, 0xdb, 0x55, 0xba, 0xec, 0x8b, 0x9b, 0x6c,
-	0x71, 0xf1, 0xfd, 0x08, 0x63, 0xcc, 0x26, 0x8a, 0x96, 0x28, 0x94, 0xa4, 0xa2, 0x25, 0xfa, 0x19,
-	0xb5, 0xa2, 0x07, 0x51, 0x57, 0xa4, 0xe0, 0xeb, 0xd7, 0x0d, 0xfc, 0xa9, 0xa0, 0x2b, 0xd2, 0x28,
-	0x3d, 0x82, 0x51, 0xa1, 0x59, 0x4a, 0x57, 0xc4, 0x18, 0x09, 0x0f, 0xfd, 0xbb, 0x8e, 0x67, 0xf0,
-	0xa4, 0xf6, 0x52, 0x25, 0x14, 0xb4, 0x0f, 0x3c, 0x3d, 0x1b, 0x24, 0xd3, 0x8f, 0x14, 0x3c, 0x06,
-	0xd3, 0x86, 0x20, 0xb5, 0xef, 0xc0, 0x64, 0x52, 0x0e, 0xcb, 0x65, 0x55, 0xdc, 0x1f, 0xde, 0x72,
-	0x87, 0x2b, 0xf1, 0x66, 0xed, 0x04, 0x5e, 0x42, 0x2b, 0x94, 0xbd, 0xc9, 0x45, 0x75, 0x80, 0xed,
-	0x1b, 0x58, 0x56, 0x09, 0x1d, 0x88, 0xe8, 0x16, 0x0c, 0xf9, 0xfa, 0x5b, 0xea, 0x92, 0x45, 0x63,
-	0x34, 0xd1, 0xce, 0xa1, 0xfa, 0x29, 0xd6, 0xed, 0x0f, 0x82, 0xf3, 0xea, 0x9e, 0x90, 0x32, 0x5e,
-	0x4c, 0xa6, 0x3b, 0x8d, 0x40, 0x00, 0xdf, 0x85, 0xa3, 0x0d, 0xc3, 0x65, 0xa5, 0x60, 0x8e, 0x92,
-	0x98, 0xc7, 0xb9, 0x4c, 0x34, 0x6f, 0x19, 0x2e, 0x8b, 0x3b, 0x3d, 0xd2, 0x48, 0x36, 0x69, 0x8f,
-	0x10, 0x63, 0xbe, 0x61, 0x6c, 0xd2, 0xb4, 0x1b, 0xf6, 0x12, 0x4c, 0xf2, 0x7f, 0x79, 0xb4, 0xdf,
-	0x4c, 0x87, 0x79, 0xbb, 0x70, 0xbf, 0x56, 0x82, 0xeb, 0xba, 0xdd, 0x57, 0x58, 0xb3, 0x00, 0x3a,
-	0xb3, 0x36, 0x6c, 0x24, 0xa1, 0x65, 0x5f, 0x0f, 0xde, 0x70, 0xaf, 0xd4, 0xf2, 0xa6, 0xb2, 0x36,
-	0x6c, 0x8d, 0x46, 0xbb, 0xc3, 0xef, 0xa3, 0x15, 0xdb, 0xa9, 0xf6, 0x5d, 0xba, 0xfe, 0x56, 0x89,
-	0x34, 0x72, 0x7c, 0x1e, 0xa4, 0xb2, 0x92, 0xa0, 0x32, 0x20, 0x47, 0x05, 0x73, 0x33, 0x22, 0xd4,
-	0xbf, 0x3d, 0x58, 0x44, 0xa5, 0x8a, 0xe1, 0xe7, 0x47, 0xed, 0xb2, 0x55, 0xe5, 0x52, 0x50, 0xa2,
-	0x40, 0x9e, 0x82, 0x41, 0x2e, 0x3e, 0x51, 0xcd, 0xf8, 0x0f, 0xda, 0x06, 0xea, 0xd7, 0x74, 0xa7,
-	0x1d, 0x96, 0x75, 0xa0, 0xf7, 0x65, 0x15, 0xce, 0xd6, 0x3c, 0x2f, 0xab, 0xf9, 0xbf, 0xd2, 0xfa,
-	0xbd, 0xaa, 0x9f, 0x28, 0x62, 0xf6, 0x08, 0xd3, 0x84, 0x12, 0x78, 0x5c, 0xfc, 0x4f, 0x5e, 0x70,
-	0xe5, 0x1f, 0x0d, 0xae, 0x7c, 0xd1, 0x66, 0xac, 0x1c, 0x3d, 0xf4, 0xf1, 0xff, 0x0d, 0xcb, 0xb8,
-	0x8a, 0x2b, 0x94, 0x09, 0xb3, 0xe5, 0xbd, 0xca, 0xb9, 0x1e, 0x84, 0x23, 0xae, 0x46, 0xbc, 0x70,
-	0x8c, 0x09, 0x6a, 0x44, 0x7b, 0x17, 0xd7, 0x2c, 0xdd, 0x05, 0x52, 0x7d, 0x1d, 0xc6, 0x44, 0xaa,
-	0x18, 0xd4, 0x54, 0xa6, 0xa3, 0x02, 0x53, 0xed, 0x76, 0x74, 0x8c, 0x0b, 0x63, 0xbc, 0x8a, 0x4d,
-	0x22, 0xc9, 0xb4, 0x1f, 0xa6, 0xb2, 0x43, 0x6b, 0x44, 0xf6, 0x2e, 0x10, 0x11, 0x19, 0x2f, 0x26,
-	0x29, 0xe2, 0xbb, 0xd2, 0x25, 0xab, 0x12, 0x2e, 0x27, 0xcb, 0x89, 0x96, 0x85, 0x5f, 0xce, 0xc1,
-	0x20, 0x47, 0x40, 0x3e, 0x56, 0x60, 0xc8, 0x2f, 0x3c, 0x88, 0x9e, 0xe9, 0xb5, 0x5d, 0x92, 0xa9,
-	0xaf, 0xc8, 0x1b, 0xf8, 0xa4, 0xb4, 0xd9, 0x1f, 0xff, 0xed, 0x3f, 0x3f, 0x3d, 0x78, 0x96, 0x9c,
-	0xd6, 0xbd, 0xf1, 0x57, 0xb8, 0xa9, 0x9e, 0xf8, 0xb7, 0x2c, 0xf9, 0x93, 0x02, 0xc3, 0x81, 0x42,
-	0x22, 0x57, 0xbb, 0xcf, 0x91, 0xd0, 0x6d, 0xea, 0x42, 0x2f, 0x26, 0x08, 0xec, 0x11, 0x07, 0xf6,
-	0x35, 0x92, 0x4f, 0x05, 0x16, 0x6a, 0x33, 0x7d, 0xb7, 0x4d, 0xa0, 0xec, 0xe9, 0xbb, 0x31, 0x05,
-	0xb5, 0x47, 0xfe, 0xae, 0x00, 0x69, 0x57, 0x39, 0xe4, 0x56, 0x77, 0x58, 0x1d, 0x15, 0x9e, 0x7a,
-	0x7b, 0x7f, 0xc6, 0xc8, 0xee, 0x3e, 0x67, 0x77, 0x87, 0x2c, 0xa5, 0xb2, 0x43, 0x4a, 0xe5, 0x96,
-	0xc0, 0x2a, 0x8d, 0x28, 0xf9, 0x85, 0x02, 0xa3, 0x82, 0xe2, 0x20, 0x57, 0xba, 0x83, 0x12, 0x86,
-	0xab, 0xaf, 0xf5, 0x34, 0x3c, 0x04, 0x7f, 0x89, 0x83, 0x9f, 0x25, 0xe7, 0x53, 0xc1, 0x87, 0x15,
-	0x81, 0x4b, 0x19, 0xf9, 0x8d, 0x02, 0x87, 0x13, 0x02, 0x46, 0x26, 0x81, 0x12, 0x26, 0xea, 0x8d,
-	0x9e, 0x4d, 0x42, 0xb0, 0x97, 0x39, 0xd8, 0x97, 0xc8, 0x8b, 0xa9, 0x60, 0xdd, 0x04, 0xb6, 0x7f,
-	0x2b, 0x70, 0x3c, 0x5d, 0xe8, 0x90, 0x3b, 0xdd, 0x31, 0x64, 0x6a, 0x2c, 0xf5, 0xee, 0xfe, 0x1d,
-	0x20, 0x97, 0x3c, 0xe7, 0x72, 0x9b, 0xdc, 0x4c, 0xe5, 0x52, 0xa3, 0xac, 0x24, 0x0a, 0x9f, 0xd2,
-	0x86, 0xed, 0xf8, 0x0d, 0xfa, 0x6e, 0x70, 0xee, 0xed, 0x91, 0x4f, 0x15, 0x98, 0x88, 0x4f, 0x43,
-	0xae, 0xf7, 0x0a, 0x2c, 0x60, 0xb4, 0xd8, 0xbb, 0x21, 0x32, 0xb9, 0xc2, 0x99, 0x5c, 0x24, 0x17,
-	0xa4, 0x98, 0x78, 0xa0, 0x63, 0xfa, 0x40, 0x0e, 0x71, 0xbb, 0x18, 0x92, 0x44, 0x9c, 0x22, 0x6f,
-	0xb4, 0x57, 0x38, 0xe2, 0x79, 0x32, 0x97, 0x8a, 0x58, 0x90, 0x63, 0xfa, 0x2e, 0x57, 0x80, 0x7b,
-	0x5e, 0xee, 0x4f, 0x08, 0x9e, 0x96, 0x1b, 0x0d, 0x19, 0xdc, 0xa9, 0x22, 0x4e, 0x06, 0x77, 0xba,
-	0x2c, 0xd3, 0xe6, 0x38, 0x6e, 0x8d, 0xcc, 0x74, 0xc3, 0x4d, 0xfe, 0xa8, 0xc0, 0xe1, 0x84, 0x62,
-	0x91, 0x39, 0x22, 0x3b, 0x4a, 0x2b, 0x99, 0x23, 0xb2, 0xb3, 0xe8, 0xea, 0x92, 0x22, 0x49, 0x3d,
-	0x46, 0x7e, 0xa6, 0xc0, 0x90, 0xaf, 0x73, 0xc8, 0x82, 0xd4, 0xbc, 0x31, 0xa9, 0xa5, 0x5e, 0xeb,
-	0xc9, 0x46, 0xea, 0xf2, 0xf4, 0xd5, 0x16, 0xf9, 0xb3, 0x02, 0x47, 0xda, 0x74, 0x14, 0xb9, 0x29,
-	0x71, 0xa2, 0x75, 0x90, 0x67, 0xea, 0xad, 0x7d, 0xd9, 0x22, 0xe6, 0x1b, 0x1c, 0xf3, 0x35, 0x72,
-	0x55, 0xc4, 0x1c, 0x78, 0x11, 0x0e, 0xc6, 0xba, 0xfd, 0x41, 0x42, 0xdc, 0x91, 0xbf, 0x2a, 0x70,
-	0xa4, 0x4d, 0x43, 0xc9, 0x30, 0xe9, 0x24, 0xe2, 0x64, 0x98, 0x74, 0x14, 0x6d, 0xda, 0x3d, 0xce,
-	0x64, 0x89, 0xdc, 0x4a, 0xbf, 0x43, 0x79, 0xe1, 0x9f, 0xbc, 0x42, 0x13, 0x8a, 0x71, 0xcf, 0x2b,
-	0x6d, 0xc8, 0x0a, 0x65, 0x09, 0x35, 0x45, 0xe4, 0xf6, 0x5b, 0x8a, 0xd0, 0x93, 0xb9, 0xaa, 0x3a,
-	0x48, 0x37, 0x6d, 0x81, 0x13, 0xba, 0x4c, 0xe6, 0x3b, 0x1e, 0x8a, 0x46, 0xa3, 0x51, 0xf2, 0x39,
-	0x38, 0x08, 0xf4, 0x4b, 0x05, 0x8e, 0x71, 0x67, 0x6e, 0x42, 0x04, 0x91, 0x25, 0xe9, 0xd8, 0xa6,
-	0x29, 0x32, 0xf5, 0x8d, 0xfd, 0x9a, 0x23, 0x99, 0x55, 0x4e, 0x26, 0x4f, 0xee, 0x66, 0xaf, 0x8e,
-	0xbf, 0x85, 0x0d, 0xab, 0xea, 0xbf, 0x61, 0x14, 0x6e, 0x2a, 0x7d, 0x97, 0xb7, 0xec, 0x79, 0xe7,
-	0x52, 0xb8, 0x44, 0x82, 0xb2, 0xb9, 0x2e, 0x19, 0xe8, 0xa4, 0x68, 0x53, 0x17, 0x7b, 0x37, 0xec,
-	0x71, 0x81, 0x04, 0xa5, 0x46, 0xfe, 0xa9, 0xc0, 0x54, 0x9a, 0xe0, 0x91, 0x59, 0x9f, 0x0c, 0xad,
-	0x25, 0xb3, 0x3e, 0x59, 0x3a, 0x4b, 0xa2, 0x96, 0x88, 0x89, 0x9d, 0x72, 0x8b, 0x8b, 0x3a, 0x6f,
-	0x0b, 0x05, 0x02, 0x6f, 0x8f, 0xfc, 0x57, 0x01, 0x35, 0x45, 0x31, 0x61, 0x4a, 0x90, 0xdb, 0xbd,
-	0x42, 0x14, 0xd5, 0x9a, 0xba, 0xb4, 0x4f, 0x6b, 0x29, 0xfd, 0xd0, 0xc6, 0x8f, 0x8b, 0xb9, 0x28,
-	0x21, 0xcd, 0xaa, 0x58, 0x33, 0xfd, 0x44, 0x81, 0x41, 0xfe, 0x9e, 0x8c, 0xe4, 0x24, 0x04, 0x96,
-	0xf0, 0xe2, 0x4f, 0xd5, 0xa5, 0xc7, 0x23, 0x6c, 0x8d, 0xc3, 0x3e, 0x43, 0xd4, 0x74, 0x3d, 0xc6,
-	0x41, 0x7c, 0xa6, 0xc0, 0x78, 0xec, 0xe5, 0x2d, 0x79, 0x5d, 0x2a, 0x56, 0x6d, 0xef, 0xc0, 0xd5,
-	0xeb, 0x3d, 0xdb, 0x21, 0xcc, 0x3b, 0x1c, 0xe6, 0x0d, 0x72, 0xbd, 0x63, 0x74, 0x99, 0xeb, 0x06,
-	0x02, 0x4c, 0xdf, 0x4d, 0xbe, 0x99, 0xde, 0x23, 0x3f, 0x3f, 0x08, 0xd3, 0xd9, 0x2f, 0xa0, 0xc9,
-	0x4a, 0x8f, 0xe0, 0x3a, 0xbd, 0x4e, 0x57, 0x57, 0xbf, 0xba, 0x23, 0xa4, 0x5d, 0xe6, 0xb4, 0xbf,
-	0x4d, 0x9e, 0xca, 0xd0, 0x2e, 0xd5, 0xf9, 0x7b, 0x6a, 0xb3, 0x62, 0x34, 0xf4, 0xdd, 0xd4, 0xf7,
-	0xf9, 0x7b, 0x69, 0x91, 0xf9, 0x48, 0xe1, 0xdf, 0x3b, 0xc8, 0x88, 0xff, 0xd8, 0xe7, 0x13, 0x32,
-	0xe2, 0x3f, 0xfe, 0x65, 0x85, 0x36, 0xc3, 0xe9, 0xa8, 0xe4, 0x64, 0x2a, 0x1d, 0x0f, 0xc4, 0x27,
-	0x0a, 0x40, 0xf4, 0xc6, 0x9d, 0x48, 0x54, 0x49, 0x6d, 0x9f, 0x00, 0xa8, 0xaf, 0xf6, 0x66, 0x84,
-	0xd8, 0x2e, 0x72, 0x6c, 0xe7, 0xc9, 0xb9, 0x54, 0x6c, 0x2c, 0xc2, 0xf4, 0x3b, 0x05, 0x26, 0x63,
-	0x9f, 0x9c, 0x78, 0x85, 0xb6, 0xdc, 0x2d, 0x9c, 0xf6, 0x91, 0x91, 0x7a, 0x73, 0x3f, 0xa6, 0x08,
-	0x7a, 0x9e, 0x83, 0x7e, 0x91, 0x68, 0xe9, 0xbb, 0x37, 0xf6, 0x25, 0xd0, 0x5f, 0x14, 0x98, 0x4a,
-	0xfb, 0xfa, 0x46, 0xe6, 0x62, 0xc8, 0xf8, 0xe8, 0x47, 0xe6, 0x62, 0xc8, 0xfa, 0xe8, 0x47, 0x7b,
-	0x8d, 0x73, 0xd0, 0xc9, 0x95, 0xee, 0x1c, 0x12, 0xba, 0x32, 0xf6, 0x51, 0x58, 0x0f, 0xa2, 0x32,
-	0x1e, 0xff, 0xc5, 0xde, 0x0d, 0xa5, 0x24, 0x5a, 0x25, 0xb2, 0x88, 0x49, 0x34, 0xc1, 0x93, 0xbc,
-	0x44, 0xdb, 0x1f, 0xee, 0xf4, 0x2f, 0xf2, 0xba, 0x48, 0x34, 0x01, 0x77, 0xfe, 0xe1, 0xe7, 0xcf,
-	0xa6, 0x95, 0x2f, 0x9e, 0x4d, 0x2b, 0x5f, 0x3e, 0x9b, 0x56, 0x3e, 0x7e, 0x3e, 0x7d, 0xe0, 0x8b,
-	0xe7, 0xd3, 0x07, 0xfe, 0xf1, 0x7c, 0xfa, 0xc0, 0x53, 0xbd, 0x66, 0xb2, 0xfa, 0x56, 0x39, 0x57,
-	0xb1, 0x37, 0x53, 0x0b, 0xfb, 0x1d, 0x61, 0xef, 0xb4, 0x9a, 0xd4, 0x2d, 0x0f, 0xf1, 0x0f, 0x27,
-	0xaf, 0xfd, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x52, 0xe2, 0xbb, 0x9a, 0x01, 0x2b, 0x00, 0x00,
+func (m *QueryGetKeygenRequest) Reset()         { *m = QueryGetKeygenRequest{} }
+func (m *QueryGetKeygenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeygenRequest) ProtoMessage()    {}
+func (*QueryGetKeygenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{57}
+}
+func (m *QueryGetKeygenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetKeygenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeygenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetKeygenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeygenRequest.Merge(m, src)
+}
+func (m *QueryGetKeygenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetKeygenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeygenRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetKeygenRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Query if a voter has voted for a ballot
-	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error)
-	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetChainParamsForChain items.
-	GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error)
-	// Queries a list of GetChainParams items.
-	GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
-	GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error)
-	GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error)
-	GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error)
-	// merkle proof verification
-	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
-	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
-	// Queries a tSS by index.
-	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
-	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
-	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
+type QueryGetKeygenResponse struct {
+	Keygen *Keygen `protobuf:"bytes,1,opt,name=keygen,proto3" json:"keygen,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetKeygenResponse) Reset()         { *m = QueryGetKeygenResponse{} }
+func (m *QueryGetKeygenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetKeygenResponse) ProtoMessage()    {}
+func (*QueryGetKeygenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{58}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetKeygenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetKeygenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetKeygenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
-	out := new(QueryHasVotedResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/HasVoted", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetKeygenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetKeygenResponse.Merge(m, src)
 }
-
-func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
-	out := new(QueryBallotByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetKeygenResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error) {
-	out := new(QueryObserverSetResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverSet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetKeygenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetKeygenResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
-	out := new(QuerySupportedChainsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetKeygenResponse proto.InternalMessageInfo
+
+func (m *QueryGetKeygenResponse) GetKeygen() *Keygen {
+	if m != nil {
+		return m.Keygen
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error) {
-	out := new(QueryGetChainParamsForChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParamsForChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryShowObserverCountRequest struct {
 }
 
-func (c *queryClient) GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error) {
-	out := new(QueryGetChainParamsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryShowObserverCountRequest) Reset()         { *m = QueryShowObserverCountRequest{} }
+func (m *QueryShowObserverCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryShowObserverCountRequest) ProtoMessage()    {}
+func (*QueryShowObserverCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{59}
 }
-
-func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
-	out := new(QueryGetNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryShowObserverCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
-	out := new(QueryAllNodeAccountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShowObserverCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowObserverCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
-	out := new(QueryGetCrosschainFlagsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryShowObserverCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowObserverCountRequest.Merge(m, src)
 }
-
-func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
-	out := new(QueryGetKeygenResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryShowObserverCountRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
-	out := new(QueryShowObserverCountResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryShowObserverCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowObserverCountRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
-	out := new(QueryBlameByIdentifierResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_QueryShowObserverCountRequest proto.InternalMessageInfo
 
-func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
-	out := new(QueryAllBlameRecordsResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryShowObserverCountResponse struct {
+	LastObserverCount *LastObserverCount `protobuf:"bytes,1,opt,name=last_observer_count,json=lastObserverCount,proto3" json:"last_observer_count,omitempty"`
 }
 
-func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
-	out := new(QueryBlameByChainAndNonceResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryShowObserverCountResponse) Reset()         { *m = QueryShowObserverCountResponse{} }
+func (m *QueryShowObserverCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryShowObserverCountResponse) ProtoMessage()    {}
+func (*QueryShowObserverCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{60}
 }
-
-func (c *queryClient) GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error) {
-	out := new(QueryAllBlockHeaderResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlockHeaders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryShowObserverCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryShowObserverCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryShowObserverCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryShowObserverCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryShowObserverCountResponse.Merge(m, src)
+}
+func (m *QueryShowObserverCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryShowObserverCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryShowObserverCountResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error) {
-	out := new(QueryGetBlockHeaderByHashResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryShowObserverCountResponse proto.InternalMessageInfo
+
+func (m *QueryShowObserverCountResponse) GetLastObserverCount() *LastObserverCount {
+	if m != nil {
+		return m.LastObserverCount
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error) {
-	out := new(QueryGetBlockHeaderStateResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryBlameByIdentifierRequest struct {
+	BlameIdentifier string `protobuf:"bytes,1,opt,name=blame_identifier,json=blameIdentifier,proto3" json:"blame_identifier,omitempty"`
 }
 
-func (c *queryClient) Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error) {
-	out := new(QueryProveResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Prove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlameByIdentifierRequest) Reset()         { *m = QueryBlameByIdentifierRequest{} }
+func (m *QueryBlameByIdentifierRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByIdentifierRequest) ProtoMessage()    {}
+func (*QueryBlameByIdentifierRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{61}
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByIdentifierRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByIdentifierRequest.Merge(m, src)
+}
+func (m *QueryBlameByIdentifierRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameByIdentifierRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByIdentifierRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
-	out := new(QueryGetTssAddressResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameByIdentifierRequest proto.InternalMessageInfo
+
+func (m *QueryBlameByIdentifierRequest) GetBlameIdentifier() string {
+	if m != nil {
+		return m.BlameIdentifier
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	out := new(QueryGetTssAddressByFinalizedHeightResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryBlameByIdentifierResponse struct {
+	BlameInfo *Blame `protobuf:"bytes,1,opt,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
 }
 
-func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
-	out := new(QueryGetTSSResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TSS", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBlameByIdentifierResponse) Reset()         { *m = QueryBlameByIdentifierResponse{} }
+func (m *QueryBlameByIdentifierResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByIdentifierResponse) ProtoMessage()    {}
+func (*QueryBlameByIdentifierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{62}
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByIdentifierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByIdentifierResponse.Merge(m, src)
+}
+func (m *QueryBlameByIdentifierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameByIdentifierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByIdentifierResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
-	out := new(QueryTssHistoryResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBlameByIdentifierResponse proto.InternalMessageInfo
+
+func (m *QueryBlameByIdentifierResponse) GetBlameInfo() *Blame {
+	if m != nil {
+		return m.BlameInfo
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
-	out := new(QueryAllPendingNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAllBlameRecordsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
-	out := new(QueryPendingNoncesByChainResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesByChain", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryAllBlameRecordsRequest) Reset()         { *m = QueryAllBlameRecordsRequest{} }
+func (m *QueryAllBlameRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlameRecordsRequest) ProtoMessage()    {}
+func (*QueryAllBlameRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{63}
 }
-
-func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
-	out := new(QueryGetChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNonces", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAllBlameRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlameRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlameRecordsRequest.Merge(m, src)
+}
+func (m *QueryAllBlameRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlameRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlameRecordsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
-	out := new(QueryAllChainNoncesResponse)
-	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNoncesAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllBlameRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryAllBlameRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Query if a voter has voted for a ballot
-	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
-	// Queries a list of ObserversByChainAndType items.
-	ObserverSet(context.Context, *QueryObserverSet) (*QueryObserverSetResponse, error)
-	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
-	// Queries a list of GetChainParamsForChain items.
-	GetChainParamsForChain(context.Context, *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error)
-	// Queries a list of GetChainParams items.
-	GetChainParams(context.Context, *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error)
-	// Queries a nodeAccount by index.
-	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
-	// Queries a list of nodeAccount items.
-	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
-	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
-	// Queries a keygen by index.
-	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
-	// Queries a list of ShowObserverCount items.
-	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
-	// Queries a list of VoterByIdentifier items.
-	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
-	GetAllBlockHeaders(context.Context, *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error)
-	GetBlockHeaderByHash(context.Context, *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error)
-	GetBlockHeaderStateByChain(context.Context, *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error)
-	// merkle proof verification
-	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
-	// Queries a list of GetTssAddress items.
-	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
-	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
-	// Queries a tSS by index.
-	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
-	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
-	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
-	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
-	// Queries a chainNonces by index.
-	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
-	// Queries a list of chainNonces items.
-	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
+type QueryAllBlameRecordsResponse struct {
+	BlameInfo  []Blame             `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllBlameRecordsResponse) Reset()         { *m = QueryAllBlameRecordsResponse{} }
+func (m *QueryAllBlameRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlameRecordsResponse) ProtoMessage()    {}
+func (*QueryAllBlameRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{64}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllBlameRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
+func (m *QueryAllBlameRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlameRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
+func (m *QueryAllBlameRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlameRecordsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ObserverSet(ctx context.Context, req *QueryObserverSet) (*QueryObserverSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ObserverSet not implemented")
+func (m *QueryAllBlameRecordsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
+func (m *QueryAllBlameRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlameRecordsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetChainParamsForChain(ctx context.Context, req *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChainParamsForChain not implemented")
+
+var xxx_messageInfo_QueryAllBlameRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryAllBlameRecordsResponse) GetBlameInfo() []Blame {
+	if m != nil {
+		return m.BlameInfo
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetChainParams(ctx context.Context, req *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetChainParams not implemented")
+
+func (m *QueryAllBlameRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+
+type QueryBlameByChainAndNonceRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Nonce   int64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
-func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+
+func (m *QueryBlameByChainAndNonceRequest) Reset()         { *m = QueryBlameByChainAndNonceRequest{} }
+func (m *QueryBlameByChainAndNonceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByChainAndNonceRequest) ProtoMessage()    {}
+func (*QueryBlameByChainAndNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{65}
 }
-func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByChainAndNonceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByChainAndNonceRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+func (m *QueryBlameByChainAndNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByChainAndNonceRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+
+var xxx_messageInfo_QueryBlameByChainAndNonceRequest proto.InternalMessageInfo
+
+func (m *QueryBlameByChainAndNonceRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetAllBlockHeaders(ctx context.Context, req *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlockHeaders not implemented")
+
+func (m *QueryBlameByChainAndNonceRequest) GetNonce() int64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) GetBlockHeaderByHash(ctx context.Context, req *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHash not implemented")
+
+type QueryBlameByChainAndNonceResponse struct {
+	BlameInfo []*Blame `protobuf:"bytes,1,rep,name=blame_info,json=blameInfo,proto3" json:"blame_info,omitempty"`
 }
-func (*UnimplementedQueryServer) GetBlockHeaderStateByChain(ctx context.Context, req *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderStateByChain not implemented")
+
+func (m *QueryBlameByChainAndNonceResponse) Reset()         { *m = QueryBlameByChainAndNonceResponse{} }
+func (m *QueryBlameByChainAndNonceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameByChainAndNonceResponse) ProtoMessage()    {}
+func (*QueryBlameByChainAndNonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{66}
 }
-func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveRequest) (*QueryProveResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
+func (m *QueryBlameByChainAndNonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+func (m *QueryBlameByChainAndNonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameByChainAndNonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
+func (m *QueryBlameByChainAndNonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameByChainAndNonceResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+func (m *QueryBlameByChainAndNonceResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+func (m *QueryBlameByChainAndNonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameByChainAndNonceResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+
+var xxx_messageInfo_QueryBlameByChainAndNonceResponse proto.InternalMessageInfo
+
+func (m *QueryBlameByChainAndNonceResponse) GetBlameInfo() []*Blame {
+	if m != nil {
+		return m.BlameInfo
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+
+type QueryBlameStatisticsRequest struct {
+	// chain to count the blames for, 0 for all chains
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// number of recent blocks the blames are counted in, 0 for all the retained blames
+	WindowBlocks int64 `protobuf:"varint,2,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
 }
-func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+
+func (m *QueryBlameStatisticsRequest) Reset()         { *m = QueryBlameStatisticsRequest{} }
+func (m *QueryBlameStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameStatisticsRequest) ProtoMessage()    {}
+func (*QueryBlameStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{67}
 }
-func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
+func (m *QueryBlameStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryBlameStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameStatisticsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlameStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameStatisticsRequest.Merge(m, src)
+}
+func (m *QueryBlameStatisticsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameStatisticsRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryBlameStatisticsRequest proto.InternalMessageInfo
+
+func (m *QueryBlameStatisticsRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHasVotedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HasVoted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/HasVoted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
+func (m *QueryBlameStatisticsRequest) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBallotByIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BallotByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBlameStatisticsResponse struct {
+	// nodes ordered by decreasing number of blames
+	NodeStatistics []NodeBlameStatistics `protobuf:"bytes,1,rep,name=node_statistics,json=nodeStatistics,proto3" json:"node_statistics"`
+	// first zeta height of the window
+	FromHeight int64 `protobuf:"varint,2,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
 }
 
-func _Query_ObserverSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryObserverSet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ObserverSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ObserverSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ObserverSet(ctx, req.(*QueryObserverSet))
+func (m *QueryBlameStatisticsResponse) Reset()         { *m = QueryBlameStatisticsResponse{} }
+func (m *QueryBlameStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlameStatisticsResponse) ProtoMessage()    {}
+func (*QueryBlameStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{68}
+}
+func (m *QueryBlameStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlameStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlameStatisticsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBlameStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlameStatisticsResponse.Merge(m, src)
+}
+func (m *QueryBlameStatisticsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlameStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlameStatisticsResponse.DiscardUnknown(m)
 }
 
-func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupportedChains)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).SupportedChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
+var xxx_messageInfo_QueryBlameStatisticsResponse proto.InternalMessageInfo
+
+func (m *QueryBlameStatisticsResponse) GetNodeStatistics() []NodeBlameStatistics {
+	if m != nil {
+		return m.NodeStatistics
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetChainParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainParamsForChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetChainParamsForChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParamsForChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetChainParamsForChain(ctx, req.(*QueryGetChainParamsForChainRequest))
+func (m *QueryBlameStatisticsResponse) GetFromHeight() int64 {
+	if m != nil {
+		return m.FromHeight
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetChainParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetChainParams(ctx, req.(*QueryGetChainParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllBlockHeaderRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetNodeAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
+func (m *QueryAllBlockHeaderRequest) Reset()         { *m = QueryAllBlockHeaderRequest{} }
+func (m *QueryAllBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlockHeaderRequest) ProtoMessage()    {}
+func (*QueryAllBlockHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{69}
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlockHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlockHeaderRequest.Merge(m, src)
+}
+func (m *QueryAllBlockHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlockHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlockHeaderRequest.DiscardUnknown(m)
 }
 
-func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllNodeAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeAccountAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
+var xxx_messageInfo_QueryAllBlockHeaderRequest proto.InternalMessageInfo
+
+func (m *QueryAllBlockHeaderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCrosschainFlagsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CrosschainFlags(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAllBlockHeaderResponse struct {
+	BlockHeaders []*common.BlockHeader `protobuf:"bytes,1,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"`
+	Pagination   *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetKeygenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Keygen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAllBlockHeaderResponse) Reset()         { *m = QueryAllBlockHeaderResponse{} }
+func (m *QueryAllBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllBlockHeaderResponse) ProtoMessage()    {}
+func (*QueryAllBlockHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{70}
 }
-
-func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryShowObserverCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ShowObserverCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
+func (m *QueryAllBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllBlockHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAllBlockHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllBlockHeaderResponse.Merge(m, src)
+}
+func (m *QueryAllBlockHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllBlockHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllBlockHeaderResponse.DiscardUnknown(m)
 }
 
-func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByIdentifierRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlameByIdentifier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+var xxx_messageInfo_QueryAllBlockHeaderResponse proto.InternalMessageInfo
+
+func (m *QueryAllBlockHeaderResponse) GetBlockHeaders() []*common.BlockHeader {
+	if m != nil {
+		return m.BlockHeaders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlameRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+func (m *QueryAllBlockHeaderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlameByChainAndNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetBlockHeaderByHashRequest struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func _Query_GetAllBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBlockHeaderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllBlockHeaders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlockHeaders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllBlockHeaders(ctx, req.(*QueryAllBlockHeaderRequest))
+func (m *QueryGetBlockHeaderByHashRequest) Reset()         { *m = QueryGetBlockHeaderByHashRequest{} }
+func (m *QueryGetBlockHeaderByHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderByHashRequest) ProtoMessage()    {}
+func (*QueryGetBlockHeaderByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{71}
+}
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.Merge(m, src)
+}
+func (m *QueryGetBlockHeaderByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockHeaderByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderByHashRequest.DiscardUnknown(m)
 }
 
-func _Query_GetBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlockHeaderByHashRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBlockHeaderByHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBlockHeaderByHash(ctx, req.(*QueryGetBlockHeaderByHashRequest))
+var xxx_messageInfo_QueryGetBlockHeaderByHashRequest proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderByHashRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetBlockHeaderStateByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetBlockHeaderStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, req.(*QueryGetBlockHeaderStateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetBlockHeaderByHashResponse struct {
+	BlockHeader *common.BlockHeader `protobuf:"bytes,1,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
 }
 
-func _Query_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProveRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Prove(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/Prove",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Prove(ctx, req.(*QueryProveRequest))
+func (m *QueryGetBlockHeaderByHashResponse) Reset()         { *m = QueryGetBlockHeaderByHashResponse{} }
+func (m *QueryGetBlockHeaderByHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderByHashResponse) ProtoMessage()    {}
+func (*QueryGetBlockHeaderByHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{72}
+}
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.Merge(m, src)
+}
+func (m *QueryGetBlockHeaderByHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockHeaderByHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderByHashResponse.DiscardUnknown(m)
 }
 
-func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+var xxx_messageInfo_QueryGetBlockHeaderByHashResponse proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderByHashResponse) GetBlockHeader() *common.BlockHeader {
+	if m != nil {
+		return m.BlockHeader
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTssAddressByFinalizedHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, req.(*QueryGetTssAddressByFinalizedHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetBlockHeaderStateRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
-func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetTSSRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TSS(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/TSS",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
+func (m *QueryGetBlockHeaderStateRequest) Reset()         { *m = QueryGetBlockHeaderStateRequest{} }
+func (m *QueryGetBlockHeaderStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderStateRequest) ProtoMessage()    {}
+func (*QueryGetBlockHeaderStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{73}
+}
+func (m *QueryGetBlockHeaderStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockHeaderStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetBlockHeaderStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderStateRequest.Merge(m, src)
+}
+func (m *QueryGetBlockHeaderStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockHeaderStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderStateRequest.DiscardUnknown(m)
 }
 
-func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTssHistoryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TssHistory(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/TssHistory",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
+var xxx_messageInfo_QueryGetBlockHeaderStateRequest proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderStateRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPendingNoncesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryGetBlockHeaderStateResponse struct {
+	BlockHeaderState *BlockHeaderState `protobuf:"bytes,1,opt,name=block_header_state,json=blockHeaderState,proto3" json:"block_header_state,omitempty"`
 }
 
-func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingNoncesByChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesByChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
+func (m *QueryGetBlockHeaderStateResponse) Reset()         { *m = QueryGetBlockHeaderStateResponse{} }
+func (m *QueryGetBlockHeaderStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetBlockHeaderStateResponse) ProtoMessage()    {}
+func (*QueryGetBlockHeaderStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{74}
+}
+func (m *QueryGetBlockHeaderStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetBlockHeaderStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetBlockHeaderStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetBlockHeaderStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetBlockHeaderStateResponse.Merge(m, src)
+}
+func (m *QueryGetBlockHeaderStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetBlockHeaderStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetBlockHeaderStateResponse.DiscardUnknown(m)
 }
 
-func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetChainNoncesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNonces(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ChainNonces",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
+var xxx_messageInfo_QueryGetBlockHeaderStateResponse proto.InternalMessageInfo
+
+func (m *QueryGetBlockHeaderStateResponse) GetBlockHeaderState() *BlockHeaderState {
+	if m != nil {
+		return m.BlockHeaderState
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllChainNoncesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryEthereumLightClientRequest struct {
+	ChainId int64 `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryEthereumLightClientRequest) Reset()         { *m = QueryEthereumLightClientRequest{} }
+func (m *QueryEthereumLightClientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEthereumLightClientRequest) ProtoMessage()    {}
+func (*QueryEthereumLightClientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{75}
+}
+func (m *QueryEthereumLightClientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEthereumLightClientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEthereumLightClientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ChainNoncesAll(ctx, in)
+}
+func (m *QueryEthereumLightClientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEthereumLightClientRequest.Merge(m, src)
+}
+func (m *QueryEthereumLightClientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEthereumLightClientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEthereumLightClientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEthereumLightClientRequest proto.InternalMessageInfo
+
+func (m *QueryEthereumLightClientRequest) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/zetachain.zetacore.observer.Query/ChainNoncesAll",
+	return 0
+}
+
+type QueryEthereumLightClientResponse struct {
+	LightClient EthereumLightClient `protobuf:"bytes,1,opt,name=light_client,json=lightClient,proto3" json:"light_client"`
+}
+
+func (m *QueryEthereumLightClientResponse) Reset()         { *m = QueryEthereumLightClientResponse{} }
+func (m *QueryEthereumLightClientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEthereumLightClientResponse) ProtoMessage()    {}
+func (*QueryEthereumLightClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dcb801e455adaee4, []int{76}
+}
+func (m *QueryEthereumLightClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEthereumLightClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEthereumLightClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
+}
+func (m *QueryEthereumLightClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEthereumLightClientResponse.Merge(m, src)
+}
+func (m *QueryEthereumLightClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEthereumLightClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEthereumLightClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEthereumLightClientResponse proto.InternalMessageInfo
+
+func (m *QueryEthereumLightClientResponse) GetLightClient() EthereumLightClient {
+	if m != nil {
+		return m.LightClient
 	}
-	return interceptor(ctx, in, info, handler)
+	return EthereumLightClient{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "zetachain.zetacore.observer.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "HasVoted",
-			Handler:    _Query_HasVoted_Handler,
-		},
-		{
-			MethodName: "BallotByIdentifier",
-			Handler:    _Query_BallotByIdentifier_Handler,
-		},
-		{
-			MethodName: "ObserverSet",
-			Handler:    _Query_ObserverSet_Handler,
-		},
-		{
-			MethodName: "SupportedChains",
-			Handler:    _Query_SupportedChains_Handler,
-		},
-		{
-			MethodName: "GetChainParamsForChain",
-			Handler:    _Query_GetChainParamsForChain_Handler,
-		},
-		{
-			MethodName: "GetChainParams",
-			Handler:    _Query_GetChainParams_Handler,
-		},
-		{
-			MethodName: "NodeAccount",
-			Handler:    _Query_NodeAccount_Handler,
-		},
-		{
-			MethodName: "NodeAccountAll",
-			Handler:    _Query_NodeAccountAll_Handler,
-		},
-		{
-			MethodName: "CrosschainFlags",
-			Handler:    _Query_CrosschainFlags_Handler,
-		},
-		{
-			MethodName: "Keygen",
-			Handler:    _Query_Keygen_Handler,
-		},
-		{
-			MethodName: "ShowObserverCount",
-			Handler:    _Query_ShowObserverCount_Handler,
-		},
-		{
-			MethodName: "BlameByIdentifier",
-			Handler:    _Query_BlameByIdentifier_Handler,
-		},
-		{
-			MethodName: "GetAllBlameRecords",
-			Handler:    _Query_GetAllBlameRecords_Handler,
-		},
-		{
-			MethodName: "BlamesByChainAndNonce",
-			Handler:    _Query_BlamesByChainAndNonce_Handler,
-		},
-		{
-			MethodName: "GetAllBlockHeaders",
-			Handler:    _Query_GetAllBlockHeaders_Handler,
-		},
-		{
-			MethodName: "GetBlockHeaderByHash",
-			Handler:    _Query_GetBlockHeaderByHash_Handler,
-		},
-		{
-			MethodName: "GetBlockHeaderStateByChain",
-			Handler:    _Query_GetBlockHeaderStateByChain_Handler,
-		},
-		{
-			MethodName: "Prove",
-			Handler:    _Query_Prove_Handler,
-		},
-		{
-			MethodName: "GetTssAddress",
-			Handler:    _Query_GetTssAddress_Handler,
-		},
-		{
-			MethodName: "GetTssAddressByFinalizedHeight",
-			Handler:    _Query_GetTssAddressByFinalizedHeight_Handler,
-		},
-		{
-			MethodName: "TSS",
-			Handler:    _Query_TSS_Handler,
-		},
-		{
-			MethodName: "TssHistory",
-			Handler:    _Query_TssHistory_Handler,
-		},
-		{
-			MethodName: "PendingNoncesAll",
-			Handler:    _Query_PendingNoncesAll_Handler,
-		},
-		{
-			MethodName: "PendingNoncesByChain",
-			Handler:    _Query_PendingNoncesByChain_Handler,
-		},
-		{
-			MethodName: "ChainNonces",
-			Handler:    _Query_ChainNonces_Handler,
-		},
-		{
-			MethodName: "ChainNoncesAll",
-			Handler:    _Query_ChainNoncesAll_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "observer/query.proto",
+func init() {
+	proto.RegisterType((*QueryObserverPerformanceRequest)(nil), "zetachain.zetacore.observer.QueryObserverPerformanceRequest")
+	proto.RegisterType((*QueryObserverPerformanceResponse)(nil), "zetachain.zetacore.observer.QueryObserverPerformanceResponse")
+	proto.RegisterType((*QueryGetChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesRequest")
+	proto.RegisterType((*QueryGetChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryGetChainNoncesResponse")
+	proto.RegisterType((*QueryAllChainNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesRequest")
+	proto.RegisterType((*QueryAllChainNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllChainNoncesResponse")
+	proto.RegisterType((*QueryAllPendingNoncesRequest)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesRequest")
+	proto.RegisterType((*QueryAllPendingNoncesResponse)(nil), "zetachain.zetacore.observer.QueryAllPendingNoncesResponse")
+	proto.RegisterType((*QueryPendingNoncesByChainRequest)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainRequest")
+	proto.RegisterType((*QueryPendingNoncesByChainResponse)(nil), "zetachain.zetacore.observer.QueryPendingNoncesByChainResponse")
+	proto.RegisterType((*QueryGetTSSRequest)(nil), "zetachain.zetacore.observer.QueryGetTSSRequest")
+	proto.RegisterType((*QueryGetTSSResponse)(nil), "zetachain.zetacore.observer.QueryGetTSSResponse")
+	proto.RegisterType((*QueryGetTssAddressRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressRequest")
+	proto.RegisterType((*QueryGetTssAddressResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressResponse")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightRequest)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightRequest")
+	proto.RegisterType((*QueryGetTssAddressByFinalizedHeightResponse)(nil), "zetachain.zetacore.observer.QueryGetTssAddressByFinalizedHeightResponse")
+	proto.RegisterType((*QueryTssHistoryRequest)(nil), "zetachain.zetacore.observer.QueryTssHistoryRequest")
+	proto.RegisterType((*QueryTssHistoryResponse)(nil), "zetachain.zetacore.observer.QueryTssHistoryResponse")
+	proto.RegisterType((*QueryProveRequest)(nil), "zetachain.zetacore.observer.QueryProveRequest")
+	proto.RegisterType((*QueryProveResponse)(nil), "zetachain.zetacore.observer.QueryProveResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "zetachain.zetacore.observer.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "zetachain.zetacore.observer.QueryParamsResponse")
+	proto.RegisterType((*QueryHasVotedRequest)(nil), "zetachain.zetacore.observer.QueryHasVotedRequest")
+	proto.RegisterType((*QueryHasVotedResponse)(nil), "zetachain.zetacore.observer.QueryHasVotedResponse")
+	proto.RegisterType((*QueryBallotByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierRequest")
+	proto.RegisterType((*VoterList)(nil), "zetachain.zetacore.observer.VoterList")
+	proto.RegisterType((*QueryBallotByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBallotByIdentifierResponse")
+	proto.RegisterType((*QueryBallotsByHeightRequest)(nil), "zetachain.zetacore.observer.QueryBallotsByHeightRequest")
+	proto.RegisterType((*QueryBallotsByHeightResponse)(nil), "zetachain.zetacore.observer.QueryBallotsByHeightResponse")
+	proto.RegisterType((*QueryBallotsByVoterRequest)(nil), "zetachain.zetacore.observer.QueryBallotsByVoterRequest")
+	proto.RegisterType((*QueryBallotsByVoterResponse)(nil), "zetachain.zetacore.observer.QueryBallotsByVoterResponse")
+	proto.RegisterType((*QueryBallotsByStatusRequest)(nil), "zetachain.zetacore.observer.QueryBallotsByStatusRequest")
+	proto.RegisterType((*QueryBallotsByStatusResponse)(nil), "zetachain.zetacore.observer.QueryBallotsByStatusResponse")
+	proto.RegisterType((*QueryObserverSet)(nil), "zetachain.zetacore.observer.QueryObserverSet")
+	proto.RegisterType((*QueryObserverSetResponse)(nil), "zetachain.zetacore.observer.QueryObserverSetResponse")
+	proto.RegisterType((*QueryObserverChainsRequest)(nil), "zetachain.zetacore.observer.QueryObserverChainsRequest")
+	proto.RegisterType((*QueryObserverChainsResponse)(nil), "zetachain.zetacore.observer.QueryObserverChainsResponse")
+	proto.RegisterType((*QueryObserverLivenessRequest)(nil), "zetachain.zetacore.observer.QueryObserverLivenessRequest")
+	proto.RegisterType((*QueryObserverLivenessResponse)(nil), "zetachain.zetacore.observer.QueryObserverLivenessResponse")
+	proto.RegisterType((*QueryJailedObserversRequest)(nil), "zetachain.zetacore.observer.QueryJailedObserversRequest")
+	proto.RegisterType((*QueryJailedObserversResponse)(nil), "zetachain.zetacore.observer.QueryJailedObserversResponse")
+	proto.RegisterType((*QuerySupportedChains)(nil), "zetachain.zetacore.observer.QuerySupportedChains")
+	proto.RegisterType((*QuerySupportedChainsResponse)(nil), "zetachain.zetacore.observer.QuerySupportedChainsResponse")
+	proto.RegisterType((*QueryGetChainParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainRequest")
+	proto.RegisterType((*QueryGetChainParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsForChainResponse")
+	proto.RegisterType((*QueryGetChainParamsRequest)(nil), "zetachain.zetacore.observer.QueryGetChainParamsRequest")
+	proto.RegisterType((*QueryGetChainParamsResponse)(nil), "zetachain.zetacore.observer.QueryGetChainParamsResponse")
+	proto.RegisterType((*QueryPendingChainParamsRequest)(nil), "zetachain.zetacore.observer.QueryPendingChainParamsRequest")
+	proto.RegisterType((*QueryPendingChainParamsResponse)(nil), "zetachain.zetacore.observer.QueryPendingChainParamsResponse")
+	proto.RegisterType((*QueryPendingChainParamsForChainRequest)(nil), "zetachain.zetacore.observer.QueryPendingChainParamsForChainRequest")
+	proto.RegisterType((*QueryPendingChainParamsForChainResponse)(nil), "zetachain.zetacore.observer.QueryPendingChainParamsForChainResponse")
+	proto.RegisterType((*QueryGetNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountRequest")
+	proto.RegisterType((*QueryGetNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryGetNodeAccountResponse")
+	proto.RegisterType((*QueryAllNodeAccountRequest)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountRequest")
+	proto.RegisterType((*QueryAllNodeAccountResponse)(nil), "zetachain.zetacore.observer.QueryAllNodeAccountResponse")
+	proto.RegisterType((*QueryGetCrosschainFlagsRequest)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsRequest")
+	proto.RegisterType((*QueryGetCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.QueryGetCrosschainFlagsResponse")
+	proto.RegisterType((*QueryGetKeygenRequest)(nil), "zetachain.zetacore.observer.QueryGetKeygenRequest")
+	proto.RegisterType((*QueryGetKeygenResponse)(nil), "zetachain.zetacore.observer.QueryGetKeygenResponse")
+	proto.RegisterType((*QueryShowObserverCountRequest)(nil), "zetachain.zetacore.observer.QueryShowObserverCountRequest")
+	proto.RegisterType((*QueryShowObserverCountResponse)(nil), "zetachain.zetacore.observer.QueryShowObserverCountResponse")
+	proto.RegisterType((*QueryBlameByIdentifierRequest)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierRequest")
+	proto.RegisterType((*QueryBlameByIdentifierResponse)(nil), "zetachain.zetacore.observer.QueryBlameByIdentifierResponse")
+	proto.RegisterType((*QueryAllBlameRecordsRequest)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsRequest")
+	proto.RegisterType((*QueryAllBlameRecordsResponse)(nil), "zetachain.zetacore.observer.QueryAllBlameRecordsResponse")
+	proto.RegisterType((*QueryBlameByChainAndNonceRequest)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceRequest")
+	proto.RegisterType((*QueryBlameByChainAndNonceResponse)(nil), "zetachain.zetacore.observer.QueryBlameByChainAndNonceResponse")
+	proto.RegisterType((*QueryBlameStatisticsRequest)(nil), "zetachain.zetacore.observer.QueryBlameStatisticsRequest")
+	proto.RegisterType((*QueryBlameStatisticsResponse)(nil), "zetachain.zetacore.observer.QueryBlameStatisticsResponse")
+	proto.RegisterType((*QueryAllBlockHeaderRequest)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderRequest")
+	proto.RegisterType((*QueryAllBlockHeaderResponse)(nil), "zetachain.zetacore.observer.QueryAllBlockHeaderResponse")
+	proto.RegisterType((*QueryGetBlockHeaderByHashRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashRequest")
+	proto.RegisterType((*QueryGetBlockHeaderByHashResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderByHashResponse")
+	proto.RegisterType((*QueryGetBlockHeaderStateRequest)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateRequest")
+	proto.RegisterType((*QueryGetBlockHeaderStateResponse)(nil), "zetachain.zetacore.observer.QueryGetBlockHeaderStateResponse")
+	proto.RegisterType((*QueryEthereumLightClientRequest)(nil), "zetachain.zetacore.observer.QueryEthereumLightClientRequest")
+	proto.RegisterType((*QueryEthereumLightClientResponse)(nil), "zetachain.zetacore.observer.QueryEthereumLightClientResponse")
 }
 
-func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("observer/query.proto", fileDescriptor_dcb801e455adaee4) }
+
+var fileDescriptor_dcb801e455adaee4 = []byte{
+	// 3291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdb, 0x6f, 0xdc, 0xc6,
+	0xf5, 0x36, 0xa5, 0x48, 0x96, 0x8e, 0xae, 0x1e, 0xc9, 0x97, 0x50, 0xb2, 0x24, 0x53, 0x89, 0x2d,
+	0x2b, 0xb6, 0x36, 0x56, 0x2e, 0x96, 0x2c, 0xc9, 0x8e, 0xa4, 0xd8, 0x92, 0x1d, 0xff, 0x12, 0x67,
+	0xe5, 0x5f, 0xd3, 0x26, 0x4d, 0x37, 0xd4, 0xee, 0x68, 0x97, 0xc9, 0x8a, 0xdc, 0x2c, 0x29, 0x59,
+	0x8a, 0x2a, 0xf4, 0xf2, 0x18, 0x14, 0x45, 0xd0, 0x02, 0xed, 0x5b, 0x11, 0xa0, 0x68, 0x5f, 0xda,
+	0xa2, 0x45, 0x80, 0xb4, 0x05, 0x82, 0x3e, 0xa4, 0x28, 0xd0, 0x14, 0xe8, 0x43, 0x82, 0x02, 0x6d,
+	0xfa, 0xd2, 0x06, 0x71, 0xfb, 0x7f, 0x14, 0x1c, 0x1e, 0x72, 0x87, 0xe4, 0x2c, 0x77, 0xb8, 0xde,
+	0x00, 0x79, 0xd2, 0x72, 0x86, 0xe7, 0xcc, 0xf7, 0x9d, 0x39, 0x43, 0x9e, 0xf9, 0xa8, 0x81, 0x61,
+	0x6b, 0xd3, 0xa6, 0xd5, 0x5d, 0x5a, 0xcd, 0xbc, 0xb9, 0x43, 0xab, 0xfb, 0x33, 0x95, 0xaa, 0xe5,
+	0x58, 0x64, 0xe4, 0x2d, 0xea, 0xe8, 0xf9, 0x92, 0x6e, 0x98, 0x33, 0xec, 0x97, 0x55, 0xa5, 0x33,
+	0xfe, 0x8d, 0xea, 0x50, 0xde, 0xda, 0xde, 0xb6, 0xcc, 0x8c, 0xf7, 0xc7, 0xb3, 0x50, 0xa7, 0xf3,
+	0x96, 0xbd, 0x6d, 0xd9, 0x99, 0x4d, 0xdd, 0xa6, 0x9e, 0xab, 0xcc, 0xee, 0xa5, 0x4d, 0xea, 0xe8,
+	0x97, 0x32, 0x15, 0xbd, 0x68, 0x98, 0xba, 0x63, 0x04, 0xf7, 0x0e, 0x17, 0xad, 0xa2, 0xc5, 0x7e,
+	0x66, 0xdc, 0x5f, 0xd8, 0x3a, 0x5a, 0xb4, 0xac, 0x62, 0x99, 0x66, 0xf4, 0x8a, 0x91, 0xd1, 0x4d,
+	0xd3, 0x72, 0x98, 0x89, 0x8d, 0xbd, 0xc7, 0x03, 0x9c, 0x9b, 0x7a, 0xb9, 0x6c, 0x39, 0xbe, 0xab,
+	0x5a, 0x73, 0x59, 0xdf, 0xa6, 0xd8, 0x3a, 0xc2, 0xb5, 0x5a, 0xf9, 0x37, 0x72, 0x25, 0xaa, 0x17,
+	0x68, 0x35, 0xd6, 0xc9, 0x08, 0xe6, 0x4c, 0xcb, 0xcc, 0x53, 0x7f, 0x98, 0xf1, 0x5a, 0x67, 0xd5,
+	0xb2, 0x6d, 0xef, 0x8e, 0xad, 0xb2, 0x5e, 0x8c, 0xe3, 0x78, 0x83, 0xee, 0x17, 0xa9, 0x19, 0x73,
+	0x5a, 0x36, 0x8a, 0x25, 0x27, 0x97, 0x2f, 0x1b, 0xd4, 0x74, 0x62, 0x9d, 0xa6, 0x55, 0xa0, 0x39,
+	0x3d, 0x9f, 0xb7, 0x76, 0x82, 0xce, 0x93, 0x41, 0xa7, 0xff, 0x03, 0x3b, 0x26, 0x62, 0x1d, 0xb9,
+	0xb2, 0xb1, 0x4b, 0x4d, 0x6a, 0xfb, 0x58, 0x26, 0xe3, 0x77, 0x54, 0x68, 0x75, 0xcb, 0xaa, 0x6e,
+	0xeb, 0x66, 0x9e, 0xc6, 0x00, 0x57, 0xf4, 0xaa, 0xbe, 0xed, 0xdb, 0x9e, 0xae, 0x35, 0x53, 0xb3,
+	0x60, 0x98, 0xc5, 0x70, 0x1c, 0x48, 0xd0, 0xed, 0xf8, 0xc3, 0x69, 0xbf, 0x56, 0x60, 0xfc, 0x45,
+	0x77, 0x66, 0x5f, 0xc0, 0xbe, 0x3b, 0xb5, 0xc1, 0xb2, 0xf4, 0xcd, 0x1d, 0x6a, 0x3b, 0xe4, 0x3c,
+	0x0c, 0x06, 0x58, 0xf4, 0x42, 0xa1, 0x4a, 0x6d, 0xfb, 0x94, 0x32, 0xa1, 0x4c, 0x75, 0x67, 0x07,
+	0xfc, 0xf6, 0x65, 0xaf, 0x99, 0x3c, 0x0c, 0x5d, 0x5e, 0x78, 0x8d, 0xc2, 0xa9, 0xb6, 0x09, 0x65,
+	0xaa, 0x3d, 0x7b, 0x94, 0x5d, 0xdf, 0x2c, 0x90, 0x1b, 0x00, 0xb5, 0xa4, 0x39, 0xd5, 0x3e, 0xa1,
+	0x4c, 0xf5, 0xcc, 0x9e, 0x9d, 0xf1, 0x32, 0x6c, 0xc6, 0xcd, 0xb0, 0x19, 0x2f, 0x59, 0x31, 0xc3,
+	0x66, 0xee, 0xe8, 0x45, 0x1f, 0x41, 0x96, 0xb3, 0xd4, 0x3e, 0x55, 0x60, 0xa2, 0x3e, 0x62, 0xbb,
+	0x62, 0x99, 0x36, 0x25, 0x6f, 0xc0, 0x71, 0x51, 0xf8, 0x5c, 0xdc, 0xed, 0x53, 0x3d, 0xb3, 0x8f,
+	0xcf, 0x24, 0xac, 0x85, 0x19, 0x81, 0xe3, 0x95, 0x87, 0x3e, 0xfa, 0xd7, 0xf8, 0x91, 0xec, 0xb0,
+	0x15, 0xef, 0xb2, 0xc9, 0x5a, 0x88, 0x59, 0x1b, 0x63, 0x76, 0xae, 0x21, 0x33, 0x0f, 0x69, 0x88,
+	0xda, 0x2c, 0xa8, 0x8c, 0xd9, 0x1a, 0x75, 0x56, 0x5d, 0x6c, 0xcf, 0xb3, 0xd9, 0xf3, 0xa7, 0x61,
+	0x18, 0x3a, 0x0c, 0xb3, 0x40, 0xf7, 0x30, 0xf6, 0xde, 0x85, 0x66, 0xc1, 0x88, 0xd0, 0x06, 0x03,
+	0x71, 0x07, 0x7a, 0xb8, 0x66, 0x66, 0xda, 0x33, 0x3b, 0x95, 0x48, 0x9f, 0xbb, 0x1f, 0x69, 0xf3,
+	0x2e, 0xb4, 0x02, 0x82, 0x5c, 0x2e, 0x97, 0x05, 0x20, 0xc3, 0xb3, 0xac, 0x34, 0x3d, 0xcb, 0xbf,
+	0x57, 0x60, 0x44, 0x38, 0x4c, 0x3d, 0x5e, 0xed, 0x0f, 0xc8, 0xab, 0x75, 0xb3, 0xb8, 0x05, 0xa3,
+	0x3e, 0xf2, 0x3b, 0xde, 0x32, 0xfc, 0x62, 0x42, 0xf4, 0xa1, 0x02, 0xa7, 0xeb, 0x0c, 0x84, 0x41,
+	0x7a, 0x09, 0xfa, 0xc3, 0x0f, 0x02, 0x8c, 0xd3, 0x74, 0x62, 0x9c, 0x42, 0xbe, 0x30, 0x52, 0x7d,
+	0x15, 0xbe, 0xb1, 0x75, 0xb1, 0x5a, 0xc2, 0xb5, 0x1c, 0x1e, 0x73, 0x9f, 0xcd, 0x8b, 0x1f, 0x2f,
+	0xfe, 0x99, 0xa2, 0x84, 0x9e, 0x29, 0xda, 0x37, 0xe1, 0x4c, 0x82, 0x79, 0x42, 0x14, 0x94, 0x16,
+	0x44, 0x41, 0x1b, 0x06, 0xe2, 0x2f, 0xbd, 0xbb, 0x1b, 0x1b, 0x08, 0x57, 0x7b, 0x01, 0x86, 0x42,
+	0xad, 0x88, 0x62, 0x0e, 0xda, 0xef, 0x6e, 0x6c, 0xe0, 0xd0, 0x13, 0x89, 0x43, 0xdf, 0xdd, 0xd8,
+	0xc0, 0x01, 0x5d, 0x13, 0xed, 0x3a, 0x3c, 0x1c, 0x38, 0xb4, 0x6d, 0x7c, 0xd2, 0xfa, 0xc1, 0x99,
+	0x82, 0xc1, 0x4d, 0xc3, 0xc9, 0x5b, 0x86, 0x99, 0x8b, 0x3c, 0x78, 0xfb, 0xb1, 0x7d, 0x15, 0x63,
+	0xf5, 0x0c, 0xa8, 0x22, 0x37, 0x08, 0x6f, 0x10, 0xda, 0xa9, 0x53, 0xc2, 0x47, 0x8b, 0xfb, 0xd3,
+	0x6d, 0xd9, 0x74, 0xf2, 0xcc, 0x59, 0x77, 0xd6, 0xfd, 0xa9, 0xbd, 0xad, 0xc0, 0x74, 0xdc, 0xc5,
+	0xca, 0xfe, 0x0d, 0xc3, 0xd4, 0xcb, 0xc6, 0x5b, 0xb4, 0xb0, 0x4e, 0xdd, 0xf7, 0xa4, 0x0f, 0x6d,
+	0x16, 0x8e, 0x6f, 0xf9, 0x3d, 0x39, 0x97, 0x65, 0xae, 0xc4, 0xfa, 0x71, 0x12, 0x87, 0x82, 0xce,
+	0x97, 0xa9, 0xa3, 0x7b, 0xa6, 0x29, 0xe8, 0xbc, 0x08, 0x8f, 0x49, 0x61, 0x49, 0xc1, 0xef, 0x35,
+	0x38, 0xc1, 0x5c, 0xde, 0xb5, 0xed, 0x75, 0xc3, 0x76, 0xac, 0xea, 0x7e, 0xab, 0x97, 0xec, 0xcf,
+	0x14, 0x38, 0x19, 0x1b, 0x02, 0x11, 0x2e, 0x43, 0x97, 0x63, 0xdb, 0xb9, 0xb2, 0x61, 0x3b, 0xb8,
+	0x4c, 0x65, 0xb3, 0xe4, 0xa8, 0x63, 0xdb, 0xb7, 0x0d, 0xdb, 0x69, 0xdd, 0xb2, 0xfc, 0xb9, 0x02,
+	0xc7, 0xbc, 0x85, 0x55, 0xb5, 0x76, 0x69, 0xe3, 0x85, 0x48, 0x4e, 0xc2, 0x51, 0x67, 0x2f, 0x57,
+	0xd2, 0xed, 0x12, 0x06, 0xb4, 0xd3, 0xd9, 0x5b, 0xd7, 0xed, 0x12, 0x99, 0x84, 0x8e, 0x4a, 0xd5,
+	0xb2, 0xb6, 0xf0, 0x85, 0xdf, 0x37, 0x83, 0x05, 0xe6, 0x1d, 0xb7, 0x31, 0xeb, 0xf5, 0x91, 0xd3,
+	0x00, 0x58, 0xd3, 0xb9, 0x0e, 0x1e, 0x62, 0x0e, 0xba, 0x59, 0x0b, 0xf3, 0xf1, 0x30, 0x74, 0x39,
+	0x7b, 0x39, 0xef, 0xdd, 0xd7, 0xe1, 0x8d, 0xeb, 0xec, 0xdd, 0x74, 0x2f, 0xb5, 0x69, 0x20, 0x3c,
+	0x4e, 0x0c, 0xe5, 0x30, 0x74, 0xec, 0xea, 0x65, 0x44, 0xd9, 0x95, 0xf5, 0x2e, 0x82, 0xe5, 0x7a,
+	0x87, 0x95, 0x4c, 0xfe, 0x72, 0xfd, 0x2a, 0x0c, 0x85, 0x5a, 0x83, 0xd9, 0xe8, 0xf4, 0x4a, 0x2b,
+	0x9c, 0xed, 0xc9, 0xe4, 0x87, 0x05, 0xbb, 0x15, 0xa7, 0x03, 0x0d, 0xb5, 0x12, 0x0c, 0x33, 0xcf,
+	0xeb, 0xba, 0xfd, 0x15, 0xcb, 0xa1, 0x05, 0x3f, 0x8c, 0x8f, 0xc1, 0x31, 0xaf, 0xdc, 0xcd, 0x19,
+	0x05, 0x6a, 0x3a, 0xc6, 0x96, 0x41, 0xab, 0x98, 0x98, 0x83, 0x5e, 0xc7, 0xcd, 0xa0, 0x9d, 0x4c,
+	0x42, 0xdf, 0xae, 0xe5, 0x70, 0x85, 0x97, 0x17, 0xde, 0x5e, 0xd6, 0x88, 0x59, 0xaf, 0x3d, 0x09,
+	0xc7, 0x23, 0x23, 0x21, 0x8b, 0x11, 0xe8, 0x2e, 0xe9, 0x76, 0xce, 0xbd, 0xd9, 0x0f, 0x46, 0x57,
+	0x09, 0x6f, 0xd2, 0xfe, 0x0f, 0xc6, 0x98, 0xd5, 0x0a, 0x1b, 0x73, 0x65, 0xbf, 0x36, 0x6a, 0x33,
+	0x48, 0x35, 0x07, 0xba, 0x5d, 0xbf, 0x55, 0x96, 0x89, 0x31, 0xd8, 0x4a, 0x1c, 0x36, 0x59, 0x81,
+	0x6e, 0xf7, 0x3a, 0xe7, 0xec, 0x57, 0x28, 0xe3, 0xd5, 0x3f, 0xfb, 0x68, 0x62, 0x98, 0x5d, 0xff,
+	0x77, 0xf7, 0x2b, 0x34, 0xdb, 0xb5, 0x8b, 0xbf, 0xb4, 0xdf, 0xb5, 0xc1, 0x78, 0x5d, 0x16, 0x18,
+	0x85, 0x54, 0x01, 0xbf, 0x0a, 0x9d, 0x0c, 0xa4, 0x1b, 0xe9, 0x76, 0xb6, 0xcc, 0x1b, 0x21, 0x62,
+	0x8c, 0xb3, 0x68, 0x45, 0x5e, 0xf2, 0x8b, 0x65, 0xb6, 0x92, 0x3c, 0x6e, 0xed, 0x8c, 0xdb, 0x05,
+	0x89, 0xa2, 0x93, 0x19, 0x31, 0x8a, 0x03, 0x56, 0xb8, 0x81, 0x3c, 0x0f, 0x7d, 0xc8, 0xc2, 0x76,
+	0x74, 0x67, 0xc7, 0x66, 0xeb, 0xa4, 0x7f, 0xf6, 0x7c, 0xa2, 0x57, 0x2f, 0x2a, 0x1b, 0xcc, 0x20,
+	0xdb, 0xbb, 0xc9, 0x5d, 0x69, 0x3f, 0xf5, 0x2b, 0x2c, 0xef, 0x1e, 0x7b, 0x65, 0x3f, 0xfc, 0xf8,
+	0x3e, 0x0d, 0xb0, 0x6d, 0x98, 0xe1, 0x67, 0x76, 0xf7, 0xb6, 0x61, 0xe2, 0x93, 0xda, 0xed, 0xd6,
+	0xf7, 0xfc, 0xee, 0x36, 0xec, 0xd6, 0xf7, 0xb0, 0xbb, 0x55, 0xd5, 0xfe, 0x2f, 0x15, 0x18, 0x15,
+	0xa3, 0xc4, 0xc9, 0x5d, 0x85, 0xa3, 0x1e, 0x2d, 0xbf, 0xb8, 0x99, 0x94, 0x08, 0x88, 0xff, 0xe0,
+	0x44, 0xcb, 0x96, 0x3e, 0x38, 0xd5, 0x30, 0x5c, 0x96, 0x21, 0x7e, 0x4c, 0xa5, 0x96, 0x45, 0x68,
+	0xd1, 0xb6, 0x85, 0x17, 0x6d, 0xcb, 0xe2, 0xfa, 0x8b, 0xd8, 0xec, 0x23, 0xd0, 0x2f, 0x65, 0x58,
+	0xbf, 0xdf, 0x16, 0x45, 0x8b, 0x29, 0x8d, 0x71, 0x8d, 0xad, 0x0d, 0xe5, 0x81, 0xd6, 0x86, 0x70,
+	0x11, 0xb7, 0xb5, 0x62, 0x11, 0x7f, 0x71, 0xcb, 0xc2, 0x0f, 0xc8, 0x97, 0x72, 0xfe, 0x08, 0x0c,
+	0x86, 0xb6, 0xec, 0x1b, 0xd4, 0xd1, 0xe6, 0xe0, 0x54, 0xb4, 0x2d, 0x40, 0x3f, 0x0a, 0xdd, 0x3e,
+	0x34, 0x0f, 0x7f, 0x77, 0xb6, 0xd6, 0xa0, 0xad, 0x81, 0x1a, 0xb2, 0x64, 0x25, 0xa1, 0x9d, 0x5e,
+	0xad, 0xd0, 0xae, 0xc0, 0x88, 0xd0, 0x51, 0xed, 0xed, 0xe9, 0xd7, 0x3b, 0x1e, 0x8a, 0xf6, 0x6c,
+	0x17, 0x16, 0x3c, 0xb6, 0x76, 0x13, 0x46, 0x43, 0xb6, 0xb7, 0x51, 0xc6, 0x69, 0x02, 0xc6, 0x77,
+	0xfc, 0x8d, 0x5c, 0xdc, 0x17, 0x22, 0x79, 0x0d, 0x8e, 0xc5, 0xf4, 0x22, 0x2c, 0x4c, 0x2e, 0x4a,
+	0x49, 0x19, 0xbe, 0x47, 0x9c, 0xe1, 0x41, 0x2b, 0xd2, 0xae, 0x51, 0x0c, 0xc5, 0x2d, 0xdd, 0x28,
+	0xd3, 0x82, 0x6f, 0xd6, 0xf2, 0x3d, 0xeb, 0x5f, 0xfc, 0xbc, 0x8d, 0x8d, 0x93, 0xcc, 0xb4, 0xbd,
+	0x65, 0x4c, 0x5b, 0x97, 0xd4, 0x27, 0xb0, 0xbe, 0xdb, 0xd8, 0xa9, 0x54, 0xac, 0xaa, 0x43, 0x0b,
+	0x5e, 0xfa, 0x68, 0xd7, 0x61, 0x54, 0xd4, 0x1e, 0x50, 0x7c, 0x14, 0x3a, 0x19, 0x09, 0x9f, 0x57,
+	0x50, 0x13, 0xb3, 0xfb, 0xb2, 0xd8, 0xa9, 0x5d, 0x03, 0x2d, 0x24, 0xec, 0x78, 0x35, 0xe6, 0x0d,
+	0xab, 0x2a, 0xbb, 0x39, 0xae, 0xc2, 0x64, 0xa2, 0x03, 0x84, 0xf3, 0x1c, 0xf4, 0x7a, 0x1e, 0x42,
+	0xf5, 0xae, 0x84, 0x94, 0x82, 0x15, 0x73, 0x4f, 0xbe, 0x76, 0xa1, 0x8d, 0x46, 0x14, 0xac, 0x70,
+	0xad, 0x6d, 0xc2, 0x88, 0xb0, 0x17, 0x91, 0xbc, 0x20, 0x44, 0x72, 0x41, 0x16, 0x09, 0x2b, 0xc3,
+	0x42, 0x68, 0x26, 0xb0, 0xc2, 0xc5, 0xbd, 0xbc, 0x00, 0xd1, 0xdb, 0xbe, 0xfc, 0x29, 0xba, 0x05,
+	0x61, 0x15, 0x61, 0xd8, 0xd7, 0x0f, 0x22, 0xf0, 0xdc, 0xd9, 0xcb, 0xc8, 0xa8, 0x08, 0x9c, 0x5b,
+	0xcc, 0x4b, 0x52, 0x89, 0xf5, 0x68, 0xab, 0x70, 0xb6, 0x0e, 0x96, 0x14, 0xb3, 0xfe, 0x03, 0x05,
+	0xce, 0x35, 0xf4, 0xd2, 0x90, 0x99, 0xd2, 0x5a, 0x66, 0x9c, 0xb0, 0xf9, 0xbc, 0x55, 0xa0, 0xcb,
+	0x9e, 0x58, 0x9e, 0x2c, 0x6c, 0xbe, 0x0e, 0x23, 0x42, 0x9b, 0x5a, 0xda, 0xf2, 0xc2, 0xbb, 0x54,
+	0xda, 0xf2, 0x7e, 0x7a, 0xcc, 0xda, 0x05, 0xaf, 0x69, 0x0a, 0xf0, 0xb5, 0xea, 0xe1, 0xf7, 0x1e,
+	0xa7, 0x69, 0x8a, 0x28, 0xdd, 0x82, 0x1e, 0xae, 0x59, 0x4a, 0xd3, 0x0c, 0x31, 0xe2, 0x2e, 0x5a,
+	0xf7, 0x94, 0xf3, 0xd7, 0x90, 0xbb, 0x66, 0x83, 0xaf, 0x27, 0x37, 0xdc, 0x8f, 0x27, 0xfe, 0x1a,
+	0xfa, 0xb6, 0xbf, 0x86, 0x44, 0xb7, 0x20, 0xb5, 0x57, 0x61, 0x30, 0xfa, 0xed, 0x45, 0x6e, 0x79,
+	0x87, 0xfd, 0x61, 0x8a, 0x0d, 0xe4, 0xc3, 0xcd, 0xda, 0x49, 0xdc, 0x00, 0xaf, 0x51, 0xe7, 0x39,
+	0xf6, 0x05, 0xc7, 0xc7, 0xf6, 0xff, 0x70, 0x22, 0xda, 0x81, 0x88, 0x16, 0xa0, 0xd3, 0xfb, 0xd8,
+	0x23, 0xb5, 0xc1, 0x47, 0x63, 0x34, 0xd1, 0xc6, 0xf1, 0x85, 0xbd, 0x51, 0xb2, 0xee, 0x05, 0xc5,
+	0x03, 0x97, 0x32, 0x6e, 0x4c, 0xc6, 0xea, 0xdd, 0x81, 0x00, 0xbe, 0x01, 0x43, 0x65, 0xdd, 0x76,
+	0x72, 0xc1, 0xeb, 0x8e, 0xcf, 0xe3, 0x99, 0x44, 0x34, 0xb7, 0x75, 0xdb, 0x09, 0x3b, 0x3d, 0x56,
+	0x8e, 0x36, 0x69, 0xb7, 0x10, 0xe3, 0x8a, 0xfb, 0x0d, 0x4d, 0xb4, 0xbb, 0x3f, 0x0f, 0x83, 0xec,
+	0xfb, 0x5a, 0x7c, 0x57, 0x3c, 0xc0, 0xda, 0x6b, 0x16, 0x5a, 0xde, 0x97, 0x0a, 0xe2, 0xbe, 0x02,
+	0xbd, 0x04, 0xd0, 0x99, 0xb9, 0x65, 0x21, 0x09, 0x2d, 0xb9, 0xe4, 0x74, 0x6f, 0xcf, 0x76, 0x7b,
+	0x43, 0x99, 0x5b, 0x56, 0x50, 0x82, 0x2c, 0x97, 0xcb, 0x5e, 0x1f, 0xcd, 0x5b, 0xd5, 0x42, 0xcb,
+	0x4b, 0x90, 0xdf, 0x28, 0x30, 0x2a, 0x1e, 0x07, 0xa9, 0xac, 0x45, 0xa8, 0xb4, 0xcb, 0x51, 0xc1,
+	0xdc, 0xac, 0x11, 0x6a, 0xdd, 0x1a, 0xdc, 0x40, 0x95, 0x1c, 0xc3, 0xcf, 0x9e, 0xac, 0xcb, 0x66,
+	0x81, 0xc9, 0xd0, 0x12, 0xe2, 0xdc, 0x30, 0x74, 0x30, 0xe1, 0x1b, 0x77, 0xe9, 0xde, 0x85, 0xb6,
+	0x05, 0x67, 0x12, 0x9c, 0xd6, 0x99, 0xd6, 0xf6, 0xf4, 0xd3, 0xfa, 0xaa, 0xbf, 0x75, 0x73, 0x5b,
+	0xdc, 0x5d, 0x8a, 0x61, 0x3b, 0x46, 0xde, 0x96, 0xc0, 0x3d, 0x09, 0x7d, 0xf7, 0x0c, 0xb3, 0x60,
+	0xdd, 0xcb, 0x31, 0x2d, 0xd0, 0x46, 0xfc, 0xbd, 0x5e, 0xe3, 0x0a, 0x6b, 0xd3, 0xde, 0x0d, 0x76,
+	0x42, 0x51, 0xff, 0x48, 0x21, 0x07, 0x03, 0xec, 0x45, 0x61, 0x07, 0x5d, 0x52, 0x1f, 0x01, 0xdd,
+	0x87, 0x69, 0xc4, 0x25, 0xce, 0x70, 0xbf, 0xeb, 0xae, 0xd6, 0x4a, 0xc6, 0xa1, 0x67, 0xab, 0x6a,
+	0x6d, 0x87, 0xa5, 0x10, 0x70, 0x9b, 0x3c, 0xa9, 0x82, 0x7f, 0xbb, 0x30, 0xd0, 0xeb, 0xec, 0xcb,
+	0x75, 0xab, 0xf3, 0xfa, 0x5d, 0x05, 0x46, 0x84, 0xc3, 0x04, 0x1f, 0x20, 0xfa, 0xf8, 0x0f, 0xe7,
+	0x7e, 0x14, 0x86, 0xfc, 0xea, 0x93, 0xb7, 0xe9, 0xdd, 0xac, 0x5d, 0xb4, 0xb0, 0x62, 0x5e, 0xc6,
+	0x3c, 0x5e, 0xa3, 0x0e, 0x37, 0xda, 0x8a, 0xab, 0x5b, 0x96, 0x38, 0xd9, 0x89, 0xd3, 0x82, 0xdd,
+	0x70, 0xf4, 0x72, 0x5a, 0xb0, 0xf6, 0x0a, 0x9c, 0x49, 0x70, 0x81, 0x54, 0x9f, 0x86, 0x5e, 0x9e,
+	0x2a, 0x06, 0x55, 0xc8, 0xb4, 0x87, 0x63, 0xaa, 0x2d, 0xd6, 0x5e, 0x64, 0xdc, 0x3d, 0xee, 0x54,
+	0x4b, 0x2c, 0x33, 0xed, 0x5b, 0x30, 0x51, 0xdf, 0x1a, 0x91, 0xbd, 0x02, 0x84, 0x47, 0xc6, 0x92,
+	0x92, 0x4a, 0xed, 0xe4, 0x62, 0x2e, 0x07, 0x37, 0x23, 0x2d, 0x01, 0xfc, 0xeb, 0x4e, 0x89, 0x56,
+	0xe9, 0xce, 0xf6, 0x6d, 0x37, 0xfb, 0x56, 0xd9, 0x3f, 0x2d, 0x48, 0xc0, 0x3f, 0x84, 0x89, 0xfa,
+	0xd6, 0x08, 0xff, 0x6b, 0xd0, 0xcb, 0xff, 0x2b, 0x04, 0x02, 0x4f, 0x5e, 0x48, 0x02, 0x7f, 0xfe,
+	0xe7, 0xd7, 0x72, 0xad, 0x69, 0xf6, 0x83, 0x79, 0xe8, 0x60, 0xe3, 0x93, 0x77, 0x14, 0xe8, 0xf4,
+	0xea, 0x46, 0x92, 0x5c, 0x82, 0xc6, 0xd5, 0x7c, 0xf5, 0x71, 0x79, 0x03, 0x8f, 0x92, 0x36, 0xf9,
+	0xdd, 0xbf, 0xfd, 0xe7, 0x87, 0x6d, 0xa7, 0xc9, 0x48, 0xc6, 0xbd, 0xff, 0x22, 0x33, 0xcd, 0x44,
+	0xfe, 0xbd, 0x82, 0xfc, 0x41, 0x81, 0x2e, 0x5f, 0x5c, 0x27, 0x97, 0x1a, 0x8f, 0x11, 0x91, 0xfc,
+	0xd5, 0xd9, 0x34, 0x26, 0x08, 0xec, 0x16, 0x03, 0xf6, 0x2c, 0x59, 0x11, 0x02, 0x0b, 0x14, 0xc2,
+	0xcc, 0x41, 0x4c, 0xdb, 0x3e, 0xcc, 0x1c, 0x84, 0x54, 0xc6, 0x43, 0xf2, 0x77, 0x05, 0x48, 0x5c,
+	0x20, 0x27, 0x0b, 0x8d, 0x61, 0xd5, 0xfd, 0x38, 0xa0, 0x2e, 0x36, 0x67, 0x8c, 0xec, 0xae, 0x33,
+	0x76, 0xd7, 0xc8, 0x92, 0x90, 0x1d, 0x52, 0xda, 0xdc, 0xe7, 0x58, 0x89, 0x88, 0x92, 0xdf, 0x2a,
+	0x30, 0x10, 0x51, 0x86, 0xc9, 0x9c, 0x2c, 0xb0, 0xa8, 0xe4, 0xad, 0xce, 0x37, 0x61, 0x89, 0x7c,
+	0x66, 0x18, 0x9f, 0x29, 0x72, 0x36, 0x81, 0x8f, 0xed, 0x12, 0xf2, 0xde, 0x12, 0xe4, 0x8f, 0x0a,
+	0xf4, 0x87, 0xa5, 0x57, 0x72, 0x39, 0xc5, 0xe8, 0xbc, 0xaa, 0xac, 0xce, 0xa5, 0x37, 0x44, 0xd4,
+	0x4b, 0x0c, 0xf5, 0x65, 0xf2, 0x54, 0x23, 0xd4, 0x2c, 0x9f, 0x62, 0x69, 0x15, 0x8a, 0x3e, 0x4a,
+	0xa7, 0x69, 0xc0, 0x84, 0x44, 0x5c, 0x75, 0xbe, 0x09, 0xcb, 0xb4, 0xd1, 0xf7, 0xe4, 0x61, 0xf2,
+	0x13, 0x05, 0x7a, 0x38, 0xdd, 0x91, 0x5c, 0x6c, 0x3c, 0x34, 0x77, 0xbb, 0xfa, 0x54, 0xaa, 0xdb,
+	0x03, 0x94, 0xe7, 0x19, 0xca, 0x49, 0x72, 0x46, 0x88, 0xd2, 0xff, 0x91, 0xb3, 0xa9, 0x43, 0xfe,
+	0xa4, 0x40, 0x7f, 0x58, 0x95, 0x94, 0x49, 0x0f, 0xa1, 0x20, 0xaa, 0xce, 0xa5, 0x37, 0x44, 0xc0,
+	0xd7, 0x18, 0xe0, 0x79, 0x72, 0x39, 0x19, 0x30, 0x6b, 0xb7, 0x33, 0x07, 0x51, 0xbd, 0xf3, 0x90,
+	0xfc, 0x55, 0x81, 0xc1, 0xa8, 0x30, 0x47, 0xe6, 0xe5, 0xf1, 0x44, 0x44, 0x55, 0xf5, 0x4a, 0x33,
+	0xa6, 0x48, 0x66, 0x99, 0x91, 0x59, 0x20, 0xf3, 0xc9, 0x64, 0x7c, 0xd1, 0x51, 0x44, 0xe7, 0x7d,
+	0x05, 0x06, 0x22, 0xc2, 0xa5, 0x4c, 0xbe, 0x8b, 0x35, 0x55, 0x75, 0xbe, 0x09, 0x4b, 0xe4, 0x72,
+	0x91, 0x71, 0x39, 0x47, 0x1e, 0x15, 0x72, 0x79, 0x9d, 0x59, 0x05, 0x1b, 0x4b, 0x9b, 0xfc, 0x4a,
+	0x81, 0x81, 0x88, 0x1a, 0x29, 0xf3, 0x16, 0x8b, 0x98, 0xa8, 0xf3, 0xa9, 0x4d, 0x02, 0xc0, 0x17,
+	0x18, 0xe0, 0xb3, 0xe4, 0x11, 0x21, 0x60, 0x3b, 0x82, 0xed, 0xdf, 0x0a, 0x9c, 0x10, 0xab, 0x96,
+	0xe4, 0x5a, 0x63, 0x0c, 0x89, 0x82, 0xa9, 0xfa, 0x4c, 0xf3, 0x0e, 0x90, 0xcb, 0x0a, 0xe3, 0xb2,
+	0x48, 0xae, 0x08, 0xb9, 0x14, 0xa9, 0x13, 0x12, 0xd3, 0x72, 0x5b, 0x16, 0xae, 0x90, 0xcc, 0x81,
+	0x5f, 0x7a, 0x1d, 0x92, 0xf7, 0x14, 0xe8, 0x0f, 0x0f, 0x23, 0xb3, 0xbe, 0x85, 0xaa, 0xaa, 0x3a,
+	0x97, 0xde, 0x50, 0x2a, 0x8d, 0xa2, 0x4c, 0xdc, 0x77, 0x16, 0x89, 0xcb, 0x7e, 0x32, 0x55, 0x44,
+	0x5d, 0x01, 0x56, 0x5d, 0x6c, 0xce, 0x18, 0x09, 0x5c, 0x62, 0x04, 0x1e, 0x23, 0xe7, 0xc5, 0xc5,
+	0x9b, 0x40, 0xdb, 0x24, 0xff, 0x55, 0x40, 0xad, 0x2f, 0x8d, 0x92, 0xd5, 0x66, 0xf0, 0x44, 0x73,
+	0xec, 0xd9, 0x07, 0x73, 0x82, 0xe4, 0x16, 0x19, 0xb9, 0xa7, 0xc9, 0x93, 0xd2, 0xe4, 0x22, 0x19,
+	0x16, 0x12, 0x04, 0xe5, 0xd2, 0x2b, 0xae, 0x7e, 0xaa, 0x73, 0xe9, 0x0d, 0x91, 0xc0, 0xe3, 0x8c,
+	0xc0, 0x34, 0x99, 0x12, 0x12, 0xe0, 0xf4, 0xd7, 0xcc, 0x01, 0x93, 0x7c, 0x0f, 0xdd, 0x07, 0x55,
+	0x3f, 0xe7, 0x69, 0xb9, 0x5c, 0x96, 0xc1, 0x2d, 0x54, 0x6d, 0xd5, 0xb9, 0xf4, 0x86, 0x88, 0x7b,
+	0x8a, 0xe1, 0xd6, 0xc8, 0x44, 0x23, 0xdc, 0xe4, 0x03, 0x05, 0x06, 0x22, 0x12, 0x25, 0x59, 0x90,
+	0x5b, 0x8e, 0x42, 0x2d, 0x55, 0x5d, 0x6c, 0xce, 0x58, 0x6a, 0x3d, 0x47, 0x05, 0x58, 0xf2, 0x23,
+	0x05, 0x3a, 0x3d, 0x61, 0x93, 0xcc, 0x4a, 0x8d, 0x1b, 0xd2, 0x56, 0xd5, 0x27, 0x52, 0xd9, 0x48,
+	0x6d, 0xb7, 0x3c, 0x79, 0x95, 0xfc, 0x59, 0x81, 0x63, 0x31, 0xe1, 0x94, 0x48, 0xbc, 0xfc, 0xeb,
+	0xe9, 0xb1, 0xea, 0x42, 0x53, 0xb6, 0x88, 0x79, 0x9e, 0x61, 0x7e, 0x82, 0x5c, 0xe2, 0x31, 0xfb,
+	0x5e, 0x6a, 0xe0, 0xed, 0x92, 0x75, 0x2f, 0xa2, 0xe6, 0x92, 0x4f, 0x14, 0x38, 0x16, 0x13, 0x4d,
+	0x65, 0x98, 0xd4, 0x53, 0x6d, 0xd5, 0x85, 0xa6, 0x6c, 0x91, 0xc9, 0x2a, 0x63, 0xb2, 0x44, 0x16,
+	0xc4, 0x75, 0xb2, 0x6b, 0x17, 0xdb, 0x74, 0x45, 0x24, 0xe2, 0x43, 0x77, 0x33, 0x4c, 0xd6, 0xa8,
+	0x13, 0x91, 0x4f, 0x89, 0xdc, 0x7a, 0x13, 0x28, 0xbb, 0xea, 0x7c, 0x13, 0x96, 0x48, 0x68, 0x96,
+	0x11, 0xba, 0x40, 0xa6, 0xeb, 0xbe, 0xc1, 0xf4, 0x72, 0x39, 0xe7, 0x71, 0xa8, 0x22, 0xd0, 0xcf,
+	0x14, 0x38, 0xce, 0x9c, 0xd9, 0x11, 0xd5, 0x93, 0x2c, 0x49, 0xc7, 0x56, 0x24, 0xc1, 0xaa, 0x57,
+	0x9b, 0x35, 0x47, 0x32, 0xeb, 0x8c, 0xcc, 0x0a, 0x79, 0x26, 0x79, 0x76, 0xbc, 0x25, 0xac, 0x9b,
+	0x05, 0xef, 0xdf, 0x99, 0xb9, 0x87, 0x7e, 0xe6, 0x80, 0xb5, 0x78, 0x85, 0x6a, 0x44, 0xbc, 0x94,
+	0xda, 0x98, 0x09, 0x25, 0x5a, 0x75, 0xbe, 0x09, 0x4b, 0xa9, 0x27, 0x92, 0x47, 0xa9, 0x26, 0xcc,
+	0x92, 0x0f, 0xb8, 0xd4, 0xe2, 0x04, 0xc8, 0xcb, 0x92, 0x09, 0x12, 0xd5, 0x56, 0xd5, 0xb9, 0xf4,
+	0x86, 0x29, 0x13, 0x8b, 0x13, 0x54, 0xc9, 0x3f, 0x15, 0x18, 0x16, 0xe9, 0x92, 0x32, 0x79, 0x95,
+	0x20, 0x89, 0xaa, 0x57, 0x9b, 0x35, 0x97, 0x2e, 0x58, 0x43, 0x9a, 0xa4, 0x2b, 0x52, 0xe8, 0x76,
+	0x29, 0x73, 0x80, 0xad, 0xba, 0x5d, 0x3a, 0x64, 0x65, 0x93, 0x40, 0xd8, 0xc4, 0x54, 0x26, 0x8b,
+	0x69, 0x21, 0xf2, 0xa2, 0xaa, 0xba, 0xd4, 0xa4, 0xb5, 0x94, 0x52, 0x16, 0xe3, 0xe7, 0xe6, 0x1b,
+	0xb7, 0x90, 0x8c, 0x02, 0x5f, 0x36, 0x7d, 0xa2, 0xc0, 0x90, 0x40, 0xb1, 0x94, 0x21, 0x58, 0x5f,
+	0x76, 0x55, 0x97, 0x9a, 0xb4, 0x96, 0x92, 0x69, 0x28, 0x5a, 0xe6, 0x78, 0x69, 0x96, 0xe7, 0xf4,
+	0x3d, 0x05, 0x3a, 0xd8, 0x3f, 0x48, 0x93, 0x19, 0x89, 0xc2, 0x94, 0xfb, 0x8f, 0x6f, 0x35, 0x23,
+	0x7d, 0x3f, 0x22, 0xd5, 0x18, 0xd2, 0x51, 0xa2, 0x8a, 0x6b, 0x56, 0x06, 0xe2, 0x43, 0x05, 0xfa,
+	0x42, 0xff, 0xb5, 0x4f, 0x9e, 0x96, 0x9a, 0xff, 0xd8, 0xe1, 0x07, 0xf5, 0x72, 0x6a, 0x3b, 0x29,
+	0x61, 0xc3, 0xcd, 0x18, 0xc7, 0xb6, 0xfd, 0x7d, 0x7f, 0xe6, 0x20, 0x7a, 0x24, 0xe1, 0x90, 0xfc,
+	0xb8, 0x0d, 0xc6, 0x92, 0x4f, 0x1e, 0x90, 0xb5, 0x94, 0xe0, 0xea, 0x9d, 0xa3, 0x50, 0xd7, 0x1f,
+	0xdc, 0x11, 0xd2, 0xde, 0x64, 0xb4, 0xbf, 0x4e, 0x5e, 0x96, 0xa1, 0x9d, 0x2b, 0xb1, 0x03, 0x0a,
+	0x46, 0x5e, 0x2f, 0x67, 0x0e, 0x84, 0x07, 0x39, 0x0e, 0x45, 0x91, 0x79, 0x5b, 0x61, 0x07, 0x5d,
+	0x48, 0x46, 0x0e, 0xf5, 0xc6, 0x46, 0x0a, 0xe9, 0x3e, 0x7c, 0xa4, 0x46, 0x9b, 0x60, 0x74, 0x54,
+	0x72, 0x4a, 0x48, 0xc7, 0x05, 0xf1, 0xae, 0x02, 0x50, 0x3b, 0x6a, 0x41, 0x24, 0x2a, 0xd6, 0xd8,
+	0xd9, 0x0f, 0xf5, 0xc9, 0x74, 0x46, 0x88, 0xed, 0x1c, 0xc3, 0x76, 0x86, 0x8c, 0x0b, 0xb1, 0x39,
+	0x35, 0x4c, 0xef, 0x2b, 0x30, 0x18, 0x3a, 0x6b, 0xe4, 0x6e, 0x7a, 0xe4, 0x2a, 0x22, 0xd1, 0xe9,
+	0x32, 0xf5, 0x4a, 0x33, 0xa6, 0x08, 0x7a, 0x9a, 0x81, 0x7e, 0x84, 0x68, 0x49, 0x3b, 0x4e, 0xcf,
+	0xc6, 0x95, 0xf6, 0x86, 0x45, 0xc7, 0xae, 0x64, 0x5e, 0x76, 0x09, 0xa7, 0xbd, 0xd4, 0xab, 0xcd,
+	0x9a, 0x23, 0x87, 0xa7, 0x18, 0x87, 0x0c, 0xb9, 0xd8, 0x98, 0x43, 0x74, 0xbb, 0xcc, 0x9f, 0x06,
+	0x4c, 0xa1, 0xc6, 0x84, 0xe3, 0x3f, 0x97, 0xde, 0x50, 0x6a, 0xbb, 0x9c, 0xaf, 0x59, 0x84, 0xb6,
+	0xcb, 0x9c, 0x27, 0xf9, 0xed, 0x72, 0x73, 0xb8, 0xc5, 0x47, 0x31, 0x1b, 0x6c, 0x97, 0x39, 0xdc,
+	0xe4, 0x1f, 0x0a, 0x0c, 0x09, 0x0e, 0xd7, 0xca, 0xbc, 0x5c, 0xeb, 0x1f, 0x4f, 0x56, 0x97, 0x9a,
+	0xb4, 0x96, 0xda, 0x13, 0x09, 0x0e, 0xfc, 0x0a, 0x94, 0xe1, 0x95, 0x9b, 0x1f, 0x7d, 0x3e, 0xa6,
+	0x7c, 0xfc, 0xf9, 0x98, 0xf2, 0xd9, 0xe7, 0x63, 0xca, 0x3b, 0xf7, 0xc7, 0x8e, 0x7c, 0x7c, 0x7f,
+	0xec, 0xc8, 0xa7, 0xf7, 0xc7, 0x8e, 0xbc, 0x9c, 0x29, 0x1a, 0x4e, 0x69, 0x67, 0xd3, 0xfd, 0xf6,
+	0x2c, 0xdc, 0x3e, 0xee, 0xd5, 0xc6, 0x72, 0xf6, 0x2b, 0xd4, 0xde, 0xec, 0x64, 0x07, 0xb3, 0x9f,
+	0xf8, 0xdf, 0x00, 0x48, 0xac, 0x6d, 0x26, 0xc5, 0x3f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query if a voter has voted for a ballot
+	HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error)
+	// Queries the ballots created in a range of zeta heights
+	BallotsByHeight(ctx context.Context, in *QueryBallotsByHeightRequest, opts ...grpc.CallOption) (*QueryBallotsByHeightResponse, error)
+	// Queries the ballots an observer has voted or has not voted on
+	BallotsByVoter(ctx context.Context, in *QueryBallotsByVoterRequest, opts ...grpc.CallOption) (*QueryBallotsByVoterResponse, error)
+	// Queries the ballots with a given status, optionally filtered by observation type
+	BallotsByStatus(ctx context.Context, in *QueryBallotsByStatusRequest, opts ...grpc.CallOption) (*QueryBallotsByStatusResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error)
+	// Queries the chains an observer is assigned to
+	ObserverChains(ctx context.Context, in *QueryObserverChainsRequest, opts ...grpc.CallOption) (*QueryObserverChainsResponse, error)
+	// Queries the liveness state of an observer
+	ObserverLiveness(ctx context.Context, in *QueryObserverLivenessRequest, opts ...grpc.CallOption) (*QueryObserverLivenessResponse, error)
+	// Queries the observers currently jailed for missing ballots
+	JailedObservers(ctx context.Context, in *QueryJailedObserversRequest, opts ...grpc.CallOption) (*QueryJailedObserversResponse, error)
+	SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error)
+	// Queries a list of GetChainParamsForChain items.
+	GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error)
+	// Queries a list of GetChainParams items.
+	GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error)
+	// Queries the chain params scheduled to be applied at an activation height
+	PendingChainParams(ctx context.Context, in *QueryPendingChainParamsRequest, opts ...grpc.CallOption) (*QueryPendingChainParamsResponse, error)
+	// Queries the chain params scheduled to be applied at an activation height for a chain
+	PendingChainParamsForChain(ctx context.Context, in *QueryPendingChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryPendingChainParamsForChainResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error)
+	// Queries the number of times the nodes were blamed per chain in the recent blocks
+	BlameStatistics(ctx context.Context, in *QueryBlameStatisticsRequest, opts ...grpc.CallOption) (*QueryBlameStatisticsResponse, error)
+	GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error)
+	GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error)
+	GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error)
+	// Queries the beacon chain light client of an Ethereum chain
+	EthereumLightClient(ctx context.Context, in *QueryEthereumLightClientRequest, opts ...grpc.CallOption) (*QueryEthereumLightClientResponse, error)
+	// merkle proof verification
+	Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error)
+	GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	// Queries a tSS by index.
+	TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error)
+	TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error)
+	PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error)
+	// Queries the participation counters of an observer
+	ObserverPerformance(ctx context.Context, in *QueryObserverPerformanceRequest, opts ...grpc.CallOption) (*QueryObserverPerformanceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) HasVoted(ctx context.Context, in *QueryHasVotedRequest, opts ...grpc.CallOption) (*QueryHasVotedResponse, error) {
+	out := new(QueryHasVotedResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/HasVoted", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BallotByIdentifier(ctx context.Context, in *QueryBallotByIdentifierRequest, opts ...grpc.CallOption) (*QueryBallotByIdentifierResponse, error) {
+	out := new(QueryBallotByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotByIdentifier", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BallotsByHeight(ctx context.Context, in *QueryBallotsByHeightRequest, opts ...grpc.CallOption) (*QueryBallotsByHeightResponse, error) {
+	out := new(QueryBallotsByHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotsByHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) BallotsByVoter(ctx context.Context, in *QueryBallotsByVoterRequest, opts ...grpc.CallOption) (*QueryBallotsByVoterResponse, error) {
+	out := new(QueryBallotsByVoterResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotsByVoter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BallotsByStatus(ctx context.Context, in *QueryBallotsByStatusRequest, opts ...grpc.CallOption) (*QueryBallotsByStatusResponse, error) {
+	out := new(QueryBallotsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BallotsByStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ObserverSet(ctx context.Context, in *QueryObserverSet, opts ...grpc.CallOption) (*QueryObserverSetResponse, error) {
+	out := new(QueryObserverSetResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ObserverChains(ctx context.Context, in *QueryObserverChainsRequest, opts ...grpc.CallOption) (*QueryObserverChainsResponse, error) {
+	out := new(QueryObserverChainsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverChains", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ObserverLiveness(ctx context.Context, in *QueryObserverLivenessRequest, opts ...grpc.CallOption) (*QueryObserverLivenessResponse, error) {
+	out := new(QueryObserverLivenessResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverLiveness", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) JailedObservers(ctx context.Context, in *QueryJailedObserversRequest, opts ...grpc.CallOption) (*QueryJailedObserversResponse, error) {
+	out := new(QueryJailedObserversResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/JailedObservers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainNonces) > 0 {
-		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) SupportedChains(ctx context.Context, in *QuerySupportedChains, opts ...grpc.CallOption) (*QuerySupportedChainsResponse, error) {
+	out := new(QuerySupportedChainsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/SupportedChains", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetChainParamsForChain(ctx context.Context, in *QueryGetChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryGetChainParamsForChainResponse, error) {
+	out := new(QueryGetChainParamsForChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParamsForChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetChainParams(ctx context.Context, in *QueryGetChainParamsRequest, opts ...grpc.CallOption) (*QueryGetChainParamsResponse, error) {
+	out := new(QueryGetChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetChainParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PendingChainParams(ctx context.Context, in *QueryPendingChainParamsRequest, opts ...grpc.CallOption) (*QueryPendingChainParamsResponse, error) {
+	out := new(QueryPendingChainParamsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingChainParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingChainParamsForChain(ctx context.Context, in *QueryPendingChainParamsForChainRequest, opts ...grpc.CallOption) (*QueryPendingChainParamsForChainResponse, error) {
+	out := new(QueryPendingChainParamsForChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingChainParamsForChain", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NodeAccount(ctx context.Context, in *QueryGetNodeAccountRequest, opts ...grpc.CallOption) (*QueryGetNodeAccountResponse, error) {
+	out := new(QueryGetNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) NodeAccountAll(ctx context.Context, in *QueryAllNodeAccountRequest, opts ...grpc.CallOption) (*QueryAllNodeAccountResponse, error) {
+	out := new(QueryAllNodeAccountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/NodeAccountAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PendingNonces) > 0 {
-		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return out, nil
+}
+
+func (c *queryClient) CrosschainFlags(ctx context.Context, in *QueryGetCrosschainFlagsRequest, opts ...grpc.CallOption) (*QueryGetCrosschainFlagsResponse, error) {
+	out := new(QueryGetCrosschainFlagsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/CrosschainFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Keygen(ctx context.Context, in *QueryGetKeygenRequest, opts ...grpc.CallOption) (*QueryGetKeygenResponse, error) {
+	out := new(QueryGetKeygenResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Keygen", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ShowObserverCount(ctx context.Context, in *QueryShowObserverCountRequest, opts ...grpc.CallOption) (*QueryShowObserverCountResponse, error) {
+	out := new(QueryShowObserverCountResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ShowObserverCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) BlameByIdentifier(ctx context.Context, in *QueryBlameByIdentifierRequest, opts ...grpc.CallOption) (*QueryBlameByIdentifierResponse, error) {
+	out := new(QueryBlameByIdentifierResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameByIdentifier", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllBlameRecords(ctx context.Context, in *QueryAllBlameRecordsRequest, opts ...grpc.CallOption) (*QueryAllBlameRecordsResponse, error) {
+	out := new(QueryAllBlameRecordsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlameRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlamesByChainAndNonce(ctx context.Context, in *QueryBlameByChainAndNonceRequest, opts ...grpc.CallOption) (*QueryBlameByChainAndNonceResponse, error) {
+	out := new(QueryBlameByChainAndNonceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) BlameStatistics(ctx context.Context, in *QueryBlameStatisticsRequest, opts ...grpc.CallOption) (*QueryBlameStatisticsResponse, error) {
+	out := new(QueryBlameStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/BlameStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAllBlockHeaders(ctx context.Context, in *QueryAllBlockHeaderRequest, opts ...grpc.CallOption) (*QueryAllBlockHeaderResponse, error) {
+	out := new(QueryAllBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetAllBlockHeaders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBlockHeaderByHash(ctx context.Context, in *QueryGetBlockHeaderByHashRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderByHashResponse, error) {
+	out := new(QueryGetBlockHeaderByHashResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) GetBlockHeaderStateByChain(ctx context.Context, in *QueryGetBlockHeaderStateRequest, opts ...grpc.CallOption) (*QueryGetBlockHeaderStateResponse, error) {
+	out := new(QueryGetBlockHeaderStateResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EthereumLightClient(ctx context.Context, in *QueryEthereumLightClientRequest, opts ...grpc.CallOption) (*QueryEthereumLightClientResponse, error) {
+	out := new(QueryEthereumLightClientResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/EthereumLightClient", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
+func (c *queryClient) Prove(ctx context.Context, in *QueryProveRequest, opts ...grpc.CallOption) (*QueryProveResponse, error) {
+	out := new(QueryProveResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/Prove", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTssAddress(ctx context.Context, in *QueryGetTssAddressRequest, opts ...grpc.CallOption) (*QueryGetTssAddressResponse, error) {
+	out := new(QueryGetTssAddressResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetTssAddressByFinalizedHeight(ctx context.Context, in *QueryGetTssAddressByFinalizedHeightRequest, opts ...grpc.CallOption) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	out := new(QueryGetTssAddressByFinalizedHeightResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TSS(ctx context.Context, in *QueryGetTSSRequest, opts ...grpc.CallOption) (*QueryGetTSSResponse, error) {
+	out := new(QueryGetTSSResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TSS", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BitcoinChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.FinalizedZetaHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) TssHistory(ctx context.Context, in *QueryTssHistoryRequest, opts ...grpc.CallOption) (*QueryTssHistoryResponse, error) {
+	out := new(QueryTssHistoryResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/TssHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PendingNoncesAll(ctx context.Context, in *QueryAllPendingNoncesRequest, opts ...grpc.CallOption) (*QueryAllPendingNoncesResponse, error) {
+	out := new(QueryAllPendingNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesAll", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Btc) > 0 {
-		i -= len(m.Btc)
-		copy(dAtA[i:], m.Btc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Eth) > 0 {
-		i -= len(m.Eth)
-		copy(dAtA[i:], m.Eth)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PendingNoncesByChain(ctx context.Context, in *QueryPendingNoncesByChainRequest, opts ...grpc.CallOption) (*QueryPendingNoncesByChainResponse, error) {
+	out := new(QueryPendingNoncesByChainResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/PendingNoncesByChain", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ChainNonces(ctx context.Context, in *QueryGetChainNoncesRequest, opts ...grpc.CallOption) (*QueryGetChainNoncesResponse, error) {
+	out := new(QueryGetChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNonces", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ChainNoncesAll(ctx context.Context, in *QueryAllChainNoncesRequest, opts ...grpc.CallOption) (*QueryAllChainNoncesResponse, error) {
+	out := new(QueryAllChainNoncesResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ChainNoncesAll", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ObserverPerformance(ctx context.Context, in *QueryObserverPerformanceRequest, opts ...grpc.CallOption) (*QueryObserverPerformanceResponse, error) {
+	out := new(QueryObserverPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Query/ObserverPerformance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query if a voter has voted for a ballot
+	HasVoted(context.Context, *QueryHasVotedRequest) (*QueryHasVotedResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BallotByIdentifier(context.Context, *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error)
+	// Queries the ballots created in a range of zeta heights
+	BallotsByHeight(context.Context, *QueryBallotsByHeightRequest) (*QueryBallotsByHeightResponse, error)
+	// Queries the ballots an observer has voted or has not voted on
+	BallotsByVoter(context.Context, *QueryBallotsByVoterRequest) (*QueryBallotsByVoterResponse, error)
+	// Queries the ballots with a given status, optionally filtered by observation type
+	BallotsByStatus(context.Context, *QueryBallotsByStatusRequest) (*QueryBallotsByStatusResponse, error)
+	// Queries a list of ObserversByChainAndType items.
+	ObserverSet(context.Context, *QueryObserverSet) (*QueryObserverSetResponse, error)
+	// Queries the chains an observer is assigned to
+	ObserverChains(context.Context, *QueryObserverChainsRequest) (*QueryObserverChainsResponse, error)
+	// Queries the liveness state of an observer
+	ObserverLiveness(context.Context, *QueryObserverLivenessRequest) (*QueryObserverLivenessResponse, error)
+	// Queries the observers currently jailed for missing ballots
+	JailedObservers(context.Context, *QueryJailedObserversRequest) (*QueryJailedObserversResponse, error)
+	SupportedChains(context.Context, *QuerySupportedChains) (*QuerySupportedChainsResponse, error)
+	// Queries a list of GetChainParamsForChain items.
+	GetChainParamsForChain(context.Context, *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error)
+	// Queries a list of GetChainParams items.
+	GetChainParams(context.Context, *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error)
+	// Queries the chain params scheduled to be applied at an activation height
+	PendingChainParams(context.Context, *QueryPendingChainParamsRequest) (*QueryPendingChainParamsResponse, error)
+	// Queries the chain params scheduled to be applied at an activation height for a chain
+	PendingChainParamsForChain(context.Context, *QueryPendingChainParamsForChainRequest) (*QueryPendingChainParamsForChainResponse, error)
+	// Queries a nodeAccount by index.
+	NodeAccount(context.Context, *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error)
+	// Queries a list of nodeAccount items.
+	NodeAccountAll(context.Context, *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error)
+	CrosschainFlags(context.Context, *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error)
+	// Queries a keygen by index.
+	Keygen(context.Context, *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error)
+	// Queries a list of ShowObserverCount items.
+	ShowObserverCount(context.Context, *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlameByIdentifier(context.Context, *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	GetAllBlameRecords(context.Context, *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error)
+	// Queries a list of VoterByIdentifier items.
+	BlamesByChainAndNonce(context.Context, *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error)
+	// Queries the number of times the nodes were blamed per chain in the recent blocks
+	BlameStatistics(context.Context, *QueryBlameStatisticsRequest) (*QueryBlameStatisticsResponse, error)
+	GetAllBlockHeaders(context.Context, *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error)
+	GetBlockHeaderByHash(context.Context, *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error)
+	GetBlockHeaderStateByChain(context.Context, *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error)
+	// Queries the beacon chain light client of an Ethereum chain
+	EthereumLightClient(context.Context, *QueryEthereumLightClientRequest) (*QueryEthereumLightClientResponse, error)
+	// merkle proof verification
+	Prove(context.Context, *QueryProveRequest) (*QueryProveResponse, error)
+	// Queries a list of GetTssAddress items.
+	GetTssAddress(context.Context, *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error)
+	GetTssAddressByFinalizedHeight(context.Context, *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error)
+	// Queries a tSS by index.
+	TSS(context.Context, *QueryGetTSSRequest) (*QueryGetTSSResponse, error)
+	TssHistory(context.Context, *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error)
+	PendingNoncesAll(context.Context, *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error)
+	PendingNoncesByChain(context.Context, *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error)
+	// Queries a chainNonces by index.
+	ChainNonces(context.Context, *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error)
+	// Queries a list of chainNonces items.
+	ChainNoncesAll(context.Context, *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error)
+	// Queries the participation counters of an observer
+	ObserverPerformance(context.Context, *QueryObserverPerformanceRequest) (*QueryObserverPerformanceResponse, error)
 }
 
-func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.TssList) > 0 {
-		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryProveRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryProveRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) HasVoted(ctx context.Context, req *QueryHasVotedRequest) (*QueryHasVotedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasVoted not implemented")
 }
-
-func (m *QueryProveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TxIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TxHash) > 0 {
-		i -= len(m.TxHash)
-		copy(dAtA[i:], m.TxHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) BallotByIdentifier(ctx context.Context, req *QueryBallotByIdentifierRequest) (*QueryBallotByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotByIdentifier not implemented")
 }
-
-func (m *QueryProveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) BallotsByHeight(ctx context.Context, req *QueryBallotsByHeightRequest) (*QueryBallotsByHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotsByHeight not implemented")
 }
-
-func (m *QueryProveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) BallotsByVoter(ctx context.Context, req *QueryBallotsByVoterRequest) (*QueryBallotsByVoterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotsByVoter not implemented")
 }
-
-func (m *QueryProveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valid {
-		i--
-		if m.Valid {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) BallotsByStatus(ctx context.Context, req *QueryBallotsByStatusRequest) (*QueryBallotsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotsByStatus not implemented")
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ObserverSet(ctx context.Context, req *QueryObserverSet) (*QueryObserverSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverSet not implemented")
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ObserverChains(ctx context.Context, req *QueryObserverChainsRequest) (*QueryObserverChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverChains not implemented")
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ObserverLiveness(ctx context.Context, req *QueryObserverLivenessRequest) (*QueryObserverLivenessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverLiveness not implemented")
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) JailedObservers(ctx context.Context, req *QueryJailedObserversRequest) (*QueryJailedObserversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JailedObservers not implemented")
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) SupportedChains(ctx context.Context, req *QuerySupportedChains) (*QuerySupportedChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupportedChains not implemented")
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetChainParamsForChain(ctx context.Context, req *QueryGetChainParamsForChainRequest) (*QueryGetChainParamsForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainParamsForChain not implemented")
 }
-
-func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetChainParams(ctx context.Context, req *QueryGetChainParamsRequest) (*QueryGetChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChainParams not implemented")
 }
-
-func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) PendingChainParams(ctx context.Context, req *QueryPendingChainParamsRequest) (*QueryPendingChainParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingChainParams not implemented")
 }
-
-func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) PendingChainParamsForChain(ctx context.Context, req *QueryPendingChainParamsForChainRequest) (*QueryPendingChainParamsForChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingChainParamsForChain not implemented")
 }
-
-func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) NodeAccount(ctx context.Context, req *QueryGetNodeAccountRequest) (*QueryGetNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccount not implemented")
+}
+func (*UnimplementedQueryServer) NodeAccountAll(ctx context.Context, req *QueryAllNodeAccountRequest) (*QueryAllNodeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeAccountAll not implemented")
+}
+func (*UnimplementedQueryServer) CrosschainFlags(ctx context.Context, req *QueryGetCrosschainFlagsRequest) (*QueryGetCrosschainFlagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrosschainFlags not implemented")
+}
+func (*UnimplementedQueryServer) Keygen(ctx context.Context, req *QueryGetKeygenRequest) (*QueryGetKeygenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keygen not implemented")
+}
+func (*UnimplementedQueryServer) ShowObserverCount(ctx context.Context, req *QueryShowObserverCountRequest) (*QueryShowObserverCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowObserverCount not implemented")
+}
+func (*UnimplementedQueryServer) BlameByIdentifier(ctx context.Context, req *QueryBlameByIdentifierRequest) (*QueryBlameByIdentifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameByIdentifier not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlameRecords(ctx context.Context, req *QueryAllBlameRecordsRequest) (*QueryAllBlameRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlameRecords not implemented")
+}
+func (*UnimplementedQueryServer) BlamesByChainAndNonce(ctx context.Context, req *QueryBlameByChainAndNonceRequest) (*QueryBlameByChainAndNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlamesByChainAndNonce not implemented")
+}
+func (*UnimplementedQueryServer) BlameStatistics(ctx context.Context, req *QueryBlameStatisticsRequest) (*QueryBlameStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlameStatistics not implemented")
+}
+func (*UnimplementedQueryServer) GetAllBlockHeaders(ctx context.Context, req *QueryAllBlockHeaderRequest) (*QueryAllBlockHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBlockHeaders not implemented")
+}
+func (*UnimplementedQueryServer) GetBlockHeaderByHash(ctx context.Context, req *QueryGetBlockHeaderByHashRequest) (*QueryGetBlockHeaderByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderByHash not implemented")
+}
+func (*UnimplementedQueryServer) GetBlockHeaderStateByChain(ctx context.Context, req *QueryGetBlockHeaderStateRequest) (*QueryGetBlockHeaderStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockHeaderStateByChain not implemented")
+}
+func (*UnimplementedQueryServer) EthereumLightClient(ctx context.Context, req *QueryEthereumLightClientRequest) (*QueryEthereumLightClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EthereumLightClient not implemented")
+}
+func (*UnimplementedQueryServer) Prove(ctx context.Context, req *QueryProveRequest) (*QueryProveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prove not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddress(ctx context.Context, req *QueryGetTssAddressRequest) (*QueryGetTssAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddress not implemented")
+}
+func (*UnimplementedQueryServer) GetTssAddressByFinalizedHeight(ctx context.Context, req *QueryGetTssAddressByFinalizedHeightRequest) (*QueryGetTssAddressByFinalizedHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTssAddressByFinalizedHeight not implemented")
+}
+func (*UnimplementedQueryServer) TSS(ctx context.Context, req *QueryGetTSSRequest) (*QueryGetTSSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSS not implemented")
+}
+func (*UnimplementedQueryServer) TssHistory(ctx context.Context, req *QueryTssHistoryRequest) (*QueryTssHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TssHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesAll(ctx context.Context, req *QueryAllPendingNoncesRequest) (*QueryAllPendingNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) PendingNoncesByChain(ctx context.Context, req *QueryPendingNoncesByChainRequest) (*QueryPendingNoncesByChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingNoncesByChain not implemented")
+}
+func (*UnimplementedQueryServer) ChainNonces(ctx context.Context, req *QueryGetChainNoncesRequest) (*QueryGetChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNonces not implemented")
+}
+func (*UnimplementedQueryServer) ChainNoncesAll(ctx context.Context, req *QueryAllChainNoncesRequest) (*QueryAllChainNoncesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainNoncesAll not implemented")
+}
+func (*UnimplementedQueryServer) ObserverPerformance(ctx context.Context, req *QueryObserverPerformanceRequest) (*QueryObserverPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObserverPerformance not implemented")
 }
 
-func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.HasVoted {
-		i--
-		if m.HasVoted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_HasVoted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasVotedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).HasVoted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/HasVoted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasVoted(ctx, req.(*QueryHasVotedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BallotByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BallotByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotByIdentifier(ctx, req.(*QueryBallotByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+func _Query_BallotsByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotsByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BallotsByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotsByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotsByHeight(ctx, req.(*QueryBallotsByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VoterList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BallotsByVoter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotsByVoterRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BallotsByVoter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotsByVoter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotsByVoter(ctx, req.(*QueryBallotsByVoterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BallotsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BallotsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BallotsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotsByStatus(ctx, req.(*QueryBallotsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.VoteType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
-		i--
-		dAtA[i] = 0x10
+func _Query_ObserverSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverSet)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.VoterAddress) > 0 {
-		i -= len(m.VoterAddress)
-		copy(dAtA[i:], m.VoterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverSet(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverSet(ctx, req.(*QueryObserverSet))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ObserverChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverChainsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverChains(ctx, req.(*QueryObserverChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ObserverLiveness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverLivenessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverLiveness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverLiveness",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverLiveness(ctx, req.(*QueryObserverLivenessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BallotStatus != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
-		i--
-		dAtA[i] = 0x20
+func _Query_JailedObservers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryJailedObserversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.ObservationType != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).JailedObservers(ctx, in)
 	}
-	if len(m.Voters) > 0 {
-		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/JailedObservers",
 	}
-	if len(m.BallotIdentifier) > 0 {
-		i -= len(m.BallotIdentifier)
-		copy(dAtA[i:], m.BallotIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).JailedObservers(ctx, req.(*QueryJailedObserversRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SupportedChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupportedChains)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).SupportedChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/SupportedChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupportedChains(ctx, req.(*QuerySupportedChains))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetChainParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainParamsForChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChainParamsForChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParamsForChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChainParamsForChain(ctx, req.(*QueryGetChainParamsForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetChainParams(ctx, req.(*QueryGetChainParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingChainParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingChainParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PendingChainParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PendingChainParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingChainParams(ctx, req.(*QueryPendingChainParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_PendingChainParamsForChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingChainParamsForChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingChainParamsForChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PendingChainParamsForChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingChainParamsForChain(ctx, req.(*QueryPendingChainParamsForChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Observers) > 0 {
-		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Observers[iNdEx])
-			copy(dAtA[i:], m.Observers[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_NodeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetNodeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccount(ctx, req.(*QueryGetNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NodeAccountAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllNodeAccountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).NodeAccountAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/NodeAccountAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeAccountAll(ctx, req.(*QueryAllNodeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CrosschainFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCrosschainFlagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CrosschainFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/CrosschainFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CrosschainFlags(ctx, req.(*QueryGetCrosschainFlagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_Keygen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetKeygenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Keygen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Keygen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Keygen(ctx, req.(*QueryGetKeygenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ShowObserverCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowObserverCountRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ShowObserverCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ShowObserverCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowObserverCount(ctx, req.(*QueryShowObserverCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_BlameByIdentifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByIdentifierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BlameByIdentifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlameByIdentifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameByIdentifier(ctx, req.(*QueryBlameByIdentifierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetAllBlameRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlameRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlameRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlameRecords(ctx, req.(*QueryAllBlameRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BlamesByChainAndNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameByChainAndNonceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlamesByChainAndNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlamesByChainAndNonce(ctx, req.(*QueryBlameByChainAndNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BlameStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlameStatisticsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BlameStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/BlameStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlameStatistics(ctx, req.(*QueryBlameStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetAllBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllBlockHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetAllBlockHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllBlockHeaders(ctx, req.(*QueryAllBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_GetBlockHeaderByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlockHeaderByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlockHeaderByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlockHeaderByHash(ctx, req.(*QueryGetBlockHeaderByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBlockHeaderStateByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetBlockHeaderStateRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetBlockHeaderStateByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlockHeaderStateByChain(ctx, req.(*QueryGetBlockHeaderStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EthereumLightClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEthereumLightClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EthereumLightClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/EthereumLightClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EthereumLightClient(ctx, req.(*QueryEthereumLightClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ChainParams != nil {
-		{
-			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Prove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Prove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/Prove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Prove(ctx, req.(*QueryProveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetTssAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddress(ctx, req.(*QueryGetTssAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0xa
+func _Query_GetTssAddressByFinalizedHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTssAddressByFinalizedHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/GetTssAddressByFinalizedHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTssAddressByFinalizedHeight(ctx, req.(*QueryGetTssAddressByFinalizedHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TSS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTSSRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NodeAccount != nil {
-		{
-			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).TSS(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/TSS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TSS(ctx, req.(*QueryGetTSSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_TssHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTssHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).TssHistory(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/TssHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TssHistory(ctx, req.(*QueryTssHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingNoncesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesAll(ctx, in)
 	}
-	if len(m.NodeAccount) > 0 {
-		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesAll",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesAll(ctx, req.(*QueryAllPendingNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PendingNoncesByChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingNoncesByChainRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/PendingNoncesByChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingNoncesByChain(ctx, req.(*QueryPendingNoncesByChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_ChainNonces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetChainNoncesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNonces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainNonces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNonces(ctx, req.(*QueryGetChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ChainNoncesAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllChainNoncesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ChainNoncesAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ChainNoncesAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainNoncesAll(ctx, req.(*QueryAllChainNoncesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ObserverPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserverPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ObserverPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Query/ObserverPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ObserverPerformance(ctx, req.(*QueryObserverPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "zetachain.zetacore.observer.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "HasVoted",
+			Handler:    _Query_HasVoted_Handler,
+		},
+		{
+			MethodName: "BallotByIdentifier",
+			Handler:    _Query_BallotByIdentifier_Handler,
+		},
+		{
+			MethodName: "BallotsByHeight",
+			Handler:    _Query_BallotsByHeight_Handler,
+		},
+		{
+			MethodName: "BallotsByVoter",
+			Handler:    _Query_BallotsByVoter_Handler,
+		},
+		{
+			MethodName: "BallotsByStatus",
+			Handler:    _Query_BallotsByStatus_Handler,
+		},
+		{
+			MethodName: "ObserverSet",
+			Handler:    _Query_ObserverSet_Handler,
+		},
+		{
+			MethodName: "ObserverChains",
+			Handler:    _Query_ObserverChains_Handler,
+		},
+		{
+			MethodName: "ObserverLiveness",
+			Handler:    _Query_ObserverLiveness_Handler,
+		},
+		{
+			MethodName: "JailedObservers",
+			Handler:    _Query_JailedObservers_Handler,
+		},
+		{
+			MethodName: "SupportedChains",
+			Handler:    _Query_SupportedChains_Handler,
+		},
+		{
+			MethodName: "GetChainParamsForChain",
+			Handler:    _Query_GetChainParamsForChain_Handler,
+		},
+		{
+			MethodName: "GetChainParams",
+			Handler:    _Query_GetChainParams_Handler,
+		},
+		{
+			MethodName: "PendingChainParams",
+			Handler:    _Query_PendingChainParams_Handler,
+		},
+		{
+			MethodName: "PendingChainParamsForChain",
+			Handler:    _Query_PendingChainParamsForChain_Handler,
+		},
+		{
+			MethodName: "NodeAccount",
+			Handler:    _Query_NodeAccount_Handler,
+		},
+		{
+			MethodName: "NodeAccountAll",
+			Handler:    _Query_NodeAccountAll_Handler,
+		},
+		{
+			MethodName: "CrosschainFlags",
+			Handler:    _Query_CrosschainFlags_Handler,
+		},
+		{
+			MethodName: "Keygen",
+			Handler:    _Query_Keygen_Handler,
+		},
+		{
+			MethodName: "ShowObserverCount",
+			Handler:    _Query_ShowObserverCount_Handler,
+		},
+		{
+			MethodName: "BlameByIdentifier",
+			Handler:    _Query_BlameByIdentifier_Handler,
+		},
+		{
+			MethodName: "GetAllBlameRecords",
+			Handler:    _Query_GetAllBlameRecords_Handler,
+		},
+		{
+			MethodName: "BlamesByChainAndNonce",
+			Handler:    _Query_BlamesByChainAndNonce_Handler,
+		},
+		{
+			MethodName: "BlameStatistics",
+			Handler:    _Query_BlameStatistics_Handler,
+		},
+		{
+			MethodName: "GetAllBlockHeaders",
+			Handler:    _Query_GetAllBlockHeaders_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderByHash",
+			Handler:    _Query_GetBlockHeaderByHash_Handler,
+		},
+		{
+			MethodName: "GetBlockHeaderStateByChain",
+			Handler:    _Query_GetBlockHeaderStateByChain_Handler,
+		},
+		{
+			MethodName: "EthereumLightClient",
+			Handler:    _Query_EthereumLightClient_Handler,
+		},
+		{
+			MethodName: "Prove",
+			Handler:    _Query_Prove_Handler,
+		},
+		{
+			MethodName: "GetTssAddress",
+			Handler:    _Query_GetTssAddress_Handler,
+		},
+		{
+			MethodName: "GetTssAddressByFinalizedHeight",
+			Handler:    _Query_GetTssAddressByFinalizedHeight_Handler,
+		},
+		{
+			MethodName: "TSS",
+			Handler:    _Query_TSS_Handler,
+		},
+		{
+			MethodName: "TssHistory",
+			Handler:    _Query_TssHistory_Handler,
+		},
+		{
+			MethodName: "PendingNoncesAll",
+			Handler:    _Query_PendingNoncesAll_Handler,
+		},
+		{
+			MethodName: "PendingNoncesByChain",
+			Handler:    _Query_PendingNoncesByChain_Handler,
+		},
+		{
+			MethodName: "ChainNonces",
+			Handler:    _Query_ChainNonces_Handler,
+		},
+		{
+			MethodName: "ChainNoncesAll",
+			Handler:    _Query_ChainNoncesAll_Handler,
+		},
+		{
+			MethodName: "ObserverPerformance",
+			Handler:    _Query_ObserverPerformance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "observer/query.proto",
 }
 
-func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverPerformanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5177,20 +5414,44 @@ func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverPerformanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryObserverPerformanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5200,19 +5461,19 @@ func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryObserverPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryObserverPerformanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Keygen != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5220,12 +5481,26 @@ func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.ObserverPerformances) > 0 {
+		for iNdEx := len(m.ObserverPerformances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverPerformances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5235,20 +5510,27 @@ func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5258,32 +5540,30 @@ func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastObserverCount != nil {
-		{
-			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.ChainNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5293,27 +5573,32 @@ func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameIdentifier) > 0 {
-		i -= len(m.BlameIdentifier)
-		copy(dAtA[i:], m.BlameIdentifier)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllChainNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5323,19 +5608,19 @@ func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllChainNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlameInfo != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5343,12 +5628,26 @@ func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainNonces) > 0 {
+		for iNdEx := len(m.ChainNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5358,12 +5657,12 @@ func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5383,7 +5682,7 @@ func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAllPendingNoncesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5393,12 +5692,12 @@ func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAllPendingNoncesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5415,10 +5714,10 @@ func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PendingNonces) > 0 {
+		for iNdEx := len(m.PendingNonces) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PendingNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5432,7 +5731,7 @@ func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5442,21 +5741,16 @@ func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.ChainId != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
 		i--
@@ -5465,7 +5759,7 @@ func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPendingNoncesByChainResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5475,34 +5769,30 @@ func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPendingNoncesByChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.PendingNonces.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTSSRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5512,32 +5802,53 @@ func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTSSRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTSSResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTSSResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTSSResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TSS.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5547,46 +5858,25 @@ func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BlockHeaders) > 0 {
-		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5596,27 +5886,34 @@ func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlockHash) > 0 {
-		i -= len(m.BlockHash)
-		copy(dAtA[i:], m.BlockHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5626,32 +5923,30 @@ func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeader != nil {
-		{
-			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.BitcoinChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BitcoinChainId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.FinalizedZetaHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FinalizedZetaHeight))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5661,25 +5956,34 @@ func (m *QueryGetBlockHeaderStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetTssAddressByFinalizedHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+	if len(m.Btc) > 0 {
+		i -= len(m.Btc)
+		copy(dAtA[i:], m.Btc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Btc)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Eth) > 0 {
+		i -= len(m.Eth)
+		copy(dAtA[i:], m.Eth)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Eth)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetBlockHeaderStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryTssHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5689,19 +5993,19 @@ func (m *QueryGetBlockHeaderStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetBlockHeaderStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetBlockHeaderStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryTssHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlockHeaderState != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.BlockHeaderState.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5714,778 +6018,4969 @@ func (m *QueryGetBlockHeaderStateResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTssHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryGetChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ChainNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryTssHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllChainNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryTssHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.TssList) > 0 {
+		for iNdEx := len(m.TssList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TssList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllChainNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryProveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ChainNonces) > 0 {
-		for _, e := range m.ChainNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.TxIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllPendingNoncesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryProveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllPendingNoncesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryProveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PendingNonces) > 0 {
-		for _, e := range m.PendingNonces {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PendingNonces.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetTSSRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTSSResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TSS.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryHasVotedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasVotedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasVotedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalizedZetaHeight != 0 {
-		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
-	}
-	if m.BitcoinChainId != 0 {
-		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+func (m *QueryHasVotedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Eth)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Btc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryHasVotedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTssHistoryRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryHasVotedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.HasVoted {
+		i--
+		if m.HasVoted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTssHistoryResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TssList) > 0 {
-		for _, e := range m.TssList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBallotByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryProveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	l = len(m.TxHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.TxIndex != 0 {
-		n += 1 + sovQuery(uint64(m.TxIndex))
-	}
-	return n
+func (m *QueryBallotByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryProveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Valid {
-		n += 2
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VoterList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *VoterList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryHasVotedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VoterList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.VoteType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoteType))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryHasVotedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HasVoted {
-		n += 2
+func (m *QueryBallotByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBallotByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBallotByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VoterList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.VoterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.VoteType != 0 {
-		n += 1 + sovQuery(uint64(m.VoteType))
+	if m.BallotStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
-}
-
-func (m *QueryBallotByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BallotIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ObservationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Voters) > 0 {
-		for _, e := range m.Voters {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Voters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Voters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.ObservationType != 0 {
-		n += 1 + sovQuery(uint64(m.ObservationType))
-	}
-	if m.BallotStatus != 0 {
-		n += 1 + sovQuery(uint64(m.BallotStatus))
-	}
-	return n
-}
-
-func (m *QueryObserverSet) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.BallotIdentifier) > 0 {
+		i -= len(m.BallotIdentifier)
+		copy(dAtA[i:], m.BallotIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BallotIdentifier)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryObserverSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Observers) > 0 {
-		for _, s := range m.Observers {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryBallotsByHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySupportedChains) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryBallotsByHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySupportedChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotsByHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+	if m.MinHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBallotsByHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetChainParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryBallotsByHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetChainParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotsByHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainParams != nil {
-		l = m.ChainParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Ballots) > 0 {
+		for iNdEx := len(m.Ballots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ballots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBallotsByVoterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetNodeAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NodeAccount != nil {
-		l = m.NodeAccount.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBallotsByVoterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllNodeAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotsByVoterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAllNodeAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.NodeAccount) > 0 {
-		for _, e := range m.NodeAccount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.HasVoted {
+		i--
+		if m.HasVoted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.VoterAddress) > 0 {
+		i -= len(m.VoterAddress)
+		copy(dAtA[i:], m.VoterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBallotsByVoterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.CrosschainFlags.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBallotsByVoterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetKeygenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotsByVoterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryGetKeygenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Keygen != nil {
-		l = m.Keygen.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Ballots) > 0 {
+		for iNdEx := len(m.Ballots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ballots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryShowObserverCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBallotsByStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryShowObserverCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastObserverCount != nil {
-		l = m.LastObserverCount.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBallotsByStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlameByIdentifierRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotsByStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BlameIdentifier)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *QueryBlameByIdentifierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ObservationType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservationType))
+		i--
+		dAtA[i] = 0x10
 	}
-	var l int
-	_ = l
-	if m.BlameInfo != nil {
-		l = m.BlameInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BallotStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotStatus))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlameRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBallotsByStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllBlameRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBallotsByStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBallotsByStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Ballots) > 0 {
+		for iNdEx := len(m.Ballots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ballots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlameInfo) > 0 {
-		for _, e := range m.BlameInfo {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Observers[iNdEx])
+			copy(dAtA[i:], m.Observers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Observers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryObserverChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryObserverChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlockHeaders) > 0 {
-		for _, e := range m.BlockHeaders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.ChainIds) > 0 {
+		dAtA21 := make([]byte, len(m.ChainIds)*10)
+		var j20 int
+		for _, num1 := range m.ChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA21[j20] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j20++
+			}
+			dAtA21[j20] = uint8(num)
+			j20++
+		}
+		i -= j20
+		copy(dAtA[i:], dAtA21[:j20])
+		i = encodeVarintQuery(dAtA, i, uint64(j20))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObserverLivenessRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverLivenessRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverLivenessRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ObserverAddress) > 0 {
+		i -= len(m.ObserverAddress)
+		copy(dAtA[i:], m.ObserverAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ObserverAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObserverLivenessResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserverLivenessResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserverLivenessResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ObserverLiveness.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailedObserversRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailedObserversRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailedObserversRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryJailedObserversResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryJailedObserversResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryJailedObserversResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObserverLiveness) > 0 {
+		for iNdEx := len(m.ObserverLiveness) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverLiveness[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupportedChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupportedChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupportedChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupportedChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupportedChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupportedChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		{
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		{
+			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChainParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChainParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChainParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChainParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChainParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChainParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingChainParams) > 0 {
+		for iNdEx := len(m.PendingChainParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChainParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChainParamsForChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChainParamsForChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChainParamsForChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingChainParamsForChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingChainParamsForChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingChainParamsForChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingChainParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NodeAccount != nil {
+		{
+			size, err := m.NodeAccount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNodeAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNodeAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNodeAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllNodeAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllNodeAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllNodeAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeAccount) > 0 {
+		for iNdEx := len(m.NodeAccount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeAccount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCrosschainFlagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCrosschainFlagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCrosschainFlagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCrosschainFlagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCrosschainFlagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCrosschainFlagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CrosschainFlags.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeygenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetKeygenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetKeygenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		{
+			size, err := m.Keygen.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowObserverCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowObserverCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowObserverCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryShowObserverCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryShowObserverCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryShowObserverCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		{
+			size, err := m.LastObserverCount.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByIdentifierRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameIdentifier) > 0 {
+		i -= len(m.BlameIdentifier)
+		copy(dAtA[i:], m.BlameIdentifier)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlameIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByIdentifierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByIdentifierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		{
+			size, err := m.BlameInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlameRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlameRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlameRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameByChainAndNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameByChainAndNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for iNdEx := len(m.BlameInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlameInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlameStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlameStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlameStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NodeStatistics) > 0 {
+		for iNdEx := len(m.NodeStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlockHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlockHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlockHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllBlockHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBlockHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBlockHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockHeaders) > 0 {
+		for iNdEx := len(m.BlockHeaders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockHeaders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		{
+			size, err := m.BlockHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetBlockHeaderStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetBlockHeaderStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetBlockHeaderStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeaderState != nil {
+		{
+			size, err := m.BlockHeaderState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEthereumLightClientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthereumLightClientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthereumLightClientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEthereumLightClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEthereumLightClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEthereumLightClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LightClient.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryObserverPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserverPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObserverPerformances) > 0 {
+		for _, e := range m.ObserverPerformances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ChainNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllChainNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllChainNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainNonces) > 0 {
+		for _, e := range m.ChainNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingNoncesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingNonces) > 0 {
+		for _, e := range m.PendingNonces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPendingNoncesByChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingNonces.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTSSRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetTSSResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TSS.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetTssAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalizedZetaHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FinalizedZetaHeight))
+	}
+	if m.BitcoinChainId != 0 {
+		n += 1 + sovQuery(uint64(m.BitcoinChainId))
+	}
+	return n
+}
+
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Eth)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Btc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTssHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TssList) > 0 {
+		for _, e := range m.TssList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TxIndex))
+	}
+	return n
+}
+
+func (m *QueryProveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHasVotedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasVotedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasVoted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VoterList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VoteType != 0 {
+		n += 1 + sovQuery(uint64(m.VoteType))
+	}
+	return n
+}
+
+func (m *QueryBallotByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BallotIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Voters) > 0 {
+		for _, e := range m.Voters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovQuery(uint64(m.ObservationType))
+	}
+	if m.BallotStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BallotStatus))
+	}
+	return n
+}
+
+func (m *QueryBallotsByHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MinHeight))
+	}
+	if m.MaxHeight != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBallotsByHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBallotsByVoterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HasVoted {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBallotsByVoterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBallotsByStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BallotStatus != 0 {
+		n += 1 + sovQuery(uint64(m.BallotStatus))
+	}
+	if m.ObservationType != 0 {
+		n += 1 + sovQuery(uint64(m.ObservationType))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBallotsByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ballots) > 0 {
+		for _, e := range m.Ballots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserverSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryObserverSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Observers) > 0 {
+		for _, s := range m.Observers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryObserverChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserverChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ChainIds) > 0 {
+		l = 0
+		for _, e := range m.ChainIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryObserverLivenessRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryObserverLivenessResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObserverLiveness.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryJailedObserversRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryJailedObserversResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ObserverLiveness) > 0 {
+		for _, e := range m.ObserverLiveness {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupportedChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySupportedChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainParams != nil {
+		l = m.ChainParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingChainParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingChainParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingChainParams) > 0 {
+		for _, e := range m.PendingChainParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingChainParamsForChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryPendingChainParamsForChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingChainParams.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NodeAccount != nil {
+		l = m.NodeAccount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllNodeAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeAccount) > 0 {
+		for _, e := range m.NodeAccount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetCrosschainFlagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CrosschainFlags.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetKeygenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetKeygenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Keygen != nil {
+		l = m.Keygen.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryShowObserverCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryShowObserverCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastObserverCount != nil {
+		l = m.LastObserverCount.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlameIdentifier)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByIdentifierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlameInfo != nil {
+		l = m.BlameInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlameRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBlameByChainAndNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlameInfo) > 0 {
+		for _, e := range m.BlameInfo {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlameStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *QueryBlameStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeStatistics) > 0 {
+		for _, e := range m.NodeStatistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	return n
+}
+
+func (m *QueryAllBlockHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockHeaders) > 0 {
+		for _, e := range m.BlockHeaders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderByHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeader != nil {
+		l = m.BlockHeader.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryGetBlockHeaderStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeaderState != nil {
+		l = m.BlockHeaderState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEthereumLightClientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryEthereumLightClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LightClient.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryObserverPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserverPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserverPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserverPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserverPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserverPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverPerformances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverPerformances = append(m.ObserverPerformances, ObserverPerformance{})
+			if err := m.ObserverPerformances[len(m.ObserverPerformances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
+			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
+			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+			}
+			m.BitcoinChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitcoinChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedZetaHeight", wireType)
+			}
+			m.FinalizedZetaHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedZetaHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+			}
+			m.BitcoinChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BitcoinChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Eth = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Btc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryGetBlockHeaderByHashRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryGetBlockHeaderByHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeader != nil {
-		l = m.BlockHeader.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryGetBlockHeaderStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ChainId != 0 {
-		n += 1 + sovQuery(uint64(m.ChainId))
+func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TssList = append(m.TssList, TSS{})
+			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetBlockHeaderStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockHeaderState != nil {
-		l = m.BlockHeaderState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6508,17 +11003,68 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6528,79 +11074,33 @@ func (m *QueryGetChainNoncesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Proof == nil {
+				m.Proof = &common.Proof{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6610,25 +11110,43 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ChainNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6650,7 +11168,7 @@ func (m *QueryGetChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6673,17 +11191,17 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6693,28 +11211,12 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Valid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6736,7 +11238,7 @@ func (m *QueryAllChainNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6759,82 +11261,12 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllChainNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainNonces", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainNonces = append(m.ChainNonces, ChainNonces{})
-			if err := m.ChainNonces[len(m.ChainNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6856,7 +11288,7 @@ func (m *QueryAllChainNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6879,15 +11311,15 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6914,10 +11346,7 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6942,7 +11371,7 @@ func (m *QueryAllPendingNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6965,17 +11394,17 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPendingNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6985,31 +11414,29 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingNonces = append(m.PendingNonces, PendingNonces{})
-			if err := m.PendingNonces[len(m.PendingNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7019,27 +11446,23 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7062,7 +11485,7 @@ func (m *QueryAllPendingNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7085,17 +11508,17 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7105,11 +11528,12 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HasVoted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7131,7 +11555,7 @@ func (m *QueryPendingNoncesByChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7154,17 +11578,17 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingNoncesByChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7174,24 +11598,23 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PendingNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7214,7 +11637,7 @@ func (m *QueryPendingNoncesByChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
+func (m *VoterList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7237,12 +11660,63 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
+			}
+			m.VoteType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoteType |= VoteType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7264,7 +11738,7 @@ func (m *QueryGetTSSRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7287,15 +11761,47 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTSSResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TSS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7322,10 +11828,49 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TSS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Voters = append(m.Voters, &VoterList{})
+			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
+			}
+			m.ObservationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
+			}
+			m.BallotStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7347,7 +11892,7 @@ func (m *QueryGetTSSResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotsByHeightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7370,17 +11915,36 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotsByHeightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotsByHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHeight", wireType)
+			}
+			m.MinHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHeight", wireType)
 			}
-			m.BitcoinChainId = 0
+			m.MaxHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7390,11 +11954,47 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
+				m.MaxHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7416,7 +12016,7 @@ func (m *QueryGetTssAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotsByHeightResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7439,17 +12039,17 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotsByHeightResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotsByHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7459,29 +12059,31 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.Ballots = append(m.Ballots, Ballot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7491,23 +12093,27 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7530,7 +12136,7 @@ func (m *QueryGetTssAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotsByVoterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7553,17 +12159,17 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotsByVoterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotsByVoterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedZetaHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
 			}
-			m.FinalizedZetaHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7573,16 +12179,29 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedZetaHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BitcoinChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
 			}
-			m.BitcoinChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7592,11 +12211,48 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BitcoinChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasVoted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7618,7 +12274,7 @@ func (m *QueryGetTssAddressByFinalizedHeightRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotsByVoterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7641,17 +12297,17 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotsByVoterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTssAddressByFinalizedHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotsByVoterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7661,29 +12317,31 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Eth = string(dAtA[iNdEx:postIndex])
+			m.Ballots = append(m.Ballots, Ballot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Btc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7693,23 +12351,27 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Btc = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7732,7 +12394,7 @@ func (m *QueryGetTssAddressByFinalizedHeightResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotsByStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7755,13 +12417,51 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotsByStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotsByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
+			}
+			m.BallotStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotStatus |= BallotStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
+			}
+			m.ObservationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservationType |= ObservationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7818,7 +12518,7 @@ func (m *QueryTssHistoryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotsByStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7841,15 +12541,15 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotsByStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTssHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotsByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TssList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ballots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7876,8 +12576,8 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TssList = append(m.TssList, TSS{})
-			if err := m.TssList[len(m.TssList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ballots = append(m.Ballots, Ballot{})
+			if err := m.Ballots[len(m.Ballots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7938,7 +12638,7 @@ func (m *QueryTssHistoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7948,115 +12648,78 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProveRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TxHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserverSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserverSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &common.Proof{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserverSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserverSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8084,27 +12747,8 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
-			}
-			m.TxIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TxIndex |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8126,7 +12770,7 @@ func (m *QueryProveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverChainsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8149,17 +12793,17 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverChainsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8169,12 +12813,24 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Valid = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8196,7 +12852,7 @@ func (m *QueryProveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverChainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8219,12 +12875,88 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverChainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ChainIds = append(m.ChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ChainIds) == 0 {
+					m.ChainIds = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChainIds = append(m.ChainIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8246,7 +12978,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverLivenessRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8269,17 +13001,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverLivenessRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverLivenessRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8289,24 +13021,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8329,7 +13060,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryObserverLivenessResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8352,17 +13083,17 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryObserverLivenessResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryObserverLivenessResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8372,55 +13103,24 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.ObserverLiveness.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8443,7 +13143,7 @@ func (m *QueryHasVotedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryJailedObserversRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8466,17 +13166,17 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryJailedObserversRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHasVotedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryJailedObserversRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasVoted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8486,12 +13186,28 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasVoted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8513,7 +13229,7 @@ func (m *QueryHasVotedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryJailedObserversResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8536,17 +13252,51 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryJailedObserversResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryJailedObserversResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverLiveness", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverLiveness = append(m.ObserverLiveness, ObserverLiveness{})
+			if err := m.ObserverLiveness[len(m.ObserverLiveness)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8556,23 +13306,27 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8595,7 +13349,7 @@ func (m *QueryBallotByIdentifierRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterList) Unmarshal(dAtA []byte) error {
+func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8618,63 +13372,12 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoterList: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoterAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoterAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteType", wireType)
-			}
-			m.VoteType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VoteType |= VoteType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8696,7 +13399,7 @@ func (m *VoterList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8719,47 +13422,15 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBallotByIdentifierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotIdentifier", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BallotIdentifier = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8786,49 +13457,11 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voters = append(m.Voters, &VoterList{})
-			if err := m.Voters[len(m.Voters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Chains = append(m.Chains, &common.Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationType", wireType)
-			}
-			m.ObservationType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservationType |= ObservationType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BallotStatus", wireType)
-			}
-			m.BallotStatus = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BallotStatus |= BallotStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8850,7 +13483,7 @@ func (m *QueryBallotByIdentifierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8873,12 +13506,31 @@ func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserverSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserverSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8900,7 +13552,7 @@ func (m *QueryObserverSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8923,17 +13575,17 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryObserverSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryObserverSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8943,23 +13595,27 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers, string(dAtA[iNdEx:postIndex]))
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8982,7 +13638,7 @@ func (m *QueryObserverSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9005,10 +13661,10 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9032,7 +13688,7 @@ func (m *QuerySupportedChains) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9055,15 +13711,15 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetChainParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupportedChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9090,8 +13746,10 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, &common.Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParamsList{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9116,7 +13774,7 @@ func (m *QuerySupportedChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChainParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9130,40 +13788,21 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingChainParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9185,7 +13824,7 @@ func (m *QueryGetChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChainParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9208,15 +13847,15 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingChainParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChainParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9243,10 +13882,8 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParams{}
-			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingChainParams = append(m.PendingChainParams, PendingChainParams{})
+			if err := m.PendingChainParams[len(m.PendingChainParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9271,7 +13908,7 @@ func (m *QueryGetChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChainParamsForChainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9294,12 +13931,31 @@ func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingChainParamsForChainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingChainParamsForChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9321,7 +13977,7 @@ func (m *QueryGetChainParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingChainParamsForChainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9344,15 +14000,15 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingChainParamsForChainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingChainParamsForChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChainParams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9379,10 +14035,7 @@ func (m *QueryGetChainParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParamsList{}
-			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10384,10 +15037,216 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlameInfo = append(m.BlameInfo, Blame{})
+			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var msglen int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10397,28 +15256,30 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10440,7 +15301,7 @@ func (m *QueryAllBlameRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10463,10 +15324,10 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBlameRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10498,47 +15359,11 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameInfo = append(m.BlameInfo, Blame{})
+			m.BlameInfo = append(m.BlameInfo, &Blame{})
 			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10560,7 +15385,7 @@ func (m *QueryAllBlameRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameStatisticsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10583,10 +15408,10 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameStatisticsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10610,9 +15435,9 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
 			}
-			m.Nonce = 0
+			m.WindowBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10622,7 +15447,7 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= int64(b&0x7F) << shift
+				m.WindowBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10648,7 +15473,7 @@ func (m *QueryBlameByChainAndNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlameStatisticsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10671,15 +15496,15 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlameStatisticsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlameByChainAndNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlameStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeStatistics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10706,11 +15531,30 @@ func (m *QueryBlameByChainAndNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlameInfo = append(m.BlameInfo, &Blame{})
-			if err := m.BlameInfo[len(m.BlameInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NodeStatistics = append(m.NodeStatistics, NodeBlameStatistics{})
+			if err := m.NodeStatistics[len(m.NodeStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11263,6 +16107,158 @@ func (m *QueryGetBlockHeaderStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryEthereumLightClientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthereumLightClientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthereumLightClientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEthereumLightClientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEthereumLightClientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEthereumLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LightClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LightClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
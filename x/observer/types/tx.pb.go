@@ -14,6 +14,7 @@ import (
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	common "github.com/zeta-chain/zetacore/common"
+	ethereum "github.com/zeta-chain/zetacore/common/ethereum"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -249,6 +250,8 @@ var xxx_messageInfo_MsgAddBlockHeaderResponse proto.InternalMessageInfo
 type MsgUpdateChainParams struct {
 	Creator     string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ChainParams *ChainParams `protobuf:"bytes,2,opt,name=chainParams,proto3" json:"chainParams,omitempty"`
+	// zeta height at which the chain params are applied, the chain params are applied immediately if zero
+	ActivationHeight int64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (m *MsgUpdateChainParams) Reset()         { *m = MsgUpdateChainParams{} }
@@ -298,6 +301,13 @@ func (m *MsgUpdateChainParams) GetChainParams() *ChainParams {
 	return nil
 }
 
+func (m *MsgUpdateChainParams) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 type MsgUpdateChainParamsResponse struct {
 }
 
@@ -628,6 +638,10 @@ type MsgUpdateCrosschainFlags struct {
 	IsOutboundEnabled            bool                          `protobuf:"varint,4,opt,name=isOutboundEnabled,proto3" json:"isOutboundEnabled,omitempty"`
 	GasPriceIncreaseFlags        *GasPriceIncreaseFlags        `protobuf:"bytes,5,opt,name=gasPriceIncreaseFlags,proto3" json:"gasPriceIncreaseFlags,omitempty"`
 	BlockHeaderVerificationFlags *BlockHeaderVerificationFlags `protobuf:"bytes,6,opt,name=blockHeaderVerificationFlags,proto3" json:"blockHeaderVerificationFlags,omitempty"`
+	PruningFlags                 *PruningFlags                 `protobuf:"bytes,7,opt,name=pruningFlags,proto3" json:"pruningFlags,omitempty"`
+	// chainFlags and foreignCoinFlags replace the flags of the provided chains and foreign coins
+	ChainFlags       []ChainFlags       `protobuf:"bytes,8,rep,name=chainFlags,proto3" json:"chainFlags"`
+	ForeignCoinFlags []ForeignCoinFlags `protobuf:"bytes,9,rep,name=foreignCoinFlags,proto3" json:"foreignCoinFlags"`
 }
 
 func (m *MsgUpdateCrosschainFlags) Reset()         { *m = MsgUpdateCrosschainFlags{} }
@@ -698,6 +712,27 @@ func (m *MsgUpdateCrosschainFlags) GetBlockHeaderVerificationFlags() *BlockHeade
 	return nil
 }
 
+func (m *MsgUpdateCrosschainFlags) GetPruningFlags() *PruningFlags {
+	if m != nil {
+		return m.PruningFlags
+	}
+	return nil
+}
+
+func (m *MsgUpdateCrosschainFlags) GetChainFlags() []ChainFlags {
+	if m != nil {
+		return m.ChainFlags
+	}
+	return nil
+}
+
+func (m *MsgUpdateCrosschainFlags) GetForeignCoinFlags() []ForeignCoinFlags {
+	if m != nil {
+		return m.ForeignCoinFlags
+	}
+	return nil
+}
+
 type MsgUpdateCrosschainFlagsResponse struct {
 }
 
@@ -822,6 +857,381 @@ func (m *MsgUpdateKeygenResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateKeygenResponse proto.InternalMessageInfo
 
+// MsgUpdateObserverChains sets the chains observed by the observer
+// an empty list of chains assigns the observer to all the supported chains
+type MsgUpdateObserverChains struct {
+	Creator  string  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainIds []int64 `protobuf:"varint,2,rep,packed,name=chain_ids,json=chainIds,proto3" json:"chain_ids,omitempty"`
+}
+
+func (m *MsgUpdateObserverChains) Reset()         { *m = MsgUpdateObserverChains{} }
+func (m *MsgUpdateObserverChains) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateObserverChains) ProtoMessage()    {}
+func (*MsgUpdateObserverChains) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{16}
+}
+func (m *MsgUpdateObserverChains) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateObserverChains) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateObserverChains.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateObserverChains) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateObserverChains.Merge(m, src)
+}
+func (m *MsgUpdateObserverChains) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateObserverChains) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateObserverChains.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateObserverChains proto.InternalMessageInfo
+
+func (m *MsgUpdateObserverChains) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateObserverChains) GetChainIds() []int64 {
+	if m != nil {
+		return m.ChainIds
+	}
+	return nil
+}
+
+type MsgUpdateObserverChainsResponse struct {
+}
+
+func (m *MsgUpdateObserverChainsResponse) Reset()         { *m = MsgUpdateObserverChainsResponse{} }
+func (m *MsgUpdateObserverChainsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateObserverChainsResponse) ProtoMessage()    {}
+func (*MsgUpdateObserverChainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{17}
+}
+func (m *MsgUpdateObserverChainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateObserverChainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateObserverChainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateObserverChainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateObserverChainsResponse.Merge(m, src)
+}
+func (m *MsgUpdateObserverChainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateObserverChainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateObserverChainsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateObserverChainsResponse proto.InternalMessageInfo
+
+// MsgUnjailObserver adds back a jailed observer to the voters of the new ballots once the jail duration has elapsed
+type MsgUnjailObserver struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgUnjailObserver) Reset()         { *m = MsgUnjailObserver{} }
+func (m *MsgUnjailObserver) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserver) ProtoMessage()    {}
+func (*MsgUnjailObserver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{18}
+}
+func (m *MsgUnjailObserver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailObserver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserver.Merge(m, src)
+}
+func (m *MsgUnjailObserver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailObserver proto.InternalMessageInfo
+
+func (m *MsgUnjailObserver) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type MsgUnjailObserverResponse struct {
+}
+
+func (m *MsgUnjailObserverResponse) Reset()         { *m = MsgUnjailObserverResponse{} }
+func (m *MsgUnjailObserverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailObserverResponse) ProtoMessage()    {}
+func (*MsgUnjailObserverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{19}
+}
+func (m *MsgUnjailObserverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailObserverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailObserverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailObserverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailObserverResponse.Merge(m, src)
+}
+func (m *MsgUnjailObserverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailObserverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailObserverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailObserverResponse proto.InternalMessageInfo
+
+// MsgBootstrapEthereumLightClient initializes the beacon chain light client of an Ethereum chain from a trusted block root
+type MsgBootstrapEthereumLightClient struct {
+	Creator          string                        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId          int64                         `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	TrustedBlockRoot []byte                        `protobuf:"bytes,3,opt,name=trusted_block_root,json=trustedBlockRoot,proto3" json:"trusted_block_root,omitempty"`
+	Bootstrap        ethereum.LightClientBootstrap `protobuf:"bytes,4,opt,name=bootstrap,proto3" json:"bootstrap"`
+}
+
+func (m *MsgBootstrapEthereumLightClient) Reset()         { *m = MsgBootstrapEthereumLightClient{} }
+func (m *MsgBootstrapEthereumLightClient) String() string { return proto.CompactTextString(m) }
+func (*MsgBootstrapEthereumLightClient) ProtoMessage()    {}
+func (*MsgBootstrapEthereumLightClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{20}
+}
+func (m *MsgBootstrapEthereumLightClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBootstrapEthereumLightClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBootstrapEthereumLightClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBootstrapEthereumLightClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBootstrapEthereumLightClient.Merge(m, src)
+}
+func (m *MsgBootstrapEthereumLightClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBootstrapEthereumLightClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBootstrapEthereumLightClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBootstrapEthereumLightClient proto.InternalMessageInfo
+
+func (m *MsgBootstrapEthereumLightClient) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBootstrapEthereumLightClient) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgBootstrapEthereumLightClient) GetTrustedBlockRoot() []byte {
+	if m != nil {
+		return m.TrustedBlockRoot
+	}
+	return nil
+}
+
+func (m *MsgBootstrapEthereumLightClient) GetBootstrap() ethereum.LightClientBootstrap {
+	if m != nil {
+		return m.Bootstrap
+	}
+	return ethereum.LightClientBootstrap{}
+}
+
+type MsgBootstrapEthereumLightClientResponse struct {
+}
+
+func (m *MsgBootstrapEthereumLightClientResponse) Reset() {
+	*m = MsgBootstrapEthereumLightClientResponse{}
+}
+func (m *MsgBootstrapEthereumLightClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBootstrapEthereumLightClientResponse) ProtoMessage()    {}
+func (*MsgBootstrapEthereumLightClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{21}
+}
+func (m *MsgBootstrapEthereumLightClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBootstrapEthereumLightClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBootstrapEthereumLightClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBootstrapEthereumLightClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBootstrapEthereumLightClientResponse.Merge(m, src)
+}
+func (m *MsgBootstrapEthereumLightClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBootstrapEthereumLightClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBootstrapEthereumLightClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBootstrapEthereumLightClientResponse proto.InternalMessageInfo
+
+// MsgUpdateEthereumLightClient applies a sync committee period update or a finality update to the beacon chain light client
+type MsgUpdateEthereumLightClient struct {
+	Creator string                     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChainId int64                      `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Update  ethereum.LightClientUpdate `protobuf:"bytes,3,opt,name=update,proto3" json:"update"`
+}
+
+func (m *MsgUpdateEthereumLightClient) Reset()         { *m = MsgUpdateEthereumLightClient{} }
+func (m *MsgUpdateEthereumLightClient) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEthereumLightClient) ProtoMessage()    {}
+func (*MsgUpdateEthereumLightClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{22}
+}
+func (m *MsgUpdateEthereumLightClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEthereumLightClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEthereumLightClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEthereumLightClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEthereumLightClient.Merge(m, src)
+}
+func (m *MsgUpdateEthereumLightClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEthereumLightClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEthereumLightClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEthereumLightClient proto.InternalMessageInfo
+
+func (m *MsgUpdateEthereumLightClient) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateEthereumLightClient) GetChainId() int64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *MsgUpdateEthereumLightClient) GetUpdate() ethereum.LightClientUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return ethereum.LightClientUpdate{}
+}
+
+type MsgUpdateEthereumLightClientResponse struct {
+}
+
+func (m *MsgUpdateEthereumLightClientResponse) Reset()         { *m = MsgUpdateEthereumLightClientResponse{} }
+func (m *MsgUpdateEthereumLightClientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEthereumLightClientResponse) ProtoMessage()    {}
+func (*MsgUpdateEthereumLightClientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bcd40fa296a2b1d, []int{23}
+}
+func (m *MsgUpdateEthereumLightClientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateEthereumLightClientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateEthereumLightClientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateEthereumLightClientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEthereumLightClientResponse.Merge(m, src)
+}
+func (m *MsgUpdateEthereumLightClientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateEthereumLightClientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEthereumLightClientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateEthereumLightClientResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateObserver)(nil), "zetachain.zetacore.observer.MsgUpdateObserver")
 	proto.RegisterType((*MsgUpdateObserverResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverResponse")
@@ -839,71 +1249,100 @@ func init() {
 	proto.RegisterType((*MsgUpdateCrosschainFlagsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateCrosschainFlagsResponse")
 	proto.RegisterType((*MsgUpdateKeygen)(nil), "zetachain.zetacore.observer.MsgUpdateKeygen")
 	proto.RegisterType((*MsgUpdateKeygenResponse)(nil), "zetachain.zetacore.observer.MsgUpdateKeygenResponse")
+	proto.RegisterType((*MsgUpdateObserverChains)(nil), "zetachain.zetacore.observer.MsgUpdateObserverChains")
+	proto.RegisterType((*MsgUpdateObserverChainsResponse)(nil), "zetachain.zetacore.observer.MsgUpdateObserverChainsResponse")
+	proto.RegisterType((*MsgUnjailObserver)(nil), "zetachain.zetacore.observer.MsgUnjailObserver")
+	proto.RegisterType((*MsgUnjailObserverResponse)(nil), "zetachain.zetacore.observer.MsgUnjailObserverResponse")
+	proto.RegisterType((*MsgBootstrapEthereumLightClient)(nil), "zetachain.zetacore.observer.MsgBootstrapEthereumLightClient")
+	proto.RegisterType((*MsgBootstrapEthereumLightClientResponse)(nil), "zetachain.zetacore.observer.MsgBootstrapEthereumLightClientResponse")
+	proto.RegisterType((*MsgUpdateEthereumLightClient)(nil), "zetachain.zetacore.observer.MsgUpdateEthereumLightClient")
+	proto.RegisterType((*MsgUpdateEthereumLightClientResponse)(nil), "zetachain.zetacore.observer.MsgUpdateEthereumLightClientResponse")
 }
 
 func init() { proto.RegisterFile("observer/tx.proto", fileDescriptor_1bcd40fa296a2b1d) }
 
 var fileDescriptor_1bcd40fa296a2b1d = []byte{
-	// 939 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0xe3, 0xc4, 0x3f, 0x23, 0xd7, 0x89, 0xb7, 0x76, 0x2c, 0x2b, 0x89, 0x62, 0xf0, 0xe4,
-	0xb6, 0xae, 0x14, 0x2b, 0x6d, 0x81, 0x14, 0xe8, 0xc1, 0xee, 0x8f, 0xa3, 0x06, 0xa9, 0x0d, 0x02,
-	0xf5, 0xa1, 0x17, 0x62, 0xc9, 0x1d, 0x53, 0x44, 0xa8, 0x5d, 0x81, 0x4b, 0x25, 0x56, 0xd1, 0x1e,
-	0xfa, 0x00, 0x45, 0xfb, 0x2a, 0x7d, 0x87, 0x1e, 0x72, 0xcc, 0xb1, 0xa7, 0xa2, 0xb0, 0x4f, 0xed,
-	0x0b, 0xf4, 0x1a, 0x70, 0x49, 0xae, 0x44, 0x51, 0xa2, 0xe4, 0x9c, 0xc4, 0xdd, 0xfd, 0xe6, 0x9b,
-	0xf9, 0x66, 0xbf, 0x5d, 0x2d, 0x6c, 0x08, 0x47, 0x62, 0xf8, 0x12, 0xc3, 0x66, 0x74, 0xd1, 0xe8,
-	0x85, 0x22, 0x12, 0xe4, 0xde, 0x8f, 0x18, 0x51, 0xb7, 0x43, 0x7d, 0xde, 0x50, 0x5f, 0x22, 0xc4,
-	0x46, 0x86, 0xaa, 0xbd, 0xef, 0x8a, 0x6e, 0x57, 0xf0, 0x66, 0xf2, 0x93, 0x44, 0xd4, 0x36, 0x3d,
-	0xe1, 0x09, 0xf5, 0xd9, 0x8c, 0xbf, 0xb2, 0x59, 0x4d, 0xed, 0x04, 0xb4, 0x8b, 0xe9, 0xec, 0x43,
-	0x3d, 0xeb, 0x86, 0x42, 0x4a, 0x95, 0xc7, 0x3e, 0x0f, 0xa8, 0x27, 0x53, 0xc0, 0xb6, 0x06, 0x64,
-	0x1f, 0xe9, 0xc2, 0x96, 0x5e, 0xe8, 0xd1, 0x90, 0x76, 0x33, 0xfc, 0x83, 0xe1, 0x34, 0x72, 0xe6,
-	0x73, 0xcf, 0xe6, 0x82, 0xbb, 0x98, 0x2d, 0x93, 0xa1, 0x40, 0x99, 0xce, 0x99, 0xff, 0x1a, 0xb0,
-	0xf1, 0x5c, 0x7a, 0xdf, 0xf7, 0x18, 0x8d, 0xf0, 0x24, 0x5d, 0x27, 0x55, 0x58, 0x76, 0x43, 0xa4,
-	0x91, 0x08, 0xab, 0xc6, 0xae, 0xb1, 0xb7, 0x6a, 0x65, 0x43, 0xf2, 0x08, 0x36, 0x45, 0xc0, 0xec,
-	0x8c, 0xc9, 0xa6, 0x8c, 0x85, 0x28, 0x65, 0xf5, 0x86, 0x82, 0x11, 0x11, 0xb0, 0x8c, 0xe4, 0x30,
-	0x59, 0x89, 0x23, 0x38, 0xbe, 0x2a, 0x46, 0x2c, 0x26, 0x11, 0x1c, 0x5f, 0x8d, 0x47, 0x9c, 0xc1,
-	0x7b, 0x7d, 0x55, 0x8f, 0x1d, 0x22, 0x95, 0x82, 0x57, 0x6f, 0xee, 0x1a, 0x7b, 0xeb, 0xad, 0x83,
-	0x46, 0xc9, 0x6e, 0x34, 0x32, 0x92, 0x44, 0x89, 0xa5, 0x02, 0xad, 0xb5, 0xfe, 0xc8, 0xc8, 0xbc,
-	0x07, 0x3b, 0x05, 0xa9, 0x16, 0xca, 0x9e, 0xe0, 0x12, 0xcd, 0x3f, 0x92, 0x46, 0x1c, 0x32, 0x76,
-	0x14, 0x08, 0xf7, 0xc5, 0x53, 0xa4, 0xac, 0xb4, 0x11, 0x3b, 0xb0, 0x92, 0x6c, 0x98, 0xcf, 0x94,
-	0xf8, 0x45, 0x6b, 0x59, 0x8d, 0xdb, 0x8c, 0x3c, 0x00, 0x70, 0x62, 0x0e, 0xbb, 0x43, 0x65, 0x47,
-	0xe9, 0x5c, 0xb3, 0x56, 0xd5, 0xcc, 0x53, 0x2a, 0x3b, 0xe4, 0x2e, 0x2c, 0x75, 0xd0, 0xf7, 0x3a,
-	0x91, 0xd2, 0xb5, 0x68, 0xa5, 0x23, 0xf2, 0x28, 0x9e, 0x8f, 0xb3, 0x56, 0x6f, 0xed, 0x1a, 0x7b,
-	0x95, 0x16, 0x69, 0xa4, 0xce, 0x4a, 0x6a, 0xf9, 0x8a, 0x46, 0xf4, 0xe8, 0xe6, 0xeb, 0xbf, 0x1f,
-	0x2e, 0x58, 0x29, 0x2e, 0x15, 0x94, 0x2f, 0x59, 0x0b, 0xfa, 0x09, 0x36, 0xb5, 0xda, 0x2f, 0xe3,
-	0xca, 0x4e, 0x95, 0x55, 0x4a, 0x24, 0x7d, 0x0b, 0x15, 0x77, 0x08, 0x54, 0xaa, 0x2a, 0xad, 0xbd,
-	0xd2, 0xae, 0x8f, 0x10, 0x5b, 0xa3, 0xc1, 0x66, 0x1d, 0xee, 0x4f, 0xca, 0xae, 0xab, 0x7b, 0xa6,
-	0xaa, 0xb3, 0xb0, 0x2b, 0x5e, 0xce, 0x59, 0xdd, 0xf4, 0x86, 0xa7, 0xc9, 0x0a, 0x64, 0x3a, 0xd9,
-	0x9f, 0x06, 0xac, 0x27, 0x8d, 0x9a, 0xc3, 0xe1, 0x1f, 0xc0, 0x9d, 0x29, 0xee, 0xbe, 0x2d, 0xc6,
-	0x8c, 0xfa, 0x39, 0xec, 0xa8, 0x96, 0x04, 0x3e, 0xf2, 0xc8, 0xf6, 0x42, 0xca, 0x23, 0x44, 0xbb,
-	0xd7, 0x77, 0x5e, 0xe0, 0x20, 0xf5, 0xf7, 0xf6, 0x10, 0x70, 0x9c, 0xac, 0x9f, 0xaa, 0x65, 0x72,
-	0x00, 0x5b, 0x94, 0x31, 0x9b, 0x0b, 0x86, 0x36, 0x75, 0x5d, 0xd1, 0xe7, 0x91, 0x2d, 0x78, 0x30,
-	0x50, 0xa6, 0x58, 0xb1, 0x08, 0x65, 0xec, 0x3b, 0xc1, 0xf0, 0x30, 0x59, 0x3a, 0xe1, 0xc1, 0xc0,
-	0xac, 0xc2, 0xdd, 0xbc, 0x0a, 0x2d, 0xf0, 0x37, 0x03, 0x6e, 0x67, 0x4e, 0xa0, 0x5d, 0x3c, 0x13,
-	0x11, 0xbe, 0x9b, 0x75, 0x8f, 0x63, 0xeb, 0xd2, 0x2e, 0xda, 0x3e, 0x3f, 0x17, 0x4a, 0x42, 0xa5,
-	0x65, 0x96, 0x3a, 0x40, 0x25, 0x4c, 0x7d, 0xb9, 0xaa, 0x62, 0xdb, 0xfc, 0x5c, 0x98, 0x3b, 0xb0,
-	0x3d, 0x56, 0x90, 0x2e, 0xf6, 0xff, 0x1b, 0x50, 0x1d, 0x7a, 0x43, 0xdf, 0x7c, 0xdf, 0xc4, 0x17,
-	0x5f, 0x49, 0xd5, 0x1f, 0xc2, 0x1d, 0x5f, 0xb6, 0xb9, 0x23, 0xfa, 0x9c, 0x7d, 0xcd, 0xa9, 0x13,
-	0x20, 0x53, 0x05, 0xae, 0x58, 0x85, 0x79, 0xb2, 0x0f, 0x1b, 0xbe, 0x3c, 0xe9, 0x47, 0x39, 0x70,
-	0xd2, 0xd8, 0xe2, 0x02, 0xe9, 0xc0, 0x96, 0x47, 0xe5, 0x69, 0xe8, 0xbb, 0xd8, 0xe6, 0x71, 0x3a,
-	0x89, 0xaa, 0x98, 0xf4, 0x1c, 0xb6, 0x4a, 0xf5, 0x1f, 0x4f, 0x8a, 0xb4, 0x26, 0x13, 0x92, 0x9f,
-	0xe1, 0xbe, 0x33, 0x3c, 0xaa, 0x67, 0x18, 0xfa, 0xe7, 0xbe, 0x4b, 0x23, 0x5f, 0x24, 0xea, 0xab,
-	0x4b, 0x2a, 0xe1, 0x93, 0x19, 0x0d, 0x9f, 0x4e, 0x60, 0x95, 0xd2, 0x9b, 0x26, 0xec, 0x4e, 0x6b,
-	0xbc, 0xde, 0x9d, 0x43, 0xe5, 0xa4, 0x04, 0xf3, 0x0c, 0x07, 0x1e, 0xf2, 0x92, 0x3d, 0xd9, 0x84,
-	0x5b, 0x2a, 0x61, 0x6a, 0xa3, 0x64, 0x90, 0xee, 0xfd, 0x28, 0x45, 0xc6, 0xde, 0xfa, 0x6f, 0x19,
-	0x16, 0x9f, 0x4b, 0x8f, 0x08, 0xa8, 0x8c, 0x9e, 0xc6, 0x8f, 0x4a, 0x15, 0xe7, 0x4d, 0x5f, 0x7b,
-	0x7c, 0x0d, 0x70, 0x96, 0x98, 0x5c, 0xc0, 0xfa, 0xd8, 0x7f, 0x5c, 0x63, 0x16, 0x4d, 0x1e, 0x5f,
-	0xfb, 0xec, 0x7a, 0x78, 0x9d, 0xf9, 0x17, 0x03, 0x36, 0x8a, 0xb7, 0xf0, 0xc1, 0x7c, 0x6c, 0x23,
-	0x21, 0xb5, 0x27, 0xd7, 0x0e, 0xc9, 0xd5, 0x50, 0xbc, 0x6b, 0x67, 0xd6, 0x50, 0x08, 0x99, 0x5d,
-	0xc3, 0xd4, 0x4b, 0x98, 0x84, 0xb0, 0x96, 0xbb, 0x9f, 0xf6, 0xe7, 0xd8, 0x46, 0x8d, 0xae, 0x7d,
-	0x72, 0x1d, 0xb4, 0xce, 0xf9, 0xab, 0x01, 0x5b, 0x93, 0xef, 0x99, 0x4f, 0xe7, 0x6c, 0x66, 0x3e,
-	0xac, 0xf6, 0xc5, 0x3b, 0x85, 0x8d, 0xf6, 0x20, 0x77, 0xb2, 0xf6, 0xe7, 0xa3, 0x4b, 0xd0, 0xb3,
-	0x7b, 0x30, 0xe9, 0xc8, 0xc5, 0xce, 0x1f, 0x7b, 0xd4, 0x34, 0xe6, 0xea, 0xa5, 0xc6, 0xcf, 0x76,
-	0xfe, 0xe4, 0x17, 0xc8, 0x51, 0xfb, 0xf5, 0x65, 0xdd, 0x78, 0x73, 0x59, 0x37, 0xfe, 0xb9, 0xac,
-	0x1b, 0xbf, 0x5f, 0xd5, 0x17, 0xde, 0x5c, 0xd5, 0x17, 0xfe, 0xba, 0xaa, 0x2f, 0xfc, 0xd0, 0xf4,
-	0xfc, 0xa8, 0xd3, 0x77, 0xe2, 0x07, 0x4e, 0x33, 0x66, 0xfc, 0x58, 0x91, 0x37, 0x33, 0xf2, 0xe6,
-	0x45, 0x73, 0xf8, 0x54, 0x1d, 0xf4, 0x50, 0x3a, 0x4b, 0xea, 0xb5, 0xfa, 0xf8, 0x6d, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x9f, 0xe7, 0x2f, 0x7d, 0xa4, 0x0b, 0x00, 0x00,
+	// 1277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x53, 0xdb, 0xc6,
+	0x17, 0x47, 0x5f, 0x27, 0x04, 0x3f, 0xf3, 0x0d, 0xa0, 0x42, 0x30, 0x82, 0x18, 0xaa, 0xe9, 0x34,
+	0xa6, 0x01, 0x3b, 0x38, 0x94, 0x19, 0x3a, 0xed, 0x01, 0x48, 0x02, 0x34, 0xa5, 0x30, 0x9a, 0x29,
+	0x87, 0x5e, 0x34, 0x6b, 0x69, 0x91, 0xd5, 0xd8, 0xbb, 0x1e, 0x69, 0x4d, 0xa0, 0x33, 0x3d, 0xf4,
+	0xda, 0x99, 0xfe, 0xb8, 0xf6, 0xda, 0x5b, 0x8f, 0xed, 0xdf, 0xd0, 0x03, 0xc7, 0x1c, 0x7b, 0xea,
+	0x74, 0xe0, 0xd4, 0x7f, 0xa0, 0xe7, 0x8e, 0x56, 0xab, 0xb5, 0xe4, 0x1f, 0xb2, 0x4d, 0x7b, 0x42,
+	0xda, 0xf7, 0xf9, 0xbc, 0xf7, 0x3e, 0xab, 0xf7, 0xde, 0x2e, 0x86, 0x19, 0x5a, 0xf5, 0xb1, 0x77,
+	0x8e, 0xbd, 0x32, 0xbb, 0x28, 0x35, 0x3d, 0xca, 0xa8, 0xba, 0xf8, 0x25, 0x66, 0xc8, 0xaa, 0x21,
+	0x97, 0x94, 0xf8, 0x13, 0xf5, 0x70, 0x29, 0x42, 0x69, 0x6f, 0x59, 0xb4, 0xd1, 0xa0, 0xa4, 0x1c,
+	0xfe, 0x09, 0x19, 0x9a, 0x2e, 0x16, 0x31, 0xab, 0x61, 0x0f, 0xb7, 0x1a, 0xe5, 0xba, 0xeb, 0xd4,
+	0x98, 0x69, 0xd5, 0x5d, 0x4c, 0x98, 0xc0, 0xcc, 0x3a, 0xd4, 0xa1, 0xfc, 0xb1, 0x1c, 0x3c, 0x45,
+	0xab, 0x32, 0x7c, 0xb5, 0x8e, 0x1a, 0x58, 0xac, 0x2e, 0xcb, 0x55, 0xcb, 0xa3, 0xbe, 0xcf, 0x73,
+	0x31, 0xcf, 0xea, 0xc8, 0xf1, 0x05, 0x60, 0x5e, 0x02, 0xa2, 0x07, 0x61, 0x98, 0x93, 0x86, 0x26,
+	0xf2, 0x50, 0x23, 0xc2, 0x3f, 0x6c, 0x2f, 0x63, 0x62, 0xbb, 0xc4, 0x31, 0x09, 0x25, 0x16, 0x8e,
+	0xcc, 0xaa, 0x34, 0x33, 0x5f, 0xac, 0xe9, 0x7f, 0x29, 0x30, 0x73, 0xe4, 0x3b, 0x9f, 0x35, 0x6d,
+	0xc4, 0xf0, 0xb1, 0xb0, 0xab, 0x79, 0xb8, 0x67, 0x79, 0x18, 0x31, 0xea, 0xe5, 0x95, 0x15, 0xa5,
+	0x98, 0x35, 0xa2, 0x57, 0xf5, 0x09, 0xcc, 0xd2, 0xba, 0x6d, 0x46, 0x9e, 0x4c, 0x64, 0xdb, 0x1e,
+	0xf6, 0xfd, 0xfc, 0xff, 0x38, 0x4c, 0xa5, 0x75, 0x3b, 0x72, 0xb2, 0x13, 0x5a, 0x02, 0x06, 0xc1,
+	0xaf, 0xbb, 0x19, 0x99, 0x90, 0x41, 0xf0, 0xeb, 0x4e, 0xc6, 0x29, 0xfc, 0xbf, 0xc5, 0xf3, 0x31,
+	0x3d, 0x8c, 0x7c, 0x4a, 0xf2, 0x77, 0x56, 0x94, 0xe2, 0xfd, 0xca, 0x46, 0x29, 0xe5, 0x8b, 0x95,
+	0x22, 0x27, 0xa1, 0x12, 0x83, 0x13, 0x8d, 0xc9, 0x56, 0xec, 0x4d, 0x5f, 0x84, 0x85, 0x2e, 0xa9,
+	0x06, 0xf6, 0x9b, 0x94, 0xf8, 0x58, 0xff, 0x25, 0xdc, 0x88, 0x1d, 0xdb, 0xde, 0xad, 0x53, 0xeb,
+	0xd5, 0x01, 0x46, 0x76, 0xea, 0x46, 0x2c, 0xc0, 0x44, 0xf8, 0xc1, 0x5c, 0x9b, 0x8b, 0xcf, 0x18,
+	0xf7, 0xf8, 0xfb, 0xa1, 0xad, 0x3e, 0x04, 0xa8, 0x06, 0x3e, 0xcc, 0x1a, 0xf2, 0x6b, 0x5c, 0xe7,
+	0xa4, 0x91, 0xe5, 0x2b, 0x07, 0xc8, 0xaf, 0xa9, 0x0f, 0x60, 0xbc, 0x86, 0x83, 0xca, 0xe1, 0xba,
+	0x32, 0x86, 0x78, 0x53, 0x9f, 0x04, 0xeb, 0x41, 0xd4, 0xfc, 0xdd, 0x15, 0xa5, 0x98, 0xab, 0xa8,
+	0x25, 0x51, 0x7d, 0x61, 0x2e, 0xcf, 0x10, 0x43, 0xbb, 0x77, 0xae, 0xfe, 0x58, 0x1e, 0x33, 0x04,
+	0x4e, 0x08, 0x4a, 0xa6, 0x2c, 0x05, 0xfd, 0xac, 0xc0, 0xac, 0x94, 0xbb, 0x17, 0xa4, 0x76, 0xc2,
+	0x6b, 0x25, 0x45, 0xd3, 0xc7, 0x90, 0xb3, 0xda, 0x40, 0x2e, 0x2b, 0x57, 0x29, 0xa6, 0x6e, 0x7b,
+	0xcc, 0xb1, 0x11, 0x27, 0xab, 0x8f, 0x61, 0x06, 0x59, 0xcc, 0x3d, 0x47, 0xcc, 0xa5, 0xc4, 0x14,
+	0x82, 0x33, 0x5c, 0xf0, 0x74, 0xdb, 0x70, 0xc0, 0xd7, 0xf5, 0x02, 0x2c, 0xf5, 0x4a, 0x55, 0x6a,
+	0x79, 0xc9, 0xa5, 0x18, 0xb8, 0x41, 0xcf, 0x87, 0x94, 0xd2, 0xff, 0xf3, 0x88, 0x60, 0x5d, 0xce,
+	0x64, 0xb0, 0xdf, 0x14, 0xb8, 0x1f, 0x6e, 0xeb, 0x10, 0xfd, 0xb0, 0x0a, 0xd3, 0x7d, 0x7a, 0x61,
+	0x8a, 0x76, 0x94, 0xf5, 0x07, 0xb0, 0xc0, 0xf7, 0x8f, 0x8f, 0x0b, 0xd3, 0xf1, 0x10, 0x61, 0x18,
+	0x9b, 0xcd, 0x56, 0xf5, 0x15, 0xbe, 0x14, 0xdd, 0x30, 0xdf, 0x06, 0xec, 0x87, 0xf6, 0x13, 0x6e,
+	0x56, 0x37, 0x60, 0x0e, 0xd9, 0xb6, 0x49, 0xa8, 0x8d, 0x4d, 0x64, 0x59, 0xb4, 0x45, 0x98, 0x49,
+	0x49, 0xfd, 0x92, 0x97, 0xd0, 0x84, 0xa1, 0x22, 0xdb, 0xfe, 0x94, 0xda, 0x78, 0x27, 0x34, 0x1d,
+	0x93, 0xfa, 0xa5, 0x9e, 0x87, 0x07, 0x49, 0x15, 0x52, 0xe0, 0xf7, 0x0a, 0x4c, 0x45, 0x75, 0x83,
+	0x1a, 0xf8, 0x94, 0x32, 0x7c, 0xbb, 0x42, 0xdf, 0x0f, 0x0a, 0x1d, 0x35, 0xb0, 0xe9, 0x92, 0x33,
+	0xca, 0x25, 0xe4, 0x2a, 0x7a, 0x6a, 0xb9, 0xf0, 0x80, 0xa2, 0x8a, 0xb3, 0x9c, 0x7b, 0x48, 0xce,
+	0xa8, 0xbe, 0x00, 0xf3, 0x1d, 0x09, 0xc9, 0x64, 0xff, 0xbe, 0x03, 0xf9, 0x76, 0x6d, 0xc8, 0x39,
+	0xf9, 0x22, 0x18, 0x93, 0x29, 0x59, 0xbf, 0x07, 0xd3, 0xae, 0x7f, 0x48, 0xaa, 0xb4, 0x45, 0xec,
+	0xe7, 0x04, 0x55, 0xeb, 0xd8, 0xe6, 0x09, 0x4e, 0x18, 0x5d, 0xeb, 0xea, 0x1a, 0xcc, 0xb8, 0xfe,
+	0x71, 0x8b, 0x25, 0xc0, 0xe1, 0xc6, 0x76, 0x1b, 0xd4, 0x1a, 0xcc, 0x39, 0xc8, 0x3f, 0xf1, 0x5c,
+	0x0b, 0x1f, 0x92, 0x20, 0x9c, 0x8f, 0x79, 0x32, 0xa2, 0x6b, 0x2b, 0xa9, 0xfa, 0xf7, 0x7b, 0x31,
+	0x8d, 0xde, 0x0e, 0xd5, 0xaf, 0x60, 0xa9, 0xda, 0x6e, 0xec, 0x53, 0xec, 0xb9, 0x67, 0xae, 0xc5,
+	0xdb, 0x26, 0x0c, 0x38, 0xce, 0x03, 0x6e, 0x0f, 0xd8, 0xf0, 0xfe, 0x0e, 0x8c, 0x54, 0xf7, 0xea,
+	0x11, 0x4c, 0x36, 0xbd, 0x16, 0x71, 0x89, 0x13, 0x86, 0xbb, 0xc7, 0xc3, 0xad, 0xa6, 0x86, 0x3b,
+	0x89, 0x11, 0x8c, 0x04, 0x5d, 0x3d, 0x02, 0x68, 0x7f, 0xb9, 0xfc, 0xc4, 0x4a, 0xa6, 0x98, 0xab,
+	0x3c, 0x1a, 0x3c, 0x5b, 0x38, 0x5c, 0x54, 0x4c, 0xcc, 0x81, 0x6a, 0xc2, 0xf4, 0x19, 0xf5, 0xb0,
+	0xeb, 0x90, 0x3d, 0x1a, 0x39, 0xcd, 0x72, 0xa7, 0xeb, 0xa9, 0x4e, 0x5f, 0x74, 0x90, 0x84, 0xeb,
+	0x2e, 0x67, 0xba, 0x0e, 0x2b, 0xfd, 0xea, 0x4e, 0x16, 0xe7, 0x0e, 0x4c, 0x49, 0xcc, 0x4b, 0x7c,
+	0xe9, 0x60, 0x92, 0x52, 0x92, 0xb3, 0x70, 0x97, 0xef, 0xb7, 0xe8, 0xa2, 0xf0, 0x45, 0x94, 0x7e,
+	0xdc, 0x85, 0xf4, 0x7e, 0x12, 0x33, 0x45, 0x4d, 0xcc, 0xf7, 0x24, 0xad, 0xf0, 0x17, 0x21, 0x1b,
+	0xb5, 0x6b, 0x30, 0x89, 0x32, 0xc5, 0x8c, 0x31, 0x21, 0xfa, 0xd5, 0xd7, 0xdf, 0x86, 0xe5, 0x3e,
+	0x1e, 0x65, 0xd0, 0xf5, 0xf0, 0x3e, 0x40, 0xbe, 0x40, 0x6e, 0x7d, 0xf0, 0xfc, 0x8b, 0xce, 0xd4,
+	0x04, 0x5c, 0xfa, 0xba, 0x52, 0x78, 0xbc, 0x5d, 0x4a, 0x99, 0xcf, 0x3c, 0xd4, 0x7c, 0x2e, 0x2e,
+	0x4e, 0x9f, 0x04, 0x43, 0x7f, 0x8f, 0x8f, 0xb9, 0xdb, 0x0d, 0x9e, 0x35, 0x50, 0x99, 0xd7, 0xf2,
+	0x19, 0xb6, 0xcd, 0xf0, 0xa4, 0xf5, 0x28, 0x65, 0xe2, 0xa4, 0x9d, 0x16, 0x16, 0x5e, 0xf9, 0x06,
+	0xa5, 0x4c, 0xdd, 0x85, 0x6c, 0x35, 0x4a, 0x81, 0xf7, 0x75, 0xae, 0x52, 0x28, 0x45, 0x97, 0xb8,
+	0x52, 0x2c, 0x19, 0x99, 0xa8, 0x9c, 0x50, 0xd1, 0x82, 0xbe, 0x0a, 0x8f, 0x06, 0x28, 0x91, 0xaa,
+	0xbf, 0x53, 0x62, 0xa7, 0xd9, 0x7f, 0x26, 0x79, 0x1b, 0xc6, 0xc3, 0xcb, 0x8c, 0x98, 0xb3, 0x8b,
+	0x3d, 0x15, 0x84, 0x41, 0xa3, 0x6b, 0x42, 0x48, 0xd0, 0xdf, 0x85, 0x77, 0xd2, 0xf2, 0x89, 0x12,
+	0xaf, 0xfc, 0x3a, 0x09, 0x99, 0x23, 0xdf, 0x51, 0x29, 0xe4, 0xe2, 0x87, 0xdf, 0xe3, 0xd4, 0x7e,
+	0x4a, 0x9e, 0x31, 0xda, 0xd3, 0x11, 0xc0, 0x51, 0x60, 0xf5, 0x02, 0xee, 0x77, 0x5c, 0x40, 0x4b,
+	0x83, 0xdc, 0x24, 0xf1, 0xda, 0xd6, 0x68, 0x78, 0x19, 0xf9, 0x6b, 0x05, 0x66, 0xba, 0x6f, 0x48,
+	0x1b, 0xc3, 0x79, 0x8b, 0x51, 0xb4, 0xed, 0x91, 0x29, 0x89, 0x1c, 0xba, 0xaf, 0x36, 0x03, 0x73,
+	0xe8, 0xa2, 0x68, 0xdb, 0x23, 0x53, 0x64, 0x0e, 0x1e, 0x4c, 0x26, 0xae, 0x03, 0x6b, 0x43, 0x7c,
+	0x46, 0x89, 0xd6, 0x36, 0x47, 0x41, 0xcb, 0x98, 0xdf, 0x2a, 0x30, 0xd7, 0xfb, 0x58, 0x7f, 0x7f,
+	0xc8, 0xcd, 0x4c, 0xd2, 0xb4, 0x8f, 0x6e, 0x45, 0x8b, 0xef, 0x41, 0x62, 0x92, 0xaf, 0x0d, 0xe7,
+	0x2e, 0x44, 0x6b, 0x9b, 0xa3, 0xa0, 0xe3, 0x95, 0xdf, 0xf1, 0x1f, 0x47, 0x69, 0xa8, 0xbd, 0x94,
+	0x78, 0x6d, 0x6b, 0x34, 0xbc, 0x8c, 0xfc, 0x8d, 0x02, 0xb3, 0x3d, 0x8f, 0x96, 0xcd, 0xd1, 0x5a,
+	0x29, 0x64, 0x69, 0x1f, 0xde, 0x86, 0x95, 0x18, 0x00, 0xc9, 0x13, 0x67, 0xf0, 0x00, 0x48, 0xe0,
+	0xb5, 0xad, 0xd1, 0xf0, 0x32, 0xf2, 0x4f, 0x0a, 0x2c, 0xa5, 0x9e, 0x4f, 0x03, 0x85, 0xa5, 0xb1,
+	0xb5, 0x67, 0xff, 0x86, 0x2d, 0x93, 0xfc, 0x51, 0x81, 0x85, 0xfe, 0xc7, 0xc9, 0x90, 0xa3, 0xa7,
+	0x57, 0x7a, 0x3b, 0xb7, 0xa6, 0x46, 0xb9, 0xed, 0x1e, 0x5e, 0x5d, 0x17, 0x94, 0x37, 0xd7, 0x05,
+	0xe5, 0xcf, 0xeb, 0x82, 0xf2, 0xc3, 0x4d, 0x61, 0xec, 0xcd, 0x4d, 0x61, 0xec, 0xf7, 0x9b, 0xc2,
+	0xd8, 0xe7, 0x65, 0xc7, 0x65, 0xb5, 0x56, 0x35, 0xf8, 0x2f, 0xb6, 0x1c, 0x38, 0x5f, 0xe7, 0x71,
+	0xca, 0x51, 0x9c, 0xf2, 0x45, 0xb9, 0xfd, 0x7b, 0xc4, 0x65, 0x13, 0xfb, 0xd5, 0x71, 0xfe, 0x93,
+	0xc4, 0xd3, 0x7f, 0x06, 0x00, 0xf3, 0x83, 0x83, 0xf8, 0xad, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -926,6 +1365,10 @@ type MsgClient interface {
 	UpdateCrosschainFlags(ctx context.Context, in *MsgUpdateCrosschainFlags, opts ...grpc.CallOption) (*MsgUpdateCrosschainFlagsResponse, error)
 	UpdateKeygen(ctx context.Context, in *MsgUpdateKeygen, opts ...grpc.CallOption) (*MsgUpdateKeygenResponse, error)
 	AddBlockHeader(ctx context.Context, in *MsgAddBlockHeader, opts ...grpc.CallOption) (*MsgAddBlockHeaderResponse, error)
+	UpdateObserverChains(ctx context.Context, in *MsgUpdateObserverChains, opts ...grpc.CallOption) (*MsgUpdateObserverChainsResponse, error)
+	UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error)
+	BootstrapEthereumLightClient(ctx context.Context, in *MsgBootstrapEthereumLightClient, opts ...grpc.CallOption) (*MsgBootstrapEthereumLightClientResponse, error)
+	UpdateEthereumLightClient(ctx context.Context, in *MsgUpdateEthereumLightClient, opts ...grpc.CallOption) (*MsgUpdateEthereumLightClientResponse, error)
 }
 
 type msgClient struct {
@@ -1008,18 +1451,58 @@ func (c *msgClient) AddBlockHeader(ctx context.Context, in *MsgAddBlockHeader, o
 	return out, nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
-	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
-	UpdateChainParams(context.Context, *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error)
-	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
-	AddBlameVote(context.Context, *MsgAddBlameVote) (*MsgAddBlameVoteResponse, error)
-	UpdateCrosschainFlags(context.Context, *MsgUpdateCrosschainFlags) (*MsgUpdateCrosschainFlagsResponse, error)
-	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
-	AddBlockHeader(context.Context, *MsgAddBlockHeader) (*MsgAddBlockHeaderResponse, error)
-}
-
+func (c *msgClient) UpdateObserverChains(ctx context.Context, in *MsgUpdateObserverChains, opts ...grpc.CallOption) (*MsgUpdateObserverChainsResponse, error) {
+	out := new(MsgUpdateObserverChainsResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateObserverChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailObserver(ctx context.Context, in *MsgUnjailObserver, opts ...grpc.CallOption) (*MsgUnjailObserverResponse, error) {
+	out := new(MsgUnjailObserverResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UnjailObserver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BootstrapEthereumLightClient(ctx context.Context, in *MsgBootstrapEthereumLightClient, opts ...grpc.CallOption) (*MsgBootstrapEthereumLightClientResponse, error) {
+	out := new(MsgBootstrapEthereumLightClientResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/BootstrapEthereumLightClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEthereumLightClient(ctx context.Context, in *MsgUpdateEthereumLightClient, opts ...grpc.CallOption) (*MsgUpdateEthereumLightClientResponse, error) {
+	out := new(MsgUpdateEthereumLightClientResponse)
+	err := c.cc.Invoke(ctx, "/zetachain.zetacore.observer.Msg/UpdateEthereumLightClient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddObserver(context.Context, *MsgAddObserver) (*MsgAddObserverResponse, error)
+	UpdateObserver(context.Context, *MsgUpdateObserver) (*MsgUpdateObserverResponse, error)
+	UpdateChainParams(context.Context, *MsgUpdateChainParams) (*MsgUpdateChainParamsResponse, error)
+	RemoveChainParams(context.Context, *MsgRemoveChainParams) (*MsgRemoveChainParamsResponse, error)
+	AddBlameVote(context.Context, *MsgAddBlameVote) (*MsgAddBlameVoteResponse, error)
+	UpdateCrosschainFlags(context.Context, *MsgUpdateCrosschainFlags) (*MsgUpdateCrosschainFlagsResponse, error)
+	UpdateKeygen(context.Context, *MsgUpdateKeygen) (*MsgUpdateKeygenResponse, error)
+	AddBlockHeader(context.Context, *MsgAddBlockHeader) (*MsgAddBlockHeaderResponse, error)
+	UpdateObserverChains(context.Context, *MsgUpdateObserverChains) (*MsgUpdateObserverChainsResponse, error)
+	UnjailObserver(context.Context, *MsgUnjailObserver) (*MsgUnjailObserverResponse, error)
+	BootstrapEthereumLightClient(context.Context, *MsgBootstrapEthereumLightClient) (*MsgBootstrapEthereumLightClientResponse, error)
+	UpdateEthereumLightClient(context.Context, *MsgUpdateEthereumLightClient) (*MsgUpdateEthereumLightClientResponse, error)
+}
+
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
 type UnimplementedMsgServer struct {
 }
@@ -1048,6 +1531,18 @@ func (*UnimplementedMsgServer) UpdateKeygen(ctx context.Context, req *MsgUpdateK
 func (*UnimplementedMsgServer) AddBlockHeader(ctx context.Context, req *MsgAddBlockHeader) (*MsgAddBlockHeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddBlockHeader not implemented")
 }
+func (*UnimplementedMsgServer) UpdateObserverChains(ctx context.Context, req *MsgUpdateObserverChains) (*MsgUpdateObserverChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateObserverChains not implemented")
+}
+func (*UnimplementedMsgServer) UnjailObserver(ctx context.Context, req *MsgUnjailObserver) (*MsgUnjailObserverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailObserver not implemented")
+}
+func (*UnimplementedMsgServer) BootstrapEthereumLightClient(ctx context.Context, req *MsgBootstrapEthereumLightClient) (*MsgBootstrapEthereumLightClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootstrapEthereumLightClient not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEthereumLightClient(ctx context.Context, req *MsgUpdateEthereumLightClient) (*MsgUpdateEthereumLightClientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEthereumLightClient not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1197,6 +1692,78 @@ func _Msg_AddBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateObserverChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateObserverChains)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateObserverChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateObserverChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateObserverChains(ctx, req.(*MsgUpdateObserverChains))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailObserver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailObserver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailObserver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UnjailObserver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailObserver(ctx, req.(*MsgUnjailObserver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BootstrapEthereumLightClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBootstrapEthereumLightClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BootstrapEthereumLightClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/BootstrapEthereumLightClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BootstrapEthereumLightClient(ctx, req.(*MsgBootstrapEthereumLightClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEthereumLightClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEthereumLightClient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEthereumLightClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/zetachain.zetacore.observer.Msg/UpdateEthereumLightClient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEthereumLightClient(ctx, req.(*MsgUpdateEthereumLightClient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "zetachain.zetacore.observer.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1233,6 +1800,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddBlockHeader",
 			Handler:    _Msg_AddBlockHeader_Handler,
 		},
+		{
+			MethodName: "UpdateObserverChains",
+			Handler:    _Msg_UpdateObserverChains_Handler,
+		},
+		{
+			MethodName: "UnjailObserver",
+			Handler:    _Msg_UnjailObserver_Handler,
+		},
+		{
+			MethodName: "BootstrapEthereumLightClient",
+			Handler:    _Msg_BootstrapEthereumLightClient_Handler,
+		},
+		{
+			MethodName: "UpdateEthereumLightClient",
+			Handler:    _Msg_UpdateEthereumLightClient_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "observer/tx.proto",
@@ -1410,6 +1993,11 @@ func (m *MsgUpdateChainParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.ChainParams != nil {
 		{
 			size, err := m.ChainParams.MarshalToSizedBuffer(dAtA[:i])
@@ -1678,6 +2266,46 @@ func (m *MsgUpdateCrosschainFlags) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.ForeignCoinFlags) > 0 {
+		for iNdEx := len(m.ForeignCoinFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForeignCoinFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChainFlags) > 0 {
+		for iNdEx := len(m.ChainFlags) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainFlags[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PruningFlags != nil {
+		{
+			size, err := m.PruningFlags.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.BlockHeaderVerificationFlags != nil {
 		{
 			size, err := m.BlockHeaderVerificationFlags.MarshalToSizedBuffer(dAtA[:i])
@@ -1813,87 +2441,355 @@ func (m *MsgUpdateKeygenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateObserverChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateObserver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateObserverChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObserverChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OldObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewObserverAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainIds) > 0 {
+		dAtA8 := make([]byte, len(m.ChainIds)*10)
+		var j7 int
+		for _, num1 := range m.ChainIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.UpdateReason != 0 {
-		n += 1 + sovTx(uint64(m.UpdateReason))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateObserverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateObserverChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateObserverChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateObserverChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddBlockHeader) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnjailObserver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ChainId != 0 {
-		n += 1 + sovTx(uint64(m.ChainId))
-	}
-	l = len(m.BlockHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Header.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddBlockHeaderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUnjailObserverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailObserverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailObserverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateChainParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBootstrapEthereumLightClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBootstrapEthereumLightClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBootstrapEthereumLightClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Bootstrap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TrustedBlockRoot) > 0 {
+		i -= len(m.TrustedBlockRoot)
+		copy(dAtA[i:], m.TrustedBlockRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrustedBlockRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBootstrapEthereumLightClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBootstrapEthereumLightClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBootstrapEthereumLightClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEthereumLightClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEthereumLightClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEthereumLightClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEthereumLightClientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEthereumLightClientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEthereumLightClientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OldObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewObserverAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UpdateReason != 0 {
+		n += 1 + sovTx(uint64(m.UpdateReason))
+	}
+	return n
+}
+
+func (m *MsgUpdateObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddBlockHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = m.Header.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddBlockHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateChainParams) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1905,6 +2801,9 @@ func (m *MsgUpdateChainParams) Size() (n int) {
 		l = m.ChainParams.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -2026,6 +2925,22 @@ func (m *MsgUpdateCrosschainFlags) Size() (n int) {
 		l = m.BlockHeaderVerificationFlags.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.PruningFlags != nil {
+		l = m.PruningFlags.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainFlags) > 0 {
+		for _, e := range m.ChainFlags {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ForeignCoinFlags) > 0 {
+		for _, e := range m.ForeignCoinFlags {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2063,38 +2978,147 @@ func (m *MsgUpdateKeygenResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateObserverChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChainIds) > 0 {
+		l = 0
+		for _, e := range m.ChainIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUpdateObserverChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
+
+func (m *MsgUnjailObserver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailObserverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBootstrapEthereumLightClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = len(m.TrustedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Bootstrap.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBootstrapEthereumLightClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateEthereumLightClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	l = m.Update.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateEthereumLightClientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
 			return fmt.Errorf("proto: MsgUpdateObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
@@ -2160,13 +3184,1006 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.OldObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
+			}
+			m.UpdateReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateReason |= ObserverUpdateReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlockHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlockHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlockHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainParams == nil {
+				m.ChainParams = &ChainParams{}
+			}
+			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AddNodeAccountOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBlameVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBlameVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewObserverAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2176,43 +4193,25 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateReason", wireType)
-			}
-			m.UpdateReason = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdateReason |= ObserverUpdateReason(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2234,7 +4233,7 @@ func (m *MsgUpdateObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2257,10 +4256,10 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddBlameVoteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddBlameVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2284,7 +4283,7 @@ func (m *MsgUpdateObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2307,10 +4306,10 @@ func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlockHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCrosschainFlags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlockHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCrosschainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2345,11 +4344,11 @@ func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
 			}
-			m.ChainId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2359,16 +4358,37 @@ func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.IsInboundEnabled = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsOutboundEnabled = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2378,31 +4398,33 @@ func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockHash == nil {
-				m.BlockHash = []byte{}
+			if m.GasPriceIncreaseFlags == nil {
+				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
+			}
+			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
 			}
-			m.Height = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2412,14 +4434,31 @@ func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockHeaderVerificationFlags == nil {
+				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
+			}
+			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningFlags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2446,115 +4485,18 @@ func (m *MsgAddBlockHeader) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddBlockHeaderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.PruningFlags == nil {
+				m.PruningFlags = &PruningFlags{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlockHeaderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlockHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.PruningFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainFlags", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2564,27 +4506,29 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.ChainFlags = append(m.ChainFlags, ChainFlags{})
+			if err := m.ChainFlags[len(m.ChainFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignCoinFlags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2611,10 +4555,8 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChainParams == nil {
-				m.ChainParams = &ChainParams{}
-			}
-			if err := m.ChainParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ForeignCoinFlags = append(m.ForeignCoinFlags, ForeignCoinFlags{})
+			if err := m.ForeignCoinFlags[len(m.ForeignCoinFlags)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2639,7 +4581,7 @@ func (m *MsgUpdateChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2662,10 +4604,10 @@ func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCrosschainFlagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2689,7 +4631,7 @@ func (m *MsgUpdateChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2712,10 +4654,10 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2752,9 +4694,9 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.ChainId = 0
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2764,7 +4706,7 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
+				m.Block |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2790,7 +4732,7 @@ func (m *MsgRemoveChainParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2813,10 +4755,10 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveChainParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2840,7 +4782,7 @@ func (m *MsgRemoveChainParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObserverChains) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2863,10 +4805,10 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserver: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObserverChains: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObserverChains: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2902,89 +4844,81 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObserverAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.ChainIds = append(m.ChainIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObserverAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZetaclientGranteePubkey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ZetaclientGranteePubkey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddNodeAccountOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				elementCount = count
+				if elementCount != 0 && len(m.ChainIds) == 0 {
+					m.ChainIds = make([]int64, 0, elementCount)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ChainIds = append(m.ChainIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainIds", wireType)
 			}
-			m.AddNodeAccountOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3006,7 +4940,7 @@ func (m *MsgAddObserver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateObserverChainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3029,10 +4963,10 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateObserverChainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateObserverChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3056,7 +4990,7 @@ func (m *MsgAddObserverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserver) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3079,10 +5013,10 @@ func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlameVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserver: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlameVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserver: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3117,58 +5051,6 @@ func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			m.ChainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ChainId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlameInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BlameInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3190,7 +5072,7 @@ func (m *MsgAddBlameVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailObserverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3213,10 +5095,10 @@ func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBlameVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBlameVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailObserverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3240,7 +5122,7 @@ func (m *MsgAddBlameVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
+func (m *MsgBootstrapEthereumLightClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3263,10 +5145,10 @@ func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCrosschainFlags: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBootstrapEthereumLightClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCrosschainFlags: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBootstrapEthereumLightClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3301,31 +5183,11 @@ func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsInboundEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsInboundEnabled = bool(v != 0)
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsOutboundEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			var v int
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3335,17 +5197,16 @@ func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsOutboundEnabled = bool(v != 0)
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceIncreaseFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustedBlockRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3355,31 +5216,29 @@ func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GasPriceIncreaseFlags == nil {
-				m.GasPriceIncreaseFlags = &GasPriceIncreaseFlags{}
-			}
-			if err := m.GasPriceIncreaseFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TrustedBlockRoot = append(m.TrustedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrustedBlockRoot == nil {
+				m.TrustedBlockRoot = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeaderVerificationFlags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bootstrap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3406,10 +5265,7 @@ func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockHeaderVerificationFlags == nil {
-				m.BlockHeaderVerificationFlags = &BlockHeaderVerificationFlags{}
-			}
-			if err := m.BlockHeaderVerificationFlags.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Bootstrap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3434,7 +5290,7 @@ func (m *MsgUpdateCrosschainFlags) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBootstrapEthereumLightClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3457,10 +5313,10 @@ func (m *MsgUpdateCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCrosschainFlagsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBootstrapEthereumLightClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCrosschainFlagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBootstrapEthereumLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3484,7 +5340,7 @@ func (m *MsgUpdateCrosschainFlagsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEthereumLightClient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3507,10 +5363,10 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEthereumLightClient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEthereumLightClient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3547,9 +5403,9 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			m.Block = 0
+			m.ChainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3559,11 +5415,44 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= int64(b&0x7F) << shift
+				m.ChainId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3585,7 +5474,7 @@ func (m *MsgUpdateKeygen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateEthereumLightClientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3608,10 +5497,10 @@ func (m *MsgUpdateKeygenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateEthereumLightClientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKeygenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateEthereumLightClientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -68,13 +68,14 @@ type BTCChainClient struct {
 	lastBlockScanned int64
 	BlockTime        uint64 // block time in seconds
 
-	Mu                *sync.Mutex // lock for all the maps, utxos and core params
-	pendingNonce      uint64
-	includedTxHashes  map[string]bool                          // key: tx hash
-	includedTxResults map[string]*btcjson.GetTransactionResult // key: chain-tss-nonce
-	broadcastedTx     map[string]string                        // key: chain-tss-nonce, value: outTx hash
-	utxos             []btcjson.ListUnspentResult
-	params            observertypes.ChainParams
+	Mu                  *sync.Mutex // lock for all the maps, utxos and core params
+	pendingNonce        uint64
+	includedTxHashes    map[string]bool                          // key: tx hash
+	includedTxResults   map[string]*btcjson.GetTransactionResult // key: chain-tss-nonce
+	broadcastedTx       map[string]string                        // key: chain-tss-nonce, value: outTx hash
+	broadcastedGasPrice map[string]*big.Int                      // key: chain-tss-nonce, value: cctx gas price the outTx was signed with
+	utxos               []btcjson.ListUnspentResult
+	params              observertypes.ChainParams
 
 	db     *gorm.DB
 	stop   chan struct{}
@@ -168,6 +169,7 @@ func NewBitcoinClient(
 	ob.includedTxHashes = make(map[string]bool)
 	ob.includedTxResults = make(map[string]*btcjson.GetTransactionResult)
 	ob.broadcastedTx = make(map[string]string)
+	ob.broadcastedGasPrice = make(map[string]*big.Int)
 	_, chainParams, found := appcontext.ZetaCoreContext().GetBTCChainParams()
 	if !found {
 		return nil, fmt.Errorf("btc chains params not initialized")
@@ -346,6 +348,18 @@ func (ob *BTCChainClient) postBlockHeader(tip int64) error {
 	res, err := ob.zetaClient.GetBlockHeaderStateByChain(ob.chain.ChainId)
 	if err == nil && res.BlockHeaderState != nil && res.BlockHeaderState.EarliestHeight > 0 {
 		bn = res.BlockHeaderState.LatestHeight + 1
+
+		// on a bitcoin chain reorg, post the headers of the new branch from the last block known by zetacore
+		for bn > res.BlockHeaderState.EarliestHeight+1 {
+			parentHash, err := ob.rpcClient.GetBlockHash(bn - 1)
+			if err != nil {
+				return fmt.Errorf("postBlockHeader: error getting bitcoin block hash %d: %s", bn-1, err)
+			}
+			if _, err := ob.zetaClient.GetBlockHeaderByHash(parentHash[:]); err == nil {
+				break
+			}
+			bn--
+		}
 	}
 	if bn > tip {
 		return fmt.Errorf("postBlockHeader: must post block confirmed block header: %d > %d", bn, tip)
@@ -381,7 +395,7 @@ func (ob *BTCChainClient) observeInTx() error {
 	if err != nil {
 		return err
 	}
-	if !flags.IsInboundEnabled {
+	if !flags.IsChainInboundEnabled(ob.chain.ChainId) {
 		return errors.New("inbound TXS / Send has been disabled by the protocol")
 	}
 
@@ -548,6 +562,21 @@ func (ob *BTCChainClient) IsSendOutTxProcessed(cctx *types.CrossChainTx, logger
 		return true, false, nil
 	}
 
+	// the cancel tx of an expired outbound is voted as a failed outbound
+	// the outbound itself can still be included if it was broadcasted before the cancellation, it is then voted as usual
+	recvStatus := common.ReceiveStatus_Success
+	if params.Cancelled {
+		isCancelTx, err := ob.isCancelOutTx(&params, res)
+		if err != nil {
+			logger.Error().Err(err).Msgf("IsSendOutTxProcessed: error checking cancelled outTx %s nonce %d", res.TxID, nonce)
+			return true, false, nil
+		}
+		if isCancelTx {
+			recvStatus = common.ReceiveStatus_Failed
+			amountInSat = big.NewInt(0)
+		}
+	}
+
 	logger.Debug().Msgf("Bitcoin outTx confirmed: txid %s, amount %s\n", res.TxID, amountInSat.String())
 	zetaHash, ballot, err := ob.zetaClient.PostVoteOutbound(
 		sendHash,
@@ -558,7 +587,7 @@ func (ob *BTCChainClient) IsSendOutTxProcessed(cctx *types.CrossChainTx, logger
 		nil, // gas price not used with Bitcoin
 		0,   // gas limit not used with Bitcoin
 		amountInSat,
-		common.ReceiveStatus_Success,
+		recvStatus,
 		ob.chain,
 		nonce,
 		common.CoinType_Gas,
@@ -601,7 +630,7 @@ func (ob *BTCChainClient) PostGasPrice() error {
 			return err
 		}
 		// #nosec G701 always in range
-		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1, "100", uint64(bn))
+		zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, 1, 0, "100", uint64(bn))
 		if err != nil {
 			ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 			return err
@@ -627,7 +656,7 @@ func (ob *BTCChainClient) PostGasPrice() error {
 		return err
 	}
 	// #nosec G701 always positive
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), "100", uint64(bn))
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, feeRatePerByte.Uint64(), 0, "100", uint64(bn))
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice:")
 		return err
@@ -1080,6 +1109,123 @@ func (ob *BTCChainClient) SaveBroadcastedTx(txHash string, nonce uint64) {
 	ob.logger.ObserveOutTx.Info().Msgf("SaveBroadcastedTx: saved broadcasted txHash %s for outTx %s", txHash, outTxID)
 }
 
+// setBroadcastedGasPrice saves the cctx gas price the outTx of the nonce was signed with
+func (ob *BTCChainClient) setBroadcastedGasPrice(nonce uint64, gasPrice *big.Int) {
+	outTxID := ob.GetTxID(nonce)
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	ob.broadcastedGasPrice[outTxID] = gasPrice
+}
+
+// IsOutTxReplaceable returns true if the outTx broadcasted for the cctx is not mined yet and was signed with a lower
+// gas price than the current one of the cctx, so that it can be replaced by fee (BIP-125).
+// An outTx whose nonce-mark is already spent by the next outTx is not replaced as this would evict the next outTx.
+func (ob *BTCChainClient) IsOutTxReplaceable(cctx *types.CrossChainTx) bool {
+	params := cctx.GetCurrentOutTxParam()
+	nonce := params.OutboundTxTssNonce
+	if nonce == 0 { // nonce 0 has no nonce-mark input, see IsSendOutTxProcessed
+		return false
+	}
+	gasPrice, ok := new(big.Int).SetString(params.OutboundTxGasPrice, 10)
+	if !ok {
+		return false
+	}
+	outTxID := ob.GetTxID(nonce)
+	nextOutTxID := ob.GetTxID(nonce + 1)
+
+	ob.Mu.Lock()
+	defer ob.Mu.Unlock()
+	if _, found := ob.broadcastedTx[outTxID]; !found {
+		return false
+	}
+	if res, found := ob.includedTxResults[outTxID]; found && res.Confirmations > 0 {
+		return false
+	}
+	if _, found := ob.broadcastedTx[nextOutTxID]; found {
+		return false
+	}
+	if _, found := ob.includedTxResults[nextOutTxID]; found {
+		return false
+	}
+
+	// the gas price is unknown if the outTx was broadcasted before a restart, the signer checks the fees paid instead
+	signedGasPrice, found := ob.broadcastedGasPrice[outTxID]
+	return !found || gasPrice.Cmp(signedGasPrice) > 0
+}
+
+// getReplaceableOutTx returns the inputs, their total value (in BTC) and the fees (in satoshi) of the outTx broadcasted
+// for the nonce so that a replacement (BIP-125) spending the same UTXOs can be signed.
+// No input is returned if there is no such outTx or if it is no longer known by the node (e.g. evicted from mempool).
+func (ob *BTCChainClient) getReplaceableOutTx(nonce uint64) ([]btcjson.ListUnspentResult, float64, int64, error) {
+	if nonce == 0 { // nonce 0 has no nonce-mark input, see IsSendOutTxProcessed
+		return nil, 0, 0, nil
+	}
+	outTxID := ob.GetTxID(nonce)
+	ob.Mu.Lock()
+	txHash, broadcasted := ob.broadcastedTx[outTxID]
+	ob.Mu.Unlock()
+	if !broadcasted {
+		return nil, 0, 0, nil
+	}
+
+	hash, err := chainhash.NewHashFromStr(txHash)
+	if err != nil {
+		return nil, 0, 0, errors.Wrapf(err, "getReplaceableOutTx: error NewHashFromStr: %s", txHash)
+	}
+	rawResult, err := ob.rpcClient.GetRawTransactionVerbose(hash)
+	if err != nil {
+		ob.logger.ObserveOutTx.Warn().Err(err).Msgf("getReplaceableOutTx: outTx %s of outTxID %s not found", txHash, outTxID)
+		return nil, 0, 0, nil
+	}
+	if rawResult.Confirmations > 0 {
+		return nil, 0, 0, fmt.Errorf("getReplaceableOutTx: outTx %s of outTxID %s is already mined", txHash, outTxID)
+	}
+
+	// the previous outputs are all paying TSS address, which is watched by the node
+	tssAddress := ob.Tss.BTCAddressWitnessPubkeyHash().EncodeAddress()
+	prevOuts := make([]btcjson.ListUnspentResult, 0, len(rawResult.Vin))
+	totalIn := int64(0)
+	for _, vin := range rawResult.Vin {
+		prevHash, err := chainhash.NewHashFromStr(vin.Txid)
+		if err != nil {
+			return nil, 0, 0, errors.Wrapf(err, "getReplaceableOutTx: error NewHashFromStr: %s", vin.Txid)
+		}
+		prevTxResult, err := ob.rpcClient.GetTransaction(prevHash)
+		if err != nil {
+			return nil, 0, 0, errors.Wrapf(err, "getReplaceableOutTx: error GetTransaction %s", vin.Txid)
+		}
+		prevTxBytes, err := hex.DecodeString(prevTxResult.Hex)
+		if err != nil {
+			return nil, 0, 0, errors.Wrapf(err, "getReplaceableOutTx: error decoding tx %s", vin.Txid)
+		}
+		prevTx := wire.NewMsgTx(wire.TxVersion)
+		if err := prevTx.Deserialize(bytes.NewReader(prevTxBytes)); err != nil {
+			return nil, 0, 0, errors.Wrapf(err, "getReplaceableOutTx: error deserializing tx %s", vin.Txid)
+		}
+		if int(vin.Vout) >= len(prevTx.TxOut) {
+			return nil, 0, 0, fmt.Errorf("getReplaceableOutTx: invalid vout %d for tx %s", vin.Vout, vin.Txid)
+		}
+		prevOut := prevTx.TxOut[vin.Vout]
+		totalIn += prevOut.Value
+		prevOuts = append(prevOuts, btcjson.ListUnspentResult{
+			TxID:         vin.Txid,
+			Vout:         vin.Vout,
+			Address:      tssAddress,
+			ScriptPubKey: hex.EncodeToString(prevOut.PkScript),
+			Amount:       btcutil.Amount(prevOut.Value).ToBTC(),
+		})
+	}
+	totalOut := int64(0)
+	for _, vout := range rawResult.Vout {
+		amount, err := GetSatoshis(vout.Value)
+		if err != nil {
+			return nil, 0, 0, errors.Wrapf(err, "getReplaceableOutTx: invalid vout value %f", vout.Value)
+		}
+		totalOut += amount
+	}
+	return prevOuts, btcutil.Amount(totalIn).ToBTC(), totalIn - totalOut, nil
+}
+
 func (ob *BTCChainClient) observeOutTx() {
 	ticker, err := clienttypes.NewDynamicTicker("Bitcoin_observeOutTx", ob.GetChainParams().OutTxTicker)
 	if err != nil {
@@ -1188,6 +1334,12 @@ func (ob *BTCChainClient) setIncludedTx(nonce uint64, getTxResult *btcjson.GetTr
 		if getTxResult.Confirmations > res.Confirmations {
 			ob.logger.ObserveOutTx.Info().Msgf("setIncludedTx: bitcoin outTx %s got confirmations %d", txHash, getTxResult.Confirmations)
 		}
+	} else if nonce > 0 && res.Confirmations == 0 { // found other hash not mined yet.
+		// the outTx was replaced by fee (BIP-125). Both spend the same nonce-mark so only one of them can be mined
+		delete(ob.includedTxHashes, res.TxID)
+		ob.includedTxHashes[txHash] = true
+		ob.includedTxResults[outTxID] = getTxResult
+		ob.logger.ObserveOutTx.Info().Msgf("setIncludedTx: bitcoin outTx %s outTxID %s replaced prior outTx %s", txHash, outTxID, res.TxID)
 	} else { // found other hash.
 		// be alert for duplicate payment!!! As we got a new hash paying same cctx (for whatever reason).
 		delete(ob.includedTxResults, outTxID) // we can't tell which txHash is true, so we remove all to be safe
@@ -1236,6 +1388,14 @@ func (ob *BTCChainClient) checkTssOutTxResult(cctx *types.CrossChainTx, hash *ch
 		if err != nil {
 			return errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vout in cancelled outTx %s nonce %d", hash, nonce)
 		}
+	} else if params.Cancelled {
+		// the outTx of an expired outbound is either the cancel tx or the outbound broadcasted before the cancellation
+		if ob.checkTSSVout(params, rawResult.Vout) != nil {
+			err = ob.checkTSSVoutCancelled(params, rawResult.Vout)
+			if err != nil {
+				return errors.Wrapf(err, "checkTssOutTxResult: invalid TSS Vout in cancelled outTx %s nonce %d", hash, nonce)
+			}
+		}
 	} else {
 		err = ob.checkTSSVout(params, rawResult.Vout)
 		if err != nil {
@@ -1363,6 +1523,20 @@ func (ob *BTCChainClient) checkTSSVout(params *types.OutboundTxParams, vouts []b
 	return nil
 }
 
+// isCancelOutTx returns true if the included outTx of an expired outbound is the cancel tx instead of the outbound
+// the outTx has already been checked to be one of them by checkTssOutTxResult
+func (ob *BTCChainClient) isCancelOutTx(params *types.OutboundTxParams, res *btcjson.GetTransactionResult) (bool, error) {
+	hash, err := chainhash.NewHashFromStr(res.TxID)
+	if err != nil {
+		return false, errors.Wrapf(err, "isCancelOutTx: error NewHashFromStr: %s", res.TxID)
+	}
+	rawResult, err := ob.getRawTxResult(hash, res)
+	if err != nil {
+		return false, errors.Wrapf(err, "isCancelOutTx: error GetRawTxResult %s", res.TxID)
+	}
+	return ob.checkTSSVout(params, rawResult.Vout) != nil, nil
+}
+
 // checkTSSVoutCancelled vout is valid if:
 //   - The first output is the nonce-mark
 //   - The second output is the change to TSS (optional)
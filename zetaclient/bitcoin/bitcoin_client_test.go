@@ -3,6 +3,7 @@ package bitcoin
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"math"
 	"math/big"
 	"path"
@@ -12,11 +13,15 @@ import (
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/require"
 	"github.com/zeta-chain/zetacore/common"
+	"github.com/zeta-chain/zetacore/testutil/sample"
 	observertypes "github.com/zeta-chain/zetacore/x/observer/types"
+	"github.com/zeta-chain/zetacore/zetaclient/interfaces"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils"
 	"github.com/zeta-chain/zetacore/zetaclient/testutils/stub"
 )
@@ -316,3 +321,175 @@ func TestCheckTSSVoutCancelled(t *testing.T) {
 		require.ErrorContains(t, err, "not match TSS address")
 	})
 }
+
+// rbfRPCClient mocks the RPC calls used to replace an outTx by fee
+type rbfRPCClient struct {
+	interfaces.BTCRPCClient
+	rawTxs map[string]*btcjson.TxRawResult
+	txs    map[string]*btcjson.GetTransactionResult
+}
+
+func (c rbfRPCClient) GetRawTransactionVerbose(txHash *chainhash.Hash) (*btcjson.TxRawResult, error) {
+	if res, found := c.rawTxs[txHash.String()]; found {
+		return res, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (c rbfRPCClient) GetTransaction(txHash *chainhash.Hash) (*btcjson.GetTransactionResult, error) {
+	if res, found := c.txs[txHash.String()]; found {
+		return res, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func createReplaceableTestClient(t *testing.T) *BTCChainClient {
+	ob := createTestClient(t)
+	ob.chain = common.BtcRegtestChain()
+	ob.includedTxHashes = make(map[string]bool)
+	ob.broadcastedTx = make(map[string]string)
+	ob.broadcastedGasPrice = make(map[string]*big.Int)
+	return ob
+}
+
+func TestIsOutTxReplaceable(t *testing.T) {
+	dummyTxID := "6e6f71d281146c1fc5c755b35908ee449f26786c84e2ae18f98b268de40b7ec4"
+	cctx := sample.CrossChainTx(t, "index")
+	cctx.GetCurrentOutTxParam().OutboundTxTssNonce = 5
+	cctx.GetCurrentOutTxParam().OutboundTxGasPrice = "20"
+
+	t.Run("should not replace an outTx not broadcasted", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		require.False(t, ob.IsOutTxReplaceable(cctx))
+	})
+
+	t.Run("should not replace the outTx of nonce 0", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		cctx := sample.CrossChainTx(t, "index")
+		cctx.GetCurrentOutTxParam().OutboundTxTssNonce = 0
+		cctx.GetCurrentOutTxParam().OutboundTxGasPrice = "20"
+		ob.broadcastedTx[ob.GetTxID(0)] = dummyTxID
+		require.False(t, ob.IsOutTxReplaceable(cctx))
+	})
+
+	t.Run("should replace an outTx broadcasted before a restart", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		ob.broadcastedTx[ob.GetTxID(5)] = dummyTxID
+		require.True(t, ob.IsOutTxReplaceable(cctx))
+	})
+
+	t.Run("should replace an outTx signed with a lower gas price", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		ob.broadcastedTx[ob.GetTxID(5)] = dummyTxID
+		ob.includedTxResults[ob.GetTxID(5)] = &btcjson.GetTransactionResult{TxID: dummyTxID, Confirmations: 0}
+		ob.setBroadcastedGasPrice(5, big.NewInt(10))
+		require.True(t, ob.IsOutTxReplaceable(cctx))
+
+		ob.setBroadcastedGasPrice(5, big.NewInt(20))
+		require.False(t, ob.IsOutTxReplaceable(cctx))
+	})
+
+	t.Run("should not replace a mined outTx", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		ob.broadcastedTx[ob.GetTxID(5)] = dummyTxID
+		ob.includedTxResults[ob.GetTxID(5)] = &btcjson.GetTransactionResult{TxID: dummyTxID, Confirmations: 1}
+		require.False(t, ob.IsOutTxReplaceable(cctx))
+	})
+
+	t.Run("should not replace an outTx whose nonce-mark is spent by the next outTx", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		ob.broadcastedTx[ob.GetTxID(5)] = dummyTxID
+		ob.broadcastedTx[ob.GetTxID(6)] = dummyTxID
+		require.False(t, ob.IsOutTxReplaceable(cctx))
+	})
+}
+
+func TestSetIncludedTxReplacement(t *testing.T) {
+	txID := "6e6f71d281146c1fc5c755b35908ee449f26786c84e2ae18f98b268de40b7ec4"
+	replacementTxID := "c1729638e1c9b6bfca57d11bf93047d98b65594b0bf75d7ee68bf7dc80dc164e"
+
+	t.Run("should accept the replacement of an outTx not mined yet", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		ob.setIncludedTx(5, &btcjson.GetTransactionResult{TxID: txID, Confirmations: 0})
+		ob.setIncludedTx(5, &btcjson.GetTransactionResult{TxID: replacementTxID, Confirmations: 1})
+
+		res := ob.getIncludedTx(5)
+		require.NotNil(t, res)
+		require.Equal(t, replacementTxID, res.TxID)
+		require.True(t, ob.isTssTransaction(replacementTxID))
+		require.False(t, ob.isTssTransaction(txID))
+	})
+
+	t.Run("should remove both outTxs if the prior one is mined", func(t *testing.T) {
+		ob := createReplaceableTestClient(t)
+		ob.setIncludedTx(5, &btcjson.GetTransactionResult{TxID: txID, Confirmations: 1})
+		ob.setIncludedTx(5, &btcjson.GetTransactionResult{TxID: replacementTxID, Confirmations: 1})
+		require.Nil(t, ob.getIncludedTx(5))
+	})
+}
+
+func TestGetReplaceableOutTx(t *testing.T) {
+	ob := createReplaceableTestClient(t)
+	payToSelf, err := PayToWitnessPubKeyHashScript(ob.Tss.BTCAddressWitnessPubkeyHash().WitnessProgram())
+	require.NoError(t, err)
+
+	// previous tx paying the nonce-mark and a change to TSS
+	prevTx := wire.NewMsgTx(wire.TxVersion)
+	prevTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{}, 0), nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(common.NonceMarkAmount(4), payToSelf))
+	prevTx.AddTxOut(wire.NewTxOut(100000, payToSelf))
+	var buf bytes.Buffer
+	require.NoError(t, prevTx.Serialize(&buf))
+	prevTxID := prevTx.TxHash().String()
+
+	outTxID := "6e6f71d281146c1fc5c755b35908ee449f26786c84e2ae18f98b268de40b7ec4"
+	rpcClient := rbfRPCClient{
+		rawTxs: map[string]*btcjson.TxRawResult{
+			outTxID: {
+				Txid: outTxID,
+				Vin:  []btcjson.Vin{{Txid: prevTxID, Vout: 0}, {Txid: prevTxID, Vout: 1}},
+				Vout: []btcjson.Vout{{Value: 0.00000005}, {Value: 0.0005}, {Value: 0.00045}},
+			},
+		},
+		txs: map[string]*btcjson.GetTransactionResult{
+			prevTxID: {TxID: prevTxID, Hex: hex.EncodeToString(buf.Bytes())},
+		},
+	}
+	ob.rpcClient = rpcClient
+
+	t.Run("should return no input if no outTx is broadcasted", func(t *testing.T) {
+		prevOuts, total, fees, err := ob.getReplaceableOutTx(5)
+		require.NoError(t, err)
+		require.Empty(t, prevOuts)
+		require.Zero(t, total)
+		require.Zero(t, fees)
+	})
+
+	t.Run("should return no input if the outTx is not found", func(t *testing.T) {
+		ob.broadcastedTx[ob.GetTxID(6)] = prevTxID
+		prevOuts, _, _, err := ob.getReplaceableOutTx(6)
+		require.NoError(t, err)
+		require.Empty(t, prevOuts)
+	})
+
+	t.Run("should return the inputs and the fees of the outTx", func(t *testing.T) {
+		ob.broadcastedTx[ob.GetTxID(5)] = outTxID
+		prevOuts, total, fees, err := ob.getReplaceableOutTx(5)
+		require.NoError(t, err)
+		require.Len(t, prevOuts, 2)
+		require.Equal(t, prevTxID, prevOuts[0].TxID)
+		require.EqualValues(t, 0, prevOuts[0].Vout)
+		require.Equal(t, hex.EncodeToString(payToSelf), prevOuts[0].ScriptPubKey)
+		require.Equal(t, btcutil.Amount(common.NonceMarkAmount(4)).ToBTC(), prevOuts[0].Amount)
+		require.EqualValues(t, 1, prevOuts[1].Vout)
+		require.Equal(t, 0.001, prevOuts[1].Amount)
+		require.Equal(t, btcutil.Amount(common.NonceMarkAmount(4)+100000).ToBTC(), total)
+		require.Equal(t, common.NonceMarkAmount(4)+100000-95005, fees)
+	})
+
+	t.Run("should fail if the outTx is already mined", func(t *testing.T) {
+		rpcClient.rawTxs[outTxID].Confirmations = 1
+		_, _, _, err := ob.getReplaceableOutTx(5)
+		require.ErrorContains(t, err, "already mined")
+	})
+}
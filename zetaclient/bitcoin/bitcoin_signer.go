@@ -3,6 +3,7 @@ package bitcoin
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"math/rand"
@@ -32,8 +33,17 @@ const (
 	consolidationRank  = 10           // the rank below (or equal to) which we consolidate UTXOs
 	outTxBytesMin      = uint64(239)  // 239vB == EstimateSegWitTxSize(2, 3)
 	outTxBytesMax      = uint64(1531) // 1531v == EstimateSegWitTxSize(21, 3)
+
+	// rbfTxInSequenceNum is the input sequence number signaling the replaceability of the outTx (BIP-125)
+	rbfTxInSequenceNum = wire.MaxTxInSequenceNum - 2
+
+	// incrementalRelayFee is the minimum fee rate increase (in sat/vB) for a replacement to be relayed
+	incrementalRelayFee = 1
 )
 
+// errReplacementFeeTooLow is returned when the fees are not high enough to replace the broadcasted outTx
+var errReplacementFeeTooLow = errors.New("fees too low to replace the outTx")
+
 type BTCSigner struct {
 	tssSigner        interfaces.TSSSigner
 	rpcClient        interfaces.BTCRPCClient
@@ -72,6 +82,7 @@ func NewBTCSigner(
 }
 
 // SignWithdrawTx receives utxos sorted by value, amount in BTC, feeRate in BTC per Kb
+// If an outTx of the nonce was already broadcasted and is not mined yet, the signed tx replaces it by fee (BIP-125)
 func (signer *BTCSigner) SignWithdrawTx(
 	to *btcutil.AddressWitnessPubKeyHash,
 	amount float64,
@@ -86,16 +97,28 @@ func (signer *BTCSigner) SignWithdrawTx(
 	estimateFee := float64(gasPrice.Uint64()*outTxBytesMax) / 1e8
 	nonceMark := common.NonceMarkAmount(nonce)
 
-	// refresh unspent UTXOs and continue with keysign regardless of error
-	err := btcClient.FetchUTXOS()
+	// replace the outTx already broadcasted for the nonce (if not mined yet) by fee (BIP-125)
+	// the replacement spends the same UTXOs (including the nonce-mark) so that only one of them can be mined
+	prevOuts, total, replacedFees, err := btcClient.getReplaceableOutTx(nonce)
 	if err != nil {
-		signer.logger.Error().Err(err).Msgf("SignWithdrawTx: FetchUTXOS error: nonce %d chain %d", nonce, chain.ChainId)
+		return nil, err
 	}
+	isReplacement := len(prevOuts) > 0
+	consolidatedUtxo, consolidatedValue := uint16(0), 0.0
+	if isReplacement {
+		signer.logger.Info().Msgf("SignWithdrawTx: replacing outTx of nonce %d paying fees %d", nonce, replacedFees)
+	} else {
+		// refresh unspent UTXOs and continue with keysign regardless of error
+		err = btcClient.FetchUTXOS()
+		if err != nil {
+			signer.logger.Error().Err(err).Msgf("SignWithdrawTx: FetchUTXOS error: nonce %d chain %d", nonce, chain.ChainId)
+		}
 
-	// select N UTXOs to cover the total expense
-	prevOuts, total, consolidatedUtxo, consolidatedValue, err := btcClient.SelectUTXOs(amount+estimateFee+float64(nonceMark)*1e-8, maxNoOfInputsPerTx, nonce, consolidationRank, false)
-	if err != nil {
-		return nil, err
+		// select N UTXOs to cover the total expense
+		prevOuts, total, consolidatedUtxo, consolidatedValue, err = btcClient.SelectUTXOs(amount+estimateFee+float64(nonceMark)*1e-8, maxNoOfInputsPerTx, nonce, consolidationRank, false)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// build tx with selected unspents
@@ -107,6 +130,7 @@ func (signer *BTCSigner) SignWithdrawTx(
 		}
 		outpoint := wire.NewOutPoint(hash, prevOut.Vout)
 		txIn := wire.NewTxIn(outpoint, nil, nil)
+		txIn.Sequence = rbfTxInSequenceNum
 		tx.AddTxIn(txIn)
 	}
 
@@ -136,6 +160,12 @@ func (signer *BTCSigner) SignWithdrawTx(
 	signer.logger.Info().Msgf("bitcoin outTx nonce %d gasPrice %s size %d fees %s consolidated %d utxos of value %v",
 		nonce, gasPrice.String(), txSize, fees.String(), consolidatedUtxo, consolidatedValue)
 
+	// the replacement must pay the fees of the replaced outTx plus the relay fee of its own size
+	// #nosec G701 always in range (checked above)
+	if isReplacement && fees.Int64() < replacedFees+int64(txSize)*incrementalRelayFee {
+		return nil, fmt.Errorf("%w: nonce %d fees %d replaced fees %d", errReplacementFeeTooLow, nonce, fees.Int64(), replacedFees)
+	}
+
 	// calculate remaining btc to TSS self
 	tssAddrWPKH := signer.tssSigner.BTCAddressWitnessPubkeyHash()
 	payToSelf, err := PayToWitnessPubKeyHashScript(tssAddrWPKH.WitnessProgram())
@@ -322,6 +352,7 @@ func (signer *BTCSigner) TryProcessOutTx(
 		return
 	}
 	satPerByte := FeeRateToSatPerByte(networkInfo.RelayFee)
+	cctxGasPrice := new(big.Int).Set(gasprice)
 	gasprice.Add(gasprice, satPerByte)
 
 	// compliance check
@@ -332,6 +363,13 @@ func (signer *BTCSigner) TryProcessOutTx(
 		amount = 0.0 // zero out the amount to cancel the tx
 	}
 
+	// expired outbound, consume the nonce with a cancel tx
+	if params.Cancelled {
+		logger.Info().Msgf("SignWithdrawTx: outbound of cctx %s expired, nonce %d", cctx.Index, outboundTxTssNonce)
+		cancelTx = true
+		amount = 0.0
+	}
+
 	logger.Info().Msgf("SignWithdrawTx: to %s, value %d sats", addr.EncodeAddress(), params.Amount.Uint64())
 	logger.Info().Msgf("using utxos: %v", btcClient.utxos)
 
@@ -347,6 +385,10 @@ func (signer *BTCSigner) TryProcessOutTx(
 		cancelTx,
 	)
 	if err != nil {
+		// don't try replacing the outTx again until the gas price is increased
+		if errors.Is(err, errReplacementFeeTooLow) {
+			btcClient.setBroadcastedGasPrice(outboundTxTssNonce, cctxGasPrice)
+		}
 		logger.Warn().Err(err).Msgf("SignOutboundTx error: nonce %d chain %d", outboundTxTssNonce, params.ReceiverChainId)
 		return
 	}
@@ -380,6 +422,7 @@ func (signer *BTCSigner) TryProcessOutTx(
 
 			// Save successfully broadcasted transaction to btc chain client
 			btcClient.SaveBroadcastedTx(outTxHash, outboundTxTssNonce)
+			btcClient.setBroadcastedGasPrice(outboundTxTssNonce, cctxGasPrice)
 
 			break // successful broadcast; no need to retry
 		}
@@ -20,6 +20,12 @@ type ZetaCoreContext struct {
 	evmChainParams     map[int64]*observertypes.ChainParams
 	bitcoinChainParams *observertypes.ChainParams
 	currentTssPubkey   string
+
+	// observedChainIDs are the chains the observer is assigned to, all chains are observed if empty
+	observedChainIDs []int64
+
+	// pendingChainParams are the chain params scheduled by zetacore and not yet activated
+	pendingChainParams map[int64]observertypes.PendingChainParams
 }
 
 // NewZetaCoreContext creates and returns new ZetaCoreContext
@@ -39,6 +45,7 @@ func NewZetaCoreContext(cfg config.Config) *ZetaCoreContext {
 		chainsEnabled:      []common.Chain{},
 		evmChainParams:     evmChainParams,
 		bitcoinChainParams: bitcoinChainParams,
+		pendingChainParams: make(map[int64]observertypes.PendingChainParams),
 	}
 }
 
@@ -72,6 +79,21 @@ func (c *ZetaCoreContext) GetEnabledChains() []common.Chain {
 	return copiedChains
 }
 
+// IsChainObserved returns true if the observer is assigned to the chain
+func (c *ZetaCoreContext) IsChainObserved(chainID int64) bool {
+	c.coreContextLock.RLock()
+	defer c.coreContextLock.RUnlock()
+	if len(c.observedChainIDs) == 0 {
+		return true
+	}
+	for _, observedChainID := range c.observedChainIDs {
+		if observedChainID == chainID {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *ZetaCoreContext) GetEVMChainParams(chainID int64) (*observertypes.ChainParams, bool) {
 	c.coreContextLock.RLock()
 	defer c.coreContextLock.RUnlock()
@@ -106,6 +128,39 @@ func (c *ZetaCoreContext) GetBTCChainParams() (common.Chain, *observertypes.Chai
 	return *chain, c.bitcoinChainParams, true
 }
 
+// GetPendingChainParams returns the chain params scheduled for a chain and not yet activated
+func (c *ZetaCoreContext) GetPendingChainParams(chainID int64) (observertypes.PendingChainParams, bool) {
+	c.coreContextLock.RLock()
+	defer c.coreContextLock.RUnlock()
+	pending, found := c.pendingChainParams[chainID]
+	return pending, found
+}
+
+// GetChainParamsAtHeight returns the chain params of a chain that has a config in file active at the zeta height
+// the pending chain params are returned from their activation height so all the clients switch at the same block
+// instead of waiting for the next core context update
+func (c *ZetaCoreContext) GetChainParamsAtHeight(chainID int64, zetaHeight int64) (*observertypes.ChainParams, bool) {
+	var (
+		chainParams *observertypes.ChainParams
+		found       bool
+	)
+	if common.IsBitcoinChain(chainID) {
+		_, chainParams, found = c.GetBTCChainParams()
+		found = found && chainParams.ChainId == chainID
+	} else {
+		chainParams, found = c.GetEVMChainParams(chainID)
+	}
+	if !found {
+		return nil, false
+	}
+
+	pending, found := c.GetPendingChainParams(chainID)
+	if found && pending.ActivationHeight <= zetaHeight && pending.ChainParams.IsSupported {
+		return pending.ChainParams, true
+	}
+	return chainParams, true
+}
+
 // Update updates core context and params for all chains
 // this must be the ONLY function that writes to core context
 func (c *ZetaCoreContext) Update(
@@ -113,6 +168,9 @@ func (c *ZetaCoreContext) Update(
 	newChains []common.Chain,
 	evmChainParams map[int64]*observertypes.ChainParams,
 	btcChainParams *observertypes.ChainParams,
+	pendingChainParams []observertypes.PendingChainParams,
+	zetaHeight int64,
+	observedChainIDs []int64,
 	tssPubKey string,
 	init bool,
 	logger zerolog.Logger,
@@ -150,6 +208,7 @@ func (c *ZetaCoreContext) Update(
 	}
 	c.keygen = *keygen
 	c.chainsEnabled = newChains
+	c.observedChainIDs = observedChainIDs
 	// update chain params for bitcoin if it has config in file
 	if c.bitcoinChainParams != nil && btcChainParams != nil {
 		c.bitcoinChainParams = btcChainParams
@@ -163,7 +222,34 @@ func (c *ZetaCoreContext) Update(
 		c.evmChainParams[params.ChainId] = params
 	}
 
+	// zetacore applies the scheduled chain params at the beginning of the activation block
+	// the ones activated at the current zeta height are applied in case the chain params were queried before
+	c.pendingChainParams = make(map[int64]observertypes.PendingChainParams)
+	for _, pending := range pendingChainParams {
+		if pending.ActivationHeight > zetaHeight {
+			c.pendingChainParams[pending.ChainParams.ChainId] = pending
+			continue
+		}
+		if pending.ChainParams.IsSupported {
+			c.setChainParams(pending.ChainParams)
+		}
+	}
+
 	if tssPubKey != "" {
 		c.currentTssPubkey = tssPubKey
 	}
 }
+
+// setChainParams updates the chain params of a chain that has a config in file
+// the core context lock must be held by the caller
+func (c *ZetaCoreContext) setChainParams(chainParams *observertypes.ChainParams) {
+	if common.IsBitcoinChain(chainParams.ChainId) {
+		if c.bitcoinChainParams != nil {
+			c.bitcoinChainParams = chainParams
+		}
+		return
+	}
+	if _, found := c.evmChainParams[chainParams.ChainId]; found {
+		c.evmChainParams[chainParams.ChainId] = chainParams
+	}
+}
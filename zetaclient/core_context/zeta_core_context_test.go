@@ -130,6 +130,9 @@ func TestUpdateZetaCoreContext(t *testing.T) {
 			enabledChainsToUpdate,
 			evmChainParamsToUpdate,
 			btcChainParamsToUpdate,
+			nil,
+			0,
+			nil,
 			tssPubKeyToUpdate,
 			false,
 			loggers.Std,
@@ -216,6 +219,9 @@ func TestUpdateZetaCoreContext(t *testing.T) {
 			enabledChainsToUpdate,
 			evmChainParamsToUpdate,
 			btcChainParamsToUpdate,
+			nil,
+			0,
+			nil,
 			tssPubKeyToUpdate,
 			false,
 			loggers.Std,
@@ -249,6 +255,122 @@ func TestUpdateZetaCoreContext(t *testing.T) {
 		require.True(t, found)
 		require.Equal(t, evmChainParamsToUpdate[2], evmChainParams2)
 	})
+
+	t.Run("should update the chains observed by the observer", func(t *testing.T) {
+		zetaContext := corecontext.NewZetaCoreContext(config.NewConfig())
+		require.NotNil(t, zetaContext)
+
+		// all the chains are observed if the observer has no assigned chains
+		require.True(t, zetaContext.IsChainObserved(1))
+		require.True(t, zetaContext.IsChainObserved(2))
+
+		loggers := clientcommon.DefaultLoggers()
+		zetaContext.Update(
+			&observertypes.Keygen{},
+			[]common.Chain{},
+			nil,
+			nil,
+			nil,
+			0,
+			[]int64{1},
+			"",
+			false,
+			loggers.Std,
+		)
+		require.True(t, zetaContext.IsChainObserved(1))
+		require.False(t, zetaContext.IsChainObserved(2))
+	})
+}
+
+func TestZetaCoreContext_PendingChainParams(t *testing.T) {
+	testCfg := config.NewConfig()
+	testCfg.EVMChainConfigs = map[int64]config.EVMConfig{
+		1: {
+			Chain: common.Chain{
+				ChainName: 1,
+				ChainId:   1,
+			},
+		},
+		2: {
+			Chain: common.Chain{
+				ChainName: 2,
+				ChainId:   2,
+			},
+		},
+	}
+	zetaContext := corecontext.NewZetaCoreContext(testCfg)
+	require.NotNil(t, zetaContext)
+
+	evmChainParams := map[int64]*observertypes.ChainParams{
+		1: {
+			ChainId:           1,
+			ConfirmationCount: 10,
+			IsSupported:       true,
+		},
+		2: {
+			ChainId:           2,
+			ConfirmationCount: 10,
+			IsSupported:       true,
+		},
+	}
+	activated := observertypes.PendingChainParams{
+		ChainParams: &observertypes.ChainParams{
+			ChainId:           1,
+			ConfirmationCount: 20,
+			IsSupported:       true,
+		},
+		ActivationHeight: 100,
+	}
+	scheduled := observertypes.PendingChainParams{
+		ChainParams: &observertypes.ChainParams{
+			ChainId:           2,
+			ConfirmationCount: 20,
+			IsSupported:       true,
+		},
+		ActivationHeight: 101,
+	}
+
+	loggers := clientcommon.DefaultLoggers()
+	zetaContext.Update(
+		&observertypes.Keygen{},
+		[]common.Chain{},
+		evmChainParams,
+		nil,
+		[]observertypes.PendingChainParams{activated, scheduled},
+		100,
+		nil,
+		"",
+		false,
+		loggers.Std,
+	)
+
+	// chain params activated at the current height are applied
+	chainParams, found := zetaContext.GetEVMChainParams(1)
+	require.True(t, found)
+	require.Equal(t, activated.ChainParams, chainParams)
+	_, found = zetaContext.GetPendingChainParams(1)
+	require.False(t, found)
+
+	// chain params scheduled at a later height are kept as pending
+	chainParams, found = zetaContext.GetEVMChainParams(2)
+	require.True(t, found)
+	require.Equal(t, evmChainParams[2], chainParams)
+	pending, found := zetaContext.GetPendingChainParams(2)
+	require.True(t, found)
+	require.Equal(t, scheduled, pending)
+
+	// the pending chain params are resolved from the activation height
+	chainParams, found = zetaContext.GetChainParamsAtHeight(2, 100)
+	require.True(t, found)
+	require.Equal(t, evmChainParams[2], chainParams)
+	chainParams, found = zetaContext.GetChainParamsAtHeight(2, 101)
+	require.True(t, found)
+	require.Equal(t, scheduled.ChainParams, chainParams)
+	chainParams, found = zetaContext.GetChainParamsAtHeight(1, 101)
+	require.True(t, found)
+	require.Equal(t, activated.ChainParams, chainParams)
+	_, found = zetaContext.GetChainParamsAtHeight(3, 101)
+	require.False(t, found)
 }
 
 func assertPanic(t *testing.T, f func(), errorLog string) {
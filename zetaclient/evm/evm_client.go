@@ -357,6 +357,30 @@ func (ob *ChainClient) IsSendOutTxProcessed(cctx *crosschaintypes.CrossChainTx,
 		return true, true, nil
 	}
 
+	// the outbound of an expired cctx is cancelled, the cancel tx consuming its nonce is voted as a failed outbound
+	// the outbound itself can still be mined if it was broadcasted before the cancellation, it is then voted as usual
+	if cctx.GetCurrentOutTxParam().Cancelled && IsCancelTx(transaction, ob.Tss.EVMAddress()) {
+		zetaTxHash, ballot, err := ob.zetaClient.PostVoteOutbound(
+			sendHash,
+			receipt.TxHash.Hex(),
+			receipt.BlockNumber.Uint64(),
+			receipt.GasUsed,
+			transaction.GasPrice(),
+			transaction.Gas(),
+			big.NewInt(0),
+			common.ReceiveStatus_Failed,
+			ob.chain,
+			nonce,
+			cointype,
+		)
+		if err != nil {
+			logger.Error().Err(err).Msgf("error posting confirmation to meta core for cctx %s nonce %d", sendHash, nonce)
+		} else if zetaTxHash != "" {
+			logger.Info().Msgf("Zeta tx hash: %s cctx %s nonce %d ballot %s", zetaTxHash, sendHash, nonce, ballot)
+		}
+		return true, true, nil
+	}
+
 	if cointype == common.CoinType_Cmd {
 		recvStatus := common.ReceiveStatus_Failed
 		if receipt.Status == 1 {
@@ -911,7 +935,7 @@ func (ob *ChainClient) observeInTX(sampledLogger zerolog.Logger) error {
 	if err != nil {
 		return err
 	}
-	if !flags.IsInboundEnabled {
+	if !flags.IsChainInboundEnabled(ob.chain.ChainId) {
 		return errors.New("inbound TXS / Send has been disabled by the protocol")
 	}
 
@@ -1139,7 +1163,7 @@ func (ob *ChainClient) observerTSSReceive(startBlock, toBlock uint64, flags obse
 		// post new block header (if any) to zetabridge and ignore error
 		// TODO: consider having a independent ticker(from TSS scaning) for posting block headers
 		if flags.BlockHeaderVerificationFlags != nil &&
-			flags.BlockHeaderVerificationFlags.IsEthTypeChainEnabled &&
+			flags.BlockHeaderVerificationFlags.IsChainEnabled(ob.chain.ChainId) &&
 			common.IsHeaderSupportedEvmChain(ob.chain.ChainId) { // post block header for supported chains
 			err := ob.postBlockHeader(toBlock)
 			if err != nil {
@@ -1227,10 +1251,18 @@ func (ob *ChainClient) PostGasPrice() error {
 		return err
 	}
 
+	// PRIORITY FEE
+	baseFee, priorityFee, err := ob.GetDynamicFees()
+	if err != nil {
+		ob.logger.WatchGasPrice.Err(err).Msg("Err GetDynamicFees:")
+		return err
+	}
+	gasPrice = DynamicFeeGasPrice(gasPrice, baseFee, priorityFee)
+
 	// SUPPLY
 	supply := "100" // lockedAmount on ETH, totalSupply on other chains
 
-	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), supply, blockNum)
+	zetaHash, err := ob.zetaClient.PostGasPrice(ob.chain, gasPrice.Uint64(), priorityFee.Uint64(), supply, blockNum)
 	if err != nil {
 		ob.logger.WatchGasPrice.Err(err).Msg("PostGasPrice to zetabridge failed")
 		return err
@@ -1240,6 +1272,47 @@ func (ob *ChainClient) PostGasPrice() error {
 	return nil
 }
 
+// IsLondonEnabled returns true if the latest block of the chain has a base fee, meaning EIP-1559 is supported
+func IsLondonEnabled(client interfaces.EVMRPCClient) (bool, error) {
+	header, err := client.HeaderByNumber(context.TODO(), nil)
+	if err != nil {
+		return false, err
+	}
+	return header.BaseFee != nil, nil
+}
+
+// GetDynamicFees returns the base fee of the latest block and the suggested priority fee (EIP-1559) of the chain
+// a nil base fee and a zero priority fee are returned if the chain doesn't support EIP-1559
+func (ob *ChainClient) GetDynamicFees() (*big.Int, *big.Int, error) {
+	header, err := ob.evmClient.HeaderByNumber(context.TODO(), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header.BaseFee == nil {
+		return nil, big.NewInt(0), nil
+	}
+	priorityFee, err := ob.evmClient.SuggestGasTipCap(context.TODO())
+	if err != nil {
+		return nil, nil, err
+	}
+	return header.BaseFee, priorityFee, nil
+}
+
+// DynamicFeeGasPrice returns the gas price to vote for a chain supporting EIP-1559
+// the voted gas price is used as the max fee per gas of the outbounds, it must then cover the base fee and the priority fee
+// eth_gasPrice already returns the base fee plus the suggested priority fee, the gas price is raised to this sum in case a node doesn't
+// the gas price is returned unchanged if the chain doesn't support EIP-1559
+func DynamicFeeGasPrice(gasPrice, baseFee, priorityFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return gasPrice
+	}
+	minGasPrice := new(big.Int).Add(baseFee, priorityFee)
+	if gasPrice.Cmp(minGasPrice) < 0 {
+		return minGasPrice
+	}
+	return gasPrice
+}
+
 func (ob *ChainClient) BuildLastBlock() error {
 	logger := ob.logger.ChainLogger.With().Str("module", "BuildBlockIndex").Logger()
 	envvar := ob.chain.ChainName.String() + "_SCAN_FROM"
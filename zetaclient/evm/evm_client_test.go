@@ -1,6 +1,7 @@
 package evm_test
 
 import (
+	"math/big"
 	"sync"
 	"testing"
 
@@ -122,3 +123,17 @@ func TestEVM_VoteOutboundBallot(t *testing.T) {
 		require.Equal(t, ballotExpected, msg.Digest())
 	})
 }
+
+func TestEVM_DynamicFeeGasPrice(t *testing.T) {
+	t.Run("should not change the gas price if EIP-1559 is not supported", func(t *testing.T) {
+		require.Equal(t, big.NewInt(100), evm.DynamicFeeGasPrice(big.NewInt(100), nil, big.NewInt(0)))
+	})
+
+	t.Run("should not change the gas price covering the base fee and priority fee", func(t *testing.T) {
+		require.Equal(t, big.NewInt(100), evm.DynamicFeeGasPrice(big.NewInt(100), big.NewInt(90), big.NewInt(10)))
+	})
+
+	t.Run("should raise the gas price to the base fee plus priority fee", func(t *testing.T) {
+		require.Equal(t, big.NewInt(110), evm.DynamicFeeGasPrice(big.NewInt(100), big.NewInt(90), big.NewInt(20)))
+	})
+}
@@ -96,6 +96,35 @@ func NewEVMSigner(
 	}, nil
 }
 
+// newTx creates a new transaction
+// an EIP-1559 transaction is created if a priority fee is provided, gasPrice is then used as the max fee per gas
+// the voted gas price is a safe max fee per gas: it is at least twice the base fee plus the priority fee of the chain when voted,
+// only the base fee and the priority fee are paid, and the gas price increase of pending outbounds raises both the max fee and the priority fee
+// a legacy transaction is created otherwise
+func (signer *Signer) newTx(
+	nonce uint64,
+	to ethcommon.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	priorityFee *big.Int,
+	data []byte,
+) *ethtypes.Transaction {
+	if priorityFee == nil {
+		return ethtypes.NewTransaction(nonce, to, amount, gasLimit, gasPrice, data)
+	}
+	return ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+		ChainID:   signer.ethSigner.ChainID(),
+		Nonce:     nonce,
+		GasTipCap: priorityFee,
+		GasFeeCap: gasPrice,
+		Gas:       gasLimit,
+		To:        &to,
+		Value:     amount,
+		Data:      data,
+	})
+}
+
 // Sign given data, and metadata (gas, nonce, etc)
 // returns a signed transaction, sig bytes, hash bytes, and error
 func (signer *Signer) Sign(
@@ -103,11 +132,12 @@ func (signer *Signer) Sign(
 	to ethcommon.Address,
 	gasLimit uint64,
 	gasPrice *big.Int,
+	priorityFee *big.Int,
 	nonce uint64,
 	height uint64,
 ) (*ethtypes.Transaction, []byte, []byte, error) {
 	log.Debug().Msgf("TSS SIGNER: %s", signer.tssSigner.Pubkey())
-	tx := ethtypes.NewTransaction(nonce, to, big.NewInt(0), gasLimit, gasPrice, data)
+	tx := signer.newTx(nonce, to, big.NewInt(0), gasLimit, gasPrice, priorityFee, data)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
@@ -165,6 +195,7 @@ func (signer *Signer) SignOutboundTx(txData *OutBoundTransactionData) (*ethtypes
 		signer.metaContractAddress,
 		txData.gasLimit,
 		txData.gasPrice,
+		txData.priorityFee,
 		txData.nonce,
 		txData.height)
 	if err != nil {
@@ -204,6 +235,7 @@ func (signer *Signer) SignRevertTx(txData *OutBoundTransactionData) (*ethtypes.T
 		signer.metaContractAddress,
 		txData.gasLimit,
 		txData.gasPrice,
+		txData.priorityFee,
 		txData.nonce,
 		txData.height)
 	if err != nil {
@@ -214,8 +246,8 @@ func (signer *Signer) SignRevertTx(txData *OutBoundTransactionData) (*ethtypes.T
 }
 
 // SignCancelTx signs a transaction from TSS address to itself with a zero amount in order to increment the nonce
-func (signer *Signer) SignCancelTx(nonce uint64, gasPrice *big.Int, height uint64) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, nil)
+func (signer *Signer) SignCancelTx(nonce uint64, gasPrice *big.Int, priorityFee *big.Int, height uint64) (*ethtypes.Transaction, error) {
+	tx := signer.newTx(nonce, signer.tssSigner.EVMAddress(), big.NewInt(0), 21000, gasPrice, priorityFee, nil)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 	sig, err := signer.tssSigner.Sign(hashBytes, height, nonce, signer.chain, "")
 	if err != nil {
@@ -235,9 +267,14 @@ func (signer *Signer) SignCancelTx(nonce uint64, gasPrice *big.Int, height uint6
 	return signedTX, nil
 }
 
+// IsCancelTx returns true if the transaction is a cancel tx signed by SignCancelTx, sending a zero amount to the TSS address
+func IsCancelTx(tx *ethtypes.Transaction, tssAddress ethcommon.Address) bool {
+	return tx.To() != nil && *tx.To() == tssAddress && tx.Value().Sign() == 0
+}
+
 // SignWithdrawTx signs a withdrawal transaction sent from the TSS address to the destination
 func (signer *Signer) SignWithdrawTx(txData *OutBoundTransactionData) (*ethtypes.Transaction, error) {
-	tx := ethtypes.NewTransaction(txData.nonce, txData.to, txData.amount, 21000, txData.gasPrice, nil)
+	tx := signer.newTx(txData.nonce, txData.to, txData.amount, 21000, txData.gasPrice, txData.priorityFee, nil)
 	hashBytes := signer.ethSigner.Hash(tx).Bytes()
 	sig, err := signer.tssSigner.Sign(hashBytes, txData.height, txData.nonce, signer.chain, "")
 	if err != nil {
@@ -325,7 +362,14 @@ func (signer *Signer) TryProcessOutTx(
 	if clientcommon.IsCctxRestricted(cctx) {
 		clientcommon.PrintComplianceLog(logger, signer.logger.Compliance,
 			true, evmClient.chain.ChainId, cctx.Index, cctx.InboundTxParams.Sender, txData.to.Hex(), cctx.GetCurrentOutTxParam().CoinType.String())
-		tx, err = signer.SignCancelTx(txData.nonce, txData.gasPrice, height) // cancel the tx
+		tx, err = signer.SignCancelTx(txData.nonce, txData.gasPrice, txData.priorityFee, height) // cancel the tx
+		if err != nil {
+			logger.Warn().Err(err).Msg(SignerErrorMsg(cctx))
+			return
+		}
+	} else if cctx.GetCurrentOutTxParam().Cancelled { // expired outbound, consume the nonce with a cancel tx
+		logger.Info().Msgf("SignCancelTx: outbound of cctx %s expired, nonce %d", cctx.Index, txData.nonce)
+		tx, err = signer.SignCancelTx(txData.nonce, txData.gasPrice, txData.priorityFee, height)
 		if err != nil {
 			logger.Warn().Err(err).Msg(SignerErrorMsg(cctx))
 			return
@@ -556,7 +600,7 @@ func (signer *Signer) SignERC20WithdrawTx(txData *OutBoundTransactionData) (*eth
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, txData.gasLimit, txData.gasPrice, txData.nonce, txData.height)
+	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, txData.gasLimit, txData.gasPrice, txData.priorityFee, txData.nonce, txData.height)
 	if err != nil {
 		return nil, fmt.Errorf("sign error: %w", err)
 	}
@@ -589,7 +633,7 @@ func (signer *Signer) SignWhitelistTx(
 		return nil, fmt.Errorf("pack error: %w", err)
 	}
 
-	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nonce, height)
+	tx, _, _, err := signer.Sign(data, signer.erc20CustodyContractAddress, gasLimit, gasPrice, nil, nonce, height)
 	if err != nil {
 		return nil, fmt.Errorf("Sign error: %w", err)
 	}
@@ -615,7 +659,7 @@ func (signer *Signer) EvmSigner() ethtypes.Signer {
 func getEVMRPC(endpoint string) (interfaces.EVMRPCClient, *big.Int, ethtypes.Signer, error) {
 	if endpoint == stub.EVMRPCEnabled {
 		chainID := big.NewInt(common.BscMainnetChain().ChainId)
-		ethSigner := ethtypes.NewEIP155Signer(chainID)
+		ethSigner := ethtypes.NewLondonSigner(chainID)
 		client := stub.EvmClient{}
 		return client, chainID, ethSigner, nil
 	}
@@ -665,7 +709,7 @@ func (signer *Signer) SignWhitelistERC20Cmd(txData *OutBoundTransactionData, par
 	if err != nil {
 		return nil, err
 	}
-	tx, _, _, err := signer.Sign(data, txData.to, txData.gasLimit, txData.gasPrice, outboundParams.OutboundTxTssNonce, txData.height)
+	tx, _, _, err := signer.Sign(data, txData.to, txData.gasLimit, txData.gasPrice, txData.priorityFee, outboundParams.OutboundTxTssNonce, txData.height)
 	if err != nil {
 		return nil, fmt.Errorf("sign error: %w", err)
 	}
@@ -674,7 +718,7 @@ func (signer *Signer) SignWhitelistERC20Cmd(txData *OutBoundTransactionData, par
 
 func (signer *Signer) SignMigrateTssFundsCmd(txData *OutBoundTransactionData) (*ethtypes.Transaction, error) {
 	outboundParams := txData.outboundParams
-	tx, _, _, err := signer.Sign(nil, txData.to, txData.gasLimit, txData.gasPrice, outboundParams.OutboundTxTssNonce, txData.height)
+	tx, _, _, err := signer.Sign(nil, txData.to, txData.gasLimit, txData.gasPrice, txData.priorityFee, outboundParams.OutboundTxTssNonce, txData.height)
 	if err != nil {
 		return nil, err
 	}
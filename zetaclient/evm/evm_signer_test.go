@@ -1,10 +1,12 @@
 package evm
 
 import (
+	"math/big"
 	"path"
 	"testing"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
@@ -153,6 +155,33 @@ func TestSigner_SignRevertTx(t *testing.T) {
 	})
 }
 
+func TestSigner_IsCancelTx(t *testing.T) {
+	// Setup evm signer
+	evmSigner, err := getNewEvmSigner()
+	require.NoError(t, err)
+	tssAddress := stub.NewTSSMainnet().EVMAddress()
+
+	t.Run("IsCancelTx - should return true for a cancel tx", func(t *testing.T) {
+		tx, err := evmSigner.SignCancelTx(42, big.NewInt(100), big.NewInt(0), 123)
+		require.NoError(t, err)
+		require.True(t, IsCancelTx(tx, tssAddress))
+	})
+
+	t.Run("IsCancelTx - should return false for an outbound", func(t *testing.T) {
+		cctx, err := getCCTX()
+		require.NoError(t, err)
+		mockChainClient, err := getNewEvmChainClient()
+		require.NoError(t, err)
+		txData, skip, err := NewOutBoundTransactionData(cctx, mockChainClient, evmSigner.EvmClient(), zerolog.Logger{}, 123)
+		require.False(t, skip)
+		require.NoError(t, err)
+
+		tx, err := evmSigner.SignWithdrawTx(txData)
+		require.NoError(t, err)
+		require.False(t, IsCancelTx(tx, tssAddress))
+	})
+}
+
 func TestSigner_SignWithdrawTx(t *testing.T) {
 	// Setup evm signer
 	evmSigner, err := getNewEvmSigner()
@@ -180,6 +209,24 @@ func TestSigner_SignWithdrawTx(t *testing.T) {
 
 		verified := crypto.VerifySignature(tss.Pubkey(), hash.Bytes(), signature)
 		require.True(t, verified)
+		require.EqualValues(t, ethtypes.LegacyTxType, tx.Type())
+	})
+
+	t.Run("SignWithdrawTx - should sign EIP-1559 transaction if priority fee is set", func(t *testing.T) {
+		dynamicTxData := *txData
+		dynamicTxData.gasPrice = big.NewInt(100)
+		dynamicTxData.priorityFee = big.NewInt(5)
+
+		tx, err := evmSigner.SignWithdrawTx(&dynamicTxData)
+		require.NoError(t, err)
+		require.EqualValues(t, ethtypes.DynamicFeeTxType, tx.Type())
+		require.Equal(t, big.NewInt(100), tx.GasFeeCap())
+		require.Equal(t, big.NewInt(5), tx.GasTipCap())
+
+		// Verify sender
+		sender, err := ethtypes.Sender(evmSigner.EvmSigner(), tx)
+		require.NoError(t, err)
+		require.Equal(t, crypto.PubkeyToAddress(stub.TestPrivateKey.PublicKey), sender)
 	})
 }
 
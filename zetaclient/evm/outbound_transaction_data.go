@@ -31,9 +31,14 @@ type OutBoundTransactionData struct {
 	amount     *big.Int
 	gasPrice   *big.Int
 	gasLimit   uint64
-	message    []byte
-	nonce      uint64
-	height     uint64
+
+	// priorityFee is the max priority fee per gas of EIP-1559 transactions, gasPrice is then used as the max fee per gas
+	// a nil priorityFee means a legacy transaction is signed
+	priorityFee *big.Int
+
+	message []byte
+	nonce   uint64
+	height  uint64
 
 	// sendHash field is the inbound message digest that is sent to the destination contract
 	sendHash [32]byte
@@ -61,7 +66,8 @@ func (txData *OutBoundTransactionData) SetChainAndSender(cctx *types.CrossChainT
 	return false
 }
 
-// SetupGas sets the gas limit and price
+// SetupGas sets the gas limit, price and priority fee
+// the priority fee is only set if provided by the cctx and if the chain supports EIP-1559
 func (txData *OutBoundTransactionData) SetupGas(
 	cctx *types.CrossChainTx,
 	logger zerolog.Logger,
@@ -97,6 +103,25 @@ func (txData *OutBoundTransactionData) SetupGas(
 	} else {
 		txData.gasPrice = specified
 	}
+
+	// use EIP-1559 transaction if a priority fee is provided and the chain supports it
+	priorityFee, err := cctx.GetCurrentOutTxParam().GetGasPriorityFee()
+	if err != nil {
+		return err
+	}
+	if priorityFee > 0 {
+		isLondon, err := IsLondonEnabled(client)
+		if err != nil {
+			return errors.Join(err, fmt.Errorf("cannot check EIP-1559 support of chain %s ", chain))
+		}
+		if isLondon {
+			txData.priorityFee = new(big.Int).SetUint64(priorityFee)
+			// the priority fee can't be higher than the max fee per gas
+			if txData.priorityFee.Cmp(txData.gasPrice) > 0 {
+				txData.priorityFee = new(big.Int).Set(txData.gasPrice)
+			}
+		}
+	}
 	return nil
 }
 
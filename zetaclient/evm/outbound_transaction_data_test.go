@@ -1,14 +1,17 @@
 package evm
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 	corecommon "github.com/zeta-chain/zetacore/common"
 	"github.com/zeta-chain/zetacore/x/crosschain/types"
+	"github.com/zeta-chain/zetacore/zetaclient/testutils/stub"
 )
 
 func TestSigner_SetChainAndSender(t *testing.T) {
@@ -68,6 +71,80 @@ func TestSigner_SetupGas(t *testing.T) {
 	})
 }
 
+// londonEvmClient is an EVM client stub for a chain supporting EIP-1559
+type londonEvmClient struct {
+	stub.EvmClient
+}
+
+func (c londonEvmClient) HeaderByNumber(_ context.Context, _ *big.Int) (*ethtypes.Header, error) {
+	return &ethtypes.Header{BaseFee: big.NewInt(10)}, nil
+}
+
+func TestSigner_SetupGasPriorityFee(t *testing.T) {
+	logger := zerolog.Logger{}
+	chain := corecommon.EthChain()
+
+	t.Run("should set priority fee if chain supports EIP-1559", func(t *testing.T) {
+		cctx, err := getCCTX()
+		require.NoError(t, err)
+		cctx.GetCurrentOutTxParam().OutboundTxGasPrice = "100"
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = "5"
+
+		txData := &OutBoundTransactionData{}
+		err = txData.SetupGas(cctx, logger, londonEvmClient{}, &chain)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(100), txData.gasPrice)
+		require.Equal(t, big.NewInt(5), txData.priorityFee)
+	})
+
+	t.Run("should cap priority fee to gas price", func(t *testing.T) {
+		cctx, err := getCCTX()
+		require.NoError(t, err)
+		cctx.GetCurrentOutTxParam().OutboundTxGasPrice = "100"
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = "200"
+
+		txData := &OutBoundTransactionData{}
+		err = txData.SetupGas(cctx, logger, londonEvmClient{}, &chain)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(100), txData.priorityFee)
+	})
+
+	t.Run("should not set priority fee if chain doesn't support EIP-1559", func(t *testing.T) {
+		cctx, err := getCCTX()
+		require.NoError(t, err)
+		cctx.GetCurrentOutTxParam().OutboundTxGasPrice = "100"
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = "5"
+
+		txData := &OutBoundTransactionData{}
+		err = txData.SetupGas(cctx, logger, stub.EvmClient{}, &chain)
+		require.NoError(t, err)
+		require.Nil(t, txData.priorityFee)
+	})
+
+	t.Run("should not set priority fee if not provided", func(t *testing.T) {
+		cctx, err := getCCTX()
+		require.NoError(t, err)
+		cctx.GetCurrentOutTxParam().OutboundTxGasPrice = "100"
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = ""
+
+		txData := &OutBoundTransactionData{}
+		err = txData.SetupGas(cctx, logger, londonEvmClient{}, &chain)
+		require.NoError(t, err)
+		require.Nil(t, txData.priorityFee)
+	})
+
+	t.Run("should fail if priority fee is invalid", func(t *testing.T) {
+		cctx, err := getCCTX()
+		require.NoError(t, err)
+		cctx.GetCurrentOutTxParam().OutboundTxGasPrice = "100"
+		cctx.GetCurrentOutTxParam().OutboundTxGasPriorityFee = "invalid"
+
+		txData := &OutBoundTransactionData{}
+		err = txData.SetupGas(cctx, logger, londonEvmClient{}, &chain)
+		require.Error(t, err)
+	})
+}
+
 func TestSigner_NewOutBoundTransactionData(t *testing.T) {
 	// Setup evm signer
 	evmSigner, err := getNewEvmSigner()
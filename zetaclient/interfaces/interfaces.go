@@ -71,9 +71,10 @@ type ZetaCoreBridger interface {
 		nonce uint64,
 		coinType common.CoinType,
 	) (string, string, error)
-	PostGasPrice(chain common.Chain, gasPrice uint64, supply string, blockNum uint64) (string, error)
+	PostGasPrice(chain common.Chain, gasPrice uint64, priorityFee uint64, supply string, blockNum uint64) (string, error)
 	PostAddBlockHeader(chainID int64, txhash []byte, height int64, header common.HeaderData) (string, error)
 	GetBlockHeaderStateByChain(chainID int64) (observertypes.QueryGetBlockHeaderStateResponse, error)
+	GetBlockHeaderByHash(blockHash []byte) (common.BlockHeader, error)
 
 	PostBlameData(blame *blame.Blame, chainID int64, index string) (string, error)
 	AddTxHashToOutTxTracker(
@@ -28,7 +28,7 @@ func (z ZetaCoreBridge) PostVoteOutbound(_ string, _ string, _ uint64, _ uint64,
 	return "", "", nil
 }
 
-func (z ZetaCoreBridge) PostGasPrice(_ common.Chain, _ uint64, _ string, _ uint64) (string, error) {
+func (z ZetaCoreBridge) PostGasPrice(_ common.Chain, _ uint64, _ uint64, _ string, _ uint64) (string, error) {
 	return "", nil
 }
 
@@ -40,6 +40,10 @@ func (z ZetaCoreBridge) GetBlockHeaderStateByChain(_ int64) (observerTypes.Query
 	return observerTypes.QueryGetBlockHeaderStateResponse{}, nil
 }
 
+func (z ZetaCoreBridge) GetBlockHeaderByHash(_ []byte) (common.BlockHeader, error) {
+	return common.BlockHeader{}, nil
+}
+
 func (z ZetaCoreBridge) PostBlameData(_ *blame.Blame, _ int64, _ string) (string, error) {
 	return "", nil
 }
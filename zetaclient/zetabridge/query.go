@@ -57,6 +57,16 @@ func (b *ZetaCoreBridge) GetChainParams() ([]*observertypes.ChainParams, error)
 	return nil, fmt.Errorf("failed to get chain params | err %s", err.Error())
 }
 
+// GetPendingChainParams returns the chain params scheduled to be applied at an activation height
+func (b *ZetaCoreBridge) GetPendingChainParams() ([]observertypes.PendingChainParams, error) {
+	client := observertypes.NewQueryClient(b.grpcConn)
+	resp, err := client.PendingChainParams(context.Background(), &observertypes.QueryPendingChainParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.PendingChainParams, nil
+}
+
 func (b *ZetaCoreBridge) GetUpgradePlan() (*upgradetypes.Plan, error) {
 	client := upgradetypes.NewQueryClient(b.grpcConn)
 
@@ -111,6 +121,18 @@ func (b *ZetaCoreBridge) GetObserverList() ([]string, error) {
 	return nil, err
 }
 
+// GetObserverChains returns the chains the observer is assigned to, the list is empty if the observer is assigned to all chains
+func (b *ZetaCoreBridge) GetObserverChains(observerAddress string) ([]int64, error) {
+	client := observertypes.NewQueryClient(b.grpcConn)
+	resp, err := client.ObserverChains(context.Background(), &observertypes.QueryObserverChainsRequest{
+		ObserverAddress: observerAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.ChainIds, nil
+}
+
 // ListPendingCctx returns a list of pending cctxs for a given chainID
 // the returned list has a limited size of crosschainkeeper.MaxPendingCctxs
 // the total number of pending cctxs is returned
@@ -380,6 +402,18 @@ func (b *ZetaCoreBridge) GetBlockHeaderStateByChain(chainID int64) (observertype
 	return *resp, nil
 }
 
+func (b *ZetaCoreBridge) GetBlockHeaderByHash(blockHash []byte) (common.BlockHeader, error) {
+	client := observertypes.NewQueryClient(b.grpcConn)
+	resp, err := client.GetBlockHeaderByHash(context.Background(), &observertypes.QueryGetBlockHeaderByHashRequest{BlockHash: blockHash})
+	if err != nil {
+		return common.BlockHeader{}, err
+	}
+	if resp.BlockHeader == nil {
+		return common.BlockHeader{}, fmt.Errorf("block header not found: %x", blockHash)
+	}
+	return *resp.BlockHeader, nil
+}
+
 func (b *ZetaCoreBridge) GetSupportedChains() ([]*common.Chain, error) {
 	client := observertypes.NewQueryClient(b.grpcConn)
 	resp, err := client.SupportedChains(context.Background(), &observertypes.QuerySupportedChains{})
@@ -74,11 +74,16 @@ func (b *ZetaCoreBridge) WrapMessageWithAuthz(msg sdk.Msg) (sdk.Msg, authz2.Sign
 	return &authzMessage, authzSigner, nil
 }
 
-func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, supply string, blockNum uint64) (string, error) {
+// PostGasPrice posts the gas price and the priority fee (EIP-1559) of the chain
+// the priority fee should be zero for chains not supporting EIP-1559
+func (b *ZetaCoreBridge) PostGasPrice(chain common.Chain, gasPrice uint64, priorityFee uint64, supply string, blockNum uint64) (string, error) {
 	// double the gas price to avoid gas price spike
+	// on chains supporting EIP-1559, the gas price is the max fee per gas of the outbounds and only the base fee and priority fee are paid
+	// the gas price covers the base fee and the priority fee, once doubled it is at least 2 * base fee + priority fee,
+	// the max fee per gas set by default by go-ethereum, allowing the base fee to double (6 full blocks) before the outbound is stuck
 	gasPrice = gasPrice * common.DefaultGasPriceMultiplier
 	signerAddress := b.keys.GetOperatorAddress().String()
-	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, supply, blockNum)
+	msg := types.NewMsgGasPriceVoter(signerAddress, chain.ChainId, gasPrice, priorityFee, supply, blockNum)
 
 	authzMsg, authzSigner, err := b.WrapMessageWithAuthz(msg)
 	if err != nil {
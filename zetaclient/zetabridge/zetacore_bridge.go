@@ -237,6 +237,21 @@ func (b *ZetaCoreBridge) UpdateZetaCoreContext(coreContext *corecontext.ZetaCore
 		}
 	}
 
+	// chain params scheduled at an activation height, switched to by all the zetaclients at the same zeta height
+	pendingChainParams, err := b.GetPendingChainParams()
+	if err != nil {
+		return err
+	}
+	newPendingChainParams := make([]observertypes.PendingChainParams, 0, len(pendingChainParams))
+	for _, pending := range pendingChainParams {
+		err := config.ValidateChainParams(pending.ChainParams)
+		if err != nil {
+			b.logger.Warn().Err(err).Msgf("Invalid pending chain params for chain %d", pending.GetChainParams().GetChainId())
+			continue
+		}
+		newPendingChainParams = append(newPendingChainParams, pending)
+	}
+
 	chains, err := b.GetSupportedChains()
 	if err != nil {
 		return err
@@ -258,7 +273,24 @@ func (b *ZetaCoreBridge) UpdateZetaCoreContext(coreContext *corecontext.ZetaCore
 		tssPubKey = tss.GetTssPubkey()
 	}
 
-	coreContext.Update(keyGen, newChains, newEVMParams, newBTCParams, tssPubKey, init, b.logger)
+	// chains the observer is assigned to, all chains are observed if none is assigned
+	observedChainIDs, err := b.GetObserverChains(b.keys.GetOperatorAddress().String())
+	if err != nil {
+		return err
+	}
+
+	coreContext.Update(
+		keyGen,
+		newChains,
+		newEVMParams,
+		newBTCParams,
+		newPendingChainParams,
+		bn,
+		observedChainIDs,
+		tssPubKey,
+		init,
+		b.logger,
+	)
 	return nil
 }
 
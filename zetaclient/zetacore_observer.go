@@ -134,12 +134,32 @@ func (co *CoreObserver) startCctxScheduler(appContext *appcontext.AppContext) {
 					// Set Current Hot key burn rate
 					metrics.HotKeyBurnRate.Set(float64(co.ts.HotKeyBurnRate.GetBurnRate().Int64()))
 
+					// get the crosschain flags to skip the chains and coins with outbounds disabled
+					flags, err := co.bridge.GetCrosschainFlags()
+					if err != nil {
+						co.logger.ZetaChainWatcher.Error().Err(err).Msg("startCctxScheduler: GetCrosschainFlags fail")
+						continue
+					}
+
 					// schedule keysign for pending cctxs on each chain
 					supportedChains := appContext.ZetaCoreContext().GetEnabledChains()
 					for _, c := range supportedChains {
 						if c.ChainId == co.bridge.ZetaChain().ChainId {
 							continue
 						}
+						if !appContext.ZetaCoreContext().IsChainObserved(c.ChainId) {
+							continue
+						}
+						// update the chain params of the chain client at each zeta block to switch at the activation height
+						ob, err := co.getUpdatedChainOb(appContext, c.ChainId, bn)
+						if err != nil {
+							co.logger.ZetaChainWatcher.Error().Err(err).Msgf("startCctxScheduler: getTargetChainOb failed for chain %d", c.ChainId)
+							continue
+						}
+						if !flags.IsChainOutboundEnabled(c.ChainId) {
+							co.logger.ZetaChainWatcher.Debug().Msgf("startCctxScheduler: outbound disabled for chain %d", c.ChainId)
+							continue
+						}
 						signer := co.signerMap[c]
 
 						cctxList, totalPending, err := co.bridge.ListPendingCctx(c.ChainId)
@@ -147,20 +167,15 @@ func (co *CoreObserver) startCctxScheduler(appContext *appcontext.AppContext) {
 							co.logger.ZetaChainWatcher.Error().Err(err).Msgf("startCctxScheduler: ListPendingCctx failed for chain %d", c.ChainId)
 							continue
 						}
-						ob, err := co.getUpdatedChainOb(appContext, c.ChainId)
-						if err != nil {
-							co.logger.ZetaChainWatcher.Error().Err(err).Msgf("startCctxScheduler: getTargetChainOb failed for chain %d", c.ChainId)
-							continue
-						}
 						// Set Pending transactions prometheus gauge
 						metrics.PendingTxsPerChain.WithLabelValues(c.ChainName.String()).Set(float64(totalPending))
 
 						// #nosec G701 range is verified
 						zetaHeight := uint64(bn)
 						if common.IsEVMChain(c.ChainId) {
-							co.scheduleCctxEVM(outTxMan, zetaHeight, c.ChainId, cctxList, ob, signer)
+							co.scheduleCctxEVM(outTxMan, zetaHeight, c.ChainId, cctxList, ob, signer, flags)
 						} else if common.IsBitcoinChain(c.ChainId) {
-							co.scheduleCctxBTC(outTxMan, zetaHeight, c.ChainId, cctxList, ob, signer)
+							co.scheduleCctxBTC(outTxMan, zetaHeight, c.ChainId, cctxList, ob, signer, flags)
 						} else {
 							co.logger.ZetaChainWatcher.Error().Msgf("startCctxScheduler: unsupported chain %d", c.ChainId)
 							continue
@@ -182,7 +197,8 @@ func (co *CoreObserver) scheduleCctxEVM(
 	chainID int64,
 	cctxList []*types.CrossChainTx,
 	ob interfaces.ChainClient,
-	signer interfaces.ChainSigner) {
+	signer interfaces.ChainSigner,
+	flags observertypes.CrosschainFlags) {
 	res, err := co.bridge.GetAllOutTxTrackerByChain(chainID, interfaces.Ascending)
 	if err != nil {
 		co.logger.ZetaChainWatcher.Warn().Err(err).Msgf("scheduleCctxEVM: GetAllOutTxTrackerByChain failed for chain %d", chainID)
@@ -219,6 +235,13 @@ func (co *CoreObserver) scheduleCctxEVM(
 			continue
 		}
 
+		// stop at the first outtx of a coin with outbounds disabled as the following nonces can't be processed before it
+		if !flags.IsForeignCoinOutboundEnabled(chainID, params.CoinType, cctx.InboundTxParams.Asset) {
+			co.logger.ZetaChainWatcher.Warn().Msgf("scheduleCctxEVM: outbound disabled for outtx %s, coin type %s, asset %s",
+				outTxID, params.CoinType.String(), cctx.InboundTxParams.Asset)
+			break
+		}
+
 		// #nosec G701 positive
 		interval := uint64(ob.GetChainParams().OutboundTxScheduleInterval)
 		lookahead := ob.GetChainParams().OutboundTxScheduleLookahead
@@ -268,7 +291,8 @@ func (co *CoreObserver) scheduleCctxBTC(
 	chainID int64,
 	cctxList []*types.CrossChainTx,
 	ob interfaces.ChainClient,
-	signer interfaces.ChainSigner) {
+	signer interfaces.ChainSigner,
+	flags observertypes.CrosschainFlags) {
 	btcClient, ok := ob.(*bitcoin.BTCChainClient)
 	if !ok { // should never happen
 		co.logger.ZetaChainWatcher.Error().Msgf("scheduleCctxBTC: chain client is not a bitcoin client")
@@ -295,8 +319,19 @@ func (co *CoreObserver) scheduleCctxBTC(
 			continue
 		}
 		if included || confirmed {
-			co.logger.ZetaChainWatcher.Info().Msgf("scheduleCctxBTC: outtx %s already included; do not schedule keysign", outTxID)
-			continue
+			// schedule a keysign only to replace the outtx by fee if its gas price was increased
+			if confirmed || !btcClient.IsOutTxReplaceable(cctx) {
+				co.logger.ZetaChainWatcher.Info().Msgf("scheduleCctxBTC: outtx %s already included; do not schedule keysign", outTxID)
+				continue
+			}
+			co.logger.ZetaChainWatcher.Info().Msgf("scheduleCctxBTC: outtx %s not mined yet; schedule keysign to replace it by fee", outTxID)
+		}
+
+		// stop at the first outtx of a coin with outbounds disabled as the following nonces can't be processed before it
+		if !flags.IsForeignCoinOutboundEnabled(chainID, params.CoinType, cctx.InboundTxParams.Asset) {
+			co.logger.ZetaChainWatcher.Warn().Msgf("scheduleCctxBTC: outbound disabled for outtx %s, coin type %s",
+				outTxID, params.CoinType.String())
+			break
 		}
 
 		// stop if the nonce being processed is higher than the pending nonce
@@ -317,33 +352,23 @@ func (co *CoreObserver) scheduleCctxBTC(
 	}
 }
 
-func (co *CoreObserver) getUpdatedChainOb(appContext *appcontext.AppContext, chainID int64) (interfaces.ChainClient, error) {
+// getUpdatedChainOb returns the chain client with the chain params active at the zeta height
+func (co *CoreObserver) getUpdatedChainOb(appContext *appcontext.AppContext, chainID int64, zetaHeight int64) (interfaces.ChainClient, error) {
 	chainOb, err := co.getTargetChainOb(chainID)
 	if err != nil {
 		return nil, err
 	}
 	// update chain client core parameters
 	curParams := chainOb.GetChainParams()
-	if common.IsEVMChain(chainID) {
-		evmParams, found := appContext.ZetaCoreContext().GetEVMChainParams(chainID)
-		if found && !observertypes.ChainParamsEqual(curParams, *evmParams) {
-			chainOb.SetChainParams(*evmParams)
-			co.logger.ZetaChainWatcher.Info().Msgf(
-				"updated chain params for chainID %d, new params: %v",
-				chainID,
-				*evmParams,
-			)
-		}
-	} else if common.IsBitcoinChain(chainID) {
-		_, btcParams, found := appContext.ZetaCoreContext().GetBTCChainParams()
-
-		if found && !observertypes.ChainParamsEqual(curParams, *btcParams) {
-			chainOb.SetChainParams(*btcParams)
-			co.logger.ZetaChainWatcher.Info().Msgf(
-				"updated chain params for Bitcoin, new params: %v",
-				*btcParams,
-			)
-		}
+	chainParams, found := appContext.ZetaCoreContext().GetChainParamsAtHeight(chainID, zetaHeight)
+	if found && !observertypes.ChainParamsEqual(curParams, *chainParams) {
+		chainOb.SetChainParams(*chainParams)
+		co.logger.ZetaChainWatcher.Info().Msgf(
+			"updated chain params for chainID %d at zeta height %d, new params: %v",
+			chainID,
+			zetaHeight,
+			*chainParams,
+		)
 	}
 	return chainOb, nil
 }